@@ -54,3 +54,19 @@ apiregistration.k8s.io/v1
 apiregistration.k8s.io/v1beta1
 {{- end -}}
 {{- end -}}
+
+{{- define "priorityclassversion" -}}
+{{- if .Capabilities.APIVersions.Has "scheduling.k8s.io/v1beta1" -}}
+scheduling.k8s.io/v1beta1
+{{- else -}}
+scheduling.k8s.io/v1alpha1
+{{- end -}}
+{{- end -}}
+
+{{- define "podsecuritypolicyversion" -}}
+{{- if semverCompare ">= 1.10" .Capabilities.KubeVersion.GitVersion -}}
+policy/v1beta1
+{{- else -}}
+extensions/v1beta1
+{{- end -}}
+{{- end -}}
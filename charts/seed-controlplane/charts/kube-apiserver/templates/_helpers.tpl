@@ -10,6 +10,12 @@
 {{- end }}
 {{- end -}}
 
+{{- define "kube-apiserver.watchCacheSizes" }}
+{{- if .Values.watchCacheSizes.resources }}
+- --watch-cache-sizes={{ range $resource, $size := .Values.watchCacheSizes.resources }}{{ $resource }}#{{ $size }},{{ end }}
+{{- end }}
+{{- end -}}
+
 {{- define "kube-apiserver.oidcConfig" }}
 {{- if .Values.oidcConfig }}
 {{- if .Values.oidcConfig.issuerURL }}
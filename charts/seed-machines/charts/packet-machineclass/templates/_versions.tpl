@@ -0,0 +1 @@
+../../../../_versions.tpl
\ No newline at end of file
@@ -110,6 +110,33 @@ resource "aws_security_group_rule" "nodes_egress_all" {
   security_group_id = "${aws_security_group.nodes.id}"
 }
 
+{{ range $worker := .Values.workerFirewallRules }}
+resource "aws_security_group" "nodes_worker_{{ $worker.name }}" {
+  name        = "{{ required "clusterName is required" $.Values.clusterName }}-nodes-{{ $worker.name }}"
+  description = "Security group for the nodes of worker group {{ $worker.name }}"
+  vpc_id      = "{{ required "vpc.id is required" $.Values.vpc.id }}"
+
+  // The security group must not carry the cluster tag, because the cloud-controller-manager expects exactly one
+  // tagged security group per instance.
+  tags {
+    Name = "{{ required "clusterName is required" $.Values.clusterName }}-nodes-{{ $worker.name }}"
+  }
+}
+{{ range $index, $rule := $worker.rules }}
+resource "aws_security_group_rule" "nodes_worker_{{ $worker.name }}_{{ $index }}" {
+  type              = "ingress"
+  from_port         = {{ $rule.fromPort }}
+  to_port           = {{ $rule.toPort }}
+  protocol          = "{{ $rule.protocol }}"
+  cidr_blocks       = [{{ range $i, $cidr := $rule.cidrs }}{{ if $i }}, {{ end }}"{{ $cidr }}"{{ end }}]
+  security_group_id = "${aws_security_group.nodes_worker_{{ $worker.name }}.id}"
+}
+{{ end }}
+output "security_group_nodes_{{ $worker.name }}" {
+  value = "${aws_security_group.nodes_worker_{{ $worker.name }}.id}"
+}
+{{ end }}
+
 {{ range $index, $zone := .Values.zones }}
 resource "aws_subnet" "nodes_z{{ $index }}" {
   vpc_id            = "{{ required "vpc.id is required" $.Values.vpc.id }}"
@@ -196,6 +223,10 @@ resource "aws_nat_gateway" "natgw_z{{ $index }}" {
   subnet_id     = "${aws_subnet.public_utility_z{{ $index }}.id}"
 }
 
+output "nat_ip_z{{ $index }}" {
+  value = "${aws_eip.eip_natgw_z{{ $index }}.public_ip}"
+}
+
 resource "aws_route_table" "routetable_private_utility_z{{ $index }}" {
   vpc_id = "{{ required "vpc.id is required" $.Values.vpc.id }}"
 
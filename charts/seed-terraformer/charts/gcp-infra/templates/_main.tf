@@ -96,6 +96,25 @@ resource "google_compute_firewall" "rule-allow-health-checks" {
   }
 }
 
+// Allow additional inbound traffic to the nodes of worker groups with firewall rules. The nodes carry the tag
+// <clusterName>-<workerName>.
+{{- range $worker := .Values.workerFirewallRules }}
+{{- range $index, $rule := $worker.rules }}
+
+resource "google_compute_firewall" "rule-allow-worker-{{ $worker.name }}-{{ $index }}" {
+  name          = "{{ required "clusterName is required" $.Values.clusterName }}-allow-worker-{{ $worker.name }}-{{ $index }}"
+  network       = "{{ required "vpc.name is required" $.Values.vpc.name }}"
+  source_ranges = [{{ range $i, $cidr := $rule.cidrs }}{{ if $i }}, {{ end }}"{{ $cidr }}"{{ end }}]
+  target_tags   = ["{{ required "clusterName is required" $.Values.clusterName }}-{{ $worker.name }}"]
+
+  allow {
+    protocol = "{{ $rule.protocol }}"
+    ports    = ["{{ $rule.fromPort }}{{ if ne $rule.fromPort $rule.toPort }}-{{ $rule.toPort }}{{ end }}"]
+  }
+}
+{{- end }}
+{{- end }}
+
 // We have introduced new output variables. However, they are not applied for
 // existing clusters as Terraform won't detect a diff when we run `terraform plan`.
 // Workaround: Providing a null-resource for letting Terraform think that there are
@@ -76,6 +76,28 @@ resource "openstack_networking_secgroup_rule_v2" "cluster_tcp_all" {
   security_group_id = "${openstack_networking_secgroup_v2.cluster.id}"
 }
 
+{{ range $worker := .Values.workerFirewallRules }}
+resource "openstack_networking_secgroup_v2" "worker_{{ $worker.name }}" {
+  name                 = "{{ required "clusterName is required" $.Values.clusterName }}-{{ $worker.name }}"
+  description          = "Cluster Nodes of worker group {{ $worker.name }}"
+  delete_default_rules = true
+}
+{{ range $index, $rule := $worker.rules }}{{ range $cidrIndex, $cidr := $rule.cidrs }}
+resource "openstack_networking_secgroup_rule_v2" "worker_{{ $worker.name }}_{{ $index }}_{{ $cidrIndex }}" {
+  direction         = "ingress"
+  ethertype         = "IPv4"
+  protocol          = "{{ $rule.protocol }}"
+  port_range_min    = {{ $rule.fromPort }}
+  port_range_max    = {{ $rule.toPort }}
+  remote_ip_prefix  = "{{ $cidr }}"
+  security_group_id = "${openstack_networking_secgroup_v2.worker_{{ $worker.name }}.id}"
+}
+{{ end }}{{ end }}
+output "security_group_name_{{ $worker.name }}" {
+  value = "${openstack_networking_secgroup_v2.worker_{{ $worker.name }}.name}"
+}
+{{ end }}
+
 //=====================================================================
 //= SSH Key for Nodes (Bastion and Worker)
 //=====================================================================
@@ -1,5 +1,8 @@
 {{- define "terraformer.podSpec" -}}
 restartPolicy: Never
+{{- if .Capabilities.APIVersions.Has (include "priorityclassversion" .) }}
+priorityClassName: gardener-shoot-controller
+{{- end }}
 activeDeadlineSeconds: 1800
 containers:
 - name: terraform
@@ -25,19 +25,27 @@ if ! CLOUD_CONFIG_SECRET="$(/bin/docker run \
   -v "$DIR_CLOUDCONFIG"/:"$DIR_CLOUDCONFIG" \
   -v "$DIR_CLOUDCONFIG_DOWNLOADER"/:"$DIR_CLOUDCONFIG_DOWNLOADER" \
   k8s.gcr.io/hyperkube:v1.9.3\
-  kubectl --kubeconfig="$PATH_KUBECONFIG" --namespace=kube-system get secret "$SECRET_NAME" -o jsonpath='{.data.cloudconfig}{"\t"}{.data.bootstrapToken}')"; then
+  kubectl --kubeconfig="$PATH_KUBECONFIG" --namespace=kube-system get secret "$SECRET_NAME" -o jsonpath='{.data.cloudconfig}{"\t"}{.data.bootstrapToken}{"\t"}{.data.checksum}')"; then
   echo "Could not retrieve the cloud config secret with name $SECRET_NAME"
   exit 1
 fi
 
 echo $CLOUD_CONFIG_SECRET | awk '{print $1}' | base64 -d > "$PATH_CLOUDCONFIG"
 BOOTSTRAP_TOKEN="$(echo $CLOUD_CONFIG_SECRET | awk '{print $2}' | base64 -d)"
+CHECKSUM="$(echo $CLOUD_CONFIG_SECRET | awk '{print $3}' | base64 -d)"
 
 if [ ! -f "$PATH_CLOUDCONFIG" ]; then
   echo "No cloud config file found at location $PATH_CLOUDCONFIG"
   exit 1
 fi
 
+# Secrets which were written before the checksum was introduced do not contain it.
+if [ -n "$CHECKSUM" ] && [ "$(sha256sum "$PATH_CLOUDCONFIG" | awk '{print $1}')" != "$CHECKSUM" ]; then
+  echo "The checksum of the downloaded cloud config does not match, discarding it"
+  rm -f "$PATH_CLOUDCONFIG"
+  exit 1
+fi
+
 if [[ ! -f "$DIR_KUBELET/kubeconfig-real" ]]; then
   CLUSTER_INFO="$("$PATH_YAML2JSON" < "$PATH_KUBECONFIG" | jq -r '.clusters[0].cluster')"
   CA_CRT="$(echo $CLUSTER_INFO | jq -r '."certificate-authority-data"')"
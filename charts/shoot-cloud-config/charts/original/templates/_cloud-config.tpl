@@ -11,9 +11,24 @@ coreos:
 {{ include "kubelet-monitor" . | indent 2 }}
 {{ include "update-ca-certs" . | indent 2 }}
 {{ include "systemd-sysctl" . | indent 2 }}
+{{- if .worker.osUpdates }}
+{{ include "os-update-monitor" . | indent 2 }}
+{{- end }}
+{{- if .worker.gpu }}
+{{ include "nvidia-driver-installer" . | indent 2 }}
+{{- end }}
+{{- if .worker.localSSDs }}
+{{ include "local-ssds" . | indent 2 }}
+{{- end }}
 write_files:
 {{ include "kubelet-binary" . }}
 {{ include "root-certs" . }}
 {{ include "kernel-config" . }}
 {{ include "health-monitor" . }}
+{{- if .worker.osUpdates }}
+{{ include "os-update-monitor-script" . }}
+{{- end }}
+{{- if .worker.localSSDs }}
+{{ include "local-ssds-script" . }}
+{{- end }}
 {{- end }}
@@ -3,3 +3,13 @@
 --feature-gates={{ range $feature, $enabled := .kubernetes.kubelet.featureGates }}{{ $feature }}={{ $enabled }},{{ end }}
 {{- end }}
 {{- end -}}
+
+{{- define "kubelet.nodeLabels" -}}
+--node-labels="kubernetes.io/role=node,node-role.kubernetes.io/node=,worker.garden.sapcloud.io/group={{ required "workers.name is required" .worker.name }}{{ range $key, $value := .worker.nodeLabels }},{{ $key }}={{ $value }}{{ end }}"
+{{- end -}}
+
+{{- define "kubelet.nodeTaints" -}}
+{{- if .worker.nodeTaints }}
+--register-with-taints="{{ join "," .worker.nodeTaints }}"
+{{- end }}
+{{- end -}}
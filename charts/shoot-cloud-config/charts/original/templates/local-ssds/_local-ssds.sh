@@ -0,0 +1,64 @@
+{{define "local-ssds-script" -}}
+{{/* Do not remove the indentation, this is required because this template is imported by others */ -}}
+- path: /opt/bin/local-ssds
+  permissions: 0755
+  content: |
+    #!/bin/bash -e
+    # Several local SSDs are combined into one striped (RAID 0) volume which is only formatted if it does not contain
+    # a file system yet, hence, its data survives reboots. The data of the container runtime and the pods of the
+    # kubelet (including their emptyDir volumes) are bind-mounted onto the volume.
+    COUNT={{ required "worker.localSSDs.count is required" .worker.localSSDs.count }}
+    MOUNT_PATH=/mnt/local-ssds
+
+    if mountpoint -q "$MOUNT_PATH"; then
+      exit 0
+    fi
+
+    function find_devices {
+      for device in {{ join " " .worker.localSSDs.devices }}; do
+        if [ -b "$device" ]; then
+          readlink -f "$device"
+        fi
+      done | sort -u
+    }
+
+    DEVICES=($(find_devices))
+    # Machines which have been created before the local SSDs were configured keep running on their root disks until
+    # they are replaced, the local SSDs of new machines might only appear some time after the boot.
+    if [ -f /var/lib/kubelet/kubeconfig-real ] && [ ${#DEVICES[@]} -eq 0 ]; then
+      echo "No local SSDs found, keeping the data on the root disk"
+      exit 0
+    fi
+    for i in $(seq 60); do
+      if [ ${#DEVICES[@]} -ge $COUNT ]; then
+        break
+      fi
+      sleep 5
+      DEVICES=($(find_devices))
+    done
+    if [ ${#DEVICES[@]} -lt $COUNT ]; then
+      echo "Found ${#DEVICES[@]} of $COUNT local SSDs: ${DEVICES[*]}"
+      exit 1
+    fi
+    DEVICES=("${DEVICES[@]:0:$COUNT}")
+
+    DEVICE="${DEVICES[0]}"
+    if [ $COUNT -gt 1 ]; then
+      DEVICE=/dev/md/local-ssds
+      if [ ! -e "$DEVICE" ]; then
+        mdadm --assemble "$DEVICE" "${DEVICES[@]}" 2>/dev/null || mdadm --create "$DEVICE" --level=0 --raid-devices=$COUNT --run --force "${DEVICES[@]}"
+      fi
+    fi
+    if ! blkid "$DEVICE" >/dev/null; then
+      mkfs.ext4 -F -m 0 "$DEVICE"
+    fi
+
+    mkdir -p "$MOUNT_PATH"
+    mount -o discard "$DEVICE" "$MOUNT_PATH"
+    for dir in docker kubelet-pods; do
+      mkdir -p "$MOUNT_PATH/$dir"
+    done
+    mkdir -p /var/lib/docker /var/lib/kubelet/pods
+    mount --bind "$MOUNT_PATH/docker" /var/lib/docker
+    mount --bind "$MOUNT_PATH/kubelet-pods" /var/lib/kubelet/pods
+{{- end}}
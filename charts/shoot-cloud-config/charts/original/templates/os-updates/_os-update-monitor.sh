@@ -0,0 +1,15 @@
+{{define "os-update-monitor-script" -}}
+{{/* Do not remove the indentation, this is required because this template is imported by others */ -}}
+- path: /opt/bin/os-update-monitor
+  permissions: 0755
+  content: |
+    #!/bin/bash
+    # The update engine installs the updates into the passive partition, the node reboot coordinator reboots the
+    # node into it once /var/run/reboot-required exists. /var/run is empty again after the reboot.
+    while true; do
+      if update_engine_client -status 2>/dev/null | grep -q UPDATE_STATUS_UPDATED_NEED_REBOOT; then
+        touch /var/run/reboot-required
+      fi
+      sleep 300
+    done
+{{- end}}
@@ -27,12 +27,15 @@ import (
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apiserver"
+	"github.com/gardener/gardener/pkg/apiserver/admission/cloudprovider"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardenclientset "github.com/gardener/gardener/pkg/client/garden/clientset/internalversion"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	resourcereferencemanager "github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
 	shootdnshostedzone "github.com/gardener/gardener/plugin/pkg/shoot/dnshostedzone"
+	shootnaming "github.com/gardener/gardener/plugin/pkg/shoot/naming"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
+	shootregionpolicy "github.com/gardener/gardener/plugin/pkg/shoot/regionpolicy"
 	shootseedmanager "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
 	"github.com/spf13/cobra"
@@ -82,6 +85,7 @@ type Options struct {
 	Recommended           *genericoptions.RecommendedOptions
 	GardenInformerFactory gardeninformers.SharedInformerFactory
 	KubeInformerFactory   kubeinformers.SharedInformerFactory
+	CloudProviderRegistry *cloudprovider.Registry
 	StdOut                io.Writer
 	StdErr                io.Writer
 }
@@ -89,9 +93,10 @@ type Options struct {
 // NewOptions returns a new Options object.
 func NewOptions(out, errOut io.Writer) *Options {
 	return &Options{
-		Recommended: genericoptions.NewRecommendedOptions(fmt.Sprintf("/registry/%s", garden.GroupName), api.Codecs.LegacyCodec(gardenv1beta1.SchemeGroupVersion)),
-		StdOut:      out,
-		StdErr:      errOut,
+		Recommended:           genericoptions.NewRecommendedOptions(fmt.Sprintf("/registry/%s", garden.GroupName), api.Codecs.LegacyCodec(gardenv1beta1.SchemeGroupVersion)),
+		CloudProviderRegistry: cloudprovider.NewDefaultRegistry(),
+		StdOut:                out,
+		StdErr:                errOut,
 	}
 }
 
@@ -113,14 +118,18 @@ func (o *Options) complete() error {
 	// Admission plugin registration
 	resourcereferencemanager.Register(o.Recommended.Admission.Plugins)
 	shootquotavalidator.Register(o.Recommended.Admission.Plugins)
+	shootregionpolicy.Register(o.Recommended.Admission.Plugins)
 	shootseedmanager.Register(o.Recommended.Admission.Plugins)
 	shootdnshostedzone.Register(o.Recommended.Admission.Plugins)
+	shootnaming.Register(o.Recommended.Admission.Plugins)
 	shootvalidator.Register(o.Recommended.Admission.Plugins)
 
 	allOrderedPlugins := []string{
 		resourcereferencemanager.PluginName,
 		shootdnshostedzone.PluginName,
+		shootnaming.PluginName,
 		shootquotavalidator.PluginName,
+		shootregionpolicy.PluginName,
 		shootseedmanager.PluginName,
 		shootvalidator.PluginName,
 	}
@@ -141,6 +150,9 @@ func (o *Options) config() (*apiserver.Config, error) {
 	if !enabledPlugins.Has(shootvalidator.PluginName) {
 		enabledPlugins.Insert(shootvalidator.PluginName)
 	}
+	if !enabledPlugins.Has(shootnaming.PluginName) {
+		enabledPlugins.Insert(shootnaming.PluginName)
+	}
 	o.Recommended.Admission.EnablePlugins = enabledPlugins.List()
 
 	// Create clientset for the garden.sapcloud.io API group
@@ -168,7 +180,7 @@ func (o *Options) config() (*apiserver.Config, error) {
 		}
 		gardenInformerFactory := gardeninformers.NewSharedInformerFactory(gardenClient, gardenerAPIServerConfig.LoopbackClientConfig.Timeout)
 		o.GardenInformerFactory = gardenInformerFactory
-		return []admission.PluginInitializer{admissioninitializer.New(gardenInformerFactory, kubeInformerFactory, gardenerAPIServerConfig.Authorization.Authorizer)}, nil
+		return []admission.PluginInitializer{admissioninitializer.New(gardenInformerFactory, kubeInformerFactory, gardenerAPIServerConfig.Authorization.Authorizer, o.CloudProviderRegistry)}, nil
 	}
 
 	gardenerAPIServerConfig.OpenAPIConfig = genericapiserver.DefaultOpenAPIConfig(openapi.GetOpenAPIDefinitions, api.Scheme)
@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -25,10 +26,12 @@ import (
 	"github.com/gardener/gardener/pkg/apis/componentconfig"
 	componentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/componentconfig/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 	gardenclientset "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controller"
+	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/server"
@@ -39,8 +42,10 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	kubeinformers "k8s.io/client-go/informers"
 	k8s "k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -55,14 +60,18 @@ import (
 type Options struct {
 	// ConfigFile is the location of the Gardener controller manager's configuration file.
 	ConfigFile string
-	config     *componentconfig.ControllerManagerConfiguration
-	scheme     *runtime.Scheme
-	codecs     serializer.CodecFactory
+	// ValidateLandscape makes the Gardener controller manager validate the landscape configuration and exit
+	// instead of running its controllers.
+	ValidateLandscape bool
+	config            *componentconfig.ControllerManagerConfiguration
+	scheme            *runtime.Scheme
+	codecs            serializer.CodecFactory
 }
 
 // AddFlags adds flags for a specific Gardener controller manager to the specified FlagSet.
 func AddFlags(options *Options, fs *pflag.FlagSet) {
 	fs.StringVar(&options.ConfigFile, "config", options.ConfigFile, "The path to the configuration file.")
+	fs.BoolVar(&options.ValidateLandscape, "validate-landscape", options.ValidateLandscape, "Validate all CloudProfiles, Seeds and Shoots of the landscape and exit without reconciling.")
 }
 
 // NewOptions returns a new Options object.
@@ -153,6 +162,10 @@ func (o *Options) run(stopCh chan struct{}) error {
 		config = c
 	}
 
+	if o.ValidateLandscape {
+		return validateLandscape(config)
+	}
+
 	gardener, err := NewGardener(config)
 	if err != nil {
 		return err
@@ -210,6 +223,7 @@ type Gardener struct {
 	Logger            *logrus.Logger
 	Recorder          record.EventRecorder
 	LeaderElection    *leaderelection.LeaderElectionConfig
+	ShardFilter       *controllerutils.ShardFilter
 }
 
 // NewGardener is the main entry point of instantiating a new Gardener controller manager.
@@ -218,6 +232,13 @@ func NewGardener(config *componentconfig.ControllerManagerConfiguration) (*Garde
 		return nil, errors.New("config is required")
 	}
 	componentconfig.ApplyEnvironmentToConfig(config)
+	if err := componentconfig.ValidateShootExtensions(config.Controllers.Shoot.Extensions); err != nil {
+		return nil, err
+	}
+	if err := componentconfig.ValidateCloudAPIs(config.CloudAPIs); err != nil {
+		return nil, err
+	}
+	configureCloudAPIs(config.CloudAPIs)
 
 	// Initialize logger
 	logger := logger.NewLogger(config.LogLevel)
@@ -257,13 +278,22 @@ func NewGardener(config *componentconfig.ControllerManagerConfiguration) (*Garde
 	}
 	k8sGardenClient.SetGardenClientset(gardenerClientset)
 
-	// Set up leader election if enabled and prepare event recorder.
+	shardFilter, err := controllerutils.NewShardFilter(config.Sharding)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sharding configuration: %v", err)
+	}
+
+	// Set up leader election if enabled and prepare event recorder. Every shard elects its own leader.
 	var (
 		leaderElectionConfig *leaderelection.LeaderElectionConfig
 		recorder             = createRecorder(k8sGardenClient.Clientset())
 	)
 	if config.LeaderElection.LeaderElect {
-		leaderElectionConfig, err = makeLeaderElectionConfig(config.LeaderElection, k8sGardenClientLeaderElection.Clientset(), recorder)
+		leaderElection := config.LeaderElection
+		if shardFilter.Sharded() {
+			leaderElection.LockObjectName = fmt.Sprintf("%s-shard-%d", leaderElection.LockObjectName, config.Sharding.Shard)
+		}
+		leaderElectionConfig, err = makeLeaderElectionConfig(leaderElection, k8sGardenClientLeaderElection.Clientset(), recorder)
 		if err != nil {
 			return nil, err
 		}
@@ -282,14 +312,37 @@ func NewGardener(config *componentconfig.ControllerManagerConfiguration) (*Garde
 		Recorder:          recorder,
 		K8sGardenClient:   k8sGardenClient,
 		LeaderElection:    leaderElectionConfig,
+		ShardFilter:       shardFilter,
 	}, nil
 }
 
+// configureCloudAPIs sets the rate limits and circuit breakers of the cloud APIs of the providers from the
+// configuration. It must be called before the first cloud API client is created.
+func configureCloudAPIs(cloudAPIs map[string]componentconfig.CloudAPIConfiguration) {
+	for provider, cloudAPI := range cloudAPIs {
+		cloudapi.Configure(provider, cloudapi.Settings{
+			QPS:              float64(cloudAPI.QPS),
+			Burst:            cloudAPI.Burst,
+			FailureThreshold: cloudAPI.FailureThreshold,
+			OpenDuration:     cloudAPI.OpenDuration.Duration,
+		})
+	}
+}
+
 // Run runs the Gardener. This should never exit.
 func (g *Gardener) Run(stopCh chan struct{}) error {
+	// The controllers register the authenticated Shoot endpoints (e.g., the reconcile plan) once they have been
+	// started, and the webhook server serves them. Until then, i.e. on replicas which do not hold the leader election,
+	// the endpoints respond that they are unavailable.
+	shootHandlers := http.NewServeMux()
+	shootHandlers.HandleFunc("/shoots/", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "the Shoot endpoints are only served by the replica which holds the leader election", http.StatusServiceUnavailable)
+	})
+
 	// Prepare a reusable run function.
 	run := func(stop <-chan struct{}) {
-		go startControllers(g, stopCh)
+		g.labelLeader(true)
+		go startControllers(g, shootHandlers, stopCh)
 		<-stop
 		if _, stopChIsNotClosed := (<-stopCh); stopChIsNotClosed {
 			close(stopCh)
@@ -301,6 +354,17 @@ func (g *Gardener) Run(stopCh chan struct{}) error {
 	go server.Serve(g.K8sGardenClient, g.Config.Server.BindAddress, g.Config.Server.Port, g.Config.Metrics.Interval.Duration)
 	handlers.UpdateHealth(true)
 
+	// Start HTTPS server for the validating webhooks and the Shoot endpoints (on all replicas, independent of the leader
+	// election)
+	if webhooks := g.Config.Server.Webhooks; webhooks != nil {
+		go server.ServeWebhooks(g.K8sGardenClient, webhooks.BindAddress, webhooks.Port, webhooks.TLSCertFile, webhooks.TLSKeyFile, shootHandlers, stopCh)
+	} else {
+		g.Logger.Warn("The webhook server is not configured (server.webhooks), hence, the Shoot endpoints (reconcile and machine plans, secret versions) are not served.")
+	}
+
+	// A replica which has been restarted in the same pod may still carry the label of a former leader.
+	g.labelLeader(false)
+
 	// If leader election is enabled, run via LeaderElector until done and exit.
 	if g.LeaderElection != nil {
 		g.LeaderElection.Callbacks = leaderelection.LeaderCallbacks{
@@ -326,7 +390,27 @@ func (g *Gardener) Run(stopCh chan struct{}) error {
 	panic("unreachable")
 }
 
-func startControllers(g *Gardener, stopCh <-chan struct{}) {
+// labelLeader sets the GardenerLeader label of the pod of this replica to <leader>, so that the service for the Shoot
+// endpoints of the webhook server only selects the pod of the replica which holds the leader election. Failures are
+// only logged, e.g. if the Gardener does not run in a pod.
+func (g *Gardener) labelLeader(leader bool) {
+	podName, err := os.Hostname()
+	if err != nil {
+		g.Logger.Errorf("Could not determine the name of the pod of this replica: %v", err)
+		return
+	}
+
+	patch := fmt.Sprintf(`{"metadata":{"labels":{%q:"%t"}}}`, common.GardenerLeader, leader)
+	if _, err := g.K8sGardenClient.Clientset().CoreV1().Pods(g.GardenerNamespace).Patch(podName, types.MergePatchType, []byte(patch)); err != nil {
+		if apierrors.IsNotFound(err) {
+			g.Logger.Debugf("Not labelling the pod of this replica as it does not run in pod %s/%s", g.GardenerNamespace, podName)
+			return
+		}
+		g.Logger.Errorf("Could not label the pod %s/%s of this replica with %s=%t: %v", g.GardenerNamespace, podName, common.GardenerLeader, leader, err)
+	}
+}
+
+func startControllers(g *Gardener, shootHandlers *http.ServeMux, stopCh <-chan struct{}) {
 	gardenInformerFactory := gardeninformers.NewSharedInformerFactory(g.K8sGardenClient.GardenClientset(), 0)
 	kubeInformerFactory := kubeinformers.NewSharedInformerFactory(g.K8sGardenClient.Clientset(), 30*time.Second)
 
@@ -338,6 +422,8 @@ func startControllers(g *Gardener, stopCh <-chan struct{}) {
 		g.Identity,
 		g.GardenerNamespace,
 		g.Recorder,
+		g.ShardFilter,
+		shootHandlers,
 	).Run(stopCh)
 }
 
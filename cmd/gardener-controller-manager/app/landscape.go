@@ -0,0 +1,75 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenclientset "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
+	"github.com/gardener/gardener/pkg/landscape"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// validateLandscape loads all CloudProfiles, Seeds and Shoots from the Garden cluster and validates them against each
+// other without reconciling anything. All violations are printed to stdout and the process exits with a non-zero
+// exit code if there are any.
+func validateLandscape(config *componentconfig.ControllerManagerConfiguration) error {
+	if config == nil {
+		return errors.New("config is required")
+	}
+	componentconfig.ApplyEnvironmentToConfig(config)
+
+	gardenerClientConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: config.GardenerClientConnection.KubeConfigFile},
+		&clientcmd.ConfigOverrides{},
+	).ClientConfig()
+	if err != nil {
+		return err
+	}
+	gardenerClientset, err := gardenclientset.NewForConfig(gardenerClientConfig)
+	if err != nil {
+		return err
+	}
+
+	cloudProfiles, err := gardenerClientset.GardenV1beta1().CloudProfiles().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	seeds, err := gardenerClientset.GardenV1beta1().Seeds().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	shoots, err := gardenerClientset.GardenV1beta1().Shoots(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	violations := landscape.Validate(cloudProfiles.Items, seeds.Items, shoots.Items)
+	if len(violations) == 0 {
+		fmt.Printf("Landscape configuration is valid (%d CloudProfiles, %d Seeds, %d Shoots).\n", len(cloudProfiles.Items), len(seeds.Items), len(shoots.Items))
+		return nil
+	}
+
+	for _, violation := range violations {
+		fmt.Println(violation)
+	}
+	fmt.Printf("Landscape configuration is invalid: found %d violation(s).\n", len(violations))
+	os.Exit(1)
+	return nil
+}
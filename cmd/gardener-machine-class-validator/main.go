@@ -0,0 +1,46 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"net/http"
+
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/webhook/machineclass"
+)
+
+var (
+	bindAddress = flag.String("bind-address", ":9443", "The address the webhook server listens on")
+	certFile    = flag.String("tls-cert-file", "/etc/machine-class-validator/tls/tls.crt", "The path to the TLS certificate of the webhook server")
+	keyFile     = flag.String("tls-private-key-file", "/etc/machine-class-validator/tls/tls.key", "The path to the TLS private key of the webhook server")
+	logLevel    = flag.String("log-level", "info", "The log level (one of debug, info, error)")
+)
+
+func main() {
+	flag.Parse()
+	log := logger.NewLogger(*logLevel)
+
+	mux := http.NewServeMux()
+	mux.Handle("/validate", machineclass.NewHandler(log))
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	log.Infof("Machine class validator is listening on %s", *bindAddress)
+	if err := http.ListenAndServeTLS(*bindAddress, *certFile, *keyFile, mux); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
@@ -46,6 +46,7 @@ spec:<% caBundle=value("spec.caBundle", "") %>
 #   ...
 #   -----END CERTIFICATE-----
   % endif
+# seedSelectionStrategy: MinimalDistance # SameRegion, MinimalDistance or Manual, overrides the global strategy
   % if cloud == "aws":
   aws:
     constraints:
@@ -73,8 +74,14 @@ spec:<% caBundle=value("spec.caBundle", "") %>
         regions:
         - name: eu-west-1
           ami: ami-32d1474b
+        # warmAMI: ami-0fedcba9876543210 # optional, pre-warmed variant with pre-pulled container images
         - name: us-east-1
           ami: ami-e582d29f
+      # - name: CoreOS
+      #   architecture: arm64 # amd64 or arm64, defaults to amd64
+      #   regions:
+      #   - name: us-east-1
+      #     ami: ami-0123456789abcdef0
       % endif
       machineTypes:<% machineTypes=value("spec.aws.constraints.machineTypes", []) %>
       % if machineTypes != []:
@@ -116,6 +123,11 @@ spec:<% caBundle=value("spec.caBundle", "") %>
         cpu: "64"
         gpu: "16"
         memory: 732Gi
+      # - name: a1.large
+      #   cpu: "2"
+      #   gpu: "0"
+      #   memory: 4Gi
+      #   architecture: arm64 # amd64 or arm64, defaults to amd64
       % endif
       volumeTypes:<% volumeTypes=value("spec.aws.constraints.volumeTypes", []) %>
       % if volumeTypes != []:
@@ -261,6 +273,7 @@ spec:<% caBundle=value("spec.caBundle", "") %>
       % else:
       - name: CoreOS
         image: projects/coreos-cloud/global/images/coreos-stable-1576-5-0-v20180105
+        # warmImage: projects/my-project/global/images/coreos-stable-1576-5-0-warm # optional, pre-warmed variant with pre-pulled container images
       % endif
       machineTypes:<% machineTypes=value("spec.gcp.constraints.machineTypes", []) %>
       % if machineTypes != []:
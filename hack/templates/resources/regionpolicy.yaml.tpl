@@ -0,0 +1,36 @@
+<%
+  import os, yaml
+
+  values={}
+  if context.get("values", "") != "":
+    values=yaml.load(open(context.get("values", "")))
+
+  def value(path, default):
+    keys=str.split(path, ".")
+    root=values
+    for key in keys:
+      if isinstance(root, dict):
+        if key in root:
+          root=root[key]
+        else:
+          return default
+      else:
+        return default
+    return root
+%># RegionPolicy object restricting the regions of a cloud profile which the projects selected by it may use for their Shoot clusters.
+---
+apiVersion: garden.sapcloud.io/v1beta1
+kind: RegionPolicy
+metadata:
+  name: ${value("metadata.name", "aws-eu")}
+spec:
+  cloudProfileName: ${value("spec.cloudProfileName", "aws")}
+  projectSelector: # optional, all projects are selected if omitted
+    matchLabels:<% matchLabels=value("spec.projectSelector.matchLabels", {"data-residency": "eu"}) %>
+      % for key, val in matchLabels.items():
+      ${key}: "${val}"
+      % endfor
+  regions:<% regions=value("spec.regions", ["eu-central-1", "eu-west-1"]) %>
+  % for region in regions:
+  - ${region}
+  % endfor
@@ -38,6 +38,10 @@ apiVersion: garden.sapcloud.io/v1beta1
 kind: Seed
 metadata:
   name: ${value("metadata.name", cloud)}
+% if cloud != "local":
+#  annotations:
+#    seed.garden.sapcloud.io/machine-class-validation: "true" # optional, deploys a webhook rejecting invalid machine classes
+% endif
 spec:
   cloud:
     profile: ${value("spec.cloud.profile", cloud)}
@@ -50,3 +54,6 @@ spec:
     nodes: ${value("spec.networks.nodes", "10.240.0.0/16") if cloud != "local" else "192.168.99.100/24"}
     pods: ${value("spec.networks.pods", "10.241.128.0/17") if cloud != "local" else "172.17.0.0/16"}
     services: ${value("spec.networks.services", "10.241.0.0/17") if cloud != "local" else "10.96.0.0/13"}
+#  shootKubernetesVersions: # optional, restricts the Kubernetes versions of Shoots hosted on this Seed
+#    min: 1.9.0
+#    max: "1.10" # all patch versions of 1.10 are allowed
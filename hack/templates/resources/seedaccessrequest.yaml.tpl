@@ -0,0 +1,30 @@
+<%
+  import os, yaml
+
+  values={}
+  if context.get("values", "") != "":
+    values=yaml.load(open(context.get("values", "")))
+
+  def value(path, default):
+    keys=str.split(path, ".")
+    root=values
+    for key in keys:
+      if isinstance(root, dict):
+        if key in root:
+          root=root[key]
+        else:
+          return default
+      else:
+        return default
+    return root
+%># SeedAccessRequest object granting temporary access to the namespace of a Shoot cluster in its Seed cluster.
+---
+apiVersion: garden.sapcloud.io/v1beta1
+kind: SeedAccessRequest
+metadata:
+  name: ${value("metadata.name", "johndoe-debug")}
+  namespace: ${value("metadata.namespace", "garden-dev")}
+spec:
+  shootName: ${value("spec.shootName", "johndoe-aws")}
+  reason: ${value("spec.reason", "Investigate crash-looping kube-apiserver")}
+  duration: ${value("spec.duration", "1h")}
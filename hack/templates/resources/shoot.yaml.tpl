@@ -74,6 +74,20 @@ spec:
         volumeSize: 20Gi
         autoScalerMin: 2
         autoScalerMax: 2
+        # machineHealthTimeout: 10m
+        # maxUnhealthy: 30%
+        # maxSurge: 25% # rolling update settings of each machine deployment, defaults: maxSurge 1, maxUnavailable 1, minReadySeconds 500
+        # maxUnavailable: 0
+        # minReadySeconds: 300
+        # labels: {cost-center: "1234"}
+        # annotations: {example.com/owner: team-a}
+        # cordoned: true # no new machines are created for this worker group
+        # architecture: amd64 # amd64 or arm64, the cloud profile must offer the machine type and the machine image for it
+        # warmUp: true # boots from the pre-warmed variant of the machine image, the cloud profile must offer it
+        # spot: # runs the machines on spot instances, which are drained when AWS reclaims them
+        #   maxPrice: "0.05" # maximum hourly price in US dollars, defaults to the on-demand price
+        #   fallbackToOnDemand: true # uses on-demand instances while there is no spare capacity
+        #   taint: true # only pods tolerating the worker.garden.sapcloud.io/spot taint are scheduled onto the nodes
       % endif
       zones: ${value("spec.cloud.aws.zones", ["eu-west-1a"])}
     % endif
@@ -106,6 +120,15 @@ spec:
         volumeSize: 35Gi # must be at least 35Gi for Azure VMs
         autoScalerMin: 2
         autoScalerMax: 2
+        # machineHealthTimeout: 10m
+        # maxUnhealthy: 30%
+        # maxSurge: 25% # rolling update settings of each machine deployment, defaults: maxSurge 1, maxUnavailable 1, minReadySeconds 500
+        # maxUnavailable: 0
+        # minReadySeconds: 300
+        # labels: {cost-center: "1234"}
+        # annotations: {example.com/owner: team-a}
+        # cordoned: true # no new machines are created for this worker group
+        # architecture: amd64 # amd64 or arm64, the cloud profile must offer the machine type and the machine image for it
       % endif
     % endif
     % if cloud == "gcp":
@@ -129,6 +152,20 @@ spec:
         volumeSize: 20Gi
         autoScalerMin: 2
         autoScalerMax: 2
+        # machineHealthTimeout: 10m
+        # maxUnhealthy: 30%
+        # maxSurge: 25% # rolling update settings of each machine deployment, defaults: maxSurge 1, maxUnavailable 1, minReadySeconds 500
+        # maxUnavailable: 0
+        # minReadySeconds: 300
+        # labels: {cost-center: "1234"}
+        # annotations: {example.com/owner: team-a}
+        # cordoned: true # no new machines are created for this worker group
+        # architecture: amd64 # amd64 or arm64, the cloud profile must offer the machine type and the machine image for it
+        # warmUp: true # boots from the pre-warmed variant of the machine image, the cloud profile must offer it
+        # spot: # runs the machines on preemptible VMs, which are drained when GCP reclaims them
+        #   fallbackToOnDemand: true # uses regular VMs while there is no spare capacity
+        #   taint: true # only pods tolerating the worker.garden.sapcloud.io/spot taint are scheduled onto the nodes
+        # preemptible: true # uses preemptible VMs
       % endif
       zones: ${value("spec.cloud.gcp.zones", ["europe-west1-b"])}
     % endif
@@ -153,6 +190,15 @@ spec:
         machineType: medium_2_4
         autoScalerMin: 2
         autoScalerMax: 2
+        # machineHealthTimeout: 10m
+        # maxUnhealthy: 30%
+        # maxSurge: 25% # rolling update settings of each machine deployment, defaults: maxSurge 1, maxUnavailable 1, minReadySeconds 500
+        # maxUnavailable: 0
+        # minReadySeconds: 300
+        # labels: {cost-center: "1234"}
+        # annotations: {example.com/owner: team-a}
+        # cordoned: true # no new machines are created for this worker group
+        # architecture: amd64 # amd64 or arm64, the cloud profile must offer the machine type and the machine image for it
       % endif
       zones: ${value("spec.cloud.openstack.zones", ["europe-1a"])}
     % endif
@@ -161,9 +207,40 @@ spec:
       endpoint: ${value("spec.cloud.local.endpoint", "localhost:3777")} # endpoint service pointing to gardener-local-provider
       networks:
         workers: ${value("spec.cloud.local.networks.workers", ["192.168.99.100/24"])}
+      # workers: # worker groups whose machines are simulated by a fake machine-controller-manager instead of the local provider
+      # - name: cpu-worker
+      #   machineType: small
+      #   autoScalerMin: 2
+      #   autoScalerMax: 2
     % endif
   kubernetes:
     version: ${value("spec.kubernetes.version", kubernetesVersion)}
+    # kubeAPIServer:
+    #   requests:
+    #     maxNonMutatingInflight: 800
+    #     maxMutatingInflight: 400
+    #   watchCacheSizes:
+    #     default: 250
+    #     resources: {pods: 1000}
+    #   eventTTL: 2h
+    % if cloud != "aws" and cloud != "local":
+    #   loadBalancerIP: 1.2.3.4 # static IP reserved in the cloud provider account of the Seed
+    % endif
+    % if cloud == "aws":
+    #   serviceAnnotations: {service.beta.kubernetes.io/aws-load-balancer-type: nlb} # load balancer flavor, only annotations of the cloud provider of the Seed
+    % endif
+    % if cloud == "azure":
+    #   serviceAnnotations: {service.beta.kubernetes.io/azure-load-balancer-internal: "true"} # load balancer flavor, only annotations of the cloud provider of the Seed
+    % endif
+    % if cloud == "gcp":
+    #   serviceAnnotations: {cloud.google.com/load-balancer-type: Internal} # load balancer flavor, only annotations of the cloud provider of the Seed
+    % endif
+    % if cloud == "openstack":
+    #   serviceAnnotations: {service.beta.kubernetes.io/openstack-internal-load-balancer: "true"} # load balancer flavor, only annotations of the cloud provider of the Seed
+    % endif
+# dependsOn: # Shoots which must have been reconciled successfully before this Shoot gets reconciled
+# - namespace: garden
+#   name: my-shooted-seed
   dns:
     provider: ${value("spec.dns.provider", "aws-route53") if cloud != "local" else "unmanaged"}
     domain: ${value("spec.dns.domain", value("metadata.name", "johndoe-" + cloud) + "." + value("metadata.namespace", "garden-dev") + ".example.com") if cloud != "local" else "<minikube-ip>.nip.io"}
@@ -222,3 +299,6 @@ spec:
       email: ${value("spec.addons.kube-lego.email", "john.doe@example.com")}
     monocular:
       enabled: ${value("spec.addons.monocular.enabled", "false")}
+    network-policies:
+      enabled: ${value("spec.addons.network-policies.enabled", "false")}
+      # excludedNamespaces: [my-namespace]
@@ -0,0 +1,34 @@
+<%
+  import os, yaml
+
+  values={}
+  if context.get("values", "") != "":
+    values=yaml.load(open(context.get("values", "")))
+
+  def value(path, default):
+    keys=str.split(path, ".")
+    root=values
+    for key in keys:
+      if isinstance(root, dict):
+        if key in root:
+          root=root[key]
+        else:
+          return default
+      else:
+        return default
+    return root
+%># ShootOperation object triggering an operation for all Shoot clusters in its namespace which match a label selector.
+---
+apiVersion: garden.sapcloud.io/v1beta1
+kind: ShootOperation
+metadata:
+  name: ${value("metadata.name", "reconcile-cost-center-1234")}
+  namespace: ${value("metadata.namespace", "garden-dev")}
+spec:
+  operation: ${value("spec.operation", "reconcile")} # one of reconcile, retry
+  selector:
+    matchLabels:<% matchLabels=value("spec.selector.matchLabels", {"cost-center": "1234"}) %>
+      % for key, val in matchLabels.items():
+      ${key}: "${val}"
+      % endfor
+  maxConcurrency: ${value("spec.maxConcurrency", 5)}
@@ -15,6 +15,7 @@
 package componentconfig
 
 import (
+	"fmt"
 	"os"
 )
 
@@ -35,3 +36,44 @@ func ApplyEnvironmentToConfig(config *ControllerManagerConfiguration) {
 		config.LeaderElection.LockObjectNamespace = watchNamespace
 	}
 }
+
+// ValidateShootExtensions checks that the given <extensions> have a type and a known extension point, and that every
+// type is registered only once.
+func ValidateShootExtensions(extensions []ShootExtension) error {
+	types := map[string]bool{}
+	for _, extension := range extensions {
+		if len(extension.Type) == 0 {
+			return fmt.Errorf("the type of a Shoot extension must not be empty")
+		}
+		if types[extension.Type] {
+			return fmt.Errorf("the Shoot extension %q is registered more than once", extension.Type)
+		}
+		types[extension.Type] = true
+
+		switch extension.Point {
+		case ShootExtensionPointAfterInfrastructure, ShootExtensionPointBeforeMachines, ShootExtensionPointAfterAddons:
+		default:
+			return fmt.Errorf("the Shoot extension %q has the unknown point %q (must be %s, %s or %s)", extension.Type, extension.Point, ShootExtensionPointAfterInfrastructure, ShootExtensionPointBeforeMachines, ShootExtensionPointAfterAddons)
+		}
+	}
+	return nil
+}
+
+// ValidateCloudAPIs checks that the given <cloudAPIs> only configure known providers and that their rate limits and
+// circuit breakers let calls through.
+func ValidateCloudAPIs(cloudAPIs map[string]CloudAPIConfiguration) error {
+	for provider, cloudAPI := range cloudAPIs {
+		switch provider {
+		case "aws", "azure", "gcp", "openstack":
+		default:
+			return fmt.Errorf("the cloud API of the unknown provider %q is configured (must be aws, azure, gcp or openstack)", provider)
+		}
+		if cloudAPI.QPS <= 0 || cloudAPI.Burst <= 0 {
+			return fmt.Errorf("the qps and the burst of the %s cloud API must be positive", provider)
+		}
+		if cloudAPI.FailureThreshold <= 0 || cloudAPI.OpenDuration.Duration < 0 {
+			return fmt.Errorf("the failure threshold of the %s cloud API must be positive and its open duration must not be negative", provider)
+		}
+	}
+	return nil
+}
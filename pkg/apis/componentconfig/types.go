@@ -15,6 +15,7 @@
 package componentconfig
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -26,6 +27,10 @@ type ControllerManagerConfiguration struct {
 	// ClientConnection specifies the kubeconfig file and client connection
 	// settings for the proxy server to use when communicating with the gardener-apiserver.
 	ClientConnection ClientConnectionConfiguration
+	// CloudAPIs configures the rate limits and circuit breakers of the calls to the cloud provider APIs by provider
+	// (aws, azure, gcp, openstack). Providers which are not listed use the default settings.
+	// +optional
+	CloudAPIs map[string]CloudAPIConfiguration
 	// GardenerClientConnection specifies the kubeconfig file and client connection
 	// settings for the garden-apiserver.
 	// +optional
@@ -40,6 +45,24 @@ type ControllerManagerConfiguration struct {
 	Metrics MetricsConfiguration
 	// Server defines the configuration of the HTTP server.
 	Server ServerConfiguration
+	// Sharding defines how the Shoots and Seeds are distributed over multiple instances of the Gardener
+	// controller manager. If it is not set, this instance is responsible for all of them.
+	// +optional
+	Sharding *ShardingConfiguration
+}
+
+// CloudAPIConfiguration defines the rate limit and the circuit breaker of the calls to the cloud API of a provider.
+// They apply per set of credentials, i.e., all Shoots using the same cloud account share them.
+type CloudAPIConfiguration struct {
+	// QPS is the number of calls per second which may be sent to the cloud API.
+	QPS float32
+	// Burst is the number of calls which may be sent at once before QPS applies.
+	Burst int
+	// FailureThreshold is the number of consecutive failures (throttling, server errors, timeouts) after which the
+	// calls are suspended.
+	FailureThreshold int
+	// OpenDuration is the duration for which the calls are suspended before a single trial call is let through.
+	OpenDuration metav1.Duration
 }
 
 // ClientConnectionConfiguration contains details for constructing a client.
@@ -73,16 +96,29 @@ type ControllerManagerControllerConfiguration struct {
 	// Seed defines the configuration of the Seed controller.
 	// +optional
 	Seed *SeedControllerConfiguration
+	// SeedAccessRequest defines the configuration of the SeedAccessRequest controller.
+	// +optional
+	SeedAccessRequest *SeedAccessRequestControllerConfiguration
 	// Shoot defines the configuration of the Shoot controller.
 	Shoot ShootControllerConfiguration
 	// ShootCare defines the configuration of the ShootCare controller.
 	ShootCare ShootCareControllerConfiguration
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration
+	// ShootOperation defines the configuration of the ShootOperation controller.
+	// +optional
+	ShootOperation *ShootOperationControllerConfiguration
 	// ShootQuota defines the configuration of the ShootQuota controller.
 	ShootQuota ShootQuotaControllerConfiguration
+	// ShootRecoveryDrill defines the configuration of the ShootRecoveryDrill controller. The recovery drills are
+	// disabled if it is not set.
+	// +optional
+	ShootRecoveryDrill *ShootRecoveryDrillControllerConfiguration
 	// BackupInfrastructure defines the configuration of the BackupInfrastructure controller.
 	BackupInfrastructure BackupInfrastructureControllerConfiguration
+	// GarbageCollection defines the configuration of the GarbageCollection controller.
+	// +optional
+	GarbageCollection *GarbageCollectionControllerConfiguration
 }
 
 // CloudProfileControllerConfiguration defines the configuration of the CloudProfile
@@ -113,6 +149,24 @@ type SeedControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// KubeconfigValidationPeriod is the duration how often the kubeconfigs of the Seed
+	// clusters are validated.
+	KubeconfigValidationPeriod metav1.Duration
+	// SyncPeriod is the duration how often the Seed clusters are reconciled, i.e., how often the
+	// components which the Gardener deploys into them are re-applied.
+	SyncPeriod metav1.Duration
+	// DeployIngressController determines whether the Gardener deploys an nginx ingress controller for the
+	// ingress domain into the Seed clusters. It must only be enabled if the Seed clusters do not run their
+	// own ingress controller for the 'nginx' ingress class.
+	DeployIngressController bool
+}
+
+// SeedAccessRequestControllerConfiguration defines the configuration of the
+// SeedAccessRequest controller.
+type SeedAccessRequestControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
 }
 
 // ShootControllerConfiguration defines the configuration of the CloudProfile
@@ -121,6 +175,56 @@ type ShootControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// ControlPlaneResourceLimits are the resource limits of the namespaces of the control planes of the Shoots in the
+	// Seed clusters by the purpose of the Shoots (see the 'garden.sapcloud.io/purpose' annotation). The limits of a
+	// purpose are merged over the limits of the 'default' entry, which also apply to Shoots without a purpose or with
+	// a purpose which is not listed.
+	// +optional
+	ControlPlaneResourceLimits map[string]ControlPlaneResourceLimits
+	// EventFeedRetention is the duration for which the events of a Shoot (e.g., its reconciliations, maintenance
+	// operations, health transitions and machine replacements, as well as the warnings of its control plane) are kept
+	// in its event feed in the Garden cluster. Defaults to 168h, 0s disables the event feed.
+	// +optional
+	EventFeedRetention *metav1.Duration
+	// Extensions are the extensions which are reconciled at defined points of the flow of every Shoot, e.g. to
+	// register the Shoots in a CMDB or to allocate their networks from an IPAM.
+	// +optional
+	Extensions []ShootExtension
+	// MachineWaitTimeout is the maximum duration the Gardener waits until the machines of a Shoot have been
+	// rolled out or deleted by the machine-controller-manager. Defaults to 30m.
+	// +optional
+	MachineWaitTimeout *metav1.Duration
+	// MachineWaitPollInterval is the initial interval in which the machine resources of a Shoot are
+	// re-checked while they do not change. The interval is backed off exponentially up to 1m. Defaults
+	// to 5s.
+	// +optional
+	MachineWaitPollInterval *metav1.Duration
+	// MachineDeploymentProgressTimeout is the maximum duration a machine deployment of a Shoot may not
+	// make any progress before its rollout fails. Defaults to 0s, which disables the check.
+	// +optional
+	MachineDeploymentProgressTimeout *metav1.Duration
+	// NodeDrainTimeout is the maximum duration the nodes of a Shoot are drained (i.e., their pods are
+	// evicted with respect to PodDisruptionBudgets) before its machines are forcefully deleted. Defaults
+	// to 10m.
+	// +optional
+	NodeDrainTimeout *metav1.Duration
+	// MachineForceDeletionConcurrency is the maximum number of machines of a Shoot which are labeled for the forceful
+	// deletion concurrently. Defaults to 10.
+	// +optional
+	MachineForceDeletionConcurrency *int
+	// MachineCredentials configures an external secret backend which issues short-lived cloud provider credentials
+	// for the machine class secrets of the Shoots instead of copying the static credentials of their secret bindings.
+	// +optional
+	MachineCredentials *MachineCredentialsConfiguration
+	// OrphanedMachineGracePeriod is the minimum age of a machine whose instance does not exist at the cloud provider
+	// anymore before it is deleted (so that the machine-controller-manager creates a replacement). Defaults to 10m,
+	// 0s disables the deletion.
+	// +optional
+	OrphanedMachineGracePeriod *metav1.Duration
+	// OrphanedNodeGracePeriod is the minimum duration a node of a Shoot which does not belong to any machine must
+	// have been not ready before it is deleted. Defaults to 10m, 0s disables the deletion.
+	// +optional
+	OrphanedNodeGracePeriod *metav1.Duration
 	// RespectSyncPeriodOverwrite determines whether a sync period overwrite of a
 	// Shoot (via annotation) is respected or not. Defaults to false.
 	// +optional
@@ -132,6 +236,10 @@ type ShootControllerConfiguration struct {
 	// readded to the queue so that the operation can be retried. Defaults to 15s.
 	// +optional
 	RetrySyncPeriod *metav1.Duration
+	// SecretHistoryLimit is the number of previous versions of the generated secrets of a Shoot which are
+	// retained (encrypted) so that they can be rolled back. Defaults to 5, 0 disables the history.
+	// +optional
+	SecretHistoryLimit *int
 	// SyncPeriod is the duration how often the existing resources are reconciled.
 	SyncPeriod metav1.Duration
 	// WatchNamespace defines the namespace which should be watched by the controller.
@@ -139,6 +247,51 @@ type ShootControllerConfiguration struct {
 	WatchNamespace *string
 }
 
+// ShootExtensionPoint is a point in the flow of the Shoot reconciliation at which extensions are reconciled.
+type ShootExtensionPoint string
+
+const (
+	// ShootExtensionPointAfterInfrastructure is the point after the infrastructure of the Shoot has been deployed.
+	ShootExtensionPointAfterInfrastructure ShootExtensionPoint = "AfterInfrastructure"
+	// ShootExtensionPointBeforeMachines is the point before the machines of the Shoot are deployed.
+	ShootExtensionPointBeforeMachines ShootExtensionPoint = "BeforeMachines"
+	// ShootExtensionPointAfterAddons is the point after the addons of the Shoot have been deployed.
+	ShootExtensionPointAfterAddons ShootExtensionPoint = "AfterAddons"
+)
+
+// ControlPlaneResourceLimits are the resource limits of the namespace of the control plane of a Shoot in the Seed
+// cluster.
+type ControlPlaneResourceLimits struct {
+	// Quota are the hard limits of the ResourceQuota of the namespace, e.g. 'requests.cpu'. The quotas of 'pods' and
+	// 'persistentvolumeclaims' are computed from the components of the control plane, a value given here is added to them.
+	// +optional
+	Quota corev1.ResourceList
+	// QuotaPerNode is added to the Quota for every node of the Shoot, i.e., for the sum of the maximum numbers of
+	// machines of its worker groups, because the load on the control plane grows with the number of nodes.
+	// +optional
+	QuotaPerNode corev1.ResourceList
+	// DefaultRequest are the default requests of the containers in the namespace which do not specify any. There are
+	// no default limits as every component of the control plane specifies its own.
+	// +optional
+	DefaultRequest corev1.ResourceList
+	// Max are the maximum limits of a single container in the namespace.
+	// +optional
+	Max corev1.ResourceList
+}
+
+// ShootExtension registers an extension which is reconciled at a defined point of the flow of every Shoot.
+type ShootExtension struct {
+	// Type is the type of the extension, e.g. "cmdb". The Gardener reconciles an Extension resource of this type in
+	// the Shoot namespace of the Seed, which is handled by the controller of the extension.
+	Type string
+	// Point is the point in the flow of the Shoot reconciliation at which the extension is reconciled
+	// (AfterInfrastructure, BeforeMachines or AfterAddons).
+	Point ShootExtensionPoint
+	// Timeout is the maximum duration the Gardener waits until the extension has been reconciled. Defaults to 10m.
+	// +optional
+	Timeout *metav1.Duration
+}
+
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
 // controller.
 type ShootCareControllerConfiguration struct {
@@ -149,6 +302,21 @@ type ShootCareControllerConfiguration struct {
 	// often the health check of Shoot clusters is performed (only if no operation is
 	// already running on them).
 	SyncPeriod metav1.Duration
+	// GarbageCollectionRetention is the minimum age of completed Terraformer pods and jobs and superseded
+	// secrets before they are deleted by the garbage collection which is performed together with the health
+	// check. Orphaned PersistentVolumeClaims are deleted once they have been orphaned for this duration.
+	// Defaults to 24h.
+	// +optional
+	GarbageCollectionRetention *metav1.Duration
+	// KubernetesUpgradeRollbackThreshold is the duration after which a Kubernetes upgrade of a Shoot cluster is
+	// rolled back to the previous version if the Shoot has not become healthy. If not set, upgrades are never rolled
+	// back automatically.
+	// +optional
+	KubernetesUpgradeRollbackThreshold *metav1.Duration
+	// NetworkUtilizationThreshold is the utilization (in percent) of the pod, service or node network of a Shoot
+	// cluster above which its NetworkCapacitySufficient condition becomes false. Defaults to 80.
+	// +optional
+	NetworkUtilizationThreshold *int
 }
 
 // ShootMaintenanceControllerConfiguration defines the configuration of the
@@ -162,6 +330,14 @@ type ShootMaintenanceControllerConfiguration struct {
 	SyncPeriod metav1.Duration
 }
 
+// ShootOperationControllerConfiguration defines the configuration of the
+// ShootOperation controller.
+type ShootOperationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+}
+
 // ShootQuotaControllerConfiguration defines the configuration of the
 // ShootQuota controller.
 type ShootQuotaControllerConfiguration struct {
@@ -186,6 +362,38 @@ type BackupInfrastructureControllerConfiguration struct {
 	DeletionGracePeriodDays *int
 }
 
+// GarbageCollectionControllerConfiguration defines the configuration of the GarbageCollection
+// controller which keeps the Garden cluster small by deleting old events and completed operation data.
+type GarbageCollectionControllerConfiguration struct {
+	// SyncPeriod is the duration how often the garbage collection of the Garden cluster is performed.
+	SyncPeriod metav1.Duration
+	// EventRetention is the minimum age of the events of the Gardener resources (e.g., Shoots and Seeds) in the
+	// Garden cluster before they are deleted. Defaults to 24h, 0s disables the deletion.
+	// +optional
+	EventRetention *metav1.Duration
+	// ShootOperationRetention is the minimum duration since the completion of a succeeded or failed ShootOperation
+	// before it is deleted. Defaults to 168h, 0s disables the deletion.
+	// +optional
+	ShootOperationRetention *metav1.Duration
+	// SeedAccessRequestRetention is the minimum duration since the expiration of an expired or failed
+	// SeedAccessRequest before it is deleted. Defaults to 168h, 0s disables the deletion.
+	// +optional
+	SeedAccessRequestRetention *metav1.Duration
+}
+
+// ShootRecoveryDrillControllerConfiguration defines the configuration of the ShootRecoveryDrill
+// controller which periodically breaks components of the Shoots which opted in and verifies that they recover.
+type ShootRecoveryDrillControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs int
+	// SyncPeriod is the duration between two recovery drills of the same Shoot.
+	SyncPeriod metav1.Duration
+	// Timeout is the duration after which a single recovery drill is considered as failed if the Shoot has not
+	// recovered. Defaults to 15m.
+	// +optional
+	Timeout *metav1.Duration
+}
+
 // LeaderElectionConfiguration defines the configuration of leader election
 // clients for components that can run with leader election enabled.
 type LeaderElectionConfiguration struct {
@@ -230,6 +438,23 @@ type ServerConfiguration struct {
 	BindAddress string
 	// Port is the port on which to serve unsecured, unauthenticated access.
 	Port int
+	// Webhooks configures the HTTPS server for the validating webhooks of the Garden cluster which reject the deletion
+	// of project namespaces and cloud provider secrets while Shoots still use them. The server also serves the
+	// authenticated plan endpoints of the Shoots. The webhooks and the plans are only served if it is set.
+	// +optional
+	Webhooks *WebhookServerConfiguration
+}
+
+// WebhookServerConfiguration contains details for the HTTPS server for the validating webhooks.
+type WebhookServerConfiguration struct {
+	// BindAddress is the IP address on which to listen for the specified port.
+	BindAddress string
+	// Port is the port on which to serve the validating webhooks.
+	Port int
+	// TLSCertFile is the path to the TLS certificate of the server.
+	TLSCertFile string
+	// TLSKeyFile is the path to the TLS private key of the server.
+	TLSKeyFile string
 }
 
 const (
@@ -238,4 +463,47 @@ const (
 
 	// ControllerManagerDefaultLockObjectName is the default lock name for leader election.
 	ControllerManagerDefaultLockObjectName = "gardener-controller-manager-leader-election"
+
+	// ControlPlaneResourceLimitsDefault is the key of the control plane resource limits which apply to all Shoots
+	// unless they are overwritten for the purpose of a Shoot.
+	ControlPlaneResourceLimitsDefault = "default"
 )
+
+// MachineCredentialsConfiguration defines the external secret backend which issues the cloud provider credentials
+// for the machine class secrets of the Shoots.
+type MachineCredentialsConfiguration struct {
+	// Vault configures a HashiCorp Vault as secret backend.
+	// +optional
+	Vault *VaultMachineCredentialsConfiguration
+}
+
+// VaultMachineCredentialsConfiguration defines how the cloud provider credentials for the machine class secrets are
+// read from a HashiCorp Vault, e.g. from the AWS secrets engine.
+type VaultMachineCredentialsConfiguration struct {
+	// Address is the address of the Vault server, e.g. https://vault.example.com:8200.
+	Address string
+	// TokenFile is the path of the file containing the Vault token of the Gardener. It is read for every request, so
+	// that the token can be rotated without a restart.
+	TokenFile string
+	// Paths maps the cloud providers (aws, azure, gcp, openstack, packet) to the Vault paths from which their
+	// credentials are read, e.g. aws/creds/machines. Shoots of other cloud providers keep the static credentials.
+	Paths map[string]string
+	// DataKeys maps the keys of the data returned by Vault to the keys of the cloud provider secrets of the Shoots,
+	// e.g. access_key to accessKeyID. Keys which are not mapped are taken as they are.
+	// +optional
+	DataKeys map[string]string
+	// RenewBefore is the duration before the expiry of the credentials in which the Gardener issues new credentials.
+	// It must be longer than the sync period of the Shoots. Defaults to 2h.
+	// +optional
+	RenewBefore *metav1.Duration
+}
+
+// ShardingConfiguration defines how the Shoots and Seeds are distributed over multiple instances of the Gardener
+// controller manager. Every instance is responsible for one shard. Shoots and Seeds are assigned to a shard by the
+// hash of their name, unless they are explicitly assigned to a shard by a label.
+type ShardingConfiguration struct {
+	// Shards is the total number of shards (i.e., of controller manager instances).
+	Shards int
+	// Shard is the index of the shard this instance is responsible for, in the range [0, Shards).
+	Shard int
+}
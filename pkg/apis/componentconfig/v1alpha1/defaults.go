@@ -17,6 +17,8 @@ package v1alpha1
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
@@ -34,6 +36,14 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 	if obj.Server.Port == 0 {
 		obj.Server.Port = 2718
 	}
+	if obj.Server.Webhooks != nil {
+		if len(obj.Server.Webhooks.BindAddress) == 0 {
+			obj.Server.Webhooks.BindAddress = "0.0.0.0"
+		}
+		if obj.Server.Webhooks.Port == 0 {
+			obj.Server.Webhooks.Port = 2719
+		}
+	}
 
 	if len(obj.ClientConnection.ContentType) == 0 {
 		obj.ClientConnection.ContentType = "application/vnd.kubernetes.protobuf"
@@ -45,6 +55,22 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		obj.ClientConnection.Burst = 100
 	}
 
+	for provider, cloudAPI := range obj.CloudAPIs {
+		if cloudAPI.QPS == 0.0 {
+			cloudAPI.QPS = 10.0
+		}
+		if cloudAPI.Burst == 0 {
+			cloudAPI.Burst = 20
+		}
+		if cloudAPI.FailureThreshold == 0 {
+			cloudAPI.FailureThreshold = 5
+		}
+		if cloudAPI.OpenDuration.Duration == 0 {
+			cloudAPI.OpenDuration = metav1.Duration{Duration: 30 * time.Second}
+		}
+		obj.CloudAPIs[provider] = cloudAPI
+	}
+
 	if obj.GardenerClientConnection == nil {
 		obj.GardenerClientConnection = &obj.ClientConnection
 	} else {
@@ -92,15 +118,142 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 			ConcurrentSyncs: 5,
 		}
 	}
+	if obj.Controllers.Seed.KubeconfigValidationPeriod.Duration == 0 {
+		obj.Controllers.Seed.KubeconfigValidationPeriod = metav1.Duration{Duration: 5 * time.Minute}
+	}
+	if obj.Controllers.Seed.SyncPeriod.Duration == 0 {
+		obj.Controllers.Seed.SyncPeriod = metav1.Duration{Duration: time.Hour}
+	}
+	if obj.Controllers.SeedAccessRequest == nil {
+		obj.Controllers.SeedAccessRequest = &SeedAccessRequestControllerConfiguration{
+			ConcurrentSyncs: 5,
+		}
+	}
+	if obj.Controllers.ShootOperation == nil {
+		obj.Controllers.ShootOperation = &ShootOperationControllerConfiguration{
+			ConcurrentSyncs: 5,
+		}
+	}
 
+	if obj.Controllers.Shoot.EventFeedRetention == nil {
+		durationVar := metav1.Duration{Duration: 168 * time.Hour}
+		obj.Controllers.Shoot.EventFeedRetention = &durationVar
+	}
 	if obj.Controllers.Shoot.RespectSyncPeriodOverwrite == nil {
 		falseVar := false
 		obj.Controllers.Shoot.RespectSyncPeriodOverwrite = &falseVar
 	}
+	if obj.Controllers.Shoot.MachineWaitTimeout == nil {
+		durationVar := metav1.Duration{Duration: 30 * time.Minute}
+		obj.Controllers.Shoot.MachineWaitTimeout = &durationVar
+	}
+	if obj.Controllers.Shoot.MachineWaitPollInterval == nil {
+		durationVar := metav1.Duration{Duration: 5 * time.Second}
+		obj.Controllers.Shoot.MachineWaitPollInterval = &durationVar
+	}
+	if obj.Controllers.Shoot.MachineDeploymentProgressTimeout == nil {
+		durationVar := metav1.Duration{}
+		obj.Controllers.Shoot.MachineDeploymentProgressTimeout = &durationVar
+	}
+	if obj.Controllers.Shoot.NodeDrainTimeout == nil {
+		durationVar := metav1.Duration{Duration: 10 * time.Minute}
+		obj.Controllers.Shoot.NodeDrainTimeout = &durationVar
+	}
+	if obj.Controllers.Shoot.MachineForceDeletionConcurrency == nil {
+		var defaultMachineForceDeletionConcurrency = DefaultMachineForceDeletionConcurrency
+		obj.Controllers.Shoot.MachineForceDeletionConcurrency = &defaultMachineForceDeletionConcurrency
+	}
+	if obj.Controllers.Shoot.MachineCredentials != nil && obj.Controllers.Shoot.MachineCredentials.Vault != nil && obj.Controllers.Shoot.MachineCredentials.Vault.RenewBefore == nil {
+		durationVar := metav1.Duration{Duration: 2 * time.Hour}
+		obj.Controllers.Shoot.MachineCredentials.Vault.RenewBefore = &durationVar
+	}
+	if obj.Controllers.Shoot.OrphanedMachineGracePeriod == nil {
+		durationVar := metav1.Duration{Duration: 10 * time.Minute}
+		obj.Controllers.Shoot.OrphanedMachineGracePeriod = &durationVar
+	}
+	if obj.Controllers.Shoot.OrphanedNodeGracePeriod == nil {
+		durationVar := metav1.Duration{Duration: 10 * time.Minute}
+		obj.Controllers.Shoot.OrphanedNodeGracePeriod = &durationVar
+	}
 	if obj.Controllers.Shoot.RetrySyncPeriod == nil {
 		durationVar := metav1.Duration{Duration: 15 * time.Second}
 		obj.Controllers.Shoot.RetrySyncPeriod = &durationVar
 	}
+	if obj.Controllers.Shoot.SecretHistoryLimit == nil {
+		var defaultSecretHistoryLimit = DefaultSecretHistoryLimit
+		obj.Controllers.Shoot.SecretHistoryLimit = &defaultSecretHistoryLimit
+	}
+	if _, ok := obj.Controllers.Shoot.ControlPlaneResourceLimits[ControlPlaneResourceLimitsDefault]; !ok {
+		if obj.Controllers.Shoot.ControlPlaneResourceLimits == nil {
+			obj.Controllers.Shoot.ControlPlaneResourceLimits = map[string]ControlPlaneResourceLimits{}
+		}
+		obj.Controllers.Shoot.ControlPlaneResourceLimits[ControlPlaneResourceLimitsDefault] = ControlPlaneResourceLimits{
+			Quota: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("4000m"),
+				corev1.ResourceRequestsMemory: resource.MustParse("8192Mi"),
+			},
+			QuotaPerNode: corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("50m"),
+				corev1.ResourceRequestsMemory: resource.MustParse("128Mi"),
+			},
+			DefaultRequest: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("50m"),
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+			},
+			Max: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+		}
+	}
+	for i, extension := range obj.Controllers.Shoot.Extensions {
+		if extension.Timeout == nil {
+			durationVar := metav1.Duration{Duration: 10 * time.Minute}
+			obj.Controllers.Shoot.Extensions[i].Timeout = &durationVar
+		}
+	}
+
+	if obj.Controllers.ShootCare.GarbageCollectionRetention == nil {
+		durationVar := metav1.Duration{Duration: 24 * time.Hour}
+		obj.Controllers.ShootCare.GarbageCollectionRetention = &durationVar
+	}
+
+	if obj.Controllers.ShootCare.NetworkUtilizationThreshold == nil {
+		var defaultNetworkUtilizationThreshold = DefaultNetworkUtilizationThreshold
+		obj.Controllers.ShootCare.NetworkUtilizationThreshold = &defaultNetworkUtilizationThreshold
+	}
+
+	if obj.Controllers.GarbageCollection == nil {
+		obj.Controllers.GarbageCollection = &GarbageCollectionControllerConfiguration{}
+	}
+	if obj.Controllers.GarbageCollection.SyncPeriod.Duration == 0 {
+		obj.Controllers.GarbageCollection.SyncPeriod = metav1.Duration{Duration: time.Hour}
+	}
+	if obj.Controllers.GarbageCollection.EventRetention == nil {
+		durationVar := metav1.Duration{Duration: 24 * time.Hour}
+		obj.Controllers.GarbageCollection.EventRetention = &durationVar
+	}
+	if obj.Controllers.GarbageCollection.ShootOperationRetention == nil {
+		durationVar := metav1.Duration{Duration: 168 * time.Hour}
+		obj.Controllers.GarbageCollection.ShootOperationRetention = &durationVar
+	}
+	if obj.Controllers.GarbageCollection.SeedAccessRequestRetention == nil {
+		durationVar := metav1.Duration{Duration: 168 * time.Hour}
+		obj.Controllers.GarbageCollection.SeedAccessRequestRetention = &durationVar
+	}
+
+	if obj.Controllers.ShootRecoveryDrill != nil {
+		if obj.Controllers.ShootRecoveryDrill.ConcurrentSyncs == 0 {
+			obj.Controllers.ShootRecoveryDrill.ConcurrentSyncs = 1
+		}
+		if obj.Controllers.ShootRecoveryDrill.SyncPeriod.Duration == 0 {
+			obj.Controllers.ShootRecoveryDrill.SyncPeriod = metav1.Duration{Duration: 24 * time.Hour}
+		}
+		if obj.Controllers.ShootRecoveryDrill.Timeout == nil {
+			durationVar := metav1.Duration{Duration: 15 * time.Minute}
+			obj.Controllers.ShootRecoveryDrill.Timeout = &durationVar
+		}
+	}
 
 	if obj.Controllers.BackupInfrastructure.DeletionGracePeriodDays == nil || *obj.Controllers.BackupInfrastructure.DeletionGracePeriodDays < 0 {
 		var defaultBackupInfrastructureDeletionGracePeriodDays = DefaultBackupInfrastructureDeletionGracePeriodDays
@@ -24,6 +24,7 @@ import (
 	unsafe "unsafe"
 
 	componentconfig "github.com/gardener/gardener/pkg/apis/componentconfig"
+	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -41,32 +42,54 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_componentconfig_BackupInfrastructureControllerConfiguration_To_v1alpha1_BackupInfrastructureControllerConfiguration,
 		Convert_v1alpha1_ClientConnectionConfiguration_To_componentconfig_ClientConnectionConfiguration,
 		Convert_componentconfig_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration,
+		Convert_v1alpha1_CloudAPIConfiguration_To_componentconfig_CloudAPIConfiguration,
+		Convert_componentconfig_CloudAPIConfiguration_To_v1alpha1_CloudAPIConfiguration,
 		Convert_v1alpha1_CloudProfileControllerConfiguration_To_componentconfig_CloudProfileControllerConfiguration,
 		Convert_componentconfig_CloudProfileControllerConfiguration_To_v1alpha1_CloudProfileControllerConfiguration,
+		Convert_v1alpha1_ControlPlaneResourceLimits_To_componentconfig_ControlPlaneResourceLimits,
+		Convert_componentconfig_ControlPlaneResourceLimits_To_v1alpha1_ControlPlaneResourceLimits,
 		Convert_v1alpha1_ControllerManagerConfiguration_To_componentconfig_ControllerManagerConfiguration,
 		Convert_componentconfig_ControllerManagerConfiguration_To_v1alpha1_ControllerManagerConfiguration,
 		Convert_v1alpha1_ControllerManagerControllerConfiguration_To_componentconfig_ControllerManagerControllerConfiguration,
 		Convert_componentconfig_ControllerManagerControllerConfiguration_To_v1alpha1_ControllerManagerControllerConfiguration,
+		Convert_v1alpha1_GarbageCollectionControllerConfiguration_To_componentconfig_GarbageCollectionControllerConfiguration,
+		Convert_componentconfig_GarbageCollectionControllerConfiguration_To_v1alpha1_GarbageCollectionControllerConfiguration,
 		Convert_v1alpha1_LeaderElectionConfiguration_To_componentconfig_LeaderElectionConfiguration,
 		Convert_componentconfig_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration,
+		Convert_v1alpha1_MachineCredentialsConfiguration_To_componentconfig_MachineCredentialsConfiguration,
+		Convert_componentconfig_MachineCredentialsConfiguration_To_v1alpha1_MachineCredentialsConfiguration,
 		Convert_v1alpha1_MetricsConfiguration_To_componentconfig_MetricsConfiguration,
 		Convert_componentconfig_MetricsConfiguration_To_v1alpha1_MetricsConfiguration,
 		Convert_v1alpha1_QuotaControllerConfiguration_To_componentconfig_QuotaControllerConfiguration,
 		Convert_componentconfig_QuotaControllerConfiguration_To_v1alpha1_QuotaControllerConfiguration,
 		Convert_v1alpha1_SecretBindingControllerConfiguration_To_componentconfig_SecretBindingControllerConfiguration,
 		Convert_componentconfig_SecretBindingControllerConfiguration_To_v1alpha1_SecretBindingControllerConfiguration,
+		Convert_v1alpha1_SeedAccessRequestControllerConfiguration_To_componentconfig_SeedAccessRequestControllerConfiguration,
+		Convert_componentconfig_SeedAccessRequestControllerConfiguration_To_v1alpha1_SeedAccessRequestControllerConfiguration,
 		Convert_v1alpha1_SeedControllerConfiguration_To_componentconfig_SeedControllerConfiguration,
 		Convert_componentconfig_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration,
 		Convert_v1alpha1_ServerConfiguration_To_componentconfig_ServerConfiguration,
 		Convert_componentconfig_ServerConfiguration_To_v1alpha1_ServerConfiguration,
+		Convert_v1alpha1_ShardingConfiguration_To_componentconfig_ShardingConfiguration,
+		Convert_componentconfig_ShardingConfiguration_To_v1alpha1_ShardingConfiguration,
 		Convert_v1alpha1_ShootCareControllerConfiguration_To_componentconfig_ShootCareControllerConfiguration,
 		Convert_componentconfig_ShootCareControllerConfiguration_To_v1alpha1_ShootCareControllerConfiguration,
 		Convert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootControllerConfiguration,
 		Convert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration,
+		Convert_v1alpha1_ShootExtension_To_componentconfig_ShootExtension,
+		Convert_componentconfig_ShootExtension_To_v1alpha1_ShootExtension,
 		Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_componentconfig_ShootMaintenanceControllerConfiguration,
 		Convert_componentconfig_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration,
+		Convert_v1alpha1_ShootOperationControllerConfiguration_To_componentconfig_ShootOperationControllerConfiguration,
+		Convert_componentconfig_ShootOperationControllerConfiguration_To_v1alpha1_ShootOperationControllerConfiguration,
 		Convert_v1alpha1_ShootQuotaControllerConfiguration_To_componentconfig_ShootQuotaControllerConfiguration,
 		Convert_componentconfig_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaControllerConfiguration,
+		Convert_v1alpha1_ShootRecoveryDrillControllerConfiguration_To_componentconfig_ShootRecoveryDrillControllerConfiguration,
+		Convert_componentconfig_ShootRecoveryDrillControllerConfiguration_To_v1alpha1_ShootRecoveryDrillControllerConfiguration,
+		Convert_v1alpha1_VaultMachineCredentialsConfiguration_To_componentconfig_VaultMachineCredentialsConfiguration,
+		Convert_componentconfig_VaultMachineCredentialsConfiguration_To_v1alpha1_VaultMachineCredentialsConfiguration,
+		Convert_v1alpha1_WebhookServerConfiguration_To_componentconfig_WebhookServerConfiguration,
+		Convert_componentconfig_WebhookServerConfiguration_To_v1alpha1_WebhookServerConfiguration,
 	)
 }
 
@@ -122,6 +145,32 @@ func Convert_componentconfig_ClientConnectionConfiguration_To_v1alpha1_ClientCon
 	return autoConvert_componentconfig_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CloudAPIConfiguration_To_componentconfig_CloudAPIConfiguration(in *CloudAPIConfiguration, out *componentconfig.CloudAPIConfiguration, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.FailureThreshold = in.FailureThreshold
+	out.OpenDuration = in.OpenDuration
+	return nil
+}
+
+// Convert_v1alpha1_CloudAPIConfiguration_To_componentconfig_CloudAPIConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_CloudAPIConfiguration_To_componentconfig_CloudAPIConfiguration(in *CloudAPIConfiguration, out *componentconfig.CloudAPIConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CloudAPIConfiguration_To_componentconfig_CloudAPIConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_CloudAPIConfiguration_To_v1alpha1_CloudAPIConfiguration(in *componentconfig.CloudAPIConfiguration, out *CloudAPIConfiguration, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.FailureThreshold = in.FailureThreshold
+	out.OpenDuration = in.OpenDuration
+	return nil
+}
+
+// Convert_componentconfig_CloudAPIConfiguration_To_v1alpha1_CloudAPIConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_CloudAPIConfiguration_To_v1alpha1_CloudAPIConfiguration(in *componentconfig.CloudAPIConfiguration, out *CloudAPIConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_CloudAPIConfiguration_To_v1alpha1_CloudAPIConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudProfileControllerConfiguration_To_componentconfig_CloudProfileControllerConfiguration(in *CloudProfileControllerConfiguration, out *componentconfig.CloudProfileControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	return nil
@@ -142,10 +191,37 @@ func Convert_componentconfig_CloudProfileControllerConfiguration_To_v1alpha1_Clo
 	return autoConvert_componentconfig_CloudProfileControllerConfiguration_To_v1alpha1_CloudProfileControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControlPlaneResourceLimits_To_componentconfig_ControlPlaneResourceLimits(in *ControlPlaneResourceLimits, out *componentconfig.ControlPlaneResourceLimits, s conversion.Scope) error {
+	out.Quota = *(*core_v1.ResourceList)(unsafe.Pointer(&in.Quota))
+	out.QuotaPerNode = *(*core_v1.ResourceList)(unsafe.Pointer(&in.QuotaPerNode))
+	out.DefaultRequest = *(*core_v1.ResourceList)(unsafe.Pointer(&in.DefaultRequest))
+	out.Max = *(*core_v1.ResourceList)(unsafe.Pointer(&in.Max))
+	return nil
+}
+
+// Convert_v1alpha1_ControlPlaneResourceLimits_To_componentconfig_ControlPlaneResourceLimits is an autogenerated conversion function.
+func Convert_v1alpha1_ControlPlaneResourceLimits_To_componentconfig_ControlPlaneResourceLimits(in *ControlPlaneResourceLimits, out *componentconfig.ControlPlaneResourceLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControlPlaneResourceLimits_To_componentconfig_ControlPlaneResourceLimits(in, out, s)
+}
+
+func autoConvert_componentconfig_ControlPlaneResourceLimits_To_v1alpha1_ControlPlaneResourceLimits(in *componentconfig.ControlPlaneResourceLimits, out *ControlPlaneResourceLimits, s conversion.Scope) error {
+	out.Quota = *(*core_v1.ResourceList)(unsafe.Pointer(&in.Quota))
+	out.QuotaPerNode = *(*core_v1.ResourceList)(unsafe.Pointer(&in.QuotaPerNode))
+	out.DefaultRequest = *(*core_v1.ResourceList)(unsafe.Pointer(&in.DefaultRequest))
+	out.Max = *(*core_v1.ResourceList)(unsafe.Pointer(&in.Max))
+	return nil
+}
+
+// Convert_componentconfig_ControlPlaneResourceLimits_To_v1alpha1_ControlPlaneResourceLimits is an autogenerated conversion function.
+func Convert_componentconfig_ControlPlaneResourceLimits_To_v1alpha1_ControlPlaneResourceLimits(in *componentconfig.ControlPlaneResourceLimits, out *ControlPlaneResourceLimits, s conversion.Scope) error {
+	return autoConvert_componentconfig_ControlPlaneResourceLimits_To_v1alpha1_ControlPlaneResourceLimits(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerManagerConfiguration_To_componentconfig_ControllerManagerConfiguration(in *ControllerManagerConfiguration, out *componentconfig.ControllerManagerConfiguration, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ClientConnectionConfiguration_To_componentconfig_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
 	}
+	out.CloudAPIs = *(*map[string]componentconfig.CloudAPIConfiguration)(unsafe.Pointer(&in.CloudAPIs))
 	out.GardenerClientConnection = (*componentconfig.ClientConnectionConfiguration)(unsafe.Pointer(in.GardenerClientConnection))
 	if err := Convert_v1alpha1_ControllerManagerControllerConfiguration_To_componentconfig_ControllerManagerControllerConfiguration(&in.Controllers, &out.Controllers, s); err != nil {
 		return err
@@ -160,6 +236,7 @@ func autoConvert_v1alpha1_ControllerManagerConfiguration_To_componentconfig_Cont
 	if err := Convert_v1alpha1_ServerConfiguration_To_componentconfig_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
 	}
+	out.Sharding = (*componentconfig.ShardingConfiguration)(unsafe.Pointer(in.Sharding))
 	return nil
 }
 
@@ -172,6 +249,7 @@ func autoConvert_componentconfig_ControllerManagerConfiguration_To_v1alpha1_Cont
 	if err := Convert_componentconfig_ClientConnectionConfiguration_To_v1alpha1_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
 	}
+	out.CloudAPIs = *(*map[string]CloudAPIConfiguration)(unsafe.Pointer(&in.CloudAPIs))
 	out.GardenerClientConnection = (*ClientConnectionConfiguration)(unsafe.Pointer(in.GardenerClientConnection))
 	if err := Convert_componentconfig_ControllerManagerControllerConfiguration_To_v1alpha1_ControllerManagerControllerConfiguration(&in.Controllers, &out.Controllers, s); err != nil {
 		return err
@@ -186,6 +264,7 @@ func autoConvert_componentconfig_ControllerManagerConfiguration_To_v1alpha1_Cont
 	if err := Convert_componentconfig_ServerConfiguration_To_v1alpha1_ServerConfiguration(&in.Server, &out.Server, s); err != nil {
 		return err
 	}
+	out.Sharding = (*ShardingConfiguration)(unsafe.Pointer(in.Sharding))
 	return nil
 }
 
@@ -199,6 +278,7 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_componentc
 	out.SecretBinding = (*componentconfig.SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Quota = (*componentconfig.QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.Seed = (*componentconfig.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
+	out.SeedAccessRequest = (*componentconfig.SeedAccessRequestControllerConfiguration)(unsafe.Pointer(in.SeedAccessRequest))
 	if err := Convert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootControllerConfiguration(&in.Shoot, &out.Shoot, s); err != nil {
 		return err
 	}
@@ -208,12 +288,15 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_componentc
 	if err := Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_componentconfig_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
+	out.ShootOperation = (*componentconfig.ShootOperationControllerConfiguration)(unsafe.Pointer(in.ShootOperation))
 	if err := Convert_v1alpha1_ShootQuotaControllerConfiguration_To_componentconfig_ShootQuotaControllerConfiguration(&in.ShootQuota, &out.ShootQuota, s); err != nil {
 		return err
 	}
+	out.ShootRecoveryDrill = (*componentconfig.ShootRecoveryDrillControllerConfiguration)(unsafe.Pointer(in.ShootRecoveryDrill))
 	if err := Convert_v1alpha1_BackupInfrastructureControllerConfiguration_To_componentconfig_BackupInfrastructureControllerConfiguration(&in.BackupInfrastructure, &out.BackupInfrastructure, s); err != nil {
 		return err
 	}
+	out.GarbageCollection = (*componentconfig.GarbageCollectionControllerConfiguration)(unsafe.Pointer(in.GarbageCollection))
 	return nil
 }
 
@@ -227,6 +310,7 @@ func autoConvert_componentconfig_ControllerManagerControllerConfiguration_To_v1a
 	out.SecretBinding = (*SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Quota = (*QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
+	out.SeedAccessRequest = (*SeedAccessRequestControllerConfiguration)(unsafe.Pointer(in.SeedAccessRequest))
 	if err := Convert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(&in.Shoot, &out.Shoot, s); err != nil {
 		return err
 	}
@@ -236,12 +320,15 @@ func autoConvert_componentconfig_ControllerManagerControllerConfiguration_To_v1a
 	if err := Convert_componentconfig_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
+	out.ShootOperation = (*ShootOperationControllerConfiguration)(unsafe.Pointer(in.ShootOperation))
 	if err := Convert_componentconfig_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaControllerConfiguration(&in.ShootQuota, &out.ShootQuota, s); err != nil {
 		return err
 	}
+	out.ShootRecoveryDrill = (*ShootRecoveryDrillControllerConfiguration)(unsafe.Pointer(in.ShootRecoveryDrill))
 	if err := Convert_componentconfig_BackupInfrastructureControllerConfiguration_To_v1alpha1_BackupInfrastructureControllerConfiguration(&in.BackupInfrastructure, &out.BackupInfrastructure, s); err != nil {
 		return err
 	}
+	out.GarbageCollection = (*GarbageCollectionControllerConfiguration)(unsafe.Pointer(in.GarbageCollection))
 	return nil
 }
 
@@ -250,6 +337,32 @@ func Convert_componentconfig_ControllerManagerControllerConfiguration_To_v1alpha
 	return autoConvert_componentconfig_ControllerManagerControllerConfiguration_To_v1alpha1_ControllerManagerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_GarbageCollectionControllerConfiguration_To_componentconfig_GarbageCollectionControllerConfiguration(in *GarbageCollectionControllerConfiguration, out *componentconfig.GarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	out.EventRetention = (*v1.Duration)(unsafe.Pointer(in.EventRetention))
+	out.ShootOperationRetention = (*v1.Duration)(unsafe.Pointer(in.ShootOperationRetention))
+	out.SeedAccessRequestRetention = (*v1.Duration)(unsafe.Pointer(in.SeedAccessRequestRetention))
+	return nil
+}
+
+// Convert_v1alpha1_GarbageCollectionControllerConfiguration_To_componentconfig_GarbageCollectionControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_GarbageCollectionControllerConfiguration_To_componentconfig_GarbageCollectionControllerConfiguration(in *GarbageCollectionControllerConfiguration, out *componentconfig.GarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GarbageCollectionControllerConfiguration_To_componentconfig_GarbageCollectionControllerConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_GarbageCollectionControllerConfiguration_To_v1alpha1_GarbageCollectionControllerConfiguration(in *componentconfig.GarbageCollectionControllerConfiguration, out *GarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = in.SyncPeriod
+	out.EventRetention = (*v1.Duration)(unsafe.Pointer(in.EventRetention))
+	out.ShootOperationRetention = (*v1.Duration)(unsafe.Pointer(in.ShootOperationRetention))
+	out.SeedAccessRequestRetention = (*v1.Duration)(unsafe.Pointer(in.SeedAccessRequestRetention))
+	return nil
+}
+
+// Convert_componentconfig_GarbageCollectionControllerConfiguration_To_v1alpha1_GarbageCollectionControllerConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_GarbageCollectionControllerConfiguration_To_v1alpha1_GarbageCollectionControllerConfiguration(in *componentconfig.GarbageCollectionControllerConfiguration, out *GarbageCollectionControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_GarbageCollectionControllerConfiguration_To_v1alpha1_GarbageCollectionControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_LeaderElectionConfiguration_To_componentconfig_LeaderElectionConfiguration(in *LeaderElectionConfiguration, out *componentconfig.LeaderElectionConfiguration, s conversion.Scope) error {
 	out.LeaderElect = in.LeaderElect
 	out.LeaseDuration = in.LeaseDuration
@@ -282,6 +395,26 @@ func Convert_componentconfig_LeaderElectionConfiguration_To_v1alpha1_LeaderElect
 	return autoConvert_componentconfig_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineCredentialsConfiguration_To_componentconfig_MachineCredentialsConfiguration(in *MachineCredentialsConfiguration, out *componentconfig.MachineCredentialsConfiguration, s conversion.Scope) error {
+	out.Vault = (*componentconfig.VaultMachineCredentialsConfiguration)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_v1alpha1_MachineCredentialsConfiguration_To_componentconfig_MachineCredentialsConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_MachineCredentialsConfiguration_To_componentconfig_MachineCredentialsConfiguration(in *MachineCredentialsConfiguration, out *componentconfig.MachineCredentialsConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineCredentialsConfiguration_To_componentconfig_MachineCredentialsConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_MachineCredentialsConfiguration_To_v1alpha1_MachineCredentialsConfiguration(in *componentconfig.MachineCredentialsConfiguration, out *MachineCredentialsConfiguration, s conversion.Scope) error {
+	out.Vault = (*VaultMachineCredentialsConfiguration)(unsafe.Pointer(in.Vault))
+	return nil
+}
+
+// Convert_componentconfig_MachineCredentialsConfiguration_To_v1alpha1_MachineCredentialsConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_MachineCredentialsConfiguration_To_v1alpha1_MachineCredentialsConfiguration(in *componentconfig.MachineCredentialsConfiguration, out *MachineCredentialsConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_MachineCredentialsConfiguration_To_v1alpha1_MachineCredentialsConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_MetricsConfiguration_To_componentconfig_MetricsConfiguration(in *MetricsConfiguration, out *componentconfig.MetricsConfiguration, s conversion.Scope) error {
 	out.Interval = in.Interval
 	return nil
@@ -342,8 +475,31 @@ func Convert_componentconfig_SecretBindingControllerConfiguration_To_v1alpha1_Se
 	return autoConvert_componentconfig_SecretBindingControllerConfiguration_To_v1alpha1_SecretBindingControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedAccessRequestControllerConfiguration_To_componentconfig_SeedAccessRequestControllerConfiguration(in *SeedAccessRequestControllerConfiguration, out *componentconfig.SeedAccessRequestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	return nil
+}
+
+// Convert_v1alpha1_SeedAccessRequestControllerConfiguration_To_componentconfig_SeedAccessRequestControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedAccessRequestControllerConfiguration_To_componentconfig_SeedAccessRequestControllerConfiguration(in *SeedAccessRequestControllerConfiguration, out *componentconfig.SeedAccessRequestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedAccessRequestControllerConfiguration_To_componentconfig_SeedAccessRequestControllerConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_SeedAccessRequestControllerConfiguration_To_v1alpha1_SeedAccessRequestControllerConfiguration(in *componentconfig.SeedAccessRequestControllerConfiguration, out *SeedAccessRequestControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	return nil
+}
+
+// Convert_componentconfig_SeedAccessRequestControllerConfiguration_To_v1alpha1_SeedAccessRequestControllerConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_SeedAccessRequestControllerConfiguration_To_v1alpha1_SeedAccessRequestControllerConfiguration(in *componentconfig.SeedAccessRequestControllerConfiguration, out *SeedAccessRequestControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_SeedAccessRequestControllerConfiguration_To_v1alpha1_SeedAccessRequestControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedControllerConfiguration_To_componentconfig_SeedControllerConfiguration(in *SeedControllerConfiguration, out *componentconfig.SeedControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.KubeconfigValidationPeriod = in.KubeconfigValidationPeriod
+	out.SyncPeriod = in.SyncPeriod
+	out.DeployIngressController = in.DeployIngressController
 	return nil
 }
 
@@ -354,6 +510,9 @@ func Convert_v1alpha1_SeedControllerConfiguration_To_componentconfig_SeedControl
 
 func autoConvert_componentconfig_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in *componentconfig.SeedControllerConfiguration, out *SeedControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.KubeconfigValidationPeriod = in.KubeconfigValidationPeriod
+	out.SyncPeriod = in.SyncPeriod
+	out.DeployIngressController = in.DeployIngressController
 	return nil
 }
 
@@ -365,6 +524,7 @@ func Convert_componentconfig_SeedControllerConfiguration_To_v1alpha1_SeedControl
 func autoConvert_v1alpha1_ServerConfiguration_To_componentconfig_ServerConfiguration(in *ServerConfiguration, out *componentconfig.ServerConfiguration, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
+	out.Webhooks = (*componentconfig.WebhookServerConfiguration)(unsafe.Pointer(in.Webhooks))
 	return nil
 }
 
@@ -376,6 +536,7 @@ func Convert_v1alpha1_ServerConfiguration_To_componentconfig_ServerConfiguration
 func autoConvert_componentconfig_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *componentconfig.ServerConfiguration, out *ServerConfiguration, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
+	out.Webhooks = (*WebhookServerConfiguration)(unsafe.Pointer(in.Webhooks))
 	return nil
 }
 
@@ -384,9 +545,34 @@ func Convert_componentconfig_ServerConfiguration_To_v1alpha1_ServerConfiguration
 	return autoConvert_componentconfig_ServerConfiguration_To_v1alpha1_ServerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShardingConfiguration_To_componentconfig_ShardingConfiguration(in *ShardingConfiguration, out *componentconfig.ShardingConfiguration, s conversion.Scope) error {
+	out.Shards = in.Shards
+	out.Shard = in.Shard
+	return nil
+}
+
+// Convert_v1alpha1_ShardingConfiguration_To_componentconfig_ShardingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShardingConfiguration_To_componentconfig_ShardingConfiguration(in *ShardingConfiguration, out *componentconfig.ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShardingConfiguration_To_componentconfig_ShardingConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *componentconfig.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	out.Shards = in.Shards
+	out.Shard = in.Shard
+	return nil
+}
+
+// Convert_componentconfig_ShardingConfiguration_To_v1alpha1_ShardingConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in *componentconfig.ShardingConfiguration, out *ShardingConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_ShardingConfiguration_To_v1alpha1_ShardingConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_componentconfig_ShootCareControllerConfiguration(in *ShootCareControllerConfiguration, out *componentconfig.ShootCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
+	out.GarbageCollectionRetention = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionRetention))
+	out.KubernetesUpgradeRollbackThreshold = (*v1.Duration)(unsafe.Pointer(in.KubernetesUpgradeRollbackThreshold))
+	out.NetworkUtilizationThreshold = (*int)(unsafe.Pointer(in.NetworkUtilizationThreshold))
 	return nil
 }
 
@@ -398,6 +584,9 @@ func Convert_v1alpha1_ShootCareControllerConfiguration_To_componentconfig_ShootC
 func autoConvert_componentconfig_ShootCareControllerConfiguration_To_v1alpha1_ShootCareControllerConfiguration(in *componentconfig.ShootCareControllerConfiguration, out *ShootCareControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
+	out.GarbageCollectionRetention = (*v1.Duration)(unsafe.Pointer(in.GarbageCollectionRetention))
+	out.KubernetesUpgradeRollbackThreshold = (*v1.Duration)(unsafe.Pointer(in.KubernetesUpgradeRollbackThreshold))
+	out.NetworkUtilizationThreshold = (*int)(unsafe.Pointer(in.NetworkUtilizationThreshold))
 	return nil
 }
 
@@ -408,9 +597,21 @@ func Convert_componentconfig_ShootCareControllerConfiguration_To_v1alpha1_ShootC
 
 func autoConvert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootControllerConfiguration(in *ShootControllerConfiguration, out *componentconfig.ShootControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ControlPlaneResourceLimits = *(*map[string]componentconfig.ControlPlaneResourceLimits)(unsafe.Pointer(&in.ControlPlaneResourceLimits))
+	out.EventFeedRetention = (*v1.Duration)(unsafe.Pointer(in.EventFeedRetention))
+	out.Extensions = *(*[]componentconfig.ShootExtension)(unsafe.Pointer(&in.Extensions))
+	out.MachineWaitTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineWaitTimeout))
+	out.MachineWaitPollInterval = (*v1.Duration)(unsafe.Pointer(in.MachineWaitPollInterval))
+	out.MachineDeploymentProgressTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDeploymentProgressTimeout))
+	out.NodeDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.NodeDrainTimeout))
+	out.MachineForceDeletionConcurrency = (*int)(unsafe.Pointer(in.MachineForceDeletionConcurrency))
+	out.MachineCredentials = (*componentconfig.MachineCredentialsConfiguration)(unsafe.Pointer(in.MachineCredentials))
+	out.OrphanedMachineGracePeriod = (*v1.Duration)(unsafe.Pointer(in.OrphanedMachineGracePeriod))
+	out.OrphanedNodeGracePeriod = (*v1.Duration)(unsafe.Pointer(in.OrphanedNodeGracePeriod))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
 	out.RetryDuration = in.RetryDuration
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
+	out.SecretHistoryLimit = (*int)(unsafe.Pointer(in.SecretHistoryLimit))
 	out.SyncPeriod = in.SyncPeriod
 	out.WatchNamespace = (*string)(unsafe.Pointer(in.WatchNamespace))
 	return nil
@@ -423,9 +624,21 @@ func Convert_v1alpha1_ShootControllerConfiguration_To_componentconfig_ShootContr
 
 func autoConvert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(in *componentconfig.ShootControllerConfiguration, out *ShootControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ControlPlaneResourceLimits = *(*map[string]ControlPlaneResourceLimits)(unsafe.Pointer(&in.ControlPlaneResourceLimits))
+	out.EventFeedRetention = (*v1.Duration)(unsafe.Pointer(in.EventFeedRetention))
+	out.Extensions = *(*[]ShootExtension)(unsafe.Pointer(&in.Extensions))
+	out.MachineWaitTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineWaitTimeout))
+	out.MachineWaitPollInterval = (*v1.Duration)(unsafe.Pointer(in.MachineWaitPollInterval))
+	out.MachineDeploymentProgressTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineDeploymentProgressTimeout))
+	out.NodeDrainTimeout = (*v1.Duration)(unsafe.Pointer(in.NodeDrainTimeout))
+	out.MachineForceDeletionConcurrency = (*int)(unsafe.Pointer(in.MachineForceDeletionConcurrency))
+	out.MachineCredentials = (*MachineCredentialsConfiguration)(unsafe.Pointer(in.MachineCredentials))
+	out.OrphanedMachineGracePeriod = (*v1.Duration)(unsafe.Pointer(in.OrphanedMachineGracePeriod))
+	out.OrphanedNodeGracePeriod = (*v1.Duration)(unsafe.Pointer(in.OrphanedNodeGracePeriod))
 	out.RespectSyncPeriodOverwrite = (*bool)(unsafe.Pointer(in.RespectSyncPeriodOverwrite))
 	out.RetryDuration = in.RetryDuration
 	out.RetrySyncPeriod = (*v1.Duration)(unsafe.Pointer(in.RetrySyncPeriod))
+	out.SecretHistoryLimit = (*int)(unsafe.Pointer(in.SecretHistoryLimit))
 	out.SyncPeriod = in.SyncPeriod
 	out.WatchNamespace = (*string)(unsafe.Pointer(in.WatchNamespace))
 	return nil
@@ -436,6 +649,30 @@ func Convert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootContr
 	return autoConvert_componentconfig_ShootControllerConfiguration_To_v1alpha1_ShootControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootExtension_To_componentconfig_ShootExtension(in *ShootExtension, out *componentconfig.ShootExtension, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Point = componentconfig.ShootExtensionPoint(in.Point)
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_ShootExtension_To_componentconfig_ShootExtension is an autogenerated conversion function.
+func Convert_v1alpha1_ShootExtension_To_componentconfig_ShootExtension(in *ShootExtension, out *componentconfig.ShootExtension, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootExtension_To_componentconfig_ShootExtension(in, out, s)
+}
+
+func autoConvert_componentconfig_ShootExtension_To_v1alpha1_ShootExtension(in *componentconfig.ShootExtension, out *ShootExtension, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Point = ShootExtensionPoint(in.Point)
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_componentconfig_ShootExtension_To_v1alpha1_ShootExtension is an autogenerated conversion function.
+func Convert_componentconfig_ShootExtension_To_v1alpha1_ShootExtension(in *componentconfig.ShootExtension, out *ShootExtension, s conversion.Scope) error {
+	return autoConvert_componentconfig_ShootExtension_To_v1alpha1_ShootExtension(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_componentconfig_ShootMaintenanceControllerConfiguration(in *ShootMaintenanceControllerConfiguration, out *componentconfig.ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
@@ -458,6 +695,26 @@ func Convert_componentconfig_ShootMaintenanceControllerConfiguration_To_v1alpha1
 	return autoConvert_componentconfig_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootOperationControllerConfiguration_To_componentconfig_ShootOperationControllerConfiguration(in *ShootOperationControllerConfiguration, out *componentconfig.ShootOperationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	return nil
+}
+
+// Convert_v1alpha1_ShootOperationControllerConfiguration_To_componentconfig_ShootOperationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootOperationControllerConfiguration_To_componentconfig_ShootOperationControllerConfiguration(in *ShootOperationControllerConfiguration, out *componentconfig.ShootOperationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootOperationControllerConfiguration_To_componentconfig_ShootOperationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_ShootOperationControllerConfiguration_To_v1alpha1_ShootOperationControllerConfiguration(in *componentconfig.ShootOperationControllerConfiguration, out *ShootOperationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	return nil
+}
+
+// Convert_componentconfig_ShootOperationControllerConfiguration_To_v1alpha1_ShootOperationControllerConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_ShootOperationControllerConfiguration_To_v1alpha1_ShootOperationControllerConfiguration(in *componentconfig.ShootOperationControllerConfiguration, out *ShootOperationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_ShootOperationControllerConfiguration_To_v1alpha1_ShootOperationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootQuotaControllerConfiguration_To_componentconfig_ShootQuotaControllerConfiguration(in *ShootQuotaControllerConfiguration, out *componentconfig.ShootQuotaControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
@@ -479,3 +736,81 @@ func autoConvert_componentconfig_ShootQuotaControllerConfiguration_To_v1alpha1_S
 func Convert_componentconfig_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaControllerConfiguration(in *componentconfig.ShootQuotaControllerConfiguration, out *ShootQuotaControllerConfiguration, s conversion.Scope) error {
 	return autoConvert_componentconfig_ShootQuotaControllerConfiguration_To_v1alpha1_ShootQuotaControllerConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootRecoveryDrillControllerConfiguration_To_componentconfig_ShootRecoveryDrillControllerConfiguration(in *ShootRecoveryDrillControllerConfiguration, out *componentconfig.ShootRecoveryDrillControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_ShootRecoveryDrillControllerConfiguration_To_componentconfig_ShootRecoveryDrillControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootRecoveryDrillControllerConfiguration_To_componentconfig_ShootRecoveryDrillControllerConfiguration(in *ShootRecoveryDrillControllerConfiguration, out *componentconfig.ShootRecoveryDrillControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootRecoveryDrillControllerConfiguration_To_componentconfig_ShootRecoveryDrillControllerConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_ShootRecoveryDrillControllerConfiguration_To_v1alpha1_ShootRecoveryDrillControllerConfiguration(in *componentconfig.ShootRecoveryDrillControllerConfiguration, out *ShootRecoveryDrillControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.SyncPeriod = in.SyncPeriod
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_componentconfig_ShootRecoveryDrillControllerConfiguration_To_v1alpha1_ShootRecoveryDrillControllerConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_ShootRecoveryDrillControllerConfiguration_To_v1alpha1_ShootRecoveryDrillControllerConfiguration(in *componentconfig.ShootRecoveryDrillControllerConfiguration, out *ShootRecoveryDrillControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_ShootRecoveryDrillControllerConfiguration_To_v1alpha1_ShootRecoveryDrillControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_VaultMachineCredentialsConfiguration_To_componentconfig_VaultMachineCredentialsConfiguration(in *VaultMachineCredentialsConfiguration, out *componentconfig.VaultMachineCredentialsConfiguration, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TokenFile = in.TokenFile
+	out.Paths = *(*map[string]string)(unsafe.Pointer(&in.Paths))
+	out.DataKeys = *(*map[string]string)(unsafe.Pointer(&in.DataKeys))
+	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_v1alpha1_VaultMachineCredentialsConfiguration_To_componentconfig_VaultMachineCredentialsConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_VaultMachineCredentialsConfiguration_To_componentconfig_VaultMachineCredentialsConfiguration(in *VaultMachineCredentialsConfiguration, out *componentconfig.VaultMachineCredentialsConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VaultMachineCredentialsConfiguration_To_componentconfig_VaultMachineCredentialsConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_VaultMachineCredentialsConfiguration_To_v1alpha1_VaultMachineCredentialsConfiguration(in *componentconfig.VaultMachineCredentialsConfiguration, out *VaultMachineCredentialsConfiguration, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TokenFile = in.TokenFile
+	out.Paths = *(*map[string]string)(unsafe.Pointer(&in.Paths))
+	out.DataKeys = *(*map[string]string)(unsafe.Pointer(&in.DataKeys))
+	out.RenewBefore = (*v1.Duration)(unsafe.Pointer(in.RenewBefore))
+	return nil
+}
+
+// Convert_componentconfig_VaultMachineCredentialsConfiguration_To_v1alpha1_VaultMachineCredentialsConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_VaultMachineCredentialsConfiguration_To_v1alpha1_VaultMachineCredentialsConfiguration(in *componentconfig.VaultMachineCredentialsConfiguration, out *VaultMachineCredentialsConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_VaultMachineCredentialsConfiguration_To_v1alpha1_VaultMachineCredentialsConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_WebhookServerConfiguration_To_componentconfig_WebhookServerConfiguration(in *WebhookServerConfiguration, out *componentconfig.WebhookServerConfiguration, s conversion.Scope) error {
+	out.BindAddress = in.BindAddress
+	out.Port = in.Port
+	out.TLSCertFile = in.TLSCertFile
+	out.TLSKeyFile = in.TLSKeyFile
+	return nil
+}
+
+// Convert_v1alpha1_WebhookServerConfiguration_To_componentconfig_WebhookServerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_WebhookServerConfiguration_To_componentconfig_WebhookServerConfiguration(in *WebhookServerConfiguration, out *componentconfig.WebhookServerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WebhookServerConfiguration_To_componentconfig_WebhookServerConfiguration(in, out, s)
+}
+
+func autoConvert_componentconfig_WebhookServerConfiguration_To_v1alpha1_WebhookServerConfiguration(in *componentconfig.WebhookServerConfiguration, out *WebhookServerConfiguration, s conversion.Scope) error {
+	out.BindAddress = in.BindAddress
+	out.Port = in.Port
+	out.TLSCertFile = in.TLSCertFile
+	out.TLSKeyFile = in.TLSKeyFile
+	return nil
+}
+
+// Convert_componentconfig_WebhookServerConfiguration_To_v1alpha1_WebhookServerConfiguration is an autogenerated conversion function.
+func Convert_componentconfig_WebhookServerConfiguration_To_v1alpha1_WebhookServerConfiguration(in *componentconfig.WebhookServerConfiguration, out *WebhookServerConfiguration, s conversion.Scope) error {
+	return autoConvert_componentconfig_WebhookServerConfiguration_To_v1alpha1_WebhookServerConfiguration(in, out, s)
+}
@@ -21,6 +21,7 @@ limitations under the License.
 package componentconfig
 
 import (
+	core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -67,6 +68,23 @@ func (in *ClientConnectionConfiguration) DeepCopy() *ClientConnectionConfigurati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudAPIConfiguration) DeepCopyInto(out *CloudAPIConfiguration) {
+	*out = *in
+	out.OpenDuration = in.OpenDuration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudAPIConfiguration.
+func (in *CloudAPIConfiguration) DeepCopy() *CloudAPIConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudAPIConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfileControllerConfiguration) DeepCopyInto(out *CloudProfileControllerConfiguration) {
 	*out = *in
@@ -83,11 +101,62 @@ func (in *CloudProfileControllerConfiguration) DeepCopy() *CloudProfileControlle
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneResourceLimits) DeepCopyInto(out *ControlPlaneResourceLimits) {
+	*out = *in
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = make(core_v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.QuotaPerNode != nil {
+		in, out := &in.QuotaPerNode, &out.QuotaPerNode
+		*out = make(core_v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.DefaultRequest != nil {
+		in, out := &in.DefaultRequest, &out.DefaultRequest
+		*out = make(core_v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = make(core_v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneResourceLimits.
+func (in *ControlPlaneResourceLimits) DeepCopy() *ControlPlaneResourceLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneResourceLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ClientConnection = in.ClientConnection
+	if in.CloudAPIs != nil {
+		in, out := &in.CloudAPIs, &out.CloudAPIs
+		*out = make(map[string]CloudAPIConfiguration, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.GardenerClientConnection != nil {
 		in, out := &in.GardenerClientConnection, &out.GardenerClientConnection
 		if *in == nil {
@@ -100,7 +169,16 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 	in.Controllers.DeepCopyInto(&out.Controllers)
 	out.LeaderElection = in.LeaderElection
 	out.Metrics = in.Metrics
-	out.Server = in.Server
+	in.Server.DeepCopyInto(&out.Server)
+	if in.Sharding != nil {
+		in, out := &in.Sharding, &out.Sharding
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShardingConfiguration)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -161,11 +239,47 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 			**out = **in
 		}
 	}
+	if in.SeedAccessRequest != nil {
+		in, out := &in.SeedAccessRequest, &out.SeedAccessRequest
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SeedAccessRequestControllerConfiguration)
+			**out = **in
+		}
+	}
 	in.Shoot.DeepCopyInto(&out.Shoot)
-	out.ShootCare = in.ShootCare
+	in.ShootCare.DeepCopyInto(&out.ShootCare)
 	out.ShootMaintenance = in.ShootMaintenance
+	if in.ShootOperation != nil {
+		in, out := &in.ShootOperation, &out.ShootOperation
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootOperationControllerConfiguration)
+			**out = **in
+		}
+	}
 	out.ShootQuota = in.ShootQuota
+	if in.ShootRecoveryDrill != nil {
+		in, out := &in.ShootRecoveryDrill, &out.ShootRecoveryDrill
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootRecoveryDrillControllerConfiguration)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	in.BackupInfrastructure.DeepCopyInto(&out.BackupInfrastructure)
+	if in.GarbageCollection != nil {
+		in, out := &in.GarbageCollection, &out.GarbageCollection
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(GarbageCollectionControllerConfiguration)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -179,6 +293,50 @@ func (in *ControllerManagerControllerConfiguration) DeepCopy() *ControllerManage
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GarbageCollectionControllerConfiguration) DeepCopyInto(out *GarbageCollectionControllerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	if in.EventRetention != nil {
+		in, out := &in.EventRetention, &out.EventRetention
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.ShootOperationRetention != nil {
+		in, out := &in.ShootOperationRetention, &out.ShootOperationRetention
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.SeedAccessRequestRetention != nil {
+		in, out := &in.SeedAccessRequestRetention, &out.SeedAccessRequestRetention
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GarbageCollectionControllerConfiguration.
+func (in *GarbageCollectionControllerConfiguration) DeepCopy() *GarbageCollectionControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GarbageCollectionControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderElectionConfiguration) DeepCopyInto(out *LeaderElectionConfiguration) {
 	*out = *in
@@ -198,6 +356,31 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCredentialsConfiguration) DeepCopyInto(out *MachineCredentialsConfiguration) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(VaultMachineCredentialsConfiguration)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCredentialsConfiguration.
+func (in *MachineCredentialsConfiguration) DeepCopy() *MachineCredentialsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCredentialsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetricsConfiguration) DeepCopyInto(out *MetricsConfiguration) {
 	*out = *in
@@ -247,9 +430,27 @@ func (in *SecretBindingControllerConfiguration) DeepCopy() *SecretBindingControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAccessRequestControllerConfiguration) DeepCopyInto(out *SeedAccessRequestControllerConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAccessRequestControllerConfiguration.
+func (in *SeedAccessRequestControllerConfiguration) DeepCopy() *SeedAccessRequestControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAccessRequestControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfiguration) {
 	*out = *in
+	out.KubeconfigValidationPeriod = in.KubeconfigValidationPeriod
+	out.SyncPeriod = in.SyncPeriod
 	return
 }
 
@@ -266,6 +467,15 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerConfiguration) DeepCopyInto(out *ServerConfiguration) {
 	*out = *in
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WebhookServerConfiguration)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -279,10 +489,53 @@ func (in *ServerConfiguration) DeepCopy() *ServerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShardingConfiguration) DeepCopyInto(out *ShardingConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShardingConfiguration.
+func (in *ShardingConfiguration) DeepCopy() *ShardingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShardingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControllerConfiguration) {
 	*out = *in
 	out.SyncPeriod = in.SyncPeriod
+	if in.GarbageCollectionRetention != nil {
+		in, out := &in.GarbageCollectionRetention, &out.GarbageCollectionRetention
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.KubernetesUpgradeRollbackThreshold != nil {
+		in, out := &in.KubernetesUpgradeRollbackThreshold, &out.KubernetesUpgradeRollbackThreshold
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.NetworkUtilizationThreshold != nil {
+		in, out := &in.NetworkUtilizationThreshold, &out.NetworkUtilizationThreshold
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -299,6 +552,103 @@ func (in *ShootCareControllerConfiguration) DeepCopy() *ShootCareControllerConfi
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfiguration) {
 	*out = *in
+	if in.ControlPlaneResourceLimits != nil {
+		in, out := &in.ControlPlaneResourceLimits, &out.ControlPlaneResourceLimits
+		*out = make(map[string]ControlPlaneResourceLimits, len(*in))
+		for key, val := range *in {
+			newVal := new(ControlPlaneResourceLimits)
+			val.DeepCopyInto(newVal)
+			(*out)[key] = *newVal
+		}
+	}
+	if in.EventFeedRetention != nil {
+		in, out := &in.EventFeedRetention, &out.EventFeedRetention
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make([]ShootExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MachineWaitTimeout != nil {
+		in, out := &in.MachineWaitTimeout, &out.MachineWaitTimeout
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.MachineWaitPollInterval != nil {
+		in, out := &in.MachineWaitPollInterval, &out.MachineWaitPollInterval
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.MachineDeploymentProgressTimeout != nil {
+		in, out := &in.MachineDeploymentProgressTimeout, &out.MachineDeploymentProgressTimeout
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.NodeDrainTimeout != nil {
+		in, out := &in.NodeDrainTimeout, &out.NodeDrainTimeout
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.MachineForceDeletionConcurrency != nil {
+		in, out := &in.MachineForceDeletionConcurrency, &out.MachineForceDeletionConcurrency
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
+	if in.MachineCredentials != nil {
+		in, out := &in.MachineCredentials, &out.MachineCredentials
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineCredentialsConfiguration)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.OrphanedMachineGracePeriod != nil {
+		in, out := &in.OrphanedMachineGracePeriod, &out.OrphanedMachineGracePeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.OrphanedNodeGracePeriod != nil {
+		in, out := &in.OrphanedNodeGracePeriod, &out.OrphanedNodeGracePeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
 	if in.RespectSyncPeriodOverwrite != nil {
 		in, out := &in.RespectSyncPeriodOverwrite, &out.RespectSyncPeriodOverwrite
 		if *in == nil {
@@ -318,6 +668,15 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 			**out = **in
 		}
 	}
+	if in.SecretHistoryLimit != nil {
+		in, out := &in.SecretHistoryLimit, &out.SecretHistoryLimit
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
 	out.SyncPeriod = in.SyncPeriod
 	if in.WatchNamespace != nil {
 		in, out := &in.WatchNamespace, &out.WatchNamespace
@@ -341,6 +700,31 @@ func (in *ShootControllerConfiguration) DeepCopy() *ShootControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootExtension) DeepCopyInto(out *ShootExtension) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootExtension.
+func (in *ShootExtension) DeepCopy() *ShootExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMaintenanceControllerConfiguration) {
 	*out = *in
@@ -358,6 +742,22 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopy() *ShootMaintenanceC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperationControllerConfiguration) DeepCopyInto(out *ShootOperationControllerConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperationControllerConfiguration.
+func (in *ShootOperationControllerConfiguration) DeepCopy() *ShootOperationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootQuotaControllerConfiguration) DeepCopyInto(out *ShootQuotaControllerConfiguration) {
 	*out = *in
@@ -374,3 +774,84 @@ func (in *ShootQuotaControllerConfiguration) DeepCopy() *ShootQuotaControllerCon
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRecoveryDrillControllerConfiguration) DeepCopyInto(out *ShootRecoveryDrillControllerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRecoveryDrillControllerConfiguration.
+func (in *ShootRecoveryDrillControllerConfiguration) DeepCopy() *ShootRecoveryDrillControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRecoveryDrillControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultMachineCredentialsConfiguration) DeepCopyInto(out *VaultMachineCredentialsConfiguration) {
+	*out = *in
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DataKeys != nil {
+		in, out := &in.DataKeys, &out.DataKeys
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultMachineCredentialsConfiguration.
+func (in *VaultMachineCredentialsConfiguration) DeepCopy() *VaultMachineCredentialsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultMachineCredentialsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookServerConfiguration) DeepCopyInto(out *WebhookServerConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookServerConfiguration.
+func (in *WebhookServerConfiguration) DeepCopy() *WebhookServerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookServerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -16,8 +16,11 @@ package helper
 
 import (
 	"errors"
+	"strings"
 
+	"github.com/Masterminds/semver"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // DetermineCloudProviderInProfile takes a CloudProfile specification and returns the cloud provider this profile is used for.
@@ -44,13 +47,17 @@ func DetermineCloudProviderInProfile(spec garden.CloudProfileSpec) (garden.Cloud
 		numClouds++
 		cloud = garden.CloudProviderOpenStack
 	}
+	if spec.Packet != nil {
+		numClouds++
+		cloud = garden.CloudProviderPacket
+	}
 	if spec.Local != nil {
 		numClouds++
 		cloud = garden.CloudProviderLocal
 	}
 
 	if numClouds != 1 {
-		return "", errors.New("cloud profile must only contain exactly one field of aws/azure/gcp/openstack/local")
+		return "", errors.New("cloud profile must only contain exactly one field of aws/azure/gcp/openstack/packet/local")
 	}
 	return cloud, nil
 }
@@ -79,13 +86,17 @@ func DetermineCloudProviderInShoot(cloudObj garden.Cloud) (garden.CloudProvider,
 		numClouds++
 		cloud = garden.CloudProviderOpenStack
 	}
+	if cloudObj.Packet != nil {
+		numClouds++
+		cloud = garden.CloudProviderPacket
+	}
 	if cloudObj.Local != nil {
 		numClouds++
 		cloud = garden.CloudProviderLocal
 	}
 
 	if numClouds != 1 {
-		return "", errors.New("cloud object must only contain exactly one field of aws/azure/gcp/openstack/local")
+		return "", errors.New("cloud object must only contain exactly one field of aws/azure/gcp/openstack/packet/local")
 	}
 	return cloud, nil
 }
@@ -101,3 +112,82 @@ func GetCondition(conditions []garden.Condition, conditionType garden.ConditionT
 	}
 	return nil
 }
+
+// SeedSupportsShootKubernetesVersion checks whether the given Seed is allowed to host a Shoot running the Kubernetes
+// version <version>. Seeds without constraints support every version. A <Max> constraint which only consists of a
+// major and a minor version (e.g. 1.10) allows all patch versions of that minor version.
+func SeedSupportsShootKubernetesVersion(seed *garden.Seed, version string) (bool, error) {
+	constraints := seed.Spec.ShootKubernetesVersions
+	if constraints == nil {
+		return true, nil
+	}
+
+	if constraints.Min != nil {
+		ok, err := utils.CompareVersions(version, ">=", *constraints.Min)
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	if constraints.Max != nil {
+		max := strings.TrimPrefix(*constraints.Max, "v")
+		if strings.Count(max, ".") == 1 {
+			// Compare against the next minor version to allow all patch versions of <max>.
+			maxVersion, err := semver.NewVersion(max)
+			if err != nil {
+				return false, err
+			}
+			nextMinorVersion := maxVersion.IncMinor()
+			return utils.CompareVersions(version, "<", nextMinorVersion.String())
+		}
+		return utils.CompareVersions(version, "<=", max)
+	}
+
+	return true, nil
+}
+
+// GetMachineArchitecture returns the given CPU <architecture>, or the default architecture amd64 if it is not set.
+func GetMachineArchitecture(architecture *garden.MachineArchitecture) garden.MachineArchitecture {
+	if architecture == nil {
+		return garden.MachineArchitectureAMD64
+	}
+	return *architecture
+}
+
+// IsKubernetesUpgradeRollback returns true if the Kubernetes version of the <newShoot> resets a progressing upgrade
+// of the <oldShoot> to the version the Shoot cluster has been upgraded from.
+func IsKubernetesUpgradeRollback(newShoot, oldShoot *garden.Shoot) bool {
+	upgrade := oldShoot.Status.KubernetesUpgrade
+	return upgrade != nil &&
+		upgrade.Phase == garden.KubernetesUpgradePhaseProgressing &&
+		upgrade.Version == oldShoot.Spec.Kubernetes.Version &&
+		upgrade.PreviousVersion == newShoot.Spec.Kubernetes.Version
+}
+
+// GetWorkerNames returns the names of the worker groups of the given <cloud> (without the external worker groups).
+func GetWorkerNames(cloud garden.Cloud) []string {
+	var names []string
+	switch {
+	case cloud.AWS != nil:
+		for _, worker := range cloud.AWS.Workers {
+			names = append(names, worker.Name)
+		}
+	case cloud.Azure != nil:
+		for _, worker := range cloud.Azure.Workers {
+			names = append(names, worker.Name)
+		}
+	case cloud.GCP != nil:
+		for _, worker := range cloud.GCP.Workers {
+			names = append(names, worker.Name)
+		}
+	case cloud.OpenStack != nil:
+		for _, worker := range cloud.OpenStack.Workers {
+			names = append(names, worker.Name)
+		}
+	case cloud.Packet != nil:
+		for _, worker := range cloud.Packet.Workers {
+			names = append(names, worker.Name)
+		}
+	}
+	return names
+}
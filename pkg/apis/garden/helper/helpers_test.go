@@ -180,4 +180,114 @@ var _ = Describe("helper", func() {
 			Expect(cond).To(BeNil())
 		})
 	})
+
+	Describe("#SeedSupportsShootKubernetesVersion", func() {
+		var (
+			seed *garden.Seed
+
+			minVersion = "1.9.0"
+			maxVersion = "1.10"
+		)
+
+		BeforeEach(func() {
+			seed = &garden.Seed{}
+		})
+
+		It("should support every version if no constraints are given", func() {
+			supported, err := SeedSupportsShootKubernetesVersion(seed, "1.8.6")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(supported).To(BeTrue())
+		})
+
+		It("should support versions within the constraints", func() {
+			seed.Spec.ShootKubernetesVersions = &garden.SeedShootKubernetesVersions{Min: &minVersion, Max: &maxVersion}
+
+			for _, version := range []string{"1.9.0", "1.9.7", "1.10.0", "1.10.5"} {
+				supported, err := SeedSupportsShootKubernetesVersion(seed, version)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(supported).To(BeTrue(), version)
+			}
+		})
+
+		It("should not support versions outside of the constraints", func() {
+			seed.Spec.ShootKubernetesVersions = &garden.SeedShootKubernetesVersions{Min: &minVersion, Max: &maxVersion}
+
+			for _, version := range []string{"1.8.6", "1.11.0"} {
+				supported, err := SeedSupportsShootKubernetesVersion(seed, version)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(supported).To(BeFalse(), version)
+			}
+		})
+
+		It("should compare against a maximum patch version", func() {
+			max := "1.10.1"
+			seed.Spec.ShootKubernetesVersions = &garden.SeedShootKubernetesVersions{Max: &max}
+
+			supported, err := SeedSupportsShootKubernetesVersion(seed, "1.10.2")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(supported).To(BeFalse())
+		})
+	})
+
+	Describe("#IsKubernetesUpgradeRollback", func() {
+		var (
+			shootWithVersion = func(version string) *garden.Shoot {
+				return &garden.Shoot{
+					Spec: garden.ShootSpec{
+						Kubernetes: garden.Kubernetes{Version: version},
+					},
+				}
+			}
+
+			oldShoot *garden.Shoot
+		)
+
+		BeforeEach(func() {
+			oldShoot = shootWithVersion("1.10.2")
+			oldShoot.Status.KubernetesUpgrade = &garden.ShootKubernetesUpgrade{
+				PreviousVersion: "1.9.6",
+				Version:         "1.10.2",
+				Phase:           garden.KubernetesUpgradePhaseProgressing,
+			}
+		})
+
+		It("should return true if a progressing upgrade is reset to the previous version", func() {
+			Expect(IsKubernetesUpgradeRollback(shootWithVersion("1.9.6"), oldShoot)).To(BeTrue())
+		})
+
+		It("should return false for other versions", func() {
+			Expect(IsKubernetesUpgradeRollback(shootWithVersion("1.9.5"), oldShoot)).To(BeFalse())
+		})
+
+		It("should return false if the upgrade is not progressing", func() {
+			oldShoot.Status.KubernetesUpgrade.Phase = garden.KubernetesUpgradePhaseSucceeded
+
+			Expect(IsKubernetesUpgradeRollback(shootWithVersion("1.9.6"), oldShoot)).To(BeFalse())
+		})
+
+		It("should return false if no upgrade has been recorded", func() {
+			oldShoot.Status.KubernetesUpgrade = nil
+
+			Expect(IsKubernetesUpgradeRollback(shootWithVersion("1.9.6"), oldShoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#GetWorkerNames", func() {
+		It("should return the names of the worker groups", func() {
+			cloud := garden.Cloud{
+				GCP: &garden.GCPCloud{
+					Workers: []garden.GCPWorker{
+						{Worker: garden.Worker{Name: "cpu-worker"}},
+						{Worker: garden.Worker{Name: "gpu-worker"}},
+					},
+				},
+			}
+
+			Expect(GetWorkerNames(cloud)).To(Equal([]string{"cpu-worker", "gpu-worker"}))
+		})
+	})
 })
@@ -58,6 +58,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ShootList{},
 		&BackupInfrastructure{},
 		&BackupInfrastructureList{},
+		&SeedAccessRequest{},
+		&SeedAccessRequestList{},
+		&ShootOperation{},
+		&ShootOperationList{},
+		&RegionPolicy{},
+		&RegionPolicyList{},
 	)
 	return nil
 }
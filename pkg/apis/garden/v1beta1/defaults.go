@@ -16,6 +16,7 @@ package v1beta1
 
 import (
 	"github.com/gardener/gardener/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -96,6 +97,15 @@ func SetDefaults_Shoot(obj *Shoot) {
 		}
 	}
 
+	if cloud.Packet != nil {
+		if cloud.Packet.Networks.Pods == nil {
+			obj.Spec.Cloud.Packet.Networks.Pods = &defaultPodCIDR
+		}
+		if cloud.Packet.Networks.Services == nil {
+			obj.Spec.Cloud.Packet.Networks.Services = &defaultServiceCIDR
+		}
+	}
+
 	if cloud.Local != nil {
 		if cloud.Local.Networks.Pods == nil {
 			obj.Spec.Cloud.Local.Networks.Pods = &defaultPodCIDR
@@ -170,3 +180,18 @@ func SetDefaults_SecretBinding(obj *SecretBinding) {
 		}
 	}
 }
+
+// SetDefaults_SeedAccessRequest sets default values for SeedAccessRequest objects.
+func SetDefaults_SeedAccessRequest(obj *SeedAccessRequest) {
+	if obj.Spec.Duration == nil {
+		obj.Spec.Duration = &metav1.Duration{Duration: DefaultSeedAccessDuration}
+	}
+}
+
+// SetDefaults_ShootOperation sets default values for ShootOperation objects.
+func SetDefaults_ShootOperation(obj *ShootOperation) {
+	if obj.Spec.MaxConcurrency == nil {
+		maxConcurrency := DefaultShootOperationMaxConcurrency
+		obj.Spec.MaxConcurrency = &maxConcurrency
+	}
+}
@@ -50,13 +50,17 @@ func DetermineCloudProviderInProfile(spec gardenv1beta1.CloudProfileSpec) (garde
 		numClouds++
 		cloud = gardenv1beta1.CloudProviderOpenStack
 	}
+	if spec.Packet != nil {
+		numClouds++
+		cloud = gardenv1beta1.CloudProviderPacket
+	}
 	if spec.Local != nil {
 		numClouds++
 		cloud = gardenv1beta1.CloudProviderLocal
 	}
 
 	if numClouds != 1 {
-		return "", errors.New("cloud profile must only contain exactly one field of aws/azure/gcp/openstack/local")
+		return "", errors.New("cloud profile must only contain exactly one field of aws/azure/gcp/openstack/packet/local")
 	}
 	return cloud, nil
 }
@@ -85,13 +89,17 @@ func DetermineCloudProviderInShoot(cloudObj gardenv1beta1.Cloud) (gardenv1beta1.
 		numClouds++
 		cloud = gardenv1beta1.CloudProviderOpenStack
 	}
+	if cloudObj.Packet != nil {
+		numClouds++
+		cloud = gardenv1beta1.CloudProviderPacket
+	}
 	if cloudObj.Local != nil {
 		numClouds++
 		cloud = gardenv1beta1.CloudProviderLocal
 	}
 
 	if numClouds != 1 {
-		return "", errors.New("cloud object must only contain exactly one field of aws/azure/gcp/openstack/local")
+		return "", errors.New("cloud object must only contain exactly one field of aws/azure/gcp/openstack/packet/local")
 	}
 	return cloud, nil
 }
@@ -163,6 +171,41 @@ func GetCondition(conditions []gardenv1beta1.Condition, conditionType gardenv1be
 	return nil
 }
 
+// MergeConditions merges the given <newConditions> into the list of <existingConditions>. Conditions of the same type
+// are replaced, all other existing conditions are kept untouched.
+func MergeConditions(existingConditions []gardenv1beta1.Condition, newConditions ...gardenv1beta1.Condition) []gardenv1beta1.Condition {
+	var (
+		merged   = make([]gardenv1beta1.Condition, 0, len(existingConditions)+len(newConditions))
+		replaced = make(map[gardenv1beta1.ConditionType]bool, len(newConditions))
+	)
+
+	for _, condition := range newConditions {
+		replaced[condition.Type] = false
+	}
+
+	for _, existing := range existingConditions {
+		if _, ok := replaced[existing.Type]; !ok {
+			merged = append(merged, existing)
+			continue
+		}
+		for _, condition := range newConditions {
+			if condition.Type == existing.Type {
+				merged = append(merged, condition)
+				replaced[condition.Type] = true
+			}
+		}
+	}
+
+	for _, condition := range newConditions {
+		if !replaced[condition.Type] {
+			merged = append(merged, condition)
+			replaced[condition.Type] = true
+		}
+	}
+
+	return merged
+}
+
 // ConditionsNeedUpdate returns true if the <existingConditions> must be updated based on <newConditions>.
 func ConditionsNeedUpdate(existingConditions, newConditions []gardenv1beta1.Condition) bool {
 	return existingConditions == nil || !apiequality.Semantic.DeepEqual(newConditions, existingConditions)
@@ -170,8 +213,15 @@ func ConditionsNeedUpdate(existingConditions, newConditions []gardenv1beta1.Cond
 
 // DetermineMachineImage finds the cloud specific machine image in the <cloudProfile> for the given <name> and
 // region. In case it does not find a machine image with the <name>, it returns false. Otherwise, true and the
-// cloud-specific machine image object will be returned.
+// cloud-specific machine image object will be returned. Only images for the default architecture amd64 are considered.
 func DetermineMachineImage(cloudProfile gardenv1beta1.CloudProfile, name gardenv1beta1.MachineImageName, region string) (bool, interface{}, error) {
+	return DetermineMachineImageForArchitecture(cloudProfile, name, region, gardenv1beta1.MachineArchitectureAMD64)
+}
+
+// DetermineMachineImageForArchitecture finds the cloud specific machine image in the <cloudProfile> for the given
+// <name>, region and CPU <architecture>. In case it does not find such a machine image, it returns false. Otherwise,
+// true and the cloud-specific machine image object will be returned.
+func DetermineMachineImageForArchitecture(cloudProfile gardenv1beta1.CloudProfile, name gardenv1beta1.MachineImageName, region string, architecture gardenv1beta1.MachineArchitecture) (bool, interface{}, error) {
 	cloudProvider, err := DetermineCloudProviderInProfile(cloudProfile.Spec)
 	if err != nil {
 		return false, nil, err
@@ -180,7 +230,7 @@ func DetermineMachineImage(cloudProfile gardenv1beta1.CloudProfile, name gardenv
 	switch cloudProvider {
 	case gardenv1beta1.CloudProviderAWS:
 		for _, image := range cloudProfile.Spec.AWS.Constraints.MachineImages {
-			if image.Name == name {
+			if image.Name == name && GetMachineArchitecture(image.Architecture) == architecture {
 				for _, regionMapping := range image.Regions {
 					if regionMapping.Name == region {
 						return true, &gardenv1beta1.AWSMachineImage{
@@ -193,21 +243,28 @@ func DetermineMachineImage(cloudProfile gardenv1beta1.CloudProfile, name gardenv
 		}
 	case gardenv1beta1.CloudProviderAzure:
 		for _, image := range cloudProfile.Spec.Azure.Constraints.MachineImages {
-			if image.Name == name {
+			if image.Name == name && GetMachineArchitecture(image.Architecture) == architecture {
 				ptr := image
 				return true, &ptr, nil
 			}
 		}
 	case gardenv1beta1.CloudProviderGCP:
 		for _, image := range cloudProfile.Spec.GCP.Constraints.MachineImages {
-			if image.Name == name {
+			if image.Name == name && GetMachineArchitecture(image.Architecture) == architecture {
 				ptr := image
 				return true, &ptr, nil
 			}
 		}
 	case gardenv1beta1.CloudProviderOpenStack:
 		for _, image := range cloudProfile.Spec.OpenStack.Constraints.MachineImages {
-			if image.Name == name {
+			if image.Name == name && GetMachineArchitecture(image.Architecture) == architecture {
+				ptr := image
+				return true, &ptr, nil
+			}
+		}
+	case gardenv1beta1.CloudProviderPacket:
+		for _, image := range cloudProfile.Spec.Packet.Constraints.MachineImages {
+			if image.Name == name && GetMachineArchitecture(image.Architecture) == architecture {
 				ptr := image
 				return true, &ptr, nil
 			}
@@ -219,6 +276,85 @@ func DetermineMachineImage(cloudProfile gardenv1beta1.CloudProfile, name gardenv
 	return false, nil, nil
 }
 
+// DetermineWarmMachineImage finds the pre-warmed variant of the machine image with the given <name> for the given <region>
+// and CPU <architecture> in the <cloudProfile>. Pre-warmed variants are only offered for AWS and GCP. In case it does not
+// find one, it returns false. Otherwise, true and the cloud-specific machine image referencing the pre-warmed variant
+// will be returned.
+func DetermineWarmMachineImage(cloudProfile gardenv1beta1.CloudProfile, name gardenv1beta1.MachineImageName, region string, architecture gardenv1beta1.MachineArchitecture) (bool, interface{}, error) {
+	cloudProvider, err := DetermineCloudProviderInProfile(cloudProfile.Spec)
+	if err != nil {
+		return false, nil, err
+	}
+
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		for _, image := range cloudProfile.Spec.AWS.Constraints.MachineImages {
+			if image.Name == name && GetMachineArchitecture(image.Architecture) == architecture {
+				for _, regionMapping := range image.Regions {
+					if regionMapping.Name == region && regionMapping.WarmAMI != nil {
+						return true, &gardenv1beta1.AWSMachineImage{
+							Name: name,
+							AMI:  *regionMapping.WarmAMI,
+						}, nil
+					}
+				}
+			}
+		}
+	case gardenv1beta1.CloudProviderGCP:
+		for _, image := range cloudProfile.Spec.GCP.Constraints.MachineImages {
+			if image.Name == name && GetMachineArchitecture(image.Architecture) == architecture && image.WarmImage != nil {
+				return true, &gardenv1beta1.GCPMachineImage{
+					Name:         name,
+					Image:        *image.WarmImage,
+					Architecture: image.Architecture,
+				}, nil
+			}
+		}
+	}
+
+	return false, nil, nil
+}
+
+// GetMachineArchitecture returns the given CPU <architecture>, or the default architecture amd64 if it is not set.
+func GetMachineArchitecture(architecture *gardenv1beta1.MachineArchitecture) gardenv1beta1.MachineArchitecture {
+	if architecture == nil {
+		return gardenv1beta1.MachineArchitectureAMD64
+	}
+	return *architecture
+}
+
+// DetermineMachineType finds the machine type with the given <name> in the <cloudProfile>. In case it does not find
+// the machine type, it returns false. Otherwise, true and the found machine type will be returned.
+func DetermineMachineType(cloudProfile gardenv1beta1.CloudProfile, name string) (bool, gardenv1beta1.MachineType, error) {
+	cloudProvider, err := DetermineCloudProviderInProfile(cloudProfile.Spec)
+	if err != nil {
+		return false, gardenv1beta1.MachineType{}, err
+	}
+
+	var machineTypes []gardenv1beta1.MachineType
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		machineTypes = cloudProfile.Spec.AWS.Constraints.MachineTypes
+	case gardenv1beta1.CloudProviderAzure:
+		machineTypes = cloudProfile.Spec.Azure.Constraints.MachineTypes
+	case gardenv1beta1.CloudProviderGCP:
+		machineTypes = cloudProfile.Spec.GCP.Constraints.MachineTypes
+	case gardenv1beta1.CloudProviderOpenStack:
+		for _, machineType := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.MachineType)
+		}
+	case gardenv1beta1.CloudProviderPacket:
+		machineTypes = cloudProfile.Spec.Packet.Constraints.MachineTypes
+	}
+
+	for _, machineType := range machineTypes {
+		if machineType.Name == name {
+			return true, machineType, nil
+		}
+	}
+	return false, gardenv1beta1.MachineType{}, nil
+}
+
 // DetermineLatestKubernetesVersion finds the latest Kubernetes patch version in the <cloudProfile> compared
 // to the given <currentVersion>. In case it does not find a newer patch version, it returns false. Otherwise,
 // true and the found version will be returned.
@@ -250,6 +386,10 @@ func DetermineLatestKubernetesVersion(cloudProfile gardenv1beta1.CloudProfile, c
 		for _, version := range cloudProfile.Spec.OpenStack.Constraints.Kubernetes.Versions {
 			versions = append(versions, version)
 		}
+	case gardenv1beta1.CloudProviderPacket:
+		for _, version := range cloudProfile.Spec.Packet.Constraints.Kubernetes.Versions {
+			versions = append(versions, version)
+		}
 	default:
 		return false, "", fmt.Errorf("unknown cloud provider %s", cloudProvider)
 	}
@@ -20,6 +20,8 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 var _ = Describe("helper", func() {
@@ -50,4 +52,125 @@ var _ = Describe("helper", func() {
 			Expect(cond).To(BeNil())
 		})
 	})
+
+	Describe("#MergeConditions", func() {
+		It("should replace conditions of the same type and keep all others", func() {
+			var (
+				existing = []gardenv1beta1.Condition{
+					{Type: "test-1", Message: "old"},
+					{Type: "test-2", Message: "old"},
+				}
+				condition = gardenv1beta1.Condition{Type: "test-2", Message: "new"}
+			)
+
+			conditions := MergeConditions(existing, condition)
+
+			Expect(conditions).To(Equal([]gardenv1beta1.Condition{
+				{Type: "test-1", Message: "old"},
+				{Type: "test-2", Message: "new"},
+			}))
+		})
+
+		It("should append conditions which do not exist yet", func() {
+			var (
+				existing  = []gardenv1beta1.Condition{{Type: "test-1"}}
+				condition = gardenv1beta1.Condition{Type: "test-2"}
+			)
+
+			conditions := MergeConditions(existing, condition)
+
+			Expect(conditions).To(Equal([]gardenv1beta1.Condition{{Type: "test-1"}, {Type: "test-2"}}))
+		})
+	})
+
+	Describe("#DetermineWarmMachineImage", func() {
+		var (
+			warmAMI      = "ami-87654321"
+			cloudProfile = gardenv1beta1.CloudProfile{
+				Spec: gardenv1beta1.CloudProfileSpec{
+					AWS: &gardenv1beta1.AWSProfile{
+						Constraints: gardenv1beta1.AWSConstraints{
+							MachineImages: []gardenv1beta1.AWSMachineImageMapping{
+								{
+									Name: gardenv1beta1.MachineImageCoreOS,
+									Regions: []gardenv1beta1.AWSRegionalMachineImage{
+										{Name: "eu-west-1", AMI: "ami-12345678", WarmAMI: &warmAMI},
+										{Name: "us-east-1", AMI: "ami-12345678"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+		)
+
+		It("should return the pre-warmed variant of the machine image", func() {
+			found, machineImage, err := DetermineWarmMachineImage(cloudProfile, gardenv1beta1.MachineImageCoreOS, "eu-west-1", gardenv1beta1.MachineArchitectureAMD64)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(machineImage).To(Equal(&gardenv1beta1.AWSMachineImage{
+				Name: gardenv1beta1.MachineImageCoreOS,
+				AMI:  warmAMI,
+			}))
+		})
+
+		It("should not find a pre-warmed variant in regions without one", func() {
+			found, _, err := DetermineWarmMachineImage(cloudProfile, gardenv1beta1.MachineImageCoreOS, "us-east-1", gardenv1beta1.MachineArchitectureAMD64)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("should not find a pre-warmed variant for other architectures", func() {
+			found, _, err := DetermineWarmMachineImage(cloudProfile, gardenv1beta1.MachineImageCoreOS, "eu-west-1", gardenv1beta1.MachineArchitectureARM64)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("#DetermineMachineType", func() {
+		var (
+			machineType = gardenv1beta1.MachineType{
+				Name:   "m5.large",
+				CPU:    resource.MustParse("2"),
+				GPU:    resource.MustParse("0"),
+				Memory: resource.MustParse("8Gi"),
+			}
+			cloudProfile = gardenv1beta1.CloudProfile{
+				Spec: gardenv1beta1.CloudProfileSpec{
+					OpenStack: &gardenv1beta1.OpenStackProfile{
+						Constraints: gardenv1beta1.OpenStackConstraints{
+							MachineTypes: []gardenv1beta1.OpenStackMachineType{
+								{MachineType: machineType, VolumeType: "default", VolumeSize: resource.MustParse("20Gi")},
+							},
+						},
+					},
+				},
+			}
+		)
+
+		It("should return the machine type", func() {
+			found, result, err := DetermineMachineType(cloudProfile, "m5.large")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(result).To(Equal(machineType))
+		})
+
+		It("should not find an unknown machine type", func() {
+			found, _, err := DetermineMachineType(cloudProfile, "m5.xlarge")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("should fail for an invalid cloud profile", func() {
+			_, _, err := DetermineMachineType(gardenv1beta1.CloudProfile{}, "m5.large")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -59,6 +59,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ShootList{},
 		&BackupInfrastructure{},
 		&BackupInfrastructureList{},
+		&SeedAccessRequest{},
+		&SeedAccessRequestList{},
+		&ShootOperation{},
+		&ShootOperationList{},
+		&RegionPolicy{},
+		&RegionPolicyList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
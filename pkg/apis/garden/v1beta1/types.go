@@ -15,10 +15,13 @@
 package v1beta1
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 ////////////////////////////////////////////////////
@@ -67,14 +70,35 @@ type CloudProfileSpec struct {
 	// OpenStack is the profile specification for the OpenStack cloud.
 	// +optional
 	OpenStack *OpenStackProfile `json:"openstack,omitempty"`
+	// Packet is the profile specification for the Packet bare-metal cloud.
+	// +optional
+	Packet *PacketProfile `json:"packet,omitempty"`
 	// Local is the profile specification for the Local provider.
 	// +optional
 	Local *LocalProfile `json:"local,omitempty"`
 	// CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
+	// SeedSelectionStrategy is the strategy used to determine the Seed cluster of Shoots using this profile which do
+	// not reference a Seed. It overrides the globally configured strategy.
+	// +optional
+	SeedSelectionStrategy *SeedSelectionStrategy `json:"seedSelectionStrategy,omitempty"`
 }
 
+// SeedSelectionStrategy is a strategy used to determine the Seed cluster of a Shoot.
+type SeedSelectionStrategy string
+
+const (
+	// SeedSelectionStrategySameRegion is a constant for a strategy which only selects Seeds in the region of the Shoot.
+	SeedSelectionStrategySameRegion SeedSelectionStrategy = "SameRegion"
+	// SeedSelectionStrategyMinimalDistance is a constant for a strategy which selects the Seed with the minimal
+	// distance to the region of the Shoot.
+	SeedSelectionStrategyMinimalDistance SeedSelectionStrategy = "MinimalDistance"
+	// SeedSelectionStrategyManual is a constant for a strategy which never selects a Seed, i.e. Shoots have to
+	// reference their Seed explicitly.
+	SeedSelectionStrategyManual SeedSelectionStrategy = "Manual"
+)
+
 // AWSProfile defines certain constraints and definitions for the AWS cloud.
 type AWSProfile struct {
 	// Constraints is an object containing constraints for certain values in the Shoot specification.
@@ -111,6 +135,9 @@ type AWSMachineImageMapping struct {
 	Name MachineImageName `json:"name"`
 	// Regions is a list of machine images with their regional technical id.
 	Regions []AWSRegionalMachineImage `json:"regions"`
+	// Architecture is the CPU architecture of the image. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
 }
 
 type AWSRegionalMachineImage struct {
@@ -118,6 +145,10 @@ type AWSRegionalMachineImage struct {
 	Name string `json:"name"`
 	// AMI is the technical id of the image (specific for region stated in the 'Name' field).
 	AMI string `json:"ami"`
+	// WarmAMI is the technical id of a pre-warmed variant of the image which already contains the container images
+	// required on the nodes. Machines of worker groups with enabled warm-up boot from it.
+	// +optional
+	WarmAMI *string `json:"warmAMI,omitempty"`
 }
 
 // AzureProfile defines certain constraints and definitions for the Azure cloud.
@@ -164,6 +195,9 @@ type AzureMachineImage struct {
 	SKU string `json:"sku"`
 	// Version is the version of the image.
 	Version string `json:"version"`
+	// Architecture is the CPU architecture of the image. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
 }
 
 // GCPProfile defines certain constraints and definitions for the GCP cloud.
@@ -195,6 +229,14 @@ type GCPMachineImage struct {
 	// Image is the technical name of the image. It contains the image name and the Google Cloud project.
 	// Example: projects/coreos-cloud/global/images/coreos-stable-1576-5-0-v20180105
 	Image string `json:"image"`
+	// Architecture is the CPU architecture of the image. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
+	// WarmImage is the technical name of a pre-warmed variant of the image which already contains the container
+	// images required on the nodes. Machines of worker groups with enabled warm-up boot from it. It is only
+	// considered in CloudProfiles.
+	// +optional
+	WarmImage *string `json:"warmImage,omitempty"`
 }
 
 // OpenStackProfile defines certain constraints and definitions for the OpenStack cloud.
@@ -248,6 +290,40 @@ type OpenStackMachineImage struct {
 	Name MachineImageName `json:"name"`
 	// Image is the technical name of the image.
 	Image string `json:"image"`
+	// Architecture is the CPU architecture of the image. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
+}
+
+// PacketProfile defines certain constraints and definitions for the Packet bare-metal cloud.
+type PacketProfile struct {
+	// Constraints is an object containing constraints for certain values in the Shoot specification.
+	Constraints PacketConstraints `json:"constraints"`
+}
+
+// PacketConstraints is an object containing constraints for certain values in the Shoot specification.
+type PacketConstraints struct {
+	// DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.
+	DNSProviders []DNSProviderConstraint `json:"dnsProviders"`
+	// Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.
+	Kubernetes KubernetesConstraints `json:"kubernetes"`
+	// MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.
+	MachineImages []PacketMachineImage `json:"machineImages"`
+	// MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.
+	MachineTypes []MachineType `json:"machineTypes"`
+	// Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.
+	Zones []Zone `json:"zones"`
+}
+
+// PacketMachineImage defines the name and the operating system of the machine image in the Packet environment.
+type PacketMachineImage struct {
+	// Name is the name of the image.
+	Name MachineImageName `json:"name"`
+	// OperatingSystem is the slug of the Packet operating system which is installed on the servers.
+	OperatingSystem string `json:"operatingSystem"`
+	// Architecture is the CPU architecture of the image. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
 }
 
 // LocalProfile defines constraints and definitions for the local development.
@@ -284,6 +360,9 @@ type MachineType struct {
 	GPU resource.Quantity `json:"gpu"`
 	// Memory is the amount of memory for this machine type.
 	Memory resource.Quantity `json:"memory"`
+	// Architecture is the CPU architecture of this machine type. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
 }
 
 // OpenStackMachineType contains certain properties of a machine type in OpenStack
@@ -319,6 +398,16 @@ const (
 	MachineImageCoreOS MachineImageName = "CoreOS"
 )
 
+// MachineArchitecture is a string alias.
+type MachineArchitecture string
+
+const (
+	// MachineArchitectureAMD64 is a constant for the amd64 (x86_64) CPU architecture.
+	MachineArchitectureAMD64 MachineArchitecture = "amd64"
+	// MachineArchitectureARM64 is a constant for the arm64 (aarch64) CPU architecture.
+	MachineArchitectureARM64 MachineArchitecture = "arm64"
+)
+
 ////////////////////////////////////////////////////
 //                      SEEDS                     //
 ////////////////////////////////////////////////////
@@ -372,6 +461,20 @@ type SeedSpec struct {
 	// Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.
 	// +optional
 	Protected *bool `json:"protected,omitempty"`
+	// ShootKubernetesVersions constrains the Kubernetes versions of Shoot clusters which may be hosted on the Seed cluster.
+	// +optional
+	ShootKubernetesVersions *SeedShootKubernetesVersions `json:"shootKubernetesVersions,omitempty"`
+}
+
+// SeedShootKubernetesVersions defines the range of Shoot Kubernetes versions a Seed cluster is able to host.
+type SeedShootKubernetesVersions struct {
+	// Min is the lowest Kubernetes version (inclusive) a Shoot hosted on the Seed may run.
+	// +optional
+	Min *string `json:"min,omitempty"`
+	// Max is the highest Kubernetes version (inclusive) a Shoot hosted on the Seed may run. If only a minor version
+	// (e.g. 1.10) is given then all patch versions of it are allowed.
+	// +optional
+	Max *string `json:"max,omitempty"`
 }
 
 // SeedStatus holds the most recently observed status of the Seed cluster.
@@ -379,6 +482,21 @@ type SeedStatus struct {
 	// Conditions represents the latest available observations of a Seed's current state.
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// Components are the components which the Gardener has deployed into the Seed cluster.
+	// +optional
+	Components []SeedComponentStatus `json:"components,omitempty"`
+}
+
+// SeedComponentStatus is the state of a component which the Gardener deploys into every Seed cluster.
+type SeedComponentStatus struct {
+	// Name is the name of the component.
+	Name string `json:"name"`
+	// Version is the version of the Gardener which has deployed the component.
+	Version string `json:"version"`
+	// Checksum is the checksum of the deployed manifests of the component.
+	Checksum string `json:"checksum"`
+	// LastUpdateTime is the last time the component has been installed or upgraded.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -523,8 +641,15 @@ type ShootSpec struct {
 	Backup *Backup `json:"backup,omitempty"`
 	// Cloud contains information about the cloud environment and their specific settings.
 	Cloud Cloud `json:"cloud"`
+	// DependsOn is a list of references to Shoots in the same or another namespace which must have been reconciled
+	// successfully before this Shoot gets reconciled. If the namespace is empty, the namespace of this Shoot is used.
+	// +optional
+	DependsOn []corev1.ObjectReference `json:"dependsOn,omitempty"`
 	// DNS contains information about the DNS settings of the Shoot.
 	DNS DNS `json:"dns"`
+	// Hibernation contains information whether the Shoot is suspended or not.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty"`
 	// Kubernetes contains the version and configuration settings of the control plane components.
 	Kubernetes Kubernetes `json:"kubernetes"`
 	// Maintenance contains information about the time window for maintenance operations and which
@@ -535,17 +660,45 @@ type ShootSpec struct {
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
 type ShootStatus struct {
+	// Cloud holds the identifiers of the infrastructure resources which have been provisioned for the Shoot
+	// cluster. It is written after a successful create/reconcile operation.
+	// +optional
+	Cloud *ShootCloudStatus `json:"cloud,omitempty"`
 	// Conditions represents the latest available observations of a Shoots's current state.
 	// +optional
 	Conditions []Condition `json:"conditions,omitempty"`
+	// EtcdOperationLock describes the operation which holds the etcd operation lock of the Shoot, i.e. which prevents
+	// that the hibernation, the backup and the restore of its etcd run concurrently. It is empty if no operation
+	// holds the lock.
+	// +optional
+	EtcdOperationLock *ShootEtcdOperationLock `json:"etcdOperationLock,omitempty"`
 	// Gardener holds information about the Gardener which last acted on the Shoot.
 	Gardener Gardener `json:"gardener"`
+	// Inventory lists the cloud resources which the Gardener considers to be owned by the Shoot cluster, e.g. for
+	// audits, cost attribution or manual cleanups. It is written after a successful create/reconcile operation.
+	// +optional
+	Inventory *ShootInventory `json:"inventory,omitempty"`
 	// LastOperation holds information about the last operation on the Shoot.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// LastError holds information about the last occurred error during an operation.
 	// +optional
 	LastError *LastError `json:"lastError,omitempty"`
+	// LastErrors holds information about all errors which occurred during the last operation, e.g. one error per
+	// failed task of the reconciliation flow.
+	// +optional
+	LastErrors []LastError `json:"lastErrors,omitempty"`
+	// Machines holds the progress of the rollout of the machines of the Shoot cluster. It is written while the
+	// Gardener waits until the machine deployments are available.
+	// +optional
+	Machines *ShootMachinesStatus `json:"machines,omitempty"`
+	// KubernetesUpgrade holds information about the last Kubernetes upgrade of the Shoot cluster.
+	// +optional
+	KubernetesUpgrade *ShootKubernetesUpgrade `json:"kubernetesUpgrade,omitempty"`
+	// Monitoring holds the URLs of the monitoring components of the Shoot cluster and a reference to the
+	// secret containing the credentials to access them. It is written after a successful create/reconcile operation.
+	// +optional
+	Monitoring *ShootMonitoring `json:"monitoring,omitempty"`
 	// ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the
 	// Shoot's generation, which is updated on mutation by the API Server.
 	// +optional
@@ -565,6 +718,224 @@ type ShootStatus struct {
 	UID types.UID `json:"uid"`
 }
 
+// ShootCloudStatus holds the identifiers of the infrastructure resources which have been provisioned for a Shoot
+// cluster. Its fields are provider-agnostic, i.e. not every field is filled for every cloud provider.
+type ShootCloudStatus struct {
+	// Network is the identifier of the VPC (AWS, GCP), the VNet (Azure) or the network (OpenStack) the Shoot
+	// cluster runs in.
+	// +optional
+	Network string `json:"network,omitempty"`
+	// Subnets are the identifiers of the subnets which have been created for the Shoot cluster.
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+	// SecurityGroups are the identifiers of the security groups which are attached to the worker nodes.
+	// +optional
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+	// NATIPs are the public IP addresses of the NAT gateways which are used for outgoing traffic of the worker nodes.
+	// +optional
+	NATIPs []string `json:"natIPs,omitempty"`
+	// IAMRoles are the identifiers of the IAM roles (AWS) or service accounts (GCP) which are used by the worker nodes.
+	// +optional
+	IAMRoles []string `json:"iamRoles,omitempty"`
+}
+
+// ShootInventory lists the cloud resources which the Gardener considers to be owned by a Shoot cluster.
+type ShootInventory struct {
+	// Resources are the cloud resources of the Shoot cluster.
+	// +optional
+	Resources []ShootInventoryResource `json:"resources,omitempty"`
+	// LastUpdateTime is the last time the inventory has been updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// ShootInventoryResource is a cloud resource which the Gardener considers to be owned by a Shoot cluster.
+type ShootInventoryResource struct {
+	// Kind is the kind of the resource, one of VirtualMachine, Disk, LoadBalancer, Network, Subnet, SecurityGroup,
+	// IPAddress, IAMRole.
+	Kind InventoryResourceKind `json:"kind"`
+	// ID is the identifier of the resource at the cloud provider, e.g. the provider ID of a virtual machine or the
+	// address of a load balancer.
+	ID string `json:"id"`
+	// Name is the name of the object which represents the resource, e.g. the machine of a virtual machine, the
+	// persistent volume of a disk or the `<namespace>/<name>` of the service of a load balancer.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Source is where the Gardener knows the resource from, one of Infrastructure (the Terraform state), Machine,
+	// PersistentVolume, Service.
+	Source InventoryResourceSource `json:"source"`
+}
+
+// InventoryResourceKind is a string alias.
+type InventoryResourceKind string
+
+const (
+	// InventoryResourceVirtualMachine is a virtual machine of a worker node.
+	InventoryResourceVirtualMachine InventoryResourceKind = "VirtualMachine"
+	// InventoryResourceDisk is a disk of a persistent volume.
+	InventoryResourceDisk InventoryResourceKind = "Disk"
+	// InventoryResourceLoadBalancer is a load balancer of a service.
+	InventoryResourceLoadBalancer InventoryResourceKind = "LoadBalancer"
+	// InventoryResourceNetwork is the VPC, VNet or network the Shoot cluster runs in.
+	InventoryResourceNetwork InventoryResourceKind = "Network"
+	// InventoryResourceSubnet is a subnet of the Shoot cluster.
+	InventoryResourceSubnet InventoryResourceKind = "Subnet"
+	// InventoryResourceSecurityGroup is a security group of the worker nodes.
+	InventoryResourceSecurityGroup InventoryResourceKind = "SecurityGroup"
+	// InventoryResourceIPAddress is a public IP address, e.g. of a NAT gateway.
+	InventoryResourceIPAddress InventoryResourceKind = "IPAddress"
+	// InventoryResourceIAMRole is an IAM role or service account of the worker nodes.
+	InventoryResourceIAMRole InventoryResourceKind = "IAMRole"
+)
+
+// InventoryResourceSource is a string alias.
+type InventoryResourceSource string
+
+const (
+	// InventoryResourceSourceInfrastructure indicates that the resource is an output of the Terraform state of the
+	// infrastructure.
+	InventoryResourceSourceInfrastructure InventoryResourceSource = "Infrastructure"
+	// InventoryResourceSourceMachine indicates that the resource belongs to a machine.
+	InventoryResourceSourceMachine InventoryResourceSource = "Machine"
+	// InventoryResourceSourcePersistentVolume indicates that the resource belongs to a persistent volume of the Shoot.
+	InventoryResourceSourcePersistentVolume InventoryResourceSource = "PersistentVolume"
+	// InventoryResourceSourceService indicates that the resource belongs to a service of the Shoot.
+	InventoryResourceSourceService InventoryResourceSource = "Service"
+)
+
+// ShootEtcdOperationLock describes the operation which holds the etcd operation lock of a Shoot.
+type ShootEtcdOperationLock struct {
+	// Holder is the identity of the Gardener controller which holds the lock.
+	Holder string `json:"holder"`
+	// Reason is the operation for which the lock is held, one of Hibernation, Backup, Restore.
+	Reason string `json:"reason"`
+	// AcquireTime is the time when the lock has been acquired.
+	AcquireTime metav1.Time `json:"acquireTime"`
+}
+
+// ShootKubernetesUpgrade holds information about a Kubernetes upgrade of a Shoot cluster.
+type ShootKubernetesUpgrade struct {
+	// PreviousVersion is the Kubernetes version the Shoot cluster has been upgraded from.
+	PreviousVersion string `json:"previousVersion"`
+	// Version is the Kubernetes version the Shoot cluster has been upgraded to.
+	Version string `json:"version"`
+	// Phase is the phase of the upgrade, one of Progressing, Succeeded, RolledBack.
+	Phase KubernetesUpgradePhase `json:"phase"`
+	// StartTime is the time when the upgrade has been requested.
+	StartTime metav1.Time `json:"startTime"`
+	// Message is a human readable message about the outcome of the upgrade.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// KubernetesUpgradePhase is a string alias.
+type KubernetesUpgradePhase string
+
+const (
+	// KubernetesUpgradePhaseProgressing indicates that the Shoot cluster has not become healthy since the upgrade.
+	KubernetesUpgradePhaseProgressing KubernetesUpgradePhase = "Progressing"
+	// KubernetesUpgradePhaseSucceeded indicates that the Shoot cluster has become healthy after the upgrade.
+	KubernetesUpgradePhaseSucceeded KubernetesUpgradePhase = "Succeeded"
+	// KubernetesUpgradePhaseRolledBack indicates that the Kubernetes version has been reset to the previous version.
+	KubernetesUpgradePhaseRolledBack KubernetesUpgradePhase = "RolledBack"
+)
+
+// ShootMachinesStatus holds the progress of the rollout of the machines of a Shoot cluster.
+type ShootMachinesStatus struct {
+	// Phase is the phase of the rollout, one of Progressing, Available, Failed.
+	Phase MachineRolloutPhase `json:"phase"`
+	// Deployments contains the progress of every machine deployment of the Shoot cluster.
+	// +optional
+	Deployments []ShootMachineDeploymentStatus `json:"deployments,omitempty"`
+	// LastError is the last error reported by the machine-controller-manager for one of the machine deployments.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+	// PendingReplacements contains the names of the worker groups whose machines are replaced in the next maintenance
+	// time window.
+	// +optional
+	PendingReplacements []string `json:"pendingReplacements,omitempty"`
+	// Canaries contains the progress of the canary rollouts of the worker groups.
+	// +optional
+	Canaries []ShootMachineCanaryStatus `json:"canaries,omitempty"`
+	// LastUpdateTime is the last time the progress has been updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
+// ShootMachineDeploymentStatus holds the progress of the rollout of a single machine deployment.
+type ShootMachineDeploymentStatus struct {
+	// Name is the name of the machine deployment.
+	Name string `json:"name"`
+	// Desired is the desired number of machines.
+	Desired int32 `json:"desired"`
+	// Ready is the number of ready machines.
+	Ready int32 `json:"ready"`
+	// Updated is the number of machines which use the current machine class.
+	Updated int32 `json:"updated"`
+	// Unavailable is the number of machines which are not available yet.
+	Unavailable int32 `json:"unavailable"`
+	// LastError is the last error reported by the machine-controller-manager for the machine deployment.
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+}
+
+// ShootMachineCanaryStatus holds the progress of the canary rollout of a worker group.
+type ShootMachineCanaryStatus struct {
+	// WorkerName is the name of the worker group.
+	WorkerName string `json:"workerName"`
+	// Phase is the phase of the canary rollout, either Soaking or Halted.
+	Phase MachineCanaryPhase `json:"phase"`
+	// SoakEndTime is the time at which the soak period of the canary machines ends.
+	// +optional
+	SoakEndTime *metav1.Time `json:"soakEndTime,omitempty"`
+	// Reason describes why the canary rollout has been halted.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// MachineCanaryPhase is a string alias.
+type MachineCanaryPhase string
+
+const (
+	// MachineCanaryPhaseSoaking indicates that the canary machines are soaking, i.e. that the change is rolled out to
+	// all machines of the worker group once their soak period has ended.
+	MachineCanaryPhaseSoaking MachineCanaryPhase = "Soaking"
+	// MachineCanaryPhaseHalted indicates that the canary machines have become unhealthy, hence, the change is not rolled
+	// out to the other machines of the worker group.
+	MachineCanaryPhaseHalted MachineCanaryPhase = "Halted"
+)
+
+// MachineRolloutPhase is a string alias.
+type MachineRolloutPhase string
+
+const (
+	// MachineRolloutPhaseProgressing indicates that the machines are being rolled out.
+	MachineRolloutPhaseProgressing MachineRolloutPhase = "Progressing"
+	// MachineRolloutPhaseAvailable indicates that all machines have been rolled out and are available.
+	MachineRolloutPhaseAvailable MachineRolloutPhase = "Available"
+	// MachineRolloutPhaseFailed indicates that the machines have not become available in time.
+	MachineRolloutPhaseFailed MachineRolloutPhase = "Failed"
+)
+
+// ShootMonitoring holds the URLs of the monitoring components of a Shoot cluster. The components are protected
+// with basic authentication, the credentials are the 'username' and 'password' keys of the referenced secret.
+type ShootMonitoring struct {
+	// AlertManagerURL is the URL of the Alertmanager of the Shoot cluster.
+	AlertManagerURL string `json:"alertManagerURL"`
+	// GrafanaURL is the URL of the Grafana of the Shoot cluster.
+	GrafanaURL string `json:"grafanaURL"`
+	// PrometheusURL is the URL of the Prometheus of the Shoot cluster.
+	PrometheusURL string `json:"prometheusURL"`
+	// CredentialsSecretRef is a reference to a secret in the namespace of the Shoot which contains the
+	// credentials to access the monitoring components.
+	CredentialsSecretRef corev1.LocalObjectReference `json:"credentialsSecretRef"`
+	// FederationURL is the URL of the federation endpoint of the Prometheus of the Shoot cluster. It only serves the
+	// '/federate' and '/api/v1/read' paths which can be used to integrate the metrics of the control plane into
+	// other monitoring systems.
+	FederationURL string `json:"federationURL"`
+	// FederationCredentialsSecretRef is a reference to a secret in the namespace of the Shoot which contains the
+	// credentials to access the federation endpoint.
+	FederationCredentialsSecretRef corev1.LocalObjectReference `json:"federationCredentialsSecretRef"`
+}
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -593,9 +964,22 @@ type Cloud struct {
 	// OpenStack contains the Shoot specification for the OpenStack cloud.
 	// +optional
 	OpenStack *OpenStackCloud `json:"openstack,omitempty"`
+	// Packet contains the Shoot specification for the Packet bare-metal cloud.
+	// +optional
+	Packet *PacketCloud `json:"packet,omitempty"`
 	// Local contains the Shoot specification for the Local local provider.
 	// +optional
 	Local *Local `json:"local,omitempty"`
+	// ExternalWorkers is a list of worker groups whose machines are not managed by the Gardener, e.g. for hardware
+	// which it cannot provision. Their nodes join the Shoot with the user data which the Gardener publishes for each
+	// of them, and their kubelets and addons are managed like those of the other worker groups.
+	// +optional
+	ExternalWorkers []ExternalWorker `json:"externalWorkers,omitempty"`
+	// ScaleDownBudget limits the number of machines of all worker groups which are deleted within a period when the
+	// desired number of machines decreases, e.g. after their maximum has been reduced. The remaining machines are
+	// removed with the following reconciliations. Unlimited if not set.
+	// +optional
+	ScaleDownBudget *ScaleDownBudget `json:"scaleDownBudget,omitempty"`
 }
 
 // K8SNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.
@@ -747,6 +1131,10 @@ type GCPWorker struct {
 	VolumeType string `json:"volumeType"`
 	// VolumeSize is the size of the root volume.
 	VolumeSize string `json:"volumeSize"`
+	// Preemptible indicates whether the worker group uses preemptible VMs. Their nodes are drained as soon as
+	// GCP announces their termination.
+	// +optional
+	Preemptible *bool `json:"preemptible,omitempty"`
 }
 
 // OpenStackCloud contains the Shoot specification for OpenStack.
@@ -789,12 +1177,46 @@ type OpenStackWorker struct {
 	Worker `json:",inline"`
 }
 
+// PacketCloud contains the Shoot specification for Packet.
+type PacketCloud struct {
+	// MachineImage holds information about the machine image to use for all workers.
+	// It will default to the first image stated in the referenced CloudProfile if no
+	// value has been provided.
+	// +optional
+	MachineImage *PacketMachineImage `json:"machineImage,omitempty"`
+	// Networks holds information about the Kubernetes and infrastructure networks.
+	Networks PacketNetworks `json:"networks"`
+	// Workers is a list of worker groups.
+	Workers []PacketWorker `json:"workers"`
+	// Zones is a list of facilities to deploy the Shoot cluster to.
+	Zones []string `json:"zones"`
+}
+
+// PacketNetworks holds information about the Kubernetes and infrastructure networks.
+type PacketNetworks struct {
+	K8SNetworks `json:",inline"`
+}
+
+// PacketWorker is the definition of a worker group.
+type PacketWorker struct {
+	Worker `json:",inline"`
+}
+
 // Local contains the Shoot specification for local provider.
 type Local struct {
 	// Networks holds information about the Kubernetes and infrastructure networks.
 	Networks LocalNetworks `json:"networks"`
 	// Endpoint of the local service.
 	Endpoint string `json:"endpoint"`
+	// Workers is a list of worker groups whose machines are simulated by a fake machine-controller-manager instead
+	// of being created by the local service. The simulated machines do not join the Shoot as nodes.
+	// +optional
+	Workers []LocalWorker `json:"workers,omitempty"`
+}
+
+// LocalWorker is the definition of a worker group whose machines are simulated.
+type LocalWorker struct {
+	Worker `json:",inline"`
 }
 
 // LocalNetworks holds information about the Kubernetes and infrastructure networks.
@@ -814,6 +1236,262 @@ type Worker struct {
 	AutoScalerMin int `json:"autoScalerMin"`
 	// AutoScalerMin is the maximum number of VMs to create.
 	AutoScalerMax int `json:"autoScalerMax"`
+	// MachineHealthTimeout is the duration after which a machine whose node is not ready gets replaced by the
+	// machine-controller-manager. Defaults to 10 minutes.
+	// +optional
+	MachineHealthTimeout *metav1.Duration `json:"machineHealthTimeout,omitempty"`
+	// MaxUnhealthy is the absolute number or percentage of unhealthy nodes in the worker group above which the
+	// automatic replacement of machines is paused for the Shoot. Unlimited if not set.
+	// +optional
+	MaxUnhealthy *intstr.IntOrString `json:"maxUnhealthy,omitempty"`
+	// MaxEvictRetries is the number of times the machine-controller-manager retries to evict a pod while it drains a
+	// node of the worker group before the machine is deleted anyway. Defaults to the setting of the
+	// machine-controller-manager.
+	// +optional
+	MaxEvictRetries *int32 `json:"maxEvictRetries,omitempty"`
+	// UnhealthyNodeConditions are the types of node conditions which render a node of the worker group unhealthy (in
+	// addition to a node which is not ready) if they are true for longer than the machine health timeout. Defaults to
+	// the conditions checked by the machine-controller-manager.
+	// +optional
+	UnhealthyNodeConditions []string `json:"unhealthyNodeConditions,omitempty"`
+	// Labels is a map of additional labels for the machine deployments of the worker group, e.g. for cost
+	// tracking or external autoscalers.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations is a map of additional annotations for the machine deployments of the worker group.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// NodeLabels is a map of additional labels for the nodes of the worker group, e.g. to schedule workload on
+	// dedicated worker groups.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+	// NodeAnnotations is a map of additional annotations for the nodes of the worker group.
+	// +optional
+	NodeAnnotations map[string]string `json:"nodeAnnotations,omitempty"`
+	// NodeTaints is a list of taints for the nodes of the worker group, e.g. to reserve them for dedicated workload.
+	// +optional
+	NodeTaints []corev1.Taint `json:"nodeTaints,omitempty"`
+	// Cordoned prevents the creation of new machines for the worker group without deleting it, e.g. to retire it
+	// gradually while its workload is drained to another worker group.
+	// +optional
+	Cordoned *bool `json:"cordoned,omitempty"`
+	// Architecture is the CPU architecture of the machines of the worker group. The referenced CloudProfile must
+	// offer the machine type and the machine image for this architecture. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
+	// WarmUp indicates that the machines of the worker group boot from the pre-warmed variant of the machine
+	// image, which already contains the container images required on the nodes. Only supported on AWS and GCP
+	// if the referenced CloudProfile offers such a variant. Defaults to false.
+	// +optional
+	WarmUp *bool `json:"warmUp,omitempty"`
+	// MaxSurge is the maximum number of machines that can be created above the desired number of machines of each
+	// machine deployment of the worker group during a rolling update. Value can be an absolute number or a percentage
+	// of the desired machines. Defaults to 1.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of machines of each machine deployment of the worker group that can be
+	// unavailable during a rolling update. Value can be an absolute number or a percentage of the desired machines.
+	// Defaults to 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// MinReadySeconds is the minimum number of seconds for which a newly created machine must be ready before it is
+	// considered available during a rolling update. Defaults to 500.
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+	// FirewallRules is a list of rules which allow additional inbound traffic to the machines of the worker group,
+	// e.g. to open ports on dedicated ingress nodes without opening them on all nodes. Not supported on Azure.
+	// +optional
+	FirewallRules []WorkerFirewallRule `json:"firewallRules,omitempty"`
+	// WaitForCriticalComponents indicates that the nodes of the worker group register with the
+	// worker.garden.sapcloud.io/critical-components-not-ready taint. It prevents pods from being scheduled onto a node until
+	// the critical DaemonSets (e.g., the CNI and kube-proxy) are ready on it, then the Gardener removes it. Defaults
+	// to false.
+	// +optional
+	WaitForCriticalComponents *bool `json:"waitForCriticalComponents,omitempty"`
+	// MachineImage is the name of the machine image for the machines of the worker group, e.g. to try a new operating
+	// system version on one worker group first. The referenced CloudProfile must offer it for the region and the
+	// architecture of the worker group. Defaults to the machine image of the Shoot.
+	// +optional
+	MachineImage *MachineImageName `json:"machineImage,omitempty"`
+	// KubernetesVersion is the version of the kubelets of the worker group, e.g. to try a new Kubernetes version on one
+	// worker group first. It must be offered by the referenced CloudProfile, must not be newer than the version of the
+	// control plane and must be at most two minor versions older. Defaults to the Kubernetes version of the Shoot.
+	// +optional
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+	// RolloutStage is the stage in which the machines of the worker group are rolled out. The worker groups of a stage
+	// are only rolled out once those of all lower stages are available, hence, a failed rollout stops before the
+	// worker groups of the higher stages are touched. Defaults to 0.
+	// +optional
+	RolloutStage *int32 `json:"rolloutStage,omitempty"`
+	// Spot indicates that the machines of the worker group run on spare capacity of the cloud provider, i.e. on spot
+	// instances on AWS and on preemptible VMs on GCP. They are much cheaper but can be reclaimed at any time, hence, their
+	// nodes are drained as soon as the cloud provider announces it. Only supported on AWS and GCP.
+	// +optional
+	Spot *WorkerSpot `json:"spot,omitempty"`
+	// ScaleDownPriority is the priority with which the machine-controller-manager deletes the machines of the worker
+	// group when it scales down one of their machine sets, from 1 (deleted first) to 4 (deleted last). Machines whose
+	// nodes are protected from scale-downs always get the priority 5. Defaults to the priority of the
+	// machine-controller-manager (3).
+	// +optional
+	ScaleDownPriority *int32 `json:"scaleDownPriority,omitempty"`
+	// Kubelet contains the configuration of the kubelets of the worker group, e.g. to run more pods on the nodes of
+	// a high-density worker group than on those of a latency-sensitive one. Only supported for Kubernetes versions
+	// >= 1.10.
+	// +optional
+	Kubelet *WorkerKubeletConfig `json:"kubelet,omitempty"`
+	// OSUpdates contains the settings for the automated updates of the operating system of the machines of the
+	// worker group.
+	// +optional
+	OSUpdates *WorkerOSUpdates `json:"osUpdates,omitempty"`
+	// Canary contains the settings for canary rollouts of the worker group. A change which replaces its machines (e.g.,
+	// of the machine image) is first rolled out to a few canary machines, and only continued once they have been
+	// healthy for a soak period.
+	// +optional
+	Canary *WorkerCanary `json:"canary,omitempty"`
+	// LocalSSDs contains the configuration of the local SSDs which are attached to the machines of the worker group,
+	// e.g. for IO-heavy workloads. They hold the data of the container runtime and of the kubelet (including the
+	// emptyDir volumes). Only supported on AWS and GCP.
+	// +optional
+	LocalSSDs *WorkerLocalSSDs `json:"localSSDs,omitempty"`
+	// ScaleDownBudget limits the number of machines of the worker group which are deleted within a period when its
+	// desired number of machines decreases. The remaining machines are removed with the following reconciliations.
+	// Unlimited if not set.
+	// +optional
+	ScaleDownBudget *ScaleDownBudget `json:"scaleDownBudget,omitempty"`
+}
+
+// ExternalWorker is a worker group whose machines are not managed by the Gardener.
+type ExternalWorker struct {
+	// Name is the name of the worker group.
+	Name string `json:"name"`
+	// NodeLabels is a map of additional labels for the nodes of the worker group.
+	// +optional
+	NodeLabels map[string]string `json:"nodeLabels,omitempty"`
+	// NodeTaints is a list of taints for the nodes of the worker group.
+	// +optional
+	NodeTaints []corev1.Taint `json:"nodeTaints,omitempty"`
+	// Architecture is the CPU architecture of the nodes of the worker group. Defaults to amd64.
+	// +optional
+	Architecture *MachineArchitecture `json:"architecture,omitempty"`
+	// KubernetesVersion is the version of the kubelets of the worker group. It must not be newer than the version of
+	// the control plane and must be at most two minor versions older. Defaults to the Kubernetes version of the Shoot.
+	// +optional
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+	// Kubelet contains the configuration of the kubelets of the worker group. Only supported for Kubernetes versions
+	// >= 1.10.
+	// +optional
+	Kubelet *WorkerKubeletConfig `json:"kubelet,omitempty"`
+}
+
+// WorkerKubeletConfig contains the configuration of the kubelets of a worker group.
+type WorkerKubeletConfig struct {
+	// EvictionHard are the hard eviction thresholds of the kubelets per eviction signal (memory.available,
+	// nodefs.available, nodefs.inodesFree, imagefs.available or imagefs.inodesFree), e.g. "100Mi" or "5%". The
+	// signals which are not set keep their defaults.
+	// +optional
+	EvictionHard map[string]string `json:"evictionHard,omitempty"`
+	// EvictionSoft are the soft eviction thresholds of the kubelets per eviction signal (see EvictionHard), which must
+	// be exceeded for 1m30s before pods are evicted. The signals which are not set keep their defaults.
+	// +optional
+	EvictionSoft map[string]string `json:"evictionSoft,omitempty"`
+	// MaxPods is the maximum number of pods per node. Defaults to 110.
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty"`
+	// KubeReserved are the resources (cpu, memory, ephemeral-storage) reserved for the Kubernetes components on every
+	// node. Defaults to 1Gi of memory.
+	// +optional
+	KubeReserved corev1.ResourceList `json:"kubeReserved,omitempty"`
+	// SystemReserved are the resources (cpu, memory, ephemeral-storage) reserved for the system daemons on every node.
+	// Nothing is reserved by default.
+	// +optional
+	SystemReserved corev1.ResourceList `json:"systemReserved,omitempty"`
+	// CPUManagerPolicy is the CPU manager policy of the kubelets, either "none" or "static". The static policy assigns
+	// exclusive CPUs to containers of Guaranteed pods with integer CPU requests and requires some CPU to be reserved
+	// (see KubeReserved and SystemReserved). Defaults to "none".
+	// +optional
+	CPUManagerPolicy *string `json:"cpuManagerPolicy,omitempty"`
+}
+
+// WorkerOSUpdates contains the settings for the automated updates of the operating system of the machines of a
+// worker group.
+type WorkerOSUpdates struct {
+	// Enabled indicates whether the machines are rebooted into the updates of the operating system (e.g., security
+	// patches) which have been installed in the background. The reboots only take place within the maintenance time
+	// window of the Shoot, and only one node of the Shoot is drained and rebooted at a time.
+	Enabled bool `json:"enabled"`
+}
+
+// WorkerCanary contains the settings for canary rollouts of a worker group.
+type WorkerCanary struct {
+	// Machines is the number of canary machines which are created with the changed machine class in addition to the
+	// existing machines of the worker group.
+	Machines int `json:"machines"`
+	// SoakPeriod is the duration for which the nodes of the canary machines must be ready and healthy before the
+	// change is rolled out to all machines of the worker group. Defaults to 10m.
+	// +optional
+	SoakPeriod *metav1.Duration `json:"soakPeriod,omitempty"`
+}
+
+// ScaleDownBudget limits the number of machines which are deleted within a period when the desired number of
+// machines decreases.
+type ScaleDownBudget struct {
+	// MaxMachines is the maximum number of machines which are deleted within the period.
+	MaxMachines int `json:"maxMachines"`
+	// Period is the duration within which at most MaxMachines machines are deleted. Defaults to 10m.
+	// +optional
+	Period *metav1.Duration `json:"period,omitempty"`
+}
+
+// WorkerLocalSSDs contains the configuration of the local SSDs of the machines of a worker group.
+type WorkerLocalSSDs struct {
+	// Count is the number of local SSDs which are attached to every machine. Several local SSDs are combined into one
+	// striped volume. On AWS, the machine type must offer at least as many instance store volumes.
+	Count int32 `json:"count"`
+	// Size is the size of every local SSD. It is fixed to 375Gi on GCP and determined by the machine type on AWS,
+	// hence, it is only validated against the size offered by GCP.
+	// +optional
+	Size *string `json:"size,omitempty"`
+	// Interface is the interface through which the local SSDs are attached on GCP, either SCSI or NVME. Defaults to
+	// NVME. Not supported on AWS, where it is determined by the machine type.
+	// +optional
+	Interface *string `json:"interface,omitempty"`
+}
+
+const (
+	// LocalSSDInterfaceSCSI is a constant for the SCSI interface of local SSDs.
+	LocalSSDInterfaceSCSI = "SCSI"
+	// LocalSSDInterfaceNVME is a constant for the NVMe interface of local SSDs.
+	LocalSSDInterfaceNVME = "NVME"
+)
+
+// WorkerSpot describes how the machines of a worker group use spare capacity of the cloud provider.
+type WorkerSpot struct {
+	// MaxPrice is the maximum hourly price in US dollars which is paid per machine, e.g. "0.05". Machines are reclaimed
+	// as soon as the spot price exceeds it. Only supported on AWS, defaults to the on-demand price.
+	// +optional
+	MaxPrice *string `json:"maxPrice,omitempty"`
+	// FallbackToOnDemand indicates that on-demand machines are created for the worker group while the cloud provider has
+	// no spare capacity. Defaults to false.
+	// +optional
+	FallbackToOnDemand *bool `json:"fallbackToOnDemand,omitempty"`
+	// Taint indicates that the nodes of the worker group register with the worker.garden.sapcloud.io/spot taint, so that
+	// only pods which tolerate interruptions are scheduled onto them. Defaults to false.
+	// +optional
+	Taint *bool `json:"taint,omitempty"`
+}
+
+// WorkerFirewallRule describes inbound traffic which is allowed to the machines of a worker group.
+type WorkerFirewallRule struct {
+	// Protocol is the protocol of the allowed traffic, either TCP or UDP. Defaults to TCP.
+	// +optional
+	Protocol *corev1.Protocol `json:"protocol,omitempty"`
+	// Port is the (first) port of the allowed traffic.
+	Port int32 `json:"port"`
+	// EndPort is the last port of the allowed port range. Defaults to Port.
+	// +optional
+	EndPort *int32 `json:"endPort,omitempty"`
+	// SourceCIDRs is the list of CIDRs from which the traffic is allowed.
+	SourceCIDRs []CIDR `json:"sourceCIDRs"`
 }
 
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
@@ -839,6 +1517,9 @@ type Addons struct {
 	// Monocular holds configuration settings for the monocular addon.
 	// +optional
 	Monocular *Monocular `json:"monocular,omitempty"`
+	// NetworkPolicies holds configuration settings for the network-policies addon.
+	// +optional
+	NetworkPolicies *NetworkPolicies `json:"network-policies,omitempty"`
 }
 
 // Addon also enabling or disabling a specific addon and is used to derive from.
@@ -877,6 +1558,14 @@ type Monocular struct {
 	Addon `json:",inline"`
 }
 
+// NetworkPolicies describes configuration values for the network-policies addon.
+type NetworkPolicies struct {
+	Addon `json:",inline"`
+	// ExcludedNamespaces is a list of namespaces which do not get the baseline network policies.
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
 // KubeLego describes configuration values for the kube-lego addon.
 type KubeLego struct {
 	Addon `json:",inline"`
@@ -951,6 +1640,8 @@ const (
 	CloudProviderGCP CloudProvider = "gcp"
 	// CloudProviderOpenStack is a constant for the OpenStack cloud provider.
 	CloudProviderOpenStack CloudProvider = "openstack"
+	// CloudProviderPacket is a constant for the Packet bare-metal cloud provider.
+	CloudProviderPacket CloudProvider = "packet"
 	// CloudProviderLocal is a constant for the development provider.
 	CloudProviderLocal CloudProvider = "local"
 )
@@ -958,6 +1649,13 @@ const (
 // CIDR is a string alias.
 type CIDR string
 
+// Hibernation contains information whether the Shoot is suspended or not. A hibernated Shoot keeps its control
+// plane and infrastructure, but its machines are deleted and re-created when it is woken up again.
+type Hibernation struct {
+	// Enabled is true if the Shoot's desired state is hibernated, false otherwise.
+	Enabled bool `json:"enabled"`
+}
+
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
 type Kubernetes struct {
 	// AllowPrivilegedContainers indicates whether privileged containers are allowed in the Shoot (default: true).
@@ -998,6 +1696,50 @@ type KubeAPIServerConfig struct {
 	// OIDCConfig contains configuration settings for the OIDC provider.
 	// +optional
 	OIDCConfig *OIDCConfig `json:"oidcConfig,omitempty"`
+	// Requests contains configuration settings for the request throughput of the kube-apiserver.
+	// +optional
+	Requests *KubeAPIServerRequests `json:"requests,omitempty"`
+	// WatchCacheSizes contains configuration settings for the watch caches of the kube-apiserver.
+	// +optional
+	WatchCacheSizes *WatchCacheSizes `json:"watchCacheSizes,omitempty"`
+	// EventTTL is the amount of time events are retained (defaults to 1h).
+	// +optional
+	EventTTL *metav1.Duration `json:"eventTTL,omitempty"`
+	// LoadBalancerIP is a static IP address which has been reserved in the cloud provider account of the Seed
+	// and which is assigned to the load balancer exposing the kube-apiserver. It remains stable when the load
+	// balancer is recreated. Not supported on AWS.
+	// +optional
+	LoadBalancerIP *string `json:"loadBalancerIP,omitempty"`
+	// ServiceAnnotations is a map of additional annotations for the Service of type LoadBalancer which exposes the
+	// kube-apiserver in the Seed, e.g. to select the type or the SKU of the load balancer or to make it internal. Only
+	// annotations of the cloud provider of the Seed are allowed.
+	// +optional
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+}
+
+// KubeAPIServerRequests contains configuration settings for the request throughput of the kube-apiserver. If a value
+// is not set, a default derived from the maximum size of the Shoot cluster is used.
+type KubeAPIServerRequests struct {
+	// MaxNonMutatingInflight is the maximum number of non-mutating requests in flight at a given time. When the
+	// kube-apiserver exceeds this, it rejects requests. Zero means no limit.
+	// +optional
+	MaxNonMutatingInflight *int `json:"maxNonMutatingInflight,omitempty"`
+	// MaxMutatingInflight is the maximum number of mutating requests in flight at a given time. When the
+	// kube-apiserver exceeds this, it rejects requests. Zero means no limit.
+	// +optional
+	MaxMutatingInflight *int `json:"maxMutatingInflight,omitempty"`
+}
+
+// WatchCacheSizes contains configuration settings for the watch caches of the kube-apiserver.
+type WatchCacheSizes struct {
+	// Default is the size of the watch caches of resources without an explicit size. If not set, a default derived
+	// from the maximum size of the Shoot cluster is used. Zero disables the watch caches of these resources.
+	// +optional
+	Default *int `json:"default,omitempty"`
+	// Resources maps resources (lowercase plural, optionally qualified with their API group as in `resource.group`)
+	// to the sizes of their watch caches. Resources qualified with an API group require Kubernetes 1.10 or later.
+	// +optional
+	Resources map[string]int `json:"resources,omitempty"`
 }
 
 // OIDCConfig contains configuration settings for the OIDC provider.
@@ -1052,6 +1794,20 @@ type Maintenance struct {
 	// AutoUpdate contains information about which constraints should be automatically updated.
 	// +optional
 	AutoUpdate *MaintenanceAutoUpdate `json:"autoUpdate,omitempty"`
+	// ReplaceMachinesOnCredentialsChange indicates whether the machines are replaced by a rolling update when the
+	// cloud provider credentials change. Otherwise, the credentials of the existing machines are updated in place.
+	// +optional
+	ReplaceMachinesOnCredentialsChange *bool `json:"replaceMachinesOnCredentialsChange,omitempty"`
+	// ReplaceMachinesOnCloudConfigChange indicates whether the machines of a worker group are replaced by a rolling
+	// update when its cloud config (e.g., the kubelet parameters or the CA bundle) changes. Otherwise, the changes are
+	// only applied in place by the cloud config downloader on the existing machines.
+	// +optional
+	ReplaceMachinesOnCloudConfigChange *bool `json:"replaceMachinesOnCloudConfigChange,omitempty"`
+	// ReplaceMachinesInTimeWindow indicates whether changes which replace the machines of a worker group (e.g., a new
+	// machine image, machine type or cloud config) are deferred until the maintenance time window. Other changes, e.g.
+	// of the number of machines, are still applied immediately.
+	// +optional
+	ReplaceMachinesInTimeWindow *bool `json:"replaceMachinesInTimeWindow,omitempty"`
 	// TimeWindow contains information about the time window for maintenance operations.
 	// +optional
 	TimeWindow *MaintenanceTimeWindow `json:"timeWindow,omitempty"`
@@ -1111,6 +1867,20 @@ type LastOperation struct {
 	State ShootLastOperationState `json:"state"`
 	// Type of the last operation, one of Create, Reconcile, Update, Delete.
 	Type ShootLastOperationType `json:"type"`
+	// Steps contains the durations of the steps of the last operation which have been executed so far, in the order
+	// in which they have been completed.
+	// +optional
+	Steps []LastOperationStep `json:"steps,omitempty"`
+}
+
+// LastOperationStep contains the duration of a single step of an operation.
+type LastOperationStep struct {
+	// Name is the name of the step.
+	Name string `json:"name"`
+	// Duration is the time it took to execute the step, including all retries.
+	Duration metav1.Duration `json:"duration"`
+	// State of the step, one of Succeeded, Error.
+	State ShootLastOperationState `json:"state"`
 }
 
 // ShootLastOperationType is a string alias.
@@ -1147,6 +1917,9 @@ const (
 type LastError struct {
 	// A human readable message indicating details about the last error.
 	Description string `json:"description"`
+	// TaskID is the identifier of the task of the operation in which the error occurred, if any.
+	// +optional
+	TaskID string `json:"taskID,omitempty"`
 	// Well-defined error codes of the last error(s).
 	// +optional
 	Codes []ErrorCode `json:"codes,omitempty"`
@@ -1164,6 +1937,9 @@ const (
 	ErrorInfraQuotaExceeded ErrorCode = "ERR_INFRA_QUOTA_EXCEEDED"
 	// ErrorInfraDependencies indicates that the last error occurred due to dependent objects on the cloud provider level.
 	ErrorInfraDependencies ErrorCode = "ERR_INFRA_DEPENDENCIES"
+	// ErrorConfigurationProblem indicates that the last error occurred due to a problem with the configuration of the
+	// Shoot or its cloud profile which cannot be resolved by retrying the operation.
+	ErrorConfigurationProblem ErrorCode = "ERR_CONFIGURATION_PROBLEM"
 )
 
 const (
@@ -1179,10 +1955,41 @@ const (
 	EventDeleted = "Deleted"
 	// EventDeleteError indicates that the a Delete operation failed.
 	EventDeleteError = "DeleteError"
+	// ShootEventDependenciesPending indicates that a Reconcile operation is postponed until the Shoots the Shoot depends
+	// on have been reconciled successfully.
+	ShootEventDependenciesPending = "DependenciesPending"
 	// ShootEventMaintenanceDone indicates that a maintenance operation has been performed.
 	ShootEventMaintenanceDone = "MaintenanceDone"
 	// ShootEventMaintenanceError indicates that a maintenance operation has failed.
 	ShootEventMaintenanceError = "MaintenanceError"
+	// ShootEventMachineRolloutProgressing indicates that the machines of a Shoot are being rolled out.
+	ShootEventMachineRolloutProgressing = "MachineRolloutProgressing"
+	// ShootEventMachineRolloutAvailable indicates that the machines of a Shoot have been rolled out.
+	ShootEventMachineRolloutAvailable = "MachineRolloutAvailable"
+	// ShootEventMachineRolloutError indicates that the machine-controller-manager reported an error for a machine
+	// deployment of a Shoot.
+	ShootEventMachineRolloutError = "MachineRolloutError"
+	// ShootEventMachineRolloutFailed indicates that the machines of a Shoot have not become available in time.
+	ShootEventMachineRolloutFailed = "MachineRolloutFailed"
+	// ShootEventNetworkCapacityLow indicates that the utilization of a network of a Shoot has exceeded the threshold.
+	ShootEventNetworkCapacityLow = "NetworkCapacityLow"
+	// ShootEventHealthChanged indicates that the status of a health condition of a Shoot has changed.
+	ShootEventHealthChanged = "HealthChanged"
+	// ShootEventMachinesReplaced indicates that machines of a Shoot have been replaced because their nodes were
+	// about to be terminated by the cloud provider.
+	ShootEventMachinesReplaced = "MachinesReplaced"
+	// ShootEventRecoveryDrillPassed indicates that a recovery drill of a Shoot has passed.
+	ShootEventRecoveryDrillPassed = "RecoveryDrillPassed"
+	// ShootEventRecoveryDrillFailed indicates that a recovery drill of a Shoot has failed.
+	ShootEventRecoveryDrillFailed = "RecoveryDrillFailed"
+	// SeedAccessRequestEventGranted indicates that the access requested by a SeedAccessRequest has been granted.
+	SeedAccessRequestEventGranted = "AccessGranted"
+	// SeedAccessRequestEventRevoked indicates that the access granted by a SeedAccessRequest has been revoked.
+	SeedAccessRequestEventRevoked = "AccessRevoked"
+	// ShootOperationEventTriggered indicates that a ShootOperation has triggered the operation of a Shoot.
+	ShootOperationEventTriggered = "OperationTriggered"
+	// ShootOperationEventCompleted indicates that the operations of all Shoots selected by a ShootOperation have completed.
+	ShootOperationEventCompleted = "OperationsCompleted"
 )
 
 const (
@@ -1218,12 +2025,24 @@ type ConditionType string
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable ConditionType = "Available"
+	// SeedKubeconfigValid is a constant for a condition type indicating whether the kubeconfig of the Seed cluster
+	// grants access to it.
+	SeedKubeconfigValid ConditionType = "KubeconfigValid"
+	// ShootCloudProfileCompliant is a constant for a condition type indicating whether the Shoot still complies with
+	// the constraints of its CloudProfile.
+	ShootCloudProfileCompliant ConditionType = "CloudProfileCompliant"
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
 	// ShootEveryNodeReady is a constant for a condition type indicating the node health.
 	ShootEveryNodeReady ConditionType = "EveryNodeReady"
 	// ShootSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	ShootSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
+	// ShootNetworkCapacitySufficient is a constant for a condition type indicating whether the pod, service and node
+	// networks of the Shoot have enough free capacity.
+	ShootNetworkCapacitySufficient ConditionType = "NetworkCapacitySufficient"
+	// ShootOSUpdatesApplied is a constant for a condition type indicating whether all nodes of the worker groups with
+	// automated operating system updates have been rebooted into the installed updates.
+	ShootOSUpdatesApplied ConditionType = "OSUpdatesApplied"
 	// ConditionCheckError is a constant for indicating that a condition could not be checked.
 	ConditionCheckError = "ConditionCheckError"
 )
@@ -1281,3 +2100,253 @@ type BackupInfrastructureStatus struct {
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
+
+////////////////////////////////////////////////////
+//              Seed Access Requests              //
+////////////////////////////////////////////////////
+
+// SeedAccessRequest is a request of an operator for temporary access to the namespace of a Shoot in its Seed cluster.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SHOOT:.spec.shootName,REQUESTER:.status.requester,PHASE:.status.phase,EXPIRATION:.status.expirationTimestamp
+type SeedAccessRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the SeedAccessRequest.
+	// +optional
+	Spec SeedAccessRequestSpec `json:"spec,omitempty"`
+	// Most recently observed status of the SeedAccessRequest.
+	// +optional
+	Status SeedAccessRequestStatus `json:"status,omitempty"`
+}
+
+// SeedAccessRequestList is a list of SeedAccessRequest objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SeedAccessRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of SeedAccessRequests.
+	Items []SeedAccessRequest `json:"items"`
+}
+
+// SeedAccessRequestSpec is the specification of a SeedAccessRequest.
+type SeedAccessRequestSpec struct {
+	// ShootName is the name of the Shoot in the same namespace whose namespace in the Seed cluster shall be accessed.
+	ShootName string `json:"shootName"`
+	// Reason is a human readable justification for the access. It is recorded for auditing purposes.
+	Reason string `json:"reason"`
+	// Duration is the time span after which the access is revoked.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+// SeedAccessRequestStatus holds the most recently observed status of the SeedAccessRequest.
+type SeedAccessRequestStatus struct {
+	// Requester is the name of the user who created the SeedAccessRequest.
+	// +optional
+	Requester string `json:"requester,omitempty"`
+	// Phase is the current phase of the SeedAccessRequest.
+	// +optional
+	Phase SeedAccessRequestPhase `json:"phase,omitempty"`
+	// Message is a human readable message about the current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// KubeconfigSecretName is the name of the secret which contains the kubeconfig for the granted access. Only the
+	// requester may read it.
+	// +optional
+	KubeconfigSecretName string `json:"kubeconfigSecretName,omitempty"`
+	// KubeconfigSecretNamespace is the namespace of the secret which contains the kubeconfig for the granted access.
+	// +optional
+	KubeconfigSecretNamespace string `json:"kubeconfigSecretNamespace,omitempty"`
+	// ExpirationTimestamp is the time at which the access is revoked.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty"`
+}
+
+// SeedAccessRequestPhase is a string alias.
+type SeedAccessRequestPhase string
+
+const (
+	// SeedAccessRequestPhasePending indicates that the access has not yet been granted.
+	SeedAccessRequestPhasePending SeedAccessRequestPhase = "Pending"
+	// SeedAccessRequestPhaseGranted indicates that the access has been granted and the kubeconfig is available.
+	SeedAccessRequestPhaseGranted SeedAccessRequestPhase = "Granted"
+	// SeedAccessRequestPhaseExpired indicates that the access has been revoked.
+	SeedAccessRequestPhaseExpired SeedAccessRequestPhase = "Expired"
+	// SeedAccessRequestPhaseFailed indicates that the access could not be granted.
+	SeedAccessRequestPhaseFailed SeedAccessRequestPhase = "Failed"
+)
+
+////////////////////////////////////////////////////
+//                Shoot Operations                //
+////////////////////////////////////////////////////
+
+// ShootOperation triggers an operation for all Shoots in its namespace which match a label selector. The Shoots are
+// processed with a limited concurrency, i.e. only a bounded number of operations is running at the same time.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,OPERATION:.spec.operation,PHASE:.status.phase,CREATION TIMESTAMP:.metadata.creationTimestamp
+type ShootOperation struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the ShootOperation.
+	// +optional
+	Spec ShootOperationSpec `json:"spec,omitempty"`
+	// Most recently observed status of the ShootOperation.
+	// +optional
+	Status ShootOperationStatus `json:"status,omitempty"`
+}
+
+// ShootOperationList is a list of ShootOperation objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ShootOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of ShootOperations.
+	Items []ShootOperation `json:"items"`
+}
+
+// ShootOperationSpec is the specification of a ShootOperation.
+type ShootOperationSpec struct {
+	// Operation is the operation which is triggered for the selected Shoots, one of reconcile, retry.
+	Operation ShootOperationType `json:"operation"`
+	// Selector is a label query over the Shoots in the namespace of the ShootOperation. An empty selector selects
+	// all Shoots of the namespace.
+	Selector *metav1.LabelSelector `json:"selector"`
+	// MaxConcurrency is the maximum number of selected Shoots whose operations are running at the same time.
+	// +optional
+	MaxConcurrency *int `json:"maxConcurrency,omitempty"`
+}
+
+// ShootOperationType is a string alias.
+type ShootOperationType string
+
+const (
+	// ShootOperationTypeReconcile indicates that the selected Shoots shall be reconciled although their
+	// specification did not change.
+	ShootOperationTypeReconcile ShootOperationType = "reconcile"
+	// ShootOperationTypeRetry indicates that the failed operations of the selected Shoots shall be retried.
+	ShootOperationTypeRetry ShootOperationType = "retry"
+)
+
+// ShootOperationStatus holds the most recently observed status of the ShootOperation.
+type ShootOperationStatus struct {
+	// Phase is the current phase of the ShootOperation.
+	// +optional
+	Phase ShootOperationPhase `json:"phase,omitempty"`
+	// StartTimestamp is the time at which the Shoots have been selected.
+	// +optional
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// CompletionTimestamp is the time at which the operations of all selected Shoots have been completed.
+	// +optional
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+	// Shoots contains the state of the operation of every selected Shoot.
+	// +optional
+	Shoots []ShootOperationShootStatus `json:"shoots,omitempty"`
+}
+
+// ShootOperationShootStatus holds the state of the operation of a single Shoot selected by a ShootOperation.
+type ShootOperationShootStatus struct {
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// State is the state of the operation of the Shoot.
+	State ShootOperationShootState `json:"state"`
+	// Generation is the generation of the Shoot after the operation has been triggered. The operation is completed
+	// once the Shoot controller has observed this generation.
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+	// Message is a human readable message about the state.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ShootOperationPhase is a string alias.
+type ShootOperationPhase string
+
+const (
+	// ShootOperationPhasePending indicates that the Shoots have not yet been selected.
+	ShootOperationPhasePending ShootOperationPhase = "Pending"
+	// ShootOperationPhaseRunning indicates that the operations of the selected Shoots are being triggered.
+	ShootOperationPhaseRunning ShootOperationPhase = "Running"
+	// ShootOperationPhaseSucceeded indicates that the operations of all selected Shoots have succeeded or were skipped.
+	ShootOperationPhaseSucceeded ShootOperationPhase = "Succeeded"
+	// ShootOperationPhaseFailed indicates that the operation of at least one selected Shoot has failed.
+	ShootOperationPhaseFailed ShootOperationPhase = "Failed"
+)
+
+// ShootOperationShootState is a string alias.
+type ShootOperationShootState string
+
+const (
+	// ShootOperationShootStatePending indicates that the operation of the Shoot has not yet been triggered.
+	ShootOperationShootStatePending ShootOperationShootState = "Pending"
+	// ShootOperationShootStateProcessing indicates that the operation of the Shoot has been triggered.
+	ShootOperationShootStateProcessing ShootOperationShootState = "Processing"
+	// ShootOperationShootStateSucceeded indicates that the operation of the Shoot has succeeded.
+	ShootOperationShootStateSucceeded ShootOperationShootState = "Succeeded"
+	// ShootOperationShootStateFailed indicates that the operation of the Shoot has failed.
+	ShootOperationShootStateFailed ShootOperationShootState = "Failed"
+	// ShootOperationShootStateSkipped indicates that the operation is not applicable to the Shoot.
+	ShootOperationShootStateSkipped ShootOperationShootState = "Skipped"
+)
+
+////////////////////////////////////////////////////
+//                 Region Policies                //
+////////////////////////////////////////////////////
+
+// RegionPolicy restricts the regions of a CloudProfile which the projects selected by it may use for their Shoots,
+// e.g. for reasons of data residency. A Shoot may only use a region of a CloudProfile which is listed by at least one
+// of the RegionPolicies for the CloudProfile which select its project. If no RegionPolicy selects the project, all
+// regions of the CloudProfile may be used.
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=x-kubernetes-print-columns:custom-columns=NAME:.metadata.name,CLOUD PROFILE:.spec.cloudProfileName,REGIONS:.spec.regions,CREATION TIMESTAMP:.metadata.creationTimestamp
+type RegionPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the RegionPolicy.
+	// +optional
+	Spec RegionPolicySpec `json:"spec,omitempty"`
+}
+
+// RegionPolicyList is a list of RegionPolicy objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RegionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of RegionPolicies.
+	Items []RegionPolicy `json:"items"`
+}
+
+// RegionPolicySpec is the specification of a RegionPolicy.
+type RegionPolicySpec struct {
+	// CloudProfileName is the name of the CloudProfile whose regions are restricted.
+	CloudProfileName string `json:"cloudProfileName"`
+	// ProjectSelector is a label query over the project namespaces to which the RegionPolicy applies. An empty
+	// selector selects all projects.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+	// Regions is the list of regions of the CloudProfile which the selected projects may use.
+	Regions []string `json:"regions"`
+}
+
+const (
+	// DefaultSeedAccessDuration is a constant for the default duration of an access granted by a SeedAccessRequest.
+	DefaultSeedAccessDuration = time.Hour
+	// DefaultShootOperationMaxConcurrency is a constant for the default number of Shoots whose operations are
+	// running at the same time for a ShootOperation.
+	DefaultShootOperationMaxConcurrency = 5
+)
@@ -13,6 +13,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -89,6 +90,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_DNS_To_v1beta1_DNS,
 		Convert_v1beta1_DNSProviderConstraint_To_garden_DNSProviderConstraint,
 		Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint,
+		Convert_v1beta1_ExternalWorker_To_garden_ExternalWorker,
+		Convert_garden_ExternalWorker_To_v1beta1_ExternalWorker,
 		Convert_v1beta1_GCPCloud_To_garden_GCPCloud,
 		Convert_garden_GCPCloud_To_v1beta1_GCPCloud,
 		Convert_v1beta1_GCPConstraints_To_garden_GCPConstraints,
@@ -109,6 +112,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_Heapster_To_v1beta1_Heapster,
 		Convert_v1beta1_HelmTiller_To_garden_HelmTiller,
 		Convert_garden_HelmTiller_To_v1beta1_HelmTiller,
+		Convert_v1beta1_Hibernation_To_garden_Hibernation,
+		Convert_garden_Hibernation_To_v1beta1_Hibernation,
 		Convert_v1beta1_K8SNetworks_To_garden_K8SNetworks,
 		Convert_garden_K8SNetworks_To_v1beta1_K8SNetworks,
 		Convert_v1beta1_Kube2IAM_To_garden_Kube2IAM,
@@ -117,6 +122,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_Kube2IAMRole_To_v1beta1_Kube2IAMRole,
 		Convert_v1beta1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig,
 		Convert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig,
+		Convert_v1beta1_KubeAPIServerRequests_To_garden_KubeAPIServerRequests,
+		Convert_garden_KubeAPIServerRequests_To_v1beta1_KubeAPIServerRequests,
 		Convert_v1beta1_KubeControllerManagerConfig_To_garden_KubeControllerManagerConfig,
 		Convert_garden_KubeControllerManagerConfig_To_v1beta1_KubeControllerManagerConfig,
 		Convert_v1beta1_KubeLego_To_garden_KubeLego,
@@ -139,6 +146,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_LastError_To_v1beta1_LastError,
 		Convert_v1beta1_LastOperation_To_garden_LastOperation,
 		Convert_garden_LastOperation_To_v1beta1_LastOperation,
+		Convert_v1beta1_LastOperationStep_To_garden_LastOperationStep,
+		Convert_garden_LastOperationStep_To_v1beta1_LastOperationStep,
 		Convert_v1beta1_Local_To_garden_Local,
 		Convert_garden_Local_To_v1beta1_Local,
 		Convert_v1beta1_LocalConstraints_To_garden_LocalConstraints,
@@ -147,6 +156,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_LocalNetworks_To_v1beta1_LocalNetworks,
 		Convert_v1beta1_LocalProfile_To_garden_LocalProfile,
 		Convert_garden_LocalProfile_To_v1beta1_LocalProfile,
+		Convert_v1beta1_LocalWorker_To_garden_LocalWorker,
+		Convert_garden_LocalWorker_To_v1beta1_LocalWorker,
 		Convert_v1beta1_MachineType_To_garden_MachineType,
 		Convert_garden_MachineType_To_v1beta1_MachineType,
 		Convert_v1beta1_Maintenance_To_garden_Maintenance,
@@ -157,6 +168,8 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow,
 		Convert_v1beta1_Monocular_To_garden_Monocular,
 		Convert_garden_Monocular_To_v1beta1_Monocular,
+		Convert_v1beta1_NetworkPolicies_To_garden_NetworkPolicies,
+		Convert_garden_NetworkPolicies_To_v1beta1_NetworkPolicies,
 		Convert_v1beta1_NginxIngress_To_garden_NginxIngress,
 		Convert_garden_NginxIngress_To_v1beta1_NginxIngress,
 		Convert_v1beta1_OIDCConfig_To_garden_OIDCConfig,
@@ -181,40 +194,114 @@ func RegisterConversions(scheme *runtime.Scheme) error {
 		Convert_garden_OpenStackRouter_To_v1beta1_OpenStackRouter,
 		Convert_v1beta1_OpenStackWorker_To_garden_OpenStackWorker,
 		Convert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker,
+		Convert_v1beta1_PacketCloud_To_garden_PacketCloud,
+		Convert_garden_PacketCloud_To_v1beta1_PacketCloud,
+		Convert_v1beta1_PacketConstraints_To_garden_PacketConstraints,
+		Convert_garden_PacketConstraints_To_v1beta1_PacketConstraints,
+		Convert_v1beta1_PacketMachineImage_To_garden_PacketMachineImage,
+		Convert_garden_PacketMachineImage_To_v1beta1_PacketMachineImage,
+		Convert_v1beta1_PacketNetworks_To_garden_PacketNetworks,
+		Convert_garden_PacketNetworks_To_v1beta1_PacketNetworks,
+		Convert_v1beta1_PacketProfile_To_garden_PacketProfile,
+		Convert_garden_PacketProfile_To_v1beta1_PacketProfile,
+		Convert_v1beta1_PacketWorker_To_garden_PacketWorker,
+		Convert_garden_PacketWorker_To_v1beta1_PacketWorker,
 		Convert_v1beta1_Quota_To_garden_Quota,
 		Convert_garden_Quota_To_v1beta1_Quota,
 		Convert_v1beta1_QuotaList_To_garden_QuotaList,
 		Convert_garden_QuotaList_To_v1beta1_QuotaList,
 		Convert_v1beta1_QuotaSpec_To_garden_QuotaSpec,
 		Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec,
+		Convert_v1beta1_RegionPolicy_To_garden_RegionPolicy,
+		Convert_garden_RegionPolicy_To_v1beta1_RegionPolicy,
+		Convert_v1beta1_RegionPolicyList_To_garden_RegionPolicyList,
+		Convert_garden_RegionPolicyList_To_v1beta1_RegionPolicyList,
+		Convert_v1beta1_RegionPolicySpec_To_garden_RegionPolicySpec,
+		Convert_garden_RegionPolicySpec_To_v1beta1_RegionPolicySpec,
+		Convert_v1beta1_ScaleDownBudget_To_garden_ScaleDownBudget,
+		Convert_garden_ScaleDownBudget_To_v1beta1_ScaleDownBudget,
 		Convert_v1beta1_SecretBinding_To_garden_SecretBinding,
 		Convert_garden_SecretBinding_To_v1beta1_SecretBinding,
 		Convert_v1beta1_SecretBindingList_To_garden_SecretBindingList,
 		Convert_garden_SecretBindingList_To_v1beta1_SecretBindingList,
 		Convert_v1beta1_Seed_To_garden_Seed,
 		Convert_garden_Seed_To_v1beta1_Seed,
+		Convert_v1beta1_SeedAccessRequest_To_garden_SeedAccessRequest,
+		Convert_garden_SeedAccessRequest_To_v1beta1_SeedAccessRequest,
+		Convert_v1beta1_SeedAccessRequestList_To_garden_SeedAccessRequestList,
+		Convert_garden_SeedAccessRequestList_To_v1beta1_SeedAccessRequestList,
+		Convert_v1beta1_SeedAccessRequestSpec_To_garden_SeedAccessRequestSpec,
+		Convert_garden_SeedAccessRequestSpec_To_v1beta1_SeedAccessRequestSpec,
+		Convert_v1beta1_SeedAccessRequestStatus_To_garden_SeedAccessRequestStatus,
+		Convert_garden_SeedAccessRequestStatus_To_v1beta1_SeedAccessRequestStatus,
 		Convert_v1beta1_SeedCloud_To_garden_SeedCloud,
 		Convert_garden_SeedCloud_To_v1beta1_SeedCloud,
+		Convert_v1beta1_SeedComponentStatus_To_garden_SeedComponentStatus,
+		Convert_garden_SeedComponentStatus_To_v1beta1_SeedComponentStatus,
 		Convert_v1beta1_SeedList_To_garden_SeedList,
 		Convert_garden_SeedList_To_v1beta1_SeedList,
 		Convert_v1beta1_SeedNetworks_To_garden_SeedNetworks,
 		Convert_garden_SeedNetworks_To_v1beta1_SeedNetworks,
+		Convert_v1beta1_SeedShootKubernetesVersions_To_garden_SeedShootKubernetesVersions,
+		Convert_garden_SeedShootKubernetesVersions_To_v1beta1_SeedShootKubernetesVersions,
 		Convert_v1beta1_SeedSpec_To_garden_SeedSpec,
 		Convert_garden_SeedSpec_To_v1beta1_SeedSpec,
 		Convert_v1beta1_SeedStatus_To_garden_SeedStatus,
 		Convert_garden_SeedStatus_To_v1beta1_SeedStatus,
 		Convert_v1beta1_Shoot_To_garden_Shoot,
 		Convert_garden_Shoot_To_v1beta1_Shoot,
+		Convert_v1beta1_ShootCloudStatus_To_garden_ShootCloudStatus,
+		Convert_garden_ShootCloudStatus_To_v1beta1_ShootCloudStatus,
+		Convert_v1beta1_ShootEtcdOperationLock_To_garden_ShootEtcdOperationLock,
+		Convert_garden_ShootEtcdOperationLock_To_v1beta1_ShootEtcdOperationLock,
+		Convert_v1beta1_ShootInventory_To_garden_ShootInventory,
+		Convert_garden_ShootInventory_To_v1beta1_ShootInventory,
+		Convert_v1beta1_ShootInventoryResource_To_garden_ShootInventoryResource,
+		Convert_garden_ShootInventoryResource_To_v1beta1_ShootInventoryResource,
+		Convert_v1beta1_ShootKubernetesUpgrade_To_garden_ShootKubernetesUpgrade,
+		Convert_garden_ShootKubernetesUpgrade_To_v1beta1_ShootKubernetesUpgrade,
 		Convert_v1beta1_ShootList_To_garden_ShootList,
 		Convert_garden_ShootList_To_v1beta1_ShootList,
+		Convert_v1beta1_ShootMachineCanaryStatus_To_garden_ShootMachineCanaryStatus,
+		Convert_garden_ShootMachineCanaryStatus_To_v1beta1_ShootMachineCanaryStatus,
+		Convert_v1beta1_ShootMachineDeploymentStatus_To_garden_ShootMachineDeploymentStatus,
+		Convert_garden_ShootMachineDeploymentStatus_To_v1beta1_ShootMachineDeploymentStatus,
+		Convert_v1beta1_ShootMachinesStatus_To_garden_ShootMachinesStatus,
+		Convert_garden_ShootMachinesStatus_To_v1beta1_ShootMachinesStatus,
+		Convert_v1beta1_ShootMonitoring_To_garden_ShootMonitoring,
+		Convert_garden_ShootMonitoring_To_v1beta1_ShootMonitoring,
+		Convert_v1beta1_ShootOperation_To_garden_ShootOperation,
+		Convert_garden_ShootOperation_To_v1beta1_ShootOperation,
+		Convert_v1beta1_ShootOperationList_To_garden_ShootOperationList,
+		Convert_garden_ShootOperationList_To_v1beta1_ShootOperationList,
+		Convert_v1beta1_ShootOperationShootStatus_To_garden_ShootOperationShootStatus,
+		Convert_garden_ShootOperationShootStatus_To_v1beta1_ShootOperationShootStatus,
+		Convert_v1beta1_ShootOperationSpec_To_garden_ShootOperationSpec,
+		Convert_garden_ShootOperationSpec_To_v1beta1_ShootOperationSpec,
+		Convert_v1beta1_ShootOperationStatus_To_garden_ShootOperationStatus,
+		Convert_garden_ShootOperationStatus_To_v1beta1_ShootOperationStatus,
 		Convert_v1beta1_ShootSpec_To_garden_ShootSpec,
 		Convert_garden_ShootSpec_To_v1beta1_ShootSpec,
 		Convert_v1beta1_ShootStatus_To_garden_ShootStatus,
 		Convert_garden_ShootStatus_To_v1beta1_ShootStatus,
 		Convert_v1beta1_VolumeType_To_garden_VolumeType,
 		Convert_garden_VolumeType_To_v1beta1_VolumeType,
+		Convert_v1beta1_WatchCacheSizes_To_garden_WatchCacheSizes,
+		Convert_garden_WatchCacheSizes_To_v1beta1_WatchCacheSizes,
 		Convert_v1beta1_Worker_To_garden_Worker,
 		Convert_garden_Worker_To_v1beta1_Worker,
+		Convert_v1beta1_WorkerCanary_To_garden_WorkerCanary,
+		Convert_garden_WorkerCanary_To_v1beta1_WorkerCanary,
+		Convert_v1beta1_WorkerFirewallRule_To_garden_WorkerFirewallRule,
+		Convert_garden_WorkerFirewallRule_To_v1beta1_WorkerFirewallRule,
+		Convert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig,
+		Convert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig,
+		Convert_v1beta1_WorkerLocalSSDs_To_garden_WorkerLocalSSDs,
+		Convert_garden_WorkerLocalSSDs_To_v1beta1_WorkerLocalSSDs,
+		Convert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates,
+		Convert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates,
+		Convert_v1beta1_WorkerSpot_To_garden_WorkerSpot,
+		Convert_garden_WorkerSpot_To_v1beta1_WorkerSpot,
 		Convert_v1beta1_Zone_To_garden_Zone,
 		Convert_garden_Zone_To_v1beta1_Zone,
 	)
@@ -309,6 +396,7 @@ func Convert_garden_AWSMachineImage_To_v1beta1_AWSMachineImage(in *garden.AWSMac
 func autoConvert_v1beta1_AWSMachineImageMapping_To_garden_AWSMachineImageMapping(in *AWSMachineImageMapping, out *garden.AWSMachineImageMapping, s conversion.Scope) error {
 	out.Name = garden.MachineImageName(in.Name)
 	out.Regions = *(*[]garden.AWSRegionalMachineImage)(unsafe.Pointer(&in.Regions))
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -320,6 +408,7 @@ func Convert_v1beta1_AWSMachineImageMapping_To_garden_AWSMachineImageMapping(in
 func autoConvert_garden_AWSMachineImageMapping_To_v1beta1_AWSMachineImageMapping(in *garden.AWSMachineImageMapping, out *AWSMachineImageMapping, s conversion.Scope) error {
 	out.Name = MachineImageName(in.Name)
 	out.Regions = *(*[]AWSRegionalMachineImage)(unsafe.Pointer(&in.Regions))
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -391,6 +480,7 @@ func Convert_garden_AWSProfile_To_v1beta1_AWSProfile(in *garden.AWSProfile, out
 func autoConvert_v1beta1_AWSRegionalMachineImage_To_garden_AWSRegionalMachineImage(in *AWSRegionalMachineImage, out *garden.AWSRegionalMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AMI = in.AMI
+	out.WarmAMI = (*string)(unsafe.Pointer(in.WarmAMI))
 	return nil
 }
 
@@ -402,6 +492,7 @@ func Convert_v1beta1_AWSRegionalMachineImage_To_garden_AWSRegionalMachineImage(i
 func autoConvert_garden_AWSRegionalMachineImage_To_v1beta1_AWSRegionalMachineImage(in *garden.AWSRegionalMachineImage, out *AWSRegionalMachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.AMI = in.AMI
+	out.WarmAMI = (*string)(unsafe.Pointer(in.WarmAMI))
 	return nil
 }
 
@@ -488,6 +579,7 @@ func autoConvert_v1beta1_Addons_To_garden_Addons(in *Addons, out *garden.Addons,
 	out.KubernetesDashboard = (*garden.KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*garden.NginxIngress)(unsafe.Pointer(in.NginxIngress))
 	out.Monocular = (*garden.Monocular)(unsafe.Pointer(in.Monocular))
+	out.NetworkPolicies = (*garden.NetworkPolicies)(unsafe.Pointer(in.NetworkPolicies))
 	return nil
 }
 
@@ -504,6 +596,7 @@ func autoConvert_garden_Addons_To_v1beta1_Addons(in *garden.Addons, out *Addons,
 	out.KubernetesDashboard = (*KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*NginxIngress)(unsafe.Pointer(in.NginxIngress))
 	out.Monocular = (*Monocular)(unsafe.Pointer(in.Monocular))
+	out.NetworkPolicies = (*NetworkPolicies)(unsafe.Pointer(in.NetworkPolicies))
 	return nil
 }
 
@@ -602,6 +695,7 @@ func autoConvert_v1beta1_AzureMachineImage_To_garden_AzureMachineImage(in *Azure
 	out.Offer = in.Offer
 	out.SKU = in.SKU
 	out.Version = in.Version
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -616,6 +710,7 @@ func autoConvert_garden_AzureMachineImage_To_v1beta1_AzureMachineImage(in *garde
 	out.Offer = in.Offer
 	out.SKU = in.SKU
 	out.Version = in.Version
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -909,7 +1004,10 @@ func autoConvert_v1beta1_Cloud_To_garden_Cloud(in *Cloud, out *garden.Cloud, s c
 	out.Azure = (*garden.AzureCloud)(unsafe.Pointer(in.Azure))
 	out.GCP = (*garden.GCPCloud)(unsafe.Pointer(in.GCP))
 	out.OpenStack = (*garden.OpenStackCloud)(unsafe.Pointer(in.OpenStack))
+	out.Packet = (*garden.PacketCloud)(unsafe.Pointer(in.Packet))
 	out.Local = (*garden.Local)(unsafe.Pointer(in.Local))
+	out.ExternalWorkers = *(*[]garden.ExternalWorker)(unsafe.Pointer(&in.ExternalWorkers))
+	out.ScaleDownBudget = (*garden.ScaleDownBudget)(unsafe.Pointer(in.ScaleDownBudget))
 	return nil
 }
 
@@ -927,7 +1025,10 @@ func autoConvert_garden_Cloud_To_v1beta1_Cloud(in *garden.Cloud, out *Cloud, s c
 	out.Azure = (*AzureCloud)(unsafe.Pointer(in.Azure))
 	out.GCP = (*GCPCloud)(unsafe.Pointer(in.GCP))
 	out.OpenStack = (*OpenStackCloud)(unsafe.Pointer(in.OpenStack))
+	out.Packet = (*PacketCloud)(unsafe.Pointer(in.Packet))
 	out.Local = (*Local)(unsafe.Pointer(in.Local))
+	out.ExternalWorkers = *(*[]ExternalWorker)(unsafe.Pointer(&in.ExternalWorkers))
+	out.ScaleDownBudget = (*ScaleDownBudget)(unsafe.Pointer(in.ScaleDownBudget))
 	return nil
 }
 
@@ -989,8 +1090,10 @@ func autoConvert_v1beta1_CloudProfileSpec_To_garden_CloudProfileSpec(in *CloudPr
 	out.Azure = (*garden.AzureProfile)(unsafe.Pointer(in.Azure))
 	out.GCP = (*garden.GCPProfile)(unsafe.Pointer(in.GCP))
 	out.OpenStack = (*garden.OpenStackProfile)(unsafe.Pointer(in.OpenStack))
+	out.Packet = (*garden.PacketProfile)(unsafe.Pointer(in.Packet))
 	out.Local = (*garden.LocalProfile)(unsafe.Pointer(in.Local))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.SeedSelectionStrategy = (*garden.SeedSelectionStrategy)(unsafe.Pointer(in.SeedSelectionStrategy))
 	return nil
 }
 
@@ -1004,8 +1107,10 @@ func autoConvert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *garden.
 	out.Azure = (*AzureProfile)(unsafe.Pointer(in.Azure))
 	out.GCP = (*GCPProfile)(unsafe.Pointer(in.GCP))
 	out.OpenStack = (*OpenStackProfile)(unsafe.Pointer(in.OpenStack))
+	out.Packet = (*PacketProfile)(unsafe.Pointer(in.Packet))
 	out.Local = (*LocalProfile)(unsafe.Pointer(in.Local))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.SeedSelectionStrategy = (*SeedSelectionStrategy)(unsafe.Pointer(in.SeedSelectionStrategy))
 	return nil
 }
 
@@ -1112,6 +1217,36 @@ func Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in *g
 	return autoConvert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in, out, s)
 }
 
+func autoConvert_v1beta1_ExternalWorker_To_garden_ExternalWorker(in *ExternalWorker, out *garden.ExternalWorker, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	out.NodeTaints = *(*[]core_v1.Taint)(unsafe.Pointer(&in.NodeTaints))
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	out.KubernetesVersion = (*string)(unsafe.Pointer(in.KubernetesVersion))
+	out.Kubelet = (*garden.WorkerKubeletConfig)(unsafe.Pointer(in.Kubelet))
+	return nil
+}
+
+// Convert_v1beta1_ExternalWorker_To_garden_ExternalWorker is an autogenerated conversion function.
+func Convert_v1beta1_ExternalWorker_To_garden_ExternalWorker(in *ExternalWorker, out *garden.ExternalWorker, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExternalWorker_To_garden_ExternalWorker(in, out, s)
+}
+
+func autoConvert_garden_ExternalWorker_To_v1beta1_ExternalWorker(in *garden.ExternalWorker, out *ExternalWorker, s conversion.Scope) error {
+	out.Name = in.Name
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	out.NodeTaints = *(*[]core_v1.Taint)(unsafe.Pointer(&in.NodeTaints))
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	out.KubernetesVersion = (*string)(unsafe.Pointer(in.KubernetesVersion))
+	out.Kubelet = (*WorkerKubeletConfig)(unsafe.Pointer(in.Kubelet))
+	return nil
+}
+
+// Convert_garden_ExternalWorker_To_v1beta1_ExternalWorker is an autogenerated conversion function.
+func Convert_garden_ExternalWorker_To_v1beta1_ExternalWorker(in *garden.ExternalWorker, out *ExternalWorker, s conversion.Scope) error {
+	return autoConvert_garden_ExternalWorker_To_v1beta1_ExternalWorker(in, out, s)
+}
+
 func autoConvert_v1beta1_GCPCloud_To_garden_GCPCloud(in *GCPCloud, out *garden.GCPCloud, s conversion.Scope) error {
 	out.MachineImage = (*garden.GCPMachineImage)(unsafe.Pointer(in.MachineImage))
 	if err := Convert_v1beta1_GCPNetworks_To_garden_GCPNetworks(&in.Networks, &out.Networks, s); err != nil {
@@ -1179,6 +1314,8 @@ func Convert_garden_GCPConstraints_To_v1beta1_GCPConstraints(in *garden.GCPConst
 func autoConvert_v1beta1_GCPMachineImage_To_garden_GCPMachineImage(in *GCPMachineImage, out *garden.GCPMachineImage, s conversion.Scope) error {
 	out.Name = garden.MachineImageName(in.Name)
 	out.Image = in.Image
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	out.WarmImage = (*string)(unsafe.Pointer(in.WarmImage))
 	return nil
 }
 
@@ -1190,6 +1327,8 @@ func Convert_v1beta1_GCPMachineImage_To_garden_GCPMachineImage(in *GCPMachineIma
 func autoConvert_garden_GCPMachineImage_To_v1beta1_GCPMachineImage(in *garden.GCPMachineImage, out *GCPMachineImage, s conversion.Scope) error {
 	out.Name = MachineImageName(in.Name)
 	out.Image = in.Image
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	out.WarmImage = (*string)(unsafe.Pointer(in.WarmImage))
 	return nil
 }
 
@@ -1276,6 +1415,7 @@ func autoConvert_v1beta1_GCPWorker_To_garden_GCPWorker(in *GCPWorker, out *garde
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
 	return nil
 }
 
@@ -1290,6 +1430,7 @@ func autoConvert_garden_GCPWorker_To_v1beta1_GCPWorker(in *garden.GCPWorker, out
 	}
 	out.VolumeType = in.VolumeType
 	out.VolumeSize = in.VolumeSize
+	out.Preemptible = (*bool)(unsafe.Pointer(in.Preemptible))
 	return nil
 }
 
@@ -1370,6 +1511,26 @@ func Convert_garden_HelmTiller_To_v1beta1_HelmTiller(in *garden.HelmTiller, out
 	return autoConvert_garden_HelmTiller_To_v1beta1_HelmTiller(in, out, s)
 }
 
+func autoConvert_v1beta1_Hibernation_To_garden_Hibernation(in *Hibernation, out *garden.Hibernation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_Hibernation_To_garden_Hibernation is an autogenerated conversion function.
+func Convert_v1beta1_Hibernation_To_garden_Hibernation(in *Hibernation, out *garden.Hibernation, s conversion.Scope) error {
+	return autoConvert_v1beta1_Hibernation_To_garden_Hibernation(in, out, s)
+}
+
+func autoConvert_garden_Hibernation_To_v1beta1_Hibernation(in *garden.Hibernation, out *Hibernation, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_garden_Hibernation_To_v1beta1_Hibernation is an autogenerated conversion function.
+func Convert_garden_Hibernation_To_v1beta1_Hibernation(in *garden.Hibernation, out *Hibernation, s conversion.Scope) error {
+	return autoConvert_garden_Hibernation_To_v1beta1_Hibernation(in, out, s)
+}
+
 func autoConvert_v1beta1_K8SNetworks_To_garden_K8SNetworks(in *K8SNetworks, out *garden.K8SNetworks, s conversion.Scope) error {
 	out.Nodes = (*garden.CIDR)(unsafe.Pointer(in.Nodes))
 	out.Pods = (*garden.CIDR)(unsafe.Pointer(in.Pods))
@@ -1450,6 +1611,11 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_garden_KubeAPIServerConfig(in *K
 	}
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.OIDCConfig = (*garden.OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
+	out.Requests = (*garden.KubeAPIServerRequests)(unsafe.Pointer(in.Requests))
+	out.WatchCacheSizes = (*garden.WatchCacheSizes)(unsafe.Pointer(in.WatchCacheSizes))
+	out.EventTTL = (*v1.Duration)(unsafe.Pointer(in.EventTTL))
+	out.LoadBalancerIP = (*string)(unsafe.Pointer(in.LoadBalancerIP))
+	out.ServiceAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAnnotations))
 	return nil
 }
 
@@ -1464,6 +1630,11 @@ func autoConvert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *g
 	}
 	out.RuntimeConfig = *(*map[string]bool)(unsafe.Pointer(&in.RuntimeConfig))
 	out.OIDCConfig = (*OIDCConfig)(unsafe.Pointer(in.OIDCConfig))
+	out.Requests = (*KubeAPIServerRequests)(unsafe.Pointer(in.Requests))
+	out.WatchCacheSizes = (*WatchCacheSizes)(unsafe.Pointer(in.WatchCacheSizes))
+	out.EventTTL = (*v1.Duration)(unsafe.Pointer(in.EventTTL))
+	out.LoadBalancerIP = (*string)(unsafe.Pointer(in.LoadBalancerIP))
+	out.ServiceAnnotations = *(*map[string]string)(unsafe.Pointer(&in.ServiceAnnotations))
 	return nil
 }
 
@@ -1472,6 +1643,28 @@ func Convert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *garde
 	return autoConvert_garden_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeAPIServerRequests_To_garden_KubeAPIServerRequests(in *KubeAPIServerRequests, out *garden.KubeAPIServerRequests, s conversion.Scope) error {
+	out.MaxNonMutatingInflight = (*int)(unsafe.Pointer(in.MaxNonMutatingInflight))
+	out.MaxMutatingInflight = (*int)(unsafe.Pointer(in.MaxMutatingInflight))
+	return nil
+}
+
+// Convert_v1beta1_KubeAPIServerRequests_To_garden_KubeAPIServerRequests is an autogenerated conversion function.
+func Convert_v1beta1_KubeAPIServerRequests_To_garden_KubeAPIServerRequests(in *KubeAPIServerRequests, out *garden.KubeAPIServerRequests, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeAPIServerRequests_To_garden_KubeAPIServerRequests(in, out, s)
+}
+
+func autoConvert_garden_KubeAPIServerRequests_To_v1beta1_KubeAPIServerRequests(in *garden.KubeAPIServerRequests, out *KubeAPIServerRequests, s conversion.Scope) error {
+	out.MaxNonMutatingInflight = (*int)(unsafe.Pointer(in.MaxNonMutatingInflight))
+	out.MaxMutatingInflight = (*int)(unsafe.Pointer(in.MaxMutatingInflight))
+	return nil
+}
+
+// Convert_garden_KubeAPIServerRequests_To_v1beta1_KubeAPIServerRequests is an autogenerated conversion function.
+func Convert_garden_KubeAPIServerRequests_To_v1beta1_KubeAPIServerRequests(in *garden.KubeAPIServerRequests, out *KubeAPIServerRequests, s conversion.Scope) error {
+	return autoConvert_garden_KubeAPIServerRequests_To_v1beta1_KubeAPIServerRequests(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeControllerManagerConfig_To_garden_KubeControllerManagerConfig(in *KubeControllerManagerConfig, out *garden.KubeControllerManagerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_garden_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -1692,6 +1885,7 @@ func Convert_garden_KubernetesDashboard_To_v1beta1_KubernetesDashboard(in *garde
 
 func autoConvert_v1beta1_LastError_To_garden_LastError(in *LastError, out *garden.LastError, s conversion.Scope) error {
 	out.Description = in.Description
+	out.TaskID = in.TaskID
 	out.Codes = *(*[]garden.ErrorCode)(unsafe.Pointer(&in.Codes))
 	return nil
 }
@@ -1703,6 +1897,7 @@ func Convert_v1beta1_LastError_To_garden_LastError(in *LastError, out *garden.La
 
 func autoConvert_garden_LastError_To_v1beta1_LastError(in *garden.LastError, out *LastError, s conversion.Scope) error {
 	out.Description = in.Description
+	out.TaskID = in.TaskID
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
 	return nil
 }
@@ -1718,6 +1913,7 @@ func autoConvert_v1beta1_LastOperation_To_garden_LastOperation(in *LastOperation
 	out.Progress = in.Progress
 	out.State = garden.ShootLastOperationState(in.State)
 	out.Type = garden.ShootLastOperationType(in.Type)
+	out.Steps = *(*[]garden.LastOperationStep)(unsafe.Pointer(&in.Steps))
 	return nil
 }
 
@@ -1732,6 +1928,7 @@ func autoConvert_garden_LastOperation_To_v1beta1_LastOperation(in *garden.LastOp
 	out.Progress = in.Progress
 	out.State = ShootLastOperationState(in.State)
 	out.Type = ShootLastOperationType(in.Type)
+	out.Steps = *(*[]LastOperationStep)(unsafe.Pointer(&in.Steps))
 	return nil
 }
 
@@ -1740,11 +1937,36 @@ func Convert_garden_LastOperation_To_v1beta1_LastOperation(in *garden.LastOperat
 	return autoConvert_garden_LastOperation_To_v1beta1_LastOperation(in, out, s)
 }
 
+func autoConvert_v1beta1_LastOperationStep_To_garden_LastOperationStep(in *LastOperationStep, out *garden.LastOperationStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Duration = in.Duration
+	out.State = garden.ShootLastOperationState(in.State)
+	return nil
+}
+
+// Convert_v1beta1_LastOperationStep_To_garden_LastOperationStep is an autogenerated conversion function.
+func Convert_v1beta1_LastOperationStep_To_garden_LastOperationStep(in *LastOperationStep, out *garden.LastOperationStep, s conversion.Scope) error {
+	return autoConvert_v1beta1_LastOperationStep_To_garden_LastOperationStep(in, out, s)
+}
+
+func autoConvert_garden_LastOperationStep_To_v1beta1_LastOperationStep(in *garden.LastOperationStep, out *LastOperationStep, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Duration = in.Duration
+	out.State = ShootLastOperationState(in.State)
+	return nil
+}
+
+// Convert_garden_LastOperationStep_To_v1beta1_LastOperationStep is an autogenerated conversion function.
+func Convert_garden_LastOperationStep_To_v1beta1_LastOperationStep(in *garden.LastOperationStep, out *LastOperationStep, s conversion.Scope) error {
+	return autoConvert_garden_LastOperationStep_To_v1beta1_LastOperationStep(in, out, s)
+}
+
 func autoConvert_v1beta1_Local_To_garden_Local(in *Local, out *garden.Local, s conversion.Scope) error {
 	if err := Convert_v1beta1_LocalNetworks_To_garden_LocalNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
 	out.Endpoint = in.Endpoint
+	out.Workers = *(*[]garden.LocalWorker)(unsafe.Pointer(&in.Workers))
 	return nil
 }
 
@@ -1758,6 +1980,7 @@ func autoConvert_garden_Local_To_v1beta1_Local(in *garden.Local, out *Local, s c
 		return err
 	}
 	out.Endpoint = in.Endpoint
+	out.Workers = *(*[]LocalWorker)(unsafe.Pointer(&in.Workers))
 	return nil
 }
 
@@ -1836,11 +2059,36 @@ func Convert_garden_LocalProfile_To_v1beta1_LocalProfile(in *garden.LocalProfile
 	return autoConvert_garden_LocalProfile_To_v1beta1_LocalProfile(in, out, s)
 }
 
+func autoConvert_v1beta1_LocalWorker_To_garden_LocalWorker(in *LocalWorker, out *garden.LocalWorker, s conversion.Scope) error {
+	if err := Convert_v1beta1_Worker_To_garden_Worker(&in.Worker, &out.Worker, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_LocalWorker_To_garden_LocalWorker is an autogenerated conversion function.
+func Convert_v1beta1_LocalWorker_To_garden_LocalWorker(in *LocalWorker, out *garden.LocalWorker, s conversion.Scope) error {
+	return autoConvert_v1beta1_LocalWorker_To_garden_LocalWorker(in, out, s)
+}
+
+func autoConvert_garden_LocalWorker_To_v1beta1_LocalWorker(in *garden.LocalWorker, out *LocalWorker, s conversion.Scope) error {
+	if err := Convert_garden_Worker_To_v1beta1_Worker(&in.Worker, &out.Worker, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_LocalWorker_To_v1beta1_LocalWorker is an autogenerated conversion function.
+func Convert_garden_LocalWorker_To_v1beta1_LocalWorker(in *garden.LocalWorker, out *LocalWorker, s conversion.Scope) error {
+	return autoConvert_garden_LocalWorker_To_v1beta1_LocalWorker(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineType_To_garden_MachineType(in *MachineType, out *garden.MachineType, s conversion.Scope) error {
 	out.Name = in.Name
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Memory = in.Memory
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -1854,6 +2102,7 @@ func autoConvert_garden_MachineType_To_v1beta1_MachineType(in *garden.MachineTyp
 	out.CPU = in.CPU
 	out.GPU = in.GPU
 	out.Memory = in.Memory
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -1864,6 +2113,9 @@ func Convert_garden_MachineType_To_v1beta1_MachineType(in *garden.MachineType, o
 
 func autoConvert_v1beta1_Maintenance_To_garden_Maintenance(in *Maintenance, out *garden.Maintenance, s conversion.Scope) error {
 	out.AutoUpdate = (*garden.MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
+	out.ReplaceMachinesOnCredentialsChange = (*bool)(unsafe.Pointer(in.ReplaceMachinesOnCredentialsChange))
+	out.ReplaceMachinesOnCloudConfigChange = (*bool)(unsafe.Pointer(in.ReplaceMachinesOnCloudConfigChange))
+	out.ReplaceMachinesInTimeWindow = (*bool)(unsafe.Pointer(in.ReplaceMachinesInTimeWindow))
 	out.TimeWindow = (*garden.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	return nil
 }
@@ -1875,6 +2127,9 @@ func Convert_v1beta1_Maintenance_To_garden_Maintenance(in *Maintenance, out *gar
 
 func autoConvert_garden_Maintenance_To_v1beta1_Maintenance(in *garden.Maintenance, out *Maintenance, s conversion.Scope) error {
 	out.AutoUpdate = (*MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
+	out.ReplaceMachinesOnCredentialsChange = (*bool)(unsafe.Pointer(in.ReplaceMachinesOnCredentialsChange))
+	out.ReplaceMachinesOnCloudConfigChange = (*bool)(unsafe.Pointer(in.ReplaceMachinesOnCloudConfigChange))
+	out.ReplaceMachinesInTimeWindow = (*bool)(unsafe.Pointer(in.ReplaceMachinesInTimeWindow))
 	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	return nil
 }
@@ -1950,6 +2205,32 @@ func Convert_garden_Monocular_To_v1beta1_Monocular(in *garden.Monocular, out *Mo
 	return autoConvert_garden_Monocular_To_v1beta1_Monocular(in, out, s)
 }
 
+func autoConvert_v1beta1_NetworkPolicies_To_garden_NetworkPolicies(in *NetworkPolicies, out *garden.NetworkPolicies, s conversion.Scope) error {
+	if err := Convert_v1beta1_Addon_To_garden_Addon(&in.Addon, &out.Addon, s); err != nil {
+		return err
+	}
+	out.ExcludedNamespaces = *(*[]string)(unsafe.Pointer(&in.ExcludedNamespaces))
+	return nil
+}
+
+// Convert_v1beta1_NetworkPolicies_To_garden_NetworkPolicies is an autogenerated conversion function.
+func Convert_v1beta1_NetworkPolicies_To_garden_NetworkPolicies(in *NetworkPolicies, out *garden.NetworkPolicies, s conversion.Scope) error {
+	return autoConvert_v1beta1_NetworkPolicies_To_garden_NetworkPolicies(in, out, s)
+}
+
+func autoConvert_garden_NetworkPolicies_To_v1beta1_NetworkPolicies(in *garden.NetworkPolicies, out *NetworkPolicies, s conversion.Scope) error {
+	if err := Convert_garden_Addon_To_v1beta1_Addon(&in.Addon, &out.Addon, s); err != nil {
+		return err
+	}
+	out.ExcludedNamespaces = *(*[]string)(unsafe.Pointer(&in.ExcludedNamespaces))
+	return nil
+}
+
+// Convert_garden_NetworkPolicies_To_v1beta1_NetworkPolicies is an autogenerated conversion function.
+func Convert_garden_NetworkPolicies_To_v1beta1_NetworkPolicies(in *garden.NetworkPolicies, out *NetworkPolicies, s conversion.Scope) error {
+	return autoConvert_garden_NetworkPolicies_To_v1beta1_NetworkPolicies(in, out, s)
+}
+
 func autoConvert_v1beta1_NginxIngress_To_garden_NginxIngress(in *NginxIngress, out *garden.NginxIngress, s conversion.Scope) error {
 	if err := Convert_v1beta1_Addon_To_garden_Addon(&in.Addon, &out.Addon, s); err != nil {
 		return err
@@ -2119,6 +2400,7 @@ func Convert_garden_OpenStackLoadBalancerProvider_To_v1beta1_OpenStackLoadBalanc
 func autoConvert_v1beta1_OpenStackMachineImage_To_garden_OpenStackMachineImage(in *OpenStackMachineImage, out *garden.OpenStackMachineImage, s conversion.Scope) error {
 	out.Name = garden.MachineImageName(in.Name)
 	out.Image = in.Image
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -2130,6 +2412,7 @@ func Convert_v1beta1_OpenStackMachineImage_To_garden_OpenStackMachineImage(in *O
 func autoConvert_garden_OpenStackMachineImage_To_v1beta1_OpenStackMachineImage(in *garden.OpenStackMachineImage, out *OpenStackMachineImage, s conversion.Scope) error {
 	out.Name = MachineImageName(in.Name)
 	out.Image = in.Image
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
 	return nil
 }
 
@@ -2268,6 +2551,164 @@ func Convert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker(in *garden.OpenSt
 	return autoConvert_garden_OpenStackWorker_To_v1beta1_OpenStackWorker(in, out, s)
 }
 
+func autoConvert_v1beta1_PacketCloud_To_garden_PacketCloud(in *PacketCloud, out *garden.PacketCloud, s conversion.Scope) error {
+	out.MachineImage = (*garden.PacketMachineImage)(unsafe.Pointer(in.MachineImage))
+	if err := Convert_v1beta1_PacketNetworks_To_garden_PacketNetworks(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.Workers = *(*[]garden.PacketWorker)(unsafe.Pointer(&in.Workers))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_v1beta1_PacketCloud_To_garden_PacketCloud is an autogenerated conversion function.
+func Convert_v1beta1_PacketCloud_To_garden_PacketCloud(in *PacketCloud, out *garden.PacketCloud, s conversion.Scope) error {
+	return autoConvert_v1beta1_PacketCloud_To_garden_PacketCloud(in, out, s)
+}
+
+func autoConvert_garden_PacketCloud_To_v1beta1_PacketCloud(in *garden.PacketCloud, out *PacketCloud, s conversion.Scope) error {
+	out.MachineImage = (*PacketMachineImage)(unsafe.Pointer(in.MachineImage))
+	if err := Convert_garden_PacketNetworks_To_v1beta1_PacketNetworks(&in.Networks, &out.Networks, s); err != nil {
+		return err
+	}
+	out.Workers = *(*[]PacketWorker)(unsafe.Pointer(&in.Workers))
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_garden_PacketCloud_To_v1beta1_PacketCloud is an autogenerated conversion function.
+func Convert_garden_PacketCloud_To_v1beta1_PacketCloud(in *garden.PacketCloud, out *PacketCloud, s conversion.Scope) error {
+	return autoConvert_garden_PacketCloud_To_v1beta1_PacketCloud(in, out, s)
+}
+
+func autoConvert_v1beta1_PacketConstraints_To_garden_PacketConstraints(in *PacketConstraints, out *garden.PacketConstraints, s conversion.Scope) error {
+	out.DNSProviders = *(*[]garden.DNSProviderConstraint)(unsafe.Pointer(&in.DNSProviders))
+	if err := Convert_v1beta1_KubernetesConstraints_To_garden_KubernetesConstraints(&in.Kubernetes, &out.Kubernetes, s); err != nil {
+		return err
+	}
+	out.MachineImages = *(*[]garden.PacketMachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.MachineTypes = *(*[]garden.MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.Zones = *(*[]garden.Zone)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_v1beta1_PacketConstraints_To_garden_PacketConstraints is an autogenerated conversion function.
+func Convert_v1beta1_PacketConstraints_To_garden_PacketConstraints(in *PacketConstraints, out *garden.PacketConstraints, s conversion.Scope) error {
+	return autoConvert_v1beta1_PacketConstraints_To_garden_PacketConstraints(in, out, s)
+}
+
+func autoConvert_garden_PacketConstraints_To_v1beta1_PacketConstraints(in *garden.PacketConstraints, out *PacketConstraints, s conversion.Scope) error {
+	out.DNSProviders = *(*[]DNSProviderConstraint)(unsafe.Pointer(&in.DNSProviders))
+	if err := Convert_garden_KubernetesConstraints_To_v1beta1_KubernetesConstraints(&in.Kubernetes, &out.Kubernetes, s); err != nil {
+		return err
+	}
+	out.MachineImages = *(*[]PacketMachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.MachineTypes = *(*[]MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_garden_PacketConstraints_To_v1beta1_PacketConstraints is an autogenerated conversion function.
+func Convert_garden_PacketConstraints_To_v1beta1_PacketConstraints(in *garden.PacketConstraints, out *PacketConstraints, s conversion.Scope) error {
+	return autoConvert_garden_PacketConstraints_To_v1beta1_PacketConstraints(in, out, s)
+}
+
+func autoConvert_v1beta1_PacketMachineImage_To_garden_PacketMachineImage(in *PacketMachineImage, out *garden.PacketMachineImage, s conversion.Scope) error {
+	out.Name = garden.MachineImageName(in.Name)
+	out.OperatingSystem = in.OperatingSystem
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_v1beta1_PacketMachineImage_To_garden_PacketMachineImage is an autogenerated conversion function.
+func Convert_v1beta1_PacketMachineImage_To_garden_PacketMachineImage(in *PacketMachineImage, out *garden.PacketMachineImage, s conversion.Scope) error {
+	return autoConvert_v1beta1_PacketMachineImage_To_garden_PacketMachineImage(in, out, s)
+}
+
+func autoConvert_garden_PacketMachineImage_To_v1beta1_PacketMachineImage(in *garden.PacketMachineImage, out *PacketMachineImage, s conversion.Scope) error {
+	out.Name = MachineImageName(in.Name)
+	out.OperatingSystem = in.OperatingSystem
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	return nil
+}
+
+// Convert_garden_PacketMachineImage_To_v1beta1_PacketMachineImage is an autogenerated conversion function.
+func Convert_garden_PacketMachineImage_To_v1beta1_PacketMachineImage(in *garden.PacketMachineImage, out *PacketMachineImage, s conversion.Scope) error {
+	return autoConvert_garden_PacketMachineImage_To_v1beta1_PacketMachineImage(in, out, s)
+}
+
+func autoConvert_v1beta1_PacketNetworks_To_garden_PacketNetworks(in *PacketNetworks, out *garden.PacketNetworks, s conversion.Scope) error {
+	if err := Convert_v1beta1_K8SNetworks_To_garden_K8SNetworks(&in.K8SNetworks, &out.K8SNetworks, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_PacketNetworks_To_garden_PacketNetworks is an autogenerated conversion function.
+func Convert_v1beta1_PacketNetworks_To_garden_PacketNetworks(in *PacketNetworks, out *garden.PacketNetworks, s conversion.Scope) error {
+	return autoConvert_v1beta1_PacketNetworks_To_garden_PacketNetworks(in, out, s)
+}
+
+func autoConvert_garden_PacketNetworks_To_v1beta1_PacketNetworks(in *garden.PacketNetworks, out *PacketNetworks, s conversion.Scope) error {
+	if err := Convert_garden_K8SNetworks_To_v1beta1_K8SNetworks(&in.K8SNetworks, &out.K8SNetworks, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_PacketNetworks_To_v1beta1_PacketNetworks is an autogenerated conversion function.
+func Convert_garden_PacketNetworks_To_v1beta1_PacketNetworks(in *garden.PacketNetworks, out *PacketNetworks, s conversion.Scope) error {
+	return autoConvert_garden_PacketNetworks_To_v1beta1_PacketNetworks(in, out, s)
+}
+
+func autoConvert_v1beta1_PacketProfile_To_garden_PacketProfile(in *PacketProfile, out *garden.PacketProfile, s conversion.Scope) error {
+	if err := Convert_v1beta1_PacketConstraints_To_garden_PacketConstraints(&in.Constraints, &out.Constraints, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_PacketProfile_To_garden_PacketProfile is an autogenerated conversion function.
+func Convert_v1beta1_PacketProfile_To_garden_PacketProfile(in *PacketProfile, out *garden.PacketProfile, s conversion.Scope) error {
+	return autoConvert_v1beta1_PacketProfile_To_garden_PacketProfile(in, out, s)
+}
+
+func autoConvert_garden_PacketProfile_To_v1beta1_PacketProfile(in *garden.PacketProfile, out *PacketProfile, s conversion.Scope) error {
+	if err := Convert_garden_PacketConstraints_To_v1beta1_PacketConstraints(&in.Constraints, &out.Constraints, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_PacketProfile_To_v1beta1_PacketProfile is an autogenerated conversion function.
+func Convert_garden_PacketProfile_To_v1beta1_PacketProfile(in *garden.PacketProfile, out *PacketProfile, s conversion.Scope) error {
+	return autoConvert_garden_PacketProfile_To_v1beta1_PacketProfile(in, out, s)
+}
+
+func autoConvert_v1beta1_PacketWorker_To_garden_PacketWorker(in *PacketWorker, out *garden.PacketWorker, s conversion.Scope) error {
+	if err := Convert_v1beta1_Worker_To_garden_Worker(&in.Worker, &out.Worker, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_PacketWorker_To_garden_PacketWorker is an autogenerated conversion function.
+func Convert_v1beta1_PacketWorker_To_garden_PacketWorker(in *PacketWorker, out *garden.PacketWorker, s conversion.Scope) error {
+	return autoConvert_v1beta1_PacketWorker_To_garden_PacketWorker(in, out, s)
+}
+
+func autoConvert_garden_PacketWorker_To_v1beta1_PacketWorker(in *garden.PacketWorker, out *PacketWorker, s conversion.Scope) error {
+	if err := Convert_garden_Worker_To_v1beta1_Worker(&in.Worker, &out.Worker, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_PacketWorker_To_v1beta1_PacketWorker is an autogenerated conversion function.
+func Convert_garden_PacketWorker_To_v1beta1_PacketWorker(in *garden.PacketWorker, out *PacketWorker, s conversion.Scope) error {
+	return autoConvert_garden_PacketWorker_To_v1beta1_PacketWorker(in, out, s)
+}
+
 func autoConvert_v1beta1_Quota_To_garden_Quota(in *Quota, out *garden.Quota, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_QuotaSpec_To_garden_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2340,6 +2781,100 @@ func Convert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in *garden.QuotaSpec, out *Qu
 	return autoConvert_garden_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_RegionPolicy_To_garden_RegionPolicy(in *RegionPolicy, out *garden.RegionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_RegionPolicySpec_To_garden_RegionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_RegionPolicy_To_garden_RegionPolicy is an autogenerated conversion function.
+func Convert_v1beta1_RegionPolicy_To_garden_RegionPolicy(in *RegionPolicy, out *garden.RegionPolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_RegionPolicy_To_garden_RegionPolicy(in, out, s)
+}
+
+func autoConvert_garden_RegionPolicy_To_v1beta1_RegionPolicy(in *garden.RegionPolicy, out *RegionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_garden_RegionPolicySpec_To_v1beta1_RegionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_RegionPolicy_To_v1beta1_RegionPolicy is an autogenerated conversion function.
+func Convert_garden_RegionPolicy_To_v1beta1_RegionPolicy(in *garden.RegionPolicy, out *RegionPolicy, s conversion.Scope) error {
+	return autoConvert_garden_RegionPolicy_To_v1beta1_RegionPolicy(in, out, s)
+}
+
+func autoConvert_v1beta1_RegionPolicyList_To_garden_RegionPolicyList(in *RegionPolicyList, out *garden.RegionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]garden.RegionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_RegionPolicyList_To_garden_RegionPolicyList is an autogenerated conversion function.
+func Convert_v1beta1_RegionPolicyList_To_garden_RegionPolicyList(in *RegionPolicyList, out *garden.RegionPolicyList, s conversion.Scope) error {
+	return autoConvert_v1beta1_RegionPolicyList_To_garden_RegionPolicyList(in, out, s)
+}
+
+func autoConvert_garden_RegionPolicyList_To_v1beta1_RegionPolicyList(in *garden.RegionPolicyList, out *RegionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]RegionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_garden_RegionPolicyList_To_v1beta1_RegionPolicyList is an autogenerated conversion function.
+func Convert_garden_RegionPolicyList_To_v1beta1_RegionPolicyList(in *garden.RegionPolicyList, out *RegionPolicyList, s conversion.Scope) error {
+	return autoConvert_garden_RegionPolicyList_To_v1beta1_RegionPolicyList(in, out, s)
+}
+
+func autoConvert_v1beta1_RegionPolicySpec_To_garden_RegionPolicySpec(in *RegionPolicySpec, out *garden.RegionPolicySpec, s conversion.Scope) error {
+	out.CloudProfileName = in.CloudProfileName
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_v1beta1_RegionPolicySpec_To_garden_RegionPolicySpec is an autogenerated conversion function.
+func Convert_v1beta1_RegionPolicySpec_To_garden_RegionPolicySpec(in *RegionPolicySpec, out *garden.RegionPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_RegionPolicySpec_To_garden_RegionPolicySpec(in, out, s)
+}
+
+func autoConvert_garden_RegionPolicySpec_To_v1beta1_RegionPolicySpec(in *garden.RegionPolicySpec, out *RegionPolicySpec, s conversion.Scope) error {
+	out.CloudProfileName = in.CloudProfileName
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.Regions = *(*[]string)(unsafe.Pointer(&in.Regions))
+	return nil
+}
+
+// Convert_garden_RegionPolicySpec_To_v1beta1_RegionPolicySpec is an autogenerated conversion function.
+func Convert_garden_RegionPolicySpec_To_v1beta1_RegionPolicySpec(in *garden.RegionPolicySpec, out *RegionPolicySpec, s conversion.Scope) error {
+	return autoConvert_garden_RegionPolicySpec_To_v1beta1_RegionPolicySpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ScaleDownBudget_To_garden_ScaleDownBudget(in *ScaleDownBudget, out *garden.ScaleDownBudget, s conversion.Scope) error {
+	out.MaxMachines = in.MaxMachines
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	return nil
+}
+
+// Convert_v1beta1_ScaleDownBudget_To_garden_ScaleDownBudget is an autogenerated conversion function.
+func Convert_v1beta1_ScaleDownBudget_To_garden_ScaleDownBudget(in *ScaleDownBudget, out *garden.ScaleDownBudget, s conversion.Scope) error {
+	return autoConvert_v1beta1_ScaleDownBudget_To_garden_ScaleDownBudget(in, out, s)
+}
+
+func autoConvert_garden_ScaleDownBudget_To_v1beta1_ScaleDownBudget(in *garden.ScaleDownBudget, out *ScaleDownBudget, s conversion.Scope) error {
+	out.MaxMachines = in.MaxMachines
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	return nil
+}
+
+// Convert_garden_ScaleDownBudget_To_v1beta1_ScaleDownBudget is an autogenerated conversion function.
+func Convert_garden_ScaleDownBudget_To_v1beta1_ScaleDownBudget(in *garden.ScaleDownBudget, out *ScaleDownBudget, s conversion.Scope) error {
+	return autoConvert_garden_ScaleDownBudget_To_v1beta1_ScaleDownBudget(in, out, s)
+}
+
 func autoConvert_v1beta1_SecretBinding_To_garden_SecretBinding(in *SecretBinding, out *garden.SecretBinding, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.SecretRef = in.SecretRef
@@ -2418,48 +2953,182 @@ func Convert_garden_Seed_To_v1beta1_Seed(in *garden.Seed, out *Seed, s conversio
 	return autoConvert_garden_Seed_To_v1beta1_Seed(in, out, s)
 }
 
-func autoConvert_v1beta1_SeedCloud_To_garden_SeedCloud(in *SeedCloud, out *garden.SeedCloud, s conversion.Scope) error {
-	out.Profile = in.Profile
-	out.Region = in.Region
+func autoConvert_v1beta1_SeedAccessRequest_To_garden_SeedAccessRequest(in *SeedAccessRequest, out *garden.SeedAccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_SeedAccessRequestSpec_To_garden_SeedAccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_SeedAccessRequestStatus_To_garden_SeedAccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1beta1_SeedCloud_To_garden_SeedCloud is an autogenerated conversion function.
-func Convert_v1beta1_SeedCloud_To_garden_SeedCloud(in *SeedCloud, out *garden.SeedCloud, s conversion.Scope) error {
-	return autoConvert_v1beta1_SeedCloud_To_garden_SeedCloud(in, out, s)
+// Convert_v1beta1_SeedAccessRequest_To_garden_SeedAccessRequest is an autogenerated conversion function.
+func Convert_v1beta1_SeedAccessRequest_To_garden_SeedAccessRequest(in *SeedAccessRequest, out *garden.SeedAccessRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedAccessRequest_To_garden_SeedAccessRequest(in, out, s)
 }
 
-func autoConvert_garden_SeedCloud_To_v1beta1_SeedCloud(in *garden.SeedCloud, out *SeedCloud, s conversion.Scope) error {
-	out.Profile = in.Profile
-	out.Region = in.Region
+func autoConvert_garden_SeedAccessRequest_To_v1beta1_SeedAccessRequest(in *garden.SeedAccessRequest, out *SeedAccessRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_garden_SeedAccessRequestSpec_To_v1beta1_SeedAccessRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_garden_SeedAccessRequestStatus_To_v1beta1_SeedAccessRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_garden_SeedCloud_To_v1beta1_SeedCloud is an autogenerated conversion function.
-func Convert_garden_SeedCloud_To_v1beta1_SeedCloud(in *garden.SeedCloud, out *SeedCloud, s conversion.Scope) error {
-	return autoConvert_garden_SeedCloud_To_v1beta1_SeedCloud(in, out, s)
+// Convert_garden_SeedAccessRequest_To_v1beta1_SeedAccessRequest is an autogenerated conversion function.
+func Convert_garden_SeedAccessRequest_To_v1beta1_SeedAccessRequest(in *garden.SeedAccessRequest, out *SeedAccessRequest, s conversion.Scope) error {
+	return autoConvert_garden_SeedAccessRequest_To_v1beta1_SeedAccessRequest(in, out, s)
 }
 
-func autoConvert_v1beta1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
+func autoConvert_v1beta1_SeedAccessRequestList_To_garden_SeedAccessRequestList(in *SeedAccessRequestList, out *garden.SeedAccessRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]garden.Seed)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]garden.SeedAccessRequest)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1beta1_SeedList_To_garden_SeedList is an autogenerated conversion function.
-func Convert_v1beta1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
-	return autoConvert_v1beta1_SeedList_To_garden_SeedList(in, out, s)
+// Convert_v1beta1_SeedAccessRequestList_To_garden_SeedAccessRequestList is an autogenerated conversion function.
+func Convert_v1beta1_SeedAccessRequestList_To_garden_SeedAccessRequestList(in *SeedAccessRequestList, out *garden.SeedAccessRequestList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedAccessRequestList_To_garden_SeedAccessRequestList(in, out, s)
 }
 
-func autoConvert_garden_SeedList_To_v1beta1_SeedList(in *garden.SeedList, out *SeedList, s conversion.Scope) error {
+func autoConvert_garden_SeedAccessRequestList_To_v1beta1_SeedAccessRequestList(in *garden.SeedAccessRequestList, out *SeedAccessRequestList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Seed)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]SeedAccessRequest)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_garden_SeedList_To_v1beta1_SeedList is an autogenerated conversion function.
-func Convert_garden_SeedList_To_v1beta1_SeedList(in *garden.SeedList, out *SeedList, s conversion.Scope) error {
-	return autoConvert_garden_SeedList_To_v1beta1_SeedList(in, out, s)
+// Convert_garden_SeedAccessRequestList_To_v1beta1_SeedAccessRequestList is an autogenerated conversion function.
+func Convert_garden_SeedAccessRequestList_To_v1beta1_SeedAccessRequestList(in *garden.SeedAccessRequestList, out *SeedAccessRequestList, s conversion.Scope) error {
+	return autoConvert_garden_SeedAccessRequestList_To_v1beta1_SeedAccessRequestList(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedAccessRequestSpec_To_garden_SeedAccessRequestSpec(in *SeedAccessRequestSpec, out *garden.SeedAccessRequestSpec, s conversion.Scope) error {
+	out.ShootName = in.ShootName
+	out.Reason = in.Reason
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_v1beta1_SeedAccessRequestSpec_To_garden_SeedAccessRequestSpec is an autogenerated conversion function.
+func Convert_v1beta1_SeedAccessRequestSpec_To_garden_SeedAccessRequestSpec(in *SeedAccessRequestSpec, out *garden.SeedAccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedAccessRequestSpec_To_garden_SeedAccessRequestSpec(in, out, s)
+}
+
+func autoConvert_garden_SeedAccessRequestSpec_To_v1beta1_SeedAccessRequestSpec(in *garden.SeedAccessRequestSpec, out *SeedAccessRequestSpec, s conversion.Scope) error {
+	out.ShootName = in.ShootName
+	out.Reason = in.Reason
+	out.Duration = (*v1.Duration)(unsafe.Pointer(in.Duration))
+	return nil
+}
+
+// Convert_garden_SeedAccessRequestSpec_To_v1beta1_SeedAccessRequestSpec is an autogenerated conversion function.
+func Convert_garden_SeedAccessRequestSpec_To_v1beta1_SeedAccessRequestSpec(in *garden.SeedAccessRequestSpec, out *SeedAccessRequestSpec, s conversion.Scope) error {
+	return autoConvert_garden_SeedAccessRequestSpec_To_v1beta1_SeedAccessRequestSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedAccessRequestStatus_To_garden_SeedAccessRequestStatus(in *SeedAccessRequestStatus, out *garden.SeedAccessRequestStatus, s conversion.Scope) error {
+	out.Requester = in.Requester
+	out.Phase = garden.SeedAccessRequestPhase(in.Phase)
+	out.Message = in.Message
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.KubeconfigSecretNamespace = in.KubeconfigSecretNamespace
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_v1beta1_SeedAccessRequestStatus_To_garden_SeedAccessRequestStatus is an autogenerated conversion function.
+func Convert_v1beta1_SeedAccessRequestStatus_To_garden_SeedAccessRequestStatus(in *SeedAccessRequestStatus, out *garden.SeedAccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedAccessRequestStatus_To_garden_SeedAccessRequestStatus(in, out, s)
+}
+
+func autoConvert_garden_SeedAccessRequestStatus_To_v1beta1_SeedAccessRequestStatus(in *garden.SeedAccessRequestStatus, out *SeedAccessRequestStatus, s conversion.Scope) error {
+	out.Requester = in.Requester
+	out.Phase = SeedAccessRequestPhase(in.Phase)
+	out.Message = in.Message
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.KubeconfigSecretNamespace = in.KubeconfigSecretNamespace
+	out.ExpirationTimestamp = (*v1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	return nil
+}
+
+// Convert_garden_SeedAccessRequestStatus_To_v1beta1_SeedAccessRequestStatus is an autogenerated conversion function.
+func Convert_garden_SeedAccessRequestStatus_To_v1beta1_SeedAccessRequestStatus(in *garden.SeedAccessRequestStatus, out *SeedAccessRequestStatus, s conversion.Scope) error {
+	return autoConvert_garden_SeedAccessRequestStatus_To_v1beta1_SeedAccessRequestStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedCloud_To_garden_SeedCloud(in *SeedCloud, out *garden.SeedCloud, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1beta1_SeedCloud_To_garden_SeedCloud is an autogenerated conversion function.
+func Convert_v1beta1_SeedCloud_To_garden_SeedCloud(in *SeedCloud, out *garden.SeedCloud, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedCloud_To_garden_SeedCloud(in, out, s)
+}
+
+func autoConvert_garden_SeedCloud_To_v1beta1_SeedCloud(in *garden.SeedCloud, out *SeedCloud, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_garden_SeedCloud_To_v1beta1_SeedCloud is an autogenerated conversion function.
+func Convert_garden_SeedCloud_To_v1beta1_SeedCloud(in *garden.SeedCloud, out *SeedCloud, s conversion.Scope) error {
+	return autoConvert_garden_SeedCloud_To_v1beta1_SeedCloud(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedComponentStatus_To_garden_SeedComponentStatus(in *SeedComponentStatus, out *garden.SeedComponentStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Checksum = in.Checksum
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_SeedComponentStatus_To_garden_SeedComponentStatus is an autogenerated conversion function.
+func Convert_v1beta1_SeedComponentStatus_To_garden_SeedComponentStatus(in *SeedComponentStatus, out *garden.SeedComponentStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedComponentStatus_To_garden_SeedComponentStatus(in, out, s)
+}
+
+func autoConvert_garden_SeedComponentStatus_To_v1beta1_SeedComponentStatus(in *garden.SeedComponentStatus, out *SeedComponentStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Checksum = in.Checksum
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_garden_SeedComponentStatus_To_v1beta1_SeedComponentStatus is an autogenerated conversion function.
+func Convert_garden_SeedComponentStatus_To_v1beta1_SeedComponentStatus(in *garden.SeedComponentStatus, out *SeedComponentStatus, s conversion.Scope) error {
+	return autoConvert_garden_SeedComponentStatus_To_v1beta1_SeedComponentStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]garden.Seed)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_SeedList_To_garden_SeedList is an autogenerated conversion function.
+func Convert_v1beta1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedList_To_garden_SeedList(in, out, s)
+}
+
+func autoConvert_garden_SeedList_To_v1beta1_SeedList(in *garden.SeedList, out *SeedList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Seed)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_garden_SeedList_To_v1beta1_SeedList is an autogenerated conversion function.
+func Convert_garden_SeedList_To_v1beta1_SeedList(in *garden.SeedList, out *SeedList, s conversion.Scope) error {
+	return autoConvert_garden_SeedList_To_v1beta1_SeedList(in, out, s)
 }
 
 func autoConvert_v1beta1_SeedNetworks_To_garden_SeedNetworks(in *SeedNetworks, out *garden.SeedNetworks, s conversion.Scope) error {
@@ -2486,6 +3155,28 @@ func Convert_garden_SeedNetworks_To_v1beta1_SeedNetworks(in *garden.SeedNetworks
 	return autoConvert_garden_SeedNetworks_To_v1beta1_SeedNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedShootKubernetesVersions_To_garden_SeedShootKubernetesVersions(in *SeedShootKubernetesVersions, out *garden.SeedShootKubernetesVersions, s conversion.Scope) error {
+	out.Min = (*string)(unsafe.Pointer(in.Min))
+	out.Max = (*string)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_v1beta1_SeedShootKubernetesVersions_To_garden_SeedShootKubernetesVersions is an autogenerated conversion function.
+func Convert_v1beta1_SeedShootKubernetesVersions_To_garden_SeedShootKubernetesVersions(in *SeedShootKubernetesVersions, out *garden.SeedShootKubernetesVersions, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedShootKubernetesVersions_To_garden_SeedShootKubernetesVersions(in, out, s)
+}
+
+func autoConvert_garden_SeedShootKubernetesVersions_To_v1beta1_SeedShootKubernetesVersions(in *garden.SeedShootKubernetesVersions, out *SeedShootKubernetesVersions, s conversion.Scope) error {
+	out.Min = (*string)(unsafe.Pointer(in.Min))
+	out.Max = (*string)(unsafe.Pointer(in.Max))
+	return nil
+}
+
+// Convert_garden_SeedShootKubernetesVersions_To_v1beta1_SeedShootKubernetesVersions is an autogenerated conversion function.
+func Convert_garden_SeedShootKubernetesVersions_To_v1beta1_SeedShootKubernetesVersions(in *garden.SeedShootKubernetesVersions, out *SeedShootKubernetesVersions, s conversion.Scope) error {
+	return autoConvert_garden_SeedShootKubernetesVersions_To_v1beta1_SeedShootKubernetesVersions(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.SeedSpec, s conversion.Scope) error {
 	if err := Convert_v1beta1_SeedCloud_To_garden_SeedCloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
@@ -2497,6 +3188,7 @@ func autoConvert_v1beta1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.S
 	}
 	out.Visible = (*bool)(unsafe.Pointer(in.Visible))
 	out.Protected = (*bool)(unsafe.Pointer(in.Protected))
+	out.ShootKubernetesVersions = (*garden.SeedShootKubernetesVersions)(unsafe.Pointer(in.ShootKubernetesVersions))
 	return nil
 }
 
@@ -2516,6 +3208,7 @@ func autoConvert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *S
 	}
 	out.Visible = (*bool)(unsafe.Pointer(in.Visible))
 	out.Protected = (*bool)(unsafe.Pointer(in.Protected))
+	out.ShootKubernetesVersions = (*SeedShootKubernetesVersions)(unsafe.Pointer(in.ShootKubernetesVersions))
 	return nil
 }
 
@@ -2526,6 +3219,7 @@ func Convert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *SeedS
 
 func autoConvert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *garden.SeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
+	out.Components = *(*[]garden.SeedComponentStatus)(unsafe.Pointer(&in.Components))
 	return nil
 }
 
@@ -2536,6 +3230,7 @@ func Convert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *garden
 
 func autoConvert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus, out *SeedStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.Components = *(*[]SeedComponentStatus)(unsafe.Pointer(&in.Components))
 	return nil
 }
 
@@ -2576,6 +3271,134 @@ func Convert_garden_Shoot_To_v1beta1_Shoot(in *garden.Shoot, out *Shoot, s conve
 	return autoConvert_garden_Shoot_To_v1beta1_Shoot(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootCloudStatus_To_garden_ShootCloudStatus(in *ShootCloudStatus, out *garden.ShootCloudStatus, s conversion.Scope) error {
+	out.Network = in.Network
+	out.Subnets = *(*[]string)(unsafe.Pointer(&in.Subnets))
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.NATIPs = *(*[]string)(unsafe.Pointer(&in.NATIPs))
+	out.IAMRoles = *(*[]string)(unsafe.Pointer(&in.IAMRoles))
+	return nil
+}
+
+// Convert_v1beta1_ShootCloudStatus_To_garden_ShootCloudStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootCloudStatus_To_garden_ShootCloudStatus(in *ShootCloudStatus, out *garden.ShootCloudStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootCloudStatus_To_garden_ShootCloudStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootCloudStatus_To_v1beta1_ShootCloudStatus(in *garden.ShootCloudStatus, out *ShootCloudStatus, s conversion.Scope) error {
+	out.Network = in.Network
+	out.Subnets = *(*[]string)(unsafe.Pointer(&in.Subnets))
+	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	out.NATIPs = *(*[]string)(unsafe.Pointer(&in.NATIPs))
+	out.IAMRoles = *(*[]string)(unsafe.Pointer(&in.IAMRoles))
+	return nil
+}
+
+// Convert_garden_ShootCloudStatus_To_v1beta1_ShootCloudStatus is an autogenerated conversion function.
+func Convert_garden_ShootCloudStatus_To_v1beta1_ShootCloudStatus(in *garden.ShootCloudStatus, out *ShootCloudStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootCloudStatus_To_v1beta1_ShootCloudStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootEtcdOperationLock_To_garden_ShootEtcdOperationLock(in *ShootEtcdOperationLock, out *garden.ShootEtcdOperationLock, s conversion.Scope) error {
+	out.Holder = in.Holder
+	out.Reason = in.Reason
+	out.AcquireTime = in.AcquireTime
+	return nil
+}
+
+// Convert_v1beta1_ShootEtcdOperationLock_To_garden_ShootEtcdOperationLock is an autogenerated conversion function.
+func Convert_v1beta1_ShootEtcdOperationLock_To_garden_ShootEtcdOperationLock(in *ShootEtcdOperationLock, out *garden.ShootEtcdOperationLock, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootEtcdOperationLock_To_garden_ShootEtcdOperationLock(in, out, s)
+}
+
+func autoConvert_garden_ShootEtcdOperationLock_To_v1beta1_ShootEtcdOperationLock(in *garden.ShootEtcdOperationLock, out *ShootEtcdOperationLock, s conversion.Scope) error {
+	out.Holder = in.Holder
+	out.Reason = in.Reason
+	out.AcquireTime = in.AcquireTime
+	return nil
+}
+
+// Convert_garden_ShootEtcdOperationLock_To_v1beta1_ShootEtcdOperationLock is an autogenerated conversion function.
+func Convert_garden_ShootEtcdOperationLock_To_v1beta1_ShootEtcdOperationLock(in *garden.ShootEtcdOperationLock, out *ShootEtcdOperationLock, s conversion.Scope) error {
+	return autoConvert_garden_ShootEtcdOperationLock_To_v1beta1_ShootEtcdOperationLock(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootInventory_To_garden_ShootInventory(in *ShootInventory, out *garden.ShootInventory, s conversion.Scope) error {
+	out.Resources = *(*[]garden.ShootInventoryResource)(unsafe.Pointer(&in.Resources))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ShootInventory_To_garden_ShootInventory is an autogenerated conversion function.
+func Convert_v1beta1_ShootInventory_To_garden_ShootInventory(in *ShootInventory, out *garden.ShootInventory, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootInventory_To_garden_ShootInventory(in, out, s)
+}
+
+func autoConvert_garden_ShootInventory_To_v1beta1_ShootInventory(in *garden.ShootInventory, out *ShootInventory, s conversion.Scope) error {
+	out.Resources = *(*[]ShootInventoryResource)(unsafe.Pointer(&in.Resources))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_garden_ShootInventory_To_v1beta1_ShootInventory is an autogenerated conversion function.
+func Convert_garden_ShootInventory_To_v1beta1_ShootInventory(in *garden.ShootInventory, out *ShootInventory, s conversion.Scope) error {
+	return autoConvert_garden_ShootInventory_To_v1beta1_ShootInventory(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootInventoryResource_To_garden_ShootInventoryResource(in *ShootInventoryResource, out *garden.ShootInventoryResource, s conversion.Scope) error {
+	out.Kind = garden.InventoryResourceKind(in.Kind)
+	out.ID = in.ID
+	out.Name = in.Name
+	out.Source = garden.InventoryResourceSource(in.Source)
+	return nil
+}
+
+// Convert_v1beta1_ShootInventoryResource_To_garden_ShootInventoryResource is an autogenerated conversion function.
+func Convert_v1beta1_ShootInventoryResource_To_garden_ShootInventoryResource(in *ShootInventoryResource, out *garden.ShootInventoryResource, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootInventoryResource_To_garden_ShootInventoryResource(in, out, s)
+}
+
+func autoConvert_garden_ShootInventoryResource_To_v1beta1_ShootInventoryResource(in *garden.ShootInventoryResource, out *ShootInventoryResource, s conversion.Scope) error {
+	out.Kind = InventoryResourceKind(in.Kind)
+	out.ID = in.ID
+	out.Name = in.Name
+	out.Source = InventoryResourceSource(in.Source)
+	return nil
+}
+
+// Convert_garden_ShootInventoryResource_To_v1beta1_ShootInventoryResource is an autogenerated conversion function.
+func Convert_garden_ShootInventoryResource_To_v1beta1_ShootInventoryResource(in *garden.ShootInventoryResource, out *ShootInventoryResource, s conversion.Scope) error {
+	return autoConvert_garden_ShootInventoryResource_To_v1beta1_ShootInventoryResource(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootKubernetesUpgrade_To_garden_ShootKubernetesUpgrade(in *ShootKubernetesUpgrade, out *garden.ShootKubernetesUpgrade, s conversion.Scope) error {
+	out.PreviousVersion = in.PreviousVersion
+	out.Version = in.Version
+	out.Phase = garden.KubernetesUpgradePhase(in.Phase)
+	out.StartTime = in.StartTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_ShootKubernetesUpgrade_To_garden_ShootKubernetesUpgrade is an autogenerated conversion function.
+func Convert_v1beta1_ShootKubernetesUpgrade_To_garden_ShootKubernetesUpgrade(in *ShootKubernetesUpgrade, out *garden.ShootKubernetesUpgrade, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootKubernetesUpgrade_To_garden_ShootKubernetesUpgrade(in, out, s)
+}
+
+func autoConvert_garden_ShootKubernetesUpgrade_To_v1beta1_ShootKubernetesUpgrade(in *garden.ShootKubernetesUpgrade, out *ShootKubernetesUpgrade, s conversion.Scope) error {
+	out.PreviousVersion = in.PreviousVersion
+	out.Version = in.Version
+	out.Phase = KubernetesUpgradePhase(in.Phase)
+	out.StartTime = in.StartTime
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_garden_ShootKubernetesUpgrade_To_v1beta1_ShootKubernetesUpgrade is an autogenerated conversion function.
+func Convert_garden_ShootKubernetesUpgrade_To_v1beta1_ShootKubernetesUpgrade(in *garden.ShootKubernetesUpgrade, out *ShootKubernetesUpgrade, s conversion.Scope) error {
+	return autoConvert_garden_ShootKubernetesUpgrade_To_v1beta1_ShootKubernetesUpgrade(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]garden.Shoot)(unsafe.Pointer(&in.Items))
@@ -2598,15 +3421,263 @@ func Convert_garden_ShootList_To_v1beta1_ShootList(in *garden.ShootList, out *Sh
 	return autoConvert_garden_ShootList_To_v1beta1_ShootList(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootMachineCanaryStatus_To_garden_ShootMachineCanaryStatus(in *ShootMachineCanaryStatus, out *garden.ShootMachineCanaryStatus, s conversion.Scope) error {
+	out.WorkerName = in.WorkerName
+	out.Phase = garden.MachineCanaryPhase(in.Phase)
+	out.SoakEndTime = (*v1.Time)(unsafe.Pointer(in.SoakEndTime))
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1beta1_ShootMachineCanaryStatus_To_garden_ShootMachineCanaryStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootMachineCanaryStatus_To_garden_ShootMachineCanaryStatus(in *ShootMachineCanaryStatus, out *garden.ShootMachineCanaryStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMachineCanaryStatus_To_garden_ShootMachineCanaryStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootMachineCanaryStatus_To_v1beta1_ShootMachineCanaryStatus(in *garden.ShootMachineCanaryStatus, out *ShootMachineCanaryStatus, s conversion.Scope) error {
+	out.WorkerName = in.WorkerName
+	out.Phase = MachineCanaryPhase(in.Phase)
+	out.SoakEndTime = (*v1.Time)(unsafe.Pointer(in.SoakEndTime))
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_garden_ShootMachineCanaryStatus_To_v1beta1_ShootMachineCanaryStatus is an autogenerated conversion function.
+func Convert_garden_ShootMachineCanaryStatus_To_v1beta1_ShootMachineCanaryStatus(in *garden.ShootMachineCanaryStatus, out *ShootMachineCanaryStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootMachineCanaryStatus_To_v1beta1_ShootMachineCanaryStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootMachineDeploymentStatus_To_garden_ShootMachineDeploymentStatus(in *ShootMachineDeploymentStatus, out *garden.ShootMachineDeploymentStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Ready = in.Ready
+	out.Updated = in.Updated
+	out.Unavailable = in.Unavailable
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_v1beta1_ShootMachineDeploymentStatus_To_garden_ShootMachineDeploymentStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootMachineDeploymentStatus_To_garden_ShootMachineDeploymentStatus(in *ShootMachineDeploymentStatus, out *garden.ShootMachineDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMachineDeploymentStatus_To_garden_ShootMachineDeploymentStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootMachineDeploymentStatus_To_v1beta1_ShootMachineDeploymentStatus(in *garden.ShootMachineDeploymentStatus, out *ShootMachineDeploymentStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Desired = in.Desired
+	out.Ready = in.Ready
+	out.Updated = in.Updated
+	out.Unavailable = in.Unavailable
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_garden_ShootMachineDeploymentStatus_To_v1beta1_ShootMachineDeploymentStatus is an autogenerated conversion function.
+func Convert_garden_ShootMachineDeploymentStatus_To_v1beta1_ShootMachineDeploymentStatus(in *garden.ShootMachineDeploymentStatus, out *ShootMachineDeploymentStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootMachineDeploymentStatus_To_v1beta1_ShootMachineDeploymentStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootMachinesStatus_To_garden_ShootMachinesStatus(in *ShootMachinesStatus, out *garden.ShootMachinesStatus, s conversion.Scope) error {
+	out.Phase = garden.MachineRolloutPhase(in.Phase)
+	out.Deployments = *(*[]garden.ShootMachineDeploymentStatus)(unsafe.Pointer(&in.Deployments))
+	out.LastError = in.LastError
+	out.PendingReplacements = *(*[]string)(unsafe.Pointer(&in.PendingReplacements))
+	out.Canaries = *(*[]garden.ShootMachineCanaryStatus)(unsafe.Pointer(&in.Canaries))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1beta1_ShootMachinesStatus_To_garden_ShootMachinesStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootMachinesStatus_To_garden_ShootMachinesStatus(in *ShootMachinesStatus, out *garden.ShootMachinesStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMachinesStatus_To_garden_ShootMachinesStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootMachinesStatus_To_v1beta1_ShootMachinesStatus(in *garden.ShootMachinesStatus, out *ShootMachinesStatus, s conversion.Scope) error {
+	out.Phase = MachineRolloutPhase(in.Phase)
+	out.Deployments = *(*[]ShootMachineDeploymentStatus)(unsafe.Pointer(&in.Deployments))
+	out.LastError = in.LastError
+	out.PendingReplacements = *(*[]string)(unsafe.Pointer(&in.PendingReplacements))
+	out.Canaries = *(*[]ShootMachineCanaryStatus)(unsafe.Pointer(&in.Canaries))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_garden_ShootMachinesStatus_To_v1beta1_ShootMachinesStatus is an autogenerated conversion function.
+func Convert_garden_ShootMachinesStatus_To_v1beta1_ShootMachinesStatus(in *garden.ShootMachinesStatus, out *ShootMachinesStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootMachinesStatus_To_v1beta1_ShootMachinesStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootMonitoring_To_garden_ShootMonitoring(in *ShootMonitoring, out *garden.ShootMonitoring, s conversion.Scope) error {
+	out.AlertManagerURL = in.AlertManagerURL
+	out.GrafanaURL = in.GrafanaURL
+	out.PrometheusURL = in.PrometheusURL
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	out.FederationURL = in.FederationURL
+	out.FederationCredentialsSecretRef = in.FederationCredentialsSecretRef
+	return nil
+}
+
+// Convert_v1beta1_ShootMonitoring_To_garden_ShootMonitoring is an autogenerated conversion function.
+func Convert_v1beta1_ShootMonitoring_To_garden_ShootMonitoring(in *ShootMonitoring, out *garden.ShootMonitoring, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMonitoring_To_garden_ShootMonitoring(in, out, s)
+}
+
+func autoConvert_garden_ShootMonitoring_To_v1beta1_ShootMonitoring(in *garden.ShootMonitoring, out *ShootMonitoring, s conversion.Scope) error {
+	out.AlertManagerURL = in.AlertManagerURL
+	out.GrafanaURL = in.GrafanaURL
+	out.PrometheusURL = in.PrometheusURL
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	out.FederationURL = in.FederationURL
+	out.FederationCredentialsSecretRef = in.FederationCredentialsSecretRef
+	return nil
+}
+
+// Convert_garden_ShootMonitoring_To_v1beta1_ShootMonitoring is an autogenerated conversion function.
+func Convert_garden_ShootMonitoring_To_v1beta1_ShootMonitoring(in *garden.ShootMonitoring, out *ShootMonitoring, s conversion.Scope) error {
+	return autoConvert_garden_ShootMonitoring_To_v1beta1_ShootMonitoring(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootOperation_To_garden_ShootOperation(in *ShootOperation, out *garden.ShootOperation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootOperationSpec_To_garden_ShootOperationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_ShootOperationStatus_To_garden_ShootOperationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootOperation_To_garden_ShootOperation is an autogenerated conversion function.
+func Convert_v1beta1_ShootOperation_To_garden_ShootOperation(in *ShootOperation, out *garden.ShootOperation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootOperation_To_garden_ShootOperation(in, out, s)
+}
+
+func autoConvert_garden_ShootOperation_To_v1beta1_ShootOperation(in *garden.ShootOperation, out *ShootOperation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_garden_ShootOperationSpec_To_v1beta1_ShootOperationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_garden_ShootOperationStatus_To_v1beta1_ShootOperationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_ShootOperation_To_v1beta1_ShootOperation is an autogenerated conversion function.
+func Convert_garden_ShootOperation_To_v1beta1_ShootOperation(in *garden.ShootOperation, out *ShootOperation, s conversion.Scope) error {
+	return autoConvert_garden_ShootOperation_To_v1beta1_ShootOperation(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootOperationList_To_garden_ShootOperationList(in *ShootOperationList, out *garden.ShootOperationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]garden.ShootOperation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_ShootOperationList_To_garden_ShootOperationList is an autogenerated conversion function.
+func Convert_v1beta1_ShootOperationList_To_garden_ShootOperationList(in *ShootOperationList, out *garden.ShootOperationList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootOperationList_To_garden_ShootOperationList(in, out, s)
+}
+
+func autoConvert_garden_ShootOperationList_To_v1beta1_ShootOperationList(in *garden.ShootOperationList, out *ShootOperationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootOperation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_garden_ShootOperationList_To_v1beta1_ShootOperationList is an autogenerated conversion function.
+func Convert_garden_ShootOperationList_To_v1beta1_ShootOperationList(in *garden.ShootOperationList, out *ShootOperationList, s conversion.Scope) error {
+	return autoConvert_garden_ShootOperationList_To_v1beta1_ShootOperationList(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootOperationShootStatus_To_garden_ShootOperationShootStatus(in *ShootOperationShootStatus, out *garden.ShootOperationShootStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = garden.ShootOperationShootState(in.State)
+	out.Generation = in.Generation
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1beta1_ShootOperationShootStatus_To_garden_ShootOperationShootStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootOperationShootStatus_To_garden_ShootOperationShootStatus(in *ShootOperationShootStatus, out *garden.ShootOperationShootStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootOperationShootStatus_To_garden_ShootOperationShootStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootOperationShootStatus_To_v1beta1_ShootOperationShootStatus(in *garden.ShootOperationShootStatus, out *ShootOperationShootStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = ShootOperationShootState(in.State)
+	out.Generation = in.Generation
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_garden_ShootOperationShootStatus_To_v1beta1_ShootOperationShootStatus is an autogenerated conversion function.
+func Convert_garden_ShootOperationShootStatus_To_v1beta1_ShootOperationShootStatus(in *garden.ShootOperationShootStatus, out *ShootOperationShootStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootOperationShootStatus_To_v1beta1_ShootOperationShootStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootOperationSpec_To_garden_ShootOperationSpec(in *ShootOperationSpec, out *garden.ShootOperationSpec, s conversion.Scope) error {
+	out.Operation = garden.ShootOperationType(in.Operation)
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.MaxConcurrency = (*int)(unsafe.Pointer(in.MaxConcurrency))
+	return nil
+}
+
+// Convert_v1beta1_ShootOperationSpec_To_garden_ShootOperationSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootOperationSpec_To_garden_ShootOperationSpec(in *ShootOperationSpec, out *garden.ShootOperationSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootOperationSpec_To_garden_ShootOperationSpec(in, out, s)
+}
+
+func autoConvert_garden_ShootOperationSpec_To_v1beta1_ShootOperationSpec(in *garden.ShootOperationSpec, out *ShootOperationSpec, s conversion.Scope) error {
+	out.Operation = ShootOperationType(in.Operation)
+	out.Selector = (*v1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.MaxConcurrency = (*int)(unsafe.Pointer(in.MaxConcurrency))
+	return nil
+}
+
+// Convert_garden_ShootOperationSpec_To_v1beta1_ShootOperationSpec is an autogenerated conversion function.
+func Convert_garden_ShootOperationSpec_To_v1beta1_ShootOperationSpec(in *garden.ShootOperationSpec, out *ShootOperationSpec, s conversion.Scope) error {
+	return autoConvert_garden_ShootOperationSpec_To_v1beta1_ShootOperationSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootOperationStatus_To_garden_ShootOperationStatus(in *ShootOperationStatus, out *garden.ShootOperationStatus, s conversion.Scope) error {
+	out.Phase = garden.ShootOperationPhase(in.Phase)
+	out.StartTimestamp = (*v1.Time)(unsafe.Pointer(in.StartTimestamp))
+	out.CompletionTimestamp = (*v1.Time)(unsafe.Pointer(in.CompletionTimestamp))
+	out.Shoots = *(*[]garden.ShootOperationShootStatus)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_v1beta1_ShootOperationStatus_To_garden_ShootOperationStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootOperationStatus_To_garden_ShootOperationStatus(in *ShootOperationStatus, out *garden.ShootOperationStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootOperationStatus_To_garden_ShootOperationStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootOperationStatus_To_v1beta1_ShootOperationStatus(in *garden.ShootOperationStatus, out *ShootOperationStatus, s conversion.Scope) error {
+	out.Phase = ShootOperationPhase(in.Phase)
+	out.StartTimestamp = (*v1.Time)(unsafe.Pointer(in.StartTimestamp))
+	out.CompletionTimestamp = (*v1.Time)(unsafe.Pointer(in.CompletionTimestamp))
+	out.Shoots = *(*[]ShootOperationShootStatus)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_garden_ShootOperationStatus_To_v1beta1_ShootOperationStatus is an autogenerated conversion function.
+func Convert_garden_ShootOperationStatus_To_v1beta1_ShootOperationStatus(in *garden.ShootOperationStatus, out *ShootOperationStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootOperationStatus_To_v1beta1_ShootOperationStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garden.ShootSpec, s conversion.Scope) error {
 	out.Addons = (*garden.Addons)(unsafe.Pointer(in.Addons))
 	out.Backup = (*garden.Backup)(unsafe.Pointer(in.Backup))
 	if err := Convert_v1beta1_Cloud_To_garden_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
+	out.DependsOn = *(*[]core_v1.ObjectReference)(unsafe.Pointer(&in.DependsOn))
 	if err := Convert_v1beta1_DNS_To_garden_DNS(&in.DNS, &out.DNS, s); err != nil {
 		return err
 	}
+	out.Hibernation = (*garden.Hibernation)(unsafe.Pointer(in.Hibernation))
 	if err := Convert_v1beta1_Kubernetes_To_garden_Kubernetes(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
@@ -2625,9 +3696,11 @@ func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out
 	if err := Convert_garden_Cloud_To_v1beta1_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
+	out.DependsOn = *(*[]core_v1.ObjectReference)(unsafe.Pointer(&in.DependsOn))
 	if err := Convert_garden_DNS_To_v1beta1_DNS(&in.DNS, &out.DNS, s); err != nil {
 		return err
 	}
+	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
 	if err := Convert_garden_Kubernetes_To_v1beta1_Kubernetes(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
 	}
@@ -2641,12 +3714,19 @@ func Convert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out *Sh
 }
 
 func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *garden.ShootStatus, s conversion.Scope) error {
+	out.Cloud = (*garden.ShootCloudStatus)(unsafe.Pointer(in.Cloud))
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
+	out.EtcdOperationLock = (*garden.ShootEtcdOperationLock)(unsafe.Pointer(in.EtcdOperationLock))
 	if err := Convert_v1beta1_Gardener_To_garden_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
+	out.Inventory = (*garden.ShootInventory)(unsafe.Pointer(in.Inventory))
 	out.LastOperation = (*garden.LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*garden.LastError)(unsafe.Pointer(in.LastError))
+	out.LastErrors = *(*[]garden.LastError)(unsafe.Pointer(&in.LastErrors))
+	out.Machines = (*garden.ShootMachinesStatus)(unsafe.Pointer(in.Machines))
+	out.KubernetesUpgrade = (*garden.ShootKubernetesUpgrade)(unsafe.Pointer(in.KubernetesUpgrade))
+	out.Monitoring = (*garden.ShootMonitoring)(unsafe.Pointer(in.Monitoring))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.Seed = in.Seed
@@ -2661,12 +3741,19 @@ func Convert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out *gar
 }
 
 func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatus, out *ShootStatus, s conversion.Scope) error {
+	out.Cloud = (*ShootCloudStatus)(unsafe.Pointer(in.Cloud))
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
+	out.EtcdOperationLock = (*ShootEtcdOperationLock)(unsafe.Pointer(in.EtcdOperationLock))
 	if err := Convert_garden_Gardener_To_v1beta1_Gardener(&in.Gardener, &out.Gardener, s); err != nil {
 		return err
 	}
+	out.Inventory = (*ShootInventory)(unsafe.Pointer(in.Inventory))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
 	out.LastError = (*LastError)(unsafe.Pointer(in.LastError))
+	out.LastErrors = *(*[]LastError)(unsafe.Pointer(&in.LastErrors))
+	out.Machines = (*ShootMachinesStatus)(unsafe.Pointer(in.Machines))
+	out.KubernetesUpgrade = (*ShootKubernetesUpgrade)(unsafe.Pointer(in.KubernetesUpgrade))
+	out.Monitoring = (*ShootMonitoring)(unsafe.Pointer(in.Monitoring))
 	out.ObservedGeneration = in.ObservedGeneration
 	out.RetryCycleStartTime = (*v1.Time)(unsafe.Pointer(in.RetryCycleStartTime))
 	out.Seed = in.Seed
@@ -2702,11 +3789,60 @@ func Convert_garden_VolumeType_To_v1beta1_VolumeType(in *garden.VolumeType, out
 	return autoConvert_garden_VolumeType_To_v1beta1_VolumeType(in, out, s)
 }
 
+func autoConvert_v1beta1_WatchCacheSizes_To_garden_WatchCacheSizes(in *WatchCacheSizes, out *garden.WatchCacheSizes, s conversion.Scope) error {
+	out.Default = (*int)(unsafe.Pointer(in.Default))
+	out.Resources = *(*map[string]int)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_v1beta1_WatchCacheSizes_To_garden_WatchCacheSizes is an autogenerated conversion function.
+func Convert_v1beta1_WatchCacheSizes_To_garden_WatchCacheSizes(in *WatchCacheSizes, out *garden.WatchCacheSizes, s conversion.Scope) error {
+	return autoConvert_v1beta1_WatchCacheSizes_To_garden_WatchCacheSizes(in, out, s)
+}
+
+func autoConvert_garden_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in *garden.WatchCacheSizes, out *WatchCacheSizes, s conversion.Scope) error {
+	out.Default = (*int)(unsafe.Pointer(in.Default))
+	out.Resources = *(*map[string]int)(unsafe.Pointer(&in.Resources))
+	return nil
+}
+
+// Convert_garden_WatchCacheSizes_To_v1beta1_WatchCacheSizes is an autogenerated conversion function.
+func Convert_garden_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in *garden.WatchCacheSizes, out *WatchCacheSizes, s conversion.Scope) error {
+	return autoConvert_garden_WatchCacheSizes_To_v1beta1_WatchCacheSizes(in, out, s)
+}
+
 func autoConvert_v1beta1_Worker_To_garden_Worker(in *Worker, out *garden.Worker, s conversion.Scope) error {
 	out.Name = in.Name
 	out.MachineType = in.MachineType
 	out.AutoScalerMin = in.AutoScalerMin
 	out.AutoScalerMax = in.AutoScalerMax
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MaxUnhealthy = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnhealthy))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	out.UnhealthyNodeConditions = *(*[]string)(unsafe.Pointer(&in.UnhealthyNodeConditions))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	out.NodeAnnotations = *(*map[string]string)(unsafe.Pointer(&in.NodeAnnotations))
+	out.NodeTaints = *(*[]core_v1.Taint)(unsafe.Pointer(&in.NodeTaints))
+	out.Cordoned = (*bool)(unsafe.Pointer(in.Cordoned))
+	out.Architecture = (*garden.MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	out.WarmUp = (*bool)(unsafe.Pointer(in.WarmUp))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MinReadySeconds = (*int32)(unsafe.Pointer(in.MinReadySeconds))
+	out.FirewallRules = *(*[]garden.WorkerFirewallRule)(unsafe.Pointer(&in.FirewallRules))
+	out.WaitForCriticalComponents = (*bool)(unsafe.Pointer(in.WaitForCriticalComponents))
+	out.MachineImage = (*garden.MachineImageName)(unsafe.Pointer(in.MachineImage))
+	out.KubernetesVersion = (*string)(unsafe.Pointer(in.KubernetesVersion))
+	out.RolloutStage = (*int32)(unsafe.Pointer(in.RolloutStage))
+	out.Spot = (*garden.WorkerSpot)(unsafe.Pointer(in.Spot))
+	out.ScaleDownPriority = (*int32)(unsafe.Pointer(in.ScaleDownPriority))
+	out.Kubelet = (*garden.WorkerKubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.OSUpdates = (*garden.WorkerOSUpdates)(unsafe.Pointer(in.OSUpdates))
+	out.Canary = (*garden.WorkerCanary)(unsafe.Pointer(in.Canary))
+	out.LocalSSDs = (*garden.WorkerLocalSSDs)(unsafe.Pointer(in.LocalSSDs))
+	out.ScaleDownBudget = (*garden.ScaleDownBudget)(unsafe.Pointer(in.ScaleDownBudget))
 	return nil
 }
 
@@ -2720,6 +3856,33 @@ func autoConvert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker,
 	out.MachineType = in.MachineType
 	out.AutoScalerMin = in.AutoScalerMin
 	out.AutoScalerMax = in.AutoScalerMax
+	out.MachineHealthTimeout = (*v1.Duration)(unsafe.Pointer(in.MachineHealthTimeout))
+	out.MaxUnhealthy = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnhealthy))
+	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
+	out.UnhealthyNodeConditions = *(*[]string)(unsafe.Pointer(&in.UnhealthyNodeConditions))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.NodeLabels = *(*map[string]string)(unsafe.Pointer(&in.NodeLabels))
+	out.NodeAnnotations = *(*map[string]string)(unsafe.Pointer(&in.NodeAnnotations))
+	out.NodeTaints = *(*[]core_v1.Taint)(unsafe.Pointer(&in.NodeTaints))
+	out.Cordoned = (*bool)(unsafe.Pointer(in.Cordoned))
+	out.Architecture = (*MachineArchitecture)(unsafe.Pointer(in.Architecture))
+	out.WarmUp = (*bool)(unsafe.Pointer(in.WarmUp))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MinReadySeconds = (*int32)(unsafe.Pointer(in.MinReadySeconds))
+	out.FirewallRules = *(*[]WorkerFirewallRule)(unsafe.Pointer(&in.FirewallRules))
+	out.WaitForCriticalComponents = (*bool)(unsafe.Pointer(in.WaitForCriticalComponents))
+	out.MachineImage = (*MachineImageName)(unsafe.Pointer(in.MachineImage))
+	out.KubernetesVersion = (*string)(unsafe.Pointer(in.KubernetesVersion))
+	out.RolloutStage = (*int32)(unsafe.Pointer(in.RolloutStage))
+	out.Spot = (*WorkerSpot)(unsafe.Pointer(in.Spot))
+	out.ScaleDownPriority = (*int32)(unsafe.Pointer(in.ScaleDownPriority))
+	out.Kubelet = (*WorkerKubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.OSUpdates = (*WorkerOSUpdates)(unsafe.Pointer(in.OSUpdates))
+	out.Canary = (*WorkerCanary)(unsafe.Pointer(in.Canary))
+	out.LocalSSDs = (*WorkerLocalSSDs)(unsafe.Pointer(in.LocalSSDs))
+	out.ScaleDownBudget = (*ScaleDownBudget)(unsafe.Pointer(in.ScaleDownBudget))
 	return nil
 }
 
@@ -2728,6 +3891,152 @@ func Convert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker, s c
 	return autoConvert_garden_Worker_To_v1beta1_Worker(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerCanary_To_garden_WorkerCanary(in *WorkerCanary, out *garden.WorkerCanary, s conversion.Scope) error {
+	out.Machines = in.Machines
+	out.SoakPeriod = (*v1.Duration)(unsafe.Pointer(in.SoakPeriod))
+	return nil
+}
+
+// Convert_v1beta1_WorkerCanary_To_garden_WorkerCanary is an autogenerated conversion function.
+func Convert_v1beta1_WorkerCanary_To_garden_WorkerCanary(in *WorkerCanary, out *garden.WorkerCanary, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerCanary_To_garden_WorkerCanary(in, out, s)
+}
+
+func autoConvert_garden_WorkerCanary_To_v1beta1_WorkerCanary(in *garden.WorkerCanary, out *WorkerCanary, s conversion.Scope) error {
+	out.Machines = in.Machines
+	out.SoakPeriod = (*v1.Duration)(unsafe.Pointer(in.SoakPeriod))
+	return nil
+}
+
+// Convert_garden_WorkerCanary_To_v1beta1_WorkerCanary is an autogenerated conversion function.
+func Convert_garden_WorkerCanary_To_v1beta1_WorkerCanary(in *garden.WorkerCanary, out *WorkerCanary, s conversion.Scope) error {
+	return autoConvert_garden_WorkerCanary_To_v1beta1_WorkerCanary(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerFirewallRule_To_garden_WorkerFirewallRule(in *WorkerFirewallRule, out *garden.WorkerFirewallRule, s conversion.Scope) error {
+	out.Protocol = (*core_v1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.EndPort = (*int32)(unsafe.Pointer(in.EndPort))
+	out.SourceCIDRs = *(*[]garden.CIDR)(unsafe.Pointer(&in.SourceCIDRs))
+	return nil
+}
+
+// Convert_v1beta1_WorkerFirewallRule_To_garden_WorkerFirewallRule is an autogenerated conversion function.
+func Convert_v1beta1_WorkerFirewallRule_To_garden_WorkerFirewallRule(in *WorkerFirewallRule, out *garden.WorkerFirewallRule, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerFirewallRule_To_garden_WorkerFirewallRule(in, out, s)
+}
+
+func autoConvert_garden_WorkerFirewallRule_To_v1beta1_WorkerFirewallRule(in *garden.WorkerFirewallRule, out *WorkerFirewallRule, s conversion.Scope) error {
+	out.Protocol = (*core_v1.Protocol)(unsafe.Pointer(in.Protocol))
+	out.Port = in.Port
+	out.EndPort = (*int32)(unsafe.Pointer(in.EndPort))
+	out.SourceCIDRs = *(*[]CIDR)(unsafe.Pointer(&in.SourceCIDRs))
+	return nil
+}
+
+// Convert_garden_WorkerFirewallRule_To_v1beta1_WorkerFirewallRule is an autogenerated conversion function.
+func Convert_garden_WorkerFirewallRule_To_v1beta1_WorkerFirewallRule(in *garden.WorkerFirewallRule, out *WorkerFirewallRule, s conversion.Scope) error {
+	return autoConvert_garden_WorkerFirewallRule_To_v1beta1_WorkerFirewallRule(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig(in *WorkerKubeletConfig, out *garden.WorkerKubeletConfig, s conversion.Scope) error {
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.EvictionSoft = *(*map[string]string)(unsafe.Pointer(&in.EvictionSoft))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.KubeReserved = *(*core_v1.ResourceList)(unsafe.Pointer(&in.KubeReserved))
+	out.SystemReserved = *(*core_v1.ResourceList)(unsafe.Pointer(&in.SystemReserved))
+	out.CPUManagerPolicy = (*string)(unsafe.Pointer(in.CPUManagerPolicy))
+	return nil
+}
+
+// Convert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig is an autogenerated conversion function.
+func Convert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig(in *WorkerKubeletConfig, out *garden.WorkerKubeletConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerKubeletConfig_To_garden_WorkerKubeletConfig(in, out, s)
+}
+
+func autoConvert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig(in *garden.WorkerKubeletConfig, out *WorkerKubeletConfig, s conversion.Scope) error {
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.EvictionSoft = *(*map[string]string)(unsafe.Pointer(&in.EvictionSoft))
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.KubeReserved = *(*core_v1.ResourceList)(unsafe.Pointer(&in.KubeReserved))
+	out.SystemReserved = *(*core_v1.ResourceList)(unsafe.Pointer(&in.SystemReserved))
+	out.CPUManagerPolicy = (*string)(unsafe.Pointer(in.CPUManagerPolicy))
+	return nil
+}
+
+// Convert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig is an autogenerated conversion function.
+func Convert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig(in *garden.WorkerKubeletConfig, out *WorkerKubeletConfig, s conversion.Scope) error {
+	return autoConvert_garden_WorkerKubeletConfig_To_v1beta1_WorkerKubeletConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerLocalSSDs_To_garden_WorkerLocalSSDs(in *WorkerLocalSSDs, out *garden.WorkerLocalSSDs, s conversion.Scope) error {
+	out.Count = in.Count
+	out.Size = (*string)(unsafe.Pointer(in.Size))
+	out.Interface = (*string)(unsafe.Pointer(in.Interface))
+	return nil
+}
+
+// Convert_v1beta1_WorkerLocalSSDs_To_garden_WorkerLocalSSDs is an autogenerated conversion function.
+func Convert_v1beta1_WorkerLocalSSDs_To_garden_WorkerLocalSSDs(in *WorkerLocalSSDs, out *garden.WorkerLocalSSDs, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerLocalSSDs_To_garden_WorkerLocalSSDs(in, out, s)
+}
+
+func autoConvert_garden_WorkerLocalSSDs_To_v1beta1_WorkerLocalSSDs(in *garden.WorkerLocalSSDs, out *WorkerLocalSSDs, s conversion.Scope) error {
+	out.Count = in.Count
+	out.Size = (*string)(unsafe.Pointer(in.Size))
+	out.Interface = (*string)(unsafe.Pointer(in.Interface))
+	return nil
+}
+
+// Convert_garden_WorkerLocalSSDs_To_v1beta1_WorkerLocalSSDs is an autogenerated conversion function.
+func Convert_garden_WorkerLocalSSDs_To_v1beta1_WorkerLocalSSDs(in *garden.WorkerLocalSSDs, out *WorkerLocalSSDs, s conversion.Scope) error {
+	return autoConvert_garden_WorkerLocalSSDs_To_v1beta1_WorkerLocalSSDs(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates(in *WorkerOSUpdates, out *garden.WorkerOSUpdates, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates is an autogenerated conversion function.
+func Convert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates(in *WorkerOSUpdates, out *garden.WorkerOSUpdates, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerOSUpdates_To_garden_WorkerOSUpdates(in, out, s)
+}
+
+func autoConvert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates(in *garden.WorkerOSUpdates, out *WorkerOSUpdates, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates is an autogenerated conversion function.
+func Convert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates(in *garden.WorkerOSUpdates, out *WorkerOSUpdates, s conversion.Scope) error {
+	return autoConvert_garden_WorkerOSUpdates_To_v1beta1_WorkerOSUpdates(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerSpot_To_garden_WorkerSpot(in *WorkerSpot, out *garden.WorkerSpot, s conversion.Scope) error {
+	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
+	out.FallbackToOnDemand = (*bool)(unsafe.Pointer(in.FallbackToOnDemand))
+	out.Taint = (*bool)(unsafe.Pointer(in.Taint))
+	return nil
+}
+
+// Convert_v1beta1_WorkerSpot_To_garden_WorkerSpot is an autogenerated conversion function.
+func Convert_v1beta1_WorkerSpot_To_garden_WorkerSpot(in *WorkerSpot, out *garden.WorkerSpot, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerSpot_To_garden_WorkerSpot(in, out, s)
+}
+
+func autoConvert_garden_WorkerSpot_To_v1beta1_WorkerSpot(in *garden.WorkerSpot, out *WorkerSpot, s conversion.Scope) error {
+	out.MaxPrice = (*string)(unsafe.Pointer(in.MaxPrice))
+	out.FallbackToOnDemand = (*bool)(unsafe.Pointer(in.FallbackToOnDemand))
+	out.Taint = (*bool)(unsafe.Pointer(in.Taint))
+	return nil
+}
+
+// Convert_garden_WorkerSpot_To_v1beta1_WorkerSpot is an autogenerated conversion function.
+func Convert_garden_WorkerSpot_To_v1beta1_WorkerSpot(in *garden.WorkerSpot, out *WorkerSpot, s conversion.Scope) error {
+	return autoConvert_garden_WorkerSpot_To_v1beta1_WorkerSpot(in, out, s)
+}
+
 func autoConvert_v1beta1_Zone_To_garden_Zone(in *Zone, out *garden.Zone, s conversion.Scope) error {
 	out.Region = in.Region
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
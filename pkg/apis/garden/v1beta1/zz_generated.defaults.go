@@ -15,9 +15,13 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&SecretBinding{}, func(obj interface{}) { SetObjectDefaults_SecretBinding(obj.(*SecretBinding)) })
 	scheme.AddTypeDefaultingFunc(&SecretBindingList{}, func(obj interface{}) { SetObjectDefaults_SecretBindingList(obj.(*SecretBindingList)) })
 	scheme.AddTypeDefaultingFunc(&Seed{}, func(obj interface{}) { SetObjectDefaults_Seed(obj.(*Seed)) })
+	scheme.AddTypeDefaultingFunc(&SeedAccessRequest{}, func(obj interface{}) { SetObjectDefaults_SeedAccessRequest(obj.(*SeedAccessRequest)) })
+	scheme.AddTypeDefaultingFunc(&SeedAccessRequestList{}, func(obj interface{}) { SetObjectDefaults_SeedAccessRequestList(obj.(*SeedAccessRequestList)) })
 	scheme.AddTypeDefaultingFunc(&SeedList{}, func(obj interface{}) { SetObjectDefaults_SeedList(obj.(*SeedList)) })
 	scheme.AddTypeDefaultingFunc(&Shoot{}, func(obj interface{}) { SetObjectDefaults_Shoot(obj.(*Shoot)) })
 	scheme.AddTypeDefaultingFunc(&ShootList{}, func(obj interface{}) { SetObjectDefaults_ShootList(obj.(*ShootList)) })
+	scheme.AddTypeDefaultingFunc(&ShootOperation{}, func(obj interface{}) { SetObjectDefaults_ShootOperation(obj.(*ShootOperation)) })
+	scheme.AddTypeDefaultingFunc(&ShootOperationList{}, func(obj interface{}) { SetObjectDefaults_ShootOperationList(obj.(*ShootOperationList)) })
 	return nil
 }
 
@@ -36,6 +40,17 @@ func SetObjectDefaults_Seed(in *Seed) {
 	SetDefaults_Seed(in)
 }
 
+func SetObjectDefaults_SeedAccessRequest(in *SeedAccessRequest) {
+	SetDefaults_SeedAccessRequest(in)
+}
+
+func SetObjectDefaults_SeedAccessRequestList(in *SeedAccessRequestList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_SeedAccessRequest(a)
+	}
+}
+
 func SetObjectDefaults_SeedList(in *SeedList) {
 	for i := range in.Items {
 		a := &in.Items[i]
@@ -53,3 +68,14 @@ func SetObjectDefaults_ShootList(in *ShootList) {
 		SetObjectDefaults_Shoot(a)
 	}
 }
+
+func SetObjectDefaults_ShootOperation(in *ShootOperation) {
+	SetDefaults_ShootOperation(in)
+}
+
+func SetObjectDefaults_ShootOperationList(in *ShootOperationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootOperation(a)
+	}
+}
@@ -32,6 +32,9 @@ import (
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
@@ -70,7 +73,7 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 	allErrs := field.ErrorList{}
 
 	if _, err := helper.DetermineCloudProviderInProfile(*spec); err != nil {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("aws/azure/gcp/openstack/local"), "cloud profile must only contain exactly one field of aws/azure/gcp/openstack"))
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("aws/azure/gcp/openstack/packet/local"), "cloud profile must only contain exactly one field of aws/azure/gcp/openstack/packet/local"))
 		return allErrs
 	}
 
@@ -142,6 +145,14 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		}
 	}
 
+	if spec.Packet != nil {
+		allErrs = append(allErrs, validateDNSProviders(spec.Packet.Constraints.DNSProviders, fldPath.Child("packet", "constraints", "dnsProviders"))...)
+		allErrs = append(allErrs, validateKubernetesConstraints(spec.Packet.Constraints.Kubernetes, fldPath.Child("packet", "constraints", "kubernetes"))...)
+		allErrs = append(allErrs, validatePacketMachineImages(spec.Packet.Constraints.MachineImages, fldPath.Child("packet", "constraints", "machineImages"))...)
+		allErrs = append(allErrs, validateMachineTypeConstraints(spec.Packet.Constraints.MachineTypes, fldPath.Child("packet", "constraints", "machineTypes"))...)
+		allErrs = append(allErrs, validateZones(spec.Packet.Constraints.Zones, fldPath.Child("packet", "constraints", "zones"))...)
+	}
+
 	if spec.CABundle != nil {
 		_, err := utils.DecodeCertificate([]byte(*(spec.CABundle)))
 		if err != nil {
@@ -149,6 +160,23 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 		}
 	}
 
+	if spec.SeedSelectionStrategy != nil {
+		allErrs = append(allErrs, ValidateSeedSelectionStrategy(*spec.SeedSelectionStrategy, fldPath.Child("seedSelectionStrategy"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateSeedSelectionStrategy validates a strategy used to determine the Seed cluster of a Shoot.
+func ValidateSeedSelectionStrategy(strategy garden.SeedSelectionStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch strategy {
+	case garden.SeedSelectionStrategySameRegion, garden.SeedSelectionStrategyMinimalDistance, garden.SeedSelectionStrategyManual:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, strategy, []string{string(garden.SeedSelectionStrategySameRegion), string(garden.SeedSelectionStrategyMinimalDistance), string(garden.SeedSelectionStrategyManual)}))
+	}
+
 	return allErrs
 }
 
@@ -207,12 +235,30 @@ func validateMachineTypeConstraints(machineTypes []garden.MachineType, fldPath *
 		allErrs = append(allErrs, validateResourceQuantityValue("cpu", machineType.CPU, cpuPath)...)
 		allErrs = append(allErrs, validateResourceQuantityValue("gpu", machineType.GPU, gpuPath)...)
 		allErrs = append(allErrs, validateResourceQuantityValue("memory", machineType.Memory, memoryPath)...)
+		if machineType.Architecture != nil {
+			allErrs = append(allErrs, validateMachineArchitecture(*machineType.Architecture, idxPath.Child("architecture"))...)
+		}
 	}
 
 	return allErrs
 }
 
-func validateMachineImageNames(names []garden.MachineImageName, fldPath *field.Path) field.ErrorList {
+func validateMachineArchitecture(architecture garden.MachineArchitecture, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch architecture {
+	case garden.MachineArchitectureAMD64, garden.MachineArchitectureARM64:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, architecture, []string{string(garden.MachineArchitectureAMD64), string(garden.MachineArchitectureARM64)}))
+	}
+
+	return allErrs
+}
+
+// validateMachineImageNames validates the names and the architectures of the machine images of a cloud profile. Every
+// machine image name may only occur once per architecture, and at least one image must be provided for the default
+// architecture.
+func validateMachineImageNames(names []garden.MachineImageName, architectures []*garden.MachineArchitecture, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if len(names) == 0 {
@@ -220,31 +266,51 @@ func validateMachineImageNames(names []garden.MachineImageName, fldPath *field.P
 		return allErrs
 	}
 
-	imageNames := map[garden.MachineImageName]bool{}
+	var (
+		imageNames          = map[string]bool{}
+		defaultArchitecture = false
+	)
 	for i, name := range names {
 		idxPath := fldPath.Index(i)
 
-		if imageNames[name] {
+		if architectures[i] != nil {
+			allErrs = append(allErrs, validateMachineArchitecture(*architectures[i], idxPath.Child("architecture"))...)
+		}
+		architecture := helper.GetMachineArchitecture(architectures[i])
+		if architecture == garden.MachineArchitectureAMD64 {
+			defaultArchitecture = true
+		}
+
+		key := fmt.Sprintf("%s/%s", name, architecture)
+		if imageNames[key] {
 			allErrs = append(allErrs, field.Duplicate(idxPath, name))
 		}
-		imageNames[name] = true
+		imageNames[key] = true
 
 		if name != garden.MachineImageCoreOS {
 			allErrs = append(allErrs, field.NotSupported(idxPath, name, []string{string(garden.MachineImageCoreOS)}))
 		}
 	}
 
+	if !defaultArchitecture {
+		allErrs = append(allErrs, field.Required(fldPath, fmt.Sprintf("must provide at least one machine image for the %s architecture", garden.MachineArchitectureAMD64)))
+	}
+
 	return allErrs
 }
 
 func validateAWSMachineImages(machineImages []garden.AWSMachineImageMapping, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	machineImageNames := []garden.MachineImageName{}
+	var (
+		machineImageNames         = []garden.MachineImageName{}
+		machineImageArchitectures = []*garden.MachineArchitecture{}
+	)
 	r, _ := regexp.Compile(`^ami-[a-z0-9]+$`)
 
 	for i, image := range machineImages {
 		machineImageNames = append(machineImageNames, image.Name)
+		machineImageArchitectures = append(machineImageArchitectures, image.Architecture)
 		idxPath := fldPath.Index(i)
 
 		if len(image.Regions) == 0 {
@@ -263,19 +329,26 @@ func validateAWSMachineImages(machineImages []garden.AWSMachineImageMapping, fld
 			if !r.MatchString(region.AMI) {
 				allErrs = append(allErrs, field.Invalid(regionIdxPath.Child("ami"), region.AMI, fmt.Sprintf("ami's must match the regex %s", r)))
 			}
+			if region.WarmAMI != nil && !r.MatchString(*region.WarmAMI) {
+				allErrs = append(allErrs, field.Invalid(regionIdxPath.Child("warmAMI"), *region.WarmAMI, fmt.Sprintf("ami's must match the regex %s", r)))
+			}
 		}
 	}
 
-	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, fldPath)...)
+	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, machineImageArchitectures, fldPath)...)
 	return allErrs
 }
 
 func validateAzureMachineImages(machineImages []garden.AzureMachineImage, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	machineImageNames := []garden.MachineImageName{}
+	var (
+		machineImageNames         = []garden.MachineImageName{}
+		machineImageArchitectures = []*garden.MachineArchitecture{}
+	)
 	for i, image := range machineImages {
 		machineImageNames = append(machineImageNames, image.Name)
+		machineImageArchitectures = append(machineImageArchitectures, image.Architecture)
 		idxPath := fldPath.Index(i)
 
 		if len(image.Publisher) == 0 {
@@ -292,33 +365,44 @@ func validateAzureMachineImages(machineImages []garden.AzureMachineImage, fldPat
 		}
 	}
 
-	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, fldPath)...)
+	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, machineImageArchitectures, fldPath)...)
 	return allErrs
 }
 
 func validateGCPMachineImages(machineImages []garden.GCPMachineImage, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	machineImageNames := []garden.MachineImageName{}
+	var (
+		machineImageNames         = []garden.MachineImageName{}
+		machineImageArchitectures = []*garden.MachineArchitecture{}
+	)
 	for i, image := range machineImages {
 		machineImageNames = append(machineImageNames, image.Name)
+		machineImageArchitectures = append(machineImageArchitectures, image.Architecture)
 		idxPath := fldPath.Index(i)
 
 		if len(image.Image) == 0 {
 			allErrs = append(allErrs, field.Required(idxPath.Child("image"), image.Image))
 		}
+		if image.WarmImage != nil && len(*image.WarmImage) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("warmImage"), "must not be empty if set"))
+		}
 	}
 
-	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, fldPath)...)
+	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, machineImageArchitectures, fldPath)...)
 	return allErrs
 }
 
 func validateOpenStackMachineImages(machineImages []garden.OpenStackMachineImage, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	machineImageNames := []garden.MachineImageName{}
+	var (
+		machineImageNames         = []garden.MachineImageName{}
+		machineImageArchitectures = []*garden.MachineArchitecture{}
+	)
 	for i, image := range machineImages {
 		machineImageNames = append(machineImageNames, image.Name)
+		machineImageArchitectures = append(machineImageArchitectures, image.Architecture)
 		idxPath := fldPath.Index(i)
 
 		if len(image.Image) == 0 {
@@ -326,7 +410,28 @@ func validateOpenStackMachineImages(machineImages []garden.OpenStackMachineImage
 		}
 	}
 
-	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, fldPath)...)
+	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, machineImageArchitectures, fldPath)...)
+	return allErrs
+}
+
+func validatePacketMachineImages(machineImages []garden.PacketMachineImage, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		machineImageNames         = []garden.MachineImageName{}
+		machineImageArchitectures = []*garden.MachineArchitecture{}
+	)
+	for i, image := range machineImages {
+		machineImageNames = append(machineImageNames, image.Name)
+		machineImageArchitectures = append(machineImageArchitectures, image.Architecture)
+		idxPath := fldPath.Index(i)
+
+		if len(image.OperatingSystem) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("operatingSystem"), image.OperatingSystem))
+		}
+	}
+
+	allErrs = append(allErrs, validateMachineImageNames(machineImageNames, machineImageArchitectures, fldPath)...)
 	return allErrs
 }
 
@@ -478,6 +583,44 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 	allErrs = append(allErrs, validateCIDR(seedSpec.Networks.Pods, networksPath.Child("pods"))...)
 	allErrs = append(allErrs, validateCIDR(seedSpec.Networks.Services, networksPath.Child("services"))...)
 
+	if seedSpec.ShootKubernetesVersions != nil {
+		allErrs = append(allErrs, validateSeedShootKubernetesVersions(seedSpec.ShootKubernetesVersions, fldPath.Child("shootKubernetesVersions"))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedShootKubernetesVersions(versions *garden.SeedShootKubernetesVersions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var minVersion, maxVersion *semver.Version
+	if versions.Min != nil {
+		v, err := semver.NewVersion(*versions.Min)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("min"), *versions.Min, err.Error()))
+		}
+		minVersion = v
+	}
+	if versions.Max != nil {
+		v, err := semver.NewVersion(*versions.Max)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("max"), *versions.Max, err.Error()))
+		}
+		maxVersion = v
+	}
+
+	if minVersion != nil && maxVersion != nil {
+		greater := minVersion.GreaterThan(maxVersion)
+		if strings.Count(strings.TrimPrefix(*versions.Max, "v"), ".") == 1 {
+			// A maximum version without patch level allows all patch versions of its minor version.
+			nextMinorVersion := maxVersion.IncMinor()
+			greater = !minVersion.LessThan(&nextMinorVersion)
+		}
+		if greater {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("min"), *versions.Min, "must not be greater than the maximum version"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -677,6 +820,10 @@ func ValidateShootUpdate(newShoot, oldShoot *garden.Shoot) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShoot.ObjectMeta, &oldShoot.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, &oldShoot.Spec, newShoot.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	// A progressing Kubernetes upgrade may be rolled back to the previous version.
+	if !helper.IsKubernetesUpgradeRollback(newShoot, oldShoot) {
+		allErrs = append(allErrs, validateKubernetesVersionUpdate(newShoot.Spec.Kubernetes.Version, oldShoot.Spec.Kubernetes.Version, field.NewPath("spec", "kubernetes", "version"))...)
+	}
 	allErrs = append(allErrs, ValidateShoot(newShoot)...)
 
 	return allErrs
@@ -689,15 +836,17 @@ func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorL
 	cloudPath := fldPath.Child("cloud")
 	provider, err := helper.DetermineCloudProviderInShoot(spec.Cloud)
 	if err != nil {
-		allErrs = append(allErrs, field.Forbidden(cloudPath.Child("aws/azure/gcp/openstack/local"), "cloud section must only contain exactly one field of aws/azure/gcp/openstack/local"))
+		allErrs = append(allErrs, field.Forbidden(cloudPath.Child("aws/azure/gcp/openstack/packet/local"), "cloud section must only contain exactly one field of aws/azure/gcp/openstack/packet/local"))
 		return allErrs
 	}
 
 	allErrs = append(allErrs, validateAddons(spec.Addons, fldPath.Child("addons"))...)
 	allErrs = append(allErrs, validateBackup(spec.Backup, provider, fldPath.Child("backup"))...)
 	allErrs = append(allErrs, validateCloud(spec.Cloud, fldPath.Child("cloud"))...)
+	allErrs = append(allErrs, validateWorkerKubernetesVersions(spec.Cloud, spec.Kubernetes.Version, fldPath.Child("cloud"))...)
+	allErrs = append(allErrs, validateShootDependencies(spec.DependsOn, fldPath.Child("dependsOn"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
-	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, fldPath.Child("kubernetes"))...)
+	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, provider, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"))...)
 
 	if spec.DNS.Provider == garden.DNSUnmanaged {
@@ -776,6 +925,15 @@ func validateAddons(addons *garden.Addons, fldPath *field.Path) field.ErrorList
 		}
 	}
 
+	if addons.NetworkPolicies != nil && addons.NetworkPolicies.Enabled {
+		excludedNamespacesPath := fldPath.Child("network-policies", "excludedNamespaces")
+		for i, namespace := range addons.NetworkPolicies.ExcludedNamespaces {
+			for _, msg := range apivalidation.ValidateNamespaceName(namespace, false) {
+				allErrs = append(allErrs, field.Invalid(excludedNamespacesPath.Index(i), namespace, msg))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -857,6 +1015,14 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range aws.Workers {
 			idxPath := awsPath.Child("workers").Index(i)
 			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			if worker.LocalSSDs != nil {
+				if worker.LocalSSDs.Size != nil {
+					allErrs = append(allErrs, field.Forbidden(idxPath.Child("localSSDs", "size"), "the size of the local SSDs is determined by the machine type on AWS"))
+				}
+				if worker.LocalSSDs.Interface != nil {
+					allErrs = append(allErrs, field.Forbidden(idxPath.Child("localSSDs", "interface"), "the interface of the local SSDs is determined by the machine type on AWS"))
+				}
+			}
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 20, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
@@ -911,12 +1077,18 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range azure.Workers {
 			idxPath := azurePath.Child("workers").Index(i)
 			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			if worker.Spot != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("spot"), "spot worker groups are not supported on Azure"))
+			}
+			if worker.LocalSSDs != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("localSSDs"), "local SSDs are not supported on Azure"))
+			}
+			if len(worker.FirewallRules) > 0 {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("firewallRules"), "firewall rules of worker groups are not supported on Azure"))
+			}
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 35, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
-			if worker.AutoScalerMax != worker.AutoScalerMin {
-				allErrs = append(allErrs, field.Forbidden(idxPath.Child("autoScalerMax"), "maximum value must be equal to minimum value"))
-			}
 			if workerNames[worker.Name] {
 				allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
 			}
@@ -953,6 +1125,19 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range gcp.Workers {
 			idxPath := gcpPath.Child("workers").Index(i)
 			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			if worker.Spot != nil {
+				if worker.Spot.MaxPrice != nil {
+					allErrs = append(allErrs, field.Forbidden(idxPath.Child("spot", "maxPrice"), "a maximum price is not supported on GCP"))
+				}
+				if worker.Preemptible != nil && !*worker.Preemptible {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("preemptible"), *worker.Preemptible, "must not be false for spot worker groups"))
+				}
+			}
+			if worker.LocalSSDs != nil && worker.LocalSSDs.Size != nil {
+				if size, err := resource.ParseQuantity(*worker.LocalSSDs.Size); err == nil && size.Cmp(gcpLocalSSDSize) != 0 {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("localSSDs", "size"), *worker.LocalSSDs.Size, fmt.Sprintf("local SSDs have a fixed size of %s on GCP", gcpLocalSSDSize.String())))
+				}
+			}
 			allErrs = append(allErrs, validateWorkerVolumeSize(worker.VolumeSize, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerMinimumVolumeSize(worker.VolumeSize, 20, idxPath.Child("volumeSize"))...)
 			allErrs = append(allErrs, validateWorkerVolumeType(worker.VolumeType, idxPath.Child("volumeType"))...)
@@ -1000,8 +1185,41 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		for i, worker := range openStack.Workers {
 			idxPath := openStackPath.Child("workers").Index(i)
 			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
-			if worker.AutoScalerMax != worker.AutoScalerMin {
-				allErrs = append(allErrs, field.Forbidden(idxPath.Child("autoScalerMax"), "maximum value must be equal to minimum value"))
+			if worker.Spot != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("spot"), "spot worker groups are not supported on OpenStack"))
+			}
+			if worker.LocalSSDs != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("localSSDs"), "local SSDs are not supported on OpenStack"))
+			}
+			if workerNames[worker.Name] {
+				allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
+			}
+			workerNames[worker.Name] = true
+		}
+	}
+
+	packet := cloud.Packet
+	packetPath := fldPath.Child("packet")
+	if packet != nil {
+		if len(packet.Zones) == 0 {
+			allErrs = append(allErrs, field.Required(packetPath.Child("zones"), "must specify at least one zone"))
+			return allErrs
+		}
+
+		allErrs = append(allErrs, validateK8SNetworks(packet.Networks.K8SNetworks, packetPath.Child("networks"))...)
+
+		if len(packet.Workers) == 0 {
+			allErrs = append(allErrs, field.Required(packetPath.Child("workers"), "must specify at least one worker"))
+			return allErrs
+		}
+		for i, worker := range packet.Workers {
+			idxPath := packetPath.Child("workers").Index(i)
+			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			if worker.Spot != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("spot"), "spot worker groups are not supported on Packet"))
+			}
+			if worker.LocalSSDs != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("localSSDs"), "local SSDs are not supported on Packet"))
 			}
 			if workerNames[worker.Name] {
 				allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
@@ -1010,6 +1228,38 @@ func validateCloud(cloud garden.Cloud, fldPath *field.Path) field.ErrorList {
 		}
 	}
 
+	local := cloud.Local
+	localPath := fldPath.Child("local")
+	if local != nil {
+		for i, worker := range local.Workers {
+			idxPath := localPath.Child("workers").Index(i)
+			allErrs = append(allErrs, validateWorker(worker.Worker, idxPath)...)
+			if worker.Spot != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("spot"), "spot worker groups are not supported on Local"))
+			}
+			if worker.LocalSSDs != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("localSSDs"), "local SSDs are not supported on Local"))
+			}
+			if workerNames[worker.Name] {
+				allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
+			}
+			workerNames[worker.Name] = true
+		}
+	}
+
+	for i, worker := range cloud.ExternalWorkers {
+		idxPath := fldPath.Child("externalWorkers").Index(i)
+		allErrs = append(allErrs, validateExternalWorker(worker, idxPath)...)
+		if workerNames[worker.Name] {
+			allErrs = append(allErrs, field.Duplicate(idxPath, worker.Name))
+		}
+		workerNames[worker.Name] = true
+	}
+
+	if cloud.ScaleDownBudget != nil {
+		allErrs = append(allErrs, validateScaleDownBudget(*cloud.ScaleDownBudget, fldPath.Child("scaleDownBudget"))...)
+	}
+
 	return allErrs
 }
 
@@ -1062,8 +1312,16 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.OpenStack.Zones, oldSpec.Cloud.OpenStack.Zones, openStackPath.Child("zones"))...)
 	}
 
+	packetPath := fldPath.Child("cloud", "packet")
+	if oldSpec.Cloud.Packet != nil && newSpec.Cloud.Packet == nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Packet, oldSpec.Cloud.Packet, packetPath)...)
+		return allErrs
+	} else if newSpec.Cloud.Packet != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Packet.Networks, oldSpec.Cloud.Packet.Networks, packetPath.Child("networks"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Cloud.Packet.Zones, oldSpec.Cloud.Packet.Zones, packetPath.Child("zones"))...)
+	}
+
 	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, fldPath.Child("dns"))...)
-	allErrs = append(allErrs, validateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
 
 	return allErrs
 }
@@ -1160,7 +1418,7 @@ func validateK8SNetworks(networks garden.K8SNetworks, fldPath *field.Path) field
 	return allErrs
 }
 
-func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
+func validateKubernetes(kubernetes garden.Kubernetes, cloudProvider garden.CloudProvider, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	kubeAPIServer := kubernetes.KubeAPIServer
@@ -1192,6 +1450,125 @@ func validateKubernetes(kubernetes garden.Kubernetes, fldPath *field.Path) field
 				allErrs = append(allErrs, field.Invalid(oidcPath.Child("usernamePrefix"), oidc.UsernamePrefix, "username prefix cannot be empty when key is provided"))
 			}
 		}
+
+		if requests := kubeAPIServer.Requests; requests != nil {
+			requestsPath := fldPath.Child("kubeAPIServer", "requests")
+
+			if requests.MaxNonMutatingInflight != nil {
+				allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*requests.MaxNonMutatingInflight), requestsPath.Child("maxNonMutatingInflight"))...)
+			}
+			if requests.MaxMutatingInflight != nil {
+				allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*requests.MaxMutatingInflight), requestsPath.Child("maxMutatingInflight"))...)
+			}
+		}
+
+		if watchCacheSizes := kubeAPIServer.WatchCacheSizes; watchCacheSizes != nil {
+			allErrs = append(allErrs, validateWatchCacheSizes(watchCacheSizes, kubernetes.Version, fldPath.Child("kubeAPIServer", "watchCacheSizes"))...)
+		}
+
+		if eventTTL := kubeAPIServer.EventTTL; eventTTL != nil && eventTTL.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("kubeAPIServer", "eventTTL"), eventTTL.Duration.String(), "must be greater than 0"))
+		}
+
+		if loadBalancerIP := kubeAPIServer.LoadBalancerIP; loadBalancerIP != nil {
+			loadBalancerIPPath := fldPath.Child("kubeAPIServer", "loadBalancerIP")
+			if cloudProvider == garden.CloudProviderAWS || cloudProvider == garden.CloudProviderLocal {
+				allErrs = append(allErrs, field.Forbidden(loadBalancerIPPath, fmt.Sprintf("a static load balancer IP is not supported for cloud provider '%s'", cloudProvider)))
+			} else if net.ParseIP(*loadBalancerIP) == nil {
+				allErrs = append(allErrs, field.Invalid(loadBalancerIPPath, *loadBalancerIP, "must be a valid IP address"))
+			}
+		}
+
+		if serviceAnnotations := kubeAPIServer.ServiceAnnotations; serviceAnnotations != nil {
+			allErrs = append(allErrs, validateKubeAPIServerServiceAnnotations(serviceAnnotations, cloudProvider, fldPath.Child("kubeAPIServer", "serviceAnnotations"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// kubeAPIServerServiceAnnotationPrefixes are the prefixes of the annotations of the kube-apiserver Service which the
+// cloud controllers of the respective cloud providers consider for the load balancers.
+var kubeAPIServerServiceAnnotationPrefixes = map[garden.CloudProvider][]string{
+	garden.CloudProviderAWS:       {"service.beta.kubernetes.io/aws-load-balancer-"},
+	garden.CloudProviderAzure:     {"service.beta.kubernetes.io/azure-"},
+	garden.CloudProviderGCP:       {"cloud.google.com/", "networking.gke.io/"},
+	garden.CloudProviderOpenStack: {"service.beta.kubernetes.io/openstack-", "loadbalancer.openstack.org/"},
+}
+
+func validateKubeAPIServerServiceAnnotations(annotations map[string]string, cloudProvider garden.CloudProvider, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	prefixes, ok := kubeAPIServerServiceAnnotationPrefixes[cloudProvider]
+	if !ok {
+		if len(annotations) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("service annotations are not supported for cloud provider '%s'", cloudProvider)))
+		}
+		return allErrs
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(annotations, fldPath)...)
+	for key := range annotations {
+		supported := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), fmt.Sprintf("only annotations with the prefixes %s are supported for cloud provider '%s'", strings.Join(prefixes, ", "), cloudProvider)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWatchCacheSizes(watchCacheSizes *garden.WatchCacheSizes, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if watchCacheSizes.Default != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*watchCacheSizes.Default), fldPath.Child("default"))...)
+	}
+
+	// The kube-apiserver accepts resources qualified with their API group only as of Kubernetes 1.10.
+	groupsSupported, err := utils.CompareVersions(kubernetesVersion, ">=", "1.10")
+	if err != nil {
+		groupsSupported = true
+	}
+
+	resourceRegex, _ := regexp.Compile(`^[a-z]+(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	for resource, size := range watchCacheSizes.Resources {
+		resourcePath := fldPath.Child("resources").Key(resource)
+
+		if !resourceRegex.MatchString(resource) {
+			allErrs = append(allErrs, field.Invalid(resourcePath, resource, "must be a lowercase plural resource name, optionally qualified with its API group (resource.group)"))
+		} else if strings.Contains(resource, ".") && !groupsSupported {
+			allErrs = append(allErrs, field.Invalid(resourcePath, resource, "resources qualified with an API group require Kubernetes 1.10 or later"))
+		}
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(size), resourcePath)...)
+	}
+
+	return allErrs
+}
+
+func validateShootDependencies(dependencies []corev1.ObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	references := make(map[string]bool, len(dependencies))
+	for i, dependency := range dependencies {
+		idxPath := fldPath.Index(i)
+
+		if len(dependency.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the Shoot"))
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", dependency.Namespace, dependency.Name)
+		if references[key] {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		}
+		references[key] = true
 	}
 
 	return allErrs
@@ -1265,6 +1642,466 @@ func validateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
 	if worker.AutoScalerMax < worker.AutoScalerMin {
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("autoScalerMax"), "maximum value must not be less or equal than minimum value"))
 	}
+	if worker.MachineHealthTimeout != nil && worker.MachineHealthTimeout.Duration < time.Minute {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machineHealthTimeout"), worker.MachineHealthTimeout.Duration.String(), "value must be at least one minute"))
+	}
+	if worker.MaxUnhealthy != nil {
+		allErrs = append(allErrs, validateIntOrPercent(*worker.MaxUnhealthy, fldPath.Child("maxUnhealthy"))...)
+	}
+	if worker.MaxEvictRetries != nil && *worker.MaxEvictRetries < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxEvictRetries"), *worker.MaxEvictRetries, "value must not be negative"))
+	}
+	allErrs = append(allErrs, validateWorkerUnhealthyNodeConditions(worker.UnhealthyNodeConditions, fldPath.Child("unhealthyNodeConditions"))...)
+	allErrs = append(allErrs, validateWorkerRollingUpdate(worker, fldPath)...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(worker.Labels, fldPath.Child("labels"))...)
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(worker.Annotations, fldPath.Child("annotations"))...)
+	allErrs = append(allErrs, validateWorkerNodeLabels(worker.NodeLabels, fldPath.Child("nodeLabels"))...)
+	allErrs = append(allErrs, validateWorkerNodeAnnotations(worker.NodeAnnotations, fldPath.Child("nodeAnnotations"))...)
+	allErrs = append(allErrs, validateWorkerNodeTaints(worker.NodeTaints, fldPath.Child("nodeTaints"))...)
+	if worker.Architecture != nil {
+		allErrs = append(allErrs, validateMachineArchitecture(*worker.Architecture, fldPath.Child("architecture"))...)
+	}
+	allErrs = append(allErrs, validateWorkerFirewallRules(worker.FirewallRules, fldPath.Child("firewallRules"))...)
+	if worker.MachineImage != nil && len(*worker.MachineImage) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machineImage"), *worker.MachineImage, "must not be empty"))
+	}
+	if worker.RolloutStage != nil && *worker.RolloutStage < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rolloutStage"), *worker.RolloutStage, "value must not be negative"))
+	}
+	if worker.Spot != nil && worker.Spot.MaxPrice != nil {
+		if price, err := strconv.ParseFloat(*worker.Spot.MaxPrice, 64); err != nil || price <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("spot", "maxPrice"), *worker.Spot.MaxPrice, "must be a positive decimal number"))
+		}
+	}
+	if worker.ScaleDownPriority != nil && (*worker.ScaleDownPriority < 1 || *worker.ScaleDownPriority > 4) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownPriority"), *worker.ScaleDownPriority, "value must be between 1 and 4"))
+	}
+	if worker.Kubelet != nil {
+		allErrs = append(allErrs, validateWorkerKubelet(*worker.Kubelet, fldPath.Child("kubelet"))...)
+	}
+	if worker.Canary != nil {
+		if worker.Canary.Machines < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("canary", "machines"), worker.Canary.Machines, "value must be at least 1"))
+		}
+		if worker.Canary.SoakPeriod != nil && worker.Canary.SoakPeriod.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("canary", "soakPeriod"), worker.Canary.SoakPeriod.Duration.String(), "value must not be negative"))
+		}
+	}
+	if worker.LocalSSDs != nil {
+		allErrs = append(allErrs, validateWorkerLocalSSDs(*worker.LocalSSDs, fldPath.Child("localSSDs"))...)
+	}
+	if worker.ScaleDownBudget != nil {
+		allErrs = append(allErrs, validateScaleDownBudget(*worker.ScaleDownBudget, fldPath.Child("scaleDownBudget"))...)
+	}
+
+	return allErrs
+}
+
+// maxLocalSSDs is the maximum number of local SSDs which can be attached to a machine.
+const maxLocalSSDs = 24
+
+// gcpLocalSSDSize is the fixed size of the local SSDs on GCP.
+var gcpLocalSSDSize = resource.MustParse("375Gi")
+
+func validateWorkerLocalSSDs(localSSDs garden.WorkerLocalSSDs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if localSSDs.Count < 1 || localSSDs.Count > maxLocalSSDs {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("count"), localSSDs.Count, fmt.Sprintf("value must be between 1 and %d", maxLocalSSDs)))
+	}
+	if localSSDs.Size != nil {
+		if _, err := resource.ParseQuantity(*localSSDs.Size); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("size"), *localSSDs.Size, fmt.Sprintf("must be a valid quantity: %v", err)))
+		}
+	}
+	if localSSDs.Interface != nil {
+		validInterfaces := sets.NewString(garden.LocalSSDInterfaceSCSI, garden.LocalSSDInterfaceNVME)
+		if !validInterfaces.Has(*localSSDs.Interface) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("interface"), *localSSDs.Interface, validInterfaces.List()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateScaleDownBudget(budget garden.ScaleDownBudget, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if budget.MaxMachines < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMachines"), budget.MaxMachines, "value must be at least 1"))
+	}
+	if budget.Period != nil && budget.Period.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("period"), budget.Period.Duration.String(), "value must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateExternalWorker(worker garden.ExternalWorker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateDNS1123Subdomain(worker.Name, fldPath.Child("name"))...)
+	maxWorkerNameLength := 15
+	if len(worker.Name) > maxWorkerNameLength {
+		allErrs = append(allErrs, field.TooLong(fldPath.Child("name"), worker.Name, maxWorkerNameLength))
+	}
+	allErrs = append(allErrs, validateWorkerNodeLabels(worker.NodeLabels, fldPath.Child("nodeLabels"))...)
+	allErrs = append(allErrs, validateWorkerNodeTaints(worker.NodeTaints, fldPath.Child("nodeTaints"))...)
+	if worker.Architecture != nil {
+		allErrs = append(allErrs, validateMachineArchitecture(*worker.Architecture, fldPath.Child("architecture"))...)
+	}
+	if worker.Kubelet != nil {
+		allErrs = append(allErrs, validateWorkerKubelet(*worker.Kubelet, fldPath.Child("kubelet"))...)
+	}
+
+	return allErrs
+}
+
+// validateWorkerKubernetesVersions validates the Kubernetes versions of the worker groups in the given <cloud> against
+// the Kubernetes version of the control plane.
+func validateWorkerKubernetesVersions(cloud garden.Cloud, kubernetesVersion string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		workers     []garden.Worker
+		workersPath *field.Path
+	)
+
+	switch {
+	case cloud.AWS != nil:
+		for _, worker := range cloud.AWS.Workers {
+			workers = append(workers, worker.Worker)
+		}
+		workersPath = fldPath.Child("aws", "workers")
+	case cloud.Azure != nil:
+		for _, worker := range cloud.Azure.Workers {
+			workers = append(workers, worker.Worker)
+		}
+		workersPath = fldPath.Child("azure", "workers")
+	case cloud.GCP != nil:
+		for _, worker := range cloud.GCP.Workers {
+			workers = append(workers, worker.Worker)
+		}
+		workersPath = fldPath.Child("gcp", "workers")
+	case cloud.OpenStack != nil:
+		for _, worker := range cloud.OpenStack.Workers {
+			workers = append(workers, worker.Worker)
+		}
+		workersPath = fldPath.Child("openstack", "workers")
+	case cloud.Packet != nil:
+		for _, worker := range cloud.Packet.Workers {
+			workers = append(workers, worker.Worker)
+		}
+		workersPath = fldPath.Child("packet", "workers")
+	}
+
+	for i, worker := range workers {
+		allErrs = append(allErrs, validateWorkerKubeletVersion(worker.KubernetesVersion, worker.Kubelet, kubernetesVersion, workersPath.Index(i))...)
+	}
+	for i, worker := range cloud.ExternalWorkers {
+		allErrs = append(allErrs, validateWorkerKubeletVersion(worker.KubernetesVersion, worker.Kubelet, kubernetesVersion, fldPath.Child("externalWorkers").Index(i))...)
+	}
+
+	return allErrs
+}
+
+// validateWorkerKubeletVersion validates the Kubernetes <version> of a worker group against the <controlPlaneVersion>
+// and whether its <kubelet> configuration is supported by it.
+func validateWorkerKubeletVersion(version *string, kubelet *garden.WorkerKubeletConfig, controlPlaneVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	workerKubernetesVersion := controlPlaneVersion
+	if version != nil {
+		allErrs = append(allErrs, validateWorkerKubernetesVersion(*version, controlPlaneVersion, fldPath.Child("kubernetesVersion"))...)
+		workerKubernetesVersion = *version
+	}
+	// The kubelet configuration is rendered into the kubelet config file, which is only used as of Kubernetes 1.10.
+	if kubelet != nil {
+		if configFileSupported, err := utils.CompareVersions(workerKubernetesVersion, ">=", "1.10"); err == nil && !configFileSupported {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubelet"), "is only supported for Kubernetes versions >= 1.10"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkerKubernetesVersion validates that the Kubernetes <version> of a worker group is not newer than the
+// <controlPlaneVersion> and at most two minor versions older (the version skew supported by Kubernetes).
+func validateWorkerKubernetesVersion(version, controlPlaneVersion string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	workerVersion, err := semver.NewVersion(version)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, version, err.Error()))
+		return allErrs
+	}
+	// The version of the control plane is checked against the CloudProfile by the ShootValidator admission plugin.
+	kubernetesVersion, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return allErrs
+	}
+
+	if workerVersion.GreaterThan(kubernetesVersion) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must not be newer than the Kubernetes version of the control plane"))
+	} else if workerVersion.Major() != kubernetesVersion.Major() || kubernetesVersion.Minor()-workerVersion.Minor() > 2 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "must be at most two minor versions older than the Kubernetes version of the control plane"))
+	}
+
+	return allErrs
+}
+
+// kubeletEvictionSignals are the eviction signals whose thresholds may be configured for the kubelets of a worker group.
+var kubeletEvictionSignals = sets.NewString("memory.available", "nodefs.available", "nodefs.inodesFree", "imagefs.available", "imagefs.inodesFree")
+
+// kubeletReservedResources are the resources which may be reserved by the kubelets of a worker group.
+var kubeletReservedResources = sets.NewString(string(corev1.ResourceCPU), string(corev1.ResourceMemory), string(corev1.ResourceEphemeralStorage))
+
+func validateWorkerKubelet(kubelet garden.WorkerKubeletConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateWorkerKubeletEvictionThresholds(kubelet.EvictionHard, fldPath.Child("evictionHard"))...)
+	allErrs = append(allErrs, validateWorkerKubeletEvictionThresholds(kubelet.EvictionSoft, fldPath.Child("evictionSoft"))...)
+	if kubelet.MaxPods != nil && *kubelet.MaxPods <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPods"), *kubelet.MaxPods, "value must be positive"))
+	}
+	allErrs = append(allErrs, validateWorkerKubeletReservedResources(kubelet.KubeReserved, fldPath.Child("kubeReserved"))...)
+	allErrs = append(allErrs, validateWorkerKubeletReservedResources(kubelet.SystemReserved, fldPath.Child("systemReserved"))...)
+
+	if kubelet.CPUManagerPolicy != nil {
+		switch *kubelet.CPUManagerPolicy {
+		case "none":
+		case "static":
+			// The static policy requires a CPU reservation, otherwise the kubelet does not start.
+			reservedCPU := resource.Quantity{}
+			if cpu, ok := kubelet.KubeReserved[corev1.ResourceCPU]; ok {
+				reservedCPU.Add(cpu)
+			}
+			if cpu, ok := kubelet.SystemReserved[corev1.ResourceCPU]; ok {
+				reservedCPU.Add(cpu)
+			}
+			if reservedCPU.Sign() <= 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("cpuManagerPolicy"), "the static policy requires a cpu reservation in kubeReserved or systemReserved"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("cpuManagerPolicy"), *kubelet.CPUManagerPolicy, []string{"none", "static"}))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerKubeletEvictionThresholds(thresholds map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for signal, threshold := range thresholds {
+		if !kubeletEvictionSignals.Has(signal) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(signal), signal, kubeletEvictionSignals.List()))
+			continue
+		}
+
+		if strings.HasSuffix(threshold, "%") {
+			if percentage, err := strconv.ParseFloat(strings.TrimSuffix(threshold, "%"), 64); err != nil || percentage <= 0 || percentage > 100 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Key(signal), threshold, "percentage must be greater than 0% and at most 100%"))
+			}
+			continue
+		}
+		if quantity, err := resource.ParseQuantity(threshold); err != nil || quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(signal), threshold, "must be a non-negative quantity or a percentage"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerKubeletReservedResources(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for name, quantity := range resources {
+		if !kubeletReservedResources.Has(string(name)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(string(name)), string(name), kubeletReservedResources.List()))
+			continue
+		}
+		if quantity.Sign() < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(string(name)), quantity.String(), "value must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerUnhealthyNodeConditions(conditions []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	seen := sets.NewString()
+	for i, condition := range conditions {
+		idxPath := fldPath.Index(i)
+
+		switch {
+		case len(condition) == 0:
+			allErrs = append(allErrs, field.Required(idxPath, "condition type must not be empty"))
+		case condition == string(corev1.NodeReady):
+			allErrs = append(allErrs, field.Forbidden(idxPath, "nodes which are not ready are always unhealthy"))
+		case strings.Contains(condition, ","):
+			allErrs = append(allErrs, field.Invalid(idxPath, condition, "condition type must not contain commas"))
+		case seen.Has(condition):
+			allErrs = append(allErrs, field.Duplicate(idxPath, condition))
+		}
+		seen.Insert(condition)
+	}
+
+	return allErrs
+}
+
+func validateWorkerFirewallRules(rules []garden.WorkerFirewallRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if rule.Protocol != nil && *rule.Protocol != corev1.ProtocolTCP && *rule.Protocol != corev1.ProtocolUDP {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), *rule.Protocol, []string{string(corev1.ProtocolTCP), string(corev1.ProtocolUDP)}))
+		}
+		for _, msg := range validation.IsValidPortNum(int(rule.Port)) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("port"), rule.Port, msg))
+		}
+		if rule.EndPort != nil {
+			for _, msg := range validation.IsValidPortNum(int(*rule.EndPort)) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("endPort"), *rule.EndPort, msg))
+			}
+			if *rule.EndPort < rule.Port {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("endPort"), *rule.EndPort, "must not be less than port"))
+			}
+		}
+		if len(rule.SourceCIDRs) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("sourceCIDRs"), "must specify at least one source CIDR"))
+		}
+		for j, cidr := range rule.SourceCIDRs {
+			allErrs = append(allErrs, validateCIDR(cidr, idxPath.Child("sourceCIDRs").Index(j))...)
+		}
+	}
+
+	return allErrs
+}
+
+// reservedNodeLabels are the labels which the Gardener itself sets on the nodes of a worker group.
+var reservedNodeLabels = []string{"kubernetes.io/role", "node-role.kubernetes.io/node", "worker.garden.sapcloud.io/group"}
+
+// reservedNodeMetadataPrefix is the prefix of the labels and annotations which the Gardener manages on the nodes of a
+// worker group.
+const reservedNodeMetadataPrefix = "worker.garden.sapcloud.io/"
+
+func validateWorkerNodeLabels(labels map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabels(labels, fldPath)...)
+	for key := range labels {
+		if strings.HasPrefix(key, reservedNodeMetadataPrefix) || utils.ValueExists(key, reservedNodeLabels) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), "label is managed by the Gardener"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerNodeAnnotations(annotations map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(annotations, fldPath)...)
+	for key := range annotations {
+		if strings.HasPrefix(key, reservedNodeMetadataPrefix) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(key), "annotation is managed by the Gardener"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerNodeTaints(taints []corev1.Taint, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	existingTaints := map[string]bool{}
+	for i, taint := range taints {
+		idxPath := fldPath.Index(i)
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelName(taint.Key, idxPath.Child("key"))...)
+		if len(taint.Value) > 0 {
+			for _, msg := range validation.IsValidLabelValue(taint.Value) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), taint.Value, msg))
+			}
+		}
+		switch taint.Effect {
+		case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), taint.Effect, []string{string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule), string(corev1.TaintEffectNoExecute)}))
+		}
+		if taint.TimeAdded != nil {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("timeAdded"), "timeAdded is set by the node controller"))
+		}
+
+		id := fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+		if existingTaints[id] {
+			allErrs = append(allErrs, field.Duplicate(idxPath, id))
+		}
+		existingTaints[id] = true
+	}
+
+	return allErrs
+}
+
+func validateWorkerRollingUpdate(worker garden.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if worker.MaxSurge != nil {
+		allErrs = append(allErrs, validateIntOrPercent(*worker.MaxSurge, fldPath.Child("maxSurge"))...)
+	}
+	if worker.MaxUnavailable != nil {
+		allErrs = append(allErrs, validateIntOrPercent(*worker.MaxUnavailable, fldPath.Child("maxUnavailable"))...)
+	}
+	if worker.MinReadySeconds != nil && *worker.MinReadySeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReadySeconds"), *worker.MinReadySeconds, "value must not be negative"))
+	}
+
+	// A rolling update can only make progress if it may either create or remove machines. Cordoned worker groups
+	// never surge, hence, they must allow unavailable machines.
+	var (
+		cordoned           = worker.Cordoned != nil && *worker.Cordoned
+		maxSurgeZero       = worker.MaxSurge != nil && isZeroIntOrPercent(*worker.MaxSurge)
+		maxUnavailableZero = worker.MaxUnavailable != nil && isZeroIntOrPercent(*worker.MaxUnavailable)
+	)
+	if maxUnavailableZero && maxSurgeZero {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), worker.MaxUnavailable.String(), "must not be 0 when maxSurge is 0"))
+	} else if maxUnavailableZero && cordoned {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), worker.MaxUnavailable.String(), "must not be 0 for cordoned worker groups"))
+	}
+
+	return allErrs
+}
+
+func isZeroIntOrPercent(value intstr.IntOrString) bool {
+	v, err := intstr.GetValueFromIntOrPercent(&value, 100, false)
+	return err == nil && v == 0
+}
+
+// validateIntOrPercent validates that the given value is either a non-negative integer or a percentage between 0%
+// and 100%.
+func validateIntOrPercent(value intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch value.Type {
+	case intstr.Int:
+		if value.IntVal < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.IntVal, "value must not be negative"))
+		}
+	case intstr.String:
+		percentRegex, _ := regexp.Compile(`^(\d+)%$`)
+		match := percentRegex.FindStringSubmatch(value.StrVal)
+		if len(match) != 2 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, "value must be an integer or a percentage (e.g. '30%')"))
+			break
+		}
+		if percent, err := strconv.Atoi(match[1]); err != nil || percent > 100 {
+			allErrs = append(allErrs, field.Invalid(fldPath, value.StrVal, "percentage must not be greater than 100%"))
+		}
+	}
 
 	return allErrs
 }
@@ -1370,3 +2207,168 @@ func ValidateBackupInfrastructureStatusUpdate(newBackupInfrastructure, oldBackup
 
 	return allErrs
 }
+
+////////////////////////////////////////////////////
+//             SEED ACCESS REQUESTS               //
+////////////////////////////////////////////////////
+
+// maxSeedAccessDuration is the maximum duration for which a SeedAccessRequest can grant access.
+const maxSeedAccessDuration = 8 * time.Hour
+
+// ValidateSeedAccessRequest validates a SeedAccessRequest object.
+func ValidateSeedAccessRequest(seedAccessRequest *garden.SeedAccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&seedAccessRequest.ObjectMeta, true, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateSeedAccessRequestSpec(&seedAccessRequest.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateSeedAccessRequestUpdate validates a SeedAccessRequest object before an update.
+func ValidateSeedAccessRequestUpdate(newSeedAccessRequest, oldSeedAccessRequest *garden.SeedAccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newSeedAccessRequest.ObjectMeta, &oldSeedAccessRequest.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedAccessRequest.Spec, oldSeedAccessRequest.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateSeedAccessRequest(newSeedAccessRequest)...)
+
+	return allErrs
+}
+
+// ValidateSeedAccessRequestSpec validates the specification of a SeedAccessRequest object.
+func ValidateSeedAccessRequestSpec(spec *garden.SeedAccessRequestSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.ShootName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("shootName"), "shoot name must not be empty"))
+	}
+	if len(strings.TrimSpace(spec.Reason)) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("reason"), "a reason for the access must be given"))
+	}
+	if spec.Duration == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("duration"), "duration must be specified"))
+	} else if spec.Duration.Duration <= 0 || spec.Duration.Duration > maxSeedAccessDuration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), spec.Duration.Duration.String(), fmt.Sprintf("duration must be positive and must not exceed %s", maxSeedAccessDuration)))
+	}
+
+	return allErrs
+}
+
+// ValidateSeedAccessRequestStatusUpdate validates the status field of a SeedAccessRequest object.
+func ValidateSeedAccessRequestStatusUpdate(newSeedAccessRequest, oldSeedAccessRequest *garden.SeedAccessRequest) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedAccessRequest.Status.Requester, oldSeedAccessRequest.Status.Requester, field.NewPath("status", "requester"))...)
+
+	return allErrs
+}
+
+////////////////////////////////////////////////////
+//               SHOOT OPERATIONS                 //
+////////////////////////////////////////////////////
+
+// ValidateShootOperation validates a ShootOperation object.
+func ValidateShootOperation(shootOperation *garden.ShootOperation) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shootOperation.ObjectMeta, true, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootOperationSpec(&shootOperation.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootOperationUpdate validates a ShootOperation object before an update.
+func ValidateShootOperationUpdate(newShootOperation, oldShootOperation *garden.ShootOperation) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShootOperation.ObjectMeta, &oldShootOperation.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newShootOperation.Spec, oldShootOperation.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateShootOperation(newShootOperation)...)
+
+	return allErrs
+}
+
+// ValidateShootOperationSpec validates the specification of a ShootOperation object.
+func ValidateShootOperationSpec(spec *garden.ShootOperationSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch spec.Operation {
+	case garden.ShootOperationTypeReconcile, garden.ShootOperationTypeRetry:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operation"), spec.Operation, []string{string(garden.ShootOperationTypeReconcile), string(garden.ShootOperationTypeRetry)}))
+	}
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), "selector must be specified"))
+	} else {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.Selector, fldPath.Child("selector"))...)
+	}
+	if spec.MaxConcurrency == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("maxConcurrency"), "maxConcurrency must be specified"))
+	} else if *spec.MaxConcurrency <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrency"), *spec.MaxConcurrency, "maxConcurrency must be positive"))
+	}
+
+	return allErrs
+}
+
+// ValidateShootOperationStatusUpdate validates the status field of a ShootOperation object.
+func ValidateShootOperationStatusUpdate(newShootOperation, oldShootOperation *garden.ShootOperation) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldShootOperation.Status.StartTimestamp != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newShootOperation.Status.StartTimestamp, oldShootOperation.Status.StartTimestamp, field.NewPath("status", "startTimestamp"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateRegionPolicy validates a RegionPolicy object.
+func ValidateRegionPolicy(regionPolicy *garden.RegionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&regionPolicy.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateRegionPolicySpec(&regionPolicy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateRegionPolicyUpdate validates a RegionPolicy object before an update.
+func ValidateRegionPolicyUpdate(newRegionPolicy, oldRegionPolicy *garden.RegionPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newRegionPolicy.ObjectMeta, &oldRegionPolicy.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newRegionPolicy.Spec.CloudProfileName, oldRegionPolicy.Spec.CloudProfileName, field.NewPath("spec", "cloudProfileName"))...)
+	allErrs = append(allErrs, ValidateRegionPolicy(newRegionPolicy)...)
+
+	return allErrs
+}
+
+// ValidateRegionPolicySpec validates the specification of a RegionPolicy object.
+func ValidateRegionPolicySpec(spec *garden.RegionPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.CloudProfileName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("cloudProfileName"), "must specify the name of a cloud profile"))
+	}
+	if spec.ProjectSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ProjectSelector, fldPath.Child("projectSelector"))...)
+	}
+	if len(spec.Regions) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("regions"), "must specify at least one region"))
+	}
+	regions := make(map[string]bool)
+	for i, region := range spec.Regions {
+		idxPath := fldPath.Child("regions").Index(i)
+		if len(region) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "region must not be empty"))
+			continue
+		}
+		if regions[region] {
+			allErrs = append(allErrs, field.Duplicate(idxPath, region))
+		}
+		regions[region] = true
+	}
+
+	return allErrs
+}
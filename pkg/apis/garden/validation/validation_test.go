@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	. "github.com/onsi/ginkgo"
@@ -127,7 +128,7 @@ var _ = Describe("validation", func() {
 			}))
 			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("spec.aws/azure/gcp/openstack/local"),
+				"Field": Equal("spec.aws/azure/gcp/openstack/packet/local"),
 			}))
 		})
 
@@ -183,6 +184,19 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should forbid unsupported seed selection strategies", func() {
+				strategy := garden.SeedSelectionStrategy("Random")
+				awsCloudProfile.Spec.SeedSelectionStrategy = &strategy
+
+				errorList := ValidateCloudProfile(awsCloudProfile)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.seedSelectionStrategy"),
+				}))
+			})
+
 			Context("dns provider constraints", func() {
 				It("should enforce that at least one provider has been defined", func() {
 					awsCloudProfile.Spec.AWS.Constraints.DNSProviders = []garden.DNSProviderConstraint{}
@@ -279,6 +293,71 @@ var _ = Describe("validation", func() {
 					}))
 				})
 
+				It("should allow the same machine image name for different architectures", func() {
+					arm64 := garden.MachineArchitectureARM64
+					awsCloudProfile.Spec.AWS.Constraints.MachineImages = []garden.AWSMachineImageMapping{
+						{
+							Name: garden.MachineImageCoreOS,
+							Regions: []garden.AWSRegionalMachineImage{
+								{
+									Name: "my-region",
+									AMI:  "ami-a1b2c3d4",
+								},
+							},
+						},
+						{
+							Name:         garden.MachineImageCoreOS,
+							Architecture: &arm64,
+							Regions: []garden.AWSRegionalMachineImage{
+								{
+									Name: "my-region",
+									AMI:  "ami-e5f6a7b8",
+								},
+							},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(len(errorList)).To(Equal(0))
+				})
+
+				It("should require a machine image for the default architecture", func() {
+					arm64 := garden.MachineArchitectureARM64
+					awsCloudProfile.Spec.AWS.Constraints.MachineImages = []garden.AWSMachineImageMapping{
+						{
+							Name:         garden.MachineImageCoreOS,
+							Architecture: &arm64,
+							Regions: []garden.AWSRegionalMachineImage{
+								{
+									Name: "my-region",
+									AMI:  "ami-e5f6a7b8",
+								},
+							},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(len(errorList)).To(Equal(1))
+					Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineImages", fldPath)),
+					}))
+				})
+
+				It("should forbid machine images with unsupported architectures", func() {
+					unsupported := garden.MachineArchitecture("ppc64le")
+					awsCloudProfile.Spec.AWS.Constraints.MachineImages[0].Architecture = &unsupported
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineImages[0].architecture", fldPath)),
+					}))))
+				})
+
 				It("should forbid machine images names other than CoreOS", func() {
 					awsCloudProfile.Spec.AWS.Constraints.MachineImages = []garden.AWSMachineImageMapping{
 						{
@@ -365,6 +444,30 @@ var _ = Describe("validation", func() {
 						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineImages[0].regions[0].ami", fldPath)),
 					}))
 				})
+
+				It("should forbid machine images with invalid warm amis", func() {
+					warmAMI := "invalid-ami"
+					awsCloudProfile.Spec.AWS.Constraints.MachineImages = []garden.AWSMachineImageMapping{
+						{
+							Name: garden.MachineImageCoreOS,
+							Regions: []garden.AWSRegionalMachineImage{
+								{
+									Name:    "my-region",
+									AMI:     "ami-a1b2c3d4",
+									WarmAMI: &warmAMI,
+								},
+							},
+						},
+					}
+
+					errorList := ValidateCloudProfile(awsCloudProfile)
+
+					Expect(len(errorList)).To(Equal(1))
+					Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("spec.%s.constraints.machineImages[0].regions[0].warmAMI", fldPath)),
+					}))
+				})
 			})
 
 			Context("machine types validation", func() {
@@ -1456,6 +1559,42 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.networks.services"),
 			}))
 		})
+
+		It("should forbid invalid shoot Kubernetes version constraints", func() {
+			min, max := "1.x.y", "foo"
+			seed.Spec.ShootKubernetesVersions = &garden.SeedShootKubernetesVersions{
+				Min: &min,
+				Max: &max,
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(len(errorList)).To(Equal(2))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.shootKubernetesVersions.min"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.shootKubernetesVersions.max"),
+			}))
+		})
+
+		It("should forbid a minimum shoot Kubernetes version greater than the maximum", func() {
+			min, max := "1.10.1", "1.9"
+			seed.Spec.ShootKubernetesVersions = &garden.SeedShootKubernetesVersions{
+				Min: &min,
+				Max: &max,
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.shootKubernetesVersions.min"),
+			}))
+		})
 	})
 
 	Describe("#ValidateQuota", func() {
@@ -1755,7 +1894,7 @@ var _ = Describe("validation", func() {
 			}))
 			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeForbidden),
-				"Field": Equal("spec.cloud.aws/azure/gcp/openstack/local"),
+				"Field": Equal("spec.cloud.aws/azure/gcp/openstack/packet/local"),
 			}))
 		})
 
@@ -1768,10 +1907,16 @@ var _ = Describe("validation", func() {
 				},
 			}
 			shoot.Spec.Addons.KubeLego.Mail = "some-invalid-email"
+			shoot.Spec.Addons.NetworkPolicies = &garden.NetworkPolicies{
+				Addon: garden.Addon{
+					Enabled: true,
+				},
+				ExcludedNamespaces: []string{"Invalid_Namespace"},
+			}
 
 			errorList := ValidateShoot(shoot)
 
-			Expect(len(errorList)).To(Equal(4))
+			Expect(len(errorList)).To(Equal(5))
 			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeRequired),
 				"Field": Equal("spec.addons.kube2iam.roles[0].name"),
@@ -1788,6 +1933,10 @@ var _ = Describe("validation", func() {
 				"Type":  Equal(field.ErrorTypeInvalid),
 				"Field": Equal("spec.addons.kube-lego.mail"),
 			}))
+			Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.addons.network-policies.excludedNamespaces[0]"),
+			}))
 		})
 
 		It("should forbid unsupported cloud specification (provider independent)", func() {
@@ -1819,6 +1968,26 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should forbid invalid Shoot dependencies", func() {
+			shoot.Spec.DependsOn = []corev1.ObjectReference{
+				{Namespace: "garden", Name: "seed"},
+				{Namespace: "garden"},
+				{Namespace: "garden", Name: "seed"},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(2))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.dependsOn[1].name"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.dependsOn[2]"),
+			}))
+		})
+
 		It("should forbid updating some cloud keys", func() {
 			newShoot := prepareShootForUpdate(shoot)
 			newShoot.Spec.Cloud.Profile = "another-profile"
@@ -2049,40 +2218,68 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
-			It("should forbid worker pools with too less volume size", func() {
+			It("should forbid invalid machine health settings", func() {
+				var (
+					healthTimeout   = metav1.Duration{Duration: 30 * time.Second}
+					maxUnhealthy    = intstr.FromString("130%")
+					maxEvictRetries = int32(-1)
+					w               = worker.DeepCopy()
+				)
+				w.MachineHealthTimeout = &healthTimeout
+				w.MaxUnhealthy = &maxUnhealthy
+				w.MaxEvictRetries = &maxEvictRetries
+				w.UnhealthyNodeConditions = []string{"KernelDeadlock", "Ready", "", "KernelDeadlock"}
 				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
 					{
-						Worker:     worker,
-						VolumeSize: "10Gi",
+						Worker:     *w,
+						VolumeSize: "20Gi",
 						VolumeType: "gp2",
 					},
 				}
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(1))
+				Expect(len(errorList)).To(Equal(6))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].volumeSize", fldPath)),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].machineHealthTimeout", fldPath)),
 				}))
-			})
-
-			It("should forbid too long worker names", func() {
-				shoot.Spec.Cloud.AWS.Workers[0].Worker = invalidWorkerTooLongName
-
-				errorList := ValidateShoot(shoot)
-
-				Expect(len(errorList)).To(Equal(1))
-				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeTooLong),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxUnhealthy", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxEvictRetries", fldPath)),
+				}))
+				Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].unhealthyNodeConditions[1]", fldPath)),
+				}))
+				Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].unhealthyNodeConditions[2]", fldPath)),
+				}))
+				Expect(*errorList[5]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].unhealthyNodeConditions[3]", fldPath)),
 				}))
 			})
 
-			It("should forbid worker pools with names that are not DNS-1123 subdomain compliant", func() {
+			It("should allow valid machine health settings", func() {
+				var (
+					healthTimeout   = metav1.Duration{Duration: 10 * time.Minute}
+					maxUnhealthy    = intstr.FromString("30%")
+					maxEvictRetries = int32(10)
+					w               = worker.DeepCopy()
+				)
+				w.MachineHealthTimeout = &healthTimeout
+				w.MaxUnhealthy = &maxUnhealthy
+				w.MaxEvictRetries = &maxEvictRetries
+				w.UnhealthyNodeConditions = []string{"KernelDeadlock", "ReadonlyFilesystem"}
 				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
 					{
-						Worker:     invalidWorkerName,
+						Worker:     *w,
 						VolumeSize: "20Gi",
 						VolumeType: "gp2",
 					},
@@ -2090,117 +2287,836 @@ var _ = Describe("validation", func() {
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(1))
-				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
-				}))
+				Expect(len(errorList)).To(Equal(0))
 			})
 
-			It("should forbid an empty zones list", func() {
-				shoot.Spec.Cloud.AWS.Zones = []string{}
+			It("should forbid invalid rolling update settings", func() {
+				var (
+					maxSurge        = intstr.FromString("0%")
+					maxUnavailable  = intstr.FromInt(0)
+					minReadySeconds = int32(-1)
+					w               = worker.DeepCopy()
+				)
+				w.MaxSurge = &maxSurge
+				w.MaxUnavailable = &maxUnavailable
+				w.MinReadySeconds = &minReadySeconds
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(1))
-				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeRequired),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.zones", fldPath)),
-				}))
-			})
-
-			It("should forbid updating networks and zones", func() {
-				newShoot := prepareShootForUpdate(shoot)
-				cidr := garden.CIDR("255.255.255.255/32")
-				newShoot.Spec.Cloud.AWS.Networks.Pods = &cidr
-				newShoot.Spec.Cloud.AWS.Zones = []string{"another-zone"}
-
-				errorList := ValidateShootUpdate(newShoot, shoot)
-
 				Expect(len(errorList)).To(Equal(2))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.networks", fldPath)),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].minReadySeconds", fldPath)),
 				}))
 				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.zones", fldPath)),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxUnavailable", fldPath)),
 				}))
 			})
 
-			It("should forbid removing the AWS section", func() {
-				newShoot := prepareShootForUpdate(shoot)
-				newShoot.Spec.Cloud.AWS = nil
+			It("should forbid zero unavailable machines for cordoned worker groups", func() {
+				var (
+					cordoned       = true
+					maxUnavailable = intstr.FromString("0%")
+					w              = worker.DeepCopy()
+				)
+				w.Cordoned = &cordoned
+				w.MaxUnavailable = &maxUnavailable
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
 
-				errorList := ValidateShootUpdate(newShoot, shoot)
+				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(2))
+				Expect(len(errorList)).To(Equal(1))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s", fldPath)),
-				}))
-				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeForbidden),
-					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/local"),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].maxUnavailable", fldPath)),
 				}))
 			})
-		})
 
-		Context("Azure specific validation", func() {
-			var (
-				fldPath    = "azure"
-				azureCloud *garden.AzureCloud
-			)
-
-			BeforeEach(func() {
-				azureCloud = &garden.AzureCloud{
-					Networks: garden.AzureNetworks{
-						K8SNetworks: k8sNetworks,
-						Workers:     garden.CIDR("10.250.0.0/16"),
-						VNet: garden.AzureVNet{
-							CIDR: &nodeCIDR,
-						},
-					},
-					Workers: []garden.AzureWorker{
-						{
-							Worker:     worker,
-							VolumeSize: "35Gi",
-							VolumeType: "default",
-						},
+			It("should allow valid rolling update settings", func() {
+				var (
+					maxSurge        = intstr.FromString("25%")
+					maxUnavailable  = intstr.FromInt(0)
+					minReadySeconds = int32(60)
+					w               = worker.DeepCopy()
+				)
+				w.MaxSurge = &maxSurge
+				w.MaxUnavailable = &maxUnavailable
+				w.MinReadySeconds = &minReadySeconds
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
 					},
 				}
-				shoot.Spec.Cloud.AWS = nil
-				shoot.Spec.Cloud.Azure = azureCloud
-			})
 
-			It("should not return any errors", func() {
 				errorList := ValidateShoot(shoot)
 
 				Expect(len(errorList)).To(Equal(0))
 			})
 
-			It("should forbid invalid backup configuration", func() {
-				shoot.Spec.Backup = invalidBackup
+			It("should forbid invalid machine image, Kubernetes version and rollout stage overrides", func() {
+				var (
+					machineImage = garden.MachineImageName("")
+					newer        = "1.9.0"
+					stage        = int32(-1)
+					w            = worker.DeepCopy()
+					w2           = worker.DeepCopy()
+					tooOld       = "1.5.7"
+				)
+				w.MachineImage = &machineImage
+				w.KubernetesVersion = &newer
+				w.RolloutStage = &stage
+				w2.Name = "worker-2"
+				w2.KubernetesVersion = &tooOld
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+					{
+						Worker:     *w2,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(2))
+				Expect(len(errorList)).To(Equal(4))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("spec.backup.schedule"),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].machineImage", fldPath)),
 				}))
 				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal("spec.backup.maximum"),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].rolloutStage", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubernetesVersion", fldPath)),
+				}))
+				Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[1].kubernetesVersion", fldPath)),
 				}))
 			})
 
-			It("should forbid specifying a resource group configuration", func() {
-				shoot.Spec.Cloud.Azure.ResourceGroup = &garden.AzureResourceGroup{}
-
-				errorList := ValidateShoot(shoot)
-
-				Expect(len(errorList)).To(Equal(1))
+			It("should allow valid machine image, Kubernetes version and rollout stage overrides", func() {
+				var (
+					machineImage = garden.MachineImageName("coreos-beta")
+					older        = "1.6.4"
+					stage        = int32(1)
+					w            = worker.DeepCopy()
+				)
+				w.MachineImage = &machineImage
+				w.KubernetesVersion = &older
+				w.RolloutStage = &stage
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid scale-down priorities out of range", func() {
+				var (
+					low  = int32(0)
+					high = int32(5)
+					w    = worker.DeepCopy()
+					w2   = worker.DeepCopy()
+				)
+				w.ScaleDownPriority = &low
+				w2.Name = "worker-2"
+				w2.ScaleDownPriority = &high
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+					{
+						Worker:     *w2,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].scaleDownPriority", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[1].scaleDownPriority", fldPath)),
+				}))
+			})
+
+			It("should forbid invalid canary settings", func() {
+				var (
+					w  = worker.DeepCopy()
+					w2 = worker.DeepCopy()
+				)
+				w.Canary = &garden.WorkerCanary{
+					Machines: 0,
+				}
+				w2.Name = "worker-2"
+				w2.Canary = &garden.WorkerCanary{
+					Machines:   2,
+					SoakPeriod: &metav1.Duration{Duration: -time.Minute},
+				}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+					{
+						Worker:     *w2,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].canary.machines", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[1].canary.soakPeriod", fldPath)),
+				}))
+			})
+
+			It("should forbid invalid scale-down budgets", func() {
+				w := worker.DeepCopy()
+				w.ScaleDownBudget = &garden.ScaleDownBudget{
+					MaxMachines: 0,
+				}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+				shoot.Spec.Cloud.ScaleDownBudget = &garden.ScaleDownBudget{
+					MaxMachines: 5,
+					Period:      &metav1.Duration{},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].scaleDownBudget.maxMachines", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.cloud.scaleDownBudget.period"),
+				}))
+			})
+
+			It("should forbid invalid external worker groups", func() {
+				architecture := garden.MachineArchitecture("sparc")
+				shoot.Spec.Cloud.ExternalWorkers = []garden.ExternalWorker{
+					{Name: "on-premises"},
+					{Name: worker.Name},
+					{Name: "arm", Architecture: &architecture},
+					{Name: "tuned", Kubelet: &garden.WorkerKubeletConfig{}},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(3))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.cloud.externalWorkers[1]"),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.cloud.externalWorkers[2].architecture"),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.externalWorkers[3].kubelet"),
+				}))
+			})
+
+			It("should allow a valid kubelet configuration", func() {
+				var (
+					maxPods = int32(250)
+					policy  = "static"
+					w       = worker.DeepCopy()
+				)
+				w.Kubelet = &garden.WorkerKubeletConfig{
+					EvictionHard:     map[string]string{"memory.available": "500Mi", "nodefs.available": "10%"},
+					EvictionSoft:     map[string]string{"memory.available": "1Gi"},
+					MaxPods:          &maxPods,
+					KubeReserved:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+					SystemReserved:   corev1.ResourceList{corev1.ResourceEphemeralStorage: resource.MustParse("5Gi")},
+					CPUManagerPolicy: &policy,
+				}
+				shoot.Spec.Kubernetes.Version = "1.10.5"
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid an invalid kubelet configuration", func() {
+				var (
+					maxPods = int32(0)
+					policy  = "static"
+					w       = worker.DeepCopy()
+				)
+				w.Kubelet = &garden.WorkerKubeletConfig{
+					EvictionHard:     map[string]string{"pid.available": "10%"},
+					EvictionSoft:     map[string]string{"nodefs.available": "120%"},
+					MaxPods:          &maxPods,
+					KubeReserved:     corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+					SystemReserved:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Gi")},
+					CPUManagerPolicy: &policy,
+				}
+				shoot.Spec.Kubernetes.Version = "1.10.5"
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(6))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubelet.evictionHard[pid.available]", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubelet.evictionSoft[nodefs.available]", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubelet.maxPods", fldPath)),
+				}))
+				Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubelet.kubeReserved[pods]", fldPath)),
+				}))
+				Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubelet.systemReserved[memory]", fldPath)),
+				}))
+				Expect(*errorList[5]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubelet.cpuManagerPolicy", fldPath)),
+				}))
+			})
+
+			It("should forbid a kubelet configuration for Kubernetes versions without kubelet config file", func() {
+				var (
+					maxPods = int32(250)
+					w       = worker.DeepCopy()
+				)
+				w.Kubelet = &garden.WorkerKubeletConfig{MaxPods: &maxPods}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].kubelet", fldPath)),
+				}))
+			})
+
+			It("should forbid unsupported worker architectures", func() {
+				var (
+					architecture = garden.MachineArchitecture("ppc64le")
+					w            = worker.DeepCopy()
+				)
+				w.Architecture = &architecture
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].architecture", fldPath)),
+				}))
+			})
+
+			It("should forbid invalid firewall rules", func() {
+				var (
+					protocol = corev1.Protocol("ICMP")
+					endPort  = int32(79)
+					w        = worker.DeepCopy()
+				)
+				w.FirewallRules = []garden.WorkerFirewallRule{
+					{Protocol: &protocol, Port: 80, EndPort: &endPort, SourceCIDRs: []garden.CIDR{"10.0.0.0"}},
+					{Port: 70000},
+				}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(5))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].firewallRules[0].protocol", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].firewallRules[0].endPort", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].firewallRules[0].sourceCIDRs[0]", fldPath)),
+				}))
+				Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].firewallRules[1].port", fldPath)),
+				}))
+				Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].firewallRules[1].sourceCIDRs", fldPath)),
+				}))
+			})
+
+			It("should allow valid firewall rules", func() {
+				var (
+					protocol = corev1.ProtocolUDP
+					endPort  = int32(8090)
+					w        = worker.DeepCopy()
+				)
+				w.FirewallRules = []garden.WorkerFirewallRule{
+					{Port: 443, SourceCIDRs: []garden.CIDR{"0.0.0.0/0"}},
+					{Protocol: &protocol, Port: 8080, EndPort: &endPort, SourceCIDRs: []garden.CIDR{"10.0.0.0/8", "192.168.0.0/16"}},
+				}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid an invalid maximum spot price", func() {
+				var (
+					maxPrice = "-0.05"
+					w        = worker.DeepCopy()
+				)
+				w.Spot = &garden.WorkerSpot{MaxPrice: &maxPrice}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].spot.maxPrice", fldPath)),
+				}))
+			})
+
+			It("should allow spot worker groups", func() {
+				var (
+					maxPrice = "0.05"
+					trueVar  = true
+					w        = worker.DeepCopy()
+				)
+				w.Spot = &garden.WorkerSpot{MaxPrice: &maxPrice, FallbackToOnDemand: &trueVar, Taint: &trueVar}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid invalid local SSDs and a size or interface on AWS", func() {
+				var (
+					size              = "375Gi"
+					localSSDInterface = garden.LocalSSDInterfaceNVME
+					w                 = worker.DeepCopy()
+				)
+				w.LocalSSDs = &garden.WorkerLocalSSDs{Count: 25, Size: &size, Interface: &localSSDInterface}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(3))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].localSSDs.count", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].localSSDs.size", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].localSSDs.interface", fldPath)),
+				}))
+			})
+
+			It("should allow local SSDs", func() {
+				w := worker.DeepCopy()
+				w.LocalSSDs = &garden.WorkerLocalSSDs{Count: 2}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid invalid machine deployment labels and annotations", func() {
+				w := worker.DeepCopy()
+				w.Labels = map[string]string{"cost-center": "not a valid value!"}
+				w.Annotations = map[string]string{"-autoscaler": "true"}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].labels", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].annotations", fldPath)),
+				}))
+			})
+
+			It("should allow valid node labels, annotations and taints", func() {
+				w := worker.DeepCopy()
+				w.NodeLabels = map[string]string{"accelerator": "nvidia-tesla-v100"}
+				w.NodeAnnotations = map[string]string{"cluster-autoscaler.kubernetes.io/scale-down-disabled": "true"}
+				w.NodeTaints = []corev1.Taint{
+					{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "nvidia.com/gpu", Effect: corev1.TaintEffectNoExecute},
+				}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid node labels and annotations managed by the Gardener", func() {
+				w := worker.DeepCopy()
+				w.NodeLabels = map[string]string{"worker.garden.sapcloud.io/group": "other"}
+				w.NodeAnnotations = map[string]string{"worker.garden.sapcloud.io/node-labels": "accelerator"}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].nodeLabels[worker.garden.sapcloud.io/group]", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].nodeAnnotations[worker.garden.sapcloud.io/node-labels]", fldPath)),
+				}))
+			})
+
+			It("should forbid invalid or duplicate node taints", func() {
+				w := worker.DeepCopy()
+				w.NodeTaints = []corev1.Taint{
+					{Key: "-gpu", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "not a valid value!", Effect: "NoWay"},
+					{Key: "dedicated", Effect: "NoWay"},
+				}
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(5))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].nodeTaints[0].key", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].nodeTaints[1].value", fldPath)),
+				}))
+				Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].nodeTaints[1].effect", fldPath)),
+				}))
+				Expect(*errorList[3]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].nodeTaints[2].effect", fldPath)),
+				}))
+				Expect(*errorList[4]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].nodeTaints[2]", fldPath)),
+				}))
+			})
+
+			It("should forbid worker pools with too less volume size", func() {
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     worker,
+						VolumeSize: "10Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].volumeSize", fldPath)),
+				}))
+			})
+
+			It("should forbid too long worker names", func() {
+				shoot.Spec.Cloud.AWS.Workers[0].Worker = invalidWorkerTooLongName
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooLong),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
+				}))
+			})
+
+			It("should forbid worker pools with names that are not DNS-1123 subdomain compliant", func() {
+				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+					{
+						Worker:     invalidWorkerName,
+						VolumeSize: "20Gi",
+						VolumeType: "gp2",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
+				}))
+			})
+
+			It("should forbid an empty zones list", func() {
+				shoot.Spec.Cloud.AWS.Zones = []string{}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.zones", fldPath)),
+				}))
+			})
+
+			It("should forbid updating networks and zones", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				cidr := garden.CIDR("255.255.255.255/32")
+				newShoot.Spec.Cloud.AWS.Networks.Pods = &cidr
+				newShoot.Spec.Cloud.AWS.Zones = []string{"another-zone"}
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.networks", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.zones", fldPath)),
+				}))
+			})
+
+			It("should forbid removing the AWS section", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.AWS = nil
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/packet/local"),
+				}))
+			})
+		})
+
+		Context("Azure specific validation", func() {
+			var (
+				fldPath    = "azure"
+				azureCloud *garden.AzureCloud
+			)
+
+			BeforeEach(func() {
+				azureCloud = &garden.AzureCloud{
+					Networks: garden.AzureNetworks{
+						K8SNetworks: k8sNetworks,
+						Workers:     garden.CIDR("10.250.0.0/16"),
+						VNet: garden.AzureVNet{
+							CIDR: &nodeCIDR,
+						},
+					},
+					Workers: []garden.AzureWorker{
+						{
+							Worker:     worker,
+							VolumeSize: "35Gi",
+							VolumeType: "default",
+						},
+					},
+				}
+				shoot.Spec.Cloud.AWS = nil
+				shoot.Spec.Cloud.Azure = azureCloud
+			})
+
+			It("should not return any errors", func() {
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid invalid backup configuration", func() {
+				shoot.Spec.Backup = invalidBackup
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backup.schedule"),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backup.maximum"),
+				}))
+			})
+
+			It("should forbid specifying a resource group configuration", func() {
+				shoot.Spec.Cloud.Azure.ResourceGroup = &garden.AzureResourceGroup{}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.resourceGroup.name", fldPath)),
@@ -2275,6 +3191,66 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should forbid firewall rules of worker groups", func() {
+				w := worker.DeepCopy()
+				w.FirewallRules = []garden.WorkerFirewallRule{{Port: 443, SourceCIDRs: []garden.CIDR{"0.0.0.0/0"}}}
+				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "35Gi",
+						VolumeType: "default",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].firewallRules", fldPath)),
+				}))
+			})
+
+			It("should forbid spot worker groups", func() {
+				w := worker.DeepCopy()
+				w.Spot = &garden.WorkerSpot{}
+				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "35Gi",
+						VolumeType: "default",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].spot", fldPath)),
+				}))
+			})
+
+			It("should forbid local SSDs", func() {
+				w := worker.DeepCopy()
+				w.LocalSSDs = &garden.WorkerLocalSSDs{Count: 1}
+				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "35Gi",
+						VolumeType: "default",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].localSSDs", fldPath)),
+				}))
+			})
+
 			It("should enforce unique worker names", func() {
 				shoot.Spec.Cloud.Azure.Workers = []garden.AzureWorker{
 					{
@@ -2309,7 +3285,7 @@ var _ = Describe("validation", func() {
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(8))
+				Expect(len(errorList)).To(Equal(7))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
@@ -2338,10 +3314,6 @@ var _ = Describe("validation", func() {
 					"Type":  Equal(field.ErrorTypeRequired),
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].volumeType", fldPath)),
 				}))
-				Expect(*errorList[7]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeForbidden),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].autoScalerMax", fldPath)),
-				}))
 			})
 
 			It("should forbid worker pools with too less volume size", func() {
@@ -2410,16 +3382,12 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
-			It("should forbid workers with auto scaling configured", func() {
+			It("should allow workers with auto scaling configured", func() {
 				shoot.Spec.Cloud.Azure.Workers[0].AutoScalerMax = shoot.Spec.Cloud.Azure.Workers[0].AutoScalerMin + 1
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(1))
-				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeForbidden),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].autoScalerMax", fldPath)),
-				}))
+				Expect(len(errorList)).To(Equal(0))
 			})
 
 			It("should forbid updating resource group and zones", func() {
@@ -2460,7 +3428,7 @@ var _ = Describe("validation", func() {
 				}))
 				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeForbidden),
-					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/local"),
+					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/packet/local"),
 				}))
 			})
 		})
@@ -2500,6 +3468,129 @@ var _ = Describe("validation", func() {
 				Expect(len(errorList)).To(Equal(0))
 			})
 
+			It("should forbid a maximum price and disabled preemption for spot worker groups", func() {
+				var (
+					maxPrice = "0.05"
+					falseVar = false
+					w        = worker.DeepCopy()
+				)
+				w.Spot = &garden.WorkerSpot{MaxPrice: &maxPrice}
+				shoot.Spec.Cloud.GCP.Workers = []garden.GCPWorker{
+					{
+						Worker:      *w,
+						VolumeSize:  "20Gi",
+						VolumeType:  "default",
+						Preemptible: &falseVar,
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].spot.maxPrice", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].preemptible", fldPath)),
+				}))
+			})
+
+			It("should forbid local SSDs with another size than offered by GCP or an unknown interface", func() {
+				var (
+					size              = "500Gi"
+					localSSDInterface = "IDE"
+					w                 = worker.DeepCopy()
+				)
+				w.LocalSSDs = &garden.WorkerLocalSSDs{Count: 4, Size: &size, Interface: &localSSDInterface}
+				shoot.Spec.Cloud.GCP.Workers = []garden.GCPWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "default",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].localSSDs.interface", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].localSSDs.size", fldPath)),
+				}))
+			})
+
+			It("should allow local SSDs with the size offered by GCP", func() {
+				var (
+					size              = "375Gi"
+					localSSDInterface = garden.LocalSSDInterfaceSCSI
+					w                 = worker.DeepCopy()
+				)
+				w.LocalSSDs = &garden.WorkerLocalSSDs{Count: 4, Size: &size, Interface: &localSSDInterface}
+				shoot.Spec.Cloud.GCP.Workers = []garden.GCPWorker{
+					{
+						Worker:     *w,
+						VolumeSize: "20Gi",
+						VolumeType: "default",
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should allow a valid kube-apiserver load balancer IP", func() {
+				loadBalancerIP := "35.195.0.10"
+				shoot.Spec.Kubernetes.KubeAPIServer.LoadBalancerIP = &loadBalancerIP
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid an invalid kube-apiserver load balancer IP", func() {
+				loadBalancerIP := "35.195.0"
+				shoot.Spec.Kubernetes.KubeAPIServer.LoadBalancerIP = &loadBalancerIP
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.loadBalancerIP"),
+				}))
+			})
+
+			It("should allow GCP annotations for the kube-apiserver service", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.ServiceAnnotations = map[string]string{
+					"cloud.google.com/load-balancer-type": "Internal",
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid annotations of other cloud providers for the kube-apiserver service", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.ServiceAnnotations = map[string]string{
+					"service.beta.kubernetes.io/aws-load-balancer-type": "nlb",
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(1))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.kubernetes.kubeAPIServer.serviceAnnotations[service.beta.kubernetes.io/aws-load-balancer-type]"),
+				}))
+			})
+
 			It("should forbid invalid network configuration", func() {
 				shoot.Spec.Cloud.GCP.Networks.Workers = []garden.CIDR{"invalid-cidr", "another cidr"}
 				shoot.Spec.Cloud.GCP.Networks.K8SNetworks = invalidK8sNetworks
@@ -2707,7 +3798,7 @@ var _ = Describe("validation", func() {
 				}))
 				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeForbidden),
-					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/local"),
+					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/packet/local"),
 				}))
 			})
 		})
@@ -2849,7 +3940,7 @@ var _ = Describe("validation", func() {
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(6))
+				Expect(len(errorList)).To(Equal(5))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
@@ -2870,10 +3961,6 @@ var _ = Describe("validation", func() {
 					"Type":  Equal(field.ErrorTypeForbidden),
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].autoScalerMax", fldPath)),
 				}))
-				Expect(*errorList[5]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeForbidden),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].autoScalerMax", fldPath)),
-				}))
 			})
 
 			It("should forbid too long worker names", func() {
@@ -2934,25 +4021,103 @@ var _ = Describe("validation", func() {
 					"Field": Equal(fmt.Sprintf("spec.cloud.%s.networks", fldPath)),
 				}))
 				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s.zones", fldPath)),
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.zones", fldPath)),
+				}))
+			})
+
+			It("should forbid removing the OpenStack section", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.OpenStack = nil
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/packet/local"),
+				}))
+			})
+		})
+
+		Context("Local specific validation", func() {
+			var fldPath = "local"
+
+			BeforeEach(func() {
+				shoot.Spec.Cloud.AWS = nil
+				shoot.Spec.Cloud.Local = &garden.Local{
+					Networks: garden.LocalNetworks{
+						K8SNetworks: k8sNetworks,
+					},
+					Endpoint: "localhost:3777",
+					Workers: []garden.LocalWorker{
+						{
+							Worker: worker,
+						},
+					},
+				}
+				shoot.Spec.Backup = nil
+			})
+
+			It("should not return any errors", func() {
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should allow a Shoot without simulated workers", func() {
+				shoot.Spec.Cloud.Local.Workers = nil
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(0))
+			})
+
+			It("should forbid invalid and duplicate simulated workers", func() {
+				shoot.Spec.Cloud.Local.Workers = []garden.LocalWorker{
+					{
+						Worker: invalidWorkerName,
+					},
+					{
+						Worker: worker,
+					},
+					{
+						Worker: worker,
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(len(errorList)).To(Equal(2))
+				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].name", fldPath)),
+				}))
+				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[2]", fldPath)),
 				}))
 			})
 
-			It("should forbid removing the OpenStack section", func() {
-				newShoot := prepareShootForUpdate(shoot)
-				newShoot.Spec.Cloud.OpenStack = nil
+			It("should forbid spot worker groups", func() {
+				w := worker.DeepCopy()
+				w.Spot = &garden.WorkerSpot{}
+				shoot.Spec.Cloud.Local.Workers = []garden.LocalWorker{
+					{
+						Worker: *w,
+					},
+				}
 
-				errorList := ValidateShootUpdate(newShoot, shoot)
+				errorList := ValidateShoot(shoot)
 
-				Expect(len(errorList)).To(Equal(2))
+				Expect(len(errorList)).To(Equal(1))
 				Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
-					"Type":  Equal(field.ErrorTypeInvalid),
-					"Field": Equal(fmt.Sprintf("spec.cloud.%s", fldPath)),
-				}))
-				Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeForbidden),
-					"Field": Equal("spec.cloud.aws/azure/gcp/openstack/local"),
+					"Field": Equal(fmt.Sprintf("spec.cloud.%s.workers[0].spot", fldPath)),
 				}))
 			})
 		})
@@ -3155,6 +4320,108 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should forbid invalid kube-apiserver request throughput settings", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.Requests = &garden.KubeAPIServerRequests{
+				MaxNonMutatingInflight: makeIntPointer(-1),
+				MaxMutatingInflight:    makeIntPointer(200),
+			}
+			shoot.Spec.Kubernetes.KubeAPIServer.WatchCacheSizes = &garden.WatchCacheSizes{
+				Default: makeIntPointer(-5),
+			}
+			shoot.Spec.Kubernetes.KubeAPIServer.EventTTL = &metav1.Duration{}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(3))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.requests.maxNonMutatingInflight"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.watchCacheSizes.default"),
+			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.eventTTL"),
+			}))
+		})
+
+		It("should forbid a kube-apiserver load balancer IP on AWS", func() {
+			loadBalancerIP := "10.250.0.10"
+			shoot.Spec.Kubernetes.KubeAPIServer.LoadBalancerIP = &loadBalancerIP
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.loadBalancerIP"),
+			}))
+		})
+
+		It("should allow AWS annotations for the kube-apiserver service", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.ServiceAnnotations = map[string]string{
+				"service.beta.kubernetes.io/aws-load-balancer-type":     "nlb",
+				"service.beta.kubernetes.io/aws-load-balancer-internal": "0.0.0.0/0",
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid unrelated and invalid annotations for the kube-apiserver service", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.ServiceAnnotations = map[string]string{
+				"foo.example.com/bar": "baz",
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.serviceAnnotations[foo.example.com/bar]"),
+			}))
+		})
+
+		It("should forbid invalid watch cache resources", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.WatchCacheSizes = &garden.WatchCacheSizes{
+				Resources: map[string]int{
+					"pods":  1000,
+					"Nodes": 500,
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.watchCacheSizes.resources[Nodes]"),
+			}))
+		})
+
+		It("should forbid watch cache resources qualified with an API group for Kubernetes versions < 1.10", func() {
+			shoot.Spec.Kubernetes.KubeAPIServer.WatchCacheSizes = &garden.WatchCacheSizes{
+				Resources: map[string]int{
+					"deployments.apps": 1000,
+				},
+			}
+
+			errorList := ValidateShoot(shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.kubernetes.kubeAPIServer.watchCacheSizes.resources[deployments.apps]"),
+			}))
+
+			shoot.Spec.Kubernetes.Version = "1.10.1"
+
+			Expect(ValidateShoot(shoot)).To(BeEmpty())
+		})
+
 		It("should forbid kubernetes version downgrades", func() {
 			newShoot := prepareShootForUpdate(shoot)
 			newShoot.Spec.Kubernetes.Version = "1.7.2"
@@ -3168,6 +4435,36 @@ var _ = Describe("validation", func() {
 			}))
 		})
 
+		It("should allow rolling back a progressing kubernetes version upgrade", func() {
+			shoot.Status.KubernetesUpgrade = &garden.ShootKubernetesUpgrade{
+				PreviousVersion: "1.7.2",
+				Version:         "1.8.2",
+				Phase:           garden.KubernetesUpgradePhaseProgressing,
+			}
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Kubernetes.Version = "1.7.2"
+
+			Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+		})
+
+		It("should forbid rolling back a kubernetes version upgrade which has succeeded", func() {
+			shoot.Status.KubernetesUpgrade = &garden.ShootKubernetesUpgrade{
+				PreviousVersion: "1.7.2",
+				Version:         "1.8.2",
+				Phase:           garden.KubernetesUpgradePhaseSucceeded,
+			}
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Kubernetes.Version = "1.7.2"
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.kubernetes.version"),
+			}))
+		})
+
 		It("should forbid kubernetes version upgrades skipping a minor version", func() {
 			newShoot := prepareShootForUpdate(shoot)
 			newShoot.Spec.Kubernetes.Version = "1.10.1"
@@ -3453,10 +4750,258 @@ var _ = Describe("validation", func() {
 			}))
 		})
 	})
+
+	Describe("#ValidateSeedAccessRequest", func() {
+		var seedAccessRequest *garden.SeedAccessRequest
+
+		BeforeEach(func() {
+			seedAccessRequest = &garden.SeedAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "debug-etcd",
+					Namespace: "garden-dev",
+				},
+				Spec: garden.SeedAccessRequestSpec{
+					ShootName: "johndoe-aws",
+					Reason:    "investigate etcd restarts",
+					Duration:  &metav1.Duration{Duration: time.Hour},
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			errorList := ValidateSeedAccessRequest(seedAccessRequest)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid SeedAccessRequest specification with empty or invalid keys", func() {
+			seedAccessRequest.Spec.ShootName = ""
+			seedAccessRequest.Spec.Reason = " "
+			seedAccessRequest.Spec.Duration = &metav1.Duration{Duration: 9 * time.Hour}
+
+			errorList := ValidateSeedAccessRequest(seedAccessRequest)
+
+			Expect(len(errorList)).To(Equal(3))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.shootName"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.reason"),
+			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.duration"),
+			}))
+		})
+
+		It("should forbid updating the specification", func() {
+			newSeedAccessRequest := seedAccessRequest.DeepCopy()
+			newSeedAccessRequest.ResourceVersion = "1"
+			newSeedAccessRequest.Spec.Duration = &metav1.Duration{Duration: 2 * time.Hour}
+
+			errorList := ValidateSeedAccessRequestUpdate(newSeedAccessRequest, seedAccessRequest)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec"),
+			}))
+		})
+	})
+
+	Describe("#ValidateShootOperation", func() {
+		var shootOperation *garden.ShootOperation
+
+		BeforeEach(func() {
+			shootOperation = &garden.ShootOperation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "reconcile-aws",
+					Namespace: "garden-dev",
+				},
+				Spec: garden.ShootOperationSpec{
+					Operation: garden.ShootOperationTypeReconcile,
+					Selector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"infrastructure": "aws"},
+					},
+					MaxConcurrency: makeIntPointer(5),
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			errorList := ValidateShootOperation(shootOperation)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid ShootOperation specification with empty or invalid keys", func() {
+			shootOperation.Spec.Operation = "wake-up"
+			shootOperation.Spec.Selector = nil
+			shootOperation.Spec.MaxConcurrency = makeIntPointer(0)
+
+			errorList := ValidateShootOperation(shootOperation)
+
+			Expect(len(errorList)).To(Equal(3))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.operation"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.selector"),
+			}))
+			Expect(*errorList[2]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.maxConcurrency"),
+			}))
+		})
+
+		It("should forbid invalid label selectors", func() {
+			shootOperation.Spec.Selector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "infrastructure",
+						Operator: metav1.LabelSelectorOpIn,
+					},
+				},
+			}
+
+			errorList := ValidateShootOperation(shootOperation)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.selector.matchExpressions[0].values"),
+			}))
+		})
+
+		It("should forbid updating the specification", func() {
+			newShootOperation := shootOperation.DeepCopy()
+			newShootOperation.ResourceVersion = "1"
+			newShootOperation.Spec.Operation = garden.ShootOperationTypeRetry
+
+			errorList := ValidateShootOperationUpdate(newShootOperation, shootOperation)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec"),
+			}))
+		})
+	})
+
+	Describe("#ValidateRegionPolicy", func() {
+		var regionPolicy *garden.RegionPolicy
+
+		BeforeEach(func() {
+			regionPolicy = &garden.RegionPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "aws-eu",
+				},
+				Spec: garden.RegionPolicySpec{
+					CloudProfileName: "aws",
+					ProjectSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"data-residency": "eu"},
+					},
+					Regions: []string{"eu-central-1", "eu-west-1"},
+				},
+			}
+		})
+
+		It("should not return any errors", func() {
+			errorList := ValidateRegionPolicy(regionPolicy)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should allow RegionPolicies without a project selector", func() {
+			regionPolicy.Spec.ProjectSelector = nil
+
+			errorList := ValidateRegionPolicy(regionPolicy)
+
+			Expect(len(errorList)).To(Equal(0))
+		})
+
+		It("should forbid RegionPolicy specification with empty keys", func() {
+			regionPolicy.Spec.CloudProfileName = ""
+			regionPolicy.Spec.Regions = nil
+
+			errorList := ValidateRegionPolicy(regionPolicy)
+
+			Expect(len(errorList)).To(Equal(2))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.cloudProfileName"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.regions"),
+			}))
+		})
+
+		It("should forbid empty and duplicate regions", func() {
+			regionPolicy.Spec.Regions = []string{"eu-central-1", "", "eu-central-1"}
+
+			errorList := ValidateRegionPolicy(regionPolicy)
+
+			Expect(len(errorList)).To(Equal(2))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.regions[1]"),
+			}))
+			Expect(*errorList[1]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.regions[2]"),
+			}))
+		})
+
+		It("should forbid invalid project selectors", func() {
+			regionPolicy.Spec.ProjectSelector = &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{
+						Key:      "data-residency",
+						Operator: metav1.LabelSelectorOpIn,
+					},
+				},
+			}
+
+			errorList := ValidateRegionPolicy(regionPolicy)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.projectSelector.matchExpressions[0].values"),
+			}))
+		})
+
+		It("should allow updating the regions but not the cloud profile", func() {
+			newRegionPolicy := regionPolicy.DeepCopy()
+			newRegionPolicy.ResourceVersion = "1"
+			newRegionPolicy.Spec.Regions = []string{"eu-central-1"}
+
+			Expect(len(ValidateRegionPolicyUpdate(newRegionPolicy, regionPolicy))).To(Equal(0))
+
+			newRegionPolicy.Spec.CloudProfileName = "aws-china"
+			errorList := ValidateRegionPolicyUpdate(newRegionPolicy, regionPolicy)
+
+			Expect(len(errorList)).To(Equal(1))
+			Expect(*errorList[0]).To(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.cloudProfileName"),
+			}))
+		})
+	})
 })
 
 // Helper functions
 
+func makeIntPointer(i int) *int {
+	ptr := i
+	return &ptr
+}
+
 func makeStringPointer(s string) *string {
 	ptr := s
 	return &ptr
@@ -21,8 +21,10 @@ limitations under the License.
 package garden
 
 import (
-	v1 "k8s.io/api/core/v1"
+	core_v1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -41,7 +43,9 @@ func (in *AWSCloud) DeepCopyInto(out *AWSCloud) {
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]AWSWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Zones != nil {
 		in, out := &in.Zones, &out.Zones
@@ -131,7 +135,18 @@ func (in *AWSMachineImageMapping) DeepCopyInto(out *AWSMachineImageMapping) {
 	if in.Regions != nil {
 		in, out := &in.Regions, &out.Regions
 		*out = make([]AWSRegionalMachineImage, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
 	}
 	return
 }
@@ -199,6 +214,15 @@ func (in *AWSProfile) DeepCopy() *AWSProfile {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSRegionalMachineImage) DeepCopyInto(out *AWSRegionalMachineImage) {
 	*out = *in
+	if in.WarmAMI != nil {
+		in, out := &in.WarmAMI, &out.WarmAMI
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -249,7 +273,7 @@ func (in *AWSVPC) DeepCopy() *AWSVPC {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSWorker) DeepCopyInto(out *AWSWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
 	return
 }
 
@@ -345,6 +369,15 @@ func (in *Addons) DeepCopyInto(out *Addons) {
 			**out = **in
 		}
 	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(NetworkPolicies)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -367,7 +400,7 @@ func (in *AzureCloud) DeepCopyInto(out *AzureCloud) {
 			*out = nil
 		} else {
 			*out = new(AzureMachineImage)
-			**out = **in
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	in.Networks.DeepCopyInto(&out.Networks)
@@ -383,7 +416,9 @@ func (in *AzureCloud) DeepCopyInto(out *AzureCloud) {
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]AzureWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -410,7 +445,9 @@ func (in *AzureConstraints) DeepCopyInto(out *AzureConstraints) {
 	if in.MachineImages != nil {
 		in, out := &in.MachineImages, &out.MachineImages
 		*out = make([]AzureMachineImage, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.MachineTypes != nil {
 		in, out := &in.MachineTypes, &out.MachineTypes
@@ -456,6 +493,15 @@ func (in *AzureDomainCount) DeepCopy() *AzureDomainCount {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureMachineImage) DeepCopyInto(out *AzureMachineImage) {
 	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -567,7 +613,7 @@ func (in *AzureVNet) DeepCopy() *AzureVNet {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureWorker) DeepCopyInto(out *AzureWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
 	return
 }
 
@@ -766,6 +812,15 @@ func (in *Cloud) DeepCopyInto(out *Cloud) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.Packet != nil {
+		in, out := &in.Packet, &out.Packet
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(PacketCloud)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	if in.Local != nil {
 		in, out := &in.Local, &out.Local
 		if *in == nil {
@@ -775,6 +830,22 @@ func (in *Cloud) DeepCopyInto(out *Cloud) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.ExternalWorkers != nil {
+		in, out := &in.ExternalWorkers, &out.ExternalWorkers
+		*out = make([]ExternalWorker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ScaleDownBudget != nil {
+		in, out := &in.ScaleDownBudget, &out.ScaleDownBudget
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ScaleDownBudget)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	return
 }
 
@@ -887,6 +958,15 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.Packet != nil {
+		in, out := &in.Packet, &out.Packet
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(PacketProfile)
+			(*in).DeepCopyInto(*out)
+		}
+	}
 	if in.Local != nil {
 		in, out := &in.Local, &out.Local
 		if *in == nil {
@@ -905,6 +985,15 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 			**out = **in
 		}
 	}
+	if in.SeedSelectionStrategy != nil {
+		in, out := &in.SeedSelectionStrategy, &out.SeedSelectionStrategy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(SeedSelectionStrategy)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1011,6 +1100,63 @@ func (in *DNSProviderConstraint) DeepCopy() *DNSProviderConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalWorker) DeepCopyInto(out *ExternalWorker) {
+	*out = *in
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]core_v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WorkerKubeletConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalWorker.
+func (in *ExternalWorker) DeepCopy() *ExternalWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPCloud) DeepCopyInto(out *GCPCloud) {
 	*out = *in
@@ -1020,14 +1166,16 @@ func (in *GCPCloud) DeepCopyInto(out *GCPCloud) {
 			*out = nil
 		} else {
 			*out = new(GCPMachineImage)
-			**out = **in
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	in.Networks.DeepCopyInto(&out.Networks)
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]GCPWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Zones != nil {
 		in, out := &in.Zones, &out.Zones
@@ -1059,7 +1207,9 @@ func (in *GCPConstraints) DeepCopyInto(out *GCPConstraints) {
 	if in.MachineImages != nil {
 		in, out := &in.MachineImages, &out.MachineImages
 		*out = make([]GCPMachineImage, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.MachineTypes != nil {
 		in, out := &in.MachineTypes, &out.MachineTypes
@@ -1096,6 +1246,24 @@ func (in *GCPConstraints) DeepCopy() *GCPConstraints {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPMachineImage) DeepCopyInto(out *GCPMachineImage) {
 	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
+	}
+	if in.WarmImage != nil {
+		in, out := &in.WarmImage, &out.WarmImage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1176,7 +1344,16 @@ func (in *GCPVPC) DeepCopy() *GCPVPC {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GCPWorker) DeepCopyInto(out *GCPWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
+	if in.Preemptible != nil {
+		in, out := &in.Preemptible, &out.Preemptible
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1240,6 +1417,22 @@ func (in *HelmTiller) DeepCopy() *HelmTiller {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hibernation) DeepCopyInto(out *Hibernation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hibernation.
+func (in *Hibernation) DeepCopy() *Hibernation {
+	if in == nil {
+		return nil
+	}
+	out := new(Hibernation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *K8SNetworks) DeepCopyInto(out *K8SNetworks) {
 	*out = *in
@@ -1341,6 +1534,49 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 			(*in).DeepCopyInto(*out)
 		}
 	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(KubeAPIServerRequests)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.WatchCacheSizes != nil {
+		in, out := &in.WatchCacheSizes, &out.WatchCacheSizes
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WatchCacheSizes)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.EventTTL != nil {
+		in, out := &in.EventTTL, &out.EventTTL
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.LoadBalancerIP != nil {
+		in, out := &in.LoadBalancerIP, &out.LoadBalancerIP
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.ServiceAnnotations != nil {
+		in, out := &in.ServiceAnnotations, &out.ServiceAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1354,6 +1590,40 @@ func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeAPIServerRequests) DeepCopyInto(out *KubeAPIServerRequests) {
+	*out = *in
+	if in.MaxNonMutatingInflight != nil {
+		in, out := &in.MaxNonMutatingInflight, &out.MaxNonMutatingInflight
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
+	if in.MaxMutatingInflight != nil {
+		in, out := &in.MaxMutatingInflight, &out.MaxMutatingInflight
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerRequests.
+func (in *KubeAPIServerRequests) DeepCopy() *KubeAPIServerRequests {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerRequests)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerConfig) {
 	*out = *in
@@ -1595,6 +1865,11 @@ func (in *LastError) DeepCopy() *LastError {
 func (in *LastOperation) DeepCopyInto(out *LastOperation) {
 	*out = *in
 	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]LastOperationStep, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1608,10 +1883,34 @@ func (in *LastOperation) DeepCopy() *LastOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LastOperationStep) DeepCopyInto(out *LastOperationStep) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LastOperationStep.
+func (in *LastOperationStep) DeepCopy() *LastOperationStep {
+	if in == nil {
+		return nil
+	}
+	out := new(LastOperationStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Local) DeepCopyInto(out *Local) {
 	*out = *in
 	in.Networks.DeepCopyInto(&out.Networks)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]LocalWorker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1685,12 +1984,38 @@ func (in *LocalProfile) DeepCopy() *LocalProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalWorker) DeepCopyInto(out *LocalWorker) {
+	*out = *in
+	in.Worker.DeepCopyInto(&out.Worker)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalWorker.
+func (in *LocalWorker) DeepCopy() *LocalWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineType) DeepCopyInto(out *MachineType) {
 	*out = *in
 	out.CPU = in.CPU.DeepCopy()
 	out.GPU = in.GPU.DeepCopy()
 	out.Memory = in.Memory.DeepCopy()
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -1716,20 +2041,47 @@ func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 			**out = **in
 		}
 	}
-	if in.TimeWindow != nil {
-		in, out := &in.TimeWindow, &out.TimeWindow
+	if in.ReplaceMachinesOnCredentialsChange != nil {
+		in, out := &in.ReplaceMachinesOnCredentialsChange, &out.ReplaceMachinesOnCredentialsChange
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = new(MaintenanceTimeWindow)
+			*out = new(bool)
 			**out = **in
 		}
 	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Maintenance.
-func (in *Maintenance) DeepCopy() *Maintenance {
+	if in.ReplaceMachinesOnCloudConfigChange != nil {
+		in, out := &in.ReplaceMachinesOnCloudConfigChange, &out.ReplaceMachinesOnCloudConfigChange
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.ReplaceMachinesInTimeWindow != nil {
+		in, out := &in.ReplaceMachinesInTimeWindow, &out.ReplaceMachinesInTimeWindow
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.TimeWindow != nil {
+		in, out := &in.TimeWindow, &out.TimeWindow
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MaintenanceTimeWindow)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Maintenance.
+func (in *Maintenance) DeepCopy() *Maintenance {
 	if in == nil {
 		return nil
 	}
@@ -1787,6 +2139,28 @@ func (in *Monocular) DeepCopy() *Monocular {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicies) DeepCopyInto(out *NetworkPolicies) {
+	*out = *in
+	out.Addon = in.Addon
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicies.
+func (in *NetworkPolicies) DeepCopy() *NetworkPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NginxIngress) DeepCopyInto(out *NginxIngress) {
 	*out = *in
@@ -1892,14 +2266,16 @@ func (in *OpenStackCloud) DeepCopyInto(out *OpenStackCloud) {
 			*out = nil
 		} else {
 			*out = new(OpenStackMachineImage)
-			**out = **in
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	in.Networks.DeepCopyInto(&out.Networks)
 	if in.Workers != nil {
 		in, out := &in.Workers, &out.Workers
 		*out = make([]OpenStackWorker, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Zones != nil {
 		in, out := &in.Zones, &out.Zones
@@ -1941,7 +2317,9 @@ func (in *OpenStackConstraints) DeepCopyInto(out *OpenStackConstraints) {
 	if in.MachineImages != nil {
 		in, out := &in.MachineImages, &out.MachineImages
 		*out = make([]OpenStackMachineImage, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.MachineTypes != nil {
 		in, out := &in.MachineTypes, &out.MachineTypes
@@ -2005,6 +2383,15 @@ func (in *OpenStackLoadBalancerProvider) DeepCopy() *OpenStackLoadBalancerProvid
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackMachineImage) DeepCopyInto(out *OpenStackMachineImage) {
 	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
+	}
 	return
 }
 
@@ -2117,7 +2504,7 @@ func (in *OpenStackRouter) DeepCopy() *OpenStackRouter {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackWorker) DeepCopyInto(out *OpenStackWorker) {
 	*out = *in
-	out.Worker = in.Worker
+	in.Worker.DeepCopyInto(&out.Worker)
 	return
 }
 
@@ -2131,6 +2518,163 @@ func (in *OpenStackWorker) DeepCopy() *OpenStackWorker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCloud) DeepCopyInto(out *PacketCloud) {
+	*out = *in
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(PacketMachineImage)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	in.Networks.DeepCopyInto(&out.Networks)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]PacketWorker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCloud.
+func (in *PacketCloud) DeepCopy() *PacketCloud {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCloud)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketConstraints) DeepCopyInto(out *PacketConstraints) {
+	*out = *in
+	if in.DNSProviders != nil {
+		in, out := &in.DNSProviders, &out.DNSProviders
+		*out = make([]DNSProviderConstraint, len(*in))
+		copy(*out, *in)
+	}
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.MachineImages != nil {
+		in, out := &in.MachineImages, &out.MachineImages
+		*out = make([]PacketMachineImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]MachineType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]Zone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketConstraints.
+func (in *PacketConstraints) DeepCopy() *PacketConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketMachineImage) DeepCopyInto(out *PacketMachineImage) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketMachineImage.
+func (in *PacketMachineImage) DeepCopy() *PacketMachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketMachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketNetworks) DeepCopyInto(out *PacketNetworks) {
+	*out = *in
+	in.K8SNetworks.DeepCopyInto(&out.K8SNetworks)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketNetworks.
+func (in *PacketNetworks) DeepCopy() *PacketNetworks {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketNetworks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketProfile) DeepCopyInto(out *PacketProfile) {
+	*out = *in
+	in.Constraints.DeepCopyInto(&out.Constraints)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketProfile.
+func (in *PacketProfile) DeepCopy() *PacketProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketWorker) DeepCopyInto(out *PacketWorker) {
+	*out = *in
+	in.Worker.DeepCopyInto(&out.Worker)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketWorker.
+func (in *PacketWorker) DeepCopy() *PacketWorker {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketWorker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Quota) DeepCopyInto(out *Quota) {
 	*out = *in
@@ -2205,7 +2749,7 @@ func (in *QuotaSpec) DeepCopyInto(out *QuotaSpec) {
 	}
 	if in.Metrics != nil {
 		in, out := &in.Metrics, &out.Metrics
-		*out = make(v1.ResourceList, len(*in))
+		*out = make(core_v1.ResourceList, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val.DeepCopy()
 		}
@@ -2224,31 +2768,26 @@ func (in *QuotaSpec) DeepCopy() *QuotaSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
+func (in *RegionPolicy) DeepCopyInto(out *RegionPolicy) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.SecretRef = in.SecretRef
-	if in.Quotas != nil {
-		in, out := &in.Quotas, &out.Quotas
-		*out = make([]v1.ObjectReference, len(*in))
-		copy(*out, *in)
-	}
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBinding.
-func (in *SecretBinding) DeepCopy() *SecretBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionPolicy.
+func (in *RegionPolicy) DeepCopy() *RegionPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretBinding)
+	out := new(RegionPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretBinding) DeepCopyObject() runtime.Object {
+func (in *RegionPolicy) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2256,13 +2795,13 @@ func (in *SecretBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretBindingList) DeepCopyInto(out *SecretBindingList) {
+func (in *RegionPolicyList) DeepCopyInto(out *RegionPolicyList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SecretBinding, len(*in))
+		*out = make([]RegionPolicy, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2270,18 +2809,18 @@ func (in *SecretBindingList) DeepCopyInto(out *SecretBindingList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingList.
-func (in *SecretBindingList) DeepCopy() *SecretBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionPolicyList.
+func (in *RegionPolicyList) DeepCopy() *RegionPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretBindingList)
+	out := new(RegionPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecretBindingList) DeepCopyObject() runtime.Object {
+func (in *RegionPolicyList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2289,76 +2828,86 @@ func (in *SecretBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Seed) DeepCopyInto(out *Seed) {
+func (in *RegionPolicySpec) DeepCopyInto(out *RegionPolicySpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.ProjectSelector != nil {
+		in, out := &in.ProjectSelector, &out.ProjectSelector
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.LabelSelector)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Seed.
-func (in *Seed) DeepCopy() *Seed {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionPolicySpec.
+func (in *RegionPolicySpec) DeepCopy() *RegionPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Seed)
+	out := new(RegionPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Seed) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedCloud) DeepCopyInto(out *SeedCloud) {
+func (in *ScaleDownBudget) DeepCopyInto(out *ScaleDownBudget) {
 	*out = *in
+	if in.Period != nil {
+		in, out := &in.Period, &out.Period
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCloud.
-func (in *SeedCloud) DeepCopy() *SeedCloud {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScaleDownBudget.
+func (in *ScaleDownBudget) DeepCopy() *ScaleDownBudget {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedCloud)
+	out := new(ScaleDownBudget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedList) DeepCopyInto(out *SeedList) {
+func (in *SecretBinding) DeepCopyInto(out *SecretBinding) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Seed, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.SecretRef = in.SecretRef
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]core_v1.ObjectReference, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedList.
-func (in *SeedList) DeepCopy() *SeedList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBinding.
+func (in *SecretBinding) DeepCopy() *SecretBinding {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedList)
+	out := new(SecretBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SeedList) DeepCopyObject() runtime.Object {
+func (in *SecretBinding) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2366,117 +2915,217 @@ func (in *SeedList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
+func (in *SecretBindingList) DeepCopyInto(out *SecretBindingList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecretBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedNetworks.
-func (in *SeedNetworks) DeepCopy() *SeedNetworks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretBindingList.
+func (in *SecretBindingList) DeepCopy() *SecretBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedNetworks)
+	out := new(SecretBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecretBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
+func (in *Seed) DeepCopyInto(out *Seed) {
 	*out = *in
-	out.Cloud = in.Cloud
-	out.SecretRef = in.SecretRef
-	out.Networks = in.Networks
-	if in.Visible != nil {
-		in, out := &in.Visible, &out.Visible
-		if *in == nil {
-			*out = nil
-		} else {
-			*out = new(bool)
-			**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Seed.
+func (in *Seed) DeepCopy() *Seed {
+	if in == nil {
+		return nil
+	}
+	out := new(Seed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Seed) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAccessRequest) DeepCopyInto(out *SeedAccessRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAccessRequest.
+func (in *SeedAccessRequest) DeepCopy() *SeedAccessRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAccessRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeedAccessRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAccessRequestList) DeepCopyInto(out *SeedAccessRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SeedAccessRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Protected != nil {
-		in, out := &in.Protected, &out.Protected
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAccessRequestList.
+func (in *SeedAccessRequestList) DeepCopy() *SeedAccessRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedAccessRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SeedAccessRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedAccessRequestSpec) DeepCopyInto(out *SeedAccessRequestSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = new(bool)
+			*out = new(v1.Duration)
 			**out = **in
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSpec.
-func (in *SeedSpec) DeepCopy() *SeedSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAccessRequestSpec.
+func (in *SeedAccessRequestSpec) DeepCopy() *SeedAccessRequestSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedSpec)
+	out := new(SeedAccessRequestSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
+func (in *SeedAccessRequestStatus) DeepCopyInto(out *SeedAccessRequestStatus) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedStatus.
-func (in *SeedStatus) DeepCopy() *SeedStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedAccessRequestStatus.
+func (in *SeedAccessRequestStatus) DeepCopy() *SeedAccessRequestStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedStatus)
+	out := new(SeedAccessRequestStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Shoot) DeepCopyInto(out *Shoot) {
+func (in *SeedCloud) DeepCopyInto(out *SeedCloud) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Shoot.
-func (in *Shoot) DeepCopy() *Shoot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCloud.
+func (in *SeedCloud) DeepCopy() *SeedCloud {
 	if in == nil {
 		return nil
 	}
-	out := new(Shoot)
+	out := new(SeedCloud)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Shoot) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedComponentStatus) DeepCopyInto(out *SeedComponentStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedComponentStatus.
+func (in *SeedComponentStatus) DeepCopy() *SeedComponentStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(SeedComponentStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootList) DeepCopyInto(out *ShootList) {
+func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Shoot, len(*in))
+		*out = make([]Seed, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2484,18 +3133,18 @@ func (in *ShootList) DeepCopyInto(out *ShootList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootList.
-func (in *ShootList) DeepCopy() *ShootList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedList.
+func (in *SeedList) DeepCopy() *SeedList {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootList)
+	out := new(SeedList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ShootList) DeepCopyObject() runtime.Object {
+func (in *SeedList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2503,129 +3152,1217 @@ func (in *ShootList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
+func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
 	*out = *in
-	if in.Addons != nil {
-		in, out := &in.Addons, &out.Addons
-		if *in == nil {
-			*out = nil
-		} else {
-			*out = new(Addons)
-			(*in).DeepCopyInto(*out)
-		}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedNetworks.
+func (in *SeedNetworks) DeepCopy() *SeedNetworks {
+	if in == nil {
+		return nil
 	}
-	if in.Backup != nil {
-		in, out := &in.Backup, &out.Backup
+	out := new(SeedNetworks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedShootKubernetesVersions) DeepCopyInto(out *SeedShootKubernetesVersions) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = new(Backup)
+			*out = new(string)
 			**out = **in
 		}
 	}
-	in.Cloud.DeepCopyInto(&out.Cloud)
-	in.DNS.DeepCopyInto(&out.DNS)
-	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
-	if in.Maintenance != nil {
-		in, out := &in.Maintenance, &out.Maintenance
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = new(Maintenance)
-			(*in).DeepCopyInto(*out)
+			*out = new(string)
+			**out = **in
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSpec.
-func (in *ShootSpec) DeepCopy() *ShootSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedShootKubernetesVersions.
+func (in *SeedShootKubernetesVersions) DeepCopy() *SeedShootKubernetesVersions {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootSpec)
+	out := new(SeedShootKubernetesVersions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
+func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	out.Gardener = in.Gardener
-	if in.LastOperation != nil {
-		in, out := &in.LastOperation, &out.LastOperation
+	out.Cloud = in.Cloud
+	out.SecretRef = in.SecretRef
+	out.Networks = in.Networks
+	if in.Visible != nil {
+		in, out := &in.Visible, &out.Visible
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = new(LastOperation)
-			(*in).DeepCopyInto(*out)
+			*out = new(bool)
+			**out = **in
 		}
 	}
-	if in.LastError != nil {
-		in, out := &in.LastError, &out.LastError
+	if in.Protected != nil {
+		in, out := &in.Protected, &out.Protected
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = new(LastError)
-			(*in).DeepCopyInto(*out)
+			*out = new(bool)
+			**out = **in
 		}
 	}
-	if in.RetryCycleStartTime != nil {
-		in, out := &in.RetryCycleStartTime, &out.RetryCycleStartTime
+	if in.ShootKubernetesVersions != nil {
+		in, out := &in.ShootKubernetesVersions, &out.ShootKubernetesVersions
 		if *in == nil {
 			*out = nil
 		} else {
-			*out = (*in).DeepCopy()
+			*out = new(SeedShootKubernetesVersions)
+			(*in).DeepCopyInto(*out)
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStatus.
-func (in *ShootStatus) DeepCopy() *ShootStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSpec.
+func (in *SeedSpec) DeepCopy() *SeedSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ShootStatus)
+	out := new(SeedSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeType) DeepCopyInto(out *VolumeType) {
+func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]SeedComponentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedStatus.
+func (in *SeedStatus) DeepCopy() *SeedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Shoot) DeepCopyInto(out *Shoot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Shoot.
+func (in *Shoot) DeepCopy() *Shoot {
+	if in == nil {
+		return nil
+	}
+	out := new(Shoot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Shoot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCloudStatus) DeepCopyInto(out *ShootCloudStatus) {
+	*out = *in
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NATIPs != nil {
+		in, out := &in.NATIPs, &out.NATIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IAMRoles != nil {
+		in, out := &in.IAMRoles, &out.IAMRoles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCloudStatus.
+func (in *ShootCloudStatus) DeepCopy() *ShootCloudStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCloudStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootEtcdOperationLock) DeepCopyInto(out *ShootEtcdOperationLock) {
+	*out = *in
+	in.AcquireTime.DeepCopyInto(&out.AcquireTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootEtcdOperationLock.
+func (in *ShootEtcdOperationLock) DeepCopy() *ShootEtcdOperationLock {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootEtcdOperationLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootInventory) DeepCopyInto(out *ShootInventory) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ShootInventoryResource, len(*in))
+		copy(*out, *in)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootInventory.
+func (in *ShootInventory) DeepCopy() *ShootInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootInventoryResource) DeepCopyInto(out *ShootInventoryResource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootInventoryResource.
+func (in *ShootInventoryResource) DeepCopy() *ShootInventoryResource {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootInventoryResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootKubernetesUpgrade) DeepCopyInto(out *ShootKubernetesUpgrade) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootKubernetesUpgrade.
+func (in *ShootKubernetesUpgrade) DeepCopy() *ShootKubernetesUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootKubernetesUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootList) DeepCopyInto(out *ShootList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Shoot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootList.
+func (in *ShootList) DeepCopy() *ShootList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMachineCanaryStatus) DeepCopyInto(out *ShootMachineCanaryStatus) {
+	*out = *in
+	if in.SoakEndTime != nil {
+		in, out := &in.SoakEndTime, &out.SoakEndTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMachineCanaryStatus.
+func (in *ShootMachineCanaryStatus) DeepCopy() *ShootMachineCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMachineCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMachineDeploymentStatus) DeepCopyInto(out *ShootMachineDeploymentStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMachineDeploymentStatus.
+func (in *ShootMachineDeploymentStatus) DeepCopy() *ShootMachineDeploymentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMachineDeploymentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMachinesStatus) DeepCopyInto(out *ShootMachinesStatus) {
+	*out = *in
+	if in.Deployments != nil {
+		in, out := &in.Deployments, &out.Deployments
+		*out = make([]ShootMachineDeploymentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.PendingReplacements != nil {
+		in, out := &in.PendingReplacements, &out.PendingReplacements
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Canaries != nil {
+		in, out := &in.Canaries, &out.Canaries
+		*out = make([]ShootMachineCanaryStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMachinesStatus.
+func (in *ShootMachinesStatus) DeepCopy() *ShootMachinesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMachinesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMonitoring) DeepCopyInto(out *ShootMonitoring) {
+	*out = *in
+	out.CredentialsSecretRef = in.CredentialsSecretRef
+	out.FederationCredentialsSecretRef = in.FederationCredentialsSecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMonitoring.
+func (in *ShootMonitoring) DeepCopy() *ShootMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperation) DeepCopyInto(out *ShootOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperation.
+func (in *ShootOperation) DeepCopy() *ShootOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperationList) DeepCopyInto(out *ShootOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperationList.
+func (in *ShootOperationList) DeepCopy() *ShootOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperationShootStatus) DeepCopyInto(out *ShootOperationShootStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperationShootStatus.
+func (in *ShootOperationShootStatus) DeepCopy() *ShootOperationShootStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperationShootStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperationSpec) DeepCopyInto(out *ShootOperationSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.LabelSelector)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperationSpec.
+func (in *ShootOperationSpec) DeepCopy() *ShootOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperationStatus) DeepCopyInto(out *ShootOperationStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	if in.Shoots != nil {
+		in, out := &in.Shoots, &out.Shoots
+		*out = make([]ShootOperationShootStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperationStatus.
+func (in *ShootOperationStatus) DeepCopy() *ShootOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
+	*out = *in
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Addons)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Backup)
+			**out = **in
+		}
+	}
+	in.Cloud.DeepCopyInto(&out.Cloud)
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]core_v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	in.DNS.DeepCopyInto(&out.DNS)
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Hibernation)
+			**out = **in
+		}
+	}
+	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(Maintenance)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootSpec.
+func (in *ShootSpec) DeepCopy() *ShootSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
+	*out = *in
+	if in.Cloud != nil {
+		in, out := &in.Cloud, &out.Cloud
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootCloudStatus)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EtcdOperationLock != nil {
+		in, out := &in.EtcdOperationLock, &out.EtcdOperationLock
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootEtcdOperationLock)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	out.Gardener = in.Gardener
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootInventory)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(LastOperation)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.LastError != nil {
+		in, out := &in.LastError, &out.LastError
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(LastError)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.LastErrors != nil {
+		in, out := &in.LastErrors, &out.LastErrors
+		*out = make([]LastError, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Machines != nil {
+		in, out := &in.Machines, &out.Machines
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootMachinesStatus)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.KubernetesUpgrade != nil {
+		in, out := &in.KubernetesUpgrade, &out.KubernetesUpgrade
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootKubernetesUpgrade)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ShootMonitoring)
+			**out = **in
+		}
+	}
+	if in.RetryCycleStartTime != nil {
+		in, out := &in.RetryCycleStartTime, &out.RetryCycleStartTime
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = (*in).DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStatus.
+func (in *ShootStatus) DeepCopy() *ShootStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeType) DeepCopyInto(out *VolumeType) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeType.
+func (in *VolumeType) DeepCopy() *VolumeType {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WatchCacheSizes) DeepCopyInto(out *WatchCacheSizes) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int)
+			**out = **in
+		}
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WatchCacheSizes.
+func (in *WatchCacheSizes) DeepCopy() *WatchCacheSizes {
+	if in == nil {
+		return nil
+	}
+	out := new(WatchCacheSizes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Worker) DeepCopyInto(out *Worker) {
+	*out = *in
+	if in.MachineHealthTimeout != nil {
+		in, out := &in.MachineHealthTimeout, &out.MachineHealthTimeout
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
+	if in.MaxUnhealthy != nil {
+		in, out := &in.MaxUnhealthy, &out.MaxUnhealthy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(intstr.IntOrString)
+			**out = **in
+		}
+	}
+	if in.MaxEvictRetries != nil {
+		in, out := &in.MaxEvictRetries, &out.MaxEvictRetries
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.UnhealthyNodeConditions != nil {
+		in, out := &in.UnhealthyNodeConditions, &out.UnhealthyNodeConditions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeLabels != nil {
+		in, out := &in.NodeLabels, &out.NodeLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeAnnotations != nil {
+		in, out := &in.NodeAnnotations, &out.NodeAnnotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeTaints != nil {
+		in, out := &in.NodeTaints, &out.NodeTaints
+		*out = make([]core_v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Cordoned != nil {
+		in, out := &in.Cordoned, &out.Cordoned
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineArchitecture)
+			**out = **in
+		}
+	}
+	if in.WarmUp != nil {
+		in, out := &in.WarmUp, &out.WarmUp
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(intstr.IntOrString)
+			**out = **in
+		}
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(intstr.IntOrString)
+			**out = **in
+		}
+	}
+	if in.MinReadySeconds != nil {
+		in, out := &in.MinReadySeconds, &out.MinReadySeconds
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.FirewallRules != nil {
+		in, out := &in.FirewallRules, &out.FirewallRules
+		*out = make([]WorkerFirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WaitForCriticalComponents != nil {
+		in, out := &in.WaitForCriticalComponents, &out.WaitForCriticalComponents
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.MachineImage != nil {
+		in, out := &in.MachineImage, &out.MachineImage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(MachineImageName)
+			**out = **in
+		}
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.RolloutStage != nil {
+		in, out := &in.RolloutStage, &out.RolloutStage
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.Spot != nil {
+		in, out := &in.Spot, &out.Spot
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WorkerSpot)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.ScaleDownPriority != nil {
+		in, out := &in.ScaleDownPriority, &out.ScaleDownPriority
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WorkerKubeletConfig)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.OSUpdates != nil {
+		in, out := &in.OSUpdates, &out.OSUpdates
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WorkerOSUpdates)
+			**out = **in
+		}
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WorkerCanary)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.LocalSSDs != nil {
+		in, out := &in.LocalSSDs, &out.LocalSSDs
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(WorkerLocalSSDs)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	if in.ScaleDownBudget != nil {
+		in, out := &in.ScaleDownBudget, &out.ScaleDownBudget
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(ScaleDownBudget)
+			(*in).DeepCopyInto(*out)
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Worker.
+func (in *Worker) DeepCopy() *Worker {
+	if in == nil {
+		return nil
+	}
+	out := new(Worker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerCanary) DeepCopyInto(out *WorkerCanary) {
 	*out = *in
+	if in.SoakPeriod != nil {
+		in, out := &in.SoakPeriod, &out.SoakPeriod
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(v1.Duration)
+			**out = **in
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeType.
-func (in *VolumeType) DeepCopy() *VolumeType {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerCanary.
+func (in *WorkerCanary) DeepCopy() *WorkerCanary {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeType)
+	out := new(WorkerCanary)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Worker) DeepCopyInto(out *Worker) {
+func (in *WorkerFirewallRule) DeepCopyInto(out *WorkerFirewallRule) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(core_v1.Protocol)
+			**out = **in
+		}
+	}
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]CIDR, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFirewallRule.
+func (in *WorkerFirewallRule) DeepCopy() *WorkerFirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerKubeletConfig) DeepCopyInto(out *WorkerKubeletConfig) {
 	*out = *in
+	if in.EvictionHard != nil {
+		in, out := &in.EvictionHard, &out.EvictionHard
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EvictionSoft != nil {
+		in, out := &in.EvictionSoft, &out.EvictionSoft
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(int32)
+			**out = **in
+		}
+	}
+	if in.KubeReserved != nil {
+		in, out := &in.KubeReserved, &out.KubeReserved
+		*out = make(core_v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.SystemReserved != nil {
+		in, out := &in.SystemReserved, &out.SystemReserved
+		*out = make(core_v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.CPUManagerPolicy != nil {
+		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Worker.
-func (in *Worker) DeepCopy() *Worker {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerKubeletConfig.
+func (in *WorkerKubeletConfig) DeepCopy() *WorkerKubeletConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(Worker)
+	out := new(WorkerKubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerLocalSSDs) DeepCopyInto(out *WorkerLocalSSDs) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerLocalSSDs.
+func (in *WorkerLocalSSDs) DeepCopy() *WorkerLocalSSDs {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerLocalSSDs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerOSUpdates) DeepCopyInto(out *WorkerOSUpdates) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerOSUpdates.
+func (in *WorkerOSUpdates) DeepCopy() *WorkerOSUpdates {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerOSUpdates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerSpot) DeepCopyInto(out *WorkerSpot) {
+	*out = *in
+	if in.MaxPrice != nil {
+		in, out := &in.MaxPrice, &out.MaxPrice
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(string)
+			**out = **in
+		}
+	}
+	if in.FallbackToOnDemand != nil {
+		in, out := &in.FallbackToOnDemand, &out.FallbackToOnDemand
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	if in.Taint != nil {
+		in, out := &in.Taint, &out.Taint
+		if *in == nil {
+			*out = nil
+		} else {
+			*out = new(bool)
+			**out = **in
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerSpot.
+func (in *WorkerSpot) DeepCopy() *WorkerSpot {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerSpot)
 	in.DeepCopyInto(out)
 	return out
 }
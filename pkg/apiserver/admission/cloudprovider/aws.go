@@ -0,0 +1,54 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovider
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type awsShootValidator struct{}
+
+// Default implements ShootValidator.
+func (v *awsShootValidator) Default(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) error {
+	return nil
+}
+
+// Validate implements ShootValidator. Every zone needs exactly one internal, public and workers subnet. If
+// the Gardener is supposed to create the VPC then all subnets must be part of the VPC CIDR, otherwise the
+// infrastructure creation would fail late in the reconciliation.
+func (v *awsShootValidator) Validate(shoot, oldShoot *garden.Shoot, cloudProfile *garden.CloudProfile, seed *garden.Seed) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		networks  = shoot.Spec.Cloud.AWS.Networks
+		fldPath   = field.NewPath("spec", "cloud", "aws", "networks")
+		zoneCount = len(shoot.Spec.Cloud.AWS.Zones)
+	)
+
+	allErrs = append(allErrs, validateSubnetCount(networks.Internal, zoneCount, fldPath.Child("internal"), "internal")...)
+	allErrs = append(allErrs, validateSubnetCount(networks.Public, zoneCount, fldPath.Child("public"), "public")...)
+	allErrs = append(allErrs, validateSubnetCount(networks.Workers, zoneCount, fldPath.Child("workers"), "workers")...)
+
+	if networks.VPC.CIDR == nil {
+		return allErrs
+	}
+	vpcCIDR := *networks.VPC.CIDR
+
+	allErrs = append(allErrs, validateSubnetsInNetwork(vpcCIDR, networks.Internal, fldPath.Child("internal"), "vpc")...)
+	allErrs = append(allErrs, validateSubnetsInNetwork(vpcCIDR, networks.Public, fldPath.Child("public"), "vpc")...)
+	allErrs = append(allErrs, validateSubnetsInNetwork(vpcCIDR, networks.Workers, fldPath.Child("workers"), "vpc")...)
+
+	return allErrs
+}
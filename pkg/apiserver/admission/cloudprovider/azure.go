@@ -0,0 +1,47 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovider
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+type azureShootValidator struct{}
+
+// Default implements ShootValidator.
+func (v *azureShootValidator) Default(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) error {
+	return nil
+}
+
+// Validate implements ShootValidator. The workers subnet is created within the VNet, hence, it must be
+// contained in the VNet CIDR.
+func (v *azureShootValidator) Validate(shoot, oldShoot *garden.Shoot, cloudProfile *garden.CloudProfile, seed *garden.Seed) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		networks = shoot.Spec.Cloud.Azure.Networks
+		fldPath  = field.NewPath("spec", "cloud", "azure", "networks")
+	)
+
+	if networks.VNet.CIDR == nil {
+		return allErrs
+	}
+
+	if !networkContains(*networks.VNet.CIDR, networks.Workers) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("workers"), networks.Workers, "must be a subset of the vnet cidr "+string(*networks.VNet.CIDR)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovider
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+)
+
+// NewRegistry returns a new empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		validators: map[garden.CloudProvider][]ShootValidator{},
+	}
+}
+
+// NewDefaultRegistry returns a new Registry which contains the ShootValidators of all cloud providers
+// that are supported by the Gardener.
+func NewDefaultRegistry() *Registry {
+	r := NewRegistry()
+	r.Register(garden.CloudProviderAWS, &awsShootValidator{})
+	r.Register(garden.CloudProviderAzure, &azureShootValidator{})
+	return r
+}
+
+// Register adds the given <validator> for the <cloudProvider> to the registry. Multiple validators may
+// be registered for the same cloud provider, they are executed in the order of their registration.
+func (r *Registry) Register(cloudProvider garden.CloudProvider, validator ShootValidator) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.validators[cloudProvider] = append(r.validators[cloudProvider], validator)
+}
+
+// ShootValidators returns the list of ShootValidators which have been registered for the <cloudProvider>.
+func (r *Registry) ShootValidators(cloudProvider garden.CloudProvider) []ShootValidator {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	validators := make([]ShootValidator, len(r.validators[cloudProvider]))
+	copy(validators, r.validators[cloudProvider])
+	return validators
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovider
+
+import (
+	"sync"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ShootValidator is implemented by cloud providers which want to contribute provider-specific defaulting and
+// validation of Shoot resources to the admission chain of the Gardener API server.
+type ShootValidator interface {
+	// Default sets provider-specific default values on the given <shoot>. It is executed before the
+	// validation takes place.
+	Default(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) error
+	// Validate validates the given <shoot> against provider-specific constraints. The <oldShoot> is nil
+	// in case of CREATE operations.
+	Validate(shoot, oldShoot *garden.Shoot, cloudProfile *garden.CloudProfile, seed *garden.Seed) field.ErrorList
+}
+
+// Registry holds the ShootValidators of the cloud providers.
+type Registry struct {
+	lock       sync.RWMutex
+	validators map[garden.CloudProvider][]ShootValidator
+}
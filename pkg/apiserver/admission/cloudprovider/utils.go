@@ -0,0 +1,58 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprovider
+
+import (
+	"net"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// networkContains returns true if the <subnet> is completely contained in the <network>. Invalid CIDRs are
+// ignored here as they are already rejected by the static validation.
+func networkContains(network, subnet garden.CIDR) bool {
+	_, outer, err1 := net.ParseCIDR(string(network))
+	_, inner, err2 := net.ParseCIDR(string(subnet))
+	if err1 != nil || err2 != nil {
+		return true
+	}
+
+	outerOnes, _ := outer.Mask.Size()
+	innerOnes, _ := inner.Mask.Size()
+	return outer.Contains(inner.IP) && innerOnes >= outerOnes
+}
+
+func validateSubnetsInNetwork(network garden.CIDR, subnets []garden.CIDR, fldPath *field.Path, networkName string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, subnet := range subnets {
+		if !networkContains(network, subnet) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), subnet, "must be a subset of the "+networkName+" cidr "+string(network)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSubnetCount(subnets []garden.CIDR, zoneCount int, fldPath *field.Path, subnetName string) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(subnets) != zoneCount {
+		allErrs = append(allErrs, field.Invalid(fldPath, subnets, "must specify as many "+subnetName+" networks as zones"))
+	}
+
+	return allErrs
+}
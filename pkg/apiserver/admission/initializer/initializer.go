@@ -15,6 +15,7 @@
 package initializer
 
 import (
+	"github.com/gardener/gardener/pkg/apiserver/admission/cloudprovider"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
@@ -22,11 +23,12 @@ import (
 )
 
 // New constructs new instance of PluginInitializer
-func New(gardenInformers gardeninformers.SharedInformerFactory, kubeInformers kubeinformers.SharedInformerFactory, authz authorizer.Authorizer) admission.PluginInitializer {
+func New(gardenInformers gardeninformers.SharedInformerFactory, kubeInformers kubeinformers.SharedInformerFactory, authz authorizer.Authorizer, cloudProviderRegistry *cloudprovider.Registry) admission.PluginInitializer {
 	return pluginInitializer{
-		gardenInformers: gardenInformers,
-		kubeInformers:   kubeInformers,
-		authorizer:      authz,
+		gardenInformers:       gardenInformers,
+		kubeInformers:         kubeInformers,
+		authorizer:            authz,
+		cloudProviderRegistry: cloudProviderRegistry,
 	}
 }
 
@@ -42,4 +44,7 @@ func (i pluginInitializer) Initialize(plugin admission.Interface) {
 	if wants, ok := plugin.(WantsAuthorizer); ok {
 		wants.SetAuthorizer(i.authorizer)
 	}
+	if wants, ok := plugin.(WantsCloudProviderRegistry); ok {
+		wants.SetCloudProviderRegistry(i.cloudProviderRegistry)
+	}
 }
@@ -15,6 +15,7 @@
 package initializer
 
 import (
+	"github.com/gardener/gardener/pkg/apiserver/admission/cloudprovider"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
@@ -39,10 +40,18 @@ type WantsAuthorizer interface {
 	admission.InitializationValidator
 }
 
+// WantsCloudProviderRegistry defines a function which sets the registry of cloud provider specific Shoot
+// hooks for admission plugins that need it.
+type WantsCloudProviderRegistry interface {
+	SetCloudProviderRegistry(*cloudprovider.Registry)
+	admission.InitializationValidator
+}
+
 type pluginInitializer struct {
-	gardenInformers gardeninformers.SharedInformerFactory
-	kubeInformers   kubeinformers.SharedInformerFactory
-	authorizer      authorizer.Authorizer
+	gardenInformers       gardeninformers.SharedInformerFactory
+	kubeInformers         kubeinformers.SharedInformerFactory
+	authorizer            authorizer.Authorizer
+	cloudProviderRegistry *cloudprovider.Registry
 }
 
 var _ admission.PluginInitializer = pluginInitializer{}
@@ -16,13 +16,17 @@ package aws
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 )
 
 // NewClient creates a new Client for the given AWS credentials <accessKeyID>, <secretAccessKey>, and
@@ -38,16 +42,48 @@ func NewClient(accessKeyID, secretAccessKey, region string) ClientInterface {
 	)
 
 	return &Client{
-		EC2: ec2.New(sess, config),
-		ELB: elb.New(sess, config),
-		STS: sts.New(sess, config),
+		EC2:   ec2.New(sess, config),
+		ELB:   elb.New(sess, config),
+		STS:   sts.New(sess, config),
+		guard: cloudapi.GuardFor(Provider, IsAPIFailure, accessKeyID, region),
 	}
 }
 
+// IsAPIFailure returns true if the given error indicates that the AWS API is throttling the requests or is
+// unavailable, and false if the error has been caused by the request itself.
+func IsAPIFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	if request.IsErrorThrottle(err) {
+		return true
+	}
+	if requestFailure, ok := err.(awserr.RequestFailure); ok {
+		return requestFailure.StatusCode() >= http.StatusInternalServerError
+	}
+	if awsErr, ok := err.(awserr.Error); ok {
+		// RequestError is the code of requests which could not be sent, e.g. because of timeouts.
+		return awsErr.Code() == "RequestError"
+	}
+	return false
+}
+
+// call sends requests to the AWS API through the rate limiter and circuit breaker which are shared by all clients
+// with the same credentials and region.
+func (c *Client) call(fn func() error) error {
+	if c.guard == nil {
+		return fn()
+	}
+	return c.guard.Do(fn)
+}
+
 // GetAccountID returns the ID of the AWS account the Client is interacting with.
 func (c *Client) GetAccountID() (string, error) {
-	getCallerIdentityOutput, err := c.STS.GetCallerIdentity(&sts.GetCallerIdentityInput{})
-	if err != nil {
+	var getCallerIdentityOutput *sts.GetCallerIdentityOutput
+	if err := c.call(func() (err error) {
+		getCallerIdentityOutput, err = c.STS.GetCallerIdentity(&sts.GetCallerIdentityInput{})
+		return err
+	}); err != nil {
 		return "", err
 	}
 	return *getCallerIdentityOutput.Account, nil
@@ -66,8 +102,11 @@ func (c *Client) GetInternetGateway(vpcID string) (string, error) {
 			},
 		},
 	}
-	describeInternetGatewaysOutput, err := c.EC2.DescribeInternetGateways(describeInternetGatewaysInput)
-	if err != nil {
+	var describeInternetGatewaysOutput *ec2.DescribeInternetGatewaysOutput
+	if err := c.call(func() (err error) {
+		describeInternetGatewaysOutput, err = c.EC2.DescribeInternetGateways(describeInternetGatewaysInput)
+		return err
+	}); err != nil {
 		return "", err
 	}
 
@@ -88,7 +127,12 @@ func (c *Client) GetELB(loadBalancerName string) (*elb.DescribeLoadBalancersOutp
 		},
 		PageSize: aws.Int64(1),
 	}
-	return c.ELB.DescribeLoadBalancers(describeLoadBalancersInput)
+	var describeLoadBalancersOutput *elb.DescribeLoadBalancersOutput
+	err := c.call(func() (err error) {
+		describeLoadBalancersOutput, err = c.ELB.DescribeLoadBalancers(describeLoadBalancersInput)
+		return err
+	})
+	return describeLoadBalancersOutput, err
 }
 
 // UpdateELBHealthCheck updates the AWS LoadBalancer health check target protocol to SSL for a given
@@ -104,6 +148,226 @@ func (c *Client) UpdateELBHealthCheck(loadBalancerName string, targetPort string
 		},
 		LoadBalancerName: aws.String(loadBalancerName),
 	}
-	_, err := c.ELB.ConfigureHealthCheck(configureHealthCheckInput)
-	return err
+	return c.call(func() error {
+		_, err := c.ELB.ConfigureHealthCheck(configureHealthCheckInput)
+		return err
+	})
+}
+
+// GetNetworkInterfaces returns all network interfaces in the given VPC <vpcID>.
+func (c *Client) GetNetworkInterfaces(vpcID string) ([]*ec2.NetworkInterface, error) {
+	describeNetworkInterfacesInput := &ec2.DescribeNetworkInterfacesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("vpc-id"),
+				Values: []*string{
+					aws.String(vpcID),
+				},
+			},
+		},
+	}
+	var describeNetworkInterfacesOutput *ec2.DescribeNetworkInterfacesOutput
+	if err := c.call(func() (err error) {
+		describeNetworkInterfacesOutput, err = c.EC2.DescribeNetworkInterfaces(describeNetworkInterfacesInput)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return describeNetworkInterfacesOutput.NetworkInterfaces, nil
+}
+
+// DeleteNetworkInterface deletes the network interface with the given <networkInterfaceID>. Only network interfaces
+// which are not attached can be deleted.
+func (c *Client) DeleteNetworkInterface(networkInterfaceID string) error {
+	deleteNetworkInterfaceInput := &ec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: aws.String(networkInterfaceID),
+	}
+	return c.call(func() error {
+		_, err := c.EC2.DeleteNetworkInterface(deleteNetworkInterfaceInput)
+		return err
+	})
+}
+
+// GetSecurityGroupsWithTag returns all security groups in the given VPC <vpcID> which carry a tag with the given
+// key <tagKey>.
+func (c *Client) GetSecurityGroupsWithTag(vpcID, tagKey string) ([]*ec2.SecurityGroup, error) {
+	describeSecurityGroupsInput := &ec2.DescribeSecurityGroupsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name: aws.String("vpc-id"),
+				Values: []*string{
+					aws.String(vpcID),
+				},
+			},
+			{
+				Name: aws.String("tag-key"),
+				Values: []*string{
+					aws.String(tagKey),
+				},
+			},
+		},
+	}
+	var describeSecurityGroupsOutput *ec2.DescribeSecurityGroupsOutput
+	if err := c.call(func() (err error) {
+		describeSecurityGroupsOutput, err = c.EC2.DescribeSecurityGroups(describeSecurityGroupsInput)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return describeSecurityGroupsOutput.SecurityGroups, nil
+}
+
+// DeleteSecurityGroup deletes the security group with the given <securityGroupID>.
+func (c *Client) DeleteSecurityGroup(securityGroupID string) error {
+	deleteSecurityGroupInput := &ec2.DeleteSecurityGroupInput{
+		GroupId: aws.String(securityGroupID),
+	}
+	return c.call(func() error {
+		_, err := c.EC2.DeleteSecurityGroup(deleteSecurityGroupInput)
+		return err
+	})
+}
+
+// GetInstanceIDsWithTag returns the IDs of all instances which carry a tag with the given key <tagKey> and value
+// <tagValue> and which have not been terminated (or are about to be terminated).
+func (c *Client) GetInstanceIDsWithTag(tagKey, tagValue string) ([]string, error) {
+	var (
+		instanceIDs            []string
+		describeInstancesInput = &ec2.DescribeInstancesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name: aws.String(fmt.Sprintf("tag:%s", tagKey)),
+					Values: []*string{
+						aws.String(tagValue),
+					},
+				},
+				{
+					Name: aws.String("instance-state-name"),
+					Values: []*string{
+						aws.String(ec2.InstanceStateNamePending),
+						aws.String(ec2.InstanceStateNameRunning),
+						aws.String(ec2.InstanceStateNameStopping),
+						aws.String(ec2.InstanceStateNameStopped),
+					},
+				},
+			},
+		}
+	)
+
+	err := c.call(func() error {
+		instanceIDs = nil
+		return c.EC2.DescribeInstancesPages(describeInstancesInput, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			for _, reservation := range page.Reservations {
+				for _, instance := range reservation.Instances {
+					instanceIDs = append(instanceIDs, *instance.InstanceId)
+				}
+			}
+			return true
+		})
+	})
+	return instanceIDs, err
+}
+
+// GetRunningInstances returns all instances in the region of the Client which are pending or running.
+func (c *Client) GetRunningInstances() ([]*ec2.Instance, error) {
+	var (
+		instances              []*ec2.Instance
+		describeInstancesInput = &ec2.DescribeInstancesInput{
+			Filters: []*ec2.Filter{
+				{
+					Name: aws.String("instance-state-name"),
+					Values: []*string{
+						aws.String(ec2.InstanceStateNamePending),
+						aws.String(ec2.InstanceStateNameRunning),
+					},
+				},
+			},
+		}
+	)
+
+	err := c.call(func() error {
+		instances = nil
+		return c.EC2.DescribeInstancesPages(describeInstancesInput, func(page *ec2.DescribeInstancesOutput, lastPage bool) bool {
+			for _, reservation := range page.Reservations {
+				instances = append(instances, reservation.Instances...)
+			}
+			return true
+		})
+	})
+	return instances, err
+}
+
+// GetAvailableImageIDs returns those of the given machine images <imageIDs> which exist in the region of the Client,
+// are available and can be used by its account.
+func (c *Client) GetAvailableImageIDs(imageIDs []string) ([]string, error) {
+	describeImagesInput := &ec2.DescribeImagesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("image-id"),
+				Values: aws.StringSlice(imageIDs),
+			},
+			{
+				Name: aws.String("state"),
+				Values: []*string{
+					aws.String(ec2.ImageStateAvailable),
+				},
+			},
+		},
+	}
+	var describeImagesOutput *ec2.DescribeImagesOutput
+	if err := c.call(func() (err error) {
+		describeImagesOutput, err = c.EC2.DescribeImages(describeImagesInput)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	var availableImageIDs []string
+	for _, image := range describeImagesOutput.Images {
+		availableImageIDs = append(availableImageIDs, *image.ImageId)
+	}
+	return availableImageIDs, nil
+}
+
+// GetAccountAttribute returns the values of the account attribute with the given <name>, e.g. the maximum number of
+// on-demand instances in the region of the Client (max-instances).
+func (c *Client) GetAccountAttribute(name string) ([]string, error) {
+	describeAccountAttributesInput := &ec2.DescribeAccountAttributesInput{
+		AttributeNames: []*string{
+			aws.String(name),
+		},
+	}
+	var describeAccountAttributesOutput *ec2.DescribeAccountAttributesOutput
+	if err := c.call(func() (err error) {
+		describeAccountAttributesOutput, err = c.EC2.DescribeAccountAttributes(describeAccountAttributesInput)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	var values []string
+	for _, attribute := range describeAccountAttributesOutput.AccountAttributes {
+		if aws.StringValue(attribute.AttributeName) != name {
+			continue
+		}
+		for _, value := range attribute.AttributeValues {
+			values = append(values, aws.StringValue(value.AttributeValue))
+		}
+	}
+	return values, nil
+}
+
+// GetSubnets returns the subnets with the given <subnetIDs>.
+func (c *Client) GetSubnets(subnetIDs []string) ([]*ec2.Subnet, error) {
+	describeSubnetsInput := &ec2.DescribeSubnetsInput{
+		SubnetIds: aws.StringSlice(subnetIDs),
+	}
+	var describeSubnetsOutput *ec2.DescribeSubnetsOutput
+	if err := c.call(func() (err error) {
+		describeSubnetsOutput, err = c.EC2.DescribeSubnets(describeSubnetsInput)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return describeSubnetsOutput.Subnets, nil
 }
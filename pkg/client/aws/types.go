@@ -18,14 +18,27 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/elb"
 	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 )
 
+// Provider is the name under which the rate limiter and circuit breaker of the AWS API calls are registered.
+const Provider = "aws"
+
 // ClientInterface is an interface which must be implemented by AWS clients.
 type ClientInterface interface {
 	GetAccountID() (string, error)
 	GetInternetGateway(string) (string, error)
 	GetELB(string) (*elb.DescribeLoadBalancersOutput, error)
 	UpdateELBHealthCheck(string, string) error
+	GetNetworkInterfaces(string) ([]*ec2.NetworkInterface, error)
+	DeleteNetworkInterface(string) error
+	GetSecurityGroupsWithTag(string, string) ([]*ec2.SecurityGroup, error)
+	DeleteSecurityGroup(string) error
+	GetInstanceIDsWithTag(string, string) ([]string, error)
+	GetRunningInstances() ([]*ec2.Instance, error)
+	GetAvailableImageIDs([]string) ([]string, error)
+	GetAccountAttribute(string) ([]string, error)
+	GetSubnets([]string) ([]*ec2.Subnet, error)
 }
 
 // Client is a struct containing several clients for the different AWS services it needs to interact with.
@@ -36,4 +49,6 @@ type Client struct {
 	EC2 *ec2.EC2
 	ELB *elb.ELB
 	STS *sts.STS
+
+	guard *cloudapi.Guard
 }
@@ -0,0 +1,160 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/gardener/gardener/pkg/client/cloudapi"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cloudapi", func() {
+	var (
+		now      time.Time
+		clock    = func() time.Time { return now }
+		settings = Settings{
+			QPS:              1000,
+			Burst:            1000,
+			FailureThreshold: 3,
+			OpenDuration:     time.Minute,
+		}
+
+		errThrottled = errors.New("throttled")
+		errNotFound  = errors.New("not found")
+		isFailure    = func(err error) bool { return err == errThrottled }
+
+		fail = func(err error) func() error {
+			return func() error { return err }
+		}
+		succeed = func() error { return nil }
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	})
+
+	Describe("Guard", func() {
+		var guard *Guard
+
+		BeforeEach(func() {
+			guard = ExportNewGuard("test", settings, isFailure, clock)
+		})
+
+		It("should open the circuit after the failure threshold has been reached", func() {
+			for i := 0; i < settings.FailureThreshold; i++ {
+				Expect(guard.Do(fail(errThrottled))).To(Equal(errThrottled))
+			}
+
+			Expect(guard.State()).To(Equal(StateOpen))
+			called := false
+			err := guard.Do(func() error {
+				called = true
+				return nil
+			})
+			Expect(called).To(BeFalse())
+			Expect(operationerrors.IsTransient(err)).To(BeTrue())
+		})
+
+		It("should not count errors which are no failures", func() {
+			for i := 0; i < 2*settings.FailureThreshold; i++ {
+				Expect(guard.Do(fail(errNotFound))).To(Equal(errNotFound))
+			}
+
+			Expect(guard.State()).To(Equal(StateClosed))
+		})
+
+		It("should reset the failures after a successful call", func() {
+			for i := 0; i < settings.FailureThreshold-1; i++ {
+				guard.Do(fail(errThrottled))
+			}
+			Expect(guard.Do(succeed)).To(Succeed())
+			guard.Do(fail(errThrottled))
+
+			Expect(guard.State()).To(Equal(StateClosed))
+		})
+
+		It("should close the circuit again if the trial call succeeds", func() {
+			for i := 0; i < settings.FailureThreshold; i++ {
+				guard.Do(fail(errThrottled))
+			}
+			now = now.Add(settings.OpenDuration)
+
+			Expect(guard.State()).To(Equal(StateHalfOpen))
+			Expect(guard.Do(succeed)).To(Succeed())
+			Expect(guard.State()).To(Equal(StateClosed))
+		})
+
+		It("should open the circuit again if the trial call fails", func() {
+			for i := 0; i < settings.FailureThreshold; i++ {
+				guard.Do(fail(errThrottled))
+			}
+			now = now.Add(settings.OpenDuration)
+
+			Expect(guard.Do(fail(errThrottled))).To(Equal(errThrottled))
+			Expect(guard.State()).To(Equal(StateOpen))
+		})
+	})
+
+	Describe("Registry", func() {
+		var registry *Registry
+
+		BeforeEach(func() {
+			registry = NewRegistry()
+			registry.SetNow(clock)
+			registry.Configure("aws", settings)
+		})
+
+		It("should share the guard between clients with the same credentials", func() {
+			Expect(registry.Guard("aws", isFailure, "key", "eu-west-1")).To(BeIdenticalTo(registry.Guard("aws", isFailure, "key", "eu-west-1")))
+		})
+
+		It("should isolate clients with different credentials", func() {
+			guard := registry.Guard("aws", isFailure, "key", "eu-west-1")
+			for i := 0; i < settings.FailureThreshold; i++ {
+				guard.Do(fail(errThrottled))
+			}
+
+			Expect(guard.State()).To(Equal(StateOpen))
+			Expect(registry.Guard("aws", isFailure, "other-key", "eu-west-1").State()).To(Equal(StateClosed))
+			Expect(registry.Guard("aws", isFailure, "key", "us-east-1").State()).To(Equal(StateClosed))
+		})
+
+		It("should evict guards which have not been used for the idle timeout", func() {
+			guard := registry.Guard("aws", isFailure, "rotated-key", "eu-west-1")
+			now = now.Add(DefaultIdleTimeout / 2)
+			used := registry.Guard("aws", isFailure, "key", "eu-west-1")
+			now = now.Add(DefaultIdleTimeout / 2)
+			Expect(used.Do(succeed)).To(Succeed())
+			now = now.Add(time.Second)
+
+			Expect(registry.Guard("aws", isFailure, "key", "eu-west-1")).To(BeIdenticalTo(used))
+			Expect(registry.Guard("aws", isFailure, "rotated-key", "eu-west-1")).NotTo(BeIdenticalTo(guard))
+		})
+
+		It("should create the guards with the configured settings", func() {
+			registry.Configure("gcp", Settings{QPS: 1000, Burst: 1000, FailureThreshold: 1, OpenDuration: time.Minute})
+
+			guard := registry.Guard("gcp", isFailure, "project")
+			guard.Do(fail(errThrottled))
+
+			Expect(guard.State()).To(Equal(StateOpen))
+		})
+	})
+})
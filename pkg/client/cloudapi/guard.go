@@ -0,0 +1,144 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	"golang.org/x/time/rate"
+)
+
+// NewGuard creates a new Guard with the given <name> (used in error messages) and <settings>. The <isFailure>
+// function decides which errors count as failures for the circuit breaker; if it is nil, every error counts.
+func NewGuard(name string, settings Settings, isFailure FailureFunc) *Guard {
+	return newGuard(name, settings, isFailure, time.Now)
+}
+
+func newGuard(name string, settings Settings, isFailure FailureFunc, now func() time.Time) *Guard {
+	if isFailure == nil {
+		isFailure = func(err error) bool { return err != nil }
+	}
+	return &Guard{
+		name:      name,
+		settings:  settings,
+		limiter:   rate.NewLimiter(rate.Limit(settings.QPS), settings.Burst),
+		isFailure: isFailure,
+		now:       now,
+		state:     StateClosed,
+		lastUsed:  now(),
+	}
+}
+
+// Do calls <fn> if the circuit is not open and the rate limit allows it, and records its result.
+func (g *Guard) Do(fn func() error) error {
+	if err := g.Acquire(context.Background()); err != nil {
+		return err
+	}
+	err := fn()
+	g.Release(err)
+	return err
+}
+
+// Acquire checks whether a call to the cloud API may be sent and waits until the rate limit allows it. It returns
+// a transient error if the circuit is open. Every successful Acquire must be followed by a Release with the result
+// of the call.
+func (g *Guard) Acquire(ctx context.Context) error {
+	if err := g.enter(); err != nil {
+		return err
+	}
+	if err := g.limiter.Wait(ctx); err != nil {
+		g.abort()
+		return operationerrors.Transient(fmt.Errorf("rate limit of the %s API: %v", g.name, err))
+	}
+	return nil
+}
+
+// Release records the result <err> of a call which has been admitted by Acquire.
+func (g *Guard) Release(err error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.state == StateHalfOpen {
+		g.probing = false
+	}
+	if err == nil || !g.isFailure(err) {
+		g.state = StateClosed
+		g.failures = 0
+		return
+	}
+
+	g.failures++
+	if g.state == StateHalfOpen || g.failures >= g.settings.FailureThreshold {
+		g.state = StateOpen
+		g.openedAt = g.now()
+	}
+}
+
+// State returns the current state of the circuit breaker.
+func (g *Guard) State() State {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	if g.state == StateOpen && !g.now().Before(g.openedAt.Add(g.settings.OpenDuration)) {
+		return StateHalfOpen
+	}
+	return g.state
+}
+
+// enter admits a call unless the circuit is open. After the OpenDuration has passed, a single trial call is
+// admitted while the circuit is half-open.
+func (g *Guard) enter() error {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.lastUsed = g.now()
+	if g.state == StateOpen {
+		retryAt := g.openedAt.Add(g.settings.OpenDuration)
+		if g.now().Before(retryAt) {
+			return g.circuitOpenError(retryAt)
+		}
+		g.state = StateHalfOpen
+	}
+	if g.state == StateHalfOpen {
+		if g.probing {
+			return g.circuitOpenError(g.now())
+		}
+		g.probing = true
+	}
+	return nil
+}
+
+// abort gives up a call which has been admitted by enter but has not been sent.
+// usedBefore returns true if the Guard has not been used since the given <deadline>.
+func (g *Guard) usedBefore(deadline time.Time) bool {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	return g.lastUsed.Before(deadline)
+}
+
+func (g *Guard) abort() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.probing = false
+}
+
+func (g *Guard) circuitOpenError(retryAt time.Time) error {
+	return operationerrors.Errorf(operationerrors.ClassTransient, "the %s API failed %d times in a row, calls are suspended until %s", g.name, g.settings.FailureThreshold, retryAt.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// DefaultIdleTimeout is the duration after which unused Guards are evicted from a Registry.
+const DefaultIdleTimeout = time.Hour
+
+var defaultRegistry = NewRegistry()
+
+// NewRegistry creates a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		guards:      map[string]*Guard{},
+		settings:    map[string]Settings{},
+		idleTimeout: DefaultIdleTimeout,
+		now:         time.Now,
+	}
+}
+
+// Configure sets the Settings of the given <provider>. It only affects Guards which are created afterwards.
+func (r *Registry) Configure(provider string, settings Settings) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.settings[provider] = settings
+}
+
+// Guard returns the Guard for the given <provider> and <credentials> (e.g. the access key and the region), and
+// creates it if it does not exist yet. The credentials are only stored as a hash. Guards which have been idle for
+// longer than the idle timeout are evicted, e.g. because their credentials have been rotated or deleted.
+func (r *Registry) Guard(provider string, isFailure FailureFunc, credentials ...string) *Guard {
+	key := provider + "/" + hashCredentials(credentials)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.evictIdleGuards()
+	if guard, ok := r.guards[key]; ok {
+		return guard
+	}
+
+	settings, ok := r.settings[provider]
+	if !ok {
+		settings = DefaultSettings
+	}
+	guard := newGuard(provider, settings, isFailure, r.now)
+	r.guards[key] = guard
+	return guard
+}
+
+// evictIdleGuards removes the Guards which have not been used for the idle timeout. The caller must hold the lock.
+func (r *Registry) evictIdleGuards() {
+	deadline := r.now().Add(-r.idleTimeout)
+	for key, guard := range r.guards {
+		if guard.usedBefore(deadline) {
+			delete(r.guards, key)
+		}
+	}
+}
+
+// Configure sets the Settings of the given <provider> in the default Registry.
+func Configure(provider string, settings Settings) {
+	defaultRegistry.Configure(provider, settings)
+}
+
+// GuardFor returns the Guard for the given <provider> and <credentials> from the default Registry.
+func GuardFor(provider string, isFailure FailureFunc, credentials ...string) *Guard {
+	return defaultRegistry.Guard(provider, isFailure, credentials...)
+}
+
+func hashCredentials(credentials []string) string {
+	hash := sha256.New()
+	for _, credential := range credentials {
+		hash.Write([]byte(credential))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudapi
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Settings configures the rate limiter and the circuit breaker of a Guard.
+type Settings struct {
+	// QPS is the number of calls per second which may be sent to the cloud API.
+	QPS float64
+	// Burst is the number of calls which may be sent at once before QPS applies.
+	Burst int
+	// FailureThreshold is the number of consecutive failures after which the circuit opens.
+	FailureThreshold int
+	// OpenDuration is the time the circuit stays open before a single trial call is let through.
+	OpenDuration time.Duration
+}
+
+// DefaultSettings are the Settings used for providers which have not been configured explicitly.
+var DefaultSettings = Settings{
+	QPS:              10,
+	Burst:            20,
+	FailureThreshold: 5,
+	OpenDuration:     30 * time.Second,
+}
+
+// FailureFunc decides whether an error returned by a cloud API counts as a failure for the circuit breaker.
+// Only errors indicating an overloaded or unavailable API (throttling, server errors, timeouts) should count,
+// errors caused by the request itself (not found, invalid parameters, ...) should not.
+type FailureFunc func(error) bool
+
+// State is the state of a circuit breaker.
+type State string
+
+const (
+	// StateClosed is the state in which all calls are let through.
+	StateClosed State = "Closed"
+	// StateOpen is the state in which all calls are rejected.
+	StateOpen State = "Open"
+	// StateHalfOpen is the state in which a single trial call is let through to probe whether the API has recovered.
+	StateHalfOpen State = "HalfOpen"
+)
+
+// Guard rate limits and circuit breaks the calls to the cloud API of a provider with a certain set of credentials.
+// It is safe for concurrent use.
+type Guard struct {
+	name      string
+	settings  Settings
+	limiter   *rate.Limiter
+	isFailure FailureFunc
+	now       func() time.Time
+
+	lock     sync.Mutex
+	state    State
+	failures int
+	openedAt time.Time
+	probing  bool
+	lastUsed time.Time
+}
+
+// Registry holds the Guards for all providers and credentials so that all Shoots sharing the same cloud account
+// share the same rate limit and circuit breaker. Guards which have not been used for the idle timeout are evicted,
+// so that the Guards of rotated or deleted credentials do not pile up.
+type Registry struct {
+	lock        sync.Mutex
+	guards      map[string]*Guard
+	settings    map[string]Settings
+	idleTimeout time.Duration
+	now         func() time.Time
+}
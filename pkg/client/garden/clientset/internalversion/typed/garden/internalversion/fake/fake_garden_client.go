@@ -24,6 +24,10 @@ func (c *FakeGarden) Quotas(namespace string) internalversion.QuotaInterface {
 	return &FakeQuotas{c, namespace}
 }
 
+func (c *FakeGarden) RegionPolicies() internalversion.RegionPolicyInterface {
+	return &FakeRegionPolicies{c}
+}
+
 func (c *FakeGarden) SecretBindings(namespace string) internalversion.SecretBindingInterface {
 	return &FakeSecretBindings{c, namespace}
 }
@@ -32,10 +36,18 @@ func (c *FakeGarden) Seeds() internalversion.SeedInterface {
 	return &FakeSeeds{c}
 }
 
+func (c *FakeGarden) SeedAccessRequests(namespace string) internalversion.SeedAccessRequestInterface {
+	return &FakeSeedAccessRequests{c, namespace}
+}
+
 func (c *FakeGarden) Shoots(namespace string) internalversion.ShootInterface {
 	return &FakeShoots{c, namespace}
 }
 
+func (c *FakeGarden) ShootOperations(namespace string) internalversion.ShootOperationInterface {
+	return &FakeShootOperations{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeGarden) RESTClient() rest.Interface {
@@ -12,9 +12,12 @@ type GardenInterface interface {
 	BackupInfrastructuresGetter
 	CloudProfilesGetter
 	QuotasGetter
+	RegionPoliciesGetter
 	SecretBindingsGetter
 	SeedsGetter
+	SeedAccessRequestsGetter
 	ShootsGetter
+	ShootOperationsGetter
 }
 
 // GardenClient is used to interact with features provided by the garden.sapcloud.io group.
@@ -34,6 +37,10 @@ func (c *GardenClient) Quotas(namespace string) QuotaInterface {
 	return newQuotas(c, namespace)
 }
 
+func (c *GardenClient) RegionPolicies() RegionPolicyInterface {
+	return newRegionPolicies(c)
+}
+
 func (c *GardenClient) SecretBindings(namespace string) SecretBindingInterface {
 	return newSecretBindings(c, namespace)
 }
@@ -42,10 +49,18 @@ func (c *GardenClient) Seeds() SeedInterface {
 	return newSeeds(c)
 }
 
+func (c *GardenClient) SeedAccessRequests(namespace string) SeedAccessRequestInterface {
+	return newSeedAccessRequests(c, namespace)
+}
+
 func (c *GardenClient) Shoots(namespace string) ShootInterface {
 	return newShoots(c, namespace)
 }
 
+func (c *GardenClient) ShootOperations(namespace string) ShootOperationInterface {
+	return newShootOperations(c, namespace)
+}
+
 // NewForConfig creates a new GardenClient for the given config.
 func NewForConfig(c *rest.Config) (*GardenClient, error) {
 	config := *c
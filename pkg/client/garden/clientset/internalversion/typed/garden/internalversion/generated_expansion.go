@@ -8,8 +8,14 @@ type CloudProfileExpansion interface{}
 
 type QuotaExpansion interface{}
 
+type RegionPolicyExpansion interface{}
+
 type SecretBindingExpansion interface{}
 
 type SeedExpansion interface{}
 
+type SeedAccessRequestExpansion interface{}
+
 type ShootExpansion interface{}
+
+type ShootOperationExpansion interface{}
@@ -24,6 +24,10 @@ func (c *FakeGardenV1beta1) Quotas(namespace string) v1beta1.QuotaInterface {
 	return &FakeQuotas{c, namespace}
 }
 
+func (c *FakeGardenV1beta1) RegionPolicies() v1beta1.RegionPolicyInterface {
+	return &FakeRegionPolicies{c}
+}
+
 func (c *FakeGardenV1beta1) SecretBindings(namespace string) v1beta1.SecretBindingInterface {
 	return &FakeSecretBindings{c, namespace}
 }
@@ -32,10 +36,18 @@ func (c *FakeGardenV1beta1) Seeds() v1beta1.SeedInterface {
 	return &FakeSeeds{c}
 }
 
+func (c *FakeGardenV1beta1) SeedAccessRequests(namespace string) v1beta1.SeedAccessRequestInterface {
+	return &FakeSeedAccessRequests{c, namespace}
+}
+
 func (c *FakeGardenV1beta1) Shoots(namespace string) v1beta1.ShootInterface {
 	return &FakeShoots{c, namespace}
 }
 
+func (c *FakeGardenV1beta1) ShootOperations(namespace string) v1beta1.ShootOperationInterface {
+	return &FakeShootOperations{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeGardenV1beta1) RESTClient() rest.Interface {
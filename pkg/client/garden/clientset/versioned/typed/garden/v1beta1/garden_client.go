@@ -14,9 +14,12 @@ type GardenV1beta1Interface interface {
 	BackupInfrastructuresGetter
 	CloudProfilesGetter
 	QuotasGetter
+	RegionPoliciesGetter
 	SecretBindingsGetter
 	SeedsGetter
+	SeedAccessRequestsGetter
 	ShootsGetter
+	ShootOperationsGetter
 }
 
 // GardenV1beta1Client is used to interact with features provided by the garden.sapcloud.io group.
@@ -36,6 +39,10 @@ func (c *GardenV1beta1Client) Quotas(namespace string) QuotaInterface {
 	return newQuotas(c, namespace)
 }
 
+func (c *GardenV1beta1Client) RegionPolicies() RegionPolicyInterface {
+	return newRegionPolicies(c)
+}
+
 func (c *GardenV1beta1Client) SecretBindings(namespace string) SecretBindingInterface {
 	return newSecretBindings(c, namespace)
 }
@@ -44,10 +51,18 @@ func (c *GardenV1beta1Client) Seeds() SeedInterface {
 	return newSeeds(c)
 }
 
+func (c *GardenV1beta1Client) SeedAccessRequests(namespace string) SeedAccessRequestInterface {
+	return newSeedAccessRequests(c, namespace)
+}
+
 func (c *GardenV1beta1Client) Shoots(namespace string) ShootInterface {
 	return newShoots(c, namespace)
 }
 
+func (c *GardenV1beta1Client) ShootOperations(namespace string) ShootOperationInterface {
+	return newShootOperations(c, namespace)
+}
+
 // NewForConfig creates a new GardenV1beta1Client for the given config.
 func NewForConfig(c *rest.Config) (*GardenV1beta1Client, error) {
 	config := *c
@@ -14,12 +14,18 @@ type Interface interface {
 	CloudProfiles() CloudProfileInformer
 	// Quotas returns a QuotaInformer.
 	Quotas() QuotaInformer
+	// RegionPolicies returns a RegionPolicyInformer.
+	RegionPolicies() RegionPolicyInformer
 	// SecretBindings returns a SecretBindingInformer.
 	SecretBindings() SecretBindingInformer
 	// Seeds returns a SeedInformer.
 	Seeds() SeedInformer
+	// SeedAccessRequests returns a SeedAccessRequestInformer.
+	SeedAccessRequests() SeedAccessRequestInformer
 	// Shoots returns a ShootInformer.
 	Shoots() ShootInformer
+	// ShootOperations returns a ShootOperationInformer.
+	ShootOperations() ShootOperationInformer
 }
 
 type version struct {
@@ -48,6 +54,11 @@ func (v *version) Quotas() QuotaInformer {
 	return &quotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RegionPolicies returns a RegionPolicyInformer.
+func (v *version) RegionPolicies() RegionPolicyInformer {
+	return &regionPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // SecretBindings returns a SecretBindingInformer.
 func (v *version) SecretBindings() SecretBindingInformer {
 	return &secretBindingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -58,7 +69,17 @@ func (v *version) Seeds() SeedInformer {
 	return &seedInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// SeedAccessRequests returns a SeedAccessRequestInformer.
+func (v *version) SeedAccessRequests() SeedAccessRequestInformer {
+	return &seedAccessRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Shoots returns a ShootInformer.
 func (v *version) Shoots() ShootInformer {
 	return &shootInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ShootOperations returns a ShootOperationInformer.
+func (v *version) ShootOperations() ShootOperationInformer {
+	return &shootOperationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -43,12 +43,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().CloudProfiles().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("quotas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().Quotas().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("regionpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().RegionPolicies().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("secretbindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().SecretBindings().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("seeds"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().Seeds().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("seedaccessrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().SeedAccessRequests().Informer()}, nil
 	case v1beta1.SchemeGroupVersion.WithResource("shoots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().Shoots().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("shootoperations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().V1beta1().ShootOperations().Informer()}, nil
 
 	}
 
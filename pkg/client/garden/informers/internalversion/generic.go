@@ -43,12 +43,18 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().CloudProfiles().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("quotas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().Quotas().Informer()}, nil
+	case garden.SchemeGroupVersion.WithResource("regionpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().RegionPolicies().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("secretbindings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().SecretBindings().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("seeds"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().Seeds().Informer()}, nil
+	case garden.SchemeGroupVersion.WithResource("seedaccessrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().SeedAccessRequests().Informer()}, nil
 	case garden.SchemeGroupVersion.WithResource("shoots"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().Shoots().Informer()}, nil
+	case garden.SchemeGroupVersion.WithResource("shootoperations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Garden().InternalVersion().ShootOperations().Informer()}, nil
 
 	}
 
@@ -22,6 +22,10 @@ type QuotaListerExpansion interface{}
 // QuotaNamespaceLister.
 type QuotaNamespaceListerExpansion interface{}
 
+// RegionPolicyListerExpansion allows custom methods to be added to
+// RegionPolicyLister.
+type RegionPolicyListerExpansion interface{}
+
 // SecretBindingListerExpansion allows custom methods to be added to
 // SecretBindingLister.
 type SecretBindingListerExpansion interface{}
@@ -34,6 +38,14 @@ type SecretBindingNamespaceListerExpansion interface{}
 // SeedLister.
 type SeedListerExpansion interface{}
 
+// SeedAccessRequestListerExpansion allows custom methods to be added to
+// SeedAccessRequestLister.
+type SeedAccessRequestListerExpansion interface{}
+
+// SeedAccessRequestNamespaceListerExpansion allows custom methods to be added to
+// SeedAccessRequestNamespaceLister.
+type SeedAccessRequestNamespaceListerExpansion interface{}
+
 // ShootListerExpansion allows custom methods to be added to
 // ShootLister.
 type ShootListerExpansion interface{}
@@ -41,3 +53,11 @@ type ShootListerExpansion interface{}
 // ShootNamespaceListerExpansion allows custom methods to be added to
 // ShootNamespaceLister.
 type ShootNamespaceListerExpansion interface{}
+
+// ShootOperationListerExpansion allows custom methods to be added to
+// ShootOperationLister.
+type ShootOperationListerExpansion interface{}
+
+// ShootOperationNamespaceListerExpansion allows custom methods to be added to
+// ShootOperationNamespaceLister.
+type ShootOperationNamespaceListerExpansion interface{}
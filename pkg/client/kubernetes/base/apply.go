@@ -135,6 +135,11 @@ func (c *Client) Apply(m []byte) error {
 				// We do not want to overwrite a ServiceAccount's `.secrets[]` list or `.imagePullSecrets[]`.
 				newObj.Object["secrets"] = oldObj.Object["secrets"]
 				newObj.Object["imagePullSecrets"] = oldObj.Object["imagePullSecrets"]
+			case "MachineDeployment":
+				// We do not want to remove labels or annotations which have been added to a MachineDeployment by
+				// external tooling (e.g. cost tracking or autoscalers). Values from the new manifest take precedence.
+				newObj.SetLabels(mergeStringMaps(oldObj.GetLabels(), newObj.GetLabels()))
+				newObj.SetAnnotations(mergeStringMaps(oldObj.GetAnnotations(), newObj.GetAnnotations()))
 			}
 
 			manifest, e = json.Marshal(newObj.UnstructuredContent())
@@ -153,6 +158,23 @@ func (c *Client) Apply(m []byte) error {
 	return nil
 }
 
+// mergeStringMaps returns a new map containing the entries of both <old> and <new>. If a key exists in both maps,
+// the value of <new> is used.
+func mergeStringMaps(old, new map[string]string) map[string]string {
+	if len(old) == 0 && len(new) == 0 {
+		return nil
+	}
+
+	out := make(map[string]string, len(old)+len(new))
+	for key, value := range old {
+		out[key] = value
+	}
+	for key, value := range new {
+		out[key] = value
+	}
+	return out
+}
+
 // buildPath creates the Kubernetes API REST URL for the given API group and kind (depending on whether the
 // kind is namespaced or not).
 func (c *Client) buildPath(apiVersion, kind, namespace string) (string, error) {
@@ -15,6 +15,7 @@
 package kubernetesbase
 
 import (
+	machineclientset "github.com/gardener/gardener/pkg/client/machine/clientset/versioned"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -22,12 +23,18 @@ import (
 
 // New returns a new Kubernetes base client.
 func New(config *rest.Config, clientset *kubernetes.Clientset, clientConfig clientcmd.ClientConfig) (*Client, error) {
+	machineClientset, err := machineclientset.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
 	baseClient := &Client{
-		config:          config,
-		clientConfig:    clientConfig,
-		clientset:       clientset,
-		gardenClientset: nil,
-		restClient:      clientset.Discovery().RESTClient(),
+		config:           config,
+		clientConfig:     clientConfig,
+		clientset:        clientset,
+		gardenClientset:  nil,
+		machineClientset: machineClientset,
+		restClient:       clientset.Discovery().RESTClient(),
 		resourceAPIGroups: map[string][]string{
 			CronJobs:                  {"apis", "batch", "v1beta1"},
 			CustomResourceDefinitions: {"apis", "apiextensions.k8s.io", "v1beta1"},
@@ -24,6 +24,11 @@ func (c *Client) GetJob(namespace, name string) (*batch_v1.Job, error) {
 	return c.clientset.BatchV1().Jobs(namespace).Get(name, metav1.GetOptions{})
 }
 
+// ListJobs returns the list of Jobs in the given <namespace>.
+func (c *Client) ListJobs(namespace string, listOptions metav1.ListOptions) (*batch_v1.JobList, error) {
+	return c.clientset.BatchV1().Jobs(namespace).List(listOptions)
+}
+
 // DeleteJob deletes a Job object.
 func (c *Client) DeleteJob(namespace, name string) error {
 	return c.clientset.BatchV1().Jobs(namespace).Delete(name, &defaultDeleteOptions)
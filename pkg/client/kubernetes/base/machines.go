@@ -14,9 +14,19 @@
 
 package kubernetesbase
 
-import "k8s.io/client-go/rest"
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+)
 
 // MachineV1alpha1 creates a RESTClient request object for the given <verb> and the given <machineClassPlural>.
 func (c *Client) MachineV1alpha1(verb, resource, namespace string) *rest.Request {
 	return c.RESTClient().Verb(verb).Prefix("apis", "machine.sapcloud.io", "v1alpha1").Namespace(namespace).Resource(resource)
 }
+
+// MachineV1alpha1Patch creates a RESTClient request object which patches the object with the given <name> of the
+// given <resource> with the given <patchType> and <body>. Only the fields in the patch are sent to the API server, so
+// the request does not conflict with concurrent changes of other fields.
+func (c *Client) MachineV1alpha1Patch(resource, namespace, name string, patchType types.PatchType, body []byte) *rest.Request {
+	return c.RESTClient().Patch(patchType).Prefix("apis", "machine.sapcloud.io", "v1alpha1").Namespace(namespace).Resource(resource).Name(name).Body(body)
+}
@@ -0,0 +1,35 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetesbase
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListPersistentVolumeClaims returns the list of PersistentVolumeClaims in the given <namespace>.
+func (c *Client) ListPersistentVolumeClaims(namespace string, listOptions metav1.ListOptions) (*corev1.PersistentVolumeClaimList, error) {
+	return c.clientset.CoreV1().PersistentVolumeClaims(namespace).List(listOptions)
+}
+
+// UpdatePersistentVolumeClaim updates an already existing PersistentVolumeClaim object.
+func (c *Client) UpdatePersistentVolumeClaim(pvc *corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error) {
+	return c.clientset.CoreV1().PersistentVolumeClaims(pvc.Namespace).Update(pvc)
+}
+
+// DeletePersistentVolumeClaim deletes a PersistentVolumeClaim object.
+func (c *Client) DeletePersistentVolumeClaim(namespace, name string) error {
+	return c.clientset.CoreV1().PersistentVolumeClaims(namespace).Delete(name, &defaultDeleteOptions)
+}
@@ -14,6 +14,16 @@
 
 package kubernetesbase
 
+import (
+	appsv1beta2 "k8s.io/api/apps/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ListStatefulSets returns the list of StatefulSets in the given <namespace>.
+func (c *Client) ListStatefulSets(namespace string, listOptions metav1.ListOptions) (*appsv1beta2.StatefulSetList, error) {
+	return c.Clientset().AppsV1beta2().StatefulSets(namespace).List(listOptions)
+}
+
 // DeleteStatefulSet deletes a StatefulSet object.
 func (c *Client) DeleteStatefulSet(namespace, name string) error {
 	return c.Clientset().AppsV1beta2().StatefulSets(namespace).Delete(name, &defaultDeleteOptions)
@@ -16,6 +16,7 @@ package kubernetesbase
 
 import (
 	gardenclientset "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
+	machineclientset "github.com/gardener/gardener/pkg/client/machine/clientset/versioned"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -34,7 +35,8 @@ var (
 // Client is a struct containing the configuration for the respective Kubernetes
 // cluster, the collection of Kubernetes clients <Clientset> containing all REST clients
 // for the built-in Kubernetes API groups, and the GardenClientset which is a REST clientset
-// for the Garden API group.
+// for the Garden API group, and the MachineClientset which is a REST clientset for the API group
+// of the machine-controller-manager.
 // The RESTClient itself is a normal HTTP client for the respective Kubernetes cluster,
 // allowing requests to arbitrary URLs.
 // The version string contains only the major/minor part in the form <major>.<minor>.
@@ -44,6 +46,7 @@ type Client struct {
 	clientConfig      clientcmd.ClientConfig
 	clientset         *kubernetes.Clientset
 	gardenClientset   *gardenclientset.Clientset
+	machineClientset  machineclientset.Interface
 	restClient        rest.Interface
 	resourceAPIGroups map[string][]string
 	version           string
@@ -17,6 +17,7 @@ package kubernetes
 import (
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/gardener/gardener/pkg/client/kubernetes/base"
 	"github.com/gardener/gardener/pkg/client/kubernetes/v110"
@@ -47,6 +48,10 @@ func NewClientFromFile(kubeconfigPath string) (Client, error) {
 
 // NewClientFromBytes creates a new Client struct for a given kubeconfig byte slice.
 func NewClientFromBytes(kubeconfig []byte) (Client, error) {
+	return newClientFromBytes(kubeconfig, nil)
+}
+
+func newClientFromBytes(kubeconfig []byte, wrapTransport func(http.RoundTripper) http.RoundTripper) (Client, error) {
 	configObj, err := clientcmd.Load(kubeconfig)
 	if err != nil {
 		return nil, err
@@ -56,6 +61,15 @@ func NewClientFromBytes(kubeconfig []byte) (Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	if wrapTransport != nil {
+		if existing := config.WrapTransport; existing != nil {
+			config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+				return wrapTransport(existing(rt))
+			}
+		} else {
+			config.WrapTransport = wrapTransport
+		}
+	}
 	return newClientSet(config, clientConfig)
 }
 
@@ -80,6 +94,16 @@ func NewClientFromSecretObject(secret *corev1.Secret) (Client, error) {
 	return nil, errors.New("The secret does not contain a field with name 'kubeconfig'")
 }
 
+// NewSeedClientFromSecretObject creates a new Client struct for the Seed cluster with the given <seedName> based on
+// the kubeconfig in the given Kubernetes Secret object. The latency and the errors of all requests of the client are
+// recorded as Prometheus metrics.
+func NewSeedClientFromSecretObject(seedName string, secret *corev1.Secret) (Client, error) {
+	if kubeconfig, ok := secret.Data["kubeconfig"]; ok {
+		return newClientFromBytes(kubeconfig, instrumentTransport(seedName))
+	}
+	return nil, errors.New("The secret does not contain a field with name 'kubeconfig'")
+}
+
 func newClientSet(config *rest.Config, clientConfig clientcmd.ClientConfig) (Client, error) {
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
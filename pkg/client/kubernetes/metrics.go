@@ -0,0 +1,136 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	seedClientRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_seed_client_request_duration_seconds",
+		Help:    "Latency of the requests of the Gardener to the Seed clusters",
+		Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+	}, []string{"seed", "verb", "resource"})
+
+	seedClientRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_seed_client_request_errors_total",
+		Help: "Count of the failed requests of the Gardener to the Seed clusters",
+	}, []string{"seed", "verb", "resource", "code"})
+)
+
+func init() {
+	prometheus.MustRegister(seedClientRequestDuration)
+	prometheus.MustRegister(seedClientRequestErrors)
+}
+
+// instrumentTransport returns a function which wraps a round tripper such that the latency and the errors of all
+// requests to the Seed cluster with the given <seedName> are recorded.
+func instrumentTransport(seedName string) func(http.RoundTripper) http.RoundTripper {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &instrumentedRoundTripper{
+			seedName: seedName,
+			delegate: rt,
+		}
+	}
+}
+
+type instrumentedRoundTripper struct {
+	seedName string
+	delegate http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var (
+		start          = time.Now()
+		resp, err      = r.delegate.RoundTrip(req)
+		verb, resource = requestVerbAndResource(req)
+	)
+
+	seedClientRequestDuration.WithLabelValues(r.seedName, verb, resource).Observe(time.Since(start).Seconds())
+
+	switch {
+	case err != nil:
+		seedClientRequestErrors.WithLabelValues(r.seedName, verb, resource, "<error>").Inc()
+	// NotFound and Conflict responses are expected results of many requests, hence, they are not counted as errors.
+	case resp.StatusCode >= http.StatusBadRequest && resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusConflict:
+		seedClientRequestErrors.WithLabelValues(r.seedName, verb, resource, strconv.Itoa(resp.StatusCode)).Inc()
+	}
+
+	return resp, err
+}
+
+// requestVerbAndResource determines the Kubernetes verb and the resource (incl. its sub resource) of the given
+// <req> from its HTTP method and its URL path, e.g. 'GET /api/v1/namespaces/foo/pods' results in ('list', 'pods').
+// Requests which do not target a resource (e.g. discovery) are reported with the resource 'other'.
+func requestVerbAndResource(req *http.Request) (string, string) {
+	var (
+		parts   = strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+		verb    = strings.ToLower(req.Method)
+		hasName bool
+	)
+
+	switch {
+	case len(parts) > 2 && parts[0] == "api":
+		parts = parts[2:]
+	case len(parts) > 3 && parts[0] == "apis":
+		parts = parts[3:]
+	default:
+		return verb, "other"
+	}
+	if len(parts) > 2 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+
+	resource := parts[0]
+	if len(parts) > 1 {
+		hasName = true
+	}
+	if len(parts) > 2 {
+		resource += "/" + parts[2]
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		switch {
+		case req.URL.Query().Get("watch") == "true":
+			verb = "watch"
+		case hasName:
+			verb = "get"
+		default:
+			verb = "list"
+		}
+	case http.MethodPost:
+		verb = "create"
+	case http.MethodPut:
+		verb = "update"
+	case http.MethodPatch:
+		verb = "patch"
+	case http.MethodDelete:
+		if hasName {
+			verb = "delete"
+		} else {
+			verb = "deletecollection"
+		}
+	}
+
+	return verb, resource
+}
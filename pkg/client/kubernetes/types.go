@@ -19,11 +19,14 @@ import (
 
 	clientset "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
 	"github.com/gardener/gardener/pkg/client/kubernetes/mapping"
+	machineclientset "github.com/gardener/gardener/pkg/client/machine/clientset/versioned"
+	appsv1beta2 "k8s.io/api/apps/v1beta2"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 )
@@ -37,6 +40,7 @@ type Client interface {
 	// Getter & Setter
 	Clientset() *kubernetes.Clientset
 	GardenClientset() *clientset.Clientset
+	MachineClientset() machineclientset.Interface
 	GetAPIResourceList() []*metav1.APIResourceList
 	GetConfig() *rest.Config
 	GetResourceAPIGroups() map[string][]string
@@ -44,9 +48,12 @@ type Client interface {
 	SetConfig(*rest.Config)
 	SetClientset(*kubernetes.Clientset)
 	SetGardenClientset(*clientset.Clientset)
+	SetMachineClientset(machineclientset.Interface)
 	SetRESTClient(rest.Interface)
 	SetResourceAPIGroups(map[string][]string)
 	MachineV1alpha1(string, string, string) *rest.Request
+	MachineV1alpha1Patch(string, string, string, types.PatchType, []byte) *rest.Request
+	ExtensionsV1alpha1(string, string) *rest.Request
 
 	// Cleanup
 	ListResources(...string) (unstructured.Unstructured, error)
@@ -87,10 +94,12 @@ type Client interface {
 	DeleteDeployment(string, string) error
 
 	// StatefulSets
+	ListStatefulSets(string, metav1.ListOptions) (*appsv1beta2.StatefulSetList, error)
 	DeleteStatefulSet(string, string) error
 
 	// Jobs
 	GetJob(string, string) (*batchv1.Job, error)
+	ListJobs(string, metav1.ListOptions) (*batchv1.JobList, error)
 	DeleteJob(string, string) error
 
 	// ReplicaSets
@@ -105,6 +114,11 @@ type Client interface {
 	CheckForwardPodPort(string, string, int, int) (bool, error)
 	DeletePod(string, string) error
 
+	// PersistentVolumeClaims
+	ListPersistentVolumeClaims(string, metav1.ListOptions) (*corev1.PersistentVolumeClaimList, error)
+	UpdatePersistentVolumeClaim(*corev1.PersistentVolumeClaim) (*corev1.PersistentVolumeClaim, error)
+	DeletePersistentVolumeClaim(string, string) error
+
 	// Nodes
 	ListNodes(metav1.ListOptions) (*corev1.NodeList, error)
 
@@ -226,6 +226,12 @@ func (c *defaultControl) reconcileBackupInfrastructure(o *operation.Operation) *
 		return formatError("Failed to create a Seed CloudBotanist", err)
 	}
 
+	releaseEtcdOperationLock, err := acquireEtcdOperationLock(o, botanist)
+	if err != nil {
+		return formatError("Failed to acquire the etcd operation lock of the Shoot", err)
+	}
+	defer releaseEtcdOperationLock()
+
 	var (
 		defaultRetry = 30 * time.Second
 
@@ -266,6 +272,12 @@ func (c *defaultControl) deleteBackupInfrastructure(o *operation.Operation) *gar
 		return formatError("Failed to create a Seed CloudBotanist", err)
 	}
 
+	releaseEtcdOperationLock, err := acquireEtcdOperationLock(o, botanist)
+	if err != nil {
+		return formatError("Failed to acquire the etcd operation lock of the Shoot", err)
+	}
+	defer releaseEtcdOperationLock()
+
 	// We check whether the Backup namespace in the Seed cluster is already in a terminating state, i.e. whether
 	// we have tried to delete it in a previous run. In that case, we do not need to cleanup backup infrastructure resource because
 	// that would have already been done.
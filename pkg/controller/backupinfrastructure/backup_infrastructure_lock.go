@@ -0,0 +1,62 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backupinfrastructure
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/etcdlock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// acquireEtcdOperationLock acquires the etcd operation lock of the Shoot of the BackupInfrastructure, so that its
+// backups are not changed while the etcd of the Shoot is restored or the Shoot is hibernated. It returns a function
+// which releases the lock. Nothing is locked if the Shoot or its namespace in the Seed do not exist (anymore).
+func acquireEtcdOperationLock(o *operation.Operation, botanist *botanistpkg.Botanist) (func(), error) {
+	release := func() {}
+
+	shoots, err := o.K8sGardenInformers.Shoots().Lister().Shoots(o.BackupInfrastructure.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var seedNamespace string
+	for _, shoot := range shoots {
+		if shoot.Status.UID == o.BackupInfrastructure.Spec.ShootUID {
+			seedNamespace = shoot.Status.TechnicalID
+			break
+		}
+	}
+	if len(seedNamespace) == 0 {
+		return release, nil
+	}
+
+	lock := etcdlock.New(botanist.K8sSeedClient.Clientset().CoreV1().ConfigMaps(seedNamespace), fmt.Sprintf("%s/backupinfrastructure", o.GardenerInfo.Name), etcdlock.DefaultLeaseDuration)
+	_, releaseLock, err := lock.Hold(etcdlock.ReasonBackup, o.Logger)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return release, nil
+		}
+		return nil, err
+	}
+
+	return func() {
+		if err := releaseLock(); err != nil {
+			o.Logger.Errorf("Could not release the etcd operation lock: %v", err)
+		}
+	}, nil
+}
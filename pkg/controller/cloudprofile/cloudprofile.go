@@ -36,9 +36,10 @@ type Controller struct {
 
 	control ControlInterface
 
-	cloudProfileLister gardenlisters.CloudProfileLister
-	cloudProfileQueue  workqueue.RateLimitingInterface
-	cloudprofileSynced cache.InformerSynced
+	cloudProfileLister      gardenlisters.CloudProfileLister
+	cloudProfileQueue       workqueue.RateLimitingInterface
+	cloudProfileShootsQueue workqueue.RateLimitingInterface
+	cloudprofileSynced      cache.InformerSynced
 
 	seedLister  gardenlisters.SeedLister
 	shootLister gardenlisters.ShootLister
@@ -58,14 +59,15 @@ func NewCloudProfileController(k8sGardenClient kubernetes.Client, k8sGardenInfor
 	)
 
 	cloudProfileController := &Controller{
-		k8sGardenClient:    k8sGardenClient,
-		k8sGardenInformers: k8sGardenInformers,
-		cloudProfileLister: cloudProfileInformer.Lister(),
-		cloudProfileQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cloudprofile"),
-		seedLister:         seedLister,
-		shootLister:        shootLister,
-		control:            NewDefaultControl(k8sGardenClient, seedLister, shootLister),
-		workerCh:           make(chan int),
+		k8sGardenClient:         k8sGardenClient,
+		k8sGardenInformers:      k8sGardenInformers,
+		cloudProfileLister:      cloudProfileInformer.Lister(),
+		cloudProfileQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cloudprofile"),
+		cloudProfileShootsQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "cloudprofile-shoots"),
+		seedLister:              seedLister,
+		shootLister:             shootLister,
+		control:                 NewDefaultControl(k8sGardenClient, seedLister, shootLister),
+		workerCh:                make(chan int),
 	}
 
 	cloudProfileInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -83,7 +85,7 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	var waitGroup sync.WaitGroup
 
 	// Check if informers cache has been populated
-	if !cache.WaitForCacheSync(stopCh, c.cloudprofileSynced) {
+	if !cache.WaitForCacheSync(stopCh, c.cloudprofileSynced, c.k8sGardenInformers.Garden().V1beta1().Shoots().Informer().HasSynced) {
 		logger.Logger.Error("Time out waiting for caches to sync")
 		return
 	}
@@ -104,16 +106,21 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	for i := 0; i < workers; i++ {
 		controllerutils.CreateWorker(c.cloudProfileQueue, "cloudprofile", c.reconcileCloudProfileKey, stopCh, &waitGroup, c.workerCh)
 	}
+	for i := 0; i < workers/2+1; i++ {
+		controllerutils.CreateWorker(c.cloudProfileShootsQueue, "cloudprofile-shoots", c.reconcileCloudProfileShootsKey, stopCh, &waitGroup, c.workerCh)
+	}
 
 	<-stopCh
 	c.cloudProfileQueue.ShutDown()
+	c.cloudProfileShootsQueue.ShutDown()
 
 	for {
-		if c.cloudProfileQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		queueLengths := c.cloudProfileQueue.Len() + c.cloudProfileShootsQueue.Len()
+		if queueLengths == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running CloudProfile worker and no items left in the queues. Terminated CloudProfile controller...")
 			break
 		}
-		logger.Logger.Debugf("Waiting for %d CloudProfile worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.cloudProfileQueue.Len())
+		logger.Logger.Debugf("Waiting for %d CloudProfile worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, queueLengths)
 		time.Sleep(5 * time.Second)
 	}
 
@@ -0,0 +1,223 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudprofile
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+)
+
+// CheckShootCompliance checks whether the given <shoot> still complies with the constraints of the given <cloudProfile>.
+// It returns a list of human readable violations which is empty if the Shoot is compliant.
+func CheckShootCompliance(cloudProfile *gardenv1beta1.CloudProfile, shoot *gardenv1beta1.Shoot) ([]string, error) {
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		spec       = cloudProfile.Spec
+		region     = shoot.Spec.Cloud.Region
+		violations []string
+	)
+
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		if spec.AWS == nil {
+			return nil, fmt.Errorf("CloudProfile %s does not contain constraints for %s", cloudProfile.Name, cloudProvider)
+		}
+		constraints := spec.AWS.Constraints
+		violations = append(violations, checkKubernetesVersion(constraints.Kubernetes, shoot.Spec.Kubernetes.Version)...)
+		violations = append(violations, checkZones(constraints.Zones, region, shoot.Spec.Cloud.AWS.Zones)...)
+		for _, worker := range shoot.Spec.Cloud.AWS.Workers {
+			violations = append(violations, checkMachineType(constraints.MachineTypes, worker.Name, worker.MachineType)...)
+			violations = append(violations, checkVolumeType(constraints.VolumeTypes, worker.Name, worker.VolumeType)...)
+		}
+		if image := shoot.Spec.Cloud.AWS.MachineImage; image != nil {
+			violations = append(violations, checkMachineImage(cloudProfile, image.Name, region)...)
+			for _, worker := range shoot.Spec.Cloud.AWS.Workers {
+				violations = append(violations, checkWorkerMachineImage(cloudProfile, worker.Worker, image.Name, region)...)
+			}
+		}
+
+	case gardenv1beta1.CloudProviderAzure:
+		if spec.Azure == nil {
+			return nil, fmt.Errorf("CloudProfile %s does not contain constraints for %s", cloudProfile.Name, cloudProvider)
+		}
+		constraints := spec.Azure.Constraints
+		violations = append(violations, checkKubernetesVersion(constraints.Kubernetes, shoot.Spec.Kubernetes.Version)...)
+		for _, worker := range shoot.Spec.Cloud.Azure.Workers {
+			violations = append(violations, checkMachineType(constraints.MachineTypes, worker.Name, worker.MachineType)...)
+			violations = append(violations, checkVolumeType(constraints.VolumeTypes, worker.Name, worker.VolumeType)...)
+		}
+		if image := shoot.Spec.Cloud.Azure.MachineImage; image != nil {
+			violations = append(violations, checkMachineImage(cloudProfile, image.Name, region)...)
+			for _, worker := range shoot.Spec.Cloud.Azure.Workers {
+				violations = append(violations, checkWorkerMachineImage(cloudProfile, worker.Worker, image.Name, region)...)
+			}
+		}
+
+	case gardenv1beta1.CloudProviderGCP:
+		if spec.GCP == nil {
+			return nil, fmt.Errorf("CloudProfile %s does not contain constraints for %s", cloudProfile.Name, cloudProvider)
+		}
+		constraints := spec.GCP.Constraints
+		violations = append(violations, checkKubernetesVersion(constraints.Kubernetes, shoot.Spec.Kubernetes.Version)...)
+		violations = append(violations, checkZones(constraints.Zones, region, shoot.Spec.Cloud.GCP.Zones)...)
+		for _, worker := range shoot.Spec.Cloud.GCP.Workers {
+			violations = append(violations, checkMachineType(constraints.MachineTypes, worker.Name, worker.MachineType)...)
+			violations = append(violations, checkVolumeType(constraints.VolumeTypes, worker.Name, worker.VolumeType)...)
+		}
+		if image := shoot.Spec.Cloud.GCP.MachineImage; image != nil {
+			violations = append(violations, checkMachineImage(cloudProfile, image.Name, region)...)
+			for _, worker := range shoot.Spec.Cloud.GCP.Workers {
+				violations = append(violations, checkWorkerMachineImage(cloudProfile, worker.Worker, image.Name, region)...)
+			}
+		}
+
+	case gardenv1beta1.CloudProviderOpenStack:
+		if spec.OpenStack == nil {
+			return nil, fmt.Errorf("CloudProfile %s does not contain constraints for %s", cloudProfile.Name, cloudProvider)
+		}
+		var (
+			constraints  = spec.OpenStack.Constraints
+			machineTypes = make([]gardenv1beta1.MachineType, 0, len(constraints.MachineTypes))
+		)
+		for _, machineType := range constraints.MachineTypes {
+			machineTypes = append(machineTypes, machineType.MachineType)
+		}
+		violations = append(violations, checkKubernetesVersion(constraints.Kubernetes, shoot.Spec.Kubernetes.Version)...)
+		violations = append(violations, checkZones(constraints.Zones, region, shoot.Spec.Cloud.OpenStack.Zones)...)
+		for _, worker := range shoot.Spec.Cloud.OpenStack.Workers {
+			violations = append(violations, checkMachineType(machineTypes, worker.Name, worker.MachineType)...)
+		}
+		if image := shoot.Spec.Cloud.OpenStack.MachineImage; image != nil {
+			violations = append(violations, checkMachineImage(cloudProfile, image.Name, region)...)
+			for _, worker := range shoot.Spec.Cloud.OpenStack.Workers {
+				violations = append(violations, checkWorkerMachineImage(cloudProfile, worker.Worker, image.Name, region)...)
+			}
+		}
+
+	case gardenv1beta1.CloudProviderPacket:
+		if spec.Packet == nil {
+			return nil, fmt.Errorf("CloudProfile %s does not contain constraints for %s", cloudProfile.Name, cloudProvider)
+		}
+		constraints := spec.Packet.Constraints
+		violations = append(violations, checkKubernetesVersion(constraints.Kubernetes, shoot.Spec.Kubernetes.Version)...)
+		violations = append(violations, checkZones(constraints.Zones, region, shoot.Spec.Cloud.Packet.Zones)...)
+		for _, worker := range shoot.Spec.Cloud.Packet.Workers {
+			violations = append(violations, checkMachineType(constraints.MachineTypes, worker.Name, worker.MachineType)...)
+		}
+		if image := shoot.Spec.Cloud.Packet.MachineImage; image != nil {
+			violations = append(violations, checkMachineImage(cloudProfile, image.Name, region)...)
+			for _, worker := range shoot.Spec.Cloud.Packet.Workers {
+				violations = append(violations, checkWorkerMachineImage(cloudProfile, worker.Worker, image.Name, region)...)
+			}
+		}
+
+	}
+
+	return violations, nil
+}
+
+func checkKubernetesVersion(constraints gardenv1beta1.KubernetesConstraints, version string) []string {
+	for _, v := range constraints.Versions {
+		if v == version {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("Kubernetes version %q is not offered anymore", version)}
+}
+
+func checkZones(constraints []gardenv1beta1.Zone, region string, zones []string) []string {
+	var violations []string
+	for _, zone := range zones {
+		found := false
+		for _, constraint := range constraints {
+			if constraint.Region != region {
+				continue
+			}
+			for _, name := range constraint.Names {
+				if name == zone {
+					found = true
+				}
+			}
+		}
+		if !found {
+			violations = append(violations, fmt.Sprintf("zone %q in region %q is not offered anymore", zone, region))
+		}
+	}
+	return violations
+}
+
+func checkMachineType(constraints []gardenv1beta1.MachineType, workerName, machineType string) []string {
+	for _, constraint := range constraints {
+		if constraint.Name == machineType {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("machine type %q of worker %q is not offered anymore", machineType, workerName)}
+}
+
+func checkVolumeType(constraints []gardenv1beta1.VolumeType, workerName, volumeType string) []string {
+	for _, constraint := range constraints {
+		if constraint.Name == volumeType {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("volume type %q of worker %q is not offered anymore", volumeType, workerName)}
+}
+
+func checkMachineImage(cloudProfile *gardenv1beta1.CloudProfile, name gardenv1beta1.MachineImageName, region string) []string {
+	found, _, err := helper.DetermineMachineImage(*cloudProfile, name, region)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	if !found {
+		return []string{fmt.Sprintf("machine image %q is not offered anymore in region %q", name, region)}
+	}
+	return nil
+}
+
+// checkWorkerMachineImage checks whether the machine image for the architecture of the given <worker> is still offered.
+// Workers of the default architecture use the machine image of the Shoot which is checked by checkMachineImage. For
+// workers with enabled warm-up, it checks whether the pre-warmed variant of the machine image is still offered.
+func checkWorkerMachineImage(cloudProfile *gardenv1beta1.CloudProfile, worker gardenv1beta1.Worker, name gardenv1beta1.MachineImageName, region string) []string {
+	architecture := helper.GetMachineArchitecture(worker.Architecture)
+	if worker.WarmUp != nil && *worker.WarmUp {
+		found, _, err := helper.DetermineWarmMachineImage(*cloudProfile, name, region, architecture)
+		if err != nil {
+			return []string{err.Error()}
+		}
+		if !found {
+			return []string{fmt.Sprintf("pre-warmed machine image %q for architecture %q of worker %q is not offered anymore in region %q", name, architecture, worker.Name, region)}
+		}
+		return nil
+	}
+	if architecture == gardenv1beta1.MachineArchitectureAMD64 {
+		return nil
+	}
+
+	found, _, err := helper.DetermineMachineImageForArchitecture(*cloudProfile, name, region, architecture)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	if !found {
+		return []string{fmt.Sprintf("machine image %q for architecture %q of worker %q is not offered anymore in region %q", name, architecture, worker.Name, region)}
+	}
+	return nil
+}
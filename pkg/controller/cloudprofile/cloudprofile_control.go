@@ -17,13 +17,18 @@ package cloudprofile
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -41,6 +46,20 @@ func (c *Controller) cloudProfileAdd(obj interface{}) {
 
 func (c *Controller) cloudProfileUpdate(oldObj, newObj interface{}) {
 	c.cloudProfileAdd(newObj)
+
+	oldCloudProfile, ok1 := oldObj.(*gardenv1beta1.CloudProfile)
+	newCloudProfile, ok2 := newObj.(*gardenv1beta1.CloudProfile)
+	if !ok1 || !ok2 || apiequality.Semantic.DeepEqual(oldCloudProfile.Spec, newCloudProfile.Spec) {
+		return
+	}
+
+	// The specification of the CloudProfile has changed, hence, the Shoots referencing it might be affected.
+	key, err := cache.MetaNamespaceKeyFunc(newObj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", newObj, err)
+		return
+	}
+	c.cloudProfileShootsQueue.Add(key)
 }
 
 func (c *Controller) cloudProfileDelete(obj interface{}) {
@@ -75,6 +94,25 @@ func (c *Controller) reconcileCloudProfileKey(key string) error {
 	return nil
 }
 
+func (c *Controller) reconcileCloudProfileShootsKey(key string) error {
+	_, cloudProfileName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	cloudProfile, err := c.cloudProfileLister.Get(cloudProfileName)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[CLOUDPROFILE SHOOTS RECONCILE] %s - skipping because CloudProfile has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[CLOUDPROFILE SHOOTS RECONCILE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	return c.control.ReconcileAffectedShoots(cloudProfile, key)
+}
+
 // ControlInterface implements the control logic for reconciling CloudProfiles. It is implemented as an interface to allow
 // for extensions that provide different semantics. Currently, there is only one implementation.
 type ControlInterface interface {
@@ -83,6 +121,11 @@ type ControlInterface interface {
 	// Implementors should sink any errors that they do not wish to trigger a retry, and they may feel free to
 	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
 	ReconcileCloudProfile(cloudprofile *gardenv1beta1.CloudProfile, key string) error
+	// ReconcileAffectedShoots implements the control logic for Shoots referencing a CloudProfile whose specification
+	// has changed. It validates whether the Shoots still comply with the CloudProfile, maintains a respective condition
+	// on them, and schedules a reconciliation for the compliant ones.
+	// If an implementation returns a non-nil error, the invocation will be retried using a rate-limited strategy.
+	ReconcileAffectedShoots(cloudprofile *gardenv1beta1.CloudProfile, key string) error
 }
 
 // NewDefaultControl returns a new instance of the default implementation ControlInterface that
@@ -153,6 +196,81 @@ func (c *defaultControl) ReconcileCloudProfile(obj *gardenv1beta1.CloudProfile,
 	return nil
 }
 
+func (c *defaultControl) ReconcileAffectedShoots(cloudProfile *gardenv1beta1.CloudProfile, key string) error {
+	if cloudProfile.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var (
+		cloudProfileLogger = logger.NewFieldLogger(logger.Logger, "cloudprofile", cloudProfile.Name)
+		errorMessages      []string
+	)
+
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		cloudProfileLogger.Error(err.Error())
+		return err
+	}
+
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.Profile != cloudProfile.Name || shoot.DeletionTimestamp != nil {
+			continue
+		}
+
+		shootLogger := logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
+		if err := c.reconcileAffectedShoot(cloudProfile, shoot.DeepCopy()); err != nil {
+			shootLogger.Errorf("Could not reconcile Shoot after the CloudProfile has changed: %+v", err)
+			errorMessages = append(errorMessages, fmt.Sprintf("%s/%s: %v", shoot.Namespace, shoot.Name, err))
+		}
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("could not reconcile all affected Shoots: %s", strings.Join(errorMessages, ", "))
+	}
+	return nil
+}
+
+// reconcileAffectedShoot checks whether the given <shoot> still complies with the <cloudProfile> and updates the
+// CloudProfileCompliant condition accordingly. Compliant Shoots are annotated to get reconciled again, non-compliant
+// Shoots are only flagged as their reconciliation would fail anyway.
+func (c *defaultControl) reconcileAffectedShoot(cloudProfile *gardenv1beta1.CloudProfile, shoot *gardenv1beta1.Shoot) error {
+	violations, err := CheckShootCompliance(cloudProfile, shoot)
+	if err != nil {
+		return err
+	}
+
+	condition := helper.InitCondition(gardenv1beta1.ShootCloudProfileCompliant, "", "")
+	if existing := helper.GetCondition(shoot.Status.Conditions, gardenv1beta1.ShootCloudProfileCompliant); existing != nil {
+		condition = existing
+	}
+	if len(violations) == 0 {
+		condition = helper.ModifyCondition(condition, corev1.ConditionTrue, "CloudProfileCompliant", fmt.Sprintf("The Shoot complies with the CloudProfile %s.", cloudProfile.Name))
+	} else {
+		condition = helper.ModifyCondition(condition, corev1.ConditionFalse, "CloudProfileNotCompliant", fmt.Sprintf("The Shoot does not comply with the CloudProfile %s anymore: %s.", cloudProfile.Name, strings.Join(violations, ", ")))
+	}
+
+	conditions := helper.MergeConditions(shoot.Status.Conditions, *condition)
+	if helper.ConditionsNeedUpdate(shoot.Status.Conditions, conditions) {
+		shoot.Status.Conditions = conditions
+		updatedShoot, err := c.k8sGardenClient.GardenClientset().GardenV1beta1().Shoots(shoot.Namespace).UpdateStatus(shoot)
+		if err != nil {
+			return err
+		}
+		shoot = updatedShoot
+	}
+
+	if len(violations) > 0 {
+		return nil
+	}
+
+	if shoot.Annotations == nil {
+		shoot.Annotations = map[string]string{}
+	}
+	shoot.Annotations[common.ShootOperation] = common.ShootOperationReconcile
+	_, err = c.k8sGardenClient.GardenClientset().GardenV1beta1().Shoots(shoot.Namespace).Update(shoot)
+	return err
+}
+
 func (c *defaultControl) determineSeedAssociations(cloudProfileName string) ([]string, error) {
 	var associatedSeeds []string
 	seeds, err := c.seedLister.List(labels.Everything())
@@ -15,6 +15,7 @@
 package controller
 
 import (
+	"net/http"
 	"os"
 
 	"github.com/gardener/gardener/pkg/apis/componentconfig"
@@ -23,13 +24,18 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	backupinfrastructurecontroller "github.com/gardener/gardener/pkg/controller/backupinfrastructure"
 	cloudprofilecontroller "github.com/gardener/gardener/pkg/controller/cloudprofile"
+	garbagecollectioncontroller "github.com/gardener/gardener/pkg/controller/garbagecollection"
 	quotacontroller "github.com/gardener/gardener/pkg/controller/quota"
 	secretbindingcontroller "github.com/gardener/gardener/pkg/controller/secretbinding"
 	seedcontroller "github.com/gardener/gardener/pkg/controller/seed"
+	seedaccessrequestcontroller "github.com/gardener/gardener/pkg/controller/seedaccessrequest"
 	shootcontroller "github.com/gardener/gardener/pkg/controller/shoot"
+	shootoperationcontroller "github.com/gardener/gardener/pkg/controller/shootoperation"
+	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/garden"
+	"github.com/gardener/gardener/pkg/server/handlers"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/gardener/gardener/pkg/version"
 	"k8s.io/apimachinery/pkg/labels"
@@ -47,10 +53,14 @@ type GardenControllerFactory struct {
 	k8sGardenInformers gardeninformers.SharedInformerFactory
 	k8sInformers       kubeinformers.SharedInformerFactory
 	recorder           record.EventRecorder
+	shardFilter        *controllerutils.ShardFilter
+	shootHandlers      *http.ServeMux
 }
 
-// NewGardenControllerFactory creates a new factory for controllers for the Garden API group.
-func NewGardenControllerFactory(k8sGardenClient kubernetes.Client, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *componentconfig.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, recorder record.EventRecorder) *GardenControllerFactory {
+// NewGardenControllerFactory creates a new factory for controllers for the Garden API group. The <shardFilter>
+// determines the Shoots and Seeds this instance is responsible for. The authenticated endpoints of the Shoots are
+// registered at <shootHandlers>.
+func NewGardenControllerFactory(k8sGardenClient kubernetes.Client, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, config *componentconfig.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, recorder record.EventRecorder, shardFilter *controllerutils.ShardFilter, shootHandlers *http.ServeMux) *GardenControllerFactory {
 	return &GardenControllerFactory{
 		config:             config,
 		identity:           identity,
@@ -59,6 +69,8 @@ func NewGardenControllerFactory(k8sGardenClient kubernetes.Client, gardenInforme
 		k8sGardenInformers: gardenInformerFactory,
 		k8sInformers:       kubeInformerFactory,
 		recorder:           recorder,
+		shardFilter:        shardFilter,
+		shootHandlers:      shootHandlers,
 	}
 }
 
@@ -71,11 +83,13 @@ func (f *GardenControllerFactory) Run(stopCh <-chan struct{}) {
 		seedInformer                 = f.k8sGardenInformers.Garden().V1beta1().Seeds().Informer()
 		shootInformer                = f.k8sGardenInformers.Garden().V1beta1().Shoots().Informer()
 		backupInfrastructureInformer = f.k8sGardenInformers.Garden().V1beta1().BackupInfrastructures().Informer()
+		seedAccessRequestInformer    = f.k8sGardenInformers.Garden().V1beta1().SeedAccessRequests().Informer()
+		shootOperationInformer       = f.k8sGardenInformers.Garden().V1beta1().ShootOperations().Informer()
 		secretInformer               = f.k8sInformers.Core().V1().Secrets().Informer()
 	)
 
 	f.k8sGardenInformers.Start(stopCh)
-	if !cache.WaitForCacheSync(make(<-chan struct{}), cloudProfileInformer.HasSynced, secretBindingInformer.HasSynced, quotaInformer.HasSynced, seedInformer.HasSynced, shootInformer.HasSynced, backupInfrastructureInformer.HasSynced) {
+	if !cache.WaitForCacheSync(make(<-chan struct{}), cloudProfileInformer.HasSynced, secretBindingInformer.HasSynced, quotaInformer.HasSynced, seedInformer.HasSynced, shootInformer.HasSynced, backupInfrastructureInformer.HasSynced, seedAccessRequestInformer.HasSynced, shootOperationInformer.HasSynced) {
 		panic("Timed out waiting for Garden caches to sync")
 	}
 
@@ -107,27 +121,53 @@ func (f *GardenControllerFactory) Run(stopCh <-chan struct{}) {
 	}
 	logger.Logger.Info("Successfully bootstrapped the Garden cluster.")
 	var (
-		shootController                = shootcontroller.NewShootController(f.k8sGardenClient, f.k8sGardenInformers, f.config, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
-		seedController                 = seedcontroller.NewSeedController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, secrets, imageVector, f.recorder)
+		shootController                = shootcontroller.NewShootController(f.k8sGardenClient, f.k8sGardenInformers, f.config, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder, f.shardFilter)
+		seedController                 = seedcontroller.NewSeedController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, secrets, imageVector, f.config, f.recorder, f.shardFilter)
 		quotaController                = quotacontroller.NewQuotaController(f.k8sGardenClient, f.k8sGardenInformers, f.recorder)
 		cloudProfileController         = cloudprofilecontroller.NewCloudProfileController(f.k8sGardenClient, f.k8sGardenInformers)
 		secretBindingController        = secretbindingcontroller.NewSecretBindingController(f.k8sGardenClient, f.k8sGardenInformers, f.k8sInformers, f.recorder)
 		backupInfrastructureController = backupinfrastructurecontroller.NewBackupInfrastructureController(f.k8sGardenClient, f.k8sGardenInformers, f.config, f.identity, f.gardenNamespace, secrets, imageVector, f.recorder)
+		seedAccessRequestController    = seedaccessrequestcontroller.NewSeedAccessRequestController(f.k8sGardenClient, f.k8sGardenInformers, f.recorder)
+		shootOperationController       = shootoperationcontroller.NewShootOperationController(f.k8sGardenClient, f.k8sGardenInformers, f.recorder)
+		garbageCollectionController    = garbagecollectioncontroller.NewGarbageCollectionController(f.k8sGardenClient, f.k8sGardenInformers, f.config)
 	)
 
-	go shootController.Run(f.config.Controllers.Shoot.ConcurrentSyncs, f.config.Controllers.ShootCare.ConcurrentSyncs, f.config.Controllers.ShootMaintenance.ConcurrentSyncs, f.config.Controllers.ShootQuota.ConcurrentSyncs, stopCh)
+	var (
+		tokenReviews         = f.k8sGardenClient.Clientset().AuthenticationV1().TokenReviews()
+		subjectAccessReviews = f.k8sGardenClient.Clientset().AuthorizationV1().SubjectAccessReviews()
+	)
+	f.shootHandlers.Handle(shootcontroller.ReconcilePlanPath, handlers.ShootSubresource(tokenReviews, subjectAccessReviews, "reconcile-plan", http.HandlerFunc(shootController.ReconcilePlanHandler)))
+	f.shootHandlers.Handle(shootcontroller.MachinePlanPath, handlers.ShootSubresource(tokenReviews, subjectAccessReviews, "machine-plan", http.HandlerFunc(shootController.MachinePlanHandler)))
+	f.shootHandlers.Handle(shootcontroller.SecretVersionsPath, handlers.ShootSubresource(tokenReviews, subjectAccessReviews, "secret-versions", http.HandlerFunc(shootController.SecretVersionsHandler)))
+
+	shootRecoveryDrillWorkers := 0
+	if f.config.Controllers.ShootRecoveryDrill != nil {
+		shootRecoveryDrillWorkers = f.config.Controllers.ShootRecoveryDrill.ConcurrentSyncs
+	}
+	go shootController.Run(f.config.Controllers.Shoot.ConcurrentSyncs, f.config.Controllers.ShootCare.ConcurrentSyncs, f.config.Controllers.ShootMaintenance.ConcurrentSyncs, f.config.Controllers.ShootQuota.ConcurrentSyncs, shootRecoveryDrillWorkers, stopCh)
 	go seedController.Run(f.config.Controllers.Seed.ConcurrentSyncs, stopCh)
-	go quotaController.Run(f.config.Controllers.Quota.ConcurrentSyncs, stopCh)
-	go cloudProfileController.Run(f.config.Controllers.CloudProfile.ConcurrentSyncs, stopCh)
-	go secretBindingController.Run(f.config.Controllers.SecretBinding.ConcurrentSyncs, stopCh)
-	go backupInfrastructureController.Run(f.config.Controllers.BackupInfrastructure.ConcurrentSyncs, stopCh)
 
-	logger.Logger.Infof("Gardener controller manager (version %s) initialized.", version.Version)
+	// The controllers for the resources which are not sharded only run in the primary shard.
+	if f.shardFilter.Primary() {
+		go quotaController.Run(f.config.Controllers.Quota.ConcurrentSyncs, stopCh)
+		go cloudProfileController.Run(f.config.Controllers.CloudProfile.ConcurrentSyncs, stopCh)
+		go secretBindingController.Run(f.config.Controllers.SecretBinding.ConcurrentSyncs, stopCh)
+		go backupInfrastructureController.Run(f.config.Controllers.BackupInfrastructure.ConcurrentSyncs, stopCh)
+		go seedAccessRequestController.Run(f.config.Controllers.SeedAccessRequest.ConcurrentSyncs, stopCh)
+		go shootOperationController.Run(f.config.Controllers.ShootOperation.ConcurrentSyncs, stopCh)
+		go garbageCollectionController.Run(stopCh)
+	}
+
+	if f.shardFilter.Sharded() {
+		logger.Logger.Infof("Gardener controller manager (version %s) initialized for %s.", version.Version, f.shardFilter)
+	} else {
+		logger.Logger.Infof("Gardener controller manager (version %s) initialized.", version.Version)
+	}
 
 	// Shutdown handling
 	<-stopCh
 	logger.Logger.Info("I have received a stop signal and will no longer watch events of the Garden API group.")
-	logger.Logger.Infof("Number of remaining workers -- Shoot: %d, Seed: %d, Quota: %d, CloudProfile: %d, SecretBinding: %d, BackupInfrastructure: %d", shootController.RunningWorkers(), seedController.RunningWorkers(), quotaController.RunningWorkers(), cloudProfileController.RunningWorkers(), secretBindingController.RunningWorkers(), backupInfrastructureController.RunningWorkers())
+	logger.Logger.Infof("Number of remaining workers -- Shoot: %d, Seed: %d, Quota: %d, CloudProfile: %d, SecretBinding: %d, BackupInfrastructure: %d, SeedAccessRequest: %d, ShootOperation: %d", shootController.RunningWorkers(), seedController.RunningWorkers(), quotaController.RunningWorkers(), cloudProfileController.RunningWorkers(), secretBindingController.RunningWorkers(), backupInfrastructureController.RunningWorkers(), seedAccessRequestController.RunningWorkers(), shootOperationController.RunningWorkers())
 	logger.Logger.Info("Bye bye!")
 
 	os.Exit(0)
@@ -0,0 +1,89 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garbagecollection
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	shootcontroller "github.com/gardener/gardener/pkg/controller/shoot"
+	"github.com/gardener/gardener/pkg/logger"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Controller performs the garbage collection of the Garden cluster. It deletes old events of the Gardener resources,
+// completed ShootOperations and expired SeedAccessRequests, and it compacts the event feeds of the Shoots, so that the
+// etcd of the Garden cluster stays small also for landscapes with thousands of Shoots.
+type Controller struct {
+	k8sGardenClient kubernetes.Client
+	config          componentconfig.GarbageCollectionControllerConfiguration
+	eventFeed       *shootcontroller.EventFeed
+
+	shootLister             gardenlisters.ShootLister
+	shootOperationLister    gardenlisters.ShootOperationLister
+	seedAccessRequestLister gardenlisters.SeedAccessRequestLister
+
+	shootSynced             cache.InformerSynced
+	shootOperationSynced    cache.InformerSynced
+	seedAccessRequestSynced cache.InformerSynced
+}
+
+// NewGarbageCollectionController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a
+// <gardenInformerFactory>, and the controller manager <config>. It creates a new Gardener controller.
+func NewGarbageCollectionController(k8sGardenClient kubernetes.Client, gardenInformerFactory gardeninformers.SharedInformerFactory, config *componentconfig.ControllerManagerConfiguration) *Controller {
+	var (
+		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
+
+		shootInformer             = gardenv1beta1Informer.Shoots()
+		shootOperationInformer    = gardenv1beta1Informer.ShootOperations()
+		seedAccessRequestInformer = gardenv1beta1Informer.SeedAccessRequests()
+
+		eventFeedRetention time.Duration
+	)
+
+	if config.Controllers.Shoot.EventFeedRetention != nil {
+		eventFeedRetention = config.Controllers.Shoot.EventFeedRetention.Duration
+	}
+
+	return &Controller{
+		k8sGardenClient:         k8sGardenClient,
+		config:                  *config.Controllers.GarbageCollection,
+		eventFeed:               shootcontroller.NewEventFeed(k8sGardenClient, eventFeedRetention),
+		shootLister:             shootInformer.Lister(),
+		shootOperationLister:    shootOperationInformer.Lister(),
+		seedAccessRequestLister: seedAccessRequestInformer.Lister(),
+		shootSynced:             shootInformer.Informer().HasSynced,
+		shootOperationSynced:    shootOperationInformer.Informer().HasSynced,
+		seedAccessRequestSynced: seedAccessRequestInformer.Informer().HasSynced,
+	}
+}
+
+// Run performs the garbage collection every sync period until the given stop channel can be read from.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	if !cache.WaitForCacheSync(stopCh, c.shootSynced, c.shootOperationSynced, c.seedAccessRequestSynced) {
+		logger.Logger.Error("Timed out waiting for caches to sync")
+		return
+	}
+
+	logger.Logger.Info("GarbageCollection controller initialized.")
+
+	wait.Until(c.collectGarbage, c.config.SyncPeriod.Duration, stopCh)
+
+	logger.Logger.Debug("Terminated GarbageCollection controller...")
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garbagecollection
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// eventListPageSize is the maximum number of events which are listed at once, so that the Garden cluster does not
+// have to return all of its events in a single response.
+const eventListPageSize = 500
+
+// collectGarbage deletes the garbage in the Garden cluster which has exceeded its retention. Errors are logged, the
+// remaining garbage is collected in the next run.
+func (c *Controller) collectGarbage() {
+	now := time.Now()
+
+	if retention := c.config.EventRetention; retention != nil && retention.Duration > 0 {
+		if deleted, err := c.deleteEvents(retention.Duration, now); err != nil {
+			logger.Logger.Errorf("[GARBAGE COLLECTION] Could not delete the events older than %s: %s (deleted %d)", retention.Duration, err.Error(), deleted)
+		} else if deleted > 0 {
+			logger.Logger.Infof("[GARBAGE COLLECTION] Deleted %d events older than %s", deleted, retention.Duration)
+		}
+	}
+
+	if retention := c.config.ShootOperationRetention; retention != nil && retention.Duration > 0 {
+		if deleted, err := c.deleteShootOperations(retention.Duration, now); err != nil {
+			logger.Logger.Errorf("[GARBAGE COLLECTION] Could not delete the ShootOperations completed more than %s ago: %s (deleted %d)", retention.Duration, err.Error(), deleted)
+		} else if deleted > 0 {
+			logger.Logger.Infof("[GARBAGE COLLECTION] Deleted %d ShootOperations completed more than %s ago", deleted, retention.Duration)
+		}
+	}
+
+	if retention := c.config.SeedAccessRequestRetention; retention != nil && retention.Duration > 0 {
+		if deleted, err := c.deleteSeedAccessRequests(retention.Duration, now); err != nil {
+			logger.Logger.Errorf("[GARBAGE COLLECTION] Could not delete the SeedAccessRequests expired more than %s ago: %s (deleted %d)", retention.Duration, err.Error(), deleted)
+		} else if deleted > 0 {
+			logger.Logger.Infof("[GARBAGE COLLECTION] Deleted %d SeedAccessRequests expired more than %s ago", deleted, retention.Duration)
+		}
+	}
+
+	if err := c.compactEventFeeds(); err != nil {
+		logger.Logger.Errorf("[GARBAGE COLLECTION] Could not compact the event feeds of the Shoots: %s", err.Error())
+	}
+}
+
+// deleteEvents deletes the events of the Gardener resources which have last occurred more than <retention> before
+// <now>. It returns the number of deleted events.
+func (c *Controller) deleteEvents(retention time.Duration, now time.Time) (int, error) {
+	var (
+		events  = c.k8sGardenClient.Clientset().CoreV1().Events(metav1.NamespaceAll)
+		options = metav1.ListOptions{Limit: eventListPageSize}
+		deleted int
+	)
+
+	for {
+		eventList, err := events.List(options)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, event := range eventList.Items {
+			if !isGardenerEvent(event) || !eventExpired(event, retention, now) {
+				continue
+			}
+			if err := c.k8sGardenClient.Clientset().CoreV1().Events(event.Namespace).Delete(event.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return deleted, err
+			}
+			deleted++
+		}
+
+		if len(eventList.Continue) == 0 {
+			return deleted, nil
+		}
+		options.Continue = eventList.Continue
+	}
+}
+
+// deleteShootOperations deletes the ShootOperations which have been completed more than <retention> before <now>.
+// It returns the number of deleted ShootOperations.
+func (c *Controller) deleteShootOperations(retention time.Duration, now time.Time) (int, error) {
+	shootOperations, err := c.shootOperationLister.List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, shootOperation := range shootOperations {
+		if !shootOperationExpired(shootOperation, retention, now) {
+			continue
+		}
+		if err := c.k8sGardenClient.GardenClientset().GardenV1beta1().ShootOperations(shootOperation.Namespace).Delete(shootOperation.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// deleteSeedAccessRequests deletes the SeedAccessRequests which have expired or failed more than <retention> before
+// <now>. It returns the number of deleted SeedAccessRequests.
+func (c *Controller) deleteSeedAccessRequests(retention time.Duration, now time.Time) (int, error) {
+	seedAccessRequests, err := c.seedAccessRequestLister.List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, seedAccessRequest := range seedAccessRequests {
+		if !seedAccessRequestExpired(seedAccessRequest, retention, now) {
+			continue
+		}
+		if err := c.k8sGardenClient.GardenClientset().GardenV1beta1().SeedAccessRequests(seedAccessRequest.Namespace).Delete(seedAccessRequest.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// compactEventFeeds drops the entries of the event feeds of all Shoots which are older than the event feed retention.
+func (c *Controller) compactEventFeeds() error {
+	if c.eventFeed == nil {
+		return nil
+	}
+
+	shoots, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	for _, shoot := range shoots {
+		if err := c.eventFeed.Compact(shoot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isGardenerEvent returns true if the involved object of the <event> is a resource of the Garden API group.
+func isGardenerEvent(event corev1.Event) bool {
+	groupVersion, err := schema.ParseGroupVersion(event.InvolvedObject.APIVersion)
+	return err == nil && groupVersion.Group == gardenv1beta1.SchemeGroupVersion.Group
+}
+
+// eventExpired returns true if the <event> has last occurred more than <retention> before <now>.
+func eventExpired(event corev1.Event, retention time.Duration, now time.Time) bool {
+	timestamp := event.LastTimestamp
+	if timestamp.IsZero() {
+		timestamp = event.FirstTimestamp
+	}
+	if timestamp.IsZero() {
+		timestamp = event.CreationTimestamp
+	}
+	return timestamp.Time.Add(retention).Before(now)
+}
+
+// shootOperationExpired returns true if the <shootOperation> has succeeded or failed more than <retention> before
+// <now>.
+func shootOperationExpired(shootOperation *gardenv1beta1.ShootOperation, retention time.Duration, now time.Time) bool {
+	switch shootOperation.Status.Phase {
+	case gardenv1beta1.ShootOperationPhaseSucceeded, gardenv1beta1.ShootOperationPhaseFailed:
+	default:
+		return false
+	}
+
+	completionTimestamp := shootOperation.CreationTimestamp
+	if shootOperation.Status.CompletionTimestamp != nil {
+		completionTimestamp = *shootOperation.Status.CompletionTimestamp
+	}
+	return completionTimestamp.Time.Add(retention).Before(now)
+}
+
+// seedAccessRequestExpired returns true if the <seedAccessRequest> has expired or failed more than <retention> before
+// <now>. Failed SeedAccessRequests have never been granted, i.e. their creation is considered as their expiration.
+func seedAccessRequestExpired(seedAccessRequest *gardenv1beta1.SeedAccessRequest, retention time.Duration, now time.Time) bool {
+	switch seedAccessRequest.Status.Phase {
+	case gardenv1beta1.SeedAccessRequestPhaseExpired, gardenv1beta1.SeedAccessRequestPhaseFailed:
+	default:
+		return false
+	}
+
+	expirationTimestamp := seedAccessRequest.CreationTimestamp
+	if seedAccessRequest.Status.ExpirationTimestamp != nil {
+		expirationTimestamp = *seedAccessRequest.Status.ExpirationTimestamp
+	}
+	return expirationTimestamp.Time.Add(retention).Before(now)
+}
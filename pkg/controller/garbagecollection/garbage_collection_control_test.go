@@ -0,0 +1,125 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package garbagecollection_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/garbagecollection"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("garbage collection", func() {
+	var (
+		now       = time.Date(2018, time.June, 1, 12, 0, 0, 0, time.UTC)
+		retention = 24 * time.Hour
+
+		ago = func(age time.Duration) metav1.Time {
+			return metav1.NewTime(now.Add(-age))
+		}
+		agoPtr = func(age time.Duration) *metav1.Time {
+			timestamp := ago(age)
+			return &timestamp
+		}
+	)
+
+	Describe("#isGardenerEvent", func() {
+		It("should return true for the events of the Garden API group", func() {
+			event := corev1.Event{InvolvedObject: corev1.ObjectReference{APIVersion: "garden.sapcloud.io/v1beta1", Kind: "Shoot"}}
+
+			Expect(ExportIsGardenerEvent(event)).To(BeTrue())
+		})
+
+		It("should return false for the events of other resources", func() {
+			Expect(ExportIsGardenerEvent(corev1.Event{InvolvedObject: corev1.ObjectReference{APIVersion: "v1", Kind: "Pod"}})).To(BeFalse())
+			Expect(ExportIsGardenerEvent(corev1.Event{})).To(BeFalse())
+		})
+	})
+
+	Describe("#eventExpired", func() {
+		It("should consider the last occurrence of the event", func() {
+			event := corev1.Event{FirstTimestamp: ago(48 * time.Hour), LastTimestamp: ago(time.Hour)}
+
+			Expect(ExportEventExpired(event, retention, now)).To(BeFalse())
+		})
+
+		It("should fall back to the first occurrence and to the creation of the event", func() {
+			Expect(ExportEventExpired(corev1.Event{FirstTimestamp: ago(25 * time.Hour)}, retention, now)).To(BeTrue())
+			Expect(ExportEventExpired(corev1.Event{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: ago(25 * time.Hour)}}, retention, now)).To(BeTrue())
+		})
+	})
+
+	Describe("#shootOperationExpired", func() {
+		It("should not expire running ShootOperations", func() {
+			shootOperation := &gardenv1beta1.ShootOperation{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: ago(48 * time.Hour)},
+				Status:     gardenv1beta1.ShootOperationStatus{Phase: gardenv1beta1.ShootOperationPhaseRunning},
+			}
+
+			Expect(ExportShootOperationExpired(shootOperation, retention, now)).To(BeFalse())
+		})
+
+		It("should expire ShootOperations completed before the retention", func() {
+			shootOperation := &gardenv1beta1.ShootOperation{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: ago(48 * time.Hour)},
+				Status:     gardenv1beta1.ShootOperationStatus{Phase: gardenv1beta1.ShootOperationPhaseFailed, CompletionTimestamp: agoPtr(25 * time.Hour)},
+			}
+
+			Expect(ExportShootOperationExpired(shootOperation, retention, now)).To(BeTrue())
+		})
+
+		It("should keep ShootOperations completed within the retention", func() {
+			shootOperation := &gardenv1beta1.ShootOperation{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: ago(48 * time.Hour)},
+				Status:     gardenv1beta1.ShootOperationStatus{Phase: gardenv1beta1.ShootOperationPhaseSucceeded, CompletionTimestamp: agoPtr(time.Hour)},
+			}
+
+			Expect(ExportShootOperationExpired(shootOperation, retention, now)).To(BeFalse())
+		})
+	})
+
+	Describe("#seedAccessRequestExpired", func() {
+		It("should not expire granted SeedAccessRequests", func() {
+			seedAccessRequest := &gardenv1beta1.SeedAccessRequest{
+				Status: gardenv1beta1.SeedAccessRequestStatus{Phase: gardenv1beta1.SeedAccessRequestPhaseGranted, ExpirationTimestamp: agoPtr(48 * time.Hour)},
+			}
+
+			Expect(ExportSeedAccessRequestExpired(seedAccessRequest, retention, now)).To(BeFalse())
+		})
+
+		It("should expire SeedAccessRequests which have expired before the retention", func() {
+			seedAccessRequest := &gardenv1beta1.SeedAccessRequest{
+				Status: gardenv1beta1.SeedAccessRequestStatus{Phase: gardenv1beta1.SeedAccessRequestPhaseExpired, ExpirationTimestamp: agoPtr(25 * time.Hour)},
+			}
+
+			Expect(ExportSeedAccessRequestExpired(seedAccessRequest, retention, now)).To(BeTrue())
+		})
+
+		It("should consider the creation of failed SeedAccessRequests", func() {
+			seedAccessRequest := &gardenv1beta1.SeedAccessRequest{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: ago(time.Hour)},
+				Status:     gardenv1beta1.SeedAccessRequestStatus{Phase: gardenv1beta1.SeedAccessRequestPhaseFailed},
+			}
+
+			Expect(ExportSeedAccessRequestExpired(seedAccessRequest, retention, now)).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,55 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+var ExportUpdatedSeedComponents = updatedSeedComponents
+
+// ExportReconcileSeedKey returns the function which reconciles the key of a Seed of a controller with the given
+// <config>, <control>, <seeds> and <queue>.
+func ExportReconcileSeedKey(config *componentconfig.ControllerManagerConfiguration, control ControlInterface, seeds []*gardenv1beta1.Seed, queue workqueue.RateLimitingInterface) (func(string) error, error) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, seed := range seeds {
+		if err := indexer.Add(seed); err != nil {
+			return nil, err
+		}
+	}
+	shardFilter, err := controllerutils.NewShardFilter(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Controller{
+		config:      config,
+		control:     control,
+		seedLister:  gardenlisters.NewSeedLister(indexer),
+		seedQueue:   queue,
+		shardFilter: shardFilter,
+	}
+	return c.reconcileSeedKey, nil
+}
+
+// ExportUpdateSeedStatus updates the status of the <seed> with the given <updater> like the default control does.
+func ExportUpdateSeedStatus(updater UpdaterInterface, seed *gardenv1beta1.Seed, components []gardenv1beta1.SeedComponentStatus, conditions ...gardenv1beta1.Condition) error {
+	return (&defaultControl{updater: updater}).updateSeedStatus(seed, components, conditions...)
+}
@@ -18,11 +18,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
+	seedpkg "github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	corev1 "k8s.io/api/core/v1"
 	kubeinformers "k8s.io/client-go/informers"
@@ -38,23 +41,28 @@ type Controller struct {
 
 	k8sInformers kubeinformers.SharedInformerFactory
 
-	control  ControlInterface
-	recorder record.EventRecorder
+	config            *componentconfig.ControllerManagerConfiguration
+	control           ControlInterface
+	kubeconfigControl KubeconfigControlInterface
+	recorder          record.EventRecorder
 
-	seedLister gardenlisters.SeedLister
-	seedQueue  workqueue.RateLimitingInterface
-	seedSynced cache.InformerSynced
+	seedLister          gardenlisters.SeedLister
+	seedQueue           workqueue.RateLimitingInterface
+	seedKubeconfigQueue workqueue.RateLimitingInterface
+	seedSynced          cache.InformerSynced
 
 	shootLister gardenlisters.ShootLister
 
+	shardFilter *controllerutils.ShardFilter
+
 	workerCh               chan int
 	numberOfRunningWorkers int
 }
 
 // NewSeedController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
-// holding information about the acting Gardener, a <seedInformer>, and a <recorder> for
-// event recording. It creates a new Gardener controller.
-func NewSeedController(k8sGardenClient kubernetes.Client, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
+// holding information about the acting Gardener, a <seedInformer>, the controller manager <config>, and a
+// <recorder> for event recording. It creates a new Gardener controller.
+func NewSeedController(k8sGardenClient kubernetes.Client, gardenInformerFactory gardeninformers.SharedInformerFactory, kubeInformerFactory kubeinformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, config *componentconfig.ControllerManagerConfiguration, recorder record.EventRecorder, shardFilter *controllerutils.ShardFilter) *Controller {
 	var (
 		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
 		corev1Informer        = kubeInformerFactory.Core().V1()
@@ -68,20 +76,34 @@ func NewSeedController(k8sGardenClient kubernetes.Client, gardenInformerFactory
 	)
 
 	seedController := &Controller{
-		k8sGardenClient:    k8sGardenClient,
-		k8sGardenInformers: gardenInformerFactory,
-		control:            NewDefaultControl(k8sGardenClient, gardenInformerFactory, secrets, imageVector, recorder, seedUpdater, secretLister, shootLister, backupInfrastructureLister),
-		recorder:           recorder,
-		seedLister:         seedLister,
-		seedQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
-		shootLister:        shootLister,
-		workerCh:           make(chan int),
+		k8sGardenClient:     k8sGardenClient,
+		k8sGardenInformers:  gardenInformerFactory,
+		config:              config,
+		control:             NewDefaultControl(k8sGardenClient, gardenInformerFactory, secrets, imageVector, config, recorder, seedUpdater, secretLister, shootLister, backupInfrastructureLister),
+		kubeconfigControl:   NewDefaultKubeconfigControl(k8sGardenClient, recorder, seedUpdater, secretLister, seedpkg.ValidateKubeconfig),
+		recorder:            recorder,
+		seedLister:          seedLister,
+		seedQueue:           workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed"),
+		seedKubeconfigQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "seed-kubeconfig"),
+		shootLister:         shootLister,
+		shardFilter:         shardFilter,
+		workerCh:            make(chan int),
 	}
 
-	seedInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc:    seedController.seedAdd,
-		UpdateFunc: seedController.seedUpdate,
-		DeleteFunc: seedController.seedDelete,
+	seedInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: seedController.seedShardFilter,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    seedController.seedAdd,
+			UpdateFunc: seedController.seedUpdate,
+			DeleteFunc: seedController.seedDelete,
+		},
+	})
+	seedInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: seedController.seedShardFilter,
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc:    seedController.seedKubeconfigAdd,
+			UpdateFunc: seedController.seedKubeconfigUpdate,
+		},
 	})
 	seedController.seedSynced = seedInformer.Informer().HasSynced
 
@@ -112,18 +134,21 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 
 	for i := 0; i < workers; i++ {
 		controllerutils.CreateWorker(c.seedQueue, "Seed", c.reconcileSeedKey, stopCh, &waitGroup, c.workerCh)
+		controllerutils.CreateWorker(c.seedKubeconfigQueue, "Seed Kubeconfig", c.reconcileSeedKubeconfigKey, stopCh, &waitGroup, c.workerCh)
 	}
 
 	// Shutdown handling
 	<-stopCh
 	c.seedQueue.ShutDown()
+	c.seedKubeconfigQueue.ShutDown()
 
 	for {
-		if c.seedQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		queueLengths := c.seedQueue.Len() + c.seedKubeconfigQueue.Len()
+		if queueLengths == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running Seed worker and no items left in the queues. Terminated Seed controller...")
 			break
 		}
-		logger.Logger.Debugf("Waiting for %d Seed worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.seedQueue.Len())
+		logger.Logger.Debugf("Waiting for %d Seed worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, queueLengths)
 		time.Sleep(5 * time.Second)
 	}
 
@@ -134,3 +159,10 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 func (c *Controller) RunningWorkers() int {
 	return c.numberOfRunningWorkers
 }
+
+// seedShardFilter filters Seeds based on the shard they belong to. Deleted Seeds whose final state is unknown are
+// passed on to the handlers.
+func (c *Controller) seedShardFilter(obj interface{}) bool {
+	seed, ok := obj.(*gardenv1beta1.Seed)
+	return !ok || c.shardFilter.Responsible(seed)
+}
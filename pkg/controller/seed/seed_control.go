@@ -18,8 +18,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
@@ -85,12 +87,23 @@ func (c *Controller) reconcileSeedKey(key string) error {
 		logger.Logger.Infof("[SEED RECONCILE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.shardFilter.Responsible(seed) {
+		logger.Logger.Debugf("[SEED RECONCILE] %s - skipping because Seed belongs to another shard", key)
+		return nil
+	}
 
 	err = c.control.ReconcileSeed(seed, key)
 	if err != nil {
 		c.seedQueue.AddAfter(key, 15*time.Second)
+		return err
 	}
-	return err
+
+	// Reconcile the Seed periodically to keep the seed components up-to-date, e.g. after they have been modified in
+	// the Seed cluster.
+	if syncPeriod := c.config.Controllers.Seed.SyncPeriod.Duration; seed.DeletionTimestamp == nil && syncPeriod > 0 {
+		c.seedQueue.AddAfter(key, syncPeriod)
+	}
+	return nil
 }
 
 // ControlInterface implements the control logic for updating Seeds. It is implemented as an interface to allow
@@ -107,8 +120,8 @@ type ControlInterface interface {
 // implements the documented semantics for Seeds. updater is the UpdaterInterface used
 // to update the status of Seeds. You should use an instance returned from NewDefaultControl() for any
 // scenario other than testing.
-func NewDefaultControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder, updater UpdaterInterface, secretLister kubecorev1listers.SecretLister, shootLister gardenlisters.ShootLister, backupInfrastructureLister gardenlisters.BackupInfrastructureLister) ControlInterface {
-	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, recorder, updater, secretLister, shootLister, backupInfrastructureLister}
+func NewDefaultControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, config *componentconfig.ControllerManagerConfiguration, recorder record.EventRecorder, updater UpdaterInterface, secretLister kubecorev1listers.SecretLister, shootLister gardenlisters.ShootLister, backupInfrastructureLister gardenlisters.BackupInfrastructureLister) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, config, recorder, updater, secretLister, shootLister, backupInfrastructureLister}
 }
 
 type defaultControl struct {
@@ -116,6 +129,7 @@ type defaultControl struct {
 	k8sGardenInformers         gardeninformers.SharedInformerFactory
 	secrets                    map[string]*corev1.Secret
 	imageVector                imagevector.ImageVector
+	config                     *componentconfig.ControllerManagerConfiguration
 	recorder                   record.EventRecorder
 	updater                    UpdaterInterface
 	secretLister               kubecorev1listers.SecretLister
@@ -221,37 +235,49 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 		message := fmt.Sprintf("Failed to create a Seed object (%s).", err.Error())
 		conditionSeedAvailable = helper.ModifyCondition(conditionSeedAvailable, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		seedLogger.Error(message)
-		c.updateSeedStatus(seed, *conditionSeedAvailable)
+		c.updateSeedStatus(seed, nil, *conditionSeedAvailable)
 		return err
 	}
 
-	// Bootstrap the Seed cluster.
-	if err := seedpkg.BootstrapCluster(seedObj, c.k8sGardenClient, c.secrets, c.imageVector); err != nil {
+	// Bootstrap the Seed cluster, i.e., install or upgrade the seed components.
+	components, err := seedpkg.BootstrapCluster(seedObj, c.k8sGardenClient, c.secrets, c.imageVector, c.config.Controllers.Seed.DeployIngressController)
+	if err != nil {
 		conditionSeedAvailable = helper.ModifyCondition(conditionSeedAvailable, corev1.ConditionFalse, "BootstrappingFailed", err.Error())
-		c.updateSeedStatus(seed, *conditionSeedAvailable)
+		c.updateSeedStatus(seed, nil, *conditionSeedAvailable)
 		seedLogger.Error(err.Error())
 		return err
 	}
+	if updated := updatedSeedComponents(seed.Status.Components, components); len(updated) > 0 {
+		c.recorder.Eventf(seed, corev1.EventTypeNormal, "SeedComponentsUpdated", "Installed or upgraded the seed components %s.", strings.Join(updated, ", "))
+	}
 
 	// Check whether the Kubernetes version of the Seed cluster fulfills the minimal requirements.
 	if err := seedObj.CheckMinimumK8SVersion(); err != nil {
 		conditionSeedAvailable = helper.ModifyCondition(conditionSeedAvailable, corev1.ConditionFalse, "K8SVersionTooOld", err.Error())
-		c.updateSeedStatus(seed, *conditionSeedAvailable)
+		c.updateSeedStatus(seed, nil, *conditionSeedAvailable)
 		seedLogger.Error(err.Error())
 		return err
 	}
 	conditionSeedAvailable = helper.ModifyCondition(conditionSeedAvailable, corev1.ConditionTrue, "Passed", "all checks passed")
-	c.updateSeedStatus(seed, *conditionSeedAvailable)
+	c.updateSeedStatus(seed, components, *conditionSeedAvailable)
 
 	return nil
 }
 
-func (c *defaultControl) updateSeedStatus(seed *gardenv1beta1.Seed, conditions ...gardenv1beta1.Condition) error {
-	if !helper.ConditionsNeedUpdate(seed.Status.Conditions, conditions) {
+// updateSeedStatus updates the status of the given <seed> with the given <conditions> and seed <components>. If
+// <components> is nil then the current seed components are kept.
+func (c *defaultControl) updateSeedStatus(seed *gardenv1beta1.Seed, components []gardenv1beta1.SeedComponentStatus, conditions ...gardenv1beta1.Condition) error {
+	// The KubeconfigValid condition is maintained by the kubeconfig validation, hence, it must be kept.
+	mergedConditions := helper.MergeConditions(seed.Status.Conditions, conditions...)
+	if components == nil {
+		components = seed.Status.Components
+	}
+	if !helper.ConditionsNeedUpdate(seed.Status.Conditions, mergedConditions) && apiequality.Semantic.DeepEqual(seed.Status.Components, components) {
 		return nil
 	}
 
-	seed.Status.Conditions = conditions
+	seed.Status.Conditions = mergedConditions
+	seed.Status.Components = components
 
 	_, err := c.updater.UpdateSeedStatus(seed)
 	if err != nil {
@@ -260,3 +286,20 @@ func (c *defaultControl) updateSeedStatus(seed *gardenv1beta1.Seed, conditions .
 
 	return err
 }
+
+// updatedSeedComponents returns the names of the seed <components> which have been installed or upgraded compared to
+// the <existing> seed components.
+func updatedSeedComponents(existing, components []gardenv1beta1.SeedComponentStatus) []string {
+	existingByName := make(map[string]gardenv1beta1.SeedComponentStatus, len(existing))
+	for _, component := range existing {
+		existingByName[component.Name] = component
+	}
+
+	var updated []string
+	for _, component := range components {
+		if old, ok := existingByName[component.Name]; !ok || !old.LastUpdateTime.Equal(&component.LastUpdateTime) {
+			updated = append(updated, component.Name)
+		}
+	}
+	return updated
+}
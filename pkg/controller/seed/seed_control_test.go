@@ -0,0 +1,214 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/seed"
+	"github.com/gardener/gardener/pkg/logger"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type fakeControl struct {
+	err        error
+	reconciled []string
+}
+
+func (f *fakeControl) ReconcileSeed(seed *gardenv1beta1.Seed, key string) error {
+	f.reconciled = append(f.reconciled, key)
+	return f.err
+}
+
+type fakeQueue struct {
+	workqueue.RateLimitingInterface
+	added map[string]time.Duration
+}
+
+func (f *fakeQueue) AddAfter(item interface{}, duration time.Duration) {
+	f.added[item.(string)] = duration
+}
+
+type fakeUpdater struct {
+	updated []*gardenv1beta1.Seed
+}
+
+func (f *fakeUpdater) UpdateSeedStatus(seed *gardenv1beta1.Seed) (*gardenv1beta1.Seed, error) {
+	f.updated = append(f.updated, seed.DeepCopy())
+	return seed, nil
+}
+
+var _ = Describe("seed control", func() {
+	var (
+		then = metav1.NewTime(time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC))
+		now  = metav1.NewTime(then.Add(time.Hour))
+
+		component = func(name, version, checksum string, lastUpdateTime metav1.Time) gardenv1beta1.SeedComponentStatus {
+			return gardenv1beta1.SeedComponentStatus{Name: name, Version: version, Checksum: checksum, LastUpdateTime: lastUpdateTime}
+		}
+	)
+
+	BeforeEach(func() {
+		logger.NewLogger("error")
+	})
+
+	Describe("#reconcileSeedKey", func() {
+		var (
+			config  *componentconfig.ControllerManagerConfiguration
+			control *fakeControl
+			queue   *fakeQueue
+			seed    *gardenv1beta1.Seed
+
+			reconcile = func() error {
+				reconcileSeedKey, err := ExportReconcileSeedKey(config, control, []*gardenv1beta1.Seed{seed}, queue)
+				Expect(err).NotTo(HaveOccurred())
+				return reconcileSeedKey("aws")
+			}
+		)
+
+		BeforeEach(func() {
+			config = &componentconfig.ControllerManagerConfiguration{
+				Controllers: componentconfig.ControllerManagerControllerConfiguration{
+					Seed: &componentconfig.SeedControllerConfiguration{
+						SyncPeriod: metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			control = &fakeControl{}
+			queue = &fakeQueue{added: map[string]time.Duration{}}
+			seed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "aws"}}
+		})
+
+		It("should requeue the Seed after the sync period to keep its components up-to-date", func() {
+			Expect(reconcile()).To(Succeed())
+
+			Expect(control.reconciled).To(Equal([]string{"aws"}))
+			Expect(queue.added).To(Equal(map[string]time.Duration{"aws": time.Hour}))
+		})
+
+		It("should requeue the Seed soon if the reconciliation failed", func() {
+			control.err = errors.New("fake")
+
+			Expect(reconcile()).To(HaveOccurred())
+			Expect(queue.added).To(Equal(map[string]time.Duration{"aws": 15 * time.Second}))
+		})
+
+		It("should not requeue the Seed if the periodic reconciliation is disabled", func() {
+			config.Controllers.Seed.SyncPeriod = metav1.Duration{}
+
+			Expect(reconcile()).To(Succeed())
+			Expect(queue.added).To(BeEmpty())
+		})
+
+		It("should not requeue a Seed which is being deleted", func() {
+			seed.DeletionTimestamp = &now
+
+			Expect(reconcile()).To(Succeed())
+			Expect(queue.added).To(BeEmpty())
+		})
+
+		It("should skip a Seed which does not exist anymore", func() {
+			reconcileSeedKey, err := ExportReconcileSeedKey(config, control, nil, queue)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(reconcileSeedKey("aws")).To(Succeed())
+			Expect(control.reconciled).To(BeEmpty())
+			Expect(queue.added).To(BeEmpty())
+		})
+	})
+
+	Describe("#updatedSeedComponents", func() {
+		It("should return all components on the first deployment", func() {
+			components := []gardenv1beta1.SeedComponentStatus{
+				component("core", "0.10.0", "a", now),
+				component("ingress", "0.10.0", "b", now),
+			}
+
+			Expect(ExportUpdatedSeedComponents(nil, components)).To(Equal([]string{"core", "ingress"}))
+		})
+
+		It("should return the installed and upgraded components", func() {
+			existing := []gardenv1beta1.SeedComponentStatus{
+				component("core", "0.10.0", "a", then),
+				component("vpn", "0.10.0", "b", then),
+			}
+			components := []gardenv1beta1.SeedComponentStatus{
+				component("core", "0.10.0", "a", then),
+				component("vpn", "0.11.0", "b", now),
+				component("ingress", "0.11.0", "c", now),
+			}
+
+			Expect(ExportUpdatedSeedComponents(existing, components)).To(Equal([]string{"vpn", "ingress"}))
+		})
+
+		It("should return nothing if no component changed", func() {
+			existing := []gardenv1beta1.SeedComponentStatus{component("core", "0.10.0", "a", then)}
+
+			Expect(ExportUpdatedSeedComponents(existing, existing)).To(BeEmpty())
+		})
+	})
+
+	Describe("#updateSeedStatus", func() {
+		var (
+			updater   *fakeUpdater
+			seed      *gardenv1beta1.Seed
+			available = gardenv1beta1.Condition{Type: gardenv1beta1.SeedAvailable, Status: corev1.ConditionTrue, Reason: "Passed", Message: "all checks passed"}
+		)
+
+		BeforeEach(func() {
+			updater = &fakeUpdater{}
+			seed = &gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws"},
+				Status: gardenv1beta1.SeedStatus{
+					Conditions: []gardenv1beta1.Condition{available},
+					Components: []gardenv1beta1.SeedComponentStatus{component("core", "0.10.0", "a", then)},
+				},
+			}
+		})
+
+		It("should store the versions and checksums of the deployed components", func() {
+			components := []gardenv1beta1.SeedComponentStatus{component("core", "0.11.0", "b", now)}
+
+			Expect(ExportUpdateSeedStatus(updater, seed, components, available)).To(Succeed())
+
+			Expect(updater.updated).To(HaveLen(1))
+			Expect(updater.updated[0].Status.Components).To(Equal(components))
+		})
+
+		It("should keep the components if none have been deployed", func() {
+			failed := gardenv1beta1.Condition{Type: gardenv1beta1.SeedAvailable, Status: corev1.ConditionFalse, Reason: "BootstrappingFailed", Message: "fake"}
+
+			Expect(ExportUpdateSeedStatus(updater, seed, nil, failed)).To(Succeed())
+
+			Expect(updater.updated).To(HaveLen(1))
+			Expect(updater.updated[0].Status.Components).To(Equal([]gardenv1beta1.SeedComponentStatus{component("core", "0.10.0", "a", then)}))
+		})
+
+		It("should not update the status if neither the conditions nor the components changed", func() {
+			Expect(ExportUpdateSeedStatus(updater, seed, seed.Status.Components, available)).To(Succeed())
+
+			Expect(updater.updated).To(BeEmpty())
+		})
+	})
+})
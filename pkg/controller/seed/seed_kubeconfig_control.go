@@ -0,0 +1,193 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func (c *Controller) seedKubeconfigAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.seedKubeconfigQueue.Add(key)
+}
+
+func (c *Controller) seedKubeconfigUpdate(oldObj, newObj interface{}) {
+	var (
+		oldSeed = oldObj.(*gardenv1beta1.Seed)
+		newSeed = newObj.(*gardenv1beta1.Seed)
+	)
+
+	// A requested rotation is performed right away instead of waiting for the next validation.
+	if rotateFrom := newSeed.Annotations[common.SeedRotateKubeconfigFrom]; len(rotateFrom) > 0 && rotateFrom != oldSeed.Annotations[common.SeedRotateKubeconfigFrom] {
+		c.seedKubeconfigAdd(newObj)
+	}
+}
+
+func (c *Controller) reconcileSeedKubeconfigKey(key string) error {
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	seed, err := c.seedLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SEED KUBECONFIG] %s - skipping because Seed has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SEED KUBECONFIG] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if !c.shardFilter.Responsible(seed) {
+		logger.Logger.Debugf("[SEED KUBECONFIG] %s - skipping because Seed belongs to another shard", key)
+		return nil
+	}
+
+	defer c.seedKubeconfigQueue.AddAfter(key, c.config.Controllers.Seed.KubeconfigValidationPeriod.Duration)
+
+	if seed.DeletionTimestamp != nil {
+		return nil
+	}
+	return c.kubeconfigControl.ValidateKubeconfig(seed, key)
+}
+
+// KubeconfigControlInterface implements the control logic for validating and rotating the kubeconfigs of Seeds. It is
+// implemented as an interface to allow for extensions that provide different semantics. Currently, there is only one
+// implementation.
+type KubeconfigControlInterface interface {
+	// ValidateKubeconfig validates the kubeconfig of the Seed (after rotating it, if requested) and reports the result
+	// in the KubeconfigValid condition of the Seed.
+	ValidateKubeconfig(seed *gardenv1beta1.Seed, key string) error
+}
+
+// NewDefaultKubeconfigControl returns a new instance of the default implementation KubeconfigControlInterface that
+// implements the documented semantics for validating the kubeconfigs of Seeds. validate is the function which checks
+// a kubeconfig secret. You should use an instance returned from NewDefaultKubeconfigControl() for any scenario other
+// than testing.
+func NewDefaultKubeconfigControl(k8sGardenClient kubernetes.Client, recorder record.EventRecorder, updater UpdaterInterface, secretLister kubecorev1listers.SecretLister, validate func(*corev1.Secret) error) KubeconfigControlInterface {
+	return &defaultKubeconfigControl{k8sGardenClient, recorder, updater, secretLister, validate}
+}
+
+type defaultKubeconfigControl struct {
+	k8sGardenClient kubernetes.Client
+	recorder        record.EventRecorder
+	updater         UpdaterInterface
+	secretLister    kubecorev1listers.SecretLister
+	validate        func(*corev1.Secret) error
+}
+
+func (c *defaultKubeconfigControl) ValidateKubeconfig(obj *gardenv1beta1.Seed, key string) error {
+	var (
+		seed       = obj.DeepCopy()
+		seedLogger = logger.NewFieldLogger(logger.Logger, "seed", seed.Name)
+		condition  = helper.NewConditions(seed.Status.Conditions, gardenv1beta1.SeedKubeconfigValid)[0]
+	)
+	seedLogger.Debugf("[SEED KUBECONFIG] %s", key)
+
+	secret, err := c.secretLister.Secrets(seed.Spec.SecretRef.Namespace).Get(seed.Spec.SecretRef.Name)
+	if apierrors.IsNotFound(err) {
+		condition = helper.ModifyCondition(condition, corev1.ConditionFalse, "SecretNotFound", fmt.Sprintf("The secret %s/%s of the Seed does not exist.", seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name))
+		return c.updateSeedStatus(seed, *condition)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rotateFrom := seed.Annotations[common.SeedRotateKubeconfigFrom]; len(rotateFrom) > 0 {
+		rotatedSeed, rotatedSecret, err := c.rotateKubeconfig(seed, secret, rotateFrom)
+		if err != nil {
+			message := fmt.Sprintf("Could not rotate the kubeconfig of the Seed to the one of secret %s/%s: %v", secret.Namespace, rotateFrom, err)
+			seedLogger.Error(message)
+			c.recorder.Event(seed, corev1.EventTypeWarning, "KubeconfigRotationFailed", message)
+		} else {
+			seed, secret = rotatedSeed, rotatedSecret
+			seedLogger.Infof("Rotated the kubeconfig of the Seed to the one of secret %s/%s", secret.Namespace, rotateFrom)
+			c.recorder.Eventf(seed, corev1.EventTypeNormal, "KubeconfigRotated", "Rotated the kubeconfig of the Seed to the one of secret %s/%s.", secret.Namespace, rotateFrom)
+		}
+	}
+
+	if err := c.validate(secret); err != nil {
+		seedLogger.Errorf("The kubeconfig of the Seed is invalid: %v", err)
+		condition = helper.ModifyCondition(condition, corev1.ConditionFalse, "KubeconfigInvalid", err.Error())
+	} else {
+		condition = helper.ModifyCondition(condition, corev1.ConditionTrue, "KubeconfigValid", "The kubeconfig grants access to the Seed cluster.")
+	}
+	return c.updateSeedStatus(seed, *condition)
+}
+
+// rotateKubeconfig validates the kubeconfig of the secret with the name <rotateFrom> in the namespace of the Seed's
+// <secret>, and replaces the kubeconfig of the Seed's secret with it if it is valid. Afterwards, the rotation
+// annotation is removed from the <seed>. It returns the updated Seed and secret.
+func (c *defaultKubeconfigControl) rotateKubeconfig(seed *gardenv1beta1.Seed, secret *corev1.Secret, rotateFrom string) (*gardenv1beta1.Seed, *corev1.Secret, error) {
+	newSecret, err := c.secretLister.Secrets(secret.Namespace).Get(rotateFrom)
+	if err != nil {
+		return nil, nil, err
+	}
+	kubeconfig, ok := newSecret.Data["kubeconfig"]
+	if !ok {
+		return nil, nil, fmt.Errorf("the secret does not contain a field with name 'kubeconfig'")
+	}
+
+	rotatedSecret := secret.DeepCopy()
+	if rotatedSecret.Data == nil {
+		rotatedSecret.Data = map[string][]byte{}
+	}
+	rotatedSecret.Data["kubeconfig"] = kubeconfig
+	if err := c.validate(rotatedSecret); err != nil {
+		return nil, nil, fmt.Errorf("the new kubeconfig is invalid: %v", err)
+	}
+
+	rotatedSecret, err = c.k8sGardenClient.UpdateSecretObject(rotatedSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	delete(seed.Annotations, common.SeedRotateKubeconfigFrom)
+	rotatedSeed, err := c.k8sGardenClient.GardenClientset().GardenV1beta1().Seeds().Update(seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rotatedSeed, rotatedSecret, nil
+}
+
+func (c *defaultKubeconfigControl) updateSeedStatus(seed *gardenv1beta1.Seed, conditions ...gardenv1beta1.Condition) error {
+	mergedConditions := helper.MergeConditions(seed.Status.Conditions, conditions...)
+	if !helper.ConditionsNeedUpdate(seed.Status.Conditions, mergedConditions) {
+		return nil
+	}
+
+	seed.Status.Conditions = mergedConditions
+
+	_, err := c.updater.UpdateSeedStatus(seed)
+	if err != nil {
+		logger.Logger.Errorf("Could not update the Seed status: %+v", err)
+	}
+
+	return err
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedaccessrequest
+
+import (
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// ExportSeedAccessRules are the permissions granted in the Seed namespace of a Shoot.
+var ExportSeedAccessRules = seedAccessRules
+
+// ExportNewControl returns a new default ControlInterface which creates the clients for the Seeds with the given
+// <newSeedClient>.
+func ExportNewControl(k8sGardenClient kubernetes.Client, recorder record.EventRecorder, shootLister gardenlisters.ShootLister, seedLister gardenlisters.SeedLister, newSeedClient func(string, *corev1.Secret) (kubernetes.Client, error)) ControlInterface {
+	return &defaultControl{
+		k8sGardenClient: k8sGardenClient,
+		recorder:        recorder,
+		shootLister:     shootLister,
+		seedLister:      seedLister,
+		newSeedClient:   newSeedClient,
+	}
+}
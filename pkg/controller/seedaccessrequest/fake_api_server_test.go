@@ -0,0 +1,217 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedaccessrequest_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	gardenclientset "github.com/gardener/gardener/pkg/client/garden/clientset/versioned"
+	kubernetesbase "github.com/gardener/gardener/pkg/client/kubernetes/base"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// fakeAPIServer is an in-memory API server which stores namespaced objects of any resource. It serves the creation,
+// retrieval, update (including the status subresource) and deletion of single objects. Like the token controller of
+// a real cluster, it creates a token secret for every new ServiceAccount.
+type fakeAPIServer struct {
+	server *httptest.Server
+
+	mutex   sync.Mutex
+	objects map[string]map[string]interface{}
+}
+
+// newFakeAPIServer starts a new fake API server. It must be closed once it is not needed anymore.
+func newFakeAPIServer() *fakeAPIServer {
+	s := &fakeAPIServer{objects: map[string]map[string]interface{}{}}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func (s *fakeAPIServer) close() {
+	s.server.Close()
+}
+
+// client returns a Kubernetes client for the fake API server.
+func (s *fakeAPIServer) client() *kubernetesbase.Client {
+	config := &rest.Config{Host: s.server.URL, CAData: []byte("ca"), QPS: 1000, Burst: 1000}
+	clientset := kubernetes.NewForConfigOrDie(config)
+
+	client := &kubernetesbase.Client{}
+	client.SetConfig(config)
+	client.SetClientset(clientset)
+	client.SetGardenClientset(gardenclientset.NewForConfigOrDie(config))
+	client.SetRESTClient(clientset.Discovery().RESTClient())
+	return client
+}
+
+// get decodes the object of the given <resource> with the given <namespace> and <name> into <obj>. It returns false
+// if the object does not exist.
+func (s *fakeAPIServer) get(resource, namespace, name string, obj interface{}) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	stored, ok := s.objects[fakeCollection(resource, namespace)][name]
+	if !ok {
+		return false
+	}
+	data, err := json.Marshal(stored)
+	if err != nil {
+		panic(err)
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		panic(err)
+	}
+	return true
+}
+
+// count returns the number of objects of the given <resource> in the given <namespace>.
+func (s *fakeAPIServer) count(resource, namespace string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return len(s.objects[fakeCollection(resource, namespace)])
+}
+
+// put stores the given <obj> of the given <resource>.
+func (s *fakeAPIServer) put(resource string, obj metav1.Object) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var stored map[string]interface{}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		panic(err)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.store(fakeCollection(resource, obj.GetNamespace()), obj.GetName(), stored)
+}
+
+func fakeCollection(resource, namespace string) string {
+	return namespace + "/" + resource
+}
+
+func (s *fakeAPIServer) store(collection, name string, obj map[string]interface{}) {
+	if s.objects[collection] == nil {
+		s.objects[collection] = map[string]interface{}{}
+	}
+	s.objects[collection][name] = obj
+}
+
+func (s *fakeAPIServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	// The paths have the form /api/v1/namespaces/<namespace>/<resource>[/<name>[/<subresource>]] or
+	// /apis/<group>/<version>/namespaces/<namespace>/<resource>[/<name>[/<subresource>]].
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	i := 0
+	for i < len(parts) && parts[i] != "namespaces" {
+		i++
+	}
+	if i+2 >= len(parts) {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, r.URL.Path)
+		return
+	}
+	namespace, resource, name := parts[i+1], parts[i+2], ""
+	if i+3 < len(parts) {
+		name = parts[i+3]
+	}
+	collection := fakeCollection(resource, namespace)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	switch r.Method {
+	case http.MethodPost:
+		var obj map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+			writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+			return
+		}
+		metadata := obj["metadata"].(map[string]interface{})
+		name = metadata["name"].(string)
+		if _, ok := s.objects[collection][name]; ok {
+			writeStatus(w, http.StatusConflict, metav1.StatusReasonAlreadyExists, name)
+			return
+		}
+		if resource == "serviceaccounts" {
+			tokenName := name + "-token"
+			s.store(fakeCollection("secrets", namespace), tokenName, map[string]interface{}{
+				"metadata": map[string]interface{}{"name": tokenName, "namespace": namespace},
+				"type":     string(corev1.SecretTypeServiceAccountToken),
+				"data":     map[string]interface{}{corev1.ServiceAccountTokenKey: base64.StdEncoding.EncodeToString([]byte("token-" + name))},
+			})
+			obj["secrets"] = []interface{}{map[string]interface{}{"name": tokenName}}
+		}
+		s.store(collection, name, obj)
+		writeObject(w, http.StatusCreated, obj)
+
+	case http.MethodGet:
+		obj, ok := s.objects[collection][name]
+		if !ok {
+			writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, name)
+			return
+		}
+		writeObject(w, http.StatusOK, obj)
+
+	case http.MethodPut:
+		if _, ok := s.objects[collection][name]; !ok {
+			writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, name)
+			return
+		}
+		var obj map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+			writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+			return
+		}
+		s.store(collection, name, obj)
+		writeObject(w, http.StatusOK, obj)
+
+	case http.MethodDelete:
+		if _, ok := s.objects[collection][name]; !ok {
+			writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, name)
+			return
+		}
+		delete(s.objects[collection], name)
+		writeObject(w, http.StatusOK, metav1.Status{TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}, Status: metav1.StatusSuccess})
+
+	default:
+		writeStatus(w, http.StatusMethodNotAllowed, metav1.StatusReasonMethodNotAllowed, r.Method)
+	}
+}
+
+func writeObject(w http.ResponseWriter, code int, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(obj)
+}
+
+func writeStatus(w http.ResponseWriter, code int, reason metav1.StatusReason, message string) {
+	writeObject(w, code, metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  message,
+		Reason:   reason,
+		Code:     int32(code),
+	})
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedaccessrequest
+
+import (
+	"sync"
+	"time"
+
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
+	"github.com/gardener/gardener/pkg/logger"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Controller controls SeedAccessRequests.
+type Controller struct {
+	k8sGardenClient    kubernetes.Client
+	k8sGardenInformers gardeninformers.SharedInformerFactory
+
+	control  ControlInterface
+	recorder record.EventRecorder
+
+	seedAccessRequestLister gardenlisters.SeedAccessRequestLister
+	seedAccessRequestQueue  workqueue.RateLimitingInterface
+	seedAccessRequestSynced cache.InformerSynced
+
+	workerCh               chan int
+	numberOfRunningWorkers int
+}
+
+// NewSeedAccessRequestController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a
+// <gardenInformerFactory>, and a <recorder> for event recording. It creates a new Gardener controller.
+func NewSeedAccessRequestController(k8sGardenClient kubernetes.Client, gardenInformerFactory gardeninformers.SharedInformerFactory, recorder record.EventRecorder) *Controller {
+	var (
+		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
+
+		seedAccessRequestInformer = gardenv1beta1Informer.SeedAccessRequests()
+		seedAccessRequestLister   = seedAccessRequestInformer.Lister()
+		shootLister               = gardenv1beta1Informer.Shoots().Lister()
+		seedLister                = gardenv1beta1Informer.Seeds().Lister()
+	)
+
+	seedAccessRequestController := &Controller{
+		k8sGardenClient:         k8sGardenClient,
+		k8sGardenInformers:      gardenInformerFactory,
+		control:                 NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, shootLister, seedLister),
+		recorder:                recorder,
+		seedAccessRequestLister: seedAccessRequestLister,
+		seedAccessRequestQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "SeedAccessRequest"),
+		workerCh:                make(chan int),
+	}
+
+	seedAccessRequestInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    seedAccessRequestController.seedAccessRequestAdd,
+		UpdateFunc: seedAccessRequestController.seedAccessRequestUpdate,
+		DeleteFunc: seedAccessRequestController.seedAccessRequestDelete,
+	})
+	seedAccessRequestController.seedAccessRequestSynced = seedAccessRequestInformer.Informer().HasSynced
+
+	return seedAccessRequestController
+}
+
+// Run runs the Controller until the given stop channel can be read from.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	var waitGroup sync.WaitGroup
+
+	if !cache.WaitForCacheSync(stopCh, c.seedAccessRequestSynced) {
+		logger.Logger.Error("Timed out waiting for caches to sync")
+		return
+	}
+
+	// Count number of running workers.
+	go func() {
+		for {
+			select {
+			case res := <-c.workerCh:
+				c.numberOfRunningWorkers += res
+				logger.Logger.Debugf("Current number of running SeedAccessRequest workers is %d", c.numberOfRunningWorkers)
+			}
+		}
+	}()
+
+	logger.Logger.Info("SeedAccessRequest controller initialized.")
+
+	for i := 0; i < workers; i++ {
+		controllerutils.CreateWorker(c.seedAccessRequestQueue, "SeedAccessRequest", c.reconcileSeedAccessRequestKey, stopCh, &waitGroup, c.workerCh)
+	}
+
+	// Shutdown handling
+	<-stopCh
+	c.seedAccessRequestQueue.ShutDown()
+
+	for {
+		if c.seedAccessRequestQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+			logger.Logger.Debug("No running SeedAccessRequest worker and no items left in the queues. Terminated SeedAccessRequest controller...")
+			break
+		}
+		logger.Logger.Debugf("Waiting for %d SeedAccessRequest worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.seedAccessRequestQueue.Len())
+		time.Sleep(5 * time.Second)
+	}
+
+	waitGroup.Wait()
+}
+
+// RunningWorkers returns the number of running workers.
+func (c *Controller) RunningWorkers() int {
+	return c.numberOfRunningWorkers
+}
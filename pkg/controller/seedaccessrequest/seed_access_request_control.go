@@ -0,0 +1,517 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedaccessrequest
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func (c *Controller) seedAccessRequestAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.seedAccessRequestQueue.Add(key)
+}
+
+func (c *Controller) seedAccessRequestUpdate(oldObj, newObj interface{}) {
+	c.seedAccessRequestAdd(newObj)
+}
+
+func (c *Controller) seedAccessRequestDelete(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.seedAccessRequestQueue.Add(key)
+}
+
+func (c *Controller) reconcileSeedAccessRequestKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	seedAccessRequest, err := c.seedAccessRequestLister.SeedAccessRequests(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SEEDACCESSREQUEST RECONCILE] %s - skipping because SeedAccessRequest has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SEEDACCESSREQUEST RECONCILE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	requeueAfter, err := c.control.ReconcileSeedAccessRequest(seedAccessRequest, key)
+	if err != nil {
+		c.seedAccessRequestQueue.AddAfter(key, time.Minute)
+		return nil
+	}
+	if requeueAfter > 0 {
+		c.seedAccessRequestQueue.AddAfter(key, requeueAfter)
+	}
+	return nil
+}
+
+// ControlInterface implements the control logic for granting and revoking SeedAccessRequests. It is implemented as
+// an interface to allow for extensions that provide different semantics. Currently, there is only one implementation.
+type ControlInterface interface {
+	// ReconcileSeedAccessRequest implements the control logic for SeedAccessRequest creation, expiration, and deletion.
+	// It returns the duration after which the SeedAccessRequest must be reconciled again (zero if not required).
+	// If an implementation returns a non-nil error, the invocation will be retried using a rate-limited strategy.
+	// Implementors should sink any errors that they do not wish to trigger a retry, and they may feel free to
+	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
+	ReconcileSeedAccessRequest(seedAccessRequest *gardenv1beta1.SeedAccessRequest, key string) (time.Duration, error)
+}
+
+// NewDefaultControl returns a new instance of the default implementation ControlInterface that
+// implements the documented semantics for SeedAccessRequests. You should use an instance returned from
+// NewDefaultControl() for any scenario other than testing.
+func NewDefaultControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, shootLister gardenlisters.ShootLister, seedLister gardenlisters.SeedLister) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, shootLister, seedLister, kubernetes.NewSeedClientFromSecretObject}
+}
+
+type defaultControl struct {
+	k8sGardenClient    kubernetes.Client
+	k8sGardenInformers gardeninformers.SharedInformerFactory
+	recorder           record.EventRecorder
+	shootLister        gardenlisters.ShootLister
+	seedLister         gardenlisters.SeedLister
+	newSeedClient      func(seedName string, secret *corev1.Secret) (kubernetes.Client, error)
+}
+
+// errAccessNotGrantable is returned if the access cannot be granted at all, i.e., retrying does not help.
+var errAccessNotGrantable = errors.New("access cannot be granted")
+
+func (c *defaultControl) ReconcileSeedAccessRequest(obj *gardenv1beta1.SeedAccessRequest, key string) (time.Duration, error) {
+	_, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		seedAccessRequest       = obj.DeepCopy()
+		seedAccessRequestLogger = logger.NewFieldLogger(logger.Logger, "seedaccessrequest", fmt.Sprintf("%s/%s", seedAccessRequest.Namespace, seedAccessRequest.Name))
+	)
+
+	// The deletionTimestamp labels a SeedAccessRequest as intended to get deleted. Before deletion, the granted
+	// access has to be revoked. When this happens the controller will remove the finalizers from the SeedAccessRequest
+	// so that it can be garbage collected.
+	if seedAccessRequest.DeletionTimestamp != nil {
+		if !sets.NewString(seedAccessRequest.Finalizers...).Has(gardenv1beta1.GardenerName) {
+			return 0, nil
+		}
+
+		// A request which is still pending may have been granted partially, hence, everything which might have been
+		// created is removed.
+		if phase := seedAccessRequest.Status.Phase; phase != gardenv1beta1.SeedAccessRequestPhaseExpired && phase != gardenv1beta1.SeedAccessRequestPhaseFailed {
+			if err := c.revoke(seedAccessRequest, seedAccessRequestLogger); err != nil {
+				seedAccessRequestLogger.Error(err.Error())
+				return 0, err
+			}
+		}
+
+		finalizers := sets.NewString(seedAccessRequest.Finalizers...)
+		finalizers.Delete(gardenv1beta1.GardenerName)
+		seedAccessRequest.Finalizers = finalizers.UnsortedList()
+		if _, err := c.k8sGardenClient.GardenClientset().GardenV1beta1().SeedAccessRequests(seedAccessRequest.Namespace).Update(seedAccessRequest); err != nil && !apierrors.IsNotFound(err) {
+			seedAccessRequestLogger.Error(err.Error())
+			return 0, err
+		}
+		return 0, nil
+	}
+
+	switch seedAccessRequest.Status.Phase {
+	case "", gardenv1beta1.SeedAccessRequestPhasePending:
+		expirationTimestamp, err := c.grant(seedAccessRequest, seedAccessRequestLogger)
+		if err == errAccessNotGrantable {
+			return 0, nil
+		}
+		if err != nil {
+			seedAccessRequestLogger.Error(err.Error())
+			return 0, err
+		}
+		return expirationTimestamp.Sub(time.Now()), nil
+
+	case gardenv1beta1.SeedAccessRequestPhaseGranted:
+		if expirationTimestamp := seedAccessRequest.Status.ExpirationTimestamp; expirationTimestamp != nil && time.Now().Before(expirationTimestamp.Time) {
+			return expirationTimestamp.Sub(time.Now()), nil
+		}
+
+		if err := c.revoke(seedAccessRequest, seedAccessRequestLogger); err != nil {
+			seedAccessRequestLogger.Error(err.Error())
+			return 0, err
+		}
+		seedAccessRequest.Status.Phase = gardenv1beta1.SeedAccessRequestPhaseExpired
+		seedAccessRequest.Status.Message = "The access has expired and has been revoked."
+		seedAccessRequest.Status.KubeconfigSecretName = ""
+		seedAccessRequest.Status.KubeconfigSecretNamespace = ""
+		if _, err := c.updateStatus(seedAccessRequest); err != nil {
+			seedAccessRequestLogger.Error(err.Error())
+			return 0, err
+		}
+	}
+
+	return 0, nil
+}
+
+// grant creates a ServiceAccount bound to a Role with the seedAccessRules in the Seed namespace of the requested Shoot.
+// A kubeconfig using the token of this ServiceAccount is stored in a Secret in the Garden namespace which only the
+// requester may read; the members of the project cannot read it. It returns the time at which the access expires.
+func (c *defaultControl) grant(seedAccessRequest *gardenv1beta1.SeedAccessRequest, seedAccessRequestLogger *logrus.Entry) (time.Time, error) {
+	requester := seedAccessRequest.Status.Requester
+	if len(requester) == 0 {
+		return time.Time{}, c.fail(seedAccessRequest, seedAccessRequestLogger, "The requester of the SeedAccessRequest is unknown.")
+	}
+
+	shoot, err := c.shootLister.Shoots(seedAccessRequest.Namespace).Get(seedAccessRequest.Spec.ShootName)
+	if apierrors.IsNotFound(err) {
+		return time.Time{}, c.fail(seedAccessRequest, seedAccessRequestLogger, fmt.Sprintf("Shoot %q does not exist.", seedAccessRequest.Spec.ShootName))
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if shoot.Spec.Cloud.Seed == nil || len(shoot.Status.TechnicalID) == 0 {
+		return time.Time{}, c.fail(seedAccessRequest, seedAccessRequestLogger, fmt.Sprintf("Shoot %q has not yet been created in a Seed cluster.", shoot.Name))
+	}
+
+	seed, k8sSeedClient, err := c.seedClient(shoot)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var (
+		name          = seedAccessName(seedAccessRequest)
+		seedNamespace = shoot.Status.TechnicalID
+	)
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: seedNamespace,
+		},
+	}
+	if _, err := k8sSeedClient.Clientset().CoreV1().ServiceAccounts(seedNamespace).Create(serviceAccount); err != nil && !apierrors.IsAlreadyExists(err) {
+		return time.Time{}, err
+	}
+
+	subject := rbacv1.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      name,
+		Namespace: seedNamespace,
+	}
+	if err := createRoleAndBinding(k8sSeedClient, seedNamespace, name, seedAccessRules, subject); err != nil {
+		return time.Time{}, err
+	}
+
+	// The token controller of the Seed cluster creates the token secret of the ServiceAccount asynchronously.
+	var token []byte
+	if err := utils.Retry(seedAccessRequestLogger, 30*time.Second, func() (bool, error) {
+		serviceAccount, err := k8sSeedClient.Clientset().CoreV1().ServiceAccounts(seedNamespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		for _, secretReference := range serviceAccount.Secrets {
+			secret, err := k8sSeedClient.GetSecret(seedNamespace, secretReference.Name)
+			if err != nil {
+				return false, err
+			}
+			if secret.Type == corev1.SecretTypeServiceAccountToken && len(secret.Data[corev1.ServiceAccountTokenKey]) > 0 {
+				token = secret.Data[corev1.ServiceAccountTokenKey]
+				return true, nil
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return time.Time{}, err
+	}
+
+	seedConfig := k8sSeedClient.GetConfig()
+	kubeconfig, err := utils.RenderLocalTemplate(kubeconfigTemplate, map[string]interface{}{
+		"APIServerURL":  seedConfig.Host,
+		"CACertificate": utils.EncodeBase64(seedConfig.CAData),
+		"ContextName":   fmt.Sprintf("%s-%s", seed.Name, seedNamespace),
+		"Namespace":     seedNamespace,
+		"Token":         string(token),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// The Secret cannot be owned by the SeedAccessRequest as it lives in another namespace. It is deleted when the
+	// access is revoked.
+	secretName := kubeconfigSecretName(seedAccessRequest)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: common.GardenNamespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"kubeconfig": kubeconfig,
+		},
+	}
+	if _, err := c.k8sGardenClient.CreateSecretObject(secret, true); err != nil {
+		return time.Time{}, err
+	}
+
+	requesterRules := []rbacv1.PolicyRule{
+		{
+			APIGroups:     []string{""},
+			Resources:     []string{"secrets"},
+			ResourceNames: []string{secretName},
+			Verbs:         []string{"get"},
+		},
+	}
+	requesterSubject := rbacv1.Subject{
+		APIGroup: rbacv1.GroupName,
+		Kind:     rbacv1.UserKind,
+		Name:     requester,
+	}
+	if err := createRoleAndBinding(c.k8sGardenClient, common.GardenNamespace, secretName, requesterRules, requesterSubject); err != nil {
+		return time.Time{}, err
+	}
+
+	duration := gardenv1beta1.DefaultSeedAccessDuration
+	if seedAccessRequest.Spec.Duration != nil {
+		duration = seedAccessRequest.Spec.Duration.Duration
+	}
+	expirationTimestamp := metav1.NewTime(time.Now().Add(duration))
+
+	seedAccessRequest.Status.Phase = gardenv1beta1.SeedAccessRequestPhaseGranted
+	seedAccessRequest.Status.Message = fmt.Sprintf("Access to namespace %q of Seed %q has been granted.", seedNamespace, seed.Name)
+	seedAccessRequest.Status.KubeconfigSecretName = secret.Name
+	seedAccessRequest.Status.KubeconfigSecretNamespace = secret.Namespace
+	seedAccessRequest.Status.ExpirationTimestamp = &expirationTimestamp
+	if _, err := c.updateStatus(seedAccessRequest); err != nil {
+		return time.Time{}, err
+	}
+
+	seedAccessRequestLogger.Infof("Granted access to namespace %q of Seed %q to %q until %s (reason: %q)", seedNamespace, seed.Name, requester, expirationTimestamp.UTC(), seedAccessRequest.Spec.Reason)
+	c.recorder.Eventf(seedAccessRequest, corev1.EventTypeNormal, gardenv1beta1.SeedAccessRequestEventGranted, "Granted access to namespace %q of Seed %q to %q until %s (reason: %q)", seedNamespace, seed.Name, requester, expirationTimestamp.UTC(), seedAccessRequest.Spec.Reason)
+	return expirationTimestamp.Time, nil
+}
+
+// revoke deletes the ServiceAccount, the Role and the RoleBinding in the Seed namespace of the requested Shoot as
+// well as the Secret containing the kubeconfig and the Role and RoleBinding of the requester in the Garden namespace.
+func (c *defaultControl) revoke(seedAccessRequest *gardenv1beta1.SeedAccessRequest, seedAccessRequestLogger *logrus.Entry) error {
+	secretName := kubeconfigSecretName(seedAccessRequest)
+	if err := deleteRoleAndBinding(c.k8sGardenClient, common.GardenNamespace, secretName); err != nil {
+		return err
+	}
+	if err := c.k8sGardenClient.DeleteSecret(common.GardenNamespace, secretName); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	shoot, err := c.shootLister.Shoots(seedAccessRequest.Namespace).Get(seedAccessRequest.Spec.ShootName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The Seed namespace is deleted together with the Shoot, hence, there is nothing left to revoke.
+			return nil
+		}
+		return err
+	}
+	if shoot.Spec.Cloud.Seed == nil || len(shoot.Status.TechnicalID) == 0 {
+		return nil
+	}
+
+	seed, k8sSeedClient, err := c.seedClient(shoot)
+	if err != nil {
+		return err
+	}
+
+	var (
+		name          = seedAccessName(seedAccessRequest)
+		seedNamespace = shoot.Status.TechnicalID
+	)
+
+	if err := deleteRoleAndBinding(k8sSeedClient, seedNamespace, name); err != nil {
+		return err
+	}
+	// The token secret of the ServiceAccount is deleted by the token controller of the Seed cluster.
+	if err := k8sSeedClient.Clientset().CoreV1().ServiceAccounts(seedNamespace).Delete(name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	seedAccessRequestLogger.Infof("Revoked access to namespace %q of Seed %q of %q", seedNamespace, seed.Name, seedAccessRequest.Status.Requester)
+	c.recorder.Eventf(seedAccessRequest, corev1.EventTypeNormal, gardenv1beta1.SeedAccessRequestEventRevoked, "Revoked access to namespace %q of Seed %q of %q", seedNamespace, seed.Name, seedAccessRequest.Status.Requester)
+	return nil
+}
+
+// seedClient returns the Seed of the given <shoot> and a Kubernetes client for it.
+func (c *defaultControl) seedClient(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Seed, kubernetes.Client, error) {
+	seed, err := c.seedLister.Get(*shoot.Spec.Cloud.Seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	seedSecret, err := c.k8sGardenClient.GetSecret(seed.Spec.SecretRef.Namespace, seed.Spec.SecretRef.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	k8sSeedClient, err := c.newSeedClient(seed.Name, seedSecret)
+	if err != nil {
+		return nil, nil, err
+	}
+	return seed, k8sSeedClient, nil
+}
+
+// createRoleAndBinding creates a Role with the given <rules> and a RoleBinding of it to the given <subject>, both
+// with the given <name> in the given <namespace>.
+func createRoleAndBinding(k8sClient kubernetes.Client, namespace, name string, rules []rbacv1.PolicyRule, subject rbacv1.Subject) error {
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Rules: rules,
+	}
+	if _, err := k8sClient.Clientset().RbacV1().Roles(namespace).Create(role); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	roleBinding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		Subjects: []rbacv1.Subject{subject},
+	}
+	if _, err := k8sClient.Clientset().RbacV1().RoleBindings(namespace).Create(roleBinding); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// deleteRoleAndBinding deletes the Role and the RoleBinding with the given <name> in the given <namespace>.
+func deleteRoleAndBinding(k8sClient kubernetes.Client, namespace, name string) error {
+	if err := k8sClient.Clientset().RbacV1().RoleBindings(namespace).Delete(name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := k8sClient.Clientset().RbacV1().Roles(namespace).Delete(name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// fail marks the SeedAccessRequest as failed with the given <message>. It returns errAccessNotGrantable if
+// the status could be updated.
+func (c *defaultControl) fail(seedAccessRequest *gardenv1beta1.SeedAccessRequest, seedAccessRequestLogger *logrus.Entry, message string) error {
+	seedAccessRequestLogger.Infof("Cannot grant access: %s", message)
+
+	seedAccessRequest.Status.Phase = gardenv1beta1.SeedAccessRequestPhaseFailed
+	seedAccessRequest.Status.Message = message
+	if _, err := c.updateStatus(seedAccessRequest); err != nil {
+		return err
+	}
+	return errAccessNotGrantable
+}
+
+func (c *defaultControl) updateStatus(seedAccessRequest *gardenv1beta1.SeedAccessRequest) (*gardenv1beta1.SeedAccessRequest, error) {
+	return c.k8sGardenClient.GardenClientset().GardenV1beta1().SeedAccessRequests(seedAccessRequest.Namespace).UpdateStatus(seedAccessRequest)
+}
+
+// seedAccessName returns the name of the ServiceAccount, the Role and the RoleBinding in the Seed namespace.
+func seedAccessName(seedAccessRequest *gardenv1beta1.SeedAccessRequest) string {
+	return fmt.Sprintf("seed-access-%s", seedAccessRequest.Name)
+}
+
+// kubeconfigSecretName returns the name of the Secret containing the kubeconfig and of the Role and RoleBinding of
+// the requester in the Garden namespace. It contains the namespace of the SeedAccessRequest as the Secrets of all
+// projects share the Garden namespace.
+func kubeconfigSecretName(seedAccessRequest *gardenv1beta1.SeedAccessRequest) string {
+	return fmt.Sprintf("seed-access.%s.%s.kubeconfig", seedAccessRequest.Namespace, seedAccessRequest.Name)
+}
+
+// seedAccessRules are the permissions granted in the Seed namespace of a Shoot. They allow to inspect its control
+// plane including the logs of the pods, to delete pods and to scale deployments and stateful sets. They neither allow
+// to read secrets, to execute commands in or to create pods, nor to manage roles and role bindings, hence, the access
+// cannot be escalated to the credentials of the control plane.
+var seedAccessRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"configmaps", "endpoints", "events", "persistentvolumeclaims", "pods", "pods/log", "serviceaccounts", "services"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods"},
+		Verbs:     []string{"delete"},
+	},
+	{
+		APIGroups: []string{"apps", "extensions"},
+		Resources: []string{"daemonsets", "deployments", "replicasets", "statefulsets"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"apps", "extensions"},
+		Resources: []string{"deployments/scale", "statefulsets/scale"},
+		Verbs:     []string{"get", "patch", "update"},
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"cronjobs", "jobs"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"machine.sapcloud.io"},
+		Resources: []string{"machinedeployments", "machines", "machinesets"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+}
+
+const kubeconfigTemplate = `---
+apiVersion: v1
+kind: Config
+current-context: {{.ContextName}}
+clusters:
+- name: {{.ContextName}}
+  cluster:
+    certificate-authority-data: {{.CACertificate}}
+    server: {{.APIServerURL}}
+contexts:
+- name: {{.ContextName}}
+  context:
+    cluster: {{.ContextName}}
+    namespace: {{.Namespace}}
+    user: {{.ContextName}}
+users:
+- name: {{.ContextName}}
+  user:
+    token: {{.Token}}`
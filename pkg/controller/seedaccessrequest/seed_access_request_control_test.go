@@ -0,0 +1,229 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedaccessrequest_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controller/seedaccessrequest"
+	"github.com/gardener/gardener/pkg/logger"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("SeedAccessRequest control", func() {
+	const (
+		namespace     = "garden-foo"
+		seedNamespace = "shoot--foo--bar"
+		secretName    = "seed-access.garden-foo.johndoe-debug.kubeconfig"
+		accessName    = "seed-access-johndoe-debug"
+	)
+
+	var (
+		garden  *fakeAPIServer
+		seed    *fakeAPIServer
+		control ControlInterface
+
+		seedAccessRequest *gardenv1beta1.SeedAccessRequest
+
+		reconcile = func() time.Duration {
+			requeueAfter, err := control.ReconcileSeedAccessRequest(seedAccessRequest, namespace+"/"+seedAccessRequest.Name)
+			Expect(err).NotTo(HaveOccurred())
+			*seedAccessRequest = gardenv1beta1.SeedAccessRequest{ObjectMeta: metav1.ObjectMeta{Name: seedAccessRequest.Name}}
+			Expect(garden.get("seedaccessrequests", namespace, seedAccessRequest.Name, seedAccessRequest)).To(BeTrue())
+			return requeueAfter
+		}
+	)
+
+	BeforeEach(func() {
+		logger.NewLogger("error")
+		garden = newFakeAPIServer()
+		seed = newFakeAPIServer()
+
+		seedName := "aws"
+		shootIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		Expect(shootIndexer.Add(&gardenv1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: namespace},
+			Spec:       gardenv1beta1.ShootSpec{Cloud: gardenv1beta1.Cloud{Seed: &seedName}},
+			Status:     gardenv1beta1.ShootStatus{TechnicalID: seedNamespace},
+		})).To(Succeed())
+		seedIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		Expect(seedIndexer.Add(&gardenv1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+			Spec:       gardenv1beta1.SeedSpec{SecretRef: corev1.SecretReference{Name: "seed-aws", Namespace: "garden"}},
+		})).To(Succeed())
+		garden.put("secrets", &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "seed-aws", Namespace: "garden"}})
+
+		control = ExportNewControl(garden.client(), record.NewFakeRecorder(100), gardenlisters.NewShootLister(shootIndexer), gardenlisters.NewSeedLister(seedIndexer), func(string, *corev1.Secret) (kubernetes.Client, error) {
+			return seed.client(), nil
+		})
+
+		seedAccessRequest = &gardenv1beta1.SeedAccessRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "johndoe-debug", Namespace: namespace, Finalizers: []string{gardenv1beta1.GardenerName}},
+			Spec:       gardenv1beta1.SeedAccessRequestSpec{ShootName: "bar", Reason: "debugging", Duration: &metav1.Duration{Duration: 2 * time.Hour}},
+			Status:     gardenv1beta1.SeedAccessRequestStatus{Requester: "john.doe@example.com"},
+		}
+		garden.put("seedaccessrequests", seedAccessRequest)
+	})
+
+	AfterEach(func() {
+		garden.close()
+		seed.close()
+	})
+
+	Describe("#grant", func() {
+		It("should grant the access to the Seed namespace to the requester only", func() {
+			requeueAfter := reconcile()
+
+			Expect(requeueAfter).To(BeNumerically("~", 2*time.Hour, time.Minute))
+			Expect(seedAccessRequest.Status.Phase).To(Equal(gardenv1beta1.SeedAccessRequestPhaseGranted))
+			Expect(seedAccessRequest.Status.KubeconfigSecretName).To(Equal(secretName))
+			Expect(seedAccessRequest.Status.KubeconfigSecretNamespace).To(Equal("garden"))
+			Expect(seedAccessRequest.Status.ExpirationTimestamp).NotTo(BeNil())
+
+			By("storing the kubeconfig outside of the project namespace")
+			secret := &corev1.Secret{}
+			Expect(garden.get("secrets", "garden", secretName, secret)).To(BeTrue())
+			Expect(string(secret.Data["kubeconfig"])).To(ContainSubstring("token: token-" + accessName))
+			Expect(string(secret.Data["kubeconfig"])).To(ContainSubstring("namespace: " + seedNamespace))
+			Expect(garden.count("secrets", namespace)).To(BeZero())
+
+			By("allowing the requester to read only this secret")
+			requesterRole := &rbacv1.Role{}
+			Expect(garden.get("roles", "garden", secretName, requesterRole)).To(BeTrue())
+			Expect(requesterRole.Rules).To(ConsistOf(rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{secretName}, Verbs: []string{"get"}}))
+			requesterRoleBinding := &rbacv1.RoleBinding{}
+			Expect(garden.get("rolebindings", "garden", secretName, requesterRoleBinding)).To(BeTrue())
+			Expect(requesterRoleBinding.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: secretName}))
+			Expect(requesterRoleBinding.Subjects).To(ConsistOf(rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "john.doe@example.com"}))
+
+			By("binding the ServiceAccount to the dedicated Role in the Seed")
+			Expect(seed.get("serviceaccounts", seedNamespace, accessName, &corev1.ServiceAccount{})).To(BeTrue())
+			role := &rbacv1.Role{}
+			Expect(seed.get("roles", seedNamespace, accessName, role)).To(BeTrue())
+			Expect(role.Rules).To(Equal(ExportSeedAccessRules))
+			roleBinding := &rbacv1.RoleBinding{}
+			Expect(seed.get("rolebindings", seedNamespace, accessName, roleBinding)).To(BeTrue())
+			Expect(roleBinding.RoleRef).To(Equal(rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: accessName}))
+			Expect(roleBinding.Subjects).To(ConsistOf(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: accessName, Namespace: seedNamespace}))
+		})
+
+		It("should fail if the Shoot does not exist", func() {
+			seedAccessRequest.Spec.ShootName = "baz"
+			garden.put("seedaccessrequests", seedAccessRequest)
+
+			Expect(reconcile()).To(BeZero())
+			Expect(seedAccessRequest.Status.Phase).To(Equal(gardenv1beta1.SeedAccessRequestPhaseFailed))
+			Expect(seed.count("serviceaccounts", seedNamespace)).To(BeZero())
+		})
+
+		It("should fail if the requester is unknown", func() {
+			seedAccessRequest.Status.Requester = ""
+			garden.put("seedaccessrequests", seedAccessRequest)
+
+			Expect(reconcile()).To(BeZero())
+			Expect(seedAccessRequest.Status.Phase).To(Equal(gardenv1beta1.SeedAccessRequestPhaseFailed))
+			Expect(garden.count("secrets", "garden")).To(Equal(1))
+		})
+	})
+
+	Describe("#seedAccessRules", func() {
+		It("should not allow to escalate the access", func() {
+			for _, rule := range ExportSeedAccessRules {
+				Expect(rule.APIGroups).NotTo(ContainElement(rbacv1.GroupName))
+				Expect(rule.Resources).NotTo(ContainElement("secrets"))
+				Expect(rule.Resources).NotTo(ContainElement("pods/exec"))
+				Expect(rule.Resources).NotTo(ContainElement("*"))
+				Expect(rule.Verbs).NotTo(ContainElement("*"))
+				Expect(rule.Verbs).NotTo(ContainElement("escalate"))
+				Expect(rule.Verbs).NotTo(ContainElement("bind"))
+				Expect(rule.Verbs).NotTo(ContainElement("impersonate"))
+				if len(rule.Resources) > 0 && rule.Resources[0] == "pods" {
+					Expect(rule.Verbs).NotTo(ContainElement("create"))
+				}
+			}
+		})
+	})
+
+	Describe("#expiry", func() {
+		BeforeEach(func() {
+			reconcile()
+		})
+
+		It("should keep the access until it expires", func() {
+			Expect(reconcile()).To(BeNumerically("~", 2*time.Hour, time.Minute))
+			Expect(seedAccessRequest.Status.Phase).To(Equal(gardenv1beta1.SeedAccessRequestPhaseGranted))
+			Expect(garden.get("secrets", "garden", secretName, &corev1.Secret{})).To(BeTrue())
+		})
+
+		It("should revoke the access once it has expired", func() {
+			expired := metav1.NewTime(time.Now().Add(-time.Minute))
+			seedAccessRequest.Status.ExpirationTimestamp = &expired
+
+			Expect(reconcile()).To(BeZero())
+			Expect(seedAccessRequest.Status.Phase).To(Equal(gardenv1beta1.SeedAccessRequestPhaseExpired))
+			Expect(seedAccessRequest.Status.KubeconfigSecretName).To(BeEmpty())
+			Expect(seedAccessRequest.Status.KubeconfigSecretNamespace).To(BeEmpty())
+			expectRevoked(garden, seed, seedNamespace, secretName, accessName)
+		})
+	})
+
+	Describe("#revocation", func() {
+		It("should revoke the access and release the SeedAccessRequest when it is deleted", func() {
+			reconcile()
+
+			now := metav1.Now()
+			seedAccessRequest.DeletionTimestamp = &now
+			garden.put("seedaccessrequests", seedAccessRequest)
+
+			Expect(reconcile()).To(BeZero())
+			Expect(seedAccessRequest.Finalizers).To(BeEmpty())
+			expectRevoked(garden, seed, seedNamespace, secretName, accessName)
+		})
+
+		It("should clean up a partially granted access when a pending SeedAccessRequest is deleted", func() {
+			garden.put("secrets", &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: "garden"}})
+			seed.put("serviceaccounts", &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: accessName, Namespace: seedNamespace}})
+
+			now := metav1.Now()
+			seedAccessRequest.DeletionTimestamp = &now
+			seedAccessRequest.Status.Phase = gardenv1beta1.SeedAccessRequestPhasePending
+			garden.put("seedaccessrequests", seedAccessRequest)
+
+			Expect(reconcile()).To(BeZero())
+			Expect(seedAccessRequest.Finalizers).To(BeEmpty())
+			expectRevoked(garden, seed, seedNamespace, secretName, accessName)
+		})
+	})
+})
+
+func expectRevoked(garden, seed *fakeAPIServer, seedNamespace, secretName, accessName string) {
+	Expect(garden.get("secrets", "garden", secretName, &corev1.Secret{})).To(BeFalse())
+	Expect(garden.get("roles", "garden", secretName, &rbacv1.Role{})).To(BeFalse())
+	Expect(garden.get("rolebindings", "garden", secretName, &rbacv1.RoleBinding{})).To(BeFalse())
+	Expect(seed.get("serviceaccounts", seedNamespace, accessName, &corev1.ServiceAccount{})).To(BeFalse())
+	Expect(seed.get("roles", seedNamespace, accessName, &rbacv1.Role{})).To(BeFalse())
+	Expect(seed.get("rolebindings", seedNamespace, accessName, &rbacv1.RoleBinding{})).To(BeFalse())
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var (
+	ExportKubernetesUpgradePhase  = kubernetesUpgradePhase
+	ExportConfigureMachineWait    = configureMachineWait
+	ExportOperationSuperseded     = operationSuperseded
+	ExportRetryPeriod             = retryPeriod
+	ExportHealthTransitions       = healthTransitions
+	ExportPruneEventFeed          = pruneEventFeed
+	ExportControlPlaneFeedEntries = controlPlaneFeedEntries
+	ExportEventFeedReasons        = eventFeedReasons
+	ExportRecoveryDrillSkipReason = recoveryDrillSkipReason
+	ExportNextRecoveryDrill       = nextRecoveryDrill
+	ExportMergeRecoveryDrills     = mergeRecoveryDrillResults
+	ExportNewReconcilePlan        = newReconcilePlan
+	ExportDesiredStateChecksums   = desiredStateChecksums
+)
+
+type ExportEventFeedEntry = eventFeedEntry
+
+type ExportRecoveryDrillResult = recoveryDrillResult
+
+// ExportRunningOperations returns functions to start, cancel and stop the operations of a new runningOperations.
+func ExportRunningOperations() (func(string) (context.Context, func()), func(string) bool, func()) {
+	r := newRunningOperations()
+	return r.start, r.cancel, r.stop
+}
+
+// ExportExclusiveOperations returns the function which starts an operation of a new exclusiveOperations.
+func ExportExclusiveOperations() func(string, string) (func(), string) {
+	return newExclusiveOperations().tryStart
+}
+
+// ExportFlowLastErrors executes the flow <f> and returns the last error and the individual last errors which are
+// reported in the status of the Shoot.
+func ExportFlowLastErrors(f *flow.Flow) (*gardenv1beta1.LastError, []gardenv1beta1.LastError) {
+	e := f.Execute()
+	if e == nil {
+		return nil, nil
+	}
+	operationError := newFlowOperationError(f, e)
+	return operationError.LastError, operationError.lastErrors
+}
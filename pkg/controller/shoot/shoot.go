@@ -37,21 +37,24 @@ type Controller struct {
 	k8sGardenClient    kubernetes.Client
 	k8sGardenInformers gardeninformers.SharedInformerFactory
 
-	config             *componentconfig.ControllerManagerConfiguration
-	control            ControlInterface
-	careControl        CareControlInterface
-	maintenanceControl MaintenanceControlInterface
-	quotaControl       QuotaControlInterface
-	recorder           record.EventRecorder
-	secrets            map[string]*corev1.Secret
-	imageVector        imagevector.ImageVector
-
-	shootLister           gardenlisters.ShootLister
-	shootQueue            workqueue.RateLimitingInterface
-	shootCareQueue        workqueue.RateLimitingInterface
-	shootMaintenanceQueue workqueue.RateLimitingInterface
-	shootQuotaQueue       workqueue.RateLimitingInterface
-	shootSeedQueue        workqueue.RateLimitingInterface
+	config               *componentconfig.ControllerManagerConfiguration
+	identity             *gardenv1beta1.Gardener
+	control              ControlInterface
+	careControl          CareControlInterface
+	maintenanceControl   MaintenanceControlInterface
+	quotaControl         QuotaControlInterface
+	recoveryDrillControl RecoveryDrillControlInterface
+	recorder             record.EventRecorder
+	secrets              map[string]*corev1.Secret
+	imageVector          imagevector.ImageVector
+
+	shootLister             gardenlisters.ShootLister
+	shootQueue              workqueue.RateLimitingInterface
+	shootCareQueue          workqueue.RateLimitingInterface
+	shootMaintenanceQueue   workqueue.RateLimitingInterface
+	shootQuotaQueue         workqueue.RateLimitingInterface
+	shootRecoveryDrillQueue workqueue.RateLimitingInterface
+	shootSeedQueue          workqueue.RateLimitingInterface
 
 	shootSynced         cache.InformerSynced
 	seedSynced          cache.InformerSynced
@@ -59,6 +62,10 @@ type Controller struct {
 	secretBindingSynced cache.InformerSynced
 	quotaSynced         cache.InformerSynced
 
+	shootOperations     *runningOperations
+	exclusiveOperations *exclusiveOperations
+	shardFilter         *controllerutils.ShardFilter
+
 	numberOfRunningWorkers int
 	workerCh               chan int
 }
@@ -66,36 +73,48 @@ type Controller struct {
 // NewShootController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a struct
 // holding information about the acting Gardener, a <shootInformer>, and a <recorder> for
 // event recording. It creates a new Gardener controller.
-func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, config *componentconfig.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder) *Controller {
+func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, config *componentconfig.ControllerManagerConfiguration, identity *gardenv1beta1.Gardener, gardenNamespace string, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, recorder record.EventRecorder, shardFilter *controllerutils.ShardFilter) *Controller {
 	var (
 		gardenv1beta1Informer = k8sGardenInformers.Garden().V1beta1()
 		shootInformer         = gardenv1beta1Informer.Shoots()
 		shootLister           = shootInformer.Lister()
 		shootUpdater          = NewRealUpdater(k8sGardenClient, shootLister)
+		eventFeed             *EventFeed
 	)
 
+	if retention := config.Controllers.Shoot.EventFeedRetention; retention != nil {
+		eventFeed = NewEventFeed(k8sGardenClient, retention.Duration)
+	}
+	recorder = NewEventFeedRecorder(recorder, eventFeed)
+
 	shootController := &Controller{
-		k8sGardenClient:       k8sGardenClient,
-		k8sGardenInformers:    k8sGardenInformers,
-		config:                config,
-		control:               NewDefaultControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, gardenNamespace, recorder, shootUpdater),
-		careControl:           NewDefaultCareControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, shootUpdater),
-		maintenanceControl:    NewDefaultMaintenanceControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, recorder, shootUpdater),
-		quotaControl:          NewDefaultQuotaControl(k8sGardenClient, gardenv1beta1Informer),
-		recorder:              recorder,
-		secrets:               secrets,
-		imageVector:           imageVector,
-		shootLister:           shootLister,
-		shootQueue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot"),
-		shootCareQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-care"),
-		shootMaintenanceQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-maintenance"),
-		shootQuotaQueue:       workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-quota"),
-		shootSeedQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-seeds"),
-		workerCh:              make(chan int),
+		k8sGardenClient:         k8sGardenClient,
+		k8sGardenInformers:      k8sGardenInformers,
+		config:                  config,
+		identity:                identity,
+		control:                 NewDefaultControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, gardenNamespace, recorder, shootUpdater),
+		careControl:             NewDefaultCareControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, recorder, eventFeed, shootUpdater),
+		maintenanceControl:      NewDefaultMaintenanceControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, recorder, shootUpdater),
+		quotaControl:            NewDefaultQuotaControl(k8sGardenClient, gardenv1beta1Informer),
+		recoveryDrillControl:    NewDefaultRecoveryDrillControl(k8sGardenClient, gardenv1beta1Informer, secrets, imageVector, identity, config, recorder),
+		recorder:                recorder,
+		secrets:                 secrets,
+		imageVector:             imageVector,
+		shootLister:             shootLister,
+		shootQueue:              workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot"),
+		shootCareQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-care"),
+		shootMaintenanceQueue:   workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-maintenance"),
+		shootQuotaQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-quota"),
+		shootRecoveryDrillQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-recovery-drill"),
+		shootSeedQueue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "shoot-seeds"),
+		shootOperations:         newRunningOperations(),
+		exclusiveOperations:     newExclusiveOperations(),
+		shardFilter:             shardFilter,
+		workerCh:                make(chan int),
 	}
 
 	shootInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
-		FilterFunc: shootController.shootNamespaceFilter,
+		FilterFunc: shootController.shootFilter,
 		Handler: cache.ResourceEventHandlerFuncs{
 			AddFunc:    shootController.shootAdd,
 			UpdateFunc: shootController.shootUpdate,
@@ -104,7 +123,7 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 	})
 
 	shootInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
-		FilterFunc: shootController.shootNamespaceFilter,
+		FilterFunc: shootController.shootFilter,
 		Handler: cache.ResourceEventHandlerFuncs{
 			AddFunc:    shootController.shootCareAdd,
 			DeleteFunc: shootController.shootCareDelete,
@@ -112,7 +131,7 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 	})
 
 	shootInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
-		FilterFunc: shootController.shootNamespaceFilter,
+		FilterFunc: shootController.shootFilter,
 		Handler: cache.ResourceEventHandlerFuncs{
 			AddFunc:    shootController.shootMaintenanceAdd,
 			DeleteFunc: shootController.shootMaintenanceDelete,
@@ -120,13 +139,24 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 	})
 
 	shootInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
-		FilterFunc: shootController.shootNamespaceFilter,
+		FilterFunc: shootController.shootFilter,
 		Handler: cache.ResourceEventHandlerFuncs{
 			AddFunc:    shootController.shootQuotaAdd,
 			DeleteFunc: shootController.shootQuotaDelete,
 		},
 	})
 
+	// The recovery drills are opt-in for the Gardener operators, hence, the Shoots are only queued if they are enabled.
+	if config.Controllers.ShootRecoveryDrill != nil {
+		shootInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: shootController.shootFilter,
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc:    shootController.shootRecoveryDrillAdd,
+				DeleteFunc: shootController.shootRecoveryDrillDelete,
+			},
+		})
+	}
+
 	shootController.shootSynced = shootInformer.Informer().HasSynced
 	shootController.seedSynced = gardenv1beta1Informer.Seeds().Informer().HasSynced
 	shootController.cloudProfileSynced = gardenv1beta1Informer.CloudProfiles().Informer().HasSynced
@@ -137,7 +167,7 @@ func NewShootController(k8sGardenClient kubernetes.Client, k8sGardenInformers ga
 }
 
 // Run runs the Controller until the given stop channel can be read from.
-func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers, shootQuotaWorkers int, stopCh <-chan struct{}) {
+func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers, shootQuotaWorkers, shootRecoveryDrillWorkers int, stopCh <-chan struct{}) {
 	var (
 		watchNamespace = c.config.Controllers.Shoot.WatchNamespace
 		waitGroup      sync.WaitGroup
@@ -164,6 +194,9 @@ func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers
 	} else {
 		logger.Logger.Infof("Watching only namespace '%s' for Shoot resources...", *watchNamespace)
 	}
+	if c.shardFilter.Sharded() {
+		logger.Logger.Infof("Reconciling only the Shoots of %s...", c.shardFilter)
+	}
 	logger.Logger.Info("Shoot controller initialized.")
 
 	for i := 0; i < shootWorkers; i++ {
@@ -178,26 +211,32 @@ func (c *Controller) Run(shootWorkers, shootCareWorkers, shootMaintenanceWorkers
 	for i := 0; i < shootQuotaWorkers; i++ {
 		controllerutils.CreateWorker(c.shootQuotaQueue, "Shoot Quota", c.reconcileShootQuotaKey, stopCh, &waitGroup, c.workerCh)
 	}
+	for i := 0; i < shootRecoveryDrillWorkers; i++ {
+		controllerutils.CreateWorker(c.shootRecoveryDrillQueue, "Shoot Recovery Drill", c.reconcileShootRecoveryDrillKey, stopCh, &waitGroup, c.workerCh)
+	}
 	for i := 0; i < shootWorkers/2+1; i++ {
 		controllerutils.CreateWorker(c.shootSeedQueue, "Shooted Seeds", c.reconcileShootKey, stopCh, &waitGroup, c.workerCh)
 	}
 
 	// Shutdown handling
 	<-stopCh
+	c.shootOperations.stop()
 	c.shootQueue.ShutDown()
 	c.shootCareQueue.ShutDown()
 	c.shootMaintenanceQueue.ShutDown()
 	c.shootQuotaQueue.ShutDown()
+	c.shootRecoveryDrillQueue.ShutDown()
 	c.shootSeedQueue.ShutDown()
 
 	for {
 		var (
-			shootQueueLength            = c.shootQueue.Len()
-			shootCareQueueLength        = c.shootCareQueue.Len()
-			shootMaintenanceQueueLength = c.shootMaintenanceQueue.Len()
-			shootQuotaQueueLength       = c.shootQuotaQueue.Len()
-			shootSeedQueueLength        = c.shootSeedQueue.Len()
-			queueLengths                = shootQueueLength + shootCareQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength + shootSeedQueueLength
+			shootQueueLength              = c.shootQueue.Len()
+			shootCareQueueLength          = c.shootCareQueue.Len()
+			shootMaintenanceQueueLength   = c.shootMaintenanceQueue.Len()
+			shootQuotaQueueLength         = c.shootQuotaQueue.Len()
+			shootRecoveryDrillQueueLength = c.shootRecoveryDrillQueue.Len()
+			shootSeedQueueLength          = c.shootSeedQueue.Len()
+			queueLengths                  = shootQueueLength + shootCareQueueLength + shootMaintenanceQueueLength + shootQuotaQueueLength + shootRecoveryDrillQueueLength + shootSeedQueueLength
 		)
 		if queueLengths == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running Shoot worker and no items left in the queues. Terminated Shoot controller...")
@@ -215,13 +254,13 @@ func (c *Controller) RunningWorkers() int {
 	return c.numberOfRunningWorkers
 }
 
-// shootNamespaceFilter filters Shoots based on their namespace and the configuration value.
-func (c *Controller) shootNamespaceFilter(obj interface{}) bool {
+// shootFilter filters Shoots based on their namespace and the configuration value, and on the shard they belong to.
+func (c *Controller) shootFilter(obj interface{}) bool {
 	var (
 		shoot          = obj.(*gardenv1beta1.Shoot)
 		watchNamespace = c.config.Controllers.Shoot.WatchNamespace
 	)
-	return watchNamespace == nil || shoot.Namespace == *watchNamespace
+	return (watchNamespace == nil || shoot.Namespace == *watchNamespace) && c.shardFilter.Responsible(shoot)
 }
 
 func (c *Controller) getShootQueue(obj interface{}) workqueue.RateLimitingInterface {
@@ -16,9 +16,12 @@ package shoot
 
 import (
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	componentconfigv1alpha1 "github.com/gardener/gardener/pkg/apis/componentconfig/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
@@ -29,9 +32,12 @@ import (
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 )
 
 func (c *Controller) shootCareAdd(obj interface{}) {
@@ -68,6 +74,10 @@ func (c *Controller) reconcileShootCareKey(key string) error {
 		logger.Logger.Infof("[SHOOT CARE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.shardFilter.Responsible(shoot) {
+		logger.Logger.Debugf("[SHOOT CARE] %s - skipping because Shoot belongs to another shard", key)
+		return nil
+	}
 
 	defer c.shootCareAdd(shoot)
 
@@ -93,10 +103,11 @@ type CareControlInterface interface {
 
 // NewDefaultCareControl returns a new instance of the default implementation CareControlInterface that
 // implements the documented semantics for caring for Shoots. updater is the UpdaterInterface used
-// to update the status of Shoots. You should use an instance returned from NewDefaultCareControl() for any
-// scenario other than testing.
-func NewDefaultCareControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *componentconfig.ControllerManagerConfiguration, updater UpdaterInterface) CareControlInterface {
-	return &defaultCareControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, config, updater}
+// to update the status of Shoots, recorder is used to report events about the Shoots, and eventFeed (which may be nil)
+// is used to add the warnings of their control planes to their event feeds. You should use an instance returned from
+// NewDefaultCareControl() for any scenario other than testing.
+func NewDefaultCareControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *componentconfig.ControllerManagerConfiguration, recorder record.EventRecorder, eventFeed *EventFeed, updater UpdaterInterface) CareControlInterface {
+	return &defaultCareControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, config, recorder, eventFeed, updater}
 }
 
 type defaultCareControl struct {
@@ -106,6 +117,8 @@ type defaultCareControl struct {
 	imageVector        imagevector.ImageVector
 	identity           *gardenv1beta1.Gardener
 	config             *componentconfig.ControllerManagerConfiguration
+	recorder           record.EventRecorder
+	eventFeed          *EventFeed
 	updater            UpdaterInterface
 }
 
@@ -156,18 +169,75 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 		conditionSystemComponentsHealthy = helper.ModifyCondition(conditionSystemComponentsHealthy, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, message)
 		operation.Logger.Error(message)
 		c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy)
+		// An unreachable API server of the Shoot may be caused by a Kubernetes upgrade.
+		c.checkKubernetesUpgrade(shoot, false, operation.Logger)
 		return nil
 	}
 
 	// Trigger garbage collection
-	garbageCollection(botanist)
+	var garbageCollectionRetention time.Duration
+	if retention := c.config.Controllers.ShootCare.GarbageCollectionRetention; retention != nil {
+		garbageCollectionRetention = retention.Duration
+	}
+	garbageCollection(botanist, garbageCollectionRetention)
+
+	// Pause or resume the automatic replacement of machines
+	if err := botanist.EnsureMachineAutoRepairBudget(); err != nil {
+		botanist.Logger.Errorf("Could not ensure the machine auto repair budget: %s", err.Error())
+	}
+
+	// Replace the machines of nodes which are about to be terminated by the cloud provider
+	replacedMachines, err := botanist.ReplaceTerminatingMachines()
+	if err != nil {
+		botanist.Logger.Errorf("Could not replace the machines of terminating nodes: %s", err.Error())
+	}
+	if len(replacedMachines) > 0 {
+		c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMachinesReplaced, "Replaced the machines %s because their nodes are terminating", strings.Join(replacedMachines, ", "))
+	}
+
+	// Allow workload on the nodes on which the critical components have become ready
+	if err := botanist.RemoveCriticalComponentsTaints(); err != nil {
+		botanist.Logger.Errorf("Could not remove the critical components taints: %s", err.Error())
+	}
+
+	// Approve the serving certificates requested by the kubelets
+	if err := botanist.ApproveKubeletServingCertificates(); err != nil {
+		botanist.Logger.Errorf("Could not approve the kubelet serving certificates: %s", err.Error())
+	}
 
 	// Trigger health check
 	conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy = healthCheck(botanist, cloudBotanist, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy)
 
-	// Update Shoot status
-	if newShoot, _ := c.updateShootStatus(shoot, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy); newShoot != nil {
+	// Check the utilization of the networks
+	conditionNetworkCapacitySufficient := c.checkNetworkCapacity(shoot, botanist)
+
+	conditions := []gardenv1beta1.Condition{*conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy, *conditionNetworkCapacitySufficient}
+
+	// Check whether the nodes have been rebooted into the installed operating system updates
+	if conditionOSUpdatesApplied := c.checkOSUpdates(shoot, botanist); conditionOSUpdatesApplied != nil {
+		conditions = append(conditions, *conditionOSUpdatesApplied)
+	}
+
+	// Update Shoot status and report the transitions of the health conditions
+	previousConditions := shoot.Status.Conditions
+	if newShoot, _ := c.updateShootStatus(shoot, conditions...); newShoot != nil {
 		shoot = newShoot
+		for _, condition := range healthTransitions(previousConditions, *conditionControlPlaneHealthy, *conditionEveryNodeReady, *conditionSystemComponentsHealthy) {
+			eventType := corev1.EventTypeNormal
+			if condition.Status != corev1.ConditionTrue {
+				eventType = corev1.EventTypeWarning
+			}
+			c.recorder.Eventf(shoot, eventType, gardenv1beta1.ShootEventHealthChanged, "Condition %s is %s: %s", condition.Type, condition.Status, condition.Message)
+		}
+	}
+
+	// Add the warnings of the control plane to the event feed
+	if c.eventFeed != nil {
+		if eventList, err := botanist.K8sSeedClient.Clientset().CoreV1().Events(botanist.Shoot.SeedNamespace).List(metav1.ListOptions{}); err != nil {
+			botanist.Logger.Errorf("Could not list the events of the control plane: %s", err.Error())
+		} else if err := c.eventFeed.RecordControlPlaneEvents(shoot, eventList.Items); err != nil {
+			botanist.Logger.Errorf("Could not add the events of the control plane to the event feed: %s", err.Error())
+		}
 	}
 
 	// Mark Shoot as healthy/unhealthy
@@ -178,10 +248,101 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 	)
 	c.labelShoot(shoot, healthy)
 
+	// Complete or roll back a progressing Kubernetes upgrade
+	c.checkKubernetesUpgrade(shoot, healthy, operation.Logger)
+
 	return nil
 }
 
+// checkNetworkCapacity computes the NetworkCapacitySufficient condition of the <shoot>. An exhausted network only
+// shows up as nodes which cannot be registered or scheduled, hence, a warning event is reported whenever the
+// utilization of a network exceeds the configured threshold.
+func (c *defaultCareControl) checkNetworkCapacity(shoot *gardenv1beta1.Shoot, botanist *botanistpkg.Botanist) *gardenv1beta1.Condition {
+	threshold := componentconfigv1alpha1.DefaultNetworkUtilizationThreshold
+	if value := c.config.Controllers.ShootCare.NetworkUtilizationThreshold; value != nil {
+		threshold = *value
+	}
+
+	var (
+		condition      = helper.NewConditions(shoot.Status.Conditions, gardenv1beta1.ShootNetworkCapacitySufficient)[0]
+		previousStatus = condition.Status
+		newCondition   = botanist.CheckConditionNetworkCapacitySufficient(condition, threshold)
+	)
+	if newCondition.Status == corev1.ConditionFalse && previousStatus != corev1.ConditionFalse {
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventNetworkCapacityLow, newCondition.Message)
+	}
+	return newCondition
+}
+
+// checkOSUpdates computes the OSUpdatesApplied condition of the <shoot> if one of its worker groups reboots its nodes
+// into the installed operating system updates. It returns nil if the Shoot never had such worker groups, and resets
+// the condition once the automated updates have been disabled for all worker groups.
+func (c *defaultCareControl) checkOSUpdates(shoot *gardenv1beta1.Shoot, botanist *botanistpkg.Botanist) *gardenv1beta1.Condition {
+	condition := helper.NewConditions(shoot.Status.Conditions, gardenv1beta1.ShootOSUpdatesApplied)[0]
+
+	if len(botanist.Shoot.GetOSUpdatesWorkerNames()) == 0 {
+		if helper.GetCondition(shoot.Status.Conditions, gardenv1beta1.ShootOSUpdatesApplied) == nil {
+			return nil
+		}
+		return helper.ModifyCondition(condition, corev1.ConditionTrue, "UpdatesDisabled", "No worker group reboots its nodes into operating system updates.")
+	}
+
+	if err := botanist.ReleaseStaleNodeRebootLock(); err != nil {
+		botanist.Logger.Errorf("Could not release a stale node reboot lock: %s", err.Error())
+	}
+	return botanist.CheckConditionOSUpdatesApplied(condition)
+}
+
+// checkKubernetesUpgrade marks a progressing Kubernetes upgrade of the <shoot> as succeeded once the Shoot is
+// <healthy>. If the Shoot has not become healthy within the configured rollback threshold, it resets the Kubernetes
+// version to the version the Shoot has been upgraded from (which triggers a reconciliation with the previous version).
+func (c *defaultCareControl) checkKubernetesUpgrade(shoot *gardenv1beta1.Shoot, healthy bool, logger *logrus.Entry) {
+	upgrade := shoot.Status.KubernetesUpgrade
+	if upgrade == nil || upgrade.Phase != gardenv1beta1.KubernetesUpgradePhaseProgressing || shoot.DeletionTimestamp != nil {
+		return
+	}
+
+	var rollbackThreshold time.Duration
+	if threshold := c.config.Controllers.ShootCare.KubernetesUpgradeRollbackThreshold; threshold != nil {
+		rollbackThreshold = threshold.Duration
+	}
+
+	switch kubernetesUpgradePhase(shoot, healthy, rollbackThreshold, time.Now()) {
+	case gardenv1beta1.KubernetesUpgradePhaseSucceeded:
+		upgrade.Phase = gardenv1beta1.KubernetesUpgradePhaseSucceeded
+		upgrade.Message = fmt.Sprintf("Shoot cluster is healthy after the upgrade to %s.", upgrade.Version)
+		if _, err := c.updater.UpdateShootStatusIfNoOperation(shoot); err != nil {
+			logger.Errorf("Could not mark the Kubernetes upgrade as succeeded: %s", err.Error())
+		}
+
+	case gardenv1beta1.KubernetesUpgradePhaseRolledBack:
+		var (
+			previousVersion = upgrade.PreviousVersion
+			message         = fmt.Sprintf("Shoot cluster has not become healthy within %s after the upgrade to %s, the Kubernetes version has been rolled back to %s.", rollbackThreshold, upgrade.Version, previousVersion)
+		)
+		logger.Info(message)
+
+		// The message is stored before the rollback because the status is not changed by an update of the specification.
+		upgrade.Message = message
+		newShoot, err := c.updater.UpdateShootStatusIfNoOperation(shoot)
+		if err != nil || newShoot == nil {
+			if err != nil {
+				logger.Errorf("Could not record the rollback of the Kubernetes upgrade: %s", err.Error())
+			}
+			return
+		}
+
+		newShoot.Spec.Kubernetes.Version = previousVersion
+		if _, err := c.updater.UpdateShoot(newShoot); err != nil {
+			logger.Errorf("Could not roll back the Kubernetes upgrade: %s", err.Error())
+		}
+	}
+}
+
 func (c *defaultCareControl) updateShootStatus(shoot *gardenv1beta1.Shoot, conditions ...gardenv1beta1.Condition) (*gardenv1beta1.Shoot, error) {
+	// Other controllers maintain further conditions on the Shoot (e.g., the CloudProfile compliance), hence, only the
+	// health conditions are replaced.
+	conditions = helper.MergeConditions(shoot.Status.Conditions, conditions...)
 	if !helper.ConditionsNeedUpdate(shoot.Status.Conditions, conditions) {
 		return shoot, nil
 	}
@@ -206,17 +367,22 @@ func (c *defaultCareControl) labelShoot(shoot *gardenv1beta1.Shoot, healthy bool
 
 // garbageCollection cleans the Seed and the Shoot cluster from unrequired objects.
 // It receives a Garden object <garden> which stores the Shoot object.
-func garbageCollection(botanist *botanistpkg.Botanist) {
+// Completed or orphaned objects in the Seed cluster are only deleted if they are older than <retention>.
+func garbageCollection(botanist *botanistpkg.Botanist, retention time.Duration) {
 	var wg sync.WaitGroup
 
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
-		botanist.PerformGarbageCollectionSeed()
+		if err := botanist.PerformGarbageCollectionSeed(retention); err != nil {
+			botanist.Logger.Errorf("Error during garbage collection in the Seed cluster: %s", err.Error())
+		}
 	}()
 	go func() {
 		defer wg.Done()
-		botanist.PerformGarbageCollectionShoot()
+		if err := botanist.PerformGarbageCollectionShoot(); err != nil {
+			botanist.Logger.Errorf("Error during garbage collection in the Shoot cluster: %s", err.Error())
+		}
 	}()
 	wg.Wait()
 
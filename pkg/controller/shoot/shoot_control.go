@@ -15,8 +15,11 @@
 package shoot
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gardener/gardener/pkg/apis/componentconfig"
@@ -27,6 +30,7 @@ import (
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	corev1 "k8s.io/api/core/v1"
@@ -57,6 +61,14 @@ func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
 	shootLogger.Debugf(string(oldShootJSON))
 	shootLogger.Debugf(string(newShootJSON))
 
+	// A running operation is canceled if it has been superseded by the changes, e.g. a reconciliation of an outdated
+	// specification or a reconciliation of a Shoot whose deletion has been confirmed in the meantime.
+	if operationSuperseded(oldShoot, newShoot) {
+		if key, err := cache.MetaNamespaceKeyFunc(newObj); err == nil && c.shootOperations.cancel(key) {
+			shootLogger.Info("Canceling the running operation as it has been superseded by changes of the Shoot")
+		}
+	}
+
 	// If the generation did not change for an update event (i.e., no changes to the .spec section have
 	// been made), we do not want to add the Shoot to the queue. The period reconciliation is handled
 	// elsewhere by adding the Shoot to the queue to dedicated times.
@@ -75,6 +87,11 @@ func (c *Controller) shootDelete(obj interface{}) {
 		return
 	}
 
+	// The Shoot is gone, hence, there is no point in continuing an operation which is still running for it.
+	if c.shootOperations.cancel(key) {
+		logger.Logger.Infof("[SHOOT RECONCILE] %s - canceling the running operation as the Shoot has been deleted", key)
+	}
+
 	c.getShootQueue(obj).Add(key)
 }
 
@@ -93,6 +110,10 @@ func (c *Controller) reconcileShootKey(key string) error {
 		logger.Logger.Infof("[SHOOT RECONCILE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.shardFilter.Responsible(shoot) {
+		logger.Logger.Debugf("[SHOOT RECONCILE] %s - skipping because Shoot belongs to another shard", key)
+		return nil
+	}
 
 	var (
 		shootLogger  = logger.NewShootLogger(logger.Logger, shoot.ObjectMeta.Name, shoot.ObjectMeta.Namespace, "")
@@ -111,10 +132,19 @@ func (c *Controller) reconcileShootKey(key string) error {
 	if mustIgnoreShoot(shoot.Annotations, c.config.Controllers.Shoot.RespectSyncPeriodOverwrite) {
 		shootLogger.Info("Skipping reconciliation because Shoot is marked as 'to-be-ignored'.")
 	} else {
-		needsRequeue, reconcileErr = c.control.ReconcileShoot(shoot, key)
+		finished, running := c.exclusiveOperations.tryStart(key, "reconciliation")
+		if finished == nil {
+			shootLogger.Infof("Postponing the reconciliation because the %s of the Shoot is running.", running)
+			c.getShootQueue(shoot).AddAfter(key, c.config.Controllers.Shoot.RetrySyncPeriod.Duration)
+			return nil
+		}
+		ctx, done := c.shootOperations.start(key)
+		needsRequeue, reconcileErr = c.control.ReconcileShoot(ctx, shoot, key)
+		done()
+		finished()
 	}
 
-	if wantsResync, durationToNextSync := scheduleNextSync(shoot.ObjectMeta, reconcileErr != nil, c.config.Controllers.Shoot); wantsResync && needsRequeue {
+	if wantsResync, durationToNextSync := scheduleNextSync(shoot.ObjectMeta, reconcileErr, shoot.Status.RetryCycleStartTime, c.config.Controllers.Shoot); wantsResync && needsRequeue {
 		c.getShootQueue(shoot).AddAfter(key, durationToNextSync)
 		shootLogger.Infof("Scheduled next queuing time for Shoot '%s' to %s", key, durationToNextSync)
 	}
@@ -122,9 +152,11 @@ func (c *Controller) reconcileShootKey(key string) error {
 	return nil
 }
 
-func scheduleNextSync(objectMeta metav1.ObjectMeta, errorOccurred bool, config componentconfig.ShootControllerConfiguration) (bool, time.Duration) {
-	if errorOccurred {
-		return true, (*config.RetrySyncPeriod).Duration
+func scheduleNextSync(objectMeta metav1.ObjectMeta, reconcileErr error, retryCycleStartTime *metav1.Time, config componentconfig.ShootControllerConfiguration) (bool, time.Duration) {
+	if reconcileErr != nil {
+		if duration, ok := retryPeriod(operationerrors.ClassOf(reconcileErr), retryCycleStartTime, (*config.RetrySyncPeriod).Duration, time.Now()); ok {
+			return true, duration
+		}
 	}
 
 	var (
@@ -162,7 +194,8 @@ type ControlInterface interface {
 	// Implementors should sink any errors that they do not wish to trigger a retry, and they may feel free to
 	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
 	// The bool return value determines whether the Shoot should be automatically requeued for reconciliation.
-	ReconcileShoot(shoot *gardenv1beta1.Shoot, key string) (bool, error)
+	// Implementors should abort the operation once the given context has been canceled.
+	ReconcileShoot(ctx context.Context, shoot *gardenv1beta1.Shoot, key string) (bool, error)
 }
 
 // NewDefaultControl returns a new instance of the default implementation ControlInterface that
@@ -185,7 +218,7 @@ type defaultControl struct {
 	updater            UpdaterInterface
 }
 
-func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key string) (bool, error) {
+func (c *defaultControl) ReconcileShoot(ctx context.Context, shootObj *gardenv1beta1.Shoot, key string) (bool, error) {
 	key, err := cache.MetaNamespaceKeyFunc(shootObj)
 	if err != nil {
 		return true, err
@@ -233,13 +266,19 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 			shootLogger.Errorf("Could not update the Shoot status after deletion start: %+v", updateErr)
 			return true, updateErr
 		}
-		if deleteErr := c.deleteShoot(operation); deleteErr != nil {
+		if deleteErr := c.deleteShoot(ctx, operation); deleteErr != nil {
+			// A canceled deletion is neither an error of the Shoot nor does it count against the retry duration. It is
+			// resumed with the next reconciliation.
+			if ctx.Err() != nil {
+				shootLogger.Infof("Deletion of the Shoot cluster has been canceled: %s", deleteErr.Description)
+				return true, ctx.Err()
+			}
 			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.EventDeleteError, "[%s] %s", operationID, deleteErr.Description)
 			if state, updateErr := c.updateShootStatusDeleteError(operation, deleteErr); updateErr != nil {
 				shootLogger.Errorf("Could not update the Shoot status after deletion error: %+v", updateErr)
 				return state != gardenv1beta1.ShootLastOperationStateFailed, updateErr
 			}
-			return true, errors.New(deleteErr.Description)
+			return true, deleteErr.err()
 		}
 		c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.EventDeleted, "[%s] Deleted Shoot cluster", operationID)
 		if updateErr := c.updateShootStatusDeleteSuccess(operation); updateErr != nil {
@@ -249,19 +288,34 @@ func (c *defaultControl) ReconcileShoot(shootObj *gardenv1beta1.Shoot, key strin
 		return false, nil
 	}
 
+	// Shoots which depend on other Shoots (e.g. Shoots scheduled on a shooted Seed) are only reconciled after all of their
+	// dependencies have been reconciled successfully. The reconciliation is retried after the retry sync period.
+	if pending := pendingShootDependencies(c.k8sGardenInformers.Shoots().Lister(), shoot); len(pending) > 0 {
+		message := fmt.Sprintf("Waiting for the Shoots %s to be reconciled successfully", strings.Join(pending, ", "))
+		shootLogger.Info(message)
+		c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventDependenciesPending, "[%s] %s", operationID, message)
+		return true, operationerrors.Transient(errors.New(message))
+	}
+
 	// When a Shoot clusters deletion timestamp is not set we need to create/reconcile the cluster.
 	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.EventReconciling, "[%s] Reconciling Shoot cluster state", operationID)
 	if updateErr := c.updateShootStatusReconcileStart(operation, operationType); updateErr != nil {
 		shootLogger.Errorf("Could not update the Shoot status after reconciliation start: %+v", updateErr)
 		return true, updateErr
 	}
-	if reconcileErr := c.reconcileShoot(operation, operationType); reconcileErr != nil {
+	if reconcileErr := c.reconcileShoot(ctx, operation, operationType, operationID); reconcileErr != nil {
+		// A canceled reconciliation is neither an error of the Shoot nor does it count against the retry duration. The
+		// Shoot is reconciled again with its current state.
+		if ctx.Err() != nil {
+			shootLogger.Infof("Reconciliation of the Shoot cluster has been canceled: %s", reconcileErr.Description)
+			return true, ctx.Err()
+		}
 		c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.EventReconcileError, "[%s] %s", operationID, reconcileErr.Description)
 		if state, updateErr := c.updateShootStatusReconcileError(operation, operationType, reconcileErr); updateErr != nil {
 			shootLogger.Errorf("Could not update the Shoot status after reconciliation error: %+v", updateErr)
 			return state != gardenv1beta1.ShootLastOperationStateFailed, updateErr
 		}
-		return true, errors.New(reconcileErr.Description)
+		return true, reconcileErr.err()
 	}
 	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.EventReconciled, "[%s] Reconciled Shoot cluster state", operationID)
 	if updateErr := c.updateShootStatusReconcileSuccess(operation, operationType); updateErr != nil {
@@ -0,0 +1,128 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"sync"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+)
+
+// runningOperations keeps track of the operations which are currently running for Shoots so that they can be canceled
+// once they have been superseded, or once the controller is shutting down.
+type runningOperations struct {
+	lock       sync.Mutex
+	operations map[string]*runningOperation
+	stopped    bool
+}
+
+// runningOperation is an operation which is currently running for a Shoot.
+type runningOperation struct {
+	cancel context.CancelFunc
+}
+
+func newRunningOperations() *runningOperations {
+	return &runningOperations{operations: map[string]*runningOperation{}}
+}
+
+// start registers a new operation for the Shoot with the given <key>. It returns the context of the operation, and a
+// function which must be called once the operation has finished. The context is canceled right away if the operations
+// have already been stopped.
+func (r *runningOperations) start(key string) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	operation := &runningOperation{cancel: cancel}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.stopped {
+		cancel()
+	}
+	r.operations[key] = operation
+
+	return ctx, func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+
+		if r.operations[key] == operation {
+			delete(r.operations, key)
+		}
+		cancel()
+	}
+}
+
+// cancel cancels the operation which is running for the Shoot with the given <key>. It returns true if there was such
+// an operation.
+func (r *runningOperations) cancel(key string) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	operation, ok := r.operations[key]
+	if ok {
+		operation.cancel()
+	}
+	return ok
+}
+
+// stop cancels all running operations, and all operations which are started afterwards.
+func (r *runningOperations) stop() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.stopped = true
+	for _, operation := range r.operations {
+		operation.cancel()
+	}
+}
+
+// exclusiveOperations ensures that the operations of the Shoot controller which must not overlap for the same Shoot,
+// i.e. its reconciliations and deletions and its recovery drills, do not run at the same time.
+type exclusiveOperations struct {
+	lock    sync.Mutex
+	running map[string]string
+}
+
+func newExclusiveOperations() *exclusiveOperations {
+	return &exclusiveOperations{running: map[string]string{}}
+}
+
+// tryStart marks the <operation> as running for the Shoot with the given <key>. It returns a function which must be
+// called once the operation has finished. If another operation is already running for the Shoot, it returns nil and
+// the name of the running operation instead.
+func (e *exclusiveOperations) tryStart(key, operation string) (func(), string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if running, ok := e.running[key]; ok {
+		return nil, running
+	}
+	e.running[key] = operation
+
+	return func() {
+		e.lock.Lock()
+		defer e.lock.Unlock()
+
+		delete(e.running, key)
+	}, ""
+}
+
+// operationSuperseded checks whether the operation running for the <oldShoot> has been superseded by the changes of the
+// <newShoot>, i.e. whether its generation has changed (e.g., because its specification has been changed or its
+// deletion has been confirmed). A running deletion is never superseded.
+func operationSuperseded(oldShoot, newShoot *gardenv1beta1.Shoot) bool {
+	return newShoot.Generation != oldShoot.Generation && !common.CheckConfirmationDeletionTimestampValid(oldShoot.ObjectMeta)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/shoot"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("cancel", func() {
+	Describe("#runningOperations", func() {
+		It("should cancel the running operation of a Shoot", func() {
+			start, cancel, _ := ExportRunningOperations()
+
+			ctxA, doneA := start("garden-foo/a")
+			ctxB, doneB := start("garden-foo/b")
+			defer doneB()
+
+			Expect(cancel("garden-foo/a")).To(BeTrue())
+			Expect(ctxA.Err()).To(HaveOccurred())
+			Expect(ctxB.Err()).NotTo(HaveOccurred())
+
+			doneA()
+			Expect(cancel("garden-foo/a")).To(BeFalse())
+		})
+
+		It("should cancel all running and future operations once stopped", func() {
+			start, _, stop := ExportRunningOperations()
+
+			ctxA, doneA := start("garden-foo/a")
+			defer doneA()
+
+			stop()
+			Expect(ctxA.Err()).To(HaveOccurred())
+
+			ctxB, doneB := start("garden-foo/b")
+			defer doneB()
+			Expect(ctxB.Err()).To(HaveOccurred())
+		})
+	})
+
+	Describe("#exclusiveOperations", func() {
+		It("should not start a second operation for the same Shoot", func() {
+			tryStart := ExportExclusiveOperations()
+
+			finished, running := tryStart("garden-foo/a", "recovery drill")
+			Expect(finished).NotTo(BeNil())
+			Expect(running).To(BeEmpty())
+
+			blocked, running := tryStart("garden-foo/a", "reconciliation")
+			Expect(blocked).To(BeNil())
+			Expect(running).To(Equal("recovery drill"))
+
+			other, _ := tryStart("garden-foo/b", "reconciliation")
+			Expect(other).NotTo(BeNil())
+			other()
+
+			finished()
+			started, _ := tryStart("garden-foo/a", "reconciliation")
+			Expect(started).NotTo(BeNil())
+			started()
+		})
+	})
+
+	Describe("#operationSuperseded", func() {
+		var oldShoot *gardenv1beta1.Shoot
+
+		BeforeEach(func() {
+			oldShoot = &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		})
+
+		It("should not supersede the operation if the generation did not change", func() {
+			newShoot := oldShoot.DeepCopy()
+			newShoot.Status.ObservedGeneration = 2
+
+			Expect(ExportOperationSuperseded(oldShoot, newShoot)).To(BeFalse())
+		})
+
+		It("should supersede a reconciliation if the generation changed", func() {
+			newShoot := oldShoot.DeepCopy()
+			newShoot.Generation = 3
+
+			Expect(ExportOperationSuperseded(oldShoot, newShoot)).To(BeTrue())
+		})
+
+		It("should not supersede a confirmed deletion", func() {
+			now := metav1.NewTime(time.Now().Truncate(time.Second))
+			oldShoot.DeletionTimestamp = &now
+			oldShoot.Annotations = map[string]string{common.ConfirmationDeletionTimestamp: now.Format(time.RFC3339)}
+			newShoot := oldShoot.DeepCopy()
+			newShoot.Generation = 3
+
+			Expect(ExportOperationSuperseded(oldShoot, newShoot)).To(BeFalse())
+		})
+	})
+})
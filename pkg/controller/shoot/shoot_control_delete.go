@@ -15,6 +15,7 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -25,7 +26,9 @@ import (
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
 	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/machinecredentials"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	corev1 "k8s.io/api/core/v1"
@@ -35,8 +38,9 @@ import (
 )
 
 // deleteShoot deletes a Shoot cluster entirely.
-// It receives a Garden object <garden> which stores the Shoot object.
-func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.LastError {
+// It receives a Garden object <garden> which stores the Shoot object. The deletion is aborted once the given <ctx> has
+// been canceled.
+func (c *defaultControl) deleteShoot(ctx context.Context, o *operation.Operation) *operationError {
 	// If the .status.uid field is empty, then we assume that there has never been any operation running for this Shoot
 	// cluster. This implies that there can not be any resource which we have to delete. We accept the deletion.
 	if len(o.Shoot.Info.Status.UID) == 0 {
@@ -49,6 +53,7 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 	if err != nil {
 		return formatError("Failed to create a Botanist", err)
 	}
+	botanist.Extensions = c.config.Controllers.Shoot.Extensions
 
 	// We first check whether the namespace in the Seed cluster does exist - if it does not, then we assume that
 	// all resources have already been deleted. We can delete the Shoot resource as a consequence.
@@ -73,6 +78,18 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 	if err != nil {
 		return formatError("Failed to create a HybridBotanist", err)
 	}
+	configureMachineWait(hybridBotanist, c.config.Controllers.Shoot, o.Shoot.Info)
+	if timeout := c.config.Controllers.Shoot.NodeDrainTimeout; timeout != nil {
+		hybridBotanist.NodeDrainTimeout = timeout.Duration
+	}
+	if concurrency := c.config.Controllers.Shoot.MachineForceDeletionConcurrency; concurrency != nil {
+		hybridBotanist.MachineForceDeletionConcurrency = *concurrency
+	}
+	issuer, err := machinecredentials.NewIssuer(c.config.Controllers.Shoot.MachineCredentials)
+	if err != nil {
+		return formatError("Failed to create the issuer of the machine credentials", err)
+	}
+	hybridBotanist.MachineCredentialsIssuer = issuer
 
 	// We check whether the Shoot namespace in the Seed cluster is already in a terminating state, i.e. whether
 	// we have tried to delete it in a previous run. In that case, we do not need to cleanup Shoot resource because
@@ -99,8 +116,10 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 		cleanupShootResources   = nonTerminatingNamespace && kubeAPIServerFound
 		defaultRetry            = 30 * time.Second
 		isCloud                 = o.Shoot.Info.Spec.Cloud.Local == nil
+		simulatesMachines       = o.Shoot.SimulatesMachines()
+		hasPassiveReplica       = len(o.Shoot.PassiveReplicaSeedName()) > 0
 
-		f = flow.New("Shoot cluster deletion").SetProgressReporter(o.ReportShootProgress).SetLogger(o.Logger)
+		f = flow.New("Shoot cluster deletion").SetContext(ctx).SetProgressReporter(o.ReportShootProgress).SetStepReporter(o.ReportShootStep).SetLogger(o.Logger)
 
 		// We need to ensure that the deployed cloud provider secret is up-to-date. In case it has changed then we
 		// need to redeploy the cloud provider config (containing the secrets for some cloud providers) as well as
@@ -127,7 +146,11 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 		// ahead and trigger the infrastructure deletion.
 		cleanCustomResourceDefinitions = f.AddTaskConditional(botanist.CleanCustomResourceDefinitions, 5*time.Minute, cleanupShootResources, waitUntilKubeAddonManagerDeleted)
 		cleanKubernetesResources       = f.AddTaskConditional(botanist.CleanKubernetesResources, 5*time.Minute, cleanupShootResources, cleanCustomResourceDefinitions)
-		destroyMachines                = f.AddTaskConditional(hybridBotanist.DestroyMachines, defaultRetry, isCloud, cleanKubernetesResources)
+		deleteClusterAutoscaler        = f.AddTask(botanist.DeleteClusterAutoscaler, defaultRetry, cleanKubernetesResources)
+		// The fake machine-controller-manager of Shoots which simulate their machines runs in the Gardener, hence, it is
+		// started again in case the Gardener has been restarted since the last reconciliation.
+		startMachineControllerManager  = f.AddTaskConditional(botanist.DeployMachineControllerManager, defaultRetry, simulatesMachines)
+		destroyMachines                = f.AddContextTaskConditional(hybridBotanist.DestroyMachines, defaultRetry, isCloud || simulatesMachines, cleanKubernetesResources, deleteClusterAutoscaler, startMachineControllerManager)
 		destroyNginxIngressResources   = f.AddTask(botanist.DestroyIngressDNSRecord, 0, cleanKubernetesResources)
 		destroyKube2IAMResources       = f.AddTask(shootCloudBotanist.DestroyKube2IAMResources, 0, cleanKubernetesResources)
 		destroyInfrastructure          = f.AddTask(shootCloudBotanist.DestroyInfrastructure, 0, cleanKubernetesResources, destroyMachines)
@@ -147,13 +170,17 @@ func (c *defaultControl) deleteShoot(o *operation.Operation) *gardenv1beta1.Last
 
 		deleteBackupInfrastructure     = f.AddTask(botanist.DeleteBackupInfrastructure, 0, deleteKubeAPIServer, deployBackupInfrastructure)
 		destroyInternalDomainDNSRecord = f.AddTask(botanist.DestroyInternalDomainDNSRecord, 0, syncPointTerraformers)
-		deleteNamespace                = f.AddTask(botanist.DeleteNamespace, defaultRetry, syncPointTerraformers, destroyInternalDomainDNSRecord, deleteBackupInfrastructure, deleteKubeAPIServer)
+		deleteExtensions               = f.AddContextTask(botanist.DeleteExtensions, defaultRetry, syncPointTerraformers)
+		deleteRecoveryDrillNamespace   = f.AddTask(hybridBotanist.DeleteEtcdRecoveryDrillNamespace, defaultRetry)
+		deleteNamespace                = f.AddTask(botanist.DeleteNamespace, defaultRetry, syncPointTerraformers, destroyInternalDomainDNSRecord, deleteBackupInfrastructure, deleteKubeAPIServer, deleteExtensions, deleteRecoveryDrillNamespace)
 		_                              = f.AddTask(botanist.WaitUntilSeedNamespaceDeleted, 0, deleteNamespace)
 		_                              = f.AddTask(botanist.DeleteGardenSecrets, defaultRetry, deleteNamespace)
+		_                              = f.AddTask(hybridBotanist.DeleteExternalWorkerUserData, defaultRetry, deleteNamespace)
+		_                              = f.AddTaskConditional(botanist.DeletePassiveControlPlaneReplica, defaultRetry, hasPassiveReplica, deleteBackupInfrastructure)
 	)
 	if e := f.Execute(); e != nil {
 		e.Description = fmt.Sprintf("Failed to delete Shoot cluster: %s", e.Description)
-		return e
+		return newFlowOperationError(f, e)
 	}
 
 	o.Logger.Infof("Successfully deleted Shoot cluster '%s'", o.Shoot.Info.Name)
@@ -194,12 +221,14 @@ func (c *defaultControl) updateShootStatusDeleteStart(o *operation.Operation) er
 func (c *defaultControl) updateShootStatusDeleteSuccess(o *operation.Operation) error {
 	o.Shoot.Info.Status.RetryCycleStartTime = nil
 	o.Shoot.Info.Status.LastError = nil
+	o.Shoot.Info.Status.LastErrors = nil
 	o.Shoot.Info.Status.LastOperation = &gardenv1beta1.LastOperation{
 		Type:           gardenv1beta1.ShootLastOperationTypeDelete,
 		State:          gardenv1beta1.ShootLastOperationStateSucceeded,
 		Progress:       100,
 		Description:    "Shoot cluster has been successfully deleted.",
 		LastUpdateTime: metav1.Now(),
+		Steps:          lastOperationSteps(o.Shoot.Info.Status.LastOperation),
 	}
 
 	newShoot, err := c.updater.UpdateShootStatus(o.Shoot.Info)
@@ -236,21 +265,24 @@ func (c *defaultControl) updateShootStatusDeleteSuccess(o *operation.Operation)
 	})
 }
 
-func (c *defaultControl) updateShootStatusDeleteError(o *operation.Operation, lastError *gardenv1beta1.LastError) (gardenv1beta1.ShootLastOperationState, error) {
+func (c *defaultControl) updateShootStatusDeleteError(o *operation.Operation, lastError *operationError) (gardenv1beta1.ShootLastOperationState, error) {
 	var (
 		state       = gardenv1beta1.ShootLastOperationStateFailed
 		description = lastError.Description
 	)
 
-	if !utils.TimeElapsed(o.Shoot.Info.Status.RetryCycleStartTime, c.config.Controllers.Shoot.RetryDuration.Duration) {
-		description += " Operation will be retried."
+	// Errors caused by the configuration of the Shoot cannot be resolved by retrying, hence, the operation fails right
+	// away and is only started again after the Shoot specification has been changed.
+	if operationerrors.HasRetriableErrorCodes(lastError.LastError) && !utils.TimeElapsed(o.Shoot.Info.Status.RetryCycleStartTime, c.config.Controllers.Shoot.RetryDuration.Duration) {
+		description += lastError.retryDescription()
 		state = gardenv1beta1.ShootLastOperationStateError
 	} else {
 		o.Shoot.Info.Status.RetryCycleStartTime = nil
 	}
 
 	o.Shoot.Info.Status.Gardener = *o.GardenerInfo
-	o.Shoot.Info.Status.LastError = lastError
+	o.Shoot.Info.Status.LastError = lastError.LastError
+	o.Shoot.Info.Status.LastErrors = lastError.lastErrors
 	o.Shoot.Info.Status.LastOperation.Type = gardenv1beta1.ShootLastOperationTypeDelete
 	o.Shoot.Info.Status.LastOperation.State = state
 	o.Shoot.Info.Status.LastOperation.Description = description
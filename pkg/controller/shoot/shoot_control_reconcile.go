@@ -15,51 +15,175 @@
 package shoot
 
 import (
+	"context"
 	"fmt"
 	"time"
 
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	"github.com/gardener/gardener/pkg/operation/etcdlock"
 	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/machinecredentials"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // reconcileShoot reconciles the Shoot cluster's state.
-// It receives a Garden object <garden> which stores the Shoot object and the operation type.
-func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) *gardenv1beta1.LastError {
+// It receives a Garden object <garden> which stores the Shoot object and the operation type. The reconciliation is
+// aborted once the given <ctx> has been canceled.
+func (c *defaultControl) reconcileShoot(ctx context.Context, o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType, operationID string) *operationError {
 	// We create the botanists (which will do the actual work).
+	botanist, seedCloudBotanist, shootCloudBotanist, hybridBotanist, lastError := newBotanists(o)
+	if lastError != nil {
+		return lastError
+	}
+	configureMachineWait(hybridBotanist, c.config.Controllers.Shoot, o.Shoot.Info)
+	if limit := c.config.Controllers.Shoot.SecretHistoryLimit; limit != nil {
+		botanist.SecretHistoryLimit = *limit
+	}
+	botanist.Extensions = c.config.Controllers.Shoot.Extensions
+	botanist.ControlPlaneResourceLimits = c.config.Controllers.Shoot.ControlPlaneResourceLimits
+	if gracePeriod := c.config.Controllers.Shoot.OrphanedMachineGracePeriod; gracePeriod != nil {
+		hybridBotanist.OrphanedMachineGracePeriod = gracePeriod.Duration
+	}
+	if gracePeriod := c.config.Controllers.Shoot.OrphanedNodeGracePeriod; gracePeriod != nil {
+		hybridBotanist.OrphanedNodeGracePeriod = gracePeriod.Duration
+	}
+	if concurrency := c.config.Controllers.Shoot.MachineForceDeletionConcurrency; concurrency != nil {
+		hybridBotanist.MachineForceDeletionConcurrency = *concurrency
+	}
+	issuer, err := machinecredentials.NewIssuer(c.config.Controllers.Shoot.MachineCredentials)
+	if err != nil {
+		return formatError("Failed to create the issuer of the machine credentials", err)
+	}
+	hybridBotanist.MachineCredentialsIssuer = issuer
+	hybridBotanist.MachineRolloutReporter = c.machineRolloutReporter(o, operationID)
+
+	f := newReconcileShootFlow(o, botanist, seedCloudBotanist, shootCloudBotanist, hybridBotanist).SetContext(ctx)
+	e := f.Execute()
+	if err := botanist.ReleaseEtcdOperationLock(); err != nil {
+		o.Logger.Errorf("Could not release the etcd operation lock of '%s': '%s'", o.Shoot.Info.Name, err.Error())
+	}
+	if e != nil {
+		e.Description = fmt.Sprintf("Failed to reconcile Shoot cluster state: %s", e.Description)
+		return newFlowOperationError(f, e)
+	}
+
+	// The secrets have been rolled back successfully, hence, the rollback must not be performed again.
+	if _, ok := o.Shoot.Info.Annotations[common.ShootRollbackSecrets]; ok {
+		if err := removeShootAnnotation(o, common.ShootRollbackSecrets); err != nil {
+			o.Logger.Errorf("Could not remove the annotation '%s' of '%s': '%s'", common.ShootRollbackSecrets, o.Shoot.Info.Name, err.Error())
+		}
+	}
+
+	// The requested restarts of the worker groups have been rolled out, hence, they must not be performed again.
+	if _, ok := o.Shoot.Info.Annotations[common.ShootRestartWorkers]; ok {
+		if err := removeShootAnnotation(o, common.ShootRestartWorkers); err != nil {
+			o.Logger.Errorf("Could not remove the annotation '%s' of '%s': '%s'", common.ShootRestartWorkers, o.Shoot.Info.Name, err.Error())
+		}
+	}
+
+	// The renamed worker groups have been migrated, hence, their old machine deployments have been removed.
+	if _, ok := o.Shoot.Info.Annotations[common.ShootRenameWorkers]; ok {
+		if err := removeShootAnnotation(o, common.ShootRenameWorkers); err != nil {
+			o.Logger.Errorf("Could not remove the annotation '%s' of '%s': '%s'", common.ShootRenameWorkers, o.Shoot.Info.Name, err.Error())
+		}
+	}
+
+	o.Shoot.Info.Status.Monitoring = botanist.ComputeShootMonitoring()
+	if cloudStatus, err := shootCloudBotanist.GetInfrastructureStatus(); err != nil {
+		o.Logger.Errorf("Could not read the infrastructure status of '%s': '%s'", o.Shoot.Info.Name, err.Error())
+	} else {
+		o.Shoot.Info.Status.Cloud = cloudStatus
+	}
+	if inventory, err := botanist.ComputeShootInventory(o.Shoot.Info.Status.Cloud); err != nil {
+		o.Logger.Errorf("Could not compute the inventory of '%s': '%s'", o.Shoot.Info.Name, err.Error())
+	} else {
+		o.Shoot.Info.Status.Inventory = inventory
+	}
+
+	// Register the Shoot as Seed cluster if it was annotated properly and in the garden namespace
+	if shootIsUsedAsSeed(o.Shoot.Info) {
+		if err := botanist.RegisterAsSeed(); err != nil {
+			o.Logger.Errorf("Could not register '%s' as Seed: '%s'", o.Shoot.Info.Name, err.Error())
+		}
+	} else {
+		if err := botanist.UnregisterAsSeed(); err != nil {
+			o.Logger.Errorf("Could not unregister '%s' as Seed: '%s'", o.Shoot.Info.Name, err.Error())
+		}
+	}
+
+	// The checksums of the reconciled desired state tell the reconcile plan which steps would change anything.
+	if err := c.recordReconcileChecksums(o.Shoot.Info, o.Seed.Info); err != nil {
+		o.Logger.Errorf("Could not record the reconciled checksums of '%s': '%s'", o.Shoot.Info.Name, err.Error())
+	}
+
+	o.Logger.Infof("Successfully reconciled Shoot cluster state '%s'", o.Shoot.Info.Name)
+	return nil
+}
+
+// newBotanists creates the botanists which are required to perform operations on the Shoot of the given
+// operation <o>.
+func newBotanists(o *operation.Operation) (*botanistpkg.Botanist, cloudbotanistpkg.CloudBotanist, cloudbotanistpkg.CloudBotanist, *hybridbotanistpkg.HybridBotanist, *operationError) {
 	botanist, err := botanistpkg.New(o)
 	if err != nil {
-		return formatError("Failed to create a Botanist", err)
+		return nil, nil, nil, nil, formatError("Failed to create a Botanist", err)
 	}
+	return withCloudBotanists(o, botanist)
+}
+
+// newPlanningBotanists creates the botanists for the Shoot of the given operation <o> which are required to compute
+// the steps of a flow. They do not initialize the clients of the Seed cluster, hence, the flow must not be executed.
+func newPlanningBotanists(o *operation.Operation) (*botanistpkg.Botanist, cloudbotanistpkg.CloudBotanist, cloudbotanistpkg.CloudBotanist, *hybridbotanistpkg.HybridBotanist, *operationError) {
+	return withCloudBotanists(o, botanistpkg.NewWithoutSeedClients(o))
+}
+
+// withCloudBotanists creates the cloud botanists and the hybrid botanist of the given operation <o> for the given
+// <botanist>.
+func withCloudBotanists(o *operation.Operation, botanist *botanistpkg.Botanist) (*botanistpkg.Botanist, cloudbotanistpkg.CloudBotanist, cloudbotanistpkg.CloudBotanist, *hybridbotanistpkg.HybridBotanist, *operationError) {
 	seedCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeSeed)
 	if err != nil {
-		return formatError("Failed to create a Seed CloudBotanist", err)
+		return nil, nil, nil, nil, formatError("Failed to create a Seed CloudBotanist", err)
 	}
 	shootCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeShoot)
 	if err != nil {
-		return formatError("Failed to create a Shoot CloudBotanist", err)
+		return nil, nil, nil, nil, formatError("Failed to create a Shoot CloudBotanist", err)
 	}
 	hybridBotanist, err := hybridbotanistpkg.New(o, botanist, seedCloudBotanist, shootCloudBotanist)
 	if err != nil {
-		return formatError("Failed to create a HybridBotanist", err)
+		return nil, nil, nil, nil, formatError("Failed to create a HybridBotanist", err)
 	}
+	return botanist, seedCloudBotanist, shootCloudBotanist, hybridBotanist, nil
+}
 
+// newReconcileShootFlow constructs the flow which reconciles the Shoot cluster's state. The flow is not executed.
+func newReconcileShootFlow(o *operation.Operation, botanist *botanistpkg.Botanist, seedCloudBotanist, shootCloudBotanist cloudbotanistpkg.CloudBotanist, hybridBotanist *hybridbotanistpkg.HybridBotanist) *flow.Flow {
 	var (
-		defaultRetry = 30 * time.Second
-		managedDNS   = o.Shoot.Info.Spec.DNS.Provider != gardenv1beta1.DNSUnmanaged
-		isCloud      = o.Shoot.Info.Spec.Cloud.Local == nil
+		defaultRetry      = 30 * time.Second
+		managedDNS        = o.Shoot.Info.Spec.DNS.Provider != gardenv1beta1.DNSUnmanaged
+		isCloud           = o.Shoot.Info.Spec.Cloud.Local == nil
+		simulatesMachines = o.Shoot.SimulatesMachines()
+		hasMachines       = isCloud || simulatesMachines
+		hasPassiveReplica = isCloud && len(o.Shoot.PassiveReplicaSeedName()) > 0
+		isCloneInCreation = isCloud && len(o.Shoot.CloneSourceName()) > 0
+		// The restore of the etcd of a clone and the hibernation must not overlap with an operation on the backups.
+		needsEtcdOperationLock = isCloud && len(botanist.EtcdOperationLockReason()) > 0
 
-		f                                    = flow.New("Shoot cluster creation").SetProgressReporter(o.ReportShootProgress).SetLogger(o.Logger)
+		f                                    = flow.New("Shoot cluster creation").SetProgressReporter(o.ReportShootProgress).SetStepReporter(o.ReportShootStep).SetLogger(o.Logger)
 		deployNamespace                      = f.AddTask(botanist.DeployNamespace, defaultRetry)
+		_                                    = f.AddTask(hybridBotanist.DeleteEtcdRecoveryDrillNamespace, defaultRetry)
+		deployNamespaceResourceLimits        = f.AddTask(botanist.DeployNamespaceResourceLimits, defaultRetry, deployNamespace)
 		deployKubeAPIServerService           = f.AddTask(botanist.DeployKubeAPIServerService, defaultRetry, deployNamespace)
 		waitUntilKubeAPIServerServiceIsReady = f.AddTaskConditional(botanist.WaitUntilKubeAPIServerServiceIsReady, 0, isCloud, deployKubeAPIServerService)
-		deploySecrets                        = f.AddTask(botanist.DeploySecrets, 0, waitUntilKubeAPIServerServiceIsReady)
+		deploySecrets                        = f.AddTask(botanist.DeploySecrets, 0, deployNamespaceResourceLimits, waitUntilKubeAPIServerServiceIsReady)
 		_                                    = f.AddTask(botanist.DeployInternalDomainDNSRecord, 0, waitUntilKubeAPIServerServiceIsReady)
 		_                                    = f.AddTaskConditional(botanist.DeployExternalDomainDNSRecord, 0, managedDNS)
 		deployInfrastructure                 = f.AddTask(shootCloudBotanist.DeployInfrastructure, 0, deploySecrets)
@@ -73,41 +197,37 @@ func (c *defaultControl) reconcileShoot(o *operation.Operation, operationType ga
 		moveBackupTerraformResources            = f.AddTaskConditional(botanist.MoveBackupTerraformResources, 0, isCloud, deployBackupNamespace)
 		deployBackupInfrastructure              = f.AddTaskConditional(botanist.DeployBackupInfrastructure, 0, isCloud, moveBackupTerraformResources)
 		waitUntilBackupInfrastructureReconciled = f.AddTaskConditional(botanist.WaitUntilBackupInfrastructureReconciled, 0, isCloud, deployBackupInfrastructure)
-		deployETCD                              = f.AddTask(hybridBotanist.DeployETCD, defaultRetry, deploySecrets, waitUntilBackupInfrastructureReconciled)
+		acquireEtcdOperationLock                = f.AddTaskConditional(botanist.AcquireEtcdOperationLock, etcdlock.DefaultLeaseDuration, needsEtcdOperationLock, deployNamespace, waitUntilBackupInfrastructureReconciled)
+		deployETCD                              = f.AddTask(hybridBotanist.DeployETCD, defaultRetry, deploySecrets, waitUntilBackupInfrastructureReconciled, acquireEtcdOperationLock)
+		_                                       = f.AddTaskConditional(hybridBotanist.DeployPassiveETCDReplica, defaultRetry, hasPassiveReplica, deployETCD)
 		deployCloudProviderConfig               = f.AddTask(hybridBotanist.DeployCloudProviderConfig, defaultRetry, deployInfrastructure)
+		reconcileExtensionsAfterInfrastructure  = f.AddContextTaskConditional(botanist.ReconcileExtensionsAfterInfrastructure, defaultRetry, botanist.HasExtensions(componentconfig.ShootExtensionPointAfterInfrastructure), deployInfrastructure)
 		deployKubeAPIServer                     = f.AddTask(hybridBotanist.DeployKubeAPIServer, defaultRetry, deploySecrets, deployETCD, waitUntilKubeAPIServerServiceIsReady, deployCloudProviderConfig)
 		_                                       = f.AddTask(hybridBotanist.DeployKubeControllerManager, defaultRetry, deploySecrets, deployCloudProviderConfig, deployKubeAPIServer)
 		_                                       = f.AddTask(hybridBotanist.DeployKubeScheduler, defaultRetry, deploySecrets, deployKubeAPIServer)
 		waitUntilKubeAPIServerIsReady           = f.AddTask(botanist.WaitUntilKubeAPIServerReady, 0, deployKubeAPIServer)
 		initializeShootClients                  = f.AddTask(botanist.InitializeShootClients, 2*time.Minute, waitUntilKubeAPIServerIsReady)
-		deployMachineControllerManager          = f.AddTaskConditional(botanist.DeployMachineControllerManager, defaultRetry, isCloud, initializeShootClients)
-		deployMachines                          = f.AddTaskConditional(hybridBotanist.DeployMachines, defaultRetry, isCloud, deployMachineControllerManager, deployInfrastructure, initializeShootClients)
+		deployMachineControllerManager          = f.AddTaskConditional(botanist.DeployMachineControllerManager, defaultRetry, hasMachines, initializeShootClients)
+		deleteClonedNodes                       = f.AddTaskConditional(botanist.DeleteClonedNodes, defaultRetry, isCloneInCreation, initializeShootClients)
+		reconcileExtensionsBeforeMachines       = f.AddContextTaskConditional(botanist.ReconcileExtensionsBeforeMachines, defaultRetry, botanist.HasExtensions(componentconfig.ShootExtensionPointBeforeMachines), reconcileExtensionsAfterInfrastructure, initializeShootClients)
+		deployMachines                          = f.AddContextTaskConditional(hybridBotanist.DeployMachines, defaultRetry, hasMachines, deployMachineControllerManager, deployInfrastructure, initializeShootClients, deleteClonedNodes, reconcileExtensionsBeforeMachines)
+		_                                       = f.AddTaskConditional(hybridBotanist.DeployClusterAutoscaler, defaultRetry, isCloud, deployMachines)
+		_                                       = f.AddTaskConditional(botanist.ReconcileNodeMetadata, defaultRetry, isCloud, deployMachines)
+		_                                       = f.AddTaskConditional(hybridBotanist.CollectOrphanedMachines, defaultRetry, hasMachines, deployMachines)
+		_                                       = f.AddTaskConditional(hybridBotanist.ReconcileMachinePriorities, defaultRetry, hasMachines, deployMachines)
 		deployKubeAddonManager                  = f.AddTask(hybridBotanist.DeployKubeAddonManager, defaultRetry, initializeShootClients, deployInfrastructure)
+		_                                       = f.AddTaskConditional(hybridBotanist.DeployExternalWorkerUserData, defaultRetry, isCloud, deployKubeAddonManager)
+		_                                       = f.AddContextTaskConditional(botanist.ReconcileExtensionsAfterAddons, defaultRetry, botanist.HasExtensions(componentconfig.ShootExtensionPointAfterAddons), deployKubeAddonManager, reconcileExtensionsBeforeMachines)
 		_                                       = f.AddTask(shootCloudBotanist.DeployKube2IAMResources, defaultRetry, deployInfrastructure)
+		_                                       = f.AddTask(botanist.DeployNetworkPolicies, defaultRetry, initializeShootClients)
 		_                                       = f.AddTaskConditional(botanist.EnsureIngressDNSRecord, 10*time.Minute, managedDNS, deployKubeAddonManager)
-		waitUntilVPNConnectionExists            = f.AddTaskConditional(botanist.WaitUntilVPNConnectionExists, 0, !o.Shoot.Hibernated, deployKubeAddonManager, deployMachines)
+		waitUntilCriticalComponentsReady        = f.AddTaskConditional(botanist.WaitUntilCriticalComponentsReady, 0, isCloud && !o.Shoot.Hibernated, deployKubeAddonManager, deployMachines)
+		waitUntilVPNConnectionExists            = f.AddTaskConditional(botanist.WaitUntilVPNConnectionExists, 0, !o.Shoot.Hibernated && !simulatesMachines, deployKubeAddonManager, deployMachines, waitUntilCriticalComponentsReady)
 		applyCreateHook                         = f.AddTask(seedCloudBotanist.ApplyCreateHook, defaultRetry, waitUntilVPNConnectionExists)
 		_                                       = f.AddTask(botanist.DeploySeedMonitoring, defaultRetry, waitUntilKubeAPIServerIsReady, initializeShootClients, waitUntilVPNConnectionExists, deployMachines, applyCreateHook)
 	)
 
-	if e := f.Execute(); e != nil {
-		e.Description = fmt.Sprintf("Failed to reconcile Shoot cluster state: %s", e.Description)
-		return e
-	}
-
-	// Register the Shoot as Seed cluster if it was annotated properly and in the garden namespace
-	if shootIsUsedAsSeed(o.Shoot.Info) {
-		if err := botanist.RegisterAsSeed(); err != nil {
-			o.Logger.Errorf("Could not register '%s' as Seed: '%s'", o.Shoot.Info.Name, err.Error())
-		}
-	} else {
-		if err := botanist.UnregisterAsSeed(); err != nil {
-			o.Logger.Errorf("Could not unregister '%s' as Seed: '%s'", o.Shoot.Info.Name, err.Error())
-		}
-	}
-
-	o.Logger.Infof("Successfully reconciled Shoot cluster state '%s'", o.Shoot.Info.Name)
-	return nil
+	return f
 }
 
 func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) error {
@@ -127,6 +247,9 @@ func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation,
 	}
 
 	o.Shoot.Info.Status.Conditions = nil
+	if condition := helper.GetCondition(status.Conditions, gardenv1beta1.ShootCloudProfileCompliant); condition != nil {
+		o.Shoot.Info.Status.Conditions = []gardenv1beta1.Condition{*condition}
+	}
 	o.Shoot.Info.Status.Gardener = *(o.GardenerInfo)
 	o.Shoot.Info.Status.ObservedGeneration = o.Shoot.Info.Generation
 	o.Shoot.Info.Status.LastOperation = &gardenv1beta1.LastOperation{
@@ -144,16 +267,42 @@ func (c *defaultControl) updateShootStatusReconcileStart(o *operation.Operation,
 	return err
 }
 
+// machineRolloutReporter returns a function which stores the progress of the rollout of the machines in the Shoot
+// status. It emits an event on the Shoot whenever the phase of the rollout changes or the machine-controller-manager
+// reports a new error.
+func (c *defaultControl) machineRolloutReporter(o *operation.Operation, operationID string) func(*gardenv1beta1.ShootMachinesStatus) {
+	return func(status *gardenv1beta1.ShootMachinesStatus) {
+		oldStatus := o.Shoot.Info.Status.Machines
+		o.ReportShootMachines(status)
+
+		if oldStatus == nil || oldStatus.Phase != status.Phase {
+			switch status.Phase {
+			case gardenv1beta1.MachineRolloutPhaseProgressing:
+				c.recorder.Eventf(o.Shoot.Info, corev1.EventTypeNormal, gardenv1beta1.ShootEventMachineRolloutProgressing, "[%s] Rolling out machines", operationID)
+			case gardenv1beta1.MachineRolloutPhaseAvailable:
+				c.recorder.Eventf(o.Shoot.Info, corev1.EventTypeNormal, gardenv1beta1.ShootEventMachineRolloutAvailable, "[%s] All machines have been rolled out", operationID)
+			case gardenv1beta1.MachineRolloutPhaseFailed:
+				c.recorder.Eventf(o.Shoot.Info, corev1.EventTypeWarning, gardenv1beta1.ShootEventMachineRolloutFailed, "[%s] Machines have not become available in time", operationID)
+			}
+		}
+		if len(status.LastError) > 0 && (oldStatus == nil || oldStatus.LastError != status.LastError) {
+			c.recorder.Eventf(o.Shoot.Info, corev1.EventTypeWarning, gardenv1beta1.ShootEventMachineRolloutError, "[%s] %s", operationID, status.LastError)
+		}
+	}
+}
+
 func (c *defaultControl) updateShootStatusReconcileSuccess(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType) error {
 	o.Shoot.Info.Status.RetryCycleStartTime = nil
 	o.Shoot.Info.Status.Seed = o.Seed.Info.Name
 	o.Shoot.Info.Status.LastError = nil
+	o.Shoot.Info.Status.LastErrors = nil
 	o.Shoot.Info.Status.LastOperation = &gardenv1beta1.LastOperation{
 		Type:           operationType,
 		State:          gardenv1beta1.ShootLastOperationStateSucceeded,
 		Progress:       100,
 		Description:    "Shoot cluster state has been successfully reconciled.",
 		LastUpdateTime: metav1.Now(),
+		Steps:          lastOperationSteps(o.Shoot.Info.Status.LastOperation),
 	}
 
 	newShoot, err := c.updater.UpdateShootStatus(o.Shoot.Info)
@@ -163,7 +312,7 @@ func (c *defaultControl) updateShootStatusReconcileSuccess(o *operation.Operatio
 	return err
 }
 
-func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType, lastError *gardenv1beta1.LastError) (gardenv1beta1.ShootLastOperationState, error) {
+func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation, operationType gardenv1beta1.ShootLastOperationType, lastError *operationError) (gardenv1beta1.ShootLastOperationState, error) {
 	var (
 		state         = gardenv1beta1.ShootLastOperationStateFailed
 		description   = lastError.Description
@@ -171,8 +320,10 @@ func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation,
 		progress      = 1
 	)
 
-	if !utils.TimeElapsed(o.Shoot.Info.Status.RetryCycleStartTime, c.config.Controllers.Shoot.RetryDuration.Duration) {
-		description += " Operation will be retried."
+	// Errors caused by the configuration of the Shoot cannot be resolved by retrying, hence, the operation fails right
+	// away and is only started again after the Shoot specification has been changed.
+	if operationerrors.HasRetriableErrorCodes(lastError.LastError) && !utils.TimeElapsed(o.Shoot.Info.Status.RetryCycleStartTime, c.config.Controllers.Shoot.RetryDuration.Duration) {
+		description += lastError.retryDescription()
 		state = gardenv1beta1.ShootLastOperationStateError
 	} else {
 		o.Shoot.Info.Status.RetryCycleStartTime = nil
@@ -182,13 +333,15 @@ func (c *defaultControl) updateShootStatusReconcileError(o *operation.Operation,
 		progress = lastOperation.Progress
 	}
 
-	o.Shoot.Info.Status.LastError = lastError
+	o.Shoot.Info.Status.LastError = lastError.LastError
+	o.Shoot.Info.Status.LastErrors = lastError.lastErrors
 	o.Shoot.Info.Status.LastOperation = &gardenv1beta1.LastOperation{
 		Type:           operationType,
 		State:          state,
 		Progress:       progress,
 		Description:    description,
 		LastUpdateTime: metav1.Now(),
+		Steps:          lastOperationSteps(lastOperation),
 	}
 	o.Shoot.Info.Status.Gardener = *(o.GardenerInfo)
 
@@ -0,0 +1,300 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// eventFeedConfigMapKey is the key of the event feed config map which contains the entries.
+	eventFeedConfigMapKey = "events"
+	// eventFeedLimit is the maximum number of entries kept in the event feed of a Shoot.
+	eventFeedLimit = 500
+
+	// eventFeedSourceGardener is the source of the entries which have been recorded by the Gardener.
+	eventFeedSourceGardener = "gardener"
+	// eventFeedSourceControlPlane is the source of the entries which have been recorded in the namespace of the Shoot
+	// in the Seed cluster.
+	eventFeedSourceControlPlane = "control-plane"
+)
+
+// eventFeedReasons are the reasons of the events recorded by the Gardener which are added to the event feeds.
+var eventFeedReasons = map[string]bool{
+	gardenv1beta1.EventReconciling:                    true,
+	gardenv1beta1.EventReconciled:                     true,
+	gardenv1beta1.EventReconcileError:                 true,
+	gardenv1beta1.EventDeleting:                       true,
+	gardenv1beta1.EventDeleted:                        true,
+	gardenv1beta1.EventDeleteError:                    true,
+	gardenv1beta1.ShootEventMaintenanceDone:           true,
+	gardenv1beta1.ShootEventMaintenanceError:          true,
+	gardenv1beta1.ShootEventMachineRolloutProgressing: true,
+	gardenv1beta1.ShootEventMachineRolloutAvailable:   true,
+	gardenv1beta1.ShootEventMachineRolloutError:       true,
+	gardenv1beta1.ShootEventMachineRolloutFailed:      true,
+	gardenv1beta1.ShootEventNetworkCapacityLow:        true,
+	gardenv1beta1.ShootEventHealthChanged:             true,
+	gardenv1beta1.ShootEventMachinesReplaced:          true,
+	gardenv1beta1.ShootEventRecoveryDrillPassed:       true,
+	gardenv1beta1.ShootEventRecoveryDrillFailed:       true,
+}
+
+// eventFeedEntry is an entry of the event feed of a Shoot.
+type eventFeedEntry struct {
+	Timestamp metav1.Time `json:"timestamp"`
+	Source    string      `json:"source"`
+	Type      string      `json:"type"`
+	Reason    string      `json:"reason"`
+	Object    string      `json:"object,omitempty"`
+	Message   string      `json:"message"`
+}
+
+// EventFeed maintains the event feeds of the Shoots. The event feed of a Shoot is a chronological list of what the
+// Gardener has done to the Shoot (reconciliations, maintenance operations, health transitions, machine replacements,
+// ...) and of the warnings of its control plane. It is stored in the <shoot-name>.events config map in the namespace
+// of the Shoot in the Garden cluster, so that it is readable by the members of the project.
+type EventFeed struct {
+	k8sGardenClient kubernetes.Client
+	retention       time.Duration
+
+	lock sync.Mutex
+}
+
+// NewEventFeed returns a new EventFeed which keeps the entries for the given <retention>. It returns nil (which
+// disables the event feeds) if the <retention> is not positive.
+func NewEventFeed(k8sGardenClient kubernetes.Client, retention time.Duration) *EventFeed {
+	if retention <= 0 {
+		return nil
+	}
+	return &EventFeed{
+		k8sGardenClient: k8sGardenClient,
+		retention:       retention,
+	}
+}
+
+// eventFeedConfigMapName returns the name of the config map which contains the event feed of the Shoot <shootName>.
+func eventFeedConfigMapName(shootName string) string {
+	return fmt.Sprintf("%s.events", shootName)
+}
+
+// RecordControlPlaneEvents adds the warning events of the control plane of the <shoot> which are not yet part of
+// its event feed.
+func (f *EventFeed) RecordControlPlaneEvents(shoot *gardenv1beta1.Shoot, events []corev1.Event) error {
+	if f == nil {
+		return nil
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.update(shoot, func(entries []eventFeedEntry) []eventFeedEntry {
+		return append(entries, controlPlaneFeedEntries(events, latestEntryTimestamp(entries, eventFeedSourceControlPlane))...)
+	})
+}
+
+// record adds an event recorded by the Gardener for the <object> to the event feed of the Shoot.
+func (f *EventFeed) record(object runtime.Object, timestamp metav1.Time, eventType, reason, message string) {
+	shoot, ok := object.(*gardenv1beta1.Shoot)
+	if f == nil || !ok || !eventFeedReasons[reason] {
+		return
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	entry := eventFeedEntry{
+		Timestamp: timestamp,
+		Source:    eventFeedSourceGardener,
+		Type:      eventType,
+		Reason:    reason,
+		Message:   message,
+	}
+	if err := f.update(shoot, func(entries []eventFeedEntry) []eventFeedEntry { return append(entries, entry) }); err != nil {
+		logger.Logger.Errorf("Could not add the event %s to the event feed of Shoot %s/%s: %s", reason, shoot.Namespace, shoot.Name, err.Error())
+	}
+}
+
+// Compact drops the entries of the event feed of the <shoot> which are older than the retention. The event feeds are
+// pruned whenever an entry is added, hence, only the feeds of Shoots without recent events must be compacted. The
+// config map is only written if entries are dropped.
+func (f *EventFeed) Compact(shoot *gardenv1beta1.Shoot) error {
+	if f == nil {
+		return nil
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	configMap, err := f.k8sGardenClient.Clientset().CoreV1().ConfigMaps(shoot.Namespace).Get(eventFeedConfigMapName(shoot.Name), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	var entries []eventFeedEntry
+	if err := json.Unmarshal([]byte(configMap.Data[eventFeedConfigMapKey]), &entries); err == nil && len(pruneEventFeed(entries, f.retention, eventFeedLimit, time.Now())) == len(entries) {
+		return nil
+	}
+	return f.update(shoot, func(entries []eventFeedEntry) []eventFeedEntry { return entries })
+}
+
+// update reads the event feed of the <shoot>, adds entries with the <add> function and writes the event feed
+// without the entries which are older than the retention.
+func (f *EventFeed) update(shoot *gardenv1beta1.Shoot, add func([]eventFeedEntry) []eventFeedEntry) error {
+	var (
+		configMaps = f.k8sGardenClient.Clientset().CoreV1().ConfigMaps(shoot.Namespace)
+		name       = eventFeedConfigMapName(shoot.Name)
+	)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(name, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		exists := err == nil
+
+		var entries []eventFeedEntry
+		if exists {
+			if data, ok := configMap.Data[eventFeedConfigMapKey]; ok {
+				if err := json.Unmarshal([]byte(data), &entries); err != nil {
+					logger.Logger.Warnf("Discarding the unreadable event feed of Shoot %s/%s: %s", shoot.Namespace, shoot.Name, err.Error())
+					entries = nil
+				}
+			}
+		} else {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: shoot.Namespace,
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(shoot, gardenv1beta1.SchemeGroupVersion.WithKind("Shoot")),
+					},
+				},
+			}
+		}
+
+		data, err := json.Marshal(pruneEventFeed(add(entries), f.retention, eventFeedLimit, time.Now()))
+		if err != nil {
+			return err
+		}
+		configMap.Data = map[string]string{eventFeedConfigMapKey: string(data)}
+
+		if exists {
+			_, err = configMaps.Update(configMap)
+		} else {
+			_, err = configMaps.Create(configMap)
+		}
+		return err
+	})
+}
+
+// pruneEventFeed sorts the <entries> chronologically and drops the entries which are older than the <retention>
+// (relative to <now>) as well as the oldest entries so that at most <limit> entries are kept.
+func pruneEventFeed(entries []eventFeedEntry, retention time.Duration, limit int, now time.Time) []eventFeedEntry {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(&entries[j].Timestamp)
+	})
+
+	var (
+		oldest = now.Add(-retention)
+		start  = sort.Search(len(entries), func(i int) bool { return !entries[i].Timestamp.Time.Before(oldest) })
+	)
+	if len(entries)-start > limit {
+		start = len(entries) - limit
+	}
+	return entries[start:]
+}
+
+// latestEntryTimestamp returns the timestamp of the latest of the <entries> with the given <source>.
+func latestEntryTimestamp(entries []eventFeedEntry, source string) time.Time {
+	var latest time.Time
+	for _, entry := range entries {
+		if entry.Source == source && entry.Timestamp.Time.After(latest) {
+			latest = entry.Timestamp.Time
+		}
+	}
+	return latest
+}
+
+// controlPlaneFeedEntries returns the event feed entries for the warning <events> of the control plane which have
+// last occurred after <since>.
+func controlPlaneFeedEntries(events []corev1.Event, since time.Time) []eventFeedEntry {
+	var entries []eventFeedEntry
+	for _, event := range events {
+		timestamp := event.LastTimestamp
+		if timestamp.IsZero() {
+			timestamp = event.FirstTimestamp
+		}
+		if event.Type != corev1.EventTypeWarning || !timestamp.Time.After(since) {
+			continue
+		}
+
+		entries = append(entries, eventFeedEntry{
+			Timestamp: timestamp,
+			Source:    eventFeedSourceControlPlane,
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Object:    fmt.Sprintf("%s/%s", event.InvolvedObject.Kind, event.InvolvedObject.Name),
+			Message:   event.Message,
+		})
+	}
+	return entries
+}
+
+// eventFeedRecorder is an event recorder which adds the recorded events of the Shoots to their event feeds.
+type eventFeedRecorder struct {
+	record.EventRecorder
+	feed *EventFeed
+}
+
+// NewEventFeedRecorder returns an event recorder which records the events with the given <recorder> and adds the
+// events of the Shoots to their event feeds maintained by <feed>. It returns the <recorder> if <feed> is nil.
+func NewEventFeedRecorder(recorder record.EventRecorder, feed *EventFeed) record.EventRecorder {
+	if feed == nil {
+		return recorder
+	}
+	return &eventFeedRecorder{recorder, feed}
+}
+
+func (r *eventFeedRecorder) Event(object runtime.Object, eventType, reason, message string) {
+	r.EventRecorder.Event(object, eventType, reason, message)
+	r.feed.record(object, metav1.Now(), eventType, reason, message)
+}
+
+func (r *eventFeedRecorder) Eventf(object runtime.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (r *eventFeedRecorder) PastEventf(object runtime.Object, timestamp metav1.Time, eventType, reason, messageFmt string, args ...interface{}) {
+	r.EventRecorder.PastEventf(object, timestamp, eventType, reason, messageFmt, args...)
+	r.feed.record(object, timestamp, eventType, reason, fmt.Sprintf(messageFmt, args...))
+}
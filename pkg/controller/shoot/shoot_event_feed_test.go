@@ -0,0 +1,127 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("event feed", func() {
+	now := time.Date(2018, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	entry := func(age time.Duration, reason string) ExportEventFeedEntry {
+		return ExportEventFeedEntry{Timestamp: metav1.NewTime(now.Add(-age)), Reason: reason}
+	}
+
+	Describe("#pruneEventFeed", func() {
+		It("should sort the entries chronologically", func() {
+			entries := []ExportEventFeedEntry{entry(time.Minute, "b"), entry(time.Hour, "a"), entry(0, "c")}
+
+			Expect(ExportPruneEventFeed(entries, 24*time.Hour, 10, now)).To(Equal([]ExportEventFeedEntry{
+				entry(time.Hour, "a"), entry(time.Minute, "b"), entry(0, "c"),
+			}))
+		})
+
+		It("should drop the entries which are older than the retention", func() {
+			entries := []ExportEventFeedEntry{entry(48*time.Hour, "a"), entry(25*time.Hour, "b"), entry(time.Hour, "c")}
+
+			Expect(ExportPruneEventFeed(entries, 24*time.Hour, 10, now)).To(Equal([]ExportEventFeedEntry{
+				entry(time.Hour, "c"),
+			}))
+		})
+
+		It("should drop the oldest entries above the limit", func() {
+			entries := []ExportEventFeedEntry{entry(3*time.Minute, "a"), entry(2*time.Minute, "b"), entry(time.Minute, "c")}
+
+			Expect(ExportPruneEventFeed(entries, 24*time.Hour, 2, now)).To(Equal([]ExportEventFeedEntry{
+				entry(2*time.Minute, "b"), entry(time.Minute, "c"),
+			}))
+		})
+	})
+
+	Describe("#controlPlaneFeedEntries", func() {
+		event := func(eventType string, age time.Duration, reason string) corev1.Event {
+			return corev1.Event{
+				InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "etcd-main-0"},
+				Type:           eventType,
+				Reason:         reason,
+				Message:        "message",
+				LastTimestamp:  metav1.NewTime(now.Add(-age)),
+			}
+		}
+
+		It("should only return the warnings which have occurred after the given time", func() {
+			events := []corev1.Event{
+				event(corev1.EventTypeWarning, 2*time.Hour, "Old"),
+				event(corev1.EventTypeNormal, time.Minute, "Scheduled"),
+				event(corev1.EventTypeWarning, time.Minute, "FailedScheduling"),
+			}
+
+			Expect(ExportControlPlaneFeedEntries(events, now.Add(-time.Hour))).To(Equal([]ExportEventFeedEntry{
+				{
+					Timestamp: metav1.NewTime(now.Add(-time.Minute)),
+					Source:    "control-plane",
+					Type:      corev1.EventTypeWarning,
+					Reason:    "FailedScheduling",
+					Object:    "Pod/etcd-main-0",
+					Message:   "message",
+				},
+			}))
+		})
+
+		It("should fall back to the first timestamp of the events", func() {
+			warning := event(corev1.EventTypeWarning, 0, "Unhealthy")
+			warning.LastTimestamp = metav1.Time{}
+			warning.FirstTimestamp = metav1.NewTime(now)
+
+			entries := ExportControlPlaneFeedEntries([]corev1.Event{warning}, now.Add(-time.Hour))
+
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Timestamp).To(Equal(metav1.NewTime(now)))
+		})
+	})
+
+	Describe("#eventFeedReasons", func() {
+		It("should contain the reconcile, maintenance, health and machine events", func() {
+			for _, reason := range []string{
+				gardenv1beta1.EventReconciled,
+				gardenv1beta1.ShootEventMaintenanceDone,
+				gardenv1beta1.ShootEventHealthChanged,
+				gardenv1beta1.ShootEventMachinesReplaced,
+			} {
+				Expect(ExportEventFeedReasons).To(HaveKey(reason))
+			}
+			Expect(ExportEventFeedReasons).NotTo(HaveKey(gardenv1beta1.ShootEventDependenciesPending))
+		})
+	})
+
+	Describe("#NewEventFeedRecorder", func() {
+		It("should return the given recorder if the event feed is disabled", func() {
+			recorder := record.NewFakeRecorder(1)
+
+			Expect(NewEventFeedRecorder(recorder, NewEventFeed(nil, 0))).To(BeIdenticalTo(recorder))
+		})
+	})
+})
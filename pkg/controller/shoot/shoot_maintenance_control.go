@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	corev1 "k8s.io/api/core/v1"
@@ -66,6 +67,10 @@ func (c *Controller) reconcileShootMaintenanceKey(key string) error {
 		logger.Logger.Infof("[SHOOT MAINTENANCE] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.shardFilter.Responsible(shoot) {
+		logger.Logger.Debugf("[SHOOT MAINTENANCE] %s - skipping because Shoot belongs to another shard", key)
+		return nil
+	}
 	if shoot.DeletionTimestamp != nil {
 		logger.Logger.Debugf("[SHOOT MAINTENANCE] %s - skipping because Shoot is marked as to be deleted", key)
 		return nil
@@ -117,7 +122,7 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 		}
 	)
 
-	currentTimeWithinTimeWindow, err := NowWithinTimeWindow(shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End, time.Now())
+	currentTimeWithinTimeWindow, err := utils.NowWithinTimeWindow(shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End, time.Now())
 	if err != nil {
 		handleError(err.Error())
 		return nil
@@ -154,6 +159,9 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 			case gardenv1beta1.CloudProviderOpenStack:
 				image := machineImage.(*gardenv1beta1.OpenStackMachineImage)
 				shoot.Spec.Cloud.OpenStack.MachineImage = image
+			case gardenv1beta1.CloudProviderPacket:
+				image := machineImage.(*gardenv1beta1.PacketMachineImage)
+				shoot.Spec.Cloud.Packet.MachineImage = image
 			}
 		}
 
@@ -169,6 +177,15 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 			}
 		}
 
+		// Reconcile the Shoot if the replacement of the machines of some of its worker groups has been deferred until
+		// the maintenance time window.
+		if shoot.Status.Machines != nil && len(shoot.Status.Machines.PendingReplacements) > 0 {
+			if shoot.Annotations == nil {
+				shoot.Annotations = map[string]string{}
+			}
+			shoot.Annotations[common.ShootOperation] = common.ShootOperationReconcile
+		}
+
 		// Update the Shoot resource object.
 		if _, err := c.updater.UpdateShoot(shoot); err != nil {
 			handleError(fmt.Sprintf("Could not update the Shoot specification: %s", err.Error()))
@@ -181,32 +198,3 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 
 	return nil
 }
-
-// NowWithinTimeWindow returns true in case the current time is within <begin> and <end>.
-// <begin> and <end> must be in the following format: HHMMSS+ZZZZ. In case one of the
-// times can not be parsed, an error is returned.
-func NowWithinTimeWindow(begin, end string, nowTime time.Time) (bool, error) {
-	maintenanceWindowBegin, err := utils.ParseMaintenanceTime(begin)
-	if err != nil {
-		return false, fmt.Errorf("Could not parse the maintenance time window begin value: %s", err.Error())
-	}
-	maintenanceWindowEnd, err := utils.ParseMaintenanceTime(end)
-	if err != nil {
-		return false, fmt.Errorf("Could not parse the maintenance time window end value: %s", err.Error())
-	}
-	now, err := utils.ParseMaintenanceTime(utils.FormatMaintenanceTime(nowTime))
-	if err != nil {
-		return false, fmt.Errorf("Could not parse the current time into the maintenance format: %s", err.Error())
-	}
-
-	// Handle time windows whose end is on a different day than the beginning.
-	if maintenanceWindowEnd.Sub(maintenanceWindowBegin) < 0 {
-		maintenanceWindowEnd = maintenanceWindowEnd.Add(24 * time.Hour)
-
-		if now.Sub(maintenanceWindowEnd) < 0 {
-			now = now.Add(24 * time.Hour)
-		}
-	}
-
-	return now.After(maintenanceWindowBegin) && now.Before(maintenanceWindowEnd), nil
-}
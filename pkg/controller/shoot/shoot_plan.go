@@ -0,0 +1,425 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+)
+
+// ReconcilePlanPath is the path of the HTTP endpoint which returns the reconcile plan of a Shoot.
+const ReconcilePlanPath = "/shoots/reconcile-plan"
+
+// ReconcilePlan is the ordered list of steps a reconciliation of a Shoot would execute for its current
+// specification.
+type ReconcilePlan struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Generation is the current generation of the Shoot.
+	Generation int64 `json:"generation"`
+	// ObservedGeneration is the generation of the Shoot which has been reconciled most recently.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// SpecChanged indicates whether the Shoot specification has changed since the last successful reconciliation.
+	SpecChanged bool `json:"specChanged"`
+	// ChangedSections are the sections of the desired state (e.g., 'kubernetes' or 'cloud') whose checksums differ
+	// from the ones of the last successful reconciliation.
+	ChangedSections []string `json:"changedSections,omitempty"`
+	// Steps is the ordered list of steps a reconciliation would execute.
+	Steps []ReconcilePlanStep `json:"steps"`
+}
+
+// ReconcilePlanStep is a single step of a reconcile plan.
+type ReconcilePlanStep struct {
+	flow.PlannedTask `json:",inline"`
+	// ExpectedToChange indicates whether the step is expected to modify any resources.
+	ExpectedToChange bool `json:"expectedToChange"`
+}
+
+// MachinePlanPath is the path of the HTTP endpoint which returns the machine plan of a Shoot.
+const MachinePlanPath = "/shoots/machine-plan"
+
+// SecretVersionsPath is the path of the HTTP endpoint which lists the retained versions of the generated secrets of
+// a Shoot.
+const SecretVersionsPath = "/shoots/secret-versions"
+
+// ReconcilePlanHandler is a HTTP handler which responds with the reconcile plan of the Shoot identified by the
+// 'namespace' and 'name' query parameters. None of the steps are executed.
+func (c *Controller) ReconcilePlanHandler(w http.ResponseWriter, r *http.Request) {
+	c.serveShootJSON(w, r, "reconcile plan", func(shoot *gardenv1beta1.Shoot) (interface{}, error) {
+		return c.computeReconcilePlan(shoot)
+	})
+}
+
+// MachinePlanHandler is a HTTP handler which responds with the machine classes and machine deployments the
+// reconciliation of the Shoot identified by the 'namespace' and 'name' query parameters would create, update and
+// delete in the Seed (see hybridbotanist.MachinePlan). None of the machine resources are changed. The plan tells
+// whether a change of the Shoot specification replaces existing machines.
+func (c *Controller) MachinePlanHandler(w http.ResponseWriter, r *http.Request) {
+	c.serveShootJSON(w, r, "machine plan", func(shoot *gardenv1beta1.Shoot) (interface{}, error) {
+		return c.computeMachinePlan(shoot)
+	})
+}
+
+// SecretVersionsHandler is a HTTP handler which responds with the retained versions of the bundles of generated
+// secrets of the Shoot identified by the 'namespace' and 'name' query parameters (see botanist.SecretVersions). The
+// secrets are not decrypted.
+func (c *Controller) SecretVersionsHandler(w http.ResponseWriter, r *http.Request) {
+	c.serveShootJSON(w, r, "secret versions", func(shoot *gardenv1beta1.Shoot) (interface{}, error) {
+		return c.listSecretVersions(shoot)
+	})
+}
+
+// serveShootJSON responds with the JSON encoding of the data of the given <kind> which <compute> computes for the
+// Shoot identified by the 'namespace' and 'name' query parameters of the request <r>.
+func (c *Controller) serveShootJSON(w http.ResponseWriter, r *http.Request, kind string, compute func(*gardenv1beta1.Shoot) (interface{}, error)) {
+	if r.Method != http.MethodGet {
+		http.Error(w, fmt.Sprintf("method %s not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var (
+		namespace = r.URL.Query().Get("namespace")
+		name      = r.URL.Query().Get("name")
+	)
+	if len(namespace) == 0 || len(name) == 0 {
+		http.Error(w, "query parameters 'namespace' and 'name' are required", http.StatusBadRequest)
+		return
+	}
+
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := compute(shoot.DeepCopy())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		logger.Logger.Errorf("Could not write the %s of Shoot '%s/%s': %s", kind, namespace, name, err.Error())
+	}
+}
+
+func (c *Controller) computeReconcilePlan(shoot *gardenv1beta1.Shoot) (*ReconcilePlan, error) {
+	shootLogger := logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, utils.GenerateRandomString(8))
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), c.identity, c.secrets, c.imageVector)
+	if err != nil {
+		return nil, err
+	}
+
+	// The steps are only computed, hence, the botanists must not connect to the Seed cluster.
+	botanist, seedCloudBotanist, shootCloudBotanist, hybridBotanist, lastError := newPlanningBotanists(o)
+	if lastError != nil {
+		return nil, errors.New(lastError.Description)
+	}
+	botanist.Extensions = c.config.Controllers.Shoot.Extensions
+	botanist.ControlPlaneResourceLimits = c.config.Controllers.Shoot.ControlPlaneResourceLimits
+
+	desired, err := desiredStateChecksums(shoot, o.Seed.Info, c.identity.Version, c.imageVector)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := readReconcileChecksums(c.k8sGardenClient, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return newReconcilePlan(shoot, newReconcileShootFlow(o, botanist, seedCloudBotanist, shootCloudBotanist, hybridBotanist).Plan(), desired, recorded), nil
+}
+
+func (c *Controller) computeMachinePlan(shoot *gardenv1beta1.Shoot) (*hybridbotanistpkg.MachinePlan, error) {
+	shootLogger := logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, utils.GenerateRandomString(8))
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), c.identity, c.secrets, c.imageVector)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, _, hybridBotanist, lastError := newBotanists(o)
+	if lastError != nil {
+		return nil, errors.New(lastError.Description)
+	}
+
+	return hybridBotanist.PlanMachines()
+}
+
+func (c *Controller) listSecretVersions(shoot *gardenv1beta1.Shoot) (*botanistpkg.SecretVersions, error) {
+	shootLogger := logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, utils.GenerateRandomString(8))
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), c.identity, c.secrets, c.imageVector)
+	if err != nil {
+		return nil, err
+	}
+
+	botanist, err := botanistpkg.New(o)
+	if err != nil {
+		return nil, err
+	}
+	return botanist.ListSecretVersions()
+}
+
+// newReconcilePlan computes the reconcile plan for the given <shoot> based on the <tasks> of the reconcile flow. A
+// step is expected to change something if it is not skipped, if it is not a read-only step (waiting for a condition or
+// initializing clients), and if the checksum of a section of the <desired> state which the step applies differs from
+// the one <recorded> by the last successful reconciliation. All steps are expected to change something if nothing has
+// been recorded or if the last operation did not succeed, as it might have been interrupted half-way.
+func newReconcilePlan(shoot *gardenv1beta1.Shoot, tasks []flow.PlannedTask, desired, recorded map[string]string) *ReconcilePlan {
+	var (
+		lastOperation   = shoot.Status.LastOperation
+		outOfSync       = recorded == nil || lastOperation == nil || lastOperation.State != gardenv1beta1.ShootLastOperationStateSucceeded
+		changedSections = sets.NewString()
+		steps           = make([]ReconcilePlanStep, 0, len(tasks))
+	)
+
+	for section, checksum := range desired {
+		if recorded[section] != checksum {
+			changedSections.Insert(section)
+		}
+	}
+
+	for _, task := range tasks {
+		changed := outOfSync
+		for _, section := range reconcileStepSections(task.Name) {
+			changed = changed || changedSections.Has(section)
+		}
+		steps = append(steps, ReconcilePlanStep{
+			PlannedTask:      task,
+			ExpectedToChange: changed && !task.Skipped && isMutatingStep(task.Name),
+		})
+	}
+
+	return &ReconcilePlan{
+		Namespace:          shoot.Namespace,
+		Name:               shoot.Name,
+		Generation:         shoot.Generation,
+		ObservedGeneration: shoot.Status.ObservedGeneration,
+		SpecChanged:        shoot.Generation != shoot.Status.ObservedGeneration,
+		ChangedSections:    changedSections.List(),
+		Steps:              steps,
+	}
+}
+
+func isMutatingStep(name string) bool {
+	functionName := stepFunctionName(name)
+	return !strings.HasPrefix(functionName, "WaitUntil") && functionName != "InitializeShootClients"
+}
+
+// stepFunctionName returns the name of the function of the step with the given <name>, e.g. 'DeployETCD' for
+// '(*HybridBotanist).DeployETCD'.
+func stepFunctionName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// The sections of the desired state of a Shoot. The state of the Gardener (its version and image vector) and of the
+// Seed affect every step.
+const (
+	desiredStateGardener    = "gardener"
+	desiredStateSeed        = "seed"
+	desiredStateCloud       = "cloud"
+	desiredStateDNS         = "dns"
+	desiredStateKubernetes  = "kubernetes"
+	desiredStateAddons      = "addons"
+	desiredStateBackup      = "backup"
+	desiredStateHibernation = "hibernation"
+	desiredStateAnnotations = "annotations"
+)
+
+// desiredStateOperationAnnotations are the annotations of a Shoot which request an operation of the next
+// reconciliation or which change what it deploys.
+var desiredStateOperationAnnotations = []string{common.ShootRollbackSecrets, common.ShootRestartWorkers, common.ShootRenameWorkers, common.GardenPurpose}
+
+// reconcileStepSectionsByFunction are the sections of the desired state which the steps of the reconcile flow apply,
+// by the names of their functions. Steps which are not listed apply all sections.
+var reconcileStepSectionsByFunction = map[string][]string{
+	"DeployNamespace":                {desiredStateCloud},
+	"DeployKubeAPIServerService":     {desiredStateCloud},
+	"DeployNamespaceResourceLimits":  {desiredStateCloud, desiredStateAnnotations},
+	"DeploySecrets":                  {desiredStateCloud, desiredStateDNS, desiredStateKubernetes, desiredStateAnnotations},
+	"DeployInternalDomainDNSRecord":  {desiredStateDNS},
+	"DeployExternalDomainDNSRecord":  {desiredStateDNS},
+	"EnsureIngressDNSRecord":         {desiredStateDNS},
+	"DeployInfrastructure":           {desiredStateCloud},
+	"DeployKube2IAMResources":        {desiredStateCloud},
+	"DeployCloudProviderConfig":      {desiredStateCloud},
+	"DeployNetworkPolicies":          {desiredStateCloud},
+	"DeployBackupNamespaceFromShoot": {desiredStateCloud, desiredStateBackup},
+	"MoveBackupTerraformResources":   {desiredStateCloud, desiredStateBackup},
+	"DeployBackupInfrastructure":     {desiredStateCloud, desiredStateBackup},
+	"DeployETCD":                     {desiredStateKubernetes, desiredStateBackup, desiredStateHibernation},
+	"DeployPassiveETCDReplica":       {desiredStateKubernetes, desiredStateBackup, desiredStateHibernation},
+	"DeployKubeAPIServer":            {desiredStateCloud, desiredStateDNS, desiredStateKubernetes, desiredStateHibernation},
+	"DeployKubeControllerManager":    {desiredStateCloud, desiredStateDNS, desiredStateKubernetes, desiredStateHibernation},
+	"DeployKubeScheduler":            {desiredStateCloud, desiredStateDNS, desiredStateKubernetes, desiredStateHibernation},
+	"DeployMachineControllerManager": {desiredStateCloud, desiredStateKubernetes, desiredStateHibernation, desiredStateAnnotations},
+	"DeployMachines":                 {desiredStateCloud, desiredStateKubernetes, desiredStateHibernation, desiredStateAnnotations},
+	"DeployClusterAutoscaler":        {desiredStateCloud, desiredStateKubernetes, desiredStateHibernation, desiredStateAnnotations},
+	"ReconcileNodeMetadata":          {desiredStateCloud, desiredStateKubernetes, desiredStateHibernation, desiredStateAnnotations},
+	"CollectOrphanedMachines":        {desiredStateCloud, desiredStateKubernetes, desiredStateHibernation, desiredStateAnnotations},
+	"ReconcileMachinePriorities":     {desiredStateCloud, desiredStateKubernetes, desiredStateHibernation, desiredStateAnnotations},
+	"DeployKubeAddonManager":         {desiredStateCloud, desiredStateDNS, desiredStateKubernetes, desiredStateAddons},
+	"DeployExternalWorkerUserData":   {desiredStateCloud, desiredStateDNS, desiredStateKubernetes, desiredStateAddons},
+}
+
+// reconcileStepSections returns the sections of the desired state which the step with the given <name> applies.
+func reconcileStepSections(name string) []string {
+	sections, ok := reconcileStepSectionsByFunction[stepFunctionName(name)]
+	if !ok {
+		return []string{desiredStateGardener, desiredStateSeed, desiredStateCloud, desiredStateDNS, desiredStateKubernetes, desiredStateAddons, desiredStateBackup, desiredStateHibernation, desiredStateAnnotations}
+	}
+	return append([]string{desiredStateGardener, desiredStateSeed}, sections...)
+}
+
+// desiredStateChecksums renders the sections of the desired state of the <shoot> on the <seed> which the steps of
+// the reconcile flow apply, and returns the checksums of the rendered sections. The state also comprises the
+// <gardenerVersion> and the <imageVector> which determine the deployed charts and images.
+func desiredStateChecksums(shoot *gardenv1beta1.Shoot, seed *gardenv1beta1.Seed, gardenerVersion string, imageVector imagevector.ImageVector) (map[string]string, error) {
+	annotations := map[string]string{}
+	for _, key := range desiredStateOperationAnnotations {
+		if value, ok := shoot.Annotations[key]; ok {
+			annotations[key] = value
+		}
+	}
+
+	sections := map[string]interface{}{
+		desiredStateGardener: map[string]interface{}{
+			"version":     gardenerVersion,
+			"imageVector": imageVector,
+		},
+		desiredStateSeed:        seed.Spec,
+		desiredStateCloud:       shoot.Spec.Cloud,
+		desiredStateDNS:         shoot.Spec.DNS,
+		desiredStateKubernetes:  shoot.Spec.Kubernetes,
+		desiredStateAddons:      shoot.Spec.Addons,
+		desiredStateBackup:      shoot.Spec.Backup,
+		desiredStateHibernation: shoot.Spec.Hibernation,
+		desiredStateAnnotations: annotations,
+	}
+
+	checksums := make(map[string]string, len(sections))
+	for section, state := range sections {
+		rendered, err := json.Marshal(state)
+		if err != nil {
+			return nil, err
+		}
+		checksums[section] = utils.ComputeSHA256Hex(rendered)
+	}
+	return checksums, nil
+}
+
+const reconcileChecksumsConfigMapKey = "checksums"
+
+// reconcileChecksumsConfigMapName returns the name of the config map which contains the checksums of the desired state
+// of the Shoot <shootName> which has been reconciled successfully most recently.
+func reconcileChecksumsConfigMapName(shootName string) string {
+	return fmt.Sprintf("%s.reconcile-checksums", shootName)
+}
+
+// readReconcileChecksums reads the checksums of the desired state of the <shoot> which have been recorded by the last
+// successful reconciliation. It returns nil if none have been recorded.
+func readReconcileChecksums(k8sGardenClient kubernetes.Client, shoot *gardenv1beta1.Shoot) (map[string]string, error) {
+	configMap, err := k8sGardenClient.Clientset().CoreV1().ConfigMaps(shoot.Namespace).Get(reconcileChecksumsConfigMapName(shoot.Name), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var checksums map[string]string
+	if err := json.Unmarshal([]byte(configMap.Data[reconcileChecksumsConfigMapKey]), &checksums); err != nil {
+		logger.Logger.Warnf("Discarding the unreadable reconcile checksums of Shoot %s/%s: %s", shoot.Namespace, shoot.Name, err.Error())
+		return nil, nil
+	}
+	return checksums, nil
+}
+
+// recordReconcileChecksums stores the checksums of the desired state of the <shoot> on the <seed> after it has been
+// reconciled successfully.
+func (c *defaultControl) recordReconcileChecksums(shoot *gardenv1beta1.Shoot, seed *gardenv1beta1.Seed) error {
+	checksums, err := desiredStateChecksums(shoot, seed, c.identity.Version, c.imageVector)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(checksums)
+	if err != nil {
+		return err
+	}
+
+	var (
+		configMaps = c.k8sGardenClient.Clientset().CoreV1().ConfigMaps(shoot.Namespace)
+		name       = reconcileChecksumsConfigMapName(shoot.Name)
+	)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(name, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		exists := err == nil
+
+		if !exists {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: shoot.Namespace,
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(shoot, gardenv1beta1.SchemeGroupVersion.WithKind("Shoot")),
+					},
+				},
+			}
+		}
+		configMap.Data = map[string]string{reconcileChecksumsConfigMapKey: string(data)}
+
+		if exists {
+			_, err = configMaps.Update(configMap)
+		} else {
+			_, err = configMaps.Create(configMap)
+		}
+		return err
+	})
+}
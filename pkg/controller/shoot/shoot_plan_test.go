@@ -0,0 +1,191 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/shoot"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("reconcile plan", func() {
+	var (
+		seed  *gardenv1beta1.Seed
+		shoot *gardenv1beta1.Shoot
+
+		images = imagevector.ImageVector{{Name: "etcd", Repository: "quay.io/coreos/etcd", Tag: "v3.3.9"}}
+
+		tasks = []flow.PlannedTask{
+			{Name: "(*Botanist).DeployNamespace"},
+			{Name: "(*Botanist).DeployInternalDomainDNSRecord", DependsOn: []string{"(*Botanist).DeployNamespace"}},
+			{Name: "(*HybridBotanist).DeployETCD", DependsOn: []string{"(*Botanist).DeployNamespace"}},
+			{Name: "(*Botanist).WaitUntilEtcdReady", DependsOn: []string{"(*HybridBotanist).DeployETCD"}},
+			{Name: "(*Botanist).DeployKube2IAMResources", Skipped: true},
+			{Name: "(*Botanist).DeploySeedMonitoring"},
+		}
+
+		checksums = func() map[string]string {
+			checksums, err := ExportDesiredStateChecksums(shoot, seed, "0.12.0", images)
+			Expect(err).NotTo(HaveOccurred())
+			return checksums
+		}
+
+		expectedToChange = func(plan *ReconcilePlan) []string {
+			var names []string
+			for _, step := range plan.Steps {
+				if step.ExpectedToChange {
+					names = append(names, step.Name)
+				}
+			}
+			return names
+		}
+	)
+
+	BeforeEach(func() {
+		seed = &gardenv1beta1.Seed{
+			Spec: gardenv1beta1.SeedSpec{IngressDomain: "ingress.seed.example.com"},
+		}
+		shoot = &gardenv1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "garden-dev", Generation: 2},
+			Spec: gardenv1beta1.ShootSpec{
+				Kubernetes: gardenv1beta1.Kubernetes{Version: "1.11.3"},
+			},
+			Status: gardenv1beta1.ShootStatus{
+				ObservedGeneration: 2,
+				LastOperation: &gardenv1beta1.LastOperation{
+					Type:  gardenv1beta1.ShootLastOperationTypeReconcile,
+					State: gardenv1beta1.ShootLastOperationStateSucceeded,
+				},
+			},
+		}
+	})
+
+	Describe("#desiredStateChecksums", func() {
+		It("should be stable for the same desired state", func() {
+			Expect(checksums()).To(Equal(checksums()))
+		})
+
+		It("should only change the checksum of the changed section", func() {
+			before := checksums()
+			shoot.Spec.Kubernetes.Version = "1.12.1"
+			after := checksums()
+
+			for section := range before {
+				if section == "kubernetes" {
+					Expect(after[section]).NotTo(Equal(before[section]))
+				} else {
+					Expect(after[section]).To(Equal(before[section]), section)
+				}
+			}
+		})
+
+		It("should only consider the annotations which request an operation", func() {
+			before := checksums()
+			shoot.Annotations = map[string]string{"foo": "bar"}
+			Expect(checksums()).To(Equal(before))
+
+			shoot.Annotations[common.ShootRollbackSecrets] = "true"
+			Expect(checksums()["annotations"]).NotTo(Equal(before["annotations"]))
+		})
+
+		It("should change the checksum of the Gardener for a different image vector", func() {
+			before := checksums()
+			after, err := ExportDesiredStateChecksums(shoot, seed, "0.12.0", imagevector.ImageVector{{Name: "etcd", Repository: "quay.io/coreos/etcd", Tag: "v3.3.10"}})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after["gardener"]).NotTo(Equal(before["gardener"]))
+		})
+	})
+
+	Describe("#newReconcilePlan", func() {
+		It("should not expect changes if the desired state equals the recorded one", func() {
+			plan := ExportNewReconcilePlan(shoot, tasks, checksums(), checksums())
+
+			Expect(plan.SpecChanged).To(BeFalse())
+			Expect(plan.ChangedSections).To(BeEmpty())
+			Expect(plan.Steps).To(HaveLen(len(tasks)))
+			Expect(expectedToChange(plan)).To(BeEmpty())
+		})
+
+		It("should only expect changes of the steps applying a changed section", func() {
+			recorded := checksums()
+			shoot.Spec.DNS.Domain = stringPtr("foo.example.com")
+
+			plan := ExportNewReconcilePlan(shoot, tasks, checksums(), recorded)
+
+			Expect(plan.ChangedSections).To(Equal([]string{"dns"}))
+			Expect(expectedToChange(plan)).To(Equal([]string{
+				"(*Botanist).DeployInternalDomainDNSRecord",
+				"(*Botanist).DeploySeedMonitoring",
+			}))
+		})
+
+		It("should expect changes of all mutating steps if the Seed changed", func() {
+			recorded := checksums()
+			seed.Spec.IngressDomain = "ingress.other-seed.example.com"
+
+			plan := ExportNewReconcilePlan(shoot, tasks, checksums(), recorded)
+
+			Expect(plan.ChangedSections).To(Equal([]string{"seed"}))
+			Expect(expectedToChange(plan)).To(Equal([]string{
+				"(*Botanist).DeployNamespace",
+				"(*Botanist).DeployInternalDomainDNSRecord",
+				"(*HybridBotanist).DeployETCD",
+				"(*Botanist).DeploySeedMonitoring",
+			}))
+		})
+
+		It("should expect changes of all mutating steps if nothing has been recorded", func() {
+			plan := ExportNewReconcilePlan(shoot, tasks, checksums(), nil)
+
+			Expect(expectedToChange(plan)).To(Equal([]string{
+				"(*Botanist).DeployNamespace",
+				"(*Botanist).DeployInternalDomainDNSRecord",
+				"(*HybridBotanist).DeployETCD",
+				"(*Botanist).DeploySeedMonitoring",
+			}))
+		})
+
+		It("should expect changes of all mutating steps if the last operation did not succeed", func() {
+			shoot.Status.LastOperation.State = gardenv1beta1.ShootLastOperationStateFailed
+
+			plan := ExportNewReconcilePlan(shoot, tasks, checksums(), checksums())
+
+			Expect(plan.ChangedSections).To(BeEmpty())
+			Expect(expectedToChange(plan)).To(HaveLen(4))
+		})
+
+		It("should report a changed specification by the generations", func() {
+			shoot.Generation = 3
+
+			plan := ExportNewReconcilePlan(shoot, tasks, checksums(), checksums())
+
+			Expect(plan.SpecChanged).To(BeTrue())
+			Expect(plan.Generation).To(Equal(int64(3)))
+			Expect(plan.ObservedGeneration).To(Equal(int64(2)))
+		})
+	})
+})
+
+func stringPtr(s string) *string {
+	return &s
+}
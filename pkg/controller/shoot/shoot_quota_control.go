@@ -48,6 +48,10 @@ func (c *Controller) reconcileShootQuotaKey(key string) error {
 		logger.Logger.Infof("[SHOOT QUOTA] %s - unable to retrieve object from store: %v", key, err)
 		return err
 	}
+	if !c.shardFilter.Responsible(shoot) {
+		logger.Logger.Debugf("[SHOOT QUOTA] %s - skipping because Shoot belongs to another shard", key)
+		return nil
+	}
 
 	if err := c.quotaControl.CheckQuota(shoot, key); err != nil {
 		c.shootQuotaQueue.AddAfter(key, 2*time.Minute)
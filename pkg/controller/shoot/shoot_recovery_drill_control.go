@@ -0,0 +1,381 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// recoveryDrillsConfigMapKey is the key of the recovery drills config map which contains the results.
+	recoveryDrillsConfigMapKey = "results"
+
+	// recoveryDrillPassed is the result of a recovery drill after which the Shoot has recovered in time.
+	recoveryDrillPassed = "Passed"
+	// recoveryDrillFailed is the result of a recovery drill after which the Shoot has not recovered in time.
+	recoveryDrillFailed = "Failed"
+)
+
+// recoveryDrillResult is the result of the most recent run of a recovery drill of a Shoot.
+type recoveryDrillResult struct {
+	Drill          string      `json:"drill"`
+	StartTime      metav1.Time `json:"startTime"`
+	CompletionTime metav1.Time `json:"completionTime"`
+	Result         string      `json:"result"`
+	Message        string      `json:"message,omitempty"`
+}
+
+// recoveryDrillsConfigMapName returns the name of the config map which contains the results of the recovery drills of
+// the Shoot <shootName>.
+func recoveryDrillsConfigMapName(shootName string) string {
+	return fmt.Sprintf("%s.recovery-drills", shootName)
+}
+
+func (c *Controller) shootRecoveryDrillAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.shootRecoveryDrillQueue.Add(key)
+}
+
+func (c *Controller) shootRecoveryDrillDelete(obj interface{}) {
+	shoot, ok := obj.(*gardenv1beta1.Shoot)
+	if shoot == nil || !ok {
+		return
+	}
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+	c.shootRecoveryDrillQueue.Done(key)
+}
+
+func (c *Controller) reconcileShootRecoveryDrillKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	shoot, err := c.shootLister.Shoots(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SHOOT RECOVERY DRILL] %s - skipping because Shoot has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SHOOT RECOVERY DRILL] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if !c.shardFilter.Responsible(shoot) {
+		logger.Logger.Debugf("[SHOOT RECOVERY DRILL] %s - skipping because Shoot belongs to another shard", key)
+		return nil
+	}
+
+	// The Shoot is checked again after the sync period unless its next recovery drill is due earlier.
+	syncPeriod := c.config.Controllers.ShootRecoveryDrill.SyncPeriod.Duration
+	next := syncPeriod
+	defer func() { c.shootRecoveryDrillQueue.AddAfter(key, next) }()
+
+	value, ok := shoot.Annotations[common.ShootRecoveryDrills]
+	if !ok {
+		return nil
+	}
+	drills, err := common.ParseRecoveryDrills(value)
+	if err != nil {
+		logger.Logger.Infof("[SHOOT RECOVERY DRILL] %s - skipping because of an invalid annotation: %s", key, err.Error())
+		return nil
+	}
+	if len(drills) == 0 {
+		return nil
+	}
+	if mustIgnoreShoot(shoot.Annotations, c.config.Controllers.Shoot.RespectSyncPeriodOverwrite) {
+		logger.Logger.Infof("[SHOOT RECOVERY DRILL] %s - skipping because Shoot is marked as 'to-be-ignored'.", key)
+		return nil
+	}
+	if reason := recoveryDrillSkipReason(shoot); len(reason) > 0 {
+		logger.Logger.Debugf("[SHOOT RECOVERY DRILL] %s - skipping because %s", key, reason)
+		return nil
+	}
+
+	results, err := c.getRecoveryDrillResults(shoot)
+	if err != nil {
+		return err
+	}
+	if wait := nextRecoveryDrill(results, syncPeriod, time.Now()); wait > 0 {
+		next = wait
+		return nil
+	}
+
+	// A reconciliation must not change the Shoot while it is broken on purpose, e.g. the machines must not be deployed
+	// while a machine is being replaced, hence, the Shoot is locked for the whole drills.
+	finished, running := c.exclusiveOperations.tryStart(key, "recovery drill")
+	if finished == nil {
+		logger.Logger.Debugf("[SHOOT RECOVERY DRILL] %s - postponing because the %s of the Shoot is running", key, running)
+		next = c.config.Controllers.Shoot.RetrySyncPeriod.Duration
+		return nil
+	}
+	defer finished()
+
+	return c.recoveryDrillControl.RecoveryDrill(shoot, drills, key)
+}
+
+// getRecoveryDrillResults reads the results of the previous recovery drills of the <shoot>.
+func (c *Controller) getRecoveryDrillResults(shoot *gardenv1beta1.Shoot) ([]recoveryDrillResult, error) {
+	configMap, err := c.k8sGardenClient.Clientset().CoreV1().ConfigMaps(shoot.Namespace).Get(recoveryDrillsConfigMapName(shoot.Name), metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return decodeRecoveryDrillResults(configMap.Data[recoveryDrillsConfigMapKey]), nil
+}
+
+// recoveryDrillSkipReason returns why no recovery drill must be performed for the <shoot>, or an empty string if it
+// may be performed. Drills are only performed for healthy Shoots whose last operation has succeeded, otherwise a
+// failing drill would not say anything about the ability of the Shoot to recover.
+func recoveryDrillSkipReason(shoot *gardenv1beta1.Shoot) string {
+	if shoot.DeletionTimestamp != nil {
+		return "Shoot is being deleted"
+	}
+	if hibernation := shoot.Spec.Hibernation; hibernation != nil && hibernation.Enabled {
+		return "Shoot is hibernated"
+	}
+	if operationOngoing(shoot) {
+		return "an operation is ongoing"
+	}
+	if lastOperation := shoot.Status.LastOperation; lastOperation == nil || lastOperation.State != gardenv1beta1.ShootLastOperationStateSucceeded {
+		return "the last operation of the Shoot has not succeeded"
+	}
+	for _, conditionType := range []gardenv1beta1.ConditionType{gardenv1beta1.ShootControlPlaneHealthy, gardenv1beta1.ShootEveryNodeReady} {
+		if condition := helper.GetCondition(shoot.Status.Conditions, conditionType); condition == nil || condition.Status != corev1.ConditionTrue {
+			return fmt.Sprintf("condition %s is not true", conditionType)
+		}
+	}
+	return ""
+}
+
+// nextRecoveryDrill returns the duration (relative to <now>) until the next recovery drills of a Shoot with the given
+// <results> of its previous drills are due. The drills are due every <syncPeriod> after the start of the latest drill.
+func nextRecoveryDrill(results []recoveryDrillResult, syncPeriod time.Duration, now time.Time) time.Duration {
+	var latest time.Time
+	for _, result := range results {
+		if result.StartTime.Time.After(latest) {
+			latest = result.StartTime.Time
+		}
+	}
+	if latest.IsZero() {
+		return 0
+	}
+	if wait := latest.Add(syncPeriod).Sub(now); wait > 0 {
+		return wait
+	}
+	return 0
+}
+
+// mergeRecoveryDrillResults replaces the results of the drills in <existing> by the given <results> and returns the
+// results sorted by the names of the drills.
+func mergeRecoveryDrillResults(existing []recoveryDrillResult, results ...recoveryDrillResult) []recoveryDrillResult {
+	byDrill := make(map[string]recoveryDrillResult, len(existing)+len(results))
+	for _, result := range existing {
+		byDrill[result.Drill] = result
+	}
+	for _, result := range results {
+		byDrill[result.Drill] = result
+	}
+
+	merged := make([]recoveryDrillResult, 0, len(byDrill))
+	for _, result := range byDrill {
+		merged = append(merged, result)
+	}
+	sort.Slice(merged, func(i, j int) bool { return merged[i].Drill < merged[j].Drill })
+	return merged
+}
+
+// decodeRecoveryDrillResults decodes the results stored in a recovery drills config map. Unreadable results are
+// discarded, which only means that the next drills are due immediately.
+func decodeRecoveryDrillResults(data string) []recoveryDrillResult {
+	if len(data) == 0 {
+		return nil
+	}
+	var results []recoveryDrillResult
+	if err := json.Unmarshal([]byte(data), &results); err != nil {
+		return nil
+	}
+	return results
+}
+
+// RecoveryDrillControlInterface implements the control logic for the recovery drills of Shoots. It is implemented as
+// an interface to allow for extensions that provide different semantics. Currently, there is only one implementation.
+type RecoveryDrillControlInterface interface {
+	RecoveryDrill(shoot *gardenv1beta1.Shoot, drills []string, key string) error
+}
+
+// NewDefaultRecoveryDrillControl returns a new instance of the default implementation RecoveryDrillControlInterface
+// that implements the documented semantics for the recovery drills of Shoots. recorder is used to report the results
+// of the drills. You should use an instance returned from NewDefaultRecoveryDrillControl() for any scenario other than
+// testing.
+func NewDefaultRecoveryDrillControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *componentconfig.ControllerManagerConfiguration, recorder record.EventRecorder) RecoveryDrillControlInterface {
+	return &defaultRecoveryDrillControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, config, recorder}
+}
+
+type defaultRecoveryDrillControl struct {
+	k8sGardenClient    kubernetes.Client
+	k8sGardenInformers gardeninformers.Interface
+	secrets            map[string]*corev1.Secret
+	imageVector        imagevector.ImageVector
+	identity           *gardenv1beta1.Gardener
+	config             *componentconfig.ControllerManagerConfiguration
+	recorder           record.EventRecorder
+}
+
+// RecoveryDrill performs the given <drills> for the <shoot> one after another. A Shoot which has not recovered from a
+// drill is left alone, hence, the remaining drills are not performed after a failed drill.
+func (c *defaultRecoveryDrillControl) RecoveryDrill(shootObj *gardenv1beta1.Shoot, drills []string, key string) error {
+	var (
+		shoot       = shootObj.DeepCopy()
+		shootLogger = logger.NewShootLogger(logger.Logger, shoot.Name, shoot.Namespace, "")
+	)
+	shootLogger.Debugf("[SHOOT RECOVERY DRILL] %s", key)
+
+	o, err := operation.New(shoot, shootLogger, c.k8sGardenClient, c.k8sGardenInformers, c.identity, c.secrets, c.imageVector)
+	if err != nil {
+		shootLogger.Errorf("could not initialize a new operation: %s", err.Error())
+		return nil
+	}
+	if o.Shoot.Hibernated {
+		return nil
+	}
+	botanist, _, _, hybridBotanist, operationErr := newBotanists(o)
+	if operationErr != nil {
+		shootLogger.Errorf("could not perform the recovery drills: %s", operationErr.Description)
+		return nil
+	}
+	if err := botanist.InitializeShootClients(); err != nil {
+		shootLogger.Errorf("could not perform the recovery drills: failed to create a K8SClient for the Shoot cluster (%s)", err.Error())
+		return nil
+	}
+
+	var timeout time.Duration
+	if value := c.config.Controllers.ShootRecoveryDrill.Timeout; value != nil {
+		timeout = value.Duration
+	}
+
+	for _, drill := range drills {
+		var perform func(context.Context) error
+		switch drill {
+		case common.RecoveryDrillKubeAPIServer:
+			perform = botanist.DrillKubeAPIServerRecovery
+		case common.RecoveryDrillMachine:
+			perform = botanist.DrillMachineRecovery
+		case common.RecoveryDrillEtcdRestore:
+			perform = hybridBotanist.DrillEtcdRestore
+		default:
+			continue
+		}
+
+		shootLogger.Infof("[SHOOT RECOVERY DRILL] Performing recovery drill %s", drill)
+		result := recoveryDrillResult{
+			Drill:     drill,
+			StartTime: metav1.Now(),
+			Result:    recoveryDrillPassed,
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		err := perform(ctx)
+		cancel()
+		result.CompletionTime = metav1.Now()
+
+		if err != nil {
+			result.Result = recoveryDrillFailed
+			result.Message = err.Error()
+			c.recorder.Eventf(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventRecoveryDrillFailed, "Recovery drill %s has failed: %s", drill, err.Error())
+		} else {
+			result.Message = fmt.Sprintf("Shoot has recovered within %s.", result.CompletionTime.Sub(result.StartTime.Time).Round(time.Second))
+			c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventRecoveryDrillPassed, "Recovery drill %s has passed: %s", drill, result.Message)
+		}
+
+		if err := c.recordResult(shoot, result); err != nil {
+			shootLogger.Errorf("Could not record the result of the recovery drill %s: %s", drill, err.Error())
+		}
+		if result.Result == recoveryDrillFailed {
+			break
+		}
+	}
+
+	return nil
+}
+
+// recordResult stores the <result> of a recovery drill in the recovery drills config map of the <shoot>.
+func (c *defaultRecoveryDrillControl) recordResult(shoot *gardenv1beta1.Shoot, result recoveryDrillResult) error {
+	var (
+		configMaps = c.k8sGardenClient.Clientset().CoreV1().ConfigMaps(shoot.Namespace)
+		name       = recoveryDrillsConfigMapName(shoot.Name)
+	)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(name, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		exists := err == nil
+
+		var results []recoveryDrillResult
+		if exists {
+			results = decodeRecoveryDrillResults(configMap.Data[recoveryDrillsConfigMapKey])
+		} else {
+			configMap = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: shoot.Namespace,
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(shoot, gardenv1beta1.SchemeGroupVersion.WithKind("Shoot")),
+					},
+				},
+			}
+		}
+
+		data, err := json.Marshal(mergeRecoveryDrillResults(results, result))
+		if err != nil {
+			return err
+		}
+		configMap.Data = map[string]string{recoveryDrillsConfigMapKey: string(data)}
+
+		if exists {
+			_, err = configMaps.Update(configMap)
+		} else {
+			_, err = configMaps.Create(configMap)
+		}
+		return err
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("recovery drills", func() {
+	now := time.Date(2018, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	result := func(drill string, age time.Duration, outcome string) ExportRecoveryDrillResult {
+		return ExportRecoveryDrillResult{Drill: drill, StartTime: metav1.NewTime(now.Add(-age)), Result: outcome}
+	}
+
+	Describe("#recoveryDrillSkipReason", func() {
+		var shoot *gardenv1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{
+				Status: gardenv1beta1.ShootStatus{
+					LastOperation: &gardenv1beta1.LastOperation{State: gardenv1beta1.ShootLastOperationStateSucceeded},
+					Conditions: []gardenv1beta1.Condition{
+						{Type: gardenv1beta1.ShootControlPlaneHealthy, Status: corev1.ConditionTrue},
+						{Type: gardenv1beta1.ShootEveryNodeReady, Status: corev1.ConditionTrue},
+					},
+				},
+			}
+		})
+
+		It("should perform the drills for a healthy Shoot", func() {
+			Expect(ExportRecoveryDrillSkipReason(shoot)).To(BeEmpty())
+		})
+
+		It("should skip hibernated Shoots", func() {
+			shoot.Spec.Hibernation = &gardenv1beta1.Hibernation{Enabled: true}
+
+			Expect(ExportRecoveryDrillSkipReason(shoot)).To(Equal("Shoot is hibernated"))
+		})
+
+		It("should skip Shoots whose last operation has failed", func() {
+			shoot.Status.LastOperation.State = gardenv1beta1.ShootLastOperationStateFailed
+
+			Expect(ExportRecoveryDrillSkipReason(shoot)).To(Equal("the last operation of the Shoot has not succeeded"))
+		})
+
+		It("should skip Shoots with unready nodes", func() {
+			shoot.Status.Conditions[1].Status = corev1.ConditionFalse
+
+			Expect(ExportRecoveryDrillSkipReason(shoot)).To(Equal("condition EveryNodeReady is not true"))
+		})
+	})
+
+	Describe("#nextRecoveryDrill", func() {
+		It("should perform the first drills immediately", func() {
+			Expect(ExportNextRecoveryDrill(nil, 24*time.Hour, now)).To(BeZero())
+		})
+
+		It("should wait for the sync period after the latest drill", func() {
+			results := []ExportRecoveryDrillResult{result("machine", 20*time.Hour, "Passed"), result("kube-apiserver", 23*time.Hour, "Failed")}
+
+			Expect(ExportNextRecoveryDrill(results, 24*time.Hour, now)).To(Equal(4 * time.Hour))
+		})
+
+		It("should perform overdue drills immediately", func() {
+			results := []ExportRecoveryDrillResult{result("machine", 30*time.Hour, "Passed")}
+
+			Expect(ExportNextRecoveryDrill(results, 24*time.Hour, now)).To(BeZero())
+		})
+	})
+
+	Describe("#mergeRecoveryDrillResults", func() {
+		It("should replace the results of the same drill and sort the results", func() {
+			existing := []ExportRecoveryDrillResult{result("machine", 24*time.Hour, "Failed"), result("etcd-restore", 24*time.Hour, "Passed")}
+
+			Expect(ExportMergeRecoveryDrills(existing, result("machine", 0, "Passed"), result("kube-apiserver", 0, "Passed"))).To(Equal([]ExportRecoveryDrillResult{
+				result("etcd-restore", 24*time.Hour, "Passed"),
+				result("kube-apiserver", 0, "Passed"),
+				result("machine", 0, "Passed"),
+			}))
+		})
+	})
+})
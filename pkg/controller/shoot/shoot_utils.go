@@ -17,9 +17,20 @@ package shoot
 import (
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 )
 
 // operationOngoing returns true if the .status.phase field has a value which indicates that an operation
@@ -32,10 +43,74 @@ func operationOngoing(shoot *gardenv1beta1.Shoot) bool {
 	return lastOperation.State == gardenv1beta1.ShootLastOperationStateProcessing
 }
 
-func formatError(message string, err error) *gardenv1beta1.LastError {
-	return &gardenv1beta1.LastError{
+// operationError is the error of a reconciliation or deletion of a Shoot, i.e. the last error which is reported in its
+// status along with the class of the error which decides how the operation is retried. The lastErrors are the
+// individual errors which make up the last error, e.g. the errors of all failed tasks of a flow.
+type operationError struct {
+	*gardenv1beta1.LastError
+	lastErrors []gardenv1beta1.LastError
+	class      operationerrors.Class
+}
+
+// newFlowOperationError returns the operationError for the given aggregated <lastError> of the flow <f>.
+func newFlowOperationError(f *flow.Flow, lastError *gardenv1beta1.LastError) *operationError {
+	return &operationError{LastError: lastError, lastErrors: f.LastErrors(), class: f.ErrorClass()}
+}
+
+// err returns the error which is returned to the Shoot controller, see scheduleNextSync.
+func (e *operationError) err() error {
+	return operationerrors.Errorf(e.class, "%s", e.Description)
+}
+
+// retryDescription returns the note which is appended to the description of a failed operation which will be retried.
+// Errors which cannot be resolved without an action of the user are pointed out.
+func (e *operationError) retryDescription() string {
+	switch e.class {
+	case operationerrors.ClassUnauthorized:
+		return " Operation will be retried, but it requires valid cloud provider credentials with sufficient privileges."
+	case operationerrors.ClassQuotaExceeded:
+		return " Operation will be retried, but it requires the quota limits of the cloud provider account to be raised."
+	}
+	return " Operation will be retried."
+}
+
+func formatError(message string, err error) *operationError {
+	e := operationerrors.New(err)
+	lastError := &gardenv1beta1.LastError{
 		Description: fmt.Sprintf("%s (%s)", message, err.Error()),
 	}
+	if e.Code != nil {
+		lastError.Codes = []gardenv1beta1.ErrorCode{*e.Code}
+	}
+	return &operationError{LastError: lastError, lastErrors: []gardenv1beta1.LastError{*lastError}, class: e.Class}
+}
+
+// maxRetryBackoff is the maximum duration after which an operation which failed with an unclassified error is retried.
+const maxRetryBackoff = 5 * time.Minute
+
+// retryPeriod returns the duration after which an operation which failed with an error of the given <class> is
+// retried. Transient errors and canceled operations are retried after the retry sync period right away, whereas
+// unclassified errors are retried with an increasing backoff: after as long as the retry cycle which started at
+// <retryCycleStartTime> has lasted so far, but at least after the retry sync period and at most after maxRetryBackoff.
+// Errors which cannot be resolved without an action of the user are only retried with the regular sync period, hence,
+// it returns false for them.
+func retryPeriod(class operationerrors.Class, retryCycleStartTime *metav1.Time, retrySyncPeriod time.Duration, now time.Time) (time.Duration, bool) {
+	switch class {
+	case operationerrors.ClassTransient, operationerrors.ClassCanceled:
+		return retrySyncPeriod, true
+	case operationerrors.ClassUnknown:
+		backoff := retrySyncPeriod
+		if retryCycleStartTime != nil {
+			if elapsed := now.Sub(retryCycleStartTime.Time); elapsed > backoff {
+				backoff = elapsed
+			}
+		}
+		if backoff > maxRetryBackoff && maxRetryBackoff > retrySyncPeriod {
+			backoff = maxRetryBackoff
+		}
+		return backoff, true
+	}
+	return 0, false
 }
 
 func computeLabelsWithShootHealthiness(healthy bool) func(map[string]string) map[string]string {
@@ -68,7 +143,118 @@ func shootIsUsedAsSeed(shoot *gardenv1beta1.Shoot) bool {
 	return false
 }
 
+// removeShootAnnotation removes the annotation with the given <key> from the Shoot of the operation <o> and
+// replaces the Shoot of the operation with the updated one.
+func removeShootAnnotation(o *operation.Operation, key string) error {
+	shoots := o.K8sGardenClient.GardenClientset().GardenV1beta1().Shoots(o.Shoot.Info.Namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		shoot, err := shoots.Get(o.Shoot.Info.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if _, ok := shoot.Annotations[key]; !ok {
+			return nil
+		}
+		delete(shoot.Annotations, key)
+		updatedShoot, err := shoots.Update(shoot)
+		if err != nil {
+			return err
+		}
+		o.Shoot.Info = updatedShoot
+		return nil
+	})
+}
+
 func mustIgnoreShoot(annotations map[string]string, respectSyncPeriodOverwrite *bool) bool {
 	_, ignore := annotations[common.ShootIgnore]
 	return respectSyncPeriodOverwrite != nil && ignore && *respectSyncPeriodOverwrite
 }
+
+// lastOperationSteps returns the step durations recorded for the given <lastOperation> so that they are preserved
+// when the last operation is replaced after the flow has been executed.
+func lastOperationSteps(lastOperation *gardenv1beta1.LastOperation) []gardenv1beta1.LastOperationStep {
+	if lastOperation == nil {
+		return nil
+	}
+	return lastOperation.Steps
+}
+
+// shootReconciled returns true if the last operation of the given <shoot> was a successful create or reconcile
+// operation for its current generation.
+func shootReconciled(shoot *gardenv1beta1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+	return shoot.DeletionTimestamp == nil &&
+		shoot.Generation == shoot.Status.ObservedGeneration &&
+		lastOperation != nil &&
+		lastOperation.Type != gardenv1beta1.ShootLastOperationTypeDelete &&
+		lastOperation.State == gardenv1beta1.ShootLastOperationStateSucceeded
+}
+
+// kubernetesUpgradePhase returns the phase the progressing Kubernetes upgrade of the given <shoot> has reached. The
+// upgrade has succeeded once the Shoot has been reconciled successfully and is <healthy>. Otherwise, it must be rolled
+// back once more than <rollbackThreshold> have passed since it has been requested. A zero threshold disables the
+// rollback.
+func kubernetesUpgradePhase(shoot *gardenv1beta1.Shoot, healthy bool, rollbackThreshold time.Duration, now time.Time) gardenv1beta1.KubernetesUpgradePhase {
+	if healthy && shootReconciled(shoot) {
+		return gardenv1beta1.KubernetesUpgradePhaseSucceeded
+	}
+	if rollbackThreshold > 0 && now.Sub(shoot.Status.KubernetesUpgrade.StartTime.Time) > rollbackThreshold {
+		return gardenv1beta1.KubernetesUpgradePhaseRolledBack
+	}
+	return gardenv1beta1.KubernetesUpgradePhaseProgressing
+}
+
+// healthTransitions returns those of the <conditions> whose status differs from the status of the condition with the
+// same type in <previousConditions>. Conditions which did not exist before are not considered a transition.
+func healthTransitions(previousConditions []gardenv1beta1.Condition, conditions ...gardenv1beta1.Condition) []gardenv1beta1.Condition {
+	var transitions []gardenv1beta1.Condition
+	for _, condition := range conditions {
+		if previous := helper.GetCondition(previousConditions, condition.Type); previous != nil && previous.Status != condition.Status {
+			transitions = append(transitions, condition)
+		}
+	}
+	return transitions
+}
+
+// pendingShootDependencies returns the keys of the Shoots the given <shoot> depends on which do not exist or have not
+// (yet) been reconciled successfully.
+func pendingShootDependencies(shootLister gardenlisters.ShootLister, shoot *gardenv1beta1.Shoot) []string {
+	var pending []string
+
+	for _, dependency := range shoot.Spec.DependsOn {
+		key := common.ShootDependencyKey(shoot.Namespace, dependency)
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			pending = append(pending, key)
+			continue
+		}
+		if dependencyShoot, err := shootLister.Shoots(namespace).Get(name); err != nil || !shootReconciled(dependencyShoot) {
+			pending = append(pending, key)
+		}
+	}
+
+	return pending
+}
+
+// configureMachineWait sets the timeouts and the poll interval which are used while waiting for the machines of the
+// given <shoot> on the <hybridBotanist>. The values of the controller <config> can be overwritten per Shoot with
+// annotations. Annotations whose values are no valid durations are ignored.
+func configureMachineWait(hybridBotanist *hybridbotanistpkg.HybridBotanist, config componentconfig.ShootControllerConfiguration, shoot *gardenv1beta1.Shoot) {
+	hybridBotanist.MachineWaitTimeout = durationOverwrite(shoot.Annotations, common.ShootMachineWaitTimeout, config.MachineWaitTimeout)
+	hybridBotanist.MachineWaitPollInterval = durationOverwrite(shoot.Annotations, common.ShootMachineWaitPollInterval, config.MachineWaitPollInterval)
+	hybridBotanist.MachineDeploymentProgressTimeout = durationOverwrite(shoot.Annotations, common.ShootMachineDeploymentProgressTimeout, config.MachineDeploymentProgressTimeout)
+}
+
+// durationOverwrite returns the duration in the annotation with the given <key> if it is valid, and the
+// <defaultDuration> otherwise (or zero if it is nil).
+func durationOverwrite(annotations map[string]string, key string, defaultDuration *metav1.Duration) time.Duration {
+	if value, ok := annotations[key]; ok {
+		if duration, err := time.ParseDuration(value); err == nil && duration >= 0 {
+			return duration
+		}
+	}
+	if defaultDuration == nil {
+		return 0
+	}
+	return defaultDuration.Duration
+}
@@ -0,0 +1,268 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/shoot"
+	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	"github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/sirupsen/logrus"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("utils", func() {
+	Describe("#kubernetesUpgradePhase", func() {
+		var (
+			start = time.Date(2018, time.June, 1, 12, 0, 0, 0, time.UTC)
+			shoot *gardenv1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: gardenv1beta1.ShootStatus{
+					ObservedGeneration: 2,
+					LastOperation: &gardenv1beta1.LastOperation{
+						Type:  gardenv1beta1.ShootLastOperationTypeReconcile,
+						State: gardenv1beta1.ShootLastOperationStateSucceeded,
+					},
+					KubernetesUpgrade: &gardenv1beta1.ShootKubernetesUpgrade{
+						PreviousVersion: "1.9.6",
+						Version:         "1.10.2",
+						Phase:           gardenv1beta1.KubernetesUpgradePhaseProgressing,
+						StartTime:       metav1.NewTime(start),
+					},
+				},
+			}
+		})
+
+		It("should succeed once the Shoot has been reconciled and is healthy", func() {
+			Expect(ExportKubernetesUpgradePhase(shoot, true, 30*time.Minute, start.Add(time.Hour))).To(Equal(gardenv1beta1.KubernetesUpgradePhaseSucceeded))
+		})
+
+		It("should not succeed before the upgrade has been reconciled", func() {
+			shoot.Status.ObservedGeneration = 1
+
+			Expect(ExportKubernetesUpgradePhase(shoot, true, 30*time.Minute, start.Add(time.Minute))).To(Equal(gardenv1beta1.KubernetesUpgradePhaseProgressing))
+		})
+
+		It("should keep progressing within the rollback threshold", func() {
+			Expect(ExportKubernetesUpgradePhase(shoot, false, 30*time.Minute, start.Add(10*time.Minute))).To(Equal(gardenv1beta1.KubernetesUpgradePhaseProgressing))
+		})
+
+		It("should roll back if the Shoot is unhealthy beyond the rollback threshold", func() {
+			Expect(ExportKubernetesUpgradePhase(shoot, false, 30*time.Minute, start.Add(time.Hour))).To(Equal(gardenv1beta1.KubernetesUpgradePhaseRolledBack))
+		})
+
+		It("should never roll back if no rollback threshold is configured", func() {
+			Expect(ExportKubernetesUpgradePhase(shoot, false, 0, start.Add(24*time.Hour))).To(Equal(gardenv1beta1.KubernetesUpgradePhaseProgressing))
+		})
+	})
+
+	Describe("#retryPeriod", func() {
+		var (
+			now             = time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+			retrySyncPeriod = 15 * time.Second
+
+			retryPeriod = func(class operationerrors.Class, retryCycleStartTime *metav1.Time) time.Duration {
+				period, ok := ExportRetryPeriod(class, retryCycleStartTime, retrySyncPeriod, now)
+				Expect(ok).To(BeTrue())
+				return period
+			}
+		)
+
+		It("should retry transient errors and canceled operations after the retry sync period", func() {
+			start := metav1.NewTime(now.Add(-time.Hour))
+
+			Expect(retryPeriod(operationerrors.ClassTransient, &start)).To(Equal(retrySyncPeriod))
+			Expect(retryPeriod(operationerrors.ClassCanceled, nil)).To(Equal(retrySyncPeriod))
+		})
+
+		It("should back off for unclassified errors", func() {
+			Expect(retryPeriod(operationerrors.ClassUnknown, nil)).To(Equal(retrySyncPeriod))
+
+			start := metav1.NewTime(now.Add(-2 * time.Minute))
+			Expect(retryPeriod(operationerrors.ClassUnknown, &start)).To(Equal(2 * time.Minute))
+
+			start = metav1.NewTime(now.Add(-time.Hour))
+			Expect(retryPeriod(operationerrors.ClassUnknown, &start)).To(Equal(5 * time.Minute))
+		})
+
+		It("should not retry errors which require an action of the user before the regular sync", func() {
+			for _, class := range []operationerrors.Class{operationerrors.ClassUnauthorized, operationerrors.ClassQuotaExceeded, operationerrors.ClassConfiguration} {
+				_, ok := ExportRetryPeriod(class, nil, retrySyncPeriod, now)
+				Expect(ok).To(BeFalse())
+			}
+		})
+	})
+
+	Describe("#configureMachineWait", func() {
+		var (
+			config = componentconfig.ShootControllerConfiguration{
+				MachineWaitTimeout:               &metav1.Duration{Duration: 30 * time.Minute},
+				MachineWaitPollInterval:          &metav1.Duration{Duration: 5 * time.Second},
+				MachineDeploymentProgressTimeout: &metav1.Duration{Duration: 15 * time.Minute},
+			}
+			shoot *gardenv1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{}
+		})
+
+		It("should use the values of the controller configuration", func() {
+			hybridBotanist := &hybridbotanist.HybridBotanist{}
+
+			ExportConfigureMachineWait(hybridBotanist, config, shoot)
+
+			Expect(hybridBotanist.MachineWaitTimeout).To(Equal(30 * time.Minute))
+			Expect(hybridBotanist.MachineWaitPollInterval).To(Equal(5 * time.Second))
+			Expect(hybridBotanist.MachineDeploymentProgressTimeout).To(Equal(15 * time.Minute))
+		})
+
+		It("should use the values of the annotations of the Shoot", func() {
+			hybridBotanist := &hybridbotanist.HybridBotanist{}
+			shoot.Annotations = map[string]string{
+				common.ShootMachineWaitTimeout:               "2h",
+				common.ShootMachineWaitPollInterval:          "30s",
+				common.ShootMachineDeploymentProgressTimeout: "0s",
+			}
+
+			ExportConfigureMachineWait(hybridBotanist, config, shoot)
+
+			Expect(hybridBotanist.MachineWaitTimeout).To(Equal(2 * time.Hour))
+			Expect(hybridBotanist.MachineWaitPollInterval).To(Equal(30 * time.Second))
+			Expect(hybridBotanist.MachineDeploymentProgressTimeout).To(BeZero())
+		})
+
+		It("should ignore invalid annotations", func() {
+			hybridBotanist := &hybridbotanist.HybridBotanist{}
+			shoot.Annotations = map[string]string{
+				common.ShootMachineWaitTimeout: "forever",
+			}
+
+			ExportConfigureMachineWait(hybridBotanist, config, shoot)
+
+			Expect(hybridBotanist.MachineWaitTimeout).To(Equal(30 * time.Minute))
+		})
+	})
+
+	Describe("#healthTransitions", func() {
+		previousConditions := []gardenv1beta1.Condition{
+			{Type: gardenv1beta1.ShootControlPlaneHealthy, Status: corev1.ConditionTrue},
+			{Type: gardenv1beta1.ShootEveryNodeReady, Status: corev1.ConditionTrue},
+		}
+
+		It("should return the conditions whose status has changed", func() {
+			transitions := ExportHealthTransitions(previousConditions,
+				gardenv1beta1.Condition{Type: gardenv1beta1.ShootControlPlaneHealthy, Status: corev1.ConditionTrue},
+				gardenv1beta1.Condition{Type: gardenv1beta1.ShootEveryNodeReady, Status: corev1.ConditionFalse, Message: "node is not ready"},
+			)
+
+			Expect(transitions).To(Equal([]gardenv1beta1.Condition{
+				{Type: gardenv1beta1.ShootEveryNodeReady, Status: corev1.ConditionFalse, Message: "node is not ready"},
+			}))
+		})
+
+		It("should not consider new conditions a transition", func() {
+			Expect(ExportHealthTransitions(previousConditions,
+				gardenv1beta1.Condition{Type: gardenv1beta1.ShootSystemComponentsHealthy, Status: corev1.ConditionFalse},
+			)).To(BeEmpty())
+		})
+	})
+
+	Describe("#newFlowOperationError", func() {
+		var (
+			tasks  *flowTasks
+			logger = logrus.NewEntry(logrus.New())
+		)
+
+		BeforeEach(func() {
+			tasks = &flowTasks{}
+			logger.Logger.Out = ioutil.Discard
+		})
+
+		It("should report every failed task of the flow as a separate last error", func() {
+			f := flow.New("test").SetLogger(logger)
+			deployDNS := f.AddTask(tasks.DeployDNS, 0)
+			f.AddTask(tasks.DeployMachines, 0)
+			f.AddTask(tasks.DeployNetwork, 0)
+			f.AddTask(tasks.DeployKubeAPIServer, 0, deployDNS)
+
+			lastError, lastErrors := ExportFlowLastErrors(f)
+
+			Expect(lastError).NotTo(BeNil())
+			Expect(lastError.Codes).To(ConsistOf(gardenv1beta1.ErrorInfraQuotaExceeded))
+			Expect(lastErrors).To(ConsistOf(
+				gardenv1beta1.LastError{
+					Description: "dns provider unreachable",
+					TaskID:      "(*flowTasks).DeployDNS",
+				},
+				gardenv1beta1.LastError{
+					Description: "quota exceeded",
+					TaskID:      "(*flowTasks).DeployMachines",
+					Codes:       []gardenv1beta1.ErrorCode{gardenv1beta1.ErrorInfraQuotaExceeded},
+				},
+			))
+			Expect(tasks.kubeAPIServerDeployed).To(BeFalse())
+		})
+
+		It("should not report any errors if the flow succeeded", func() {
+			f := flow.New("test").SetLogger(logger)
+			f.AddTask(tasks.DeployNetwork, 0)
+
+			lastError, lastErrors := ExportFlowLastErrors(f)
+
+			Expect(lastError).To(BeNil())
+			Expect(lastErrors).To(BeEmpty())
+		})
+	})
+})
+
+// flowTasks provides named task functions for flows, because the names of the task functions are the task IDs of the
+// last errors.
+type flowTasks struct {
+	kubeAPIServerDeployed bool
+}
+
+func (t *flowTasks) DeployDNS() error {
+	return operationerrors.Transient(errors.New("dns provider unreachable"))
+}
+
+func (t *flowTasks) DeployMachines() error {
+	return operationerrors.QuotaExceeded(errors.New("quota exceeded"))
+}
+
+func (t *flowTasks) DeployNetwork() error {
+	return nil
+}
+
+func (t *flowTasks) DeployKubeAPIServer() error {
+	t.kubeAPIServerDeployed = true
+	return nil
+}
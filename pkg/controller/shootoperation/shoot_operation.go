@@ -0,0 +1,151 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation
+
+import (
+	"sync"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllerutils "github.com/gardener/gardener/pkg/controller/utils"
+	"github.com/gardener/gardener/pkg/logger"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Controller controls ShootOperations.
+type Controller struct {
+	k8sGardenClient    kubernetes.Client
+	k8sGardenInformers gardeninformers.SharedInformerFactory
+
+	control  ControlInterface
+	recorder record.EventRecorder
+
+	shootOperationLister gardenlisters.ShootOperationLister
+	shootOperationQueue  workqueue.RateLimitingInterface
+	shootOperationSynced cache.InformerSynced
+	shootSynced          cache.InformerSynced
+
+	workerCh               chan int
+	numberOfRunningWorkers int
+}
+
+// NewShootOperationController takes a Kubernetes client for the Garden clusters <k8sGardenClient>, a
+// <gardenInformerFactory>, and a <recorder> for event recording. It creates a new Gardener controller.
+func NewShootOperationController(k8sGardenClient kubernetes.Client, gardenInformerFactory gardeninformers.SharedInformerFactory, recorder record.EventRecorder) *Controller {
+	var (
+		gardenv1beta1Informer = gardenInformerFactory.Garden().V1beta1()
+
+		shootOperationInformer = gardenv1beta1Informer.ShootOperations()
+		shootOperationLister   = shootOperationInformer.Lister()
+		shootInformer          = gardenv1beta1Informer.Shoots()
+		shootLister            = shootInformer.Lister()
+	)
+
+	shootOperationController := &Controller{
+		k8sGardenClient:      k8sGardenClient,
+		k8sGardenInformers:   gardenInformerFactory,
+		control:              NewDefaultControl(k8sGardenClient, gardenInformerFactory, recorder, shootLister),
+		recorder:             recorder,
+		shootOperationLister: shootOperationLister,
+		shootOperationQueue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ShootOperation"),
+		workerCh:             make(chan int),
+	}
+
+	shootOperationInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    shootOperationController.shootOperationAdd,
+		UpdateFunc: shootOperationController.shootOperationUpdate,
+	})
+	shootOperationController.shootOperationSynced = shootOperationInformer.Informer().HasSynced
+
+	// The progress of the triggered operations is reported in the status of the Shoots, hence, every Shoot update
+	// requeues the running ShootOperations of its namespace.
+	shootInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: shootOperationController.shootUpdate,
+	})
+	shootOperationController.shootSynced = shootInformer.Informer().HasSynced
+
+	return shootOperationController
+}
+
+// Run runs the Controller until the given stop channel can be read from.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	var waitGroup sync.WaitGroup
+
+	if !cache.WaitForCacheSync(stopCh, c.shootOperationSynced, c.shootSynced) {
+		logger.Logger.Error("Timed out waiting for caches to sync")
+		return
+	}
+
+	// Count number of running workers.
+	go func() {
+		for {
+			select {
+			case res := <-c.workerCh:
+				c.numberOfRunningWorkers += res
+				logger.Logger.Debugf("Current number of running ShootOperation workers is %d", c.numberOfRunningWorkers)
+			}
+		}
+	}()
+
+	logger.Logger.Info("ShootOperation controller initialized.")
+
+	for i := 0; i < workers; i++ {
+		controllerutils.CreateWorker(c.shootOperationQueue, "ShootOperation", c.reconcileShootOperationKey, stopCh, &waitGroup, c.workerCh)
+	}
+
+	// Shutdown handling
+	<-stopCh
+	c.shootOperationQueue.ShutDown()
+
+	for {
+		if c.shootOperationQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+			logger.Logger.Debug("No running ShootOperation worker and no items left in the queues. Terminated ShootOperation controller...")
+			break
+		}
+		logger.Logger.Debugf("Waiting for %d ShootOperation worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.shootOperationQueue.Len())
+		time.Sleep(5 * time.Second)
+	}
+
+	waitGroup.Wait()
+}
+
+// RunningWorkers returns the number of running workers.
+func (c *Controller) RunningWorkers() int {
+	return c.numberOfRunningWorkers
+}
+
+func (c *Controller) shootUpdate(oldObj, newObj interface{}) {
+	shoot, ok := newObj.(*gardenv1beta1.Shoot)
+	if !ok {
+		return
+	}
+
+	shootOperations, err := c.shootOperationLister.ShootOperations(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		logger.Logger.Errorf("Couldn't list ShootOperations in namespace %s: %v", shoot.Namespace, err)
+		return
+	}
+	for _, shootOperation := range shootOperations {
+		if shootOperation.Status.Phase == gardenv1beta1.ShootOperationPhaseRunning {
+			c.shootOperationAdd(shootOperation)
+		}
+	}
+}
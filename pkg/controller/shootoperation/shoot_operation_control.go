@@ -0,0 +1,342 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+// shootOperationResyncPeriod is the period after which a running ShootOperation is reconciled again although no
+// selected Shoot has changed.
+const shootOperationResyncPeriod = time.Minute
+
+func (c *Controller) shootOperationAdd(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.shootOperationQueue.Add(key)
+}
+
+func (c *Controller) shootOperationUpdate(oldObj, newObj interface{}) {
+	c.shootOperationAdd(newObj)
+}
+
+func (c *Controller) reconcileShootOperationKey(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	shootOperation, err := c.shootOperationLister.ShootOperations(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SHOOTOPERATION RECONCILE] %s - skipping because ShootOperation has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SHOOTOPERATION RECONCILE] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+
+	requeueAfter, err := c.control.ReconcileShootOperation(shootOperation, key)
+	if err != nil {
+		c.shootOperationQueue.AddAfter(key, time.Minute)
+		return nil
+	}
+	if requeueAfter > 0 {
+		c.shootOperationQueue.AddAfter(key, requeueAfter)
+	}
+	return nil
+}
+
+// ControlInterface implements the control logic for triggering the operations of the Shoots selected by
+// ShootOperations. It is implemented as an interface to allow for extensions that provide different semantics.
+// Currently, there is only one implementation.
+type ControlInterface interface {
+	// ReconcileShootOperation implements the control logic for selecting the Shoots of a ShootOperation and for
+	// triggering their operations with the configured concurrency. It returns the duration after which the
+	// ShootOperation must be reconciled again (zero if not required).
+	// If an implementation returns a non-nil error, the invocation will be retried using a rate-limited strategy.
+	// Implementors should sink any errors that they do not wish to trigger a retry, and they may feel free to
+	// exit exceptionally at any point provided they wish the update to be re-run at a later point in time.
+	ReconcileShootOperation(shootOperation *gardenv1beta1.ShootOperation, key string) (time.Duration, error)
+}
+
+// NewDefaultControl returns a new instance of the default implementation ControlInterface that
+// implements the documented semantics for ShootOperations. You should use an instance returned from
+// NewDefaultControl() for any scenario other than testing.
+func NewDefaultControl(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.SharedInformerFactory, recorder record.EventRecorder, shootLister gardenlisters.ShootLister) ControlInterface {
+	return &defaultControl{k8sGardenClient, k8sGardenInformers, recorder, shootLister}
+}
+
+type defaultControl struct {
+	k8sGardenClient    kubernetes.Client
+	k8sGardenInformers gardeninformers.SharedInformerFactory
+	recorder           record.EventRecorder
+	shootLister        gardenlisters.ShootLister
+}
+
+func (c *defaultControl) ReconcileShootOperation(obj *gardenv1beta1.ShootOperation, key string) (time.Duration, error) {
+	_, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		shootOperation       = obj.DeepCopy()
+		shootOperationLogger = logger.NewFieldLogger(logger.Logger, "shootoperation", fmt.Sprintf("%s/%s", shootOperation.Namespace, shootOperation.Name))
+	)
+
+	switch shootOperation.Status.Phase {
+	case gardenv1beta1.ShootOperationPhaseSucceeded, gardenv1beta1.ShootOperationPhaseFailed:
+		return 0, nil
+
+	case "", gardenv1beta1.ShootOperationPhasePending:
+		// The set of Shoots is determined only once so that Shoots which are labelled later on are not considered.
+		shootOperation, err = c.selectShoots(shootOperation, shootOperationLogger)
+		if err != nil {
+			shootOperationLogger.Error(err.Error())
+			return 0, err
+		}
+	}
+
+	if shootOperation.Status.Phase != gardenv1beta1.ShootOperationPhaseRunning {
+		return 0, nil
+	}
+
+	shootOperation, err = c.progress(shootOperation, shootOperationLogger)
+	if err != nil {
+		shootOperationLogger.Error(err.Error())
+		return 0, err
+	}
+
+	if shootOperation.Status.Phase == gardenv1beta1.ShootOperationPhaseRunning {
+		return shootOperationResyncPeriod, nil
+	}
+	return 0, nil
+}
+
+// selectShoots determines the Shoots in the namespace of the ShootOperation which match its label selector and
+// records them in the status of the ShootOperation.
+func (c *defaultControl) selectShoots(shootOperation *gardenv1beta1.ShootOperation, shootOperationLogger *logrus.Entry) (*gardenv1beta1.ShootOperation, error) {
+	selector, err := metav1.LabelSelectorAsSelector(shootOperation.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	shoots, err := c.shootLister.Shoots(shootOperation.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(shoots, func(i, j int) bool {
+		return shoots[i].Name < shoots[j].Name
+	})
+
+	now := metav1.Now()
+	shootOperation.Status.Phase = gardenv1beta1.ShootOperationPhaseRunning
+	shootOperation.Status.StartTimestamp = &now
+	shootOperation.Status.Shoots = make([]gardenv1beta1.ShootOperationShootStatus, 0, len(shoots))
+	for _, shoot := range shoots {
+		shootOperation.Status.Shoots = append(shootOperation.Status.Shoots, gardenv1beta1.ShootOperationShootStatus{
+			Name:  shoot.Name,
+			State: gardenv1beta1.ShootOperationShootStatePending,
+		})
+	}
+
+	shootOperationLogger.Infof("Selected %d Shoot(s) for operation %q", len(shoots), shootOperation.Spec.Operation)
+	return c.updateStatus(shootOperation)
+}
+
+// progress evaluates the Shoots whose operations have been triggered and triggers the operations of further Shoots
+// as long as the maximum concurrency is not reached. Once all operations have completed, the ShootOperation is
+// marked as succeeded or failed.
+func (c *defaultControl) progress(shootOperation *gardenv1beta1.ShootOperation, shootOperationLogger *logrus.Entry) (*gardenv1beta1.ShootOperation, error) {
+	var (
+		maxConcurrency = gardenv1beta1.DefaultShootOperationMaxConcurrency
+		processing     = 0
+		pending        = 0
+		failed         = 0
+		statusChanged  = false
+	)
+	if shootOperation.Spec.MaxConcurrency != nil {
+		maxConcurrency = *shootOperation.Spec.MaxConcurrency
+	}
+
+	for i, status := range shootOperation.Status.Shoots {
+		if status.State != gardenv1beta1.ShootOperationShootStateProcessing {
+			continue
+		}
+
+		shoot, err := c.shootLister.Shoots(shootOperation.Namespace).Get(status.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if apierrors.IsNotFound(err) {
+			shoot = nil
+		}
+
+		if newStatus := computeShootStatus(status, shoot); newStatus != status {
+			shootOperationLogger.Infof("Operation of Shoot %q is in state %s: %s", status.Name, newStatus.State, newStatus.Message)
+			shootOperation.Status.Shoots[i] = newStatus
+			statusChanged = true
+		}
+	}
+
+	for i := range shootOperation.Status.Shoots {
+		status := &shootOperation.Status.Shoots[i]
+
+		if status.State == gardenv1beta1.ShootOperationShootStatePending && processing < maxConcurrency {
+			if err := c.trigger(shootOperation, status, shootOperationLogger); err != nil {
+				if statusChanged {
+					if _, updateErr := c.updateStatus(shootOperation); updateErr != nil {
+						shootOperationLogger.Error(updateErr.Error())
+					}
+				}
+				return nil, err
+			}
+			statusChanged = true
+		}
+
+		switch status.State {
+		case gardenv1beta1.ShootOperationShootStatePending:
+			pending++
+		case gardenv1beta1.ShootOperationShootStateProcessing:
+			processing++
+		case gardenv1beta1.ShootOperationShootStateFailed:
+			failed++
+		}
+	}
+
+	if pending == 0 && processing == 0 {
+		now := metav1.Now()
+		shootOperation.Status.Phase = gardenv1beta1.ShootOperationPhaseSucceeded
+		if failed > 0 {
+			shootOperation.Status.Phase = gardenv1beta1.ShootOperationPhaseFailed
+		}
+		shootOperation.Status.CompletionTimestamp = &now
+		statusChanged = true
+
+		shootOperationLogger.Infof("Operations of %d Shoot(s) completed (%d failed)", len(shootOperation.Status.Shoots), failed)
+		c.recorder.Eventf(shootOperation, corev1.EventTypeNormal, gardenv1beta1.ShootOperationEventCompleted, "Operations of %d Shoot(s) completed (%d failed)", len(shootOperation.Status.Shoots), failed)
+	}
+
+	if !statusChanged {
+		return shootOperation, nil
+	}
+	return c.updateStatus(shootOperation)
+}
+
+// trigger triggers the operation of the Shoot referenced by <status> by annotating it with the ShootOperation
+// annotation, and records the generation of the Shoot which results from this update.
+func (c *defaultControl) trigger(shootOperation *gardenv1beta1.ShootOperation, status *gardenv1beta1.ShootOperationShootStatus, shootOperationLogger *logrus.Entry) error {
+	shoot, err := c.k8sGardenClient.GardenClientset().GardenV1beta1().Shoots(shootOperation.Namespace).Get(status.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		status.State = gardenv1beta1.ShootOperationShootStateFailed
+		status.Message = "The Shoot has been deleted."
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if reason := skipReason(shootOperation.Spec.Operation, shoot); len(reason) > 0 {
+		shootOperationLogger.Infof("Skipping Shoot %q: %s", shoot.Name, reason)
+		status.State = gardenv1beta1.ShootOperationShootStateSkipped
+		status.Message = reason
+		return nil
+	}
+
+	annotationValue := common.ShootOperationReconcile
+	if shootOperation.Spec.Operation == gardenv1beta1.ShootOperationTypeRetry {
+		annotationValue = common.ShootOperationRetry
+	}
+	if shoot.Annotations == nil {
+		shoot.Annotations = map[string]string{}
+	}
+	shoot.Annotations[common.ShootOperation] = annotationValue
+
+	updatedShoot, err := c.k8sGardenClient.GardenClientset().GardenV1beta1().Shoots(shoot.Namespace).Update(shoot)
+	if err != nil {
+		return err
+	}
+
+	status.State = gardenv1beta1.ShootOperationShootStateProcessing
+	status.Generation = updatedShoot.Generation
+	status.Message = fmt.Sprintf("Operation %q has been triggered.", shootOperation.Spec.Operation)
+
+	shootOperationLogger.Infof("Triggered operation %q of Shoot %q", shootOperation.Spec.Operation, shoot.Name)
+	c.recorder.Eventf(shootOperation, corev1.EventTypeNormal, gardenv1beta1.ShootOperationEventTriggered, "Triggered operation %q of Shoot %q", shootOperation.Spec.Operation, shoot.Name)
+	return nil
+}
+
+func (c *defaultControl) updateStatus(shootOperation *gardenv1beta1.ShootOperation) (*gardenv1beta1.ShootOperation, error) {
+	return c.k8sGardenClient.GardenClientset().GardenV1beta1().ShootOperations(shootOperation.Namespace).UpdateStatus(shootOperation)
+}
+
+// skipReason returns a human readable reason why the given <operation> is not applicable to the <shoot>. It returns
+// an empty string if the operation can be triggered.
+func skipReason(operation gardenv1beta1.ShootOperationType, shoot *gardenv1beta1.Shoot) string {
+	if shoot.DeletionTimestamp != nil {
+		return "The Shoot is being deleted."
+	}
+	if operation == gardenv1beta1.ShootOperationTypeRetry {
+		if lastOperation := shoot.Status.LastOperation; lastOperation == nil || lastOperation.State != gardenv1beta1.ShootLastOperationStateFailed {
+			return "The Shoot has no failed operation which could be retried."
+		}
+	}
+	return ""
+}
+
+// computeShootStatus evaluates the state of the triggered operation of the given <shoot>. The operation has completed
+// once the Shoot controller has observed the generation which resulted from triggering the operation, and once the
+// last operation of the Shoot has either succeeded or finally failed. A nil <shoot> indicates that the Shoot has been
+// deleted in the meantime.
+func computeShootStatus(status gardenv1beta1.ShootOperationShootStatus, shoot *gardenv1beta1.Shoot) gardenv1beta1.ShootOperationShootStatus {
+	if shoot == nil {
+		status.State = gardenv1beta1.ShootOperationShootStateFailed
+		status.Message = "The Shoot has been deleted."
+		return status
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	if shoot.Status.ObservedGeneration < status.Generation || lastOperation == nil {
+		return status
+	}
+
+	switch lastOperation.State {
+	case gardenv1beta1.ShootLastOperationStateSucceeded:
+		status.State = gardenv1beta1.ShootOperationShootStateSucceeded
+		status.Message = lastOperation.Description
+	case gardenv1beta1.ShootLastOperationStateFailed:
+		status.State = gardenv1beta1.ShootOperationShootStateFailed
+		status.Message = lastOperation.Description
+	}
+	return status
+}
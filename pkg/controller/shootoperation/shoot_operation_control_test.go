@@ -0,0 +1,121 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/controller/shootoperation"
+)
+
+var _ = Describe("ShootOperationControl", func() {
+	var shoot *gardenv1beta1.Shoot
+
+	BeforeEach(func() {
+		shoot = &gardenv1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "johndoe-aws",
+				Namespace:  "garden-dev",
+				Generation: 3,
+			},
+			Status: gardenv1beta1.ShootStatus{
+				ObservedGeneration: 2,
+				LastOperation: &gardenv1beta1.LastOperation{
+					Type:  gardenv1beta1.ShootLastOperationTypeReconcile,
+					State: gardenv1beta1.ShootLastOperationStateFailed,
+				},
+			},
+		}
+	})
+
+	Describe("#computeShootStatus", func() {
+		var status gardenv1beta1.ShootOperationShootStatus
+
+		BeforeEach(func() {
+			status = gardenv1beta1.ShootOperationShootStatus{
+				Name:       "johndoe-aws",
+				State:      gardenv1beta1.ShootOperationShootStateProcessing,
+				Generation: 3,
+			}
+		})
+
+		It("should fail if the Shoot has been deleted", func() {
+			Expect(ExportComputeShootStatus(status, nil).State).To(Equal(gardenv1beta1.ShootOperationShootStateFailed))
+		})
+
+		It("should keep processing until the Shoot controller has observed the triggered generation", func() {
+			Expect(ExportComputeShootStatus(status, shoot)).To(Equal(status))
+		})
+
+		It("should keep processing while the operation of the Shoot is in progress or erroneous", func() {
+			shoot.Status.ObservedGeneration = 3
+
+			for _, state := range []gardenv1beta1.ShootLastOperationState{
+				gardenv1beta1.ShootLastOperationStatePending,
+				gardenv1beta1.ShootLastOperationStateProcessing,
+				gardenv1beta1.ShootLastOperationStateError,
+			} {
+				shoot.Status.LastOperation.State = state
+				Expect(ExportComputeShootStatus(status, shoot)).To(Equal(status))
+			}
+		})
+
+		It("should succeed if the operation of the Shoot has succeeded", func() {
+			shoot.Status.ObservedGeneration = 3
+			shoot.Status.LastOperation.State = gardenv1beta1.ShootLastOperationStateSucceeded
+			shoot.Status.LastOperation.Description = "Shoot cluster state has been successfully reconciled."
+
+			newStatus := ExportComputeShootStatus(status, shoot)
+
+			Expect(newStatus.State).To(Equal(gardenv1beta1.ShootOperationShootStateSucceeded))
+			Expect(newStatus.Message).To(Equal("Shoot cluster state has been successfully reconciled."))
+		})
+
+		It("should fail if the operation of the Shoot has finally failed", func() {
+			shoot.Status.ObservedGeneration = 3
+
+			Expect(ExportComputeShootStatus(status, shoot).State).To(Equal(gardenv1beta1.ShootOperationShootStateFailed))
+		})
+	})
+
+	Describe("#skipReason", func() {
+		It("should trigger a reconciliation for any Shoot", func() {
+			shoot.Status.LastOperation = nil
+
+			Expect(ExportSkipReason(gardenv1beta1.ShootOperationTypeReconcile, shoot)).To(BeEmpty())
+		})
+
+		It("should trigger a retry for a failed Shoot", func() {
+			Expect(ExportSkipReason(gardenv1beta1.ShootOperationTypeRetry, shoot)).To(BeEmpty())
+		})
+
+		It("should skip a retry for a Shoot which has not failed", func() {
+			shoot.Status.LastOperation.State = gardenv1beta1.ShootLastOperationStateSucceeded
+
+			Expect(ExportSkipReason(gardenv1beta1.ShootOperationTypeRetry, shoot)).NotTo(BeEmpty())
+		})
+
+		It("should skip a Shoot which is being deleted", func() {
+			now := metav1.Now()
+			shoot.DeletionTimestamp = &now
+
+			Expect(ExportSkipReason(gardenv1beta1.ShootOperationTypeReconcile, shoot)).NotTo(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,81 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	"github.com/gardener/gardener/pkg/operation/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ShardFilter decides whether Shoots and Seeds belong to the shard of this Gardener controller manager instance.
+type ShardFilter struct {
+	shards int
+	shard  int
+}
+
+// NewShardFilter returns a ShardFilter for the given sharding <config>. If <config> is nil, this instance is
+// responsible for all Shoots and Seeds.
+func NewShardFilter(config *componentconfig.ShardingConfiguration) (*ShardFilter, error) {
+	if config == nil {
+		return &ShardFilter{shards: 1}, nil
+	}
+	if config.Shards < 1 {
+		return nil, fmt.Errorf("the number of shards must be positive, got %d", config.Shards)
+	}
+	if config.Shard < 0 || config.Shard >= config.Shards {
+		return nil, fmt.Errorf("the shard must be in the range [0, %d), got %d", config.Shards, config.Shard)
+	}
+	return &ShardFilter{shards: config.Shards, shard: config.Shard}, nil
+}
+
+// Sharded returns true if the Shoots and Seeds are distributed over more than one shard.
+func (f *ShardFilter) Sharded() bool {
+	return f.shards > 1
+}
+
+// Primary returns true if this instance is responsible for the first shard. Only the primary instance runs the
+// controllers for the resources which are not sharded.
+func (f *ShardFilter) Primary() bool {
+	return f.shard == 0
+}
+
+// Responsible returns true if the given Shoot or Seed <obj> belongs to the shard of this instance.
+func (f *ShardFilter) Responsible(obj metav1.Object) bool {
+	return ShardOf(obj, f.shards) == f.shard
+}
+
+func (f *ShardFilter) String() string {
+	return fmt.Sprintf("shard %d of %d", f.shard, f.shards)
+}
+
+// ShardOf returns the shard in the range [0, <shards>) the given Shoot or Seed <obj> belongs to. Objects which are
+// labeled with a valid shard index are explicitly assigned to that shard, all other objects are assigned by the
+// hash of their namespace and name.
+func ShardOf(obj metav1.Object, shards int) int {
+	if value, ok := obj.GetLabels()[common.ShardLabel]; ok {
+		if shard, err := strconv.Atoi(value); err == nil && shard >= 0 && shard < shards {
+			return shard
+		}
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(obj.GetNamespace() + "/" + obj.GetName()))
+	return int(hash.Sum32() % uint32(shards))
+}
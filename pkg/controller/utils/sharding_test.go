@@ -0,0 +1,100 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controller/utils"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("sharding", func() {
+	shoot := func(name string, labels map[string]string) *gardenv1beta1.Shoot {
+		return &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo", Labels: labels}}
+	}
+
+	Describe("#NewShardFilter", func() {
+		It("should be responsible for all objects without sharding configuration", func() {
+			filter, err := NewShardFilter(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(filter.Sharded()).To(BeFalse())
+			Expect(filter.Primary()).To(BeTrue())
+			for i := 0; i < 10; i++ {
+				Expect(filter.Responsible(shoot(fmt.Sprintf("shoot-%d", i), nil))).To(BeTrue())
+			}
+		})
+
+		It("should reject invalid sharding configurations", func() {
+			_, err := NewShardFilter(&componentconfig.ShardingConfiguration{Shards: 0})
+			Expect(err).To(HaveOccurred())
+			_, err = NewShardFilter(&componentconfig.ShardingConfiguration{Shards: 2, Shard: 2})
+			Expect(err).To(HaveOccurred())
+			_, err = NewShardFilter(&componentconfig.ShardingConfiguration{Shards: 2, Shard: -1})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should make exactly one shard responsible for every object", func() {
+			var filters []*ShardFilter
+			for i := 0; i < 3; i++ {
+				filter, err := NewShardFilter(&componentconfig.ShardingConfiguration{Shards: 3, Shard: i})
+				Expect(err).NotTo(HaveOccurred())
+				filters = append(filters, filter)
+			}
+			Expect(filters[0].Primary()).To(BeTrue())
+			Expect(filters[1].Primary()).To(BeFalse())
+
+			counts := make([]int, 3)
+			for i := 0; i < 300; i++ {
+				responsible := 0
+				for j, filter := range filters {
+					if filter.Responsible(shoot(fmt.Sprintf("shoot-%d", i), nil)) {
+						responsible++
+						counts[j]++
+					}
+				}
+				Expect(responsible).To(Equal(1))
+			}
+			for _, count := range counts {
+				Expect(count).To(BeNumerically(">", 50))
+			}
+		})
+	})
+
+	Describe("#ShardOf", func() {
+		It("should return a stable shard", func() {
+			Expect(ShardOf(shoot("foo", nil), 5)).To(Equal(ShardOf(shoot("foo", nil), 5)))
+		})
+
+		It("should respect the shard label", func() {
+			for i := 0; i < 4; i++ {
+				Expect(ShardOf(shoot("foo", map[string]string{common.ShardLabel: fmt.Sprintf("%d", i)}), 4)).To(Equal(i))
+			}
+		})
+
+		It("should ignore invalid shard labels", func() {
+			hashed := ShardOf(shoot("foo", nil), 4)
+
+			Expect(ShardOf(shoot("foo", map[string]string{common.ShardLabel: "4"}), 4)).To(Equal(hashed))
+			Expect(ShardOf(shoot("foo", map[string]string{common.ShardLabel: "bar"}), 4)).To(Equal(hashed))
+		})
+	})
+})
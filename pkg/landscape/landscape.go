@@ -0,0 +1,170 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package landscape
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenhelper "github.com/gardener/gardener/pkg/apis/garden/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controller/cloudprofile"
+	"github.com/gardener/gardener/pkg/operation/common"
+)
+
+// Violation describes an inconsistency of a single object of the landscape configuration.
+type Violation struct {
+	// Kind is the kind of the object (CloudProfile, Seed or Shoot).
+	Kind string
+	// Name is the name of the object, prefixed with its namespace for namespaced objects.
+	Name string
+	// Message is a human readable description of the violation.
+	Message string
+}
+
+// String returns a human readable representation of the violation.
+func (v Violation) String() string {
+	return fmt.Sprintf("%s %s: %s", v.Kind, v.Name, v.Message)
+}
+
+// Validate checks the given <cloudProfiles>, <seeds> and <shoots> for inconsistencies between each other, i.e. dangling
+// references (including Shoot dependencies), Kubernetes versions which are not supported by the CloudProfile or the Seed, and Shoot networks which
+// intersect with the networks of their Seed. It does not modify any object and returns the list of all violations,
+// which is empty if the landscape configuration is consistent.
+func Validate(cloudProfiles []gardenv1beta1.CloudProfile, seeds []gardenv1beta1.Seed, shoots []gardenv1beta1.Shoot) []Violation {
+	var (
+		violations         []Violation
+		cloudProfileByName = make(map[string]*gardenv1beta1.CloudProfile, len(cloudProfiles))
+		seedByName         = make(map[string]*gardenv1beta1.Seed, len(seeds))
+		shootKeys          = make(map[string]bool, len(shoots))
+	)
+
+	for i := range cloudProfiles {
+		cloudProfileByName[cloudProfiles[i].Name] = &cloudProfiles[i]
+	}
+	for i := range seeds {
+		seed := &seeds[i]
+		seedByName[seed.Name] = seed
+
+		if _, ok := cloudProfileByName[seed.Spec.Cloud.Profile]; !ok {
+			violations = append(violations, Violation{"Seed", seed.Name, fmt.Sprintf("referenced CloudProfile %s does not exist", seed.Spec.Cloud.Profile)})
+		}
+	}
+
+	for _, shoot := range shoots {
+		shootKeys[fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)] = true
+	}
+	for i := range shoots {
+		violations = append(violations, validateShoot(&shoots[i], cloudProfileByName, seedByName, shootKeys)...)
+	}
+
+	return violations
+}
+
+func validateShoot(shoot *gardenv1beta1.Shoot, cloudProfileByName map[string]*gardenv1beta1.CloudProfile, seedByName map[string]*gardenv1beta1.Seed, shootKeys map[string]bool) []Violation {
+	var (
+		violations []Violation
+		name       = fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)
+		version    = shoot.Spec.Kubernetes.Version
+	)
+
+	newViolation := func(format string, args ...interface{}) Violation {
+		return Violation{"Shoot", name, fmt.Sprintf(format, args...)}
+	}
+
+	if cloudProfile, ok := cloudProfileByName[shoot.Spec.Cloud.Profile]; !ok {
+		violations = append(violations, newViolation("referenced CloudProfile %s does not exist", shoot.Spec.Cloud.Profile))
+	} else {
+		messages, err := cloudprofile.CheckShootCompliance(cloudProfile, shoot)
+		if err != nil {
+			violations = append(violations, newViolation("could not check compliance with CloudProfile %s: %v", cloudProfile.Name, err))
+		}
+		for _, message := range messages {
+			violations = append(violations, newViolation("does not comply with CloudProfile %s: %s", cloudProfile.Name, message))
+		}
+	}
+
+	for _, dependency := range shoot.Spec.DependsOn {
+		if key := common.ShootDependencyKey(shoot.Namespace, dependency); !shootKeys[key] {
+			violations = append(violations, newViolation("referenced dependency Shoot %s does not exist", key))
+		}
+	}
+
+	// Shoots which have not yet been scheduled to a Seed are not checked against it.
+	if shoot.Spec.Cloud.Seed == nil {
+		return violations
+	}
+
+	seed, ok := seedByName[*shoot.Spec.Cloud.Seed]
+	if !ok {
+		return append(violations, newViolation("referenced Seed %s does not exist", *shoot.Spec.Cloud.Seed))
+	}
+
+	if seed.Spec.Cloud.Profile != shoot.Spec.Cloud.Profile {
+		violations = append(violations, newViolation("uses CloudProfile %s but its Seed %s uses CloudProfile %s", shoot.Spec.Cloud.Profile, seed.Name, seed.Spec.Cloud.Profile))
+	}
+
+	internalSeed := &garden.Seed{}
+	if err := gardenv1beta1.Convert_v1beta1_Seed_To_garden_Seed(seed, internalSeed, nil); err != nil {
+		violations = append(violations, newViolation("could not convert Seed %s: %v", seed.Name, err))
+	} else if supported, err := gardenhelper.SeedSupportsShootKubernetesVersion(internalSeed, version); err != nil || !supported {
+		violations = append(violations, newViolation("Kubernetes version %s is not supported by its Seed %s", version, seed.Name))
+	}
+
+	if k8sNetworks := getK8SNetworks(shoot); k8sNetworks != nil {
+		seedNetworks := seed.Spec.Networks
+		for _, n := range []struct {
+			name        string
+			shoot, seed *gardenv1beta1.CIDR
+		}{
+			{"node", k8sNetworks.Nodes, &seedNetworks.Nodes},
+			{"pod", k8sNetworks.Pods, &seedNetworks.Pods},
+			{"service", k8sNetworks.Services, &seedNetworks.Services},
+		} {
+			if n.shoot != nil && networksIntersect(*n.shoot, *n.seed) {
+				violations = append(violations, newViolation("%s network %s intersects with the %s network %s of its Seed %s", n.name, *n.shoot, n.name, *n.seed, seed.Name))
+			}
+		}
+	}
+
+	return violations
+}
+
+// getK8SNetworks returns the Kubernetes network CIDRs of the given <shoot>.
+func getK8SNetworks(shoot *gardenv1beta1.Shoot) *gardenv1beta1.K8SNetworks {
+	cloud := shoot.Spec.Cloud
+	switch {
+	case cloud.AWS != nil:
+		return &cloud.AWS.Networks.K8SNetworks
+	case cloud.Azure != nil:
+		return &cloud.Azure.Networks.K8SNetworks
+	case cloud.GCP != nil:
+		return &cloud.GCP.Networks.K8SNetworks
+	case cloud.OpenStack != nil:
+		return &cloud.OpenStack.Networks.K8SNetworks
+	case cloud.Packet != nil:
+		return &cloud.Packet.Networks.K8SNetworks
+	case cloud.Local != nil:
+		return &cloud.Local.Networks.K8SNetworks
+	}
+	return nil
+}
+
+func networksIntersect(cidr1, cidr2 gardenv1beta1.CIDR) bool {
+	_, net1, err1 := net.ParseCIDR(string(cidr1))
+	_, net2, err2 := net.ParseCIDR(string(cidr2))
+	return err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP)
+}
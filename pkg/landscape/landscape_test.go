@@ -0,0 +1,137 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package landscape_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/landscape"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("landscape", func() {
+	Describe("#Validate", func() {
+		var (
+			cloudProfile gardenv1beta1.CloudProfile
+			seed         gardenv1beta1.Seed
+			shoot        gardenv1beta1.Shoot
+
+			seedName  = "seed"
+			nodesCIDR = gardenv1beta1.CIDR("10.250.0.0/16")
+		)
+
+		BeforeEach(func() {
+			cloudProfile = gardenv1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws"},
+				Spec: gardenv1beta1.CloudProfileSpec{
+					AWS: &gardenv1beta1.AWSProfile{
+						Constraints: gardenv1beta1.AWSConstraints{
+							Kubernetes: gardenv1beta1.KubernetesConstraints{Versions: []string{"1.10.5"}},
+						},
+					},
+				},
+			}
+			seed = gardenv1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: seedName},
+				Spec: gardenv1beta1.SeedSpec{
+					Cloud: gardenv1beta1.SeedCloud{Profile: "aws", Region: "eu-west-1"},
+					Networks: gardenv1beta1.SeedNetworks{
+						Nodes:    gardenv1beta1.CIDR("10.240.0.0/16"),
+						Pods:     gardenv1beta1.CIDR("10.241.0.0/16"),
+						Services: gardenv1beta1.CIDR("10.242.0.0/16"),
+					},
+				},
+			}
+			shoot = gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						Profile: "aws",
+						Region:  "eu-west-1",
+						Seed:    &seedName,
+						AWS: &gardenv1beta1.AWSCloud{
+							Networks: gardenv1beta1.AWSNetworks{
+								K8SNetworks: gardenv1beta1.K8SNetworks{Nodes: &nodesCIDR},
+							},
+						},
+					},
+					Kubernetes: gardenv1beta1.Kubernetes{Version: "1.10.5"},
+				},
+			}
+		})
+
+		validate := func() []Violation {
+			return Validate([]gardenv1beta1.CloudProfile{cloudProfile}, []gardenv1beta1.Seed{seed}, []gardenv1beta1.Shoot{shoot})
+		}
+
+		It("should not report violations for a consistent landscape", func() {
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should report Seeds and Shoots referencing unknown CloudProfiles", func() {
+			cloudProfile.Name = "gcp"
+
+			violations := validate()
+
+			Expect(violations).To(ContainElement(Violation{Kind: "Seed", Name: "seed", Message: "referenced CloudProfile aws does not exist"}))
+			Expect(violations).To(ContainElement(Violation{Kind: "Shoot", Name: "garden-dev/shoot", Message: "referenced CloudProfile aws does not exist"}))
+		})
+
+		It("should report Shoots referencing unknown Seeds", func() {
+			seed.Name = "other-seed"
+
+			Expect(validate()).To(ConsistOf(Violation{Kind: "Shoot", Name: "garden-dev/shoot", Message: "referenced Seed seed does not exist"}))
+		})
+
+		It("should report Shoots depending on unknown Shoots", func() {
+			shoot.Spec.DependsOn = []corev1.ObjectReference{{Namespace: "garden", Name: "base"}}
+
+			Expect(validate()).To(ConsistOf(Violation{Kind: "Shoot", Name: "garden-dev/shoot", Message: "referenced dependency Shoot garden/base does not exist"}))
+		})
+
+		It("should not check unscheduled Shoots against a Seed", func() {
+			shoot.Spec.Cloud.Seed = nil
+			seed.Name = "other-seed"
+
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should report Kubernetes versions not offered by the CloudProfile", func() {
+			shoot.Spec.Kubernetes.Version = "1.9.8"
+
+			violations := validate()
+
+			Expect(violations).To(HaveLen(1))
+			Expect(violations[0].Kind).To(Equal("Shoot"))
+			Expect(violations[0].Message).To(ContainSubstring("does not comply with CloudProfile aws"))
+		})
+
+		It("should report Kubernetes versions not supported by the Seed", func() {
+			max := "1.9"
+			seed.Spec.ShootKubernetesVersions = &gardenv1beta1.SeedShootKubernetesVersions{Max: &max}
+
+			Expect(validate()).To(ConsistOf(Violation{Kind: "Shoot", Name: "garden-dev/shoot", Message: "Kubernetes version 1.10.5 is not supported by its Seed seed"}))
+		})
+
+		It("should report Shoot networks intersecting with the Seed networks", func() {
+			seed.Spec.Networks.Nodes = gardenv1beta1.CIDR("10.250.0.0/24")
+
+			Expect(validate()).To(ConsistOf(Violation{Kind: "Shoot", Name: "garden-dev/shoot", Message: "node network 10.250.0.0/16 intersects with the node network 10.250.0.0/24 of its Seed seed"}))
+		})
+	})
+})
@@ -214,6 +214,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								},
 							},
 						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of the image. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"name", "regions"},
 				},
@@ -338,6 +345,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"warmAMI": {
+							SchemaProps: spec.SchemaProps{
+								Description: "WarmAMI is the technical id of a pre-warmed variant of the image which already contains the container images required on the nodes. Machines of worker groups with enabled warm-up boot from it.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"name", "ami"},
 				},
@@ -486,11 +500,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular"),
 							},
 						},
+						"network-policies": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NetworkPolicies holds configuration settings for the network-policies addon.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicies"),
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicies", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud": {
 			Schema: spec.Schema{
@@ -670,6 +690,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of the image. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"name", "publisher", "offer", "sku", "version"},
 				},
@@ -1090,18 +1117,43 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud"),
 							},
 						},
+						"packet": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Packet contains the Shoot specification for the Packet bare-metal cloud.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud"),
+							},
+						},
 						"local": {
 							SchemaProps: spec.SchemaProps{
 								Description: "Local contains the Shoot specification for the Local local provider.",
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local"),
 							},
 						},
+						"externalWorkers": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ExternalWorkers is a list of worker groups whose machines are not managed by the Gardener, e.g. for hardware which it cannot provision. Their nodes join the Shoot with the user data which the Gardener publishes for each of them, and their kubelets and addons are managed like those of the other worker groups.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ExternalWorker"),
+										},
+									},
+								},
+							},
+						},
+						"scaleDownBudget": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ScaleDownBudget limits the number of machines of all worker groups which are deleted within a period when the desired number of machines decreases, e.g. after their maximum has been reduced. The remaining machines are removed with the following reconciliations. Unlimited if not set.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ScaleDownBudget"),
+							},
+						},
 					},
 					Required: []string{"profile", "region", "secretBindingRef"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud", "k8s.io/api/core/v1.LocalObjectReference"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ExternalWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ScaleDownBudget", "k8s.io/api/core/v1.LocalObjectReference"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile": {
 			Schema: spec.Schema{
@@ -1214,6 +1266,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile"),
 							},
 						},
+						"packet": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Packet is the profile specification for the Packet bare-metal cloud.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile"),
+							},
+						},
 						"local": {
 							SchemaProps: spec.SchemaProps{
 								Description: "Local is the profile specification for the Local provider.",
@@ -1227,11 +1285,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"seedSelectionStrategy": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SeedSelectionStrategy is the strategy used to determine the Seed cluster of Shoots using this profile which do not reference a Seed. It overrides the globally configured strategy.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler": {
 			Schema: spec.Schema{
@@ -1354,6 +1419,72 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ExternalWorker": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ExternalWorker is a worker group whose machines are not managed by the Gardener.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the worker group.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"nodeLabels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeLabels is a map of additional labels for the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"nodeTaints": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeTaints is a list of taints for the nodes of the worker group.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.Taint"),
+										},
+									},
+								},
+							},
+						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of the nodes of the worker group. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"kubernetesVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubernetesVersion is the version of the kubelets of the worker group. It must not be newer than the version of the control plane and must be at most two minor versions older. Defaults to the Kubernetes version of the Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"kubelet": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kubelet contains the configuration of the kubelets of the worker group. Only supported for Kubernetes versions >= 1.10.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+							},
+						},
+					},
+					Required: []string{"name"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "k8s.io/api/core/v1.Taint"},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -1507,6 +1638,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of the image. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"warmImage": {
+							SchemaProps: spec.SchemaProps{
+								Description: "WarmImage is the technical name of a pre-warmed variant of the image which already contains the container images required on the nodes. Machines of worker groups with enabled warm-up boot from it. It is only considered in CloudProfiles.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"name", "image"},
 				},
@@ -1649,6 +1794,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"preemptible": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Preemptible indicates whether the worker group uses preemptible VMs. Their nodes are drained as soon as GCP announces their termination.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 				},
@@ -1723,6 +1875,24 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Hibernation contains information whether the Shoot is suspended or not. A hibernated Shoot keeps its control plane and infrastructure, but its machines are deleted and re-created when it is woken up again.",
+					Properties: map[string]spec.Schema{
+						"enabled": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Enabled is true if the Shoot's desired state is hibernated, false otherwise.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"enabled"},
+				},
+			},
+			Dependencies: []string{},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.K8SNetworks": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -1857,11 +2027,74 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"),
 							},
 						},
+						"requests": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Requests contains configuration settings for the request throughput of the kube-apiserver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerRequests"),
+							},
+						},
+						"watchCacheSizes": {
+							SchemaProps: spec.SchemaProps{
+								Description: "WatchCacheSizes contains configuration settings for the watch caches of the kube-apiserver.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WatchCacheSizes"),
+							},
+						},
+						"eventTTL": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EventTTL is the amount of time events are retained (defaults to 1h).",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"loadBalancerIP": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LoadBalancerIP is a static IP address which has been reserved in the cloud provider account of the Seed and which is assigned to the load balancer exposing the kube-apiserver. It remains stable when the load balancer is recreated. Not supported on AWS.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"serviceAnnotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ServiceAnnotations is a map of additional annotations for the Service of type LoadBalancer which exposes the kube-apiserver in the Seed, e.g. to select the type or the SKU of the load balancer or to make it internal. Only annotations of the cloud provider of the Seed are allowed.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerRequests", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerRequests": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "KubeAPIServerRequests contains configuration settings for the request throughput of the kube-apiserver. If a value is not set, a default derived from the maximum size of the Shoot cluster is used.",
+					Properties: map[string]spec.Schema{
+						"maxNonMutatingInflight": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxNonMutatingInflight is the maximum number of non-mutating requests in flight at a given time. When the kube-apiserver exceeds this, it rejects requests. Zero means no limit.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"maxMutatingInflight": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxMutatingInflight is the maximum number of mutating requests in flight at a given time. When the kube-apiserver exceeds this, it rejects requests. Zero means no limit.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig": {
 			Schema: spec.Schema{
@@ -2119,6 +2352,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"taskID": {
+							SchemaProps: spec.SchemaProps{
+								Description: "TaskID is the identifier of the task of the operation in which the error occurred, if any.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 						"codes": {
 							SchemaProps: spec.SchemaProps{
 								Description: "Well-defined error codes of the last error(s).",
@@ -2178,12 +2418,57 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"steps": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Steps contains the durations of the steps of the last operation which have been executed so far, in the order in which they have been completed.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperationStep"),
+										},
+									},
+								},
+							},
+						},
 					},
 					Required: []string{"description", "lastUpdateTime", "progress", "state", "type"},
 				},
 			},
 			Dependencies: []string{
-				"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperationStep", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperationStep": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "LastOperationStep contains the duration of a single step of an operation.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the step.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"duration": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Duration is the time it took to execute the step, including all retries.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"state": {
+							SchemaProps: spec.SchemaProps{
+								Description: "State of the step, one of Succeeded, Error.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"name", "duration", "state"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Local": {
 			Schema: spec.Schema{
@@ -2203,12 +2488,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"workers": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Workers is a list of worker groups whose machines are simulated by a fake machine-controller-manager instead of being created by the local service. The simulated machines do not join the Shoot as nodes.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalWorker"),
+										},
+									},
+								},
+							},
+						},
 					},
 					Required: []string{"networks", "endpoint"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalWorker"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints": {
 			Schema: spec.Schema{
@@ -2298,43 +2596,88 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{
 				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalConstraints"},
-		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType": {
+		},		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.LocalWorker": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "MachineType contains certain properties of a machine type.",
+					Description: "LocalWorker is the definition of a worker group whose machines are simulated.",
 					Properties: map[string]spec.Schema{
 						"name": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Name is the name of the machine type.",
+								Description: "Name is the name of the worker group.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"cpu": {
+						"machineType": {
 							SchemaProps: spec.SchemaProps{
-								Description: "CPU is the number of CPUs for this machine type.",
-								Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+								Description: "MachineType is the machine type of the worker group.",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"gpu": {
+						"autoScalerMin": {
 							SchemaProps: spec.SchemaProps{
-								Description: "GPU is the number of GPUs for this machine type.",
-								Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+								Description: "AutoScalerMin is the minimum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
 							},
 						},
-						"memory": {
+						"autoScalerMax": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Memory is the amount of memory for this machine type.",
-								Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+								Description: "AutoScalerMin is the maximum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
 							},
 						},
 					},
-					Required: []string{"name", "cpu", "gpu", "memory"},
+					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 				},
 			},
-			Dependencies: []string{
-				"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "MachineType contains certain properties of a machine type.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the machine type.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"cpu": {
+							SchemaProps: spec.SchemaProps{
+								Description: "CPU is the number of CPUs for this machine type.",
+								Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							},
+						},
+						"gpu": {
+							SchemaProps: spec.SchemaProps{
+								Description: "GPU is the number of GPUs for this machine type.",
+								Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							},
+						},
+						"memory": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Memory is the amount of memory for this machine type.",
+								Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							},
+						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of this machine type. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"name", "cpu", "gpu", "memory"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance": {
 			Schema: spec.Schema{
@@ -2347,6 +2690,27 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate"),
 							},
 						},
+						"replaceMachinesOnCredentialsChange": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ReplaceMachinesOnCredentialsChange indicates whether the machines are replaced by a rolling update when the cloud provider credentials change. Otherwise, the credentials of the existing machines are updated in place.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"replaceMachinesOnCloudConfigChange": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ReplaceMachinesOnCloudConfigChange indicates whether the machines of a worker group are replaced by a rolling update when its cloud config (e.g., the kubelet parameters or the CA bundle) changes. Otherwise, the changes are only applied in place by the cloud config downloader on the existing machines.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"replaceMachinesInTimeWindow": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ReplaceMachinesInTimeWindow indicates whether changes which replace the machines of a worker group (e.g., a new machine image, machine type or cloud config) are deferred until the maintenance time window. Other changes, e.g. of the number of machines, are still applied immediately.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
 						"timeWindow": {
 							SchemaProps: spec.SchemaProps{
 								Description: "TimeWindow contains information about the time window for maintenance operations.",
@@ -2420,6 +2784,38 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NetworkPolicies": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "NetworkPolicies describes configuration values for the network-policies addon.",
+					Properties: map[string]spec.Schema{
+						"enabled": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Enabled indicates whether the addon is enabled or not.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"excludedNamespaces": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ExcludedNamespaces is a list of namespaces which do not get the baseline network policies.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"enabled"},
+				},
+			},
+			Dependencies: []string{},
+		},
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
@@ -2713,6 +3109,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Format:      "",
 							},
 						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of the image. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
 					Required: []string{"name", "image"},
 				},
@@ -2749,6 +3152,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 								Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 							},
 						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of this machine type. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 						"volumeType": {
 							SchemaProps: spec.SchemaProps{
 								Description: "VolumeType is the type of that volume.",
@@ -2925,225 +3335,250 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 			},
 			Dependencies: []string{},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
+					Description: "PacketCloud contains the Shoot specification for Packet.",
 					Properties: map[string]spec.Schema{
-						"kind": {
+						"machineImage": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-								Type:        []string{"string"},
-								Format:      "",
+								Description: "MachineImage holds information about the machine image to use for all workers. It will default to the first image stated in the referenced CloudProfile if no value has been provided.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage"),
 							},
 						},
-						"apiVersion": {
+						"networks": {
 							SchemaProps: spec.SchemaProps{
-								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-								Type:        []string{"string"},
-								Format:      "",
+								Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks"),
 							},
 						},
-						"metadata": {
+						"workers": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Standard object metadata.",
-								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+								Description: "Workers is a list of worker groups.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"),
+										},
+									},
+								},
 							},
 						},
-						"spec": {
+						"zones": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Spec defines the Quota constraints.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec"),
+								Description: "Zones is a list of facilities to deploy the Shoot cluster to.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
 							},
 						},
 					},
+					Required: []string{"networks", "workers", "zones"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "QuotaList is a collection of Quotas.",
+					Description: "PacketConstraints is an object containing constraints for certain values in the Shoot specification.",
 					Properties: map[string]spec.Schema{
-						"kind": {
+						"dnsProviders": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-								Type:        []string{"string"},
-								Format:      "",
+								Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+										},
+									},
+								},
 							},
 						},
-						"apiVersion": {
+						"kubernetes": {
 							SchemaProps: spec.SchemaProps{
-								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-								Type:        []string{"string"},
-								Format:      "",
+								Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
 							},
 						},
-						"metadata": {
+						"machineImages": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Standard list object metadata.",
-								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+								Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage"),
+										},
+									},
+								},
 							},
 						},
-						"items": {
+						"machineTypes": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Items is the list of Quotas.",
+								Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
 								Type:        []string{"array"},
 								Items: &spec.SchemaOrArray{
 									Schema: &spec.Schema{
 										SchemaProps: spec.SchemaProps{
-											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota"),
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
+										},
+									},
+								},
+							},
+						},
+						"zones": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
 										},
 									},
 								},
 							},
 						},
 					},
-					Required: []string{"items"},
+					Required: []string{"dnsProviders", "kubernetes", "machineImages", "machineTypes", "zones"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketMachineImage": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "QuotaSpec is the specification of a Quota.",
+					Description: "PacketMachineImage defines the name and the operating system of the machine image in the Packet environment.",
 					Properties: map[string]spec.Schema{
-						"clusterLifetimeDays": {
+						"name": {
 							SchemaProps: spec.SchemaProps{
-								Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
-								Type:        []string{"integer"},
-								Format:      "int32",
+								Description: "Name is the name of the image.",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"metrics": {
+						"operatingSystem": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Metrics is a list of resources which will be put under constraints.",
-								Type:        []string{"object"},
-								AdditionalProperties: &spec.SchemaOrBool{
-									Schema: &spec.Schema{
-										SchemaProps: spec.SchemaProps{
-											Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-										},
-									},
-								},
+								Description: "OperatingSystem is the slug of the Packet operating system which is installed on the servers.",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"scope": {
+						"architecture": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Scope is the scope of the Quota object, either 'project' or 'secret'.",
+								Description: "Architecture is the CPU architecture of the image. Defaults to amd64.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
 					},
-					Required: []string{"metrics", "scope"},
+					Required: []string{"name", "operatingSystem"},
 				},
 			},
-			Dependencies: []string{
-				"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			Dependencies: []string{},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
+					Description: "PacketNetworks holds information about the Kubernetes and infrastructure networks.",
 					Properties: map[string]spec.Schema{
-						"kind": {
+						"nodes": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Description: "Nodes is the CIDR of the node network.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"apiVersion": {
+						"pods": {
 							SchemaProps: spec.SchemaProps{
-								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Description: "Pods is the CIDR of the pod network.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"metadata": {
+						"services": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Standard object metadata.",
-								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+								Description: "Services is the CIDR of the service network.",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"secretRef": {
+					},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "PacketProfile defines certain constraints and definitions for the Packet bare-metal cloud.",
+					Properties: map[string]spec.Schema{
+						"constraints": {
 							SchemaProps: spec.SchemaProps{
-								Description: "SecretRef is a reference to a secret object in the same or another namespace.",
-								Ref:         ref("k8s.io/api/core/v1.SecretReference"),
-							},
-						},
-						"quotas": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Quotas is a list of references to Quota objects in the same or another namespace.",
-								Type:        []string{"array"},
-								Items: &spec.SchemaOrArray{
-									Schema: &spec.Schema{
-										SchemaProps: spec.SchemaProps{
-											Ref: ref("k8s.io/api/core/v1.ObjectReference"),
-										},
-									},
-								},
+								Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"),
 							},
 						},
 					},
-					Required: []string{"secretRef"},
+					Required: []string{"constraints"},
 				},
 			},
 			Dependencies: []string{
-				"k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "SecretBindingList is a collection of SecretBindings.",
+					Description: "PacketWorker is the definition of a worker group.",
 					Properties: map[string]spec.Schema{
-						"kind": {
+						"name": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Description: "Name is the name of the worker group.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"apiVersion": {
+						"machineType": {
 							SchemaProps: spec.SchemaProps{
-								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Description: "MachineType is the machine type of the worker group.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"metadata": {
+						"autoScalerMin": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Standard list object metadata.",
-								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+								Description: "AutoScalerMin is the minimum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
 							},
 						},
-						"items": {
+						"autoScalerMax": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Items is the list of SecretBindings.",
-								Type:        []string{"array"},
-								Items: &spec.SchemaOrArray{
-									Schema: &spec.Schema{
-										SchemaProps: spec.SchemaProps{
-											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding"),
-										},
-									},
-								},
+								Description: "AutoScalerMin is the maximum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
 							},
 						},
 					},
-					Required: []string{"items"},
+					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 				},
 			},
-			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			Dependencies: []string{},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "Seed holds certain properties about a Seed cluster.",
 					Properties: map[string]spec.Schema{
 						"kind": {
 							SchemaProps: spec.SchemaProps{
@@ -3167,56 +3602,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 						},
 						"spec": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Spec defines the Seed cluster properties.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec"),
-							},
-						},
-						"status": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Most recently observed status of the Seed cluster.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus"),
+								Description: "Spec defines the Quota constraints.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec"),
 							},
 						},
 					},
 				},
-				VendorExtensible: spec.VendorExtensible{
-					Extensions: spec.Extensions{
-						"x-kubernetes-print-columns": "custom-columns=NAME:.metadata.name,DOMAIN:.spec.ingressDomain,CLOUDPROFILE:.spec.cloud.profile,REGION:.spec.cloud.profile,READY:.status.conditions[?(@.type == 'Available')].status",
-					},
-				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud": {
-			Schema: spec.Schema{
-				SchemaProps: spec.SchemaProps{
-					Description: "SeedCloud defines the cloud profile and the region this Seed cluster belongs to.",
-					Properties: map[string]spec.Schema{
-						"profile": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Profile is the name of a cloud profile.",
-								Type:        []string{"string"},
-								Format:      "",
-							},
-						},
-						"region": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Region is a name of a region.",
-								Type:        []string{"string"},
-								Format:      "",
-							},
-						},
-					},
-					Required: []string{"profile", "region"},
-				},
-			},
-			Dependencies: []string{},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaList": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "SeedList is a collection of Seeds.",
+					Description: "QuotaList is a collection of Quotas.",
 					Properties: map[string]spec.Schema{
 						"kind": {
 							SchemaProps: spec.SchemaProps{
@@ -3240,12 +3639,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 						},
 						"items": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Items is the list of Seeds.",
+								Description: "Items is the list of Quotas.",
 								Type:        []string{"array"},
 								Items: &spec.SchemaOrArray{
 									Schema: &spec.Schema{
 										SchemaProps: spec.SchemaProps{
-											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed"),
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota"),
 										},
 									},
 								},
@@ -3256,118 +3655,51 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks": {
-			Schema: spec.Schema{
-				SchemaProps: spec.SchemaProps{
-					Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
-					Properties: map[string]spec.Schema{
-						"nodes": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Nodes is the CIDR of the node network.",
-								Type:        []string{"string"},
-								Format:      "",
-							},
-						},
-						"pods": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Pods is the CIDR of the pod network.",
-								Type:        []string{"string"},
-								Format:      "",
-							},
-						},
-						"services": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Services is the CIDR of the service network.",
-								Type:        []string{"string"},
-								Format:      "",
-							},
-						},
-					},
-					Required: []string{"nodes", "pods", "services"},
-				},
-			},
-			Dependencies: []string{},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "SeedSpec is the specification of a Seed.",
+					Description: "QuotaSpec is the specification of a Quota.",
 					Properties: map[string]spec.Schema{
-						"cloud": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Cloud defines the cloud profile and the region this Seed cluster belongs to.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud"),
-							},
-						},
-						"ingressDomain": {
-							SchemaProps: spec.SchemaProps{
-								Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
-								Type:        []string{"string"},
-								Format:      "",
-							},
-						},
-						"secretRef": {
-							SchemaProps: spec.SchemaProps{
-								Description: "SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for the account the Seed cluster has been deployed to.",
-								Ref:         ref("k8s.io/api/core/v1.SecretReference"),
-							},
-						},
-						"networks": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Networks defines the pod, service and worker network of the Seed cluster.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks"),
-							},
-						},
-						"visible": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Visible labels the Seed cluster as selectable for the seedfinder admisson controller.",
-								Type:        []string{"boolean"},
-								Format:      "",
-							},
-						},
-						"protected": {
+						"clusterLifetimeDays": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.",
-								Type:        []string{"boolean"},
-								Format:      "",
+								Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
+								Type:        []string{"integer"},
+								Format:      "int32",
 							},
 						},
-					},
-					Required: []string{"cloud", "ingressDomain", "secretRef", "networks"},
-				},
-			},
-			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks", "k8s.io/api/core/v1.SecretReference"},
-		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus": {
-			Schema: spec.Schema{
-				SchemaProps: spec.SchemaProps{
-					Description: "SeedStatus holds the most recently observed status of the Seed cluster.",
-					Properties: map[string]spec.Schema{
-						"conditions": {
+						"metrics": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Conditions represents the latest available observations of a Seed's current state.",
-								Type:        []string{"array"},
-								Items: &spec.SchemaOrArray{
+								Description: "Metrics is a list of resources which will be put under constraints.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
 									Schema: &spec.Schema{
 										SchemaProps: spec.SchemaProps{
-											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition"),
+											Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 										},
 									},
 								},
 							},
 						},
+						"scope": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Scope is the scope of the Quota object, either 'project' or 'secret'.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
 					},
+					Required: []string{"metrics", "scope"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition"},
+				"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionPolicy": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
+					Description: "RegionPolicy restricts the regions of a CloudProfile which the projects selected by it may use for their Shoots, e.g. for reasons of data residency. A Shoot may only use a region of a CloudProfile which is listed by at least one of the RegionPolicies for the CloudProfile which select its project. If no RegionPolicy selects the project, all regions of the CloudProfile may be used.",
 					Properties: map[string]spec.Schema{
 						"kind": {
 							SchemaProps: spec.SchemaProps{
@@ -3391,31 +3723,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 						},
 						"spec": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Specification of the Shoot cluster.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec"),
-							},
-						},
-						"status": {
-							SchemaProps: spec.SchemaProps{
-								Description: "Most recently observed status of the Shoot cluster.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus"),
+								Description: "Specification of the RegionPolicy.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionPolicySpec"),
 							},
 						},
 					},
 				},
 				VendorExtensible: spec.VendorExtensible{
 					Extensions: spec.Extensions{
-						"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state",
+						"x-kubernetes-print-columns": "custom-columns=NAME:.metadata.name,CLOUD PROFILE:.spec.cloudProfileName,REGIONS:.spec.regions,CREATION TIMESTAMP:.metadata.creationTimestamp",
 					},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionPolicyList": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "ShootList is a list of Shoot objects.",
+					Description: "RegionPolicyList is a list of RegionPolicy objects.",
 					Properties: map[string]spec.Schema{
 						"kind": {
 							SchemaProps: spec.SchemaProps{
@@ -3439,12 +3765,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 						},
 						"items": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Items is the list of Shoots.",
+								Description: "Items is the list of RegionPolicies.",
 								Type:        []string{"array"},
 								Items: &spec.SchemaOrArray{
 									Schema: &spec.Schema{
 										SchemaProps: spec.SchemaProps{
-											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot"),
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionPolicy"),
 										},
 									},
 								},
@@ -3455,193 +3781,2129 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.RegionPolicySpec": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "ShootSpec is the specification of a Shoot.",
+					Description: "RegionPolicySpec is the specification of a RegionPolicy.",
 					Properties: map[string]spec.Schema{
-						"addons": {
+						"cloudProfileName": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Addons contains information about enabled/disabled addons and their configuration.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
+								Description: "CloudProfileName is the name of the CloudProfile whose regions are restricted.",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"backup": {
+						"projectSelector": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Backup contains configuration settings for the etcd backups.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup"),
+								Description: "ProjectSelector is a label query over the project namespaces to which the RegionPolicy applies. An empty selector selects all projects.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 							},
 						},
-						"cloud": {
+						"regions": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Cloud contains information about the cloud environment and their specific settings.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
+								Description: "Regions is the list of regions of the CloudProfile which the selected projects may use.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
 							},
 						},
-						"dns": {
-							SchemaProps: spec.SchemaProps{
-								Description: "DNS contains information about the DNS settings of the Shoot.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS"),
-							},
+					},
+					Required: []string{"cloudProfileName", "regions"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ScaleDownBudget": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ScaleDownBudget limits the number of machines which are deleted within a period when the desired number of machines decreases.",
+					Properties: map[string]spec.Schema{
+						"maxMachines": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxMachines is the maximum number of machines which are deleted within the period.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
 						},
-						"kubernetes": {
+						"period": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Kubernetes contains the version and configuration settings of the control plane components.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes"),
+								Description: "Period is the duration within which at most MaxMachines machines are deleted. Defaults to 10m.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 							},
 						},
-						"maintenance": {
+					},
+					Required: []string{"maxMachines"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Properties: map[string]spec.Schema{
+						"kind": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							},
+						},
+						"secretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SecretRef is a reference to a secret object in the same or another namespace.",
+								Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							},
+						},
+						"quotas": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Quotas is a list of references to Quota objects in the same or another namespace.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.ObjectReference"),
+										},
+									},
+								},
 							},
 						},
 					},
-					Required: []string{"cloud", "dns", "kubernetes"},
+					Required: []string{"secretRef"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"},
+				"k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+					Description: "SecretBindingList is a collection of SecretBindings.",
 					Properties: map[string]spec.Schema{
-						"conditions": {
+						"kind": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Conditions represents the latest available observations of a Shoots's current state.",
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard list object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							},
+						},
+						"items": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Items is the list of SecretBindings.",
 								Type:        []string{"array"},
 								Items: &spec.SchemaOrArray{
 									Schema: &spec.Schema{
 										SchemaProps: spec.SchemaProps{
-											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition"),
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding"),
 										},
 									},
 								},
 							},
 						},
-						"gardener": {
+					},
+					Required: []string{"items"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Seed holds certain properties about a Seed cluster.",
+					Properties: map[string]spec.Schema{
+						"kind": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"lastOperation": {
+						"apiVersion": {
 							SchemaProps: spec.SchemaProps{
-								Description: "LastOperation holds information about the last operation on the Shoot.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation"),
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"lastError": {
+						"metadata": {
 							SchemaProps: spec.SchemaProps{
-								Description: "LastError holds information about the last occurred error during an operation.",
-								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError"),
+								Description: "Standard object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 							},
 						},
-						"observedGeneration": {
+						"spec": {
 							SchemaProps: spec.SchemaProps{
-								Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
-								Type:        []string{"integer"},
-								Format:      "int64",
+								Description: "Spec defines the Seed cluster properties.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec"),
 							},
 						},
-						"retryCycleStartTime": {
+						"status": {
 							SchemaProps: spec.SchemaProps{
-								Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
-								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+								Description: "Most recently observed status of the Seed cluster.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus"),
 							},
 						},
-						"seed": {
+					},
+				},
+				VendorExtensible: spec.VendorExtensible{
+					Extensions: spec.Extensions{
+						"x-kubernetes-print-columns": "custom-columns=NAME:.metadata.name,DOMAIN:.spec.ingressDomain,CLOUDPROFILE:.spec.cloud.profile,REGION:.spec.cloud.profile,READY:.status.conditions[?(@.type == 'Available')].status",
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequest": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedAccessRequest is a request of an operator for temporary access to the namespace of a Shoot in its Seed cluster.",
+					Properties: map[string]spec.Schema{
+						"kind": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"technicalID": {
+						"apiVersion": {
 							SchemaProps: spec.SchemaProps{
-								Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"uid": {
+						"metadata": {
 							SchemaProps: spec.SchemaProps{
-								Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
+								Description: "Standard object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							},
+						},
+						"spec": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Specification of the SeedAccessRequest.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequestSpec"),
+							},
+						},
+						"status": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Most recently observed status of the SeedAccessRequest.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequestStatus"),
+							},
+						},
+					},
+				},
+				VendorExtensible: spec.VendorExtensible{
+					Extensions: spec.Extensions{
+						"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SHOOT:.spec.shootName,REQUESTER:.status.requester,PHASE:.status.phase,EXPIRATION:.status.expirationTimestamp",
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequestSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequestList": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedAccessRequestList is a list of SeedAccessRequest objects.",
+					Properties: map[string]spec.Schema{
+						"kind": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard list object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							},
+						},
+						"items": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Items is the list of SeedAccessRequests.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequest"),
+										},
+									},
+								},
+							},
+						},
 					},
-					Required: []string{"gardener", "technicalID", "uid"},
+					Required: []string{"items"},
 				},
 			},
 			Dependencies: []string{
-				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequest", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequestSpec": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "VolumeType contains certain properties of a volume type.",
+					Description: "SeedAccessRequestSpec is the specification of a SeedAccessRequest.",
 					Properties: map[string]spec.Schema{
-						"name": {
+						"shootName": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Name is the name of the volume type.",
+								Description: "ShootName is the name of the Shoot in the same namespace whose namespace in the Seed cluster shall be accessed.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"class": {
+						"reason": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Class is the class of the volume type.",
+								Description: "Reason is a human readable justification for the access. It is recorded for auditing purposes.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
+						"duration": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Duration is the time span after which the access is revoked.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
 					},
-					Required: []string{"name", "class"},
+					Required: []string{"shootName", "reason"},
 				},
 			},
-			Dependencies: []string{},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 		},
-		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker": {
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedAccessRequestStatus": {
 			Schema: spec.Schema{
 				SchemaProps: spec.SchemaProps{
-					Description: "Worker is the base definition of a worker group.",
+					Description: "SeedAccessRequestStatus holds the most recently observed status of the SeedAccessRequest.",
 					Properties: map[string]spec.Schema{
-						"name": {
+						"requester": {
 							SchemaProps: spec.SchemaProps{
-								Description: "Name is the name of the worker group.",
+								Description: "Requester is the name of the user who created the SeedAccessRequest.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"machineType": {
+						"phase": {
 							SchemaProps: spec.SchemaProps{
-								Description: "MachineType is the machine type of the worker group.",
+								Description: "Phase is the current phase of the SeedAccessRequest.",
 								Type:        []string{"string"},
 								Format:      "",
 							},
 						},
-						"autoScalerMin": {
+						"message": {
 							SchemaProps: spec.SchemaProps{
-								Description: "AutoScalerMin is the minimum number of VMs to create.",
-								Type:        []string{"integer"},
-								Format:      "int32",
+								Description: "Message is a human readable message about the current phase.",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-						"autoScalerMax": {
+						"kubeconfigSecretName": {
 							SchemaProps: spec.SchemaProps{
-								Description: "AutoScalerMin is the maximum number of VMs to create.",
-								Type:        []string{"integer"},
-								Format:      "int32",
+								Description: "KubeconfigSecretName is the name of the secret which contains the kubeconfig for the granted access. Only the requester may read it.",
+								Type:        []string{"string"},
+								Format:      "",
 							},
 						},
-					},
-					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+						"kubeconfigSecretNamespace": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubeconfigSecretNamespace is the namespace of the secret which contains the kubeconfig for the granted access.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"expirationTimestamp": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ExpirationTimestamp is the time at which the access is revoked.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedCloud defines the cloud profile and the region this Seed cluster belongs to.",
+					Properties: map[string]spec.Schema{
+						"profile": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Profile is the name of a cloud profile.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"region": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Region is a name of a region.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"profile", "region"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedComponentStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedComponentStatus is the state of a component which the Gardener deploys into every Seed cluster.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the component.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"version": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Version is the version of the Gardener which has deployed the component.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"checksum": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Checksum is the checksum of the deployed manifests of the component.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"lastUpdateTime": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastUpdateTime is the last time the component has been installed or upgraded.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+					},
+					Required: []string{"name", "version", "checksum", "lastUpdateTime"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedList is a collection of Seeds.",
+					Properties: map[string]spec.Schema{
+						"kind": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard list object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							},
+						},
+						"items": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Items is the list of Seeds.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed"),
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"items"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
+					Properties: map[string]spec.Schema{
+						"nodes": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Nodes is the CIDR of the node network.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"pods": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Pods is the CIDR of the pod network.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"services": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Services is the CIDR of the service network.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"nodes", "pods", "services"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedSpec is the specification of a Seed.",
+					Properties: map[string]spec.Schema{
+						"cloud": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Cloud defines the cloud profile and the region this Seed cluster belongs to.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud"),
+							},
+						},
+						"ingressDomain": {
+							SchemaProps: spec.SchemaProps{
+								Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"secretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for the account the Seed cluster has been deployed to.",
+								Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							},
+						},
+						"networks": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Networks defines the pod, service and worker network of the Seed cluster.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks"),
+							},
+						},
+						"visible": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Visible labels the Seed cluster as selectable for the seedfinder admisson controller.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"protected": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"shootKubernetesVersions": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ShootKubernetesVersions constrains the Kubernetes versions of Shoot clusters which may be hosted on the Seed cluster.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedShootKubernetesVersions"),
+							},
+						},
+					},
+					Required: []string{"cloud", "ingressDomain", "secretRef", "networks"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedShootKubernetesVersions", "k8s.io/api/core/v1.SecretReference"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedShootKubernetesVersions": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedShootKubernetesVersions defines the range of Shoot Kubernetes versions a Seed cluster is able to host.",
+					Properties: map[string]spec.Schema{
+						"min": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Min is the lowest Kubernetes version (inclusive) a Shoot hosted on the Seed may run.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"max": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Max is the highest Kubernetes version (inclusive) a Shoot hosted on the Seed may run. If only a minor version (e.g. 1.10) is given then all patch versions of it are allowed.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "SeedStatus holds the most recently observed status of the Seed cluster.",
+					Properties: map[string]spec.Schema{
+						"conditions": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Conditions represents the latest available observations of a Seed's current state.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition"),
+										},
+									},
+								},
+							},
+						},
+						"components": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Components are the components which the Gardener has deployed into the Seed cluster.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedComponentStatus"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedComponentStatus"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Properties: map[string]spec.Schema{
+						"kind": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							},
+						},
+						"spec": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Specification of the Shoot cluster.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec"),
+							},
+						},
+						"status": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Most recently observed status of the Shoot cluster.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus"),
+							},
+						},
+					},
+				},
+				VendorExtensible: spec.VendorExtensible{
+					Extensions: spec.Extensions{
+						"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state",
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootList is a list of Shoot objects.",
+					Properties: map[string]spec.Schema{
+						"kind": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard list object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							},
+						},
+						"items": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Items is the list of Shoots.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot"),
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"items"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCloudStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootCloudStatus holds the identifiers of the infrastructure resources which have been provisioned for a Shoot cluster. Its fields are provider-agnostic, i.e. not every field is filled for every cloud provider.",
+					Properties: map[string]spec.Schema{
+						"network": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Network is the identifier of the VPC (AWS, GCP), the VNet (Azure) or the network (OpenStack) the Shoot cluster runs in.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"subnets": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Subnets are the identifiers of the subnets which have been created for the Shoot cluster.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"securityGroups": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SecurityGroups are the identifiers of the security groups which are attached to the worker nodes.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"natIPs": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NATIPs are the public IP addresses of the NAT gateways which are used for outgoing traffic of the worker nodes.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"iamRoles": {
+							SchemaProps: spec.SchemaProps{
+								Description: "IAMRoles are the identifiers of the IAM roles (AWS) or service accounts (GCP) which are used by the worker nodes.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootEtcdOperationLock": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootEtcdOperationLock describes the operation which holds the etcd operation lock of a Shoot.",
+					Properties: map[string]spec.Schema{
+						"holder": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Holder is the identity of the Gardener controller which holds the lock.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"reason": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Reason is the operation for which the lock is held, one of Hibernation, Backup, Restore.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"acquireTime": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AcquireTime is the time when the lock has been acquired.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+					},
+					Required: []string{"holder", "reason", "acquireTime"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootInventory": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootInventory lists the cloud resources which the Gardener considers to be owned by a Shoot cluster.",
+					Properties: map[string]spec.Schema{
+						"resources": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Resources are the cloud resources of the Shoot cluster.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootInventoryResource"),
+										},
+									},
+								},
+							},
+						},
+						"lastUpdateTime": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastUpdateTime is the last time the inventory has been updated.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+					},
+					Required: []string{"lastUpdateTime"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootInventoryResource", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootInventoryResource": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootInventoryResource is a cloud resource which the Gardener considers to be owned by a Shoot cluster.",
+					Properties: map[string]spec.Schema{
+						"kind": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kind is the kind of the resource, one of VirtualMachine, Disk, LoadBalancer, Network, Subnet, SecurityGroup, IPAddress, IAMRole.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"id": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ID is the identifier of the resource at the cloud provider, e.g. the provider ID of a virtual machine or the address of a load balancer.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the object which represents the resource, e.g. the machine of a virtual machine, the persistent volume of a disk or the `<namespace>/<name>` of the service of a load balancer.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"source": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Source is where the Gardener knows the resource from, one of Infrastructure (the Terraform state), Machine, PersistentVolume, Service.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"kind", "id", "source"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootKubernetesUpgrade": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootKubernetesUpgrade holds information about a Kubernetes upgrade of a Shoot cluster.",
+					Properties: map[string]spec.Schema{
+						"previousVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "PreviousVersion is the Kubernetes version the Shoot cluster has been upgraded from.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"version": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Version is the Kubernetes version the Shoot cluster has been upgraded to.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"phase": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Phase is the phase of the upgrade, one of Progressing, Succeeded, RolledBack.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"startTime": {
+							SchemaProps: spec.SchemaProps{
+								Description: "StartTime is the time when the upgrade has been requested.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+						"message": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Message is a human readable message about the outcome of the upgrade.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"previousVersion", "version", "phase", "startTime"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineCanaryStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootMachineCanaryStatus holds the progress of the canary rollout of a worker group.",
+					Properties: map[string]spec.Schema{
+						"workerName": {
+							SchemaProps: spec.SchemaProps{
+								Description: "WorkerName is the name of the worker group.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"phase": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Phase is the phase of the canary rollout, either Soaking or Halted.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"soakEndTime": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SoakEndTime is the time at which the soak period of the canary machines ends.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+						"reason": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Reason describes why the canary rollout has been halted.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"workerName", "phase"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineDeploymentStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootMachineDeploymentStatus holds the progress of the rollout of a single machine deployment.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the machine deployment.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"desired": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Desired is the desired number of machines.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"ready": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Ready is the number of ready machines.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"updated": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Updated is the number of machines which use the current machine class.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"unavailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Unavailable is the number of machines which are not available yet.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"lastError": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastError is the last error reported by the machine-controller-manager for the machine deployment.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"name", "desired", "ready", "updated", "unavailable"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachinesStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootMachinesStatus holds the progress of the rollout of the machines of a Shoot cluster.",
+					Properties: map[string]spec.Schema{
+						"phase": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Phase is the phase of the rollout, one of Progressing, Available, Failed.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"deployments": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Deployments contains the progress of every machine deployment of the Shoot cluster.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineDeploymentStatus"),
+										},
+									},
+								},
+							},
+						},
+						"lastError": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastError is the last error reported by the machine-controller-manager for one of the machine deployments.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"pendingReplacements": {
+							SchemaProps: spec.SchemaProps{
+								Description: "PendingReplacements contains the names of the worker groups whose machines are replaced in the next maintenance time window.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"canaries": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Canaries contains the progress of the canary rollouts of the worker groups.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineCanaryStatus"),
+										},
+									},
+								},
+							},
+						},
+						"lastUpdateTime": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastUpdateTime is the last time the progress has been updated.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+					},
+					Required: []string{"phase", "lastUpdateTime"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineCanaryStatus", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineDeploymentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMonitoring": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootMonitoring holds the URLs of the monitoring components of a Shoot cluster. The components are protected with basic authentication, the credentials are the 'username' and 'password' keys of the referenced secret.",
+					Properties: map[string]spec.Schema{
+						"alertManagerURL": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AlertManagerURL is the URL of the Alertmanager of the Shoot cluster.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"grafanaURL": {
+							SchemaProps: spec.SchemaProps{
+								Description: "GrafanaURL is the URL of the Grafana of the Shoot cluster.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"prometheusURL": {
+							SchemaProps: spec.SchemaProps{
+								Description: "PrometheusURL is the URL of the Prometheus of the Shoot cluster.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"credentialsSecretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "CredentialsSecretRef is a reference to a secret in the namespace of the Shoot which contains the credentials to access the monitoring components.",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+						"federationURL": {
+							SchemaProps: spec.SchemaProps{
+								Description: "FederationURL is the URL of the federation endpoint of the Prometheus of the Shoot cluster. It only serves the '/federate' and '/api/v1/read' paths which can be used to integrate the metrics of the control plane into other monitoring systems.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"federationCredentialsSecretRef": {
+							SchemaProps: spec.SchemaProps{
+								Description: "FederationCredentialsSecretRef is a reference to a secret in the namespace of the Shoot which contains the credentials to access the federation endpoint.",
+								Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							},
+						},
+					},
+					Required: []string{"alertManagerURL", "grafanaURL", "prometheusURL", "credentialsSecretRef", "federationURL", "federationCredentialsSecretRef"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/api/core/v1.LocalObjectReference"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperation": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootOperation triggers an operation for all Shoots in its namespace which match a label selector. The Shoots are processed with a limited concurrency, i.e. only a bounded number of operations is running at the same time.",
+					Properties: map[string]spec.Schema{
+						"kind": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							},
+						},
+						"spec": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Specification of the ShootOperation.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationSpec"),
+							},
+						},
+						"status": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Most recently observed status of the ShootOperation.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationStatus"),
+							},
+						},
+					},
+				},
+				VendorExtensible: spec.VendorExtensible{
+					Extensions: spec.Extensions{
+						"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,OPERATION:.spec.operation,PHASE:.status.phase,CREATION TIMESTAMP:.metadata.creationTimestamp",
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationList": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootOperationList is a list of ShootOperation objects.",
+					Properties: map[string]spec.Schema{
+						"kind": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"apiVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"metadata": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Standard list object metadata.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							},
+						},
+						"items": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Items is the list of ShootOperations.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperation"),
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"items"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationShootStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootOperationShootStatus holds the state of the operation of a single Shoot selected by a ShootOperation.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"state": {
+							SchemaProps: spec.SchemaProps{
+								Description: "State is the state of the operation of the Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"generation": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Generation is the generation of the Shoot after the operation has been triggered. The operation is completed once the Shoot controller has observed this generation.",
+								Type:        []string{"integer"},
+								Format:      "int64",
+							},
+						},
+						"message": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Message is a human readable message about the state.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"name", "state"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationSpec": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootOperationSpec is the specification of a ShootOperation.",
+					Properties: map[string]spec.Schema{
+						"operation": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Operation is the operation which is triggered for the selected Shoots, one of reconcile, retry.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"selector": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Selector is a label query over the Shoots in the namespace of the ShootOperation. An empty selector selects all Shoots of the namespace.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							},
+						},
+						"maxConcurrency": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxConcurrency is the maximum number of selected Shoots whose operations are running at the same time.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+					},
+					Required: []string{"operation", "selector"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootOperationStatus holds the most recently observed status of the ShootOperation.",
+					Properties: map[string]spec.Schema{
+						"phase": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Phase is the current phase of the ShootOperation.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"startTimestamp": {
+							SchemaProps: spec.SchemaProps{
+								Description: "StartTimestamp is the time at which the Shoots have been selected.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+						"completionTimestamp": {
+							SchemaProps: spec.SchemaProps{
+								Description: "CompletionTimestamp is the time at which the operations of all selected Shoots have been completed.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+						"shoots": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Shoots contains the state of the operation of every selected Shoot.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationShootStatus"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootOperationShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootSpec is the specification of a Shoot.",
+					Properties: map[string]spec.Schema{
+						"addons": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Addons contains information about enabled/disabled addons and their configuration.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
+							},
+						},
+						"backup": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Backup contains configuration settings for the etcd backups.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup"),
+							},
+						},
+						"cloud": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Cloud contains information about the cloud environment and their specific settings.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
+							},
+						},
+						"dependsOn": {
+							SchemaProps: spec.SchemaProps{
+								Description: "DependsOn is a list of references to Shoots in the same or another namespace which must have been reconciled successfully before this Shoot gets reconciled. If the namespace is empty, the namespace of this Shoot is used.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.ObjectReference"),
+										},
+									},
+								},
+							},
+						},
+						"dns": {
+							SchemaProps: spec.SchemaProps{
+								Description: "DNS contains information about the DNS settings of the Shoot.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS"),
+							},
+						},
+						"hibernation": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Hibernation contains information whether the Shoot is suspended or not.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation"),
+							},
+						},
+						"kubernetes": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kubernetes contains the version and configuration settings of the control plane components.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes"),
+							},
+						},
+						"maintenance": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
+							},
+						},
+					},
+					Required: []string{"cloud", "dns", "kubernetes"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Backup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance", "k8s.io/api/core/v1.ObjectReference"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+					Properties: map[string]spec.Schema{
+						"cloud": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Cloud holds the identifiers of the infrastructure resources which have been provisioned for the Shoot cluster. It is written after a successful create/reconcile operation.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCloudStatus"),
+							},
+						},
+						"conditions": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Conditions represents the latest available observations of a Shoots's current state.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition"),
+										},
+									},
+								},
+							},
+						},
+						"etcdOperationLock": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EtcdOperationLock describes the operation which holds the etcd operation lock of the Shoot, i.e. which prevents that the hibernation, the backup and the restore of its etcd run concurrently. It is empty if no operation holds the lock.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootEtcdOperationLock"),
+							},
+						},
+						"gardener": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
+							},
+						},
+						"inventory": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Inventory lists the cloud resources which the Gardener considers to be owned by the Shoot cluster, e.g. for audits, cost attribution or manual cleanups. It is written after a successful create/reconcile operation.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootInventory"),
+							},
+						},
+						"lastOperation": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastOperation holds information about the last operation on the Shoot.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation"),
+							},
+						},
+						"lastError": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastError holds information about the last occurred error during an operation.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError"),
+							},
+						},
+						"lastErrors": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LastErrors holds information about all errors which occurred during the last operation, e.g. one error per failed task of the reconciliation flow.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError"),
+										},
+									},
+								},
+							},
+						},
+						"machines": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Machines holds the progress of the rollout of the machines of the Shoot cluster. It is written while the Gardener waits until the machine deployments are available.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachinesStatus"),
+							},
+						},
+						"kubernetesUpgrade": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubernetesUpgrade holds information about the last Kubernetes upgrade of the Shoot cluster.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootKubernetesUpgrade"),
+							},
+						},
+						"monitoring": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Monitoring holds the URLs of the monitoring components of the Shoot cluster and a reference to the secret containing the credentials to access them. It is written after a successful create/reconcile operation.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMonitoring"),
+							},
+						},
+						"observedGeneration": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
+								Type:        []string{"integer"},
+								Format:      "int64",
+							},
+						},
+						"retryCycleStartTime": {
+							SchemaProps: spec.SchemaProps{
+								Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							},
+						},
+						"seed": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"technicalID": {
+							SchemaProps: spec.SchemaProps{
+								Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"uid": {
+							SchemaProps: spec.SchemaProps{
+								Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"gardener", "technicalID", "uid"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootCloudStatus", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootEtcdOperationLock", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootInventory", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootKubernetesUpgrade", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachinesStatus", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMonitoring", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "VolumeType contains certain properties of a volume type.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the volume type.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"class": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Class is the class of the volume type.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"name", "class"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WatchCacheSizes": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WatchCacheSizes contains configuration settings for the watch caches of the kube-apiserver.",
+					Properties: map[string]spec.Schema{
+						"default": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Default is the size of the watch caches of resources without an explicit size. If not set, a default derived from the maximum size of the Shoot cluster is used. Zero disables the watch caches of these resources.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"resources": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Resources maps resources (lowercase plural, optionally qualified with their API group as in `resource.group`) to the sizes of their watch caches. Resources qualified with an API group require Kubernetes 1.10 or later.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"integer"},
+											Format: "int32",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "Worker is the base definition of a worker group.",
+					Properties: map[string]spec.Schema{
+						"name": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Name is the name of the worker group.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"machineType": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineType is the machine type of the worker group.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"autoScalerMin": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AutoScalerMin is the minimum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"autoScalerMax": {
+							SchemaProps: spec.SchemaProps{
+								Description: "AutoScalerMin is the maximum number of VMs to create.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"machineHealthTimeout": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineHealthTimeout is the duration after which a machine whose node is not ready gets replaced by the machine-controller-manager. Defaults to 10 minutes.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+						"maxUnhealthy": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxUnhealthy is the absolute number or percentage of unhealthy nodes in the worker group above which the automatic replacement of machines is paused for the Shoot. Unlimited if not set.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"maxEvictRetries": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxEvictRetries is the number of times the machine-controller-manager retries to evict a pod while it drains a node of the worker group before the machine is deleted anyway. Defaults to the setting of the machine-controller-manager.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"unhealthyNodeConditions": {
+							SchemaProps: spec.SchemaProps{
+								Description: "UnhealthyNodeConditions are the types of node conditions which render a node of the worker group unhealthy (in addition to a node which is not ready) if they are true for longer than the machine health timeout. Defaults to the conditions checked by the machine-controller-manager.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"labels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Labels is a map of additional labels for the machine deployments of the worker group, e.g. for cost tracking or external autoscalers.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"annotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Annotations is a map of additional annotations for the machine deployments of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"nodeLabels": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeLabels is a map of additional labels for the nodes of the worker group, e.g. to schedule workload on dedicated worker groups.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"nodeAnnotations": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeAnnotations is a map of additional annotations for the nodes of the worker group.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"nodeTaints": {
+							SchemaProps: spec.SchemaProps{
+								Description: "NodeTaints is a list of taints for the nodes of the worker group, e.g. to reserve them for dedicated workload.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/api/core/v1.Taint"),
+										},
+									},
+								},
+							},
+						},
+						"cordoned": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Cordoned prevents the creation of new machines for the worker group without deleting it, e.g. to retire it gradually while its workload is drained to another worker group.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"architecture": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Architecture is the CPU architecture of the machines of the worker group. The referenced CloudProfile must offer the machine type and the machine image for this architecture. Defaults to amd64.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"warmUp": {
+							SchemaProps: spec.SchemaProps{
+								Description: "WarmUp indicates that the machines of the worker group boot from the pre-warmed variant of the machine image, which already contains the container images required on the nodes. Only supported on AWS and GCP if the referenced CloudProfile offers such a variant. Defaults to false.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"maxSurge": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxSurge is the maximum number of machines that can be created above the desired number of machines of each machine deployment of the worker group during a rolling update. Value can be an absolute number or a percentage of the desired machines. Defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"maxUnavailable": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxUnavailable is the maximum number of machines of each machine deployment of the worker group that can be unavailable during a rolling update. Value can be an absolute number or a percentage of the desired machines. Defaults to 1.",
+								Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							},
+						},
+						"minReadySeconds": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MinReadySeconds is the minimum number of seconds for which a newly created machine must be ready before it is considered available during a rolling update. Defaults to 500.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"firewallRules": {
+							SchemaProps: spec.SchemaProps{
+								Description: "FirewallRules is a list of rules which allow additional inbound traffic to the machines of the worker group, e.g. to open ports on dedicated ingress nodes without opening them on all nodes. Not supported on Azure.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerFirewallRule"),
+										},
+									},
+								},
+							},
+						},
+						"waitForCriticalComponents": {
+							SchemaProps: spec.SchemaProps{
+								Description: "WaitForCriticalComponents indicates that the nodes of the worker group register with the worker.garden.sapcloud.io/critical-components-not-ready taint. It prevents pods from being scheduled onto a node until the critical DaemonSets (e.g., the CNI and kube-proxy) are ready on it, then the Gardener removes it. Defaults to false.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"machineImage": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MachineImage is the name of the machine image for the machines of the worker group, e.g. to try a new operating system version on one worker group first. The referenced CloudProfile must offer it for the region and the architecture of the worker group. Defaults to the machine image of the Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"kubernetesVersion": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubernetesVersion is the version of the kubelets of the worker group, e.g. to try a new Kubernetes version on one worker group first. It must be offered by the referenced CloudProfile, must not be newer than the version of the control plane and must be at most two minor versions older. Defaults to the Kubernetes version of the Shoot.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"rolloutStage": {
+							SchemaProps: spec.SchemaProps{
+								Description: "RolloutStage is the stage in which the machines of the worker group are rolled out. The worker groups of a stage are only rolled out once those of all lower stages are available, hence, a failed rollout stops before the worker groups of the higher stages are touched. Defaults to 0.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"spot": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Spot indicates that the machines of the worker group run on spare capacity of the cloud provider, i.e. on spot instances on AWS and on preemptible VMs on GCP. They are much cheaper but can be reclaimed at any time, hence, their nodes are drained as soon as the cloud provider announces it. Only supported on AWS and GCP.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerSpot"),
+							},
+						},
+						"scaleDownPriority": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ScaleDownPriority is the priority with which the machine-controller-manager deletes the machines of the worker group when it scales down one of their machine sets, from 1 (deleted first) to 4 (deleted last). Machines whose nodes are protected from scale-downs always get the priority 5. Defaults to the priority of the machine-controller-manager (3).",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"kubelet": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Kubelet contains the configuration of the kubelets of the worker group, e.g. to run more pods on the nodes of a high-density worker group than on those of a latency-sensitive one. Only supported for Kubernetes versions >= 1.10.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig"),
+							},
+						},
+						"osUpdates": {
+							SchemaProps: spec.SchemaProps{
+								Description: "OSUpdates contains the settings for the automated updates of the operating system of the machines of the worker group.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates"),
+							},
+						},
+						"canary": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Canary contains the settings for canary rollouts of the worker group. A change which replaces its machines (e.g., of the machine image) is first rolled out to a few canary machines, and only continued once they have been healthy for a soak period.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCanary"),
+							},
+						},
+						"localSSDs": {
+							SchemaProps: spec.SchemaProps{
+								Description: "LocalSSDs contains the configuration of the local SSDs which are attached to the machines of the worker group, e.g. for IO-heavy workloads. They hold the data of the container runtime and of the kubelet (including the emptyDir volumes). Only supported on AWS and GCP.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerLocalSSDs"),
+							},
+						},
+						"scaleDownBudget": {
+							SchemaProps: spec.SchemaProps{
+								Description: "ScaleDownBudget limits the number of machines of the worker group which are deleted within a period when its desired number of machines decreases. The remaining machines are removed with the following reconciliations. Unlimited if not set.",
+								Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ScaleDownBudget"),
+							},
+						},
+					},
+					Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+				},
+			},
+			Dependencies: []string{
+				"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ScaleDownBudget", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCanary", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerFirewallRule", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerLocalSSDs", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerSpot", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerCanary": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WorkerCanary contains the settings for canary rollouts of a worker group.",
+					Properties: map[string]spec.Schema{
+						"machines": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Machines is the number of canary machines which are created with the changed machine class in addition to the existing machines of the worker group.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"soakPeriod": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SoakPeriod is the duration for which the nodes of the canary machines must be ready and healthy before the change is rolled out to all machines of the worker group. Defaults to 10m.",
+								Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							},
+						},
+					},
+					Required: []string{"machines"},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerFirewallRule": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WorkerFirewallRule describes inbound traffic which is allowed to the machines of a worker group.",
+					Properties: map[string]spec.Schema{
+						"protocol": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Protocol is the protocol of the allowed traffic, either TCP or UDP. Defaults to TCP.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"port": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Port is the (first) port of the allowed traffic.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"endPort": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EndPort is the last port of the allowed port range. Defaults to Port.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"sourceCIDRs": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SourceCIDRs is the list of CIDRs from which the traffic is allowed.",
+								Type:        []string{"array"},
+								Items: &spec.SchemaOrArray{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+					},
+					Required: []string{"port", "sourceCIDRs"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerKubeletConfig": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WorkerKubeletConfig contains the configuration of the kubelets of a worker group.",
+					Properties: map[string]spec.Schema{
+						"evictionHard": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EvictionHard are the hard eviction thresholds of the kubelets per eviction signal (memory.available, nodefs.available, nodefs.inodesFree, imagefs.available or imagefs.inodesFree), e.g. \"100Mi\" or \"5%\". The signals which are not set keep their defaults.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"evictionSoft": {
+							SchemaProps: spec.SchemaProps{
+								Description: "EvictionSoft are the soft eviction thresholds of the kubelets per eviction signal (see EvictionHard), which must be exceeded for 1m30s before pods are evicted. The signals which are not set keep their defaults.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Type:   []string{"string"},
+											Format: "",
+										},
+									},
+								},
+							},
+						},
+						"maxPods": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxPods is the maximum number of pods per node. Defaults to 110.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"kubeReserved": {
+							SchemaProps: spec.SchemaProps{
+								Description: "KubeReserved are the resources (cpu, memory, ephemeral-storage) reserved for the Kubernetes components on every node. Defaults to 1Gi of memory.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										},
+									},
+								},
+							},
+						},
+						"systemReserved": {
+							SchemaProps: spec.SchemaProps{
+								Description: "SystemReserved are the resources (cpu, memory, ephemeral-storage) reserved for the system daemons on every node. Nothing is reserved by default.",
+								Type:        []string{"object"},
+								AdditionalProperties: &spec.SchemaOrBool{
+									Schema: &spec.Schema{
+										SchemaProps: spec.SchemaProps{
+											Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										},
+									},
+								},
+							},
+						},
+						"cpuManagerPolicy": {
+							SchemaProps: spec.SchemaProps{
+								Description: "CPUManagerPolicy is the CPU manager policy of the kubelets, either \"none\" or \"static\". The static policy assigns exclusive CPUs to containers of Guaranteed pods with integer CPU requests and requires some CPU to be reserved (see KubeReserved and SystemReserved). Defaults to \"none\".",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+				},
+			},
+			Dependencies: []string{
+				"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerLocalSSDs": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WorkerLocalSSDs contains the configuration of the local SSDs of the machines of a worker group.",
+					Properties: map[string]spec.Schema{
+						"count": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Count is the number of local SSDs which are attached to every machine. Several local SSDs are combined into one striped volume. On AWS, the machine type must offer at least as many instance store volumes.",
+								Type:        []string{"integer"},
+								Format:      "int32",
+							},
+						},
+						"size": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Size is the size of every local SSD. It is fixed to 375Gi on GCP and determined by the machine type on AWS, hence, it is only validated against the size offered by GCP.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"interface": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Interface is the interface through which the local SSDs are attached on GCP, either SCSI or NVME. Defaults to NVME. Not supported on AWS, where it is determined by the machine type.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"count"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerOSUpdates": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WorkerOSUpdates contains the settings for the automated updates of the operating system of the machines of a worker group.",
+					Properties: map[string]spec.Schema{
+						"enabled": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Enabled indicates whether the machines are rebooted into the updates of the operating system (e.g., security patches) which have been installed in the background. The reboots only take place within the maintenance time window of the Shoot, and only one node of the Shoot is drained and rebooted at a time.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+					},
+					Required: []string{"enabled"},
+				},
+			},
+			Dependencies: []string{},
+		},
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerSpot": {
+			Schema: spec.Schema{
+				SchemaProps: spec.SchemaProps{
+					Description: "WorkerSpot describes how the machines of a worker group use spare capacity of the cloud provider.",
+					Properties: map[string]spec.Schema{
+						"maxPrice": {
+							SchemaProps: spec.SchemaProps{
+								Description: "MaxPrice is the maximum hourly price in US dollars which is paid per machine, e.g. \"0.05\". Machines are reclaimed as soon as the spot price exceeds it. Only supported on AWS, defaults to the on-demand price.",
+								Type:        []string{"string"},
+								Format:      "",
+							},
+						},
+						"fallbackToOnDemand": {
+							SchemaProps: spec.SchemaProps{
+								Description: "FallbackToOnDemand indicates that on-demand machines are created for the worker group while the cloud provider has no spare capacity. Defaults to false.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+						"taint": {
+							SchemaProps: spec.SchemaProps{
+								Description: "Taint indicates that the nodes of the worker group register with the worker.garden.sapcloud.io/spot taint, so that only pods which tolerate interruptions are scheduled onto them. Defaults to false.",
+								Type:        []string{"boolean"},
+								Format:      "",
+							},
+						},
+					},
 				},
 			},
 			Dependencies: []string{},
@@ -30,8 +30,21 @@ import (
 
 // New takes an operation object <o> and creates a new Botanist object. It checks whether the given Shoot DNS
 // domain is covered by a default domain, and if so, it sets the <DefaultDomainSecret> attribute on the Botanist
-// object.
+// object. It initializes the clients of the Seed cluster.
 func New(o *operation.Operation) (*Botanist, error) {
+	b := NewWithoutSeedClients(o)
+
+	if err := b.InitializeSeedClients(); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// NewWithoutSeedClients creates a new Botanist object like New, but it does not initialize the clients of the Seed
+// cluster. It must only be used for computations which do not access the Seed cluster, e.g. to compute the steps of a
+// flow without executing them.
+func NewWithoutSeedClients(o *operation.Operation) *Botanist {
 	b := &Botanist{
 		Operation: o,
 	}
@@ -52,11 +65,7 @@ func New(o *operation.Operation) (*Botanist, error) {
 		}
 	}
 
-	if err := b.InitializeSeedClients(); err != nil {
-		return nil, err
-	}
-
-	return b, nil
+	return b
 }
 
 // RegisterAsSeed registers a Shoot cluster as a Seed in the Garden cluster.
@@ -0,0 +1,42 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeleteClonedNodes deletes the Node objects which have been restored from the etcd backup of the source Shoot of a
+// cloned Shoot. They belong to the machines of the source Shoot and have been created before the clone itself, whereas
+// the nodes of the clone's own machines are always younger than the clone.
+func (b *Botanist) DeleteClonedNodes() error {
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodeList.Items {
+		if !node.CreationTimestamp.Before(&b.Shoot.Info.CreationTimestamp) {
+			continue
+		}
+		b.Logger.Infof("Deleting node %s which has been restored from the clone source", node.Name)
+		if err := b.K8sShootClient.Clientset().CoreV1().Nodes().Delete(node.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -18,11 +18,16 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/mockbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -45,6 +50,117 @@ func (b *Botanist) DeployNamespace() error {
 	return nil
 }
 
+// DeployNamespaceResourceLimits creates a ResourceQuota and a LimitRange in the namespace in the Seed cluster which
+// holds the control plane components. This ensures that a single misbehaving control plane cannot starve all the other
+// control planes on the Seed. The limits are taken from the configuration for the purpose of the Shoot, and the quota
+// grows with the maximum number of nodes of the Shoot, because the load on the control plane does as well. The quota
+// of pods and persistent volume claims is derived from the components of the control plane.
+func (b *Botanist) DeployNamespaceResourceLimits() error {
+	var (
+		limits                    = controlPlaneResourceLimits(b.ControlPlaneResourceLimits, b.Shoot.GetPurpose())
+		deployments, statefulSets = b.controlPlaneWorkloads()
+	)
+
+	return b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-controlplane", "charts", "resource-limits"), "resource-limits", b.Shoot.SeedNamespace, nil, namespaceResourceLimitsValues(limits, b.Shoot.GetNodeCount(), deployments, statefulSets))
+}
+
+// controlPlaneTerraformerPurposes are the purposes of the Terraformer jobs which may run concurrently in the namespace
+// of the control plane. Each of them runs at most one pod at a time.
+var controlPlaneTerraformerPurposes = []string{
+	common.TerraformerPurposeInfra,
+	common.TerraformerPurposeInternalDNS,
+	common.TerraformerPurposeExternalDNS,
+	common.TerraformerPurposeIngress,
+	common.TerraformerPurposeKube2IAM,
+}
+
+// controlPlaneWorkloads returns the numbers of the Deployments and of the StatefulSets with a single replica which are
+// deployed into the namespace of the control plane. Every StatefulSet has one persistent volume claim.
+func (b *Botanist) controlPlaneWorkloads() (int, int) {
+	// kube-apiserver, kube-controller-manager, kube-scheduler, kube-addon-manager, machine-controller-manager, grafana,
+	// kube-state-metrics-seed and kube-state-metrics-shoot
+	deployments := 8
+	if b.Shoot.ClusterAutoscalerEnabled() {
+		deployments++
+	}
+	if b.Shoot.CloudProvider == gardenv1beta1.CloudProviderAWS {
+		// aws-lb-readvertiser
+		deployments++
+	}
+
+	// etcd-main, etcd-events, prometheus and alertmanager
+	return deployments, 4
+}
+
+// controlPlaneResourceLimits merges the resource limits configured for the given <purpose> over the default resource
+// limits.
+func controlPlaneResourceLimits(config map[string]componentconfig.ControlPlaneResourceLimits, purpose string) componentconfig.ControlPlaneResourceLimits {
+	var (
+		defaults = config[componentconfig.ControlPlaneResourceLimitsDefault]
+		limits   = defaults.DeepCopy()
+	)
+
+	overwrite, ok := config[purpose]
+	if !ok || purpose == componentconfig.ControlPlaneResourceLimitsDefault {
+		return *limits
+	}
+
+	for _, lists := range []struct{ dst, src *corev1.ResourceList }{
+		{&limits.Quota, &overwrite.Quota},
+		{&limits.QuotaPerNode, &overwrite.QuotaPerNode},
+		{&limits.DefaultRequest, &overwrite.DefaultRequest},
+		{&limits.Max, &overwrite.Max},
+	} {
+		for name, quantity := range *lists.src {
+			if *lists.dst == nil {
+				*lists.dst = corev1.ResourceList{}
+			}
+			(*lists.dst)[name] = quantity.DeepCopy()
+		}
+	}
+	return *limits
+}
+
+// namespaceResourceLimitsValues computes the values of the resource-limits chart from the given <limits> for a Shoot
+// with the given <nodeCount> whose control plane consists of the given numbers of <deployments> and <statefulSets>.
+// Every Deployment may surge by one pod during a rolling update, and every Terraformer job may run one more pod. The
+// configured quota of pods and persistent volume claims is added on top as a margin.
+func namespaceResourceLimitsValues(limits componentconfig.ControlPlaneResourceLimits, nodeCount, deployments, statefulSets int) map[string]interface{} {
+	quota := limits.Quota.DeepCopy()
+	if quota == nil {
+		quota = corev1.ResourceList{}
+	}
+	add := func(name corev1.ResourceName, quantity resource.Quantity) {
+		sum := quota[name].DeepCopy()
+		sum.Add(quantity)
+		quota[name] = sum
+	}
+
+	for name, perNode := range limits.QuotaPerNode {
+		add(name, *resource.NewMilliQuantity(perNode.MilliValue()*int64(nodeCount), perNode.Format))
+	}
+	add(corev1.ResourcePods, *resource.NewQuantity(int64(2*deployments+statefulSets+len(controlPlaneTerraformerPurposes)), resource.DecimalSI))
+	add(corev1.ResourcePersistentVolumeClaims, *resource.NewQuantity(int64(statefulSets), resource.DecimalSI))
+
+	return map[string]interface{}{
+		"quota": map[string]interface{}{
+			"hard": resourceListValues(quota),
+		},
+		"limits": map[string]interface{}{
+			"defaultRequest": resourceListValues(limits.DefaultRequest),
+			"max":            resourceListValues(limits.Max),
+		},
+	}
+}
+
+func resourceListValues(list corev1.ResourceList) map[string]interface{} {
+	values := make(map[string]interface{}, len(list))
+	for name, quantity := range list {
+		values[string(name)] = quantity.String()
+	}
+	return values
+}
+
 // DeployBackupNamespaceFromShoot creates a namespace in the Seed cluster from info in shoot object, which is used to deploy all the backup infrastructure
 // realted resources for shoot cluster. Moreover, the terraform configuration and all the secrets will be
 // stored as ConfigMaps/Secrets.
@@ -98,11 +214,23 @@ func (b *Botanist) DeleteBackupNamespace() error {
 
 // DeployKubeAPIServerService creates a Service of type 'LoadBalancer' in the Seed cluster which is used to expose the
 // kube-apiserver deployment (of the Shoot cluster). It waits until the load balancer is available and stores the address
-// on the Botanist's APIServerAddress attribute.
+// on the Botanist's APIServerAddress attribute. If the Shoot specifies a static load balancer IP then it is requested
+// for the load balancer so that the address is retained when the Service or the load balancer is recreated. The
+// service annotations of the Shoot are added to the Service, e.g. to select the type of the load balancer.
 func (b *Botanist) DeployKubeAPIServerService() error {
-	return b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-controlplane", "charts", "kube-apiserver-service"), "kube-apiserver-service", b.Shoot.SeedNamespace, nil, map[string]interface{}{
+	values := map[string]interface{}{
 		"cloudProvider": b.Seed.CloudProvider,
-	})
+	}
+	if apiServerConfig := b.Shoot.Info.Spec.Kubernetes.KubeAPIServer; apiServerConfig != nil {
+		if apiServerConfig.LoadBalancerIP != nil {
+			values["loadBalancerIP"] = *apiServerConfig.LoadBalancerIP
+		}
+		if len(apiServerConfig.ServiceAnnotations) > 0 {
+			values["annotations"] = apiServerConfig.ServiceAnnotations
+		}
+	}
+
+	return b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-controlplane", "charts", "kube-apiserver-service"), "kube-apiserver-service", b.Shoot.SeedNamespace, nil, values)
 }
 
 // RefreshKubeAPIServerChecksums updates the cloud provider checksum in the kube-apiserver pod spec template.
@@ -119,6 +247,16 @@ func (b *Botanist) DeleteKubeAPIServer() error {
 	return err
 }
 
+// DeleteClusterAutoscaler deletes the cluster-autoscaler deployment in the Seed cluster which holds the Shoot's
+// control plane.
+func (b *Botanist) DeleteClusterAutoscaler() error {
+	err := b.K8sSeedClient.DeleteDeployment(b.Shoot.SeedNamespace, common.ClusterAutoscalerDeploymentName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
 // RefreshKubeControllerManagerChecksums updates the cloud provider checksum in the kube-controller-manager pod spec template.
 func (b *Botanist) RefreshKubeControllerManagerChecksums() error {
 	return b.patchDeploymentCloudProviderChecksum(common.KubeControllerManagerDeploymentName)
@@ -218,9 +356,26 @@ func (b *Botanist) DeleteKubeAddonManager() error {
 // DeployMachineControllerManager deploys the machine-controller-manager into the Shoot namespace in the Seed cluster. It is responsible
 // for managing the worker nodes of the Shoot.
 func (b *Botanist) DeployMachineControllerManager() error {
+	// The machines of Shoots which simulate them are managed by a fake machine-controller-manager which runs in the
+	// Gardener itself instead of the real one.
+	if b.Shoot.SimulatesMachines() {
+		mockbotanist.StartMachineControllerManager(b.K8sSeedClient.MachineClientset(), b.Shoot.SeedNamespace)
+		return nil
+	}
+
+	replicas, err := b.machineControllerManagerReplicas()
+	if err != nil {
+		return err
+	}
+
+	// The machine-controller-manager drains and watches the nodes of the Shoot, hence, its release is chosen by the
+	// Kubernetes version of the Shoot rather than by the one of the Seed.
 	var (
-		name          = "machine-controller-manager"
+		name          = common.MachineControllerManagerDeploymentName
+		k8sVersion    = b.Shoot.Info.Spec.Kubernetes.Version
 		defaultValues = map[string]interface{}{
+			"replicas":             replicas,
+			"machineHealthTimeout": b.machineHealthTimeoutMinutes(),
 			"podAnnotations": map[string]interface{}{
 				"checksum/secret-machine-controller-manager": b.CheckSums[name],
 			},
@@ -230,7 +385,15 @@ func (b *Botanist) DeployMachineControllerManager() error {
 		}
 	)
 
-	values, err := b.InjectImages(defaultValues, b.K8sSeedClient.Version(), map[string]string{name: name})
+	image, err := b.ImageVector.FindImage(name, k8sVersion)
+	if err != nil {
+		return err
+	}
+	if apiVersion := machinev1alpha1.SchemeGroupVersion.String(); !image.ServesAPIVersion(apiVersion) {
+		return fmt.Errorf("the machine-controller-manager image %s does not serve the machine class API %s which the Gardener creates the machine classes with", image, apiVersion)
+	}
+
+	values, err := b.InjectImages(defaultValues, k8sVersion, map[string]string{name: name})
 	if err != nil {
 		return err
 	}
@@ -248,10 +411,14 @@ func (b *Botanist) DeploySeedMonitoring() error {
 	var (
 		kubecfgSecret    = b.Secrets["kubecfg"]
 		basicAuth        = utils.CreateSHA1Secret(kubecfgSecret.Data["username"], kubecfgSecret.Data["password"])
-		alertManagerHost = b.Seed.GetIngressFQDN("a", b.Shoot.Info.Name, b.Garden.ProjectName)
-		grafanaHost      = b.Seed.GetIngressFQDN("g", b.Shoot.Info.Name, b.Garden.ProjectName)
-		prometheusHost   = b.Seed.GetIngressFQDN("p", b.Shoot.Info.Name, b.Garden.ProjectName)
+		alertManagerHost = b.Seed.GetIngressFQDN(common.AlertManagerIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName)
+		grafanaHost      = b.Seed.GetIngressFQDN(common.GrafanaIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName)
+		prometheusHost   = b.Seed.GetIngressFQDN(common.PrometheusIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName)
 		replicas         = 1
+
+		federationSecret    = b.Secrets[common.PrometheusFederationSecretName]
+		federationBasicAuth = utils.CreateSHA1Secret(federationSecret.Data["username"], federationSecret.Data["password"])
+		federationHost      = b.Seed.GetIngressFQDN(common.PrometheusFederationIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName)
 	)
 
 	if b.Shoot.Hibernated {
@@ -283,6 +450,10 @@ func (b *Botanist) DeploySeedMonitoring() error {
 				"basicAuthSecret": basicAuth,
 				"host":            prometheusHost,
 			},
+			"federation": map[string]interface{}{
+				"basicAuthSecret": federationBasicAuth,
+				"host":            federationHost,
+			},
 			"namespace": map[string]interface{}{
 				"uid": b.SeedNamespaceObject.UID,
 			},
@@ -368,6 +539,24 @@ func (b *Botanist) DeploySeedMonitoring() error {
 	return b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-monitoring"), fmt.Sprintf("%s-monitoring", b.Shoot.SeedNamespace), b.Shoot.SeedNamespace, nil, values)
 }
 
+// ComputeShootMonitoring computes the URLs of the monitoring components which are deployed by DeploySeedMonitoring
+// and exposed via the ingress of the Seed cluster. The credentials to access them are stored in the kubeconfig secret
+// of the Shoot in the Garden cluster.
+func (b *Botanist) ComputeShootMonitoring() *gardenv1beta1.ShootMonitoring {
+	return &gardenv1beta1.ShootMonitoring{
+		AlertManagerURL: "https://" + b.Seed.GetIngressFQDN(common.AlertManagerIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName),
+		GrafanaURL:      "https://" + b.Seed.GetIngressFQDN(common.GrafanaIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName),
+		PrometheusURL:   "https://" + b.Seed.GetIngressFQDN(common.PrometheusIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName),
+		CredentialsSecretRef: corev1.LocalObjectReference{
+			Name: generateGardenSecretName(b.Shoot.Info.Name, "kubeconfig"),
+		},
+		FederationURL: "https://" + b.Seed.GetIngressFQDN(common.PrometheusFederationIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName),
+		FederationCredentialsSecretRef: corev1.LocalObjectReference{
+			Name: generateGardenSecretName(b.Shoot.Info.Name, "monitoring-federation"),
+		},
+	}
+}
+
 // DeleteSeedMonitoring will delete the monitoring stack from the Seed cluster to avoid phantom alerts
 // during the deletion process. More precisely, the Alertmanager and Prometheus StatefulSets will be
 // deleted.
@@ -0,0 +1,134 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var _ = Describe("controlplane", func() {
+	var config map[string]componentconfig.ControlPlaneResourceLimits
+
+	BeforeEach(func() {
+		config = map[string]componentconfig.ControlPlaneResourceLimits{
+			componentconfig.ControlPlaneResourceLimitsDefault: {
+				Quota: corev1.ResourceList{
+					corev1.ResourceRequestsCPU:    resource.MustParse("4000m"),
+					corev1.ResourceRequestsMemory: resource.MustParse("8192Mi"),
+					corev1.ResourcePods:           resource.MustParse("2"),
+				},
+				QuotaPerNode: corev1.ResourceList{
+					corev1.ResourceRequestsCPU:    resource.MustParse("50m"),
+					corev1.ResourceRequestsMemory: resource.MustParse("128Mi"),
+				},
+				Max: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("4"),
+				},
+			},
+			"evaluation": {
+				Quota: corev1.ResourceList{
+					corev1.ResourceRequestsCPU: resource.MustParse("1000m"),
+				},
+				QuotaPerNode: corev1.ResourceList{
+					corev1.ResourceRequestsCPU: resource.MustParse("0"),
+				},
+				DefaultRequest: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("10m"),
+				},
+			},
+		}
+	})
+
+	Describe("#controlPlaneResourceLimits", func() {
+		It("should return the default limits for Shoots without purpose or with an unknown purpose", func() {
+			Expect(ExportControlPlaneResourceLimits(config, "")).To(Equal(config[componentconfig.ControlPlaneResourceLimitsDefault]))
+			Expect(ExportControlPlaneResourceLimits(config, "production")).To(Equal(config[componentconfig.ControlPlaneResourceLimitsDefault]))
+		})
+
+		It("should merge the limits of the purpose over the default limits", func() {
+			limits := ExportControlPlaneResourceLimits(config, "evaluation")
+
+			Expect(limits.Quota).To(Equal(corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("1000m"),
+				corev1.ResourceRequestsMemory: resource.MustParse("8192Mi"),
+				corev1.ResourcePods:           resource.MustParse("2"),
+			}))
+			Expect(limits.QuotaPerNode).To(Equal(corev1.ResourceList{
+				corev1.ResourceRequestsCPU:    resource.MustParse("0"),
+				corev1.ResourceRequestsMemory: resource.MustParse("128Mi"),
+			}))
+			Expect(limits.DefaultRequest).To(Equal(corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("10m"),
+			}))
+			Expect(limits.Max).To(Equal(config[componentconfig.ControlPlaneResourceLimitsDefault].Max))
+		})
+
+		It("should not modify the configuration", func() {
+			ExportControlPlaneResourceLimits(config, "evaluation")
+
+			Expect(config[componentconfig.ControlPlaneResourceLimitsDefault].Quota).To(HaveKeyWithValue(corev1.ResourceRequestsCPU, resource.MustParse("4000m")))
+			Expect(config[componentconfig.ControlPlaneResourceLimitsDefault].DefaultRequest).To(BeNil())
+		})
+
+		It("should return empty limits if nothing is configured", func() {
+			Expect(ExportControlPlaneResourceLimits(nil, "evaluation")).To(Equal(componentconfig.ControlPlaneResourceLimits{}))
+		})
+	})
+
+	Describe("#namespaceResourceLimitsValues", func() {
+		It("should grow the quota with the number of nodes and derive the pods and volumes from the components", func() {
+			values := ExportNamespaceResourceLimitsValues(config[componentconfig.ControlPlaneResourceLimitsDefault], 10, 9, 4)
+
+			Expect(values).To(Equal(map[string]interface{}{
+				"quota": map[string]interface{}{
+					"hard": map[string]interface{}{
+						"requests.cpu":           "4500m",
+						"requests.memory":        "9472Mi",
+						"pods":                   "29",
+						"persistentvolumeclaims": "4",
+					},
+				},
+				"limits": map[string]interface{}{
+					"defaultRequest": map[string]interface{}{},
+					"max": map[string]interface{}{
+						"cpu": "4",
+					},
+				},
+			}))
+		})
+
+		It("should count the quota per node from zero if there is no base quota", func() {
+			limits := componentconfig.ControlPlaneResourceLimits{
+				QuotaPerNode: corev1.ResourceList{corev1.ResourceRequestsMemory: resource.MustParse("1Gi")},
+			}
+
+			values := ExportNamespaceResourceLimitsValues(limits, 3, 8, 4)
+
+			Expect(values["quota"]).To(Equal(map[string]interface{}{
+				"hard": map[string]interface{}{
+					"requests.memory":        "3Gi",
+					"pods":                   "25",
+					"persistentvolumeclaims": "4",
+				},
+			}))
+		})
+	})
+})
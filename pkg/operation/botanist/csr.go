@@ -0,0 +1,167 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"strings"
+
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	nodeUserPrefix = "system:node:"
+	nodesGroup     = "system:nodes"
+)
+
+// kubeletServingCertificateUsages are the key usages a kubelet may request for its serving certificate.
+var kubeletServingCertificateUsages = sets.NewString(
+	string(certificatesv1beta1.UsageDigitalSignature),
+	string(certificatesv1beta1.UsageKeyEncipherment),
+	string(certificatesv1beta1.UsageServerAuth),
+)
+
+// ApproveKubeletServingCertificates approves the pending certificate signing requests of the kubelets for their serving
+// certificates. Contrary to the client certificates, the kube-controller-manager does not approve those on its own. A
+// request is only approved if it has been issued by the node it is requesting a certificate for and if the requested
+// subject alternative names match the addresses of this node. Other requests are left untouched.
+func (b *Botanist) ApproveKubeletServingCertificates() error {
+	csrClient := b.K8sShootClient.Clientset().CertificatesV1beta1().CertificateSigningRequests()
+
+	csrList, err := csrClient.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, csr := range csrList.Items {
+		if !isPendingKubeletServingCSR(&csr) {
+			continue
+		}
+
+		nodeName := strings.TrimPrefix(csr.Spec.Username, nodeUserPrefix)
+		node, err := b.K8sShootClient.Clientset().CoreV1().Nodes().Get(nodeName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			b.Logger.Warnf("Not approving certificate signing request %s: node %s does not exist", csr.Name, nodeName)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := validateKubeletServingCSR(&csr, node); err != nil {
+			b.Logger.Warnf("Not approving certificate signing request %s: %s", csr.Name, err.Error())
+			continue
+		}
+
+		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1beta1.CertificateSigningRequestCondition{
+			Type:    certificatesv1beta1.CertificateApproved,
+			Reason:  "AutoApproved",
+			Message: "Auto-approving kubelet serving certificate after verifying the node identity.",
+		})
+		if _, err := csrClient.UpdateApproval(&csr); err != nil {
+			return err
+		}
+		b.Logger.Infof("Approved kubelet serving certificate signing request %s of node %s", csr.Name, nodeName)
+	}
+
+	return nil
+}
+
+// isPendingKubeletServingCSR checks whether the given certificate signing request has been issued by a node for a
+// server certificate and has neither been approved nor denied yet.
+func isPendingKubeletServingCSR(csr *certificatesv1beta1.CertificateSigningRequest) bool {
+	if len(csr.Status.Conditions) > 0 || len(csr.Status.Certificate) > 0 {
+		return false
+	}
+	if !strings.HasPrefix(csr.Spec.Username, nodeUserPrefix) {
+		return false
+	}
+	for _, usage := range csr.Spec.Usages {
+		if usage == certificatesv1beta1.UsageServerAuth {
+			return true
+		}
+	}
+	return false
+}
+
+// validateKubeletServingCSR performs the sanity checks on the identity of the node which requests a serving certificate.
+func validateKubeletServingCSR(csr *certificatesv1beta1.CertificateSigningRequest, node *corev1.Node) error {
+	if csr.Spec.Username != nodeUserPrefix+node.Name {
+		return fmt.Errorf("requesting user %q does not belong to node %q", csr.Spec.Username, node.Name)
+	}
+	if !sets.NewString(csr.Spec.Groups...).Has(nodesGroup) {
+		return fmt.Errorf("requesting user %q is not a member of group %q", csr.Spec.Username, nodesGroup)
+	}
+
+	for _, usage := range csr.Spec.Usages {
+		if !kubeletServingCertificateUsages.Has(string(usage)) {
+			return fmt.Errorf("usage %q is not allowed for kubelet serving certificates", usage)
+		}
+	}
+
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return fmt.Errorf("request does not contain a PEM encoded certificate request")
+	}
+	request, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return err
+	}
+
+	if request.Subject.CommonName != csr.Spec.Username {
+		return fmt.Errorf("common name %q does not match the requesting user %q", request.Subject.CommonName, csr.Spec.Username)
+	}
+	if !reflect.DeepEqual(request.Subject.Organization, []string{nodesGroup}) {
+		return fmt.Errorf("organization %v is not allowed, it must be [%s]", request.Subject.Organization, nodesGroup)
+	}
+	if len(request.EmailAddresses) > 0 || len(request.URIs) > 0 {
+		return fmt.Errorf("email and URI subject alternative names are not allowed")
+	}
+	if len(request.DNSNames)+len(request.IPAddresses) == 0 {
+		return fmt.Errorf("no subject alternative names requested")
+	}
+
+	var (
+		dnsNames    = sets.NewString()
+		ipAddresses = sets.NewString()
+	)
+	for _, address := range node.Status.Addresses {
+		switch address.Type {
+		case corev1.NodeHostName, corev1.NodeInternalDNS, corev1.NodeExternalDNS:
+			dnsNames.Insert(address.Address)
+		case corev1.NodeInternalIP, corev1.NodeExternalIP:
+			ipAddresses.Insert(address.Address)
+		}
+	}
+	for _, dnsName := range request.DNSNames {
+		if !dnsNames.Has(dnsName) {
+			return fmt.Errorf("DNS name %q is not an address of node %q", dnsName, node.Name)
+		}
+	}
+	for _, ip := range request.IPAddresses {
+		if !ipAddresses.Has(ip.String()) {
+			return fmt.Errorf("IP address %q is not an address of node %q", ip.String(), node.Name)
+		}
+	}
+
+	return nil
+}
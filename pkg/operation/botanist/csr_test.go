@@ -0,0 +1,146 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("csr", func() {
+	Describe("#validateKubeletServingCSR", func() {
+		var (
+			nodeName = "node-1"
+			username = "system:node:" + nodeName
+
+			node *corev1.Node
+
+			newCSR = func(template *x509.CertificateRequest) *certificatesv1beta1.CertificateSigningRequest {
+				key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+				Expect(err).NotTo(HaveOccurred())
+
+				return &certificatesv1beta1.CertificateSigningRequest{
+					ObjectMeta: metav1.ObjectMeta{Name: "csr-1"},
+					Spec: certificatesv1beta1.CertificateSigningRequestSpec{
+						Request:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+						Username: username,
+						Groups:   []string{"system:nodes", "system:authenticated"},
+						Usages: []certificatesv1beta1.KeyUsage{
+							certificatesv1beta1.UsageDigitalSignature,
+							certificatesv1beta1.UsageKeyEncipherment,
+							certificatesv1beta1.UsageServerAuth,
+						},
+					},
+				}
+			}
+
+			validTemplate = func() *x509.CertificateRequest {
+				return &x509.CertificateRequest{
+					Subject:     pkix.Name{CommonName: username, Organization: []string{"system:nodes"}},
+					DNSNames:    []string{nodeName},
+					IPAddresses: []net.IP{net.ParseIP("10.250.0.2")},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			node = &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: nodeName},
+				Status: corev1.NodeStatus{
+					Addresses: []corev1.NodeAddress{
+						{Type: corev1.NodeHostName, Address: nodeName},
+						{Type: corev1.NodeInternalIP, Address: "10.250.0.2"},
+					},
+				},
+			}
+		})
+
+		It("should accept a request matching the node's identity", func() {
+			Expect(ExportValidateKubeletServingCSR(newCSR(validTemplate()), node)).To(Succeed())
+		})
+
+		It("should reject a request of another node", func() {
+			csr := newCSR(validTemplate())
+			csr.Spec.Username = "system:node:node-2"
+
+			Expect(ExportValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+		})
+
+		It("should reject a request of a user which is not in the nodes group", func() {
+			csr := newCSR(validTemplate())
+			csr.Spec.Groups = []string{"system:authenticated"}
+
+			Expect(ExportValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+		})
+
+		It("should reject a request with client usages", func() {
+			csr := newCSR(validTemplate())
+			csr.Spec.Usages = append(csr.Spec.Usages, certificatesv1beta1.UsageClientAuth)
+
+			Expect(ExportValidateKubeletServingCSR(csr, node)).NotTo(Succeed())
+		})
+
+		It("should reject a request whose common name does not match the user", func() {
+			template := validTemplate()
+			template.Subject.CommonName = "system:node:node-2"
+
+			Expect(ExportValidateKubeletServingCSR(newCSR(template), node)).NotTo(Succeed())
+		})
+
+		It("should reject a request with a foreign organization", func() {
+			template := validTemplate()
+			template.Subject.Organization = []string{"system:masters"}
+
+			Expect(ExportValidateKubeletServingCSR(newCSR(template), node)).NotTo(Succeed())
+		})
+
+		It("should reject a request for addresses not belonging to the node", func() {
+			template := validTemplate()
+			template.IPAddresses = append(template.IPAddresses, net.ParseIP("10.250.0.3"))
+
+			Expect(ExportValidateKubeletServingCSR(newCSR(template), node)).NotTo(Succeed())
+		})
+
+		It("should reject a request for DNS names not belonging to the node", func() {
+			template := validTemplate()
+			template.DNSNames = []string{"kubernetes.default.svc"}
+
+			Expect(ExportValidateKubeletServingCSR(newCSR(template), node)).NotTo(Succeed())
+		})
+
+		It("should reject a request with email subject alternative names", func() {
+			template := validTemplate()
+			template.EmailAddresses = []string{"admin@example.com"}
+
+			Expect(ExportValidateKubeletServingCSR(newCSR(template), node)).NotTo(Succeed())
+		})
+	})
+})
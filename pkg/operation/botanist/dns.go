@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist/awsbotanist"
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist/gcpbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -196,7 +197,7 @@ func (b *Botanist) getDomainCredentials(purposeInternalDomain bool, requiredKeys
 
 	for _, key := range requiredKeys {
 		if _, ok := secret.Data[key]; !ok {
-			return nil, fmt.Errorf("cannot use secret '%s' to create the DNS record because key '%s' is missing", secret.Name, key)
+			return nil, operationerrors.Errorf(operationerrors.ClassConfiguration, "cannot use secret '%s' to create the DNS record because key '%s' is missing", secret.Name, key)
 		}
 	}
 	return secret, nil
@@ -0,0 +1,118 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// DrainNodes cordons all nodes of the Shoot cluster and evicts the pods running on them. Evictions which are refused
+// because they would violate a PodDisruptionBudget are retried until the given <timeout> expires. It returns true if
+// all nodes have been drained in time, and false if the timeout expired before. It returns the error of the given
+// <ctx> once it has been canceled.
+func (b *Botanist) DrainNodes(ctx context.Context, timeout time.Duration) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	nodeNames := sets.NewString()
+	for _, node := range nodeList.Items {
+		nodeNames.Insert(node.Name)
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if _, err := b.K8sShootClient.Clientset().CoreV1().Nodes().Patch(node.Name, types.StrategicMergePatchType, []byte(`{"spec":{"unschedulable":true}}`)); err != nil && !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("Cordoning node %s failed: %s", node.Name, err.Error())
+		}
+	}
+	if nodeNames.Len() == 0 {
+		return true, nil
+	}
+
+	drainCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := wait.PollUntil(5*time.Second, func() (bool, error) {
+		podList, err := b.K8sShootClient.ListPods(metav1.NamespaceAll, metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		remaining := 0
+		for _, pod := range podList.Items {
+			if !nodeNames.Has(pod.Spec.NodeName) || !podEvictable(pod) {
+				continue
+			}
+			remaining++
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			eviction := &policyv1beta1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pod.Name,
+					Namespace: pod.Namespace,
+				},
+			}
+			// The eviction is refused with 429 (TooManyRequests) as long as it would violate a PodDisruptionBudget.
+			if err := b.K8sShootClient.Clientset().PolicyV1beta1().Evictions(pod.Namespace).Evict(eviction); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsTooManyRequests(err) {
+				return false, err
+			}
+		}
+
+		if remaining > 0 {
+			b.Logger.Infof("Waiting until %d pods have been evicted from the nodes...", remaining)
+			return false, nil
+		}
+		return true, nil
+	}, drainCtx.Done()); err != nil {
+		if ctx.Err() != nil {
+			return false, ctx.Err()
+		}
+		if err == wait.ErrWaitTimeout {
+			b.Logger.Warnf("Nodes could not be drained within %s", timeout)
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// podEvictable checks whether the given <pod> has to be evicted in order to drain its node. Pods managed by a
+// DaemonSet and static (mirror) pods cannot be evicted, and terminated pods do not have to be.
+func podEvictable(pod corev1.Pod) bool {
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+	if controllerRef := metav1.GetControllerOf(&pod); controllerRef != nil && controllerRef.Kind == "DaemonSet" {
+		return false
+	}
+	return pod.Status.Phase != corev1.PodSucceeded && pod.Status.Phase != corev1.PodFailed
+}
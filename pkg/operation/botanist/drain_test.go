@@ -0,0 +1,75 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("drain", func() {
+	Describe("#podEvictable", func() {
+		var pod corev1.Pod
+
+		BeforeEach(func() {
+			pod = corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod",
+					Namespace: "default",
+				},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodRunning,
+				},
+			}
+		})
+
+		It("should evict running pods", func() {
+			Expect(ExportPodEvictable(pod)).To(BeTrue())
+		})
+
+		It("should evict pods managed by a ReplicaSet", func() {
+			controller := true
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "rs", Controller: &controller}}
+
+			Expect(ExportPodEvictable(pod)).To(BeTrue())
+		})
+
+		It("should not evict pods managed by a DaemonSet", func() {
+			controller := true
+			pod.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds", Controller: &controller}}
+
+			Expect(ExportPodEvictable(pod)).To(BeFalse())
+		})
+
+		It("should not evict mirror pods", func() {
+			pod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "hash"}
+
+			Expect(ExportPodEvictable(pod)).To(BeFalse())
+		})
+
+		It("should not evict terminated pods", func() {
+			pod.Status.Phase = corev1.PodSucceeded
+			Expect(ExportPodEvictable(pod)).To(BeFalse())
+
+			pod.Status.Phase = corev1.PodFailed
+			Expect(ExportPodEvictable(pod)).To(BeFalse())
+		})
+	})
+})
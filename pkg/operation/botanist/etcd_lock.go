@@ -0,0 +1,80 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/etcdlock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EtcdOperationLockReason returns the reason for which the reconciliation of the Shoot must hold its etcd operation
+// lock, or an empty reason if it does not need the lock.
+func (b *Botanist) EtcdOperationLockReason() etcdlock.Reason {
+	switch {
+	case len(b.Shoot.CloneSourceName()) > 0:
+		return etcdlock.ReasonRestore
+	case b.Shoot.Hibernated:
+		return etcdlock.ReasonHibernation
+	}
+	return ""
+}
+
+// AcquireEtcdOperationLock acquires the etcd operation lock of the Shoot for the reason of its reconciliation and
+// keeps renewing it until ReleaseEtcdOperationLock is called. The holder of the lock is written to the status of the
+// Shoot, also if the lock is held by another operation.
+func (b *Botanist) AcquireEtcdOperationLock() error {
+	if b.releaseEtcdOperationLock != nil {
+		return nil
+	}
+
+	lock := etcdlock.New(b.K8sSeedClient.Clientset().CoreV1().ConfigMaps(b.Shoot.SeedNamespace), fmt.Sprintf("%s/shoot", b.GardenerInfo.Name), etcdlock.DefaultLeaseDuration)
+	record, release, err := lock.Hold(b.EtcdOperationLockReason(), b.Logger)
+	if err != nil {
+		if held, ok := err.(*etcdlock.HeldError); ok {
+			b.ReportShootEtcdOperationLock(etcdOperationLockStatus(held.Record))
+		}
+		return err
+	}
+
+	b.releaseEtcdOperationLock = release
+	b.ReportShootEtcdOperationLock(etcdOperationLockStatus(record))
+	return nil
+}
+
+// ReleaseEtcdOperationLock releases the etcd operation lock of the Shoot if it has been acquired by
+// AcquireEtcdOperationLock.
+func (b *Botanist) ReleaseEtcdOperationLock() error {
+	if b.releaseEtcdOperationLock == nil {
+		return nil
+	}
+	if err := b.releaseEtcdOperationLock(); err != nil {
+		return err
+	}
+
+	b.releaseEtcdOperationLock = nil
+	b.ReportShootEtcdOperationLock(nil)
+	return nil
+}
+
+func etcdOperationLockStatus(record *etcdlock.Record) *gardenv1beta1.ShootEtcdOperationLock {
+	return &gardenv1beta1.ShootEtcdOperationLock{
+		Holder:      record.Holder,
+		Reason:      string(record.Reason),
+		AcquireTime: metav1.NewTime(record.AcquireTime),
+	}
+}
@@ -17,5 +17,44 @@
 package botanist
 
 var (
-	ExportGenerateKubeconfig = generateKubeconfig
+	ExportGenerateKubeconfig             = generateKubeconfig
+	ExportValidateKubeletServingCSR      = validateKubeletServingCSR
+	ExportPodEvictable                   = podEvictable
+	ExportComputeNodeMetadata            = computeNodeMetadata
+	ExportCriticalComponentsReady        = criticalComponentsReady
+	ExportComputeNetworkUtilizations     = computeNetworkUtilizations
+	ExportInventoryResources             = inventoryResources
+	ExportSortSecretVersions             = sortSecretVersions
+	ExportObsoleteSecretVersions         = obsoleteSecretVersions
+	ExportPersistedSecretNames           = persistedSecretNames
+	ExportComputeExtensionObject         = computeExtensionObject
+	ExportExtensionReconciled            = extensionReconciled
+	ExportNodesPendingReboot             = nodesPendingReboot
+	ExportRecoveryDrillPods              = recoveryDrillPods
+	ExportRecoveryDrillMachines          = recoveryDrillMachines
+	ExportOrphanedPersistentVolumeClaims = orphanedPersistentVolumeClaims
+	ExportControlPlaneResourceLimits     = controlPlaneResourceLimits
+	ExportNamespaceResourceLimitsValues  = namespaceResourceLimitsValues
 )
+
+// ExportEncodeDecodeSecretSnapshot encrypts a snapshot of the given <secrets> with the given <key> and decrypts it
+// again with the given <decryptionKey>. It returns the decrypted snapshot and the checksum of the snapshot.
+func ExportEncodeDecodeSecretSnapshot(key, decryptionKey []byte, secrets map[string]map[string][]byte) (map[string]map[string][]byte, string, error) {
+	snapshot := secretSnapshot{}
+	for name, data := range secrets {
+		snapshot[name] = snapshotSecret{Type: "Opaque", Data: data}
+	}
+	encrypted, checksum, err := encodeSecretSnapshot(key, snapshot)
+	if err != nil {
+		return nil, "", err
+	}
+	decrypted, err := decodeSecretSnapshot(decryptionKey, encrypted)
+	if err != nil {
+		return nil, checksum, err
+	}
+	out := map[string]map[string][]byte{}
+	for name, secret := range decrypted {
+		out[name] = secret.Data
+	}
+	return out, checksum, nil
+}
@@ -0,0 +1,272 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// extensionPollInterval is the interval in which the Extension resources are checked while waiting for them.
+	extensionPollInterval = 5 * time.Second
+	// defaultExtensionTimeout is the maximum duration to wait for an extension if it does not define a timeout.
+	defaultExtensionTimeout = 10 * time.Minute
+)
+
+// HasExtensions returns true if extensions are registered for the given <point> of the flow of the Shoot.
+func (b *Botanist) HasExtensions(point componentconfig.ShootExtensionPoint) bool {
+	return len(b.extensionsAt(point)) > 0
+}
+
+// ReconcileExtensionsAfterInfrastructure reconciles the extensions which are registered for the point after the
+// infrastructure of the Shoot has been deployed.
+func (b *Botanist) ReconcileExtensionsAfterInfrastructure(ctx context.Context) error {
+	return b.reconcileExtensions(ctx, componentconfig.ShootExtensionPointAfterInfrastructure)
+}
+
+// ReconcileExtensionsBeforeMachines reconciles the extensions which are registered for the point before the machines
+// of the Shoot are deployed.
+func (b *Botanist) ReconcileExtensionsBeforeMachines(ctx context.Context) error {
+	return b.reconcileExtensions(ctx, componentconfig.ShootExtensionPointBeforeMachines)
+}
+
+// ReconcileExtensionsAfterAddons reconciles the extensions which are registered for the point after the addons of the
+// Shoot have been deployed.
+func (b *Botanist) ReconcileExtensionsAfterAddons(ctx context.Context) error {
+	return b.reconcileExtensions(ctx, componentconfig.ShootExtensionPointAfterAddons)
+}
+
+// reconcileExtensions creates or updates the Extension resources of all extensions registered for the given <point>
+// in the Shoot namespace of the Seed. They are annotated with a reconciliation request which their controllers remove
+// once they have reported the result in the status. It waits until all extensions have been reconciled successfully,
+// at most for their timeouts, and fails as soon as one of them has reported a failure.
+func (b *Botanist) reconcileExtensions(ctx context.Context, point componentconfig.ShootExtensionPoint) error {
+	var (
+		extensions = b.extensionsAt(point)
+		start      = time.Now()
+	)
+
+	for _, extension := range extensions {
+		if err := b.applyExtension(ctx, computeExtensionObject(b.Shoot.Info, b.Shoot.SeedNamespace, extension)); err != nil {
+			return fmt.Errorf("failed to request the reconciliation of extension %q: %v", extension.Type, err)
+		}
+	}
+
+	for _, extension := range extensions {
+		waitCtx, cancel := context.WithDeadline(ctx, start.Add(extensionTimeout(extension)))
+		err := b.waitUntilExtensionReconciled(waitCtx, extension.Type)
+		cancel()
+
+		if err == wait.ErrWaitTimeout {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			return fmt.Errorf("extension %q has not been reconciled within %s", extension.Type, extensionTimeout(extension))
+		}
+		if err != nil {
+			return err
+		}
+		b.Logger.Infof("Extension %q has been reconciled", extension.Type)
+	}
+
+	return nil
+}
+
+// extensionsAt returns the extensions which are registered for the given <point>.
+func (b *Botanist) extensionsAt(point componentconfig.ShootExtensionPoint) []componentconfig.ShootExtension {
+	var extensions []componentconfig.ShootExtension
+	for _, extension := range b.Extensions {
+		if extension.Point == point {
+			extensions = append(extensions, extension)
+		}
+	}
+	return extensions
+}
+
+// applyExtension creates the given Extension resource <obj>, or updates it if it exists already. The finalizers of the
+// existing resource are kept, hence, the controllers of the extensions can use them to clean up on deletion.
+func (b *Botanist) applyExtension(ctx context.Context, obj *unstructured.Unstructured) error {
+	var existing unstructured.Unstructured
+
+	err := b.K8sSeedClient.ExtensionsV1alpha1("GET", obj.GetNamespace()).Name(obj.GetName()).Context(ctx).Do().Into(&existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	if apierrors.IsNotFound(err) {
+		body, err := json.Marshal(obj.UnstructuredContent())
+		if err != nil {
+			return err
+		}
+		return b.K8sSeedClient.ExtensionsV1alpha1("POST", obj.GetNamespace()).Body(body).Context(ctx).Do().Error()
+	}
+
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	obj.SetFinalizers(existing.GetFinalizers())
+	body, err := json.Marshal(obj.UnstructuredContent())
+	if err != nil {
+		return err
+	}
+	return b.K8sSeedClient.ExtensionsV1alpha1("PUT", obj.GetNamespace()).Name(obj.GetName()).Body(body).Context(ctx).Do().Error()
+}
+
+// waitUntilExtensionReconciled waits until the controller of the extension of the given type <extensionType> has
+// handled the reconciliation request. It returns wait.ErrWaitTimeout once the given <ctx> has expired.
+func (b *Botanist) waitUntilExtensionReconciled(ctx context.Context, extensionType string) error {
+	var reconcileErr error
+
+	if err := wait.PollUntil(extensionPollInterval, func() (bool, error) {
+		var obj unstructured.Unstructured
+		if err := b.K8sSeedClient.ExtensionsV1alpha1("GET", b.Shoot.SeedNamespace).Name(extensionType).Context(ctx).Do().Into(&obj); err != nil {
+			if ctx.Err() != nil {
+				return false, wait.ErrWaitTimeout
+			}
+			return false, err
+		}
+
+		done, err := extensionReconciled(&obj)
+		if !done {
+			b.Logger.Infof("Waiting until extension %q has been reconciled", extensionType)
+		}
+		reconcileErr = err
+		return done, nil
+	}, ctx.Done()); err != nil {
+		return err
+	}
+
+	return reconcileErr
+}
+
+// DeleteExtensions deletes all Extension resources in the Shoot namespace of the Seed and waits until they are gone,
+// i.e., until their controllers have removed their finalizers (e.g., after the Shoot has been deregistered from a
+// CMDB or its networks have been released in an IPAM).
+func (b *Botanist) DeleteExtensions(ctx context.Context) error {
+	timeout := defaultExtensionTimeout
+	for _, extension := range b.Extensions {
+		if t := extensionTimeout(extension); t > timeout {
+			timeout = t
+		}
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := wait.PollUntil(extensionPollInterval, func() (bool, error) {
+		var list unstructured.UnstructuredList
+		if err := b.K8sSeedClient.ExtensionsV1alpha1("GET", b.Shoot.SeedNamespace).Context(waitCtx).Do().Into(&list); err != nil {
+			// The Extension resources do not exist in Seeds which have not been bootstrapped with their definition.
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			if waitCtx.Err() != nil {
+				return false, wait.ErrWaitTimeout
+			}
+			return false, err
+		}
+		if len(list.Items) == 0 {
+			return true, nil
+		}
+
+		if err := list.EachListItem(func(o runtime.Object) error {
+			obj := o.(*unstructured.Unstructured)
+			if obj.GetDeletionTimestamp() != nil {
+				return nil
+			}
+			if err := b.K8sSeedClient.ExtensionsV1alpha1("DELETE", b.Shoot.SeedNamespace).Name(obj.GetName()).Context(waitCtx).Do().Error(); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+			return nil
+		}); err != nil {
+			return false, err
+		}
+
+		b.Logger.Infof("Waiting until %d extension(s) have been deleted", len(list.Items))
+		return false, nil
+	}, waitCtx.Done())
+
+	if err == wait.ErrWaitTimeout {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return fmt.Errorf("the extensions have not been deleted within %s", timeout)
+	}
+	return err
+}
+
+// computeExtensionObject computes the Extension resource of the given <extension> for the given <shoot> in the given
+// <seedNamespace>. It carries the annotation which requests its reconciliation.
+func computeExtensionObject(shoot *gardenv1beta1.Shoot, seedNamespace string, extension componentconfig.ShootExtension) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "extensions.garden.sapcloud.io/v1alpha1",
+		"kind":       "Extension",
+		"spec": map[string]interface{}{
+			"type":  extension.Type,
+			"point": string(extension.Point),
+			"shoot": map[string]interface{}{
+				"name":              shoot.Name,
+				"namespace":         shoot.Namespace,
+				"uid":               string(shoot.UID),
+				"region":            shoot.Spec.Cloud.Region,
+				"kubernetesVersion": shoot.Spec.Kubernetes.Version,
+			},
+		},
+	}}
+	obj.SetName(extension.Type)
+	obj.SetNamespace(seedNamespace)
+	obj.SetLabels(map[string]string{
+		common.GardenRole: "extension",
+	})
+	obj.SetAnnotations(map[string]string{
+		common.ExtensionOperation: common.ExtensionOperationReconcile,
+	})
+	return obj
+}
+
+// extensionReconciled evaluates the given Extension resource <obj>. It returns true once its controller has removed the
+// reconciliation request, and an error if the controller has not reported success in the status of the Extension.
+func extensionReconciled(obj *unstructured.Unstructured) (bool, error) {
+	if _, ok := obj.GetAnnotations()[common.ExtensionOperation]; ok {
+		return false, nil
+	}
+
+	state, _, _ := unstructured.NestedString(obj.UnstructuredContent(), "status", "lastOperation", "state")
+	if state == string(gardenv1beta1.ShootLastOperationStateSucceeded) {
+		return true, nil
+	}
+
+	description, _, _ := unstructured.NestedString(obj.UnstructuredContent(), "status", "lastOperation", "description")
+	if len(description) == 0 {
+		description = "no description"
+	}
+	return true, fmt.Errorf("extension %q has not been reconciled successfully (state %q): %s", obj.GetName(), state, description)
+}
+
+// extensionTimeout returns the maximum duration to wait for the given <extension>.
+func extensionTimeout(extension componentconfig.ShootExtension) time.Duration {
+	if extension.Timeout != nil && extension.Timeout.Duration > 0 {
+		return extension.Timeout.Duration
+	}
+	return defaultExtensionTimeout
+}
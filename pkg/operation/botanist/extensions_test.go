@@ -0,0 +1,119 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("extensions", func() {
+	var (
+		timeout   = metav1.Duration{Duration: 5 * time.Minute}
+		extension = componentconfig.ShootExtension{
+			Type:    "cmdb",
+			Point:   componentconfig.ShootExtensionPointAfterInfrastructure,
+			Timeout: &timeout,
+		}
+	)
+
+	Describe("#computeExtensionObject", func() {
+		It("should compute the Extension resource with a reconciliation request", func() {
+			shoot := &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo", UID: "1234"},
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud:      gardenv1beta1.Cloud{Region: "eu-west-1"},
+					Kubernetes: gardenv1beta1.Kubernetes{Version: "1.11.3"},
+				},
+			}
+
+			obj := ExportComputeExtensionObject(shoot, "shoot--foo--bar", extension)
+
+			Expect(obj.GetAPIVersion()).To(Equal("extensions.garden.sapcloud.io/v1alpha1"))
+			Expect(obj.GetKind()).To(Equal("Extension"))
+			Expect(obj.GetName()).To(Equal("cmdb"))
+			Expect(obj.GetNamespace()).To(Equal("shoot--foo--bar"))
+			Expect(obj.GetAnnotations()).To(HaveKeyWithValue(common.ExtensionOperation, common.ExtensionOperationReconcile))
+			Expect(obj.Object["spec"]).To(Equal(map[string]interface{}{
+				"type":  "cmdb",
+				"point": "AfterInfrastructure",
+				"shoot": map[string]interface{}{
+					"name":              "bar",
+					"namespace":         "garden-foo",
+					"uid":               "1234",
+					"region":            "eu-west-1",
+					"kubernetesVersion": "1.11.3",
+				},
+			}))
+		})
+	})
+
+	Describe("#extensionReconciled", func() {
+		var extensionObject = func(annotations map[string]string, state, description string) *unstructured.Unstructured {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"status": map[string]interface{}{
+					"lastOperation": map[string]interface{}{
+						"state":       state,
+						"description": description,
+					},
+				},
+			}}
+			obj.SetName("cmdb")
+			obj.SetAnnotations(annotations)
+			return obj
+		}
+
+		It("should not be done while the reconciliation request has not been handled", func() {
+			done, err := ExportExtensionReconciled(extensionObject(map[string]string{common.ExtensionOperation: common.ExtensionOperationReconcile}, "Failed", "old failure"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeFalse())
+		})
+
+		It("should be done once the controller has reported success", func() {
+			done, err := ExportExtensionReconciled(extensionObject(nil, "Succeeded", ""))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(done).To(BeTrue())
+		})
+
+		It("should return the failure reported by the controller", func() {
+			done, err := ExportExtensionReconciled(extensionObject(nil, "Failed", "CMDB not reachable"))
+
+			Expect(done).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("CMDB not reachable")))
+		})
+	})
+
+	Describe("#HasExtensions", func() {
+		It("should only report the extensions of the given point", func() {
+			botanist := &Botanist{Extensions: []componentconfig.ShootExtension{extension}}
+
+			Expect(botanist.HasExtensions(componentconfig.ShootExtensionPointAfterInfrastructure)).To(BeTrue())
+			Expect(botanist.HasExtensions(componentconfig.ShootExtensionPointBeforeMachines)).To(BeFalse())
+			Expect(botanist.HasExtensions(componentconfig.ShootExtensionPointAfterAddons)).To(BeFalse())
+		})
+	})
+})
@@ -15,48 +15,237 @@
 package botanist
 
 import (
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/gardener/gardener/pkg/operation/common"
+	appsv1beta2 "k8s.io/api/apps/v1beta2"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// statefulSetPersistentVolumeClaimName matches the names of PersistentVolumeClaims which have been created
+// from the volume claim templates of a StatefulSet, i.e. '<template>-<statefulset>-<ordinal>'.
+var statefulSetPersistentVolumeClaimName = regexp.MustCompile(`^.+-[0-9]+$`)
+
+// statefulSetOrdinal matches the ordinal of a pod of a StatefulSet.
+var statefulSetOrdinal = regexp.MustCompile(`^[0-9]+$`)
+
 // PerformGarbageCollectionSeed performs garbage collection in the Shoot namespace in the Seed cluster,
-// i.e., it deletes old machine sets which have a desired=actual=0 replica count.
-func (b *Botanist) PerformGarbageCollectionSeed() error {
-	var machineSetList unstructured.Unstructured
-	if err := b.K8sSeedClient.MachineV1alpha1("GET", "machinesets", b.Shoot.SeedNamespace).Do().Into(&machineSetList); err != nil {
+// i.e., it deletes old machine sets which have a desired=actual=0 replica count. Moreover, it deletes
+// completed Terraformer pods and jobs, superseded Terraformer secrets as well as PersistentVolumeClaims of
+// deleted StatefulSets if they have been orphaned for more than the given <retention>.
+func (b *Botanist) PerformGarbageCollectionSeed(retention time.Duration) error {
+	return utilerrors.NewAggregate([]error{
+		b.deleteStaleMachineSets(),
+		b.deleteCompletedTerraformerPods(retention),
+		b.deleteCompletedTerraformerJobs(retention),
+		b.deleteSupersededTerraformerSecrets(retention),
+		b.deleteOrphanedPersistentVolumeClaims(retention),
+	})
+}
+
+// deleteStaleMachineSets deletes the machine sets which have a desired=actual=0 replica count.
+func (b *Botanist) deleteStaleMachineSets() error {
+	machineSetList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineSets(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
 		return err
 	}
-	return machineSetList.EachListItem(func(o runtime.Object) error {
+
+	for _, machineSet := range machineSetList.Items {
+		if machineSet.Spec.Replicas != 0 || machineSet.Status.Replicas != 0 {
+			continue
+		}
+
+		b.Logger.Debugf("Deleting MachineSet %s as the number of desired and actual replicas is 0.", machineSet.Name)
+		if err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineSets(b.Shoot.SeedNamespace).Delete(machineSet.Name, nil); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteCompletedTerraformerPods deletes the Terraformer pods which have been completed and which are older
+// than the given <retention>. Usually, the Terraformer deletes its pods itself, however, they remain if the
+// Gardener was interrupted while the Terraformer was running.
+func (b *Botanist) deleteCompletedTerraformerPods(retention time.Duration) error {
+	podList, err := b.K8sSeedClient.ListPods(b.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, pod := range podList.Items {
 		var (
-			obj                                                          = o.(*unstructured.Unstructured)
-			machineSetName                                               = obj.GetName()
-			machineSetDesiredReplicas, machineSetDesiredReplicasFound, _ = unstructured.NestedInt64(obj.UnstructuredContent(), "spec", "replicas")
-			machineSetActualReplicas, machineSetActualReplicasFound, _   = unstructured.NestedInt64(obj.UnstructuredContent(), "status", "replicas")
+			isTerraformerPod = strings.HasSuffix(pod.Name, common.TerraformerPodSuffix) || strings.HasSuffix(pod.Labels["job-name"], common.TerraformerJobSuffix)
+			completed        = pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
 		)
+		if !isTerraformerPod || !completed || !olderThan(pod.ObjectMeta, retention) {
+			continue
+		}
+		b.Logger.Debugf("Deleting completed Terraformer pod %s.", pod.Name)
+		if err := b.K8sSeedClient.DeletePod(pod.Namespace, pod.Name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
 
-		if !machineSetDesiredReplicasFound {
-			machineSetDesiredReplicas = -1
+// deleteCompletedTerraformerJobs deletes the Terraformer jobs which have been completed and which are older
+// than the given <retention>.
+func (b *Botanist) deleteCompletedTerraformerJobs(retention time.Duration) error {
+	jobList, err := b.K8sSeedClient.ListJobs(b.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, job := range jobList.Items {
+		if !strings.HasSuffix(job.Name, common.TerraformerJobSuffix) || !jobCompleted(job) || !olderThan(job.ObjectMeta, retention) {
+			continue
 		}
-		if !machineSetActualReplicasFound {
-			machineSetActualReplicas = -1
+		b.Logger.Debugf("Deleting completed Terraformer job %s.", job.Name)
+		if err := b.K8sSeedClient.DeleteJob(job.Namespace, job.Name); err != nil && !apierrors.IsNotFound(err) {
+			return err
 		}
+	}
+	return nil
+}
 
-		if machineSetDesiredReplicas == 0 && machineSetActualReplicas == 0 {
-			b.Logger.Debugf("Deleting MachineSet %s as the number of desired and actual replicas is 0.", machineSetName)
-			err := b.K8sSeedClient.MachineV1alpha1("DELETE", "machinesets", b.Shoot.SeedNamespace).Name(machineSetName).Do().Error()
-			if apierrors.IsNotFound(err) {
-				return nil
+// deleteOrphanedPersistentVolumeClaims deletes the PersistentVolumeClaims which have been created for a StatefulSet
+// which does no longer exist, and which are neither used by any pod nor have been orphaned for less than the given
+// <retention>. The time when a claim has first been seen orphaned is recorded in an annotation on the claim.
+func (b *Botanist) deleteOrphanedPersistentVolumeClaims(retention time.Duration) error {
+	statefulSetList, err := b.K8sSeedClient.ListStatefulSets(b.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	podList, err := b.K8sSeedClient.ListPods(b.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	pvcList, err := b.K8sSeedClient.ListPersistentVolumeClaims(b.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	toUpdate, toDelete := orphanedPersistentVolumeClaims(statefulSetList.Items, podList.Items, pvcList.Items, time.Now(), retention)
+	for _, pvc := range toUpdate {
+		if _, ok := pvc.Annotations[common.PersistentVolumeClaimOrphanedSince]; ok {
+			b.Logger.Debugf("Marking PersistentVolumeClaim %s as orphaned as its StatefulSet does no longer exist.", pvc.Name)
+		} else {
+			b.Logger.Debugf("Unmarking PersistentVolumeClaim %s as its StatefulSet exists again.", pvc.Name)
+		}
+		if _, err := b.K8sSeedClient.UpdatePersistentVolumeClaim(pvc); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	for _, pvc := range toDelete {
+		b.Logger.Debugf("Deleting PersistentVolumeClaim %s as its StatefulSet does no longer exist.", pvc.Name)
+		if err := b.K8sSeedClient.DeletePersistentVolumeClaim(pvc.Namespace, pvc.Name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// orphanedPersistentVolumeClaims computes which of the given <pvcs> that have been created from the volume claim
+// templates of a StatefulSet must be updated and which must be deleted. Claims which are neither owned by one of the
+// given <statefulSets> nor used by one of the given <pods> are annotated with <now> when they are seen orphaned for
+// the first time, and they are deleted once they have been orphaned for more than <retention>. Claims which are
+// owned again (e.g., because their StatefulSet has been recreated) get the annotation removed. The claims to update
+// are returned as modified copies.
+func orphanedPersistentVolumeClaims(statefulSets []appsv1beta2.StatefulSet, pods []corev1.Pod, pvcs []corev1.PersistentVolumeClaim, now time.Time, retention time.Duration) ([]*corev1.PersistentVolumeClaim, []*corev1.PersistentVolumeClaim) {
+	var (
+		toUpdate   []*corev1.PersistentVolumeClaim
+		toDelete   []*corev1.PersistentVolumeClaim
+		usedClaims = sets.NewString()
+	)
+	for _, pod := range pods {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				usedClaims.Insert(volume.PersistentVolumeClaim.ClaimName)
 			}
+		}
+	}
+
+	for _, pvc := range pvcs {
+		if !statefulSetPersistentVolumeClaimName.MatchString(pvc.Name) {
+			continue
+		}
+		orphanedSince, marked := pvc.Annotations[common.PersistentVolumeClaimOrphanedSince]
+
+		if ownedByStatefulSet(pvc, statefulSets) || usedClaims.Has(pvc.Name) {
+			if marked {
+				updated := pvc.DeepCopy()
+				delete(updated.Annotations, common.PersistentVolumeClaimOrphanedSince)
+				toUpdate = append(toUpdate, updated)
+			}
+			continue
+		}
+
+		since, err := time.Parse(time.RFC3339, orphanedSince)
+		if !marked || err != nil {
+			updated := pvc.DeepCopy()
+			if updated.Annotations == nil {
+				updated.Annotations = map[string]string{}
+			}
+			updated.Annotations[common.PersistentVolumeClaimOrphanedSince] = now.UTC().Format(time.RFC3339)
+			toUpdate = append(toUpdate, updated)
+			continue
+		}
+		if now.Sub(since) > retention {
+			toDelete = append(toDelete, pvc.DeepCopy())
+		}
+	}
+	return toUpdate, toDelete
+}
+
+// ownedByStatefulSet returns true if the given <pvc> has been created from a volume claim template of one of the
+// given <statefulSets>, i.e., if its name is '<template>-<statefulset>-<ordinal>' and its labels match the selector
+// of the StatefulSet.
+func ownedByStatefulSet(pvc corev1.PersistentVolumeClaim, statefulSets []appsv1beta2.StatefulSet) bool {
+	for _, statefulSet := range statefulSets {
+		selector, err := metav1.LabelSelectorAsSelector(statefulSet.Spec.Selector)
+		if err != nil || !selector.Matches(labels.Set(pvc.Labels)) {
+			continue
+		}
+		for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+			ordinal := strings.TrimPrefix(pvc.Name, template.Name+"-"+statefulSet.Name+"-")
+			if ordinal != pvc.Name && statefulSetOrdinal.MatchString(ordinal) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// deleteSupersededTerraformerSecrets deletes the Terraformer variables secrets which are older than the given
+// <retention> and whose belonging Terraform state does no longer exist.
+func (b *Botanist) deleteSupersededTerraformerSecrets(retention time.Duration) error {
+	secretList, err := b.K8sSeedClient.ListSecrets(b.Shoot.SeedNamespace, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for _, secret := range secretList.Items {
+		if !strings.HasSuffix(secret.Name, common.TerraformerVariablesSuffix) || !olderThan(secret.ObjectMeta, retention) {
+			continue
+		}
+		prefix := strings.TrimSuffix(secret.Name, common.TerraformerVariablesSuffix)
+		if _, err := b.K8sSeedClient.GetConfigMap(secret.Namespace, prefix+common.TerraformerStateSuffix); !apierrors.IsNotFound(err) {
 			if err != nil {
 				return err
 			}
+			continue
 		}
-		return nil
-	})
+		b.Logger.Debugf("Deleting Terraformer secret %s as the belonging Terraform state does no longer exist.", secret.Name)
+		if err := b.K8sSeedClient.DeleteSecret(secret.Namespace, secret.Name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
 }
 
 // PerformGarbageCollectionShoot performs garbage collection in the kube-system namespace in the Shoot
@@ -84,3 +273,18 @@ func (b *Botanist) PerformGarbageCollectionShoot() error {
 	}
 	return nil
 }
+
+// jobCompleted returns true if the given <job> has either been completed successfully or failed.
+func jobCompleted(job batchv1.Job) bool {
+	for _, condition := range job.Status.Conditions {
+		if (condition.Type == batchv1.JobComplete || condition.Type == batchv1.JobFailed) && condition.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// olderThan returns true if the object with the given <meta> has been created before more than <retention>.
+func olderThan(meta metav1.ObjectMeta, retention time.Duration) bool {
+	return time.Since(meta.CreationTimestamp.Time) > retention
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1beta2 "k8s.io/api/apps/v1beta2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("garbage collection", func() {
+	Describe("#orphanedPersistentVolumeClaims", func() {
+		var (
+			retention = 24 * time.Hour
+			start     = time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+
+			statefulSet = func(name, template string, labels map[string]string) appsv1beta2.StatefulSet {
+				return appsv1beta2.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec: appsv1beta2.StatefulSetSpec{
+						Selector:             &metav1.LabelSelector{MatchLabels: labels},
+						VolumeClaimTemplates: []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: template}}},
+					},
+				}
+			}
+			claim = func(name string, labels map[string]string) corev1.PersistentVolumeClaim {
+				return corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+			}
+			names = func(pvcs []*corev1.PersistentVolumeClaim) []string {
+				out := []string{}
+				for _, pvc := range pvcs {
+					out = append(out, pvc.Name)
+				}
+				return out
+			}
+			// apply applies the computed updates to the given <pvcs> and removes the deleted ones, like the garbage
+			// collection does in the Seed cluster.
+			apply = func(pvcs []corev1.PersistentVolumeClaim, toUpdate, toDelete []*corev1.PersistentVolumeClaim) []corev1.PersistentVolumeClaim {
+				updated := map[string]*corev1.PersistentVolumeClaim{}
+				for _, pvc := range toUpdate {
+					updated[pvc.Name] = pvc
+				}
+				deleted := map[string]bool{}
+				for _, pvc := range toDelete {
+					deleted[pvc.Name] = true
+				}
+				out := []corev1.PersistentVolumeClaim{}
+				for _, pvc := range pvcs {
+					if deleted[pvc.Name] {
+						continue
+					}
+					if u, ok := updated[pvc.Name]; ok {
+						pvc = *u
+					}
+					out = append(out, pvc)
+				}
+				return out
+			}
+
+			etcdLabels = map[string]string{"app": "etcd-statefulset", "role": "main"}
+		)
+
+		It("should not touch claims of existing StatefulSets", func() {
+			statefulSets := []appsv1beta2.StatefulSet{statefulSet("etcd-main", "data", etcdLabels)}
+			pvcs := []corev1.PersistentVolumeClaim{claim("data-etcd-main-0", etcdLabels)}
+
+			toUpdate, toDelete := ExportOrphanedPersistentVolumeClaims(statefulSets, nil, pvcs, start, retention)
+
+			Expect(toUpdate).To(BeEmpty())
+			Expect(toDelete).To(BeEmpty())
+		})
+
+		It("should not touch claims which are still used by a pod", func() {
+			pvcs := []corev1.PersistentVolumeClaim{claim("data-etcd-main-0", etcdLabels)}
+			pods := []corev1.Pod{{
+				Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+					VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "data-etcd-main-0"}},
+				}}},
+			}}
+
+			toUpdate, toDelete := ExportOrphanedPersistentVolumeClaims(nil, pods, pvcs, start, retention)
+
+			Expect(toUpdate).To(BeEmpty())
+			Expect(toDelete).To(BeEmpty())
+		})
+
+		It("should ignore claims which have not been created from a volume claim template", func() {
+			pvcs := []corev1.PersistentVolumeClaim{claim("prometheus-db", nil)}
+
+			toUpdate, toDelete := ExportOrphanedPersistentVolumeClaims(nil, nil, pvcs, start.Add(48*time.Hour), retention)
+
+			Expect(toUpdate).To(BeEmpty())
+			Expect(toDelete).To(BeEmpty())
+		})
+
+		It("should match claims by the labels of the StatefulSet and not only by their name", func() {
+			// The name 'data-etcd-main-0' fits both StatefulSet 'etcd-main' with template 'data' and StatefulSet 'main' with template 'data-etcd'.
+			statefulSets := []appsv1beta2.StatefulSet{statefulSet("main", "data-etcd", map[string]string{"app": "other"})}
+			pvcs := []corev1.PersistentVolumeClaim{claim("data-etcd-main-0", etcdLabels)}
+
+			toUpdate, _ := ExportOrphanedPersistentVolumeClaims(statefulSets, nil, pvcs, start, retention)
+
+			Expect(names(toUpdate)).To(Equal([]string{"data-etcd-main-0"}))
+			Expect(toUpdate[0].Annotations).To(HaveKeyWithValue(common.PersistentVolumeClaimOrphanedSince, start.Format(time.RFC3339)))
+		})
+
+		It("should not treat claims of other StatefulSets with a common name prefix as owned", func() {
+			statefulSets := []appsv1beta2.StatefulSet{statefulSet("etcd", "data", etcdLabels)}
+			pvcs := []corev1.PersistentVolumeClaim{claim("data-etcd-main-0", etcdLabels)}
+
+			toUpdate, _ := ExportOrphanedPersistentVolumeClaims(statefulSets, nil, pvcs, start, retention)
+
+			Expect(names(toUpdate)).To(Equal([]string{"data-etcd-main-0"}))
+		})
+
+		It("should mark an invalid orphaned-since annotation again instead of deleting the claim", func() {
+			pvc := claim("data-etcd-main-0", etcdLabels)
+			pvc.Annotations = map[string]string{common.PersistentVolumeClaimOrphanedSince: "yesterday"}
+
+			toUpdate, toDelete := ExportOrphanedPersistentVolumeClaims(nil, nil, []corev1.PersistentVolumeClaim{pvc}, start, retention)
+
+			Expect(toDelete).To(BeEmpty())
+			Expect(names(toUpdate)).To(Equal([]string{"data-etcd-main-0"}))
+			Expect(toUpdate[0].Annotations).To(HaveKeyWithValue(common.PersistentVolumeClaimOrphanedSince, start.Format(time.RFC3339)))
+		})
+
+		It("should measure the retention from the time the StatefulSet has been deleted, also if it is recreated", func() {
+			var (
+				// The claim has been created long before its StatefulSet is deleted.
+				pvcs         = []corev1.PersistentVolumeClaim{claim("data-etcd-main-0", etcdLabels)}
+				statefulSets = []appsv1beta2.StatefulSet{statefulSet("etcd-main", "data", etcdLabels)}
+				now          = start
+			)
+
+			// The StatefulSet is deleted: the claim is marked as orphaned but kept.
+			toUpdate, toDelete := ExportOrphanedPersistentVolumeClaims(nil, nil, pvcs, now, retention)
+			Expect(names(toUpdate)).To(Equal([]string{"data-etcd-main-0"}))
+			Expect(toDelete).To(BeEmpty())
+			pvcs = apply(pvcs, toUpdate, toDelete)
+			Expect(pvcs[0].Annotations).To(HaveKeyWithValue(common.PersistentVolumeClaimOrphanedSince, now.Format(time.RFC3339)))
+
+			// Within the retention nothing happens.
+			now = start.Add(12 * time.Hour)
+			toUpdate, toDelete = ExportOrphanedPersistentVolumeClaims(nil, nil, pvcs, now, retention)
+			Expect(toUpdate).To(BeEmpty())
+			Expect(toDelete).To(BeEmpty())
+
+			// The StatefulSet is recreated: the mark is removed and the claim is kept even after the retention.
+			now = start.Add(36 * time.Hour)
+			toUpdate, toDelete = ExportOrphanedPersistentVolumeClaims(statefulSets, nil, pvcs, now, retention)
+			Expect(names(toUpdate)).To(Equal([]string{"data-etcd-main-0"}))
+			Expect(toDelete).To(BeEmpty())
+			pvcs = apply(pvcs, toUpdate, toDelete)
+			Expect(pvcs[0].Annotations).NotTo(HaveKey(common.PersistentVolumeClaimOrphanedSince))
+
+			// The StatefulSet is deleted again: the retention starts anew.
+			deletedAgain := start.Add(48 * time.Hour)
+			toUpdate, toDelete = ExportOrphanedPersistentVolumeClaims(nil, nil, pvcs, deletedAgain, retention)
+			Expect(names(toUpdate)).To(Equal([]string{"data-etcd-main-0"}))
+			Expect(toDelete).To(BeEmpty())
+			pvcs = apply(pvcs, toUpdate, toDelete)
+			Expect(pvcs[0].Annotations).To(HaveKeyWithValue(common.PersistentVolumeClaimOrphanedSince, deletedAgain.Format(time.RFC3339)))
+
+			toUpdate, toDelete = ExportOrphanedPersistentVolumeClaims(nil, nil, pvcs, deletedAgain.Add(retention), retention)
+			Expect(toUpdate).To(BeEmpty())
+			Expect(toDelete).To(BeEmpty())
+
+			// Only once it has been orphaned for more than the retention, the claim is deleted.
+			toUpdate, toDelete = ExportOrphanedPersistentVolumeClaims(nil, nil, pvcs, deletedAgain.Add(retention+time.Minute), retention)
+			Expect(toUpdate).To(BeEmpty())
+			Expect(names(toDelete)).To(Equal([]string{"data-etcd-main-0"}))
+			Expect(apply(pvcs, toUpdate, toDelete)).To(BeEmpty())
+		})
+	})
+})
@@ -19,10 +19,11 @@ import (
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // CheckConditionControlPlaneHealthy checks whether the control plane of the Shoot cluster is healthy,
@@ -66,34 +67,30 @@ func (b *Botanist) CheckConditionEveryNodeReady(condition *gardenv1beta1.Conditi
 	if err != nil {
 		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchNodeListFailed", err.Error())
 	}
+	externalWorkerNames := b.Shoot.GetExternalWorkerNames()
 	for _, node := range nodeList.Items {
 		if !node.Spec.Unschedulable {
 			for _, nodeCondition := range node.Status.Conditions {
 				if nodeCondition.Type == corev1.NodeReady && nodeCondition.Status != corev1.ConditionTrue {
+					// The machines of external worker groups are not managed by the Gardener, hence, their owners must repair them.
+					if group := node.Labels[common.WorkerGroupLabel]; utils.ValueExists(group, externalWorkerNames) {
+						return helper.ModifyCondition(condition, corev1.ConditionFalse, "NodeNotReady", fmt.Sprintf("Node %s of external worker group %s is not ready.", node.Name, group))
+					}
 					return helper.ModifyCondition(condition, corev1.ConditionFalse, "NodeNotReady", fmt.Sprintf("Node %s is not ready.", node.Name))
 				}
 			}
 		}
 	}
 
-	var machineList unstructured.Unstructured
-	if err := b.K8sSeedClient.MachineV1alpha1("GET", "machines", b.Shoot.SeedNamespace).Do().Into(&machineList); err != nil {
+	machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
 		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchMachineListFailed", err.Error())
 	}
-	if err := machineList.EachListItem(func(o runtime.Object) error {
-		var (
-			obj                                = o.(*unstructured.Unstructured)
-			machineName                        = obj.GetName()
-			machinePhase, machinePhaseFound, _ = unstructured.NestedString(obj.UnstructuredContent(), "status", "currentStatus", "phase")
-			lastOpDescription, _, _            = unstructured.NestedString(obj.UnstructuredContent(), "status", "lastOperation", "description")
-		)
-
-		if !machinePhaseFound || machinePhase != "Running" {
-			return fmt.Errorf("Machine %s is not running (phase: %s, description: %s)", machineName, machinePhase, lastOpDescription)
+	for _, machine := range machineList.Items {
+		if machine.Status.CurrentStatus.Phase != machinev1alpha1.MachineRunning {
+			message := fmt.Sprintf("Machine %s is not running (phase: %s, description: %s)", machine.Name, machine.Status.CurrentStatus.Phase, machine.Status.LastOperation.Description)
+			return helper.ModifyCondition(condition, corev1.ConditionFalse, "MachineUnhealthy", message)
 		}
-		return nil
-	}); err != nil {
-		return helper.ModifyCondition(condition, corev1.ConditionFalse, "MachineUnhealthy", err.Error())
 	}
 
 	return helper.ModifyCondition(condition, corev1.ConditionTrue, "EveryNodeReady", "Every node registered to the cluster is ready.")
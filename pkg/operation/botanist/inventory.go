@@ -0,0 +1,123 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComputeShootInventory lists the cloud resources which the Gardener considers to be owned by the Shoot: the
+// infrastructure resources of the given <cloudStatus>, the virtual machines of the machines in the Shoot namespace of
+// the Seed, and the disks of the persistent volumes and the load balancers of the services in the Shoot (which the
+// Gardener deletes together with the Shoot).
+func (b *Botanist) ComputeShootInventory(cloudStatus *gardenv1beta1.ShootCloudStatus) (*gardenv1beta1.ShootInventory, error) {
+	var machines []machinev1alpha1.Machine
+	if b.Shoot.Info.Spec.Cloud.Local == nil {
+		machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		machines = machineList.Items
+	}
+
+	volumeList, err := b.K8sShootClient.Clientset().CoreV1().PersistentVolumes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	serviceList, err := b.K8sShootClient.Clientset().CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gardenv1beta1.ShootInventory{
+		Resources:      inventoryResources(cloudStatus, machines, volumeList.Items, serviceList.Items),
+		LastUpdateTime: metav1.Now(),
+	}, nil
+}
+
+// inventoryResources returns the cloud resources of the given <cloudStatus>, <machines>, <volumes> and <services>.
+// Machines whose virtual machine has not been created yet, volumes which are not backed by a disk of a cloud provider
+// and services without a provisioned load balancer are omitted.
+func inventoryResources(cloudStatus *gardenv1beta1.ShootCloudStatus, machines []machinev1alpha1.Machine, volumes []corev1.PersistentVolume, services []corev1.Service) []gardenv1beta1.ShootInventoryResource {
+	var resources []gardenv1beta1.ShootInventoryResource
+	add := func(kind gardenv1beta1.InventoryResourceKind, id, name string, source gardenv1beta1.InventoryResourceSource) {
+		if len(id) > 0 {
+			resources = append(resources, gardenv1beta1.ShootInventoryResource{Kind: kind, ID: id, Name: name, Source: source})
+		}
+	}
+
+	if cloudStatus != nil {
+		add(gardenv1beta1.InventoryResourceNetwork, cloudStatus.Network, "", gardenv1beta1.InventoryResourceSourceInfrastructure)
+		for _, subnet := range cloudStatus.Subnets {
+			add(gardenv1beta1.InventoryResourceSubnet, subnet, "", gardenv1beta1.InventoryResourceSourceInfrastructure)
+		}
+		for _, securityGroup := range cloudStatus.SecurityGroups {
+			add(gardenv1beta1.InventoryResourceSecurityGroup, securityGroup, "", gardenv1beta1.InventoryResourceSourceInfrastructure)
+		}
+		for _, ip := range cloudStatus.NATIPs {
+			add(gardenv1beta1.InventoryResourceIPAddress, ip, "", gardenv1beta1.InventoryResourceSourceInfrastructure)
+		}
+		for _, role := range cloudStatus.IAMRoles {
+			add(gardenv1beta1.InventoryResourceIAMRole, role, "", gardenv1beta1.InventoryResourceSourceInfrastructure)
+		}
+	}
+
+	for _, machine := range machines {
+		add(gardenv1beta1.InventoryResourceVirtualMachine, machine.Spec.ProviderID, machine.Name, gardenv1beta1.InventoryResourceSourceMachine)
+	}
+
+	for _, volume := range volumes {
+		add(gardenv1beta1.InventoryResourceDisk, volumeDiskID(volume.Spec.PersistentVolumeSource), volume.Name, gardenv1beta1.InventoryResourceSourcePersistentVolume)
+	}
+
+	for _, service := range services {
+		if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+			continue
+		}
+		name := fmt.Sprintf("%s/%s", service.Namespace, service.Name)
+		for _, ingress := range service.Status.LoadBalancer.Ingress {
+			id := ingress.Hostname
+			if len(id) == 0 {
+				id = ingress.IP
+			}
+			add(gardenv1beta1.InventoryResourceLoadBalancer, id, name, gardenv1beta1.InventoryResourceSourceService)
+		}
+	}
+
+	return resources
+}
+
+// volumeDiskID returns the identifier of the disk of the cloud provider which backs the given persistent volume
+// <source>, or an empty string if it is not backed by such a disk.
+func volumeDiskID(source corev1.PersistentVolumeSource) string {
+	switch {
+	case source.AWSElasticBlockStore != nil:
+		return source.AWSElasticBlockStore.VolumeID
+	case source.AzureDisk != nil:
+		return source.AzureDisk.DataDiskURI
+	case source.GCEPersistentDisk != nil:
+		return source.GCEPersistentDisk.PDName
+	case source.Cinder != nil:
+		return source.Cinder.VolumeID
+	case source.CSI != nil:
+		return source.CSI.VolumeHandle
+	}
+	return ""
+}
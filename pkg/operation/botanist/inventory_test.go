@@ -0,0 +1,97 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("inventory", func() {
+	Describe("#inventoryResources", func() {
+		It("should list the infrastructure resources, virtual machines, disks and load balancers", func() {
+			var (
+				cloudStatus = &gardenv1beta1.ShootCloudStatus{
+					Network:        "vpc-1",
+					Subnets:        []string{"subnet-1", "subnet-2"},
+					SecurityGroups: []string{"sg-1"},
+					NATIPs:         []string{"1.2.3.4"},
+					IAMRoles:       []string{"arn:aws:iam::123:role/nodes"},
+				}
+				machines = []machinev1alpha1.Machine{
+					{ObjectMeta: metav1.ObjectMeta{Name: "machine-1"}, Spec: machinev1alpha1.MachineSpec{ProviderID: "aws:///eu-west-1a/i-1"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "machine-2"}},
+				}
+				volumes = []corev1.PersistentVolume{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+						Spec: corev1.PersistentVolumeSpec{PersistentVolumeSource: corev1.PersistentVolumeSource{
+							AWSElasticBlockStore: &corev1.AWSElasticBlockStoreVolumeSource{VolumeID: "vol-1"},
+						}},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "pv-2"},
+						Spec: corev1.PersistentVolumeSpec{PersistentVolumeSource: corev1.PersistentVolumeSource{
+							HostPath: &corev1.HostPathVolumeSource{Path: "/data"},
+						}},
+					},
+				}
+				services = []corev1.Service{
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+						Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+						Status: corev1.ServiceStatus{LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{
+							{Hostname: "web.elb.amazonaws.com"},
+						}}},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pending"},
+						Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "internal"},
+						Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeClusterIP},
+					},
+				}
+				resource = func(kind gardenv1beta1.InventoryResourceKind, id, name string, source gardenv1beta1.InventoryResourceSource) gardenv1beta1.ShootInventoryResource {
+					return gardenv1beta1.ShootInventoryResource{Kind: kind, ID: id, Name: name, Source: source}
+				}
+			)
+
+			Expect(ExportInventoryResources(cloudStatus, machines, volumes, services)).To(Equal([]gardenv1beta1.ShootInventoryResource{
+				resource(gardenv1beta1.InventoryResourceNetwork, "vpc-1", "", gardenv1beta1.InventoryResourceSourceInfrastructure),
+				resource(gardenv1beta1.InventoryResourceSubnet, "subnet-1", "", gardenv1beta1.InventoryResourceSourceInfrastructure),
+				resource(gardenv1beta1.InventoryResourceSubnet, "subnet-2", "", gardenv1beta1.InventoryResourceSourceInfrastructure),
+				resource(gardenv1beta1.InventoryResourceSecurityGroup, "sg-1", "", gardenv1beta1.InventoryResourceSourceInfrastructure),
+				resource(gardenv1beta1.InventoryResourceIPAddress, "1.2.3.4", "", gardenv1beta1.InventoryResourceSourceInfrastructure),
+				resource(gardenv1beta1.InventoryResourceIAMRole, "arn:aws:iam::123:role/nodes", "", gardenv1beta1.InventoryResourceSourceInfrastructure),
+				resource(gardenv1beta1.InventoryResourceVirtualMachine, "aws:///eu-west-1a/i-1", "machine-1", gardenv1beta1.InventoryResourceSourceMachine),
+				resource(gardenv1beta1.InventoryResourceDisk, "vol-1", "pv-1", gardenv1beta1.InventoryResourceSourcePersistentVolume),
+				resource(gardenv1beta1.InventoryResourceLoadBalancer, "web.elb.amazonaws.com", "default/web", gardenv1beta1.InventoryResourceSourceService),
+			}))
+		})
+
+		It("should not fail without an infrastructure status", func() {
+			Expect(ExportInventoryResources(nil, nil, nil, nil)).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,190 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// defaultMachineHealthTimeout is the duration after which the machine-controller-manager replaces a machine whose
+// node is not ready if no worker group configures a different timeout.
+const defaultMachineHealthTimeout = 10 * time.Minute
+
+// machineHealthTimeoutMinutes returns the machine health timeout (in minutes) for the machine-controller-manager.
+// As one machine-controller-manager manages all worker groups of a Shoot, the shortest timeout configured for any
+// worker group applies to all of them.
+func (b *Botanist) machineHealthTimeoutMinutes() int {
+	var timeout time.Duration
+
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.MachineHealthTimeout != nil && (timeout == 0 || worker.MachineHealthTimeout.Duration < timeout) {
+			timeout = worker.MachineHealthTimeout.Duration
+		}
+	}
+	if timeout == 0 {
+		timeout = defaultMachineHealthTimeout
+	}
+
+	return int(math.Ceil(timeout.Minutes()))
+}
+
+// machineAutoRepairBudgetExceeded checks whether any worker group has more unhealthy nodes than its <maxUnhealthy>
+// setting allows. In this case it returns a message describing the affected worker group.
+func (b *Botanist) machineAutoRepairBudgetExceeded() (string, bool, error) {
+	if b.K8sShootClient == nil {
+		return "", false, nil
+	}
+
+	var budgets = map[string]*intstr.IntOrString{}
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.MaxUnhealthy != nil {
+			budgets[worker.Name] = worker.MaxUnhealthy
+		}
+	}
+	if len(budgets) == 0 {
+		return "", false, nil
+	}
+
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return "", false, err
+	}
+
+	var (
+		total     = map[string]int{}
+		unhealthy = map[string]int{}
+	)
+	for _, node := range nodeList.Items {
+		group := node.Labels[common.WorkerGroupLabel]
+		if _, ok := budgets[group]; !ok {
+			continue
+		}
+		total[group]++
+		if !nodeReady(node) {
+			unhealthy[group]++
+		}
+	}
+
+	for group, budget := range budgets {
+		allowed, err := intstr.GetValueFromIntOrPercent(budget, total[group], false)
+		if err != nil {
+			return "", false, err
+		}
+		if unhealthy[group] > allowed {
+			return fmt.Sprintf("%d of %d nodes of worker group %s are unhealthy (max unhealthy: %s)", unhealthy[group], total[group], group, budget.String()), true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// machineControllerManagerReplicas returns the number of replicas the machine-controller-manager should run with.
+// It pauses the automatic replacement of machines (by scaling the machine-controller-manager down) as long as the
+// auto repair budget of any worker group is exceeded in order to prevent replacement storms caused by systemic issues.
+func (b *Botanist) machineControllerManagerReplicas() (int, error) {
+	message, exceeded, err := b.machineAutoRepairBudgetExceeded()
+	if err != nil {
+		return 0, err
+	}
+	if exceeded {
+		b.Logger.Warnf("Pausing the automatic replacement of machines: %s", message)
+		return 0, nil
+	}
+	return 1, nil
+}
+
+// EnsureMachineAutoRepairBudget scales the machine-controller-manager deployment in the Seed cluster according to
+// the auto repair budgets of the Shoot's worker groups.
+func (b *Botanist) EnsureMachineAutoRepairBudget() error {
+	deployment, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, common.MachineControllerManagerDeploymentName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	replicas, err := b.machineControllerManagerReplicas()
+	if err != nil {
+		return err
+	}
+	if deployment.Spec.Replicas != nil && int(*deployment.Spec.Replicas) == replicas {
+		return nil
+	}
+
+	body := fmt.Sprintf(`[{"op": "replace", "path": "/spec/replicas", "value": %d}]`, replicas)
+	_, err = b.K8sSeedClient.PatchDeployment(b.Shoot.SeedNamespace, common.MachineControllerManagerDeploymentName, []byte(body))
+	return err
+}
+
+// ReplaceTerminatingMachines deletes the machines of all Shoot nodes which have been marked as terminating by the
+// node termination handler (e.g., because their preemptible VM is about to be stopped by the cloud provider). This
+// way, the machine-controller-manager starts creating replacements before the VMs disappear instead of waiting for
+// the machine health timeout. It returns the names of the replaced machines.
+func (b *Botanist) ReplaceTerminatingMachines() ([]string, error) {
+	if len(b.Shoot.GetPreemptibleWorkerNames()) == 0 {
+		return nil, nil
+	}
+
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=true", common.NodeTerminatingLabel)})
+	if err != nil {
+		return nil, err
+	}
+	if len(nodeList.Items) == 0 {
+		return nil, nil
+	}
+
+	terminatingNodes := sets.NewString()
+	for _, node := range nodeList.Items {
+		terminatingNodes.Insert(node.Name)
+	}
+
+	machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var replaced []string
+	for _, machine := range machineList.Items {
+		if machine.DeletionTimestamp != nil || !terminatingNodes.Has(machine.Status.Node) {
+			continue
+		}
+
+		b.Logger.Infof("Replacing machine %s because its node %s is terminating", machine.Name, machine.Status.Node)
+		if err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).Delete(machine.Name, nil); err != nil && !apierrors.IsNotFound(err) {
+			return replaced, err
+		}
+		replaced = append(replaced, machine.Name)
+	}
+	return replaced, nil
+}
+
+func nodeReady(node corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
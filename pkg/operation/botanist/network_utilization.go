@@ -0,0 +1,144 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// podCIDRMaskSize is the size of the mask of the pod ranges which the kube-controller-manager allocates for the nodes
+// from the pod network (the default of its --node-cidr-mask-size flag).
+const podCIDRMaskSize = 24
+
+// networkUtilization describes how much of the capacity of a network of the Shoot is used.
+type networkUtilization struct {
+	Name     string
+	CIDR     gardenv1beta1.CIDR
+	Unit     string
+	Used     int
+	Capacity int
+}
+
+// Percentage returns the used capacity of the network in percent.
+func (u networkUtilization) Percentage() int {
+	if u.Capacity == 0 {
+		return 100
+	}
+	return u.Used * 100 / u.Capacity
+}
+
+func (u networkUtilization) String() string {
+	return fmt.Sprintf("%s network %s: %d of %d %s used (%d%%)", u.Name, u.CIDR, u.Used, u.Capacity, u.Unit, u.Percentage())
+}
+
+// CheckConditionNetworkCapacitySufficient checks whether the utilization of the pod, service and node networks of the
+// Shoot is below the given <threshold> (in percent). Every node takes a pod range of the pod network and an address
+// of the node network, and every service with a cluster IP takes an address of the service network.
+func (b *Botanist) CheckConditionNetworkCapacitySufficient(condition *gardenv1beta1.Condition, threshold int) *gardenv1beta1.Condition {
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchNodeListFailed", err.Error())
+	}
+	serviceList, err := b.K8sShootClient.Clientset().CoreV1().Services(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchServiceListFailed", err.Error())
+	}
+
+	services := 0
+	for _, service := range serviceList.Items {
+		if len(service.Spec.ClusterIP) > 0 && service.Spec.ClusterIP != corev1.ClusterIPNone {
+			services++
+		}
+	}
+
+	utilizations, err := computeNetworkUtilizations(b.Shoot.GetPodNetwork(), b.Shoot.GetServiceNetwork(), b.Shoot.GetNodeNetwork(), len(nodeList.Items), services)
+	if err != nil {
+		return helper.ModifyCondition(condition, corev1.ConditionUnknown, gardenv1beta1.ConditionCheckError, err.Error())
+	}
+
+	var exceeded, all []string
+	for _, utilization := range utilizations {
+		if utilization.Percentage() >= threshold {
+			exceeded = append(exceeded, utilization.String())
+		}
+		all = append(all, utilization.String())
+	}
+	if len(exceeded) > 0 {
+		return helper.ModifyCondition(condition, corev1.ConditionFalse, "NetworkCapacityLow", fmt.Sprintf("The utilization of the following networks is at or above %d%%: %s.", threshold, strings.Join(exceeded, "; ")))
+	}
+
+	return helper.ModifyCondition(condition, corev1.ConditionTrue, "NetworkCapacitySufficient", fmt.Sprintf("All networks have enough free capacity: %s.", strings.Join(all, "; ")))
+}
+
+// computeNetworkUtilizations computes the utilization of the given <podNetwork>, <serviceNetwork> and <nodeNetwork>
+// for a Shoot with the given number of <nodes> and <services> (with cluster IPs).
+func computeNetworkUtilizations(podNetwork, serviceNetwork, nodeNetwork gardenv1beta1.CIDR, nodes, services int) ([]networkUtilization, error) {
+	podMaskSize, err := cidrMaskSize(podNetwork)
+	if err != nil {
+		return nil, err
+	}
+	serviceMaskSize, err := cidrMaskSize(serviceNetwork)
+	if err != nil {
+		return nil, err
+	}
+	nodeMaskSize, err := cidrMaskSize(nodeNetwork)
+	if err != nil {
+		return nil, err
+	}
+
+	return []networkUtilization{
+		{Name: "pod", CIDR: podNetwork, Unit: "node ranges", Used: nodes, Capacity: subnetCount(podMaskSize, podCIDRMaskSize)},
+		{Name: "service", CIDR: serviceNetwork, Unit: "addresses", Used: services, Capacity: usableAddresses(serviceMaskSize)},
+		{Name: "node", CIDR: nodeNetwork, Unit: "addresses", Used: nodes, Capacity: usableAddresses(nodeMaskSize)},
+	}, nil
+}
+
+// cidrMaskSize returns the size of the mask of the given IPv4 <cidr>.
+func cidrMaskSize(cidr gardenv1beta1.CIDR) (int, error) {
+	_, network, err := net.ParseCIDR(string(cidr))
+	if err != nil {
+		return 0, err
+	}
+	ones, bits := network.Mask.Size()
+	if bits != 32 {
+		return 0, fmt.Errorf("network %s is not an IPv4 network", cidr)
+	}
+	return ones, nil
+}
+
+// subnetCount returns the number of subnets with the mask size <subnetMaskSize> which fit into a network with the mask
+// size <maskSize>.
+func subnetCount(maskSize, subnetMaskSize int) int {
+	if maskSize > subnetMaskSize {
+		return 0
+	}
+	return 1 << uint(subnetMaskSize-maskSize)
+}
+
+// usableAddresses returns the number of addresses of a network with the mask size <maskSize> without its network and
+// broadcast addresses.
+func usableAddresses(maskSize int) int {
+	if maskSize >= 31 {
+		return 0
+	}
+	return 1<<uint(32-maskSize) - 2
+}
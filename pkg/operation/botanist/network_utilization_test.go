@@ -0,0 +1,59 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("network utilization", func() {
+	Describe("#computeNetworkUtilizations", func() {
+		It("should compute the utilization of the pod, service and node networks", func() {
+			utilizations, err := ExportComputeNetworkUtilizations("100.96.0.0/20", "100.64.0.0/24", "10.250.0.0/26", 12, 127)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(utilizations).To(HaveLen(3))
+
+			Expect(utilizations[0].Name).To(Equal("pod"))
+			Expect(utilizations[0].Capacity).To(Equal(16))
+			Expect(utilizations[0].Percentage()).To(Equal(75))
+
+			Expect(utilizations[1].Name).To(Equal("service"))
+			Expect(utilizations[1].Capacity).To(Equal(254))
+			Expect(utilizations[1].Percentage()).To(Equal(50))
+
+			Expect(utilizations[2].Name).To(Equal("node"))
+			Expect(utilizations[2].Capacity).To(Equal(62))
+			Expect(utilizations[2].Percentage()).To(Equal(19))
+			Expect(utilizations[2].String()).To(Equal("node network 10.250.0.0/26: 12 of 62 addresses used (19%)"))
+		})
+
+		It("should consider a pod network which is smaller than a node range as exhausted", func() {
+			utilizations, err := ExportComputeNetworkUtilizations("100.96.0.0/25", "100.64.0.0/24", "10.250.0.0/16", 0, 0)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(utilizations[0].Percentage()).To(Equal(100))
+		})
+
+		It("should fail for invalid networks", func() {
+			_, err := ExportComputeNetworkUtilizations("100.96.0.0/11", "invalid", "10.250.0.0/16", 0, 0)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,74 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// DeployNetworkPolicies deploys the baseline NetworkPolicies of the network-policies addon into all user namespaces
+// of the Shoot cluster, i.e. all namespaces except kube-system, kube-public and the excluded ones. Namespaces which
+// are created later get the policies with the next reconciliation. Policies in namespaces which do no longer need
+// them (or all policies if the addon is disabled) are deleted.
+func (b *Botanist) DeployNetworkPolicies() error {
+	namespaces := []string{}
+
+	if b.Shoot.NetworkPoliciesEnabled() {
+		excludedNamespaces := sets.NewString(metav1.NamespaceSystem, metav1.NamespacePublic)
+		excludedNamespaces.Insert(b.Shoot.Info.Spec.Addons.NetworkPolicies.ExcludedNamespaces...)
+
+		namespaceList, err := b.K8sShootClient.ListNamespaces(metav1.ListOptions{})
+		if err != nil {
+			return err
+		}
+		for _, namespace := range namespaceList.Items {
+			if namespace.DeletionTimestamp == nil && !excludedNamespaces.Has(namespace.Name) {
+				namespaces = append(namespaces, namespace.Name)
+			}
+		}
+
+		if len(namespaces) > 0 {
+			if err := b.ApplyChartShoot(filepath.Join(common.ChartPath, "shoot-network-policies"), "shoot-network-policies", metav1.NamespaceSystem, map[string]interface{}{"namespaces": namespaces}, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	var (
+		desiredNamespaces = sets.NewString(namespaces...)
+		labelSelector     = fmt.Sprintf("%s=%s", common.GardenRole, common.GardenRoleBaselineNetworkPolicy)
+	)
+
+	networkPolicyList, err := b.K8sShootClient.Clientset().NetworkingV1().NetworkPolicies(metav1.NamespaceAll).List(metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return err
+	}
+	for _, networkPolicy := range networkPolicyList.Items {
+		if desiredNamespaces.Has(networkPolicy.Namespace) {
+			continue
+		}
+		if err := b.K8sShootClient.Clientset().NetworkingV1().NetworkPolicies(networkPolicy.Namespace).Delete(networkPolicy.Name, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// ReconcileNodeMetadata applies the node labels, annotations and taints of every worker group to the existing nodes
+// of the worker group. New nodes already register with the labels and taints (the kubelet flags are part of the cloud
+// config), but the kubelets do not apply changes to nodes which have already been registered. Labels, annotations
+// and taints which have been removed from a worker group are removed from its nodes, but only if the Gardener has
+// applied them before. The applied keys are therefore recorded in annotations on the nodes. Nodes which are protected
+// from scale-downs (see common.ScaleDownProtectionAnnotation) additionally get the annotation which disables their
+// removal by the cluster-autoscaler for as long as the protection is set.
+func (b *Botanist) ReconcileNodeMetadata() error {
+	workers := map[string]gardenv1beta1.Worker{}
+	for _, worker := range append(b.Shoot.GetWorkers(), b.Shoot.GetExternalWorkers()...) {
+		workers[worker.Name] = worker
+	}
+
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{LabelSelector: common.WorkerGroupLabel})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodeList.Items {
+		worker, ok := workers[node.Labels[common.WorkerGroupLabel]]
+		if !ok {
+			continue
+		}
+
+		newNode, changed := computeNodeMetadata(node, worker)
+		if !changed {
+			continue
+		}
+		if _, err := b.K8sShootClient.Clientset().CoreV1().Nodes().Update(newNode); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("Updating the metadata of node %s failed: %s", node.Name, err.Error())
+		}
+		b.Logger.Infof("Applied the labels, annotations and taints of worker group %s to node %s", worker.Name, node.Name)
+	}
+
+	return nil
+}
+
+// computeNodeMetadata returns a copy of the given <node> which carries the node labels, annotations and taints of
+// the given <worker>. It also returns whether the copy differs from the original node.
+func computeNodeMetadata(node corev1.Node, worker gardenv1beta1.Worker) (*corev1.Node, bool) {
+	newNode := node.DeepCopy()
+	if newNode.Labels == nil {
+		newNode.Labels = map[string]string{}
+	}
+	if newNode.Annotations == nil {
+		newNode.Annotations = map[string]string{}
+	}
+
+	desiredAnnotations := worker.NodeAnnotations
+	if common.ScaleDownProtected(node.Annotations) || common.ScaleDownProtected(worker.NodeAnnotations) {
+		desiredAnnotations = map[string]string{common.ClusterAutoscalerScaleDownDisabledAnnotation: "true"}
+		for key, value := range worker.NodeAnnotations {
+			desiredAnnotations[key] = value
+		}
+	}
+
+	applyManagedMap(newNode.Labels, worker.NodeLabels, managedKeys(node.Annotations[common.NodeLabelsAnnotation]))
+	applyManagedMap(newNode.Annotations, desiredAnnotations, managedKeys(node.Annotations[common.NodeAnnotationsAnnotation]))
+
+	var (
+		desiredTaints   = map[string]corev1.Taint{}
+		appliedTaints   = sets.NewString()
+		previousTaints  = managedKeys(node.Annotations[common.NodeTaintsAnnotation])
+		taints          []corev1.Taint
+		managedTaintIDs []string
+	)
+	for _, taint := range worker.NodeTaints {
+		desiredTaints[taintID(taint)] = taint
+		managedTaintIDs = append(managedTaintIDs, taintID(taint))
+	}
+	for _, taint := range newNode.Spec.Taints {
+		id := taintID(taint)
+		if desiredTaint, ok := desiredTaints[id]; ok {
+			taint.Value = desiredTaint.Value
+			appliedTaints.Insert(id)
+		} else if previousTaints.Has(id) {
+			continue
+		}
+		taints = append(taints, taint)
+	}
+	for _, taint := range worker.NodeTaints {
+		if !appliedTaints.Has(taintID(taint)) {
+			taints = append(taints, corev1.Taint{Key: taint.Key, Value: taint.Value, Effect: taint.Effect})
+		}
+	}
+	newNode.Spec.Taints = taints
+
+	setManagedKeys(newNode.Annotations, common.NodeLabelsAnnotation, mapKeys(worker.NodeLabels))
+	setManagedKeys(newNode.Annotations, common.NodeAnnotationsAnnotation, mapKeys(desiredAnnotations))
+	setManagedKeys(newNode.Annotations, common.NodeTaintsAnnotation, managedTaintIDs)
+
+	return newNode, !apiequality.Semantic.DeepEqual(node, *newNode)
+}
+
+// applyManagedMap sets all <desired> entries in <current> and deletes the <previous> keys which are no longer desired.
+func applyManagedMap(current, desired map[string]string, previous sets.String) {
+	for key := range previous {
+		if _, ok := desired[key]; !ok {
+			delete(current, key)
+		}
+	}
+	for key, value := range desired {
+		current[key] = value
+	}
+}
+
+// managedKeys parses the comma-separated value of one of the annotations recording the applied keys.
+func managedKeys(value string) sets.String {
+	keys := sets.NewString()
+	for _, key := range strings.Split(value, ",") {
+		if len(key) > 0 {
+			keys.Insert(key)
+		}
+	}
+	return keys
+}
+
+// setManagedKeys records the given <keys> in the annotation with the given <name>. The annotation is removed if there
+// are no keys.
+func setManagedKeys(annotations map[string]string, name string, keys []string) {
+	if len(keys) == 0 {
+		delete(annotations, name)
+		return
+	}
+	sort.Strings(keys)
+	annotations[name] = strings.Join(keys, ",")
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// taintID returns the identity of a taint, i.e. its key and effect.
+func taintID(taint corev1.Taint) string {
+	return fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+}
+
+// criticalDaemonSets are the names of the DaemonSets in the kube-system namespace of the Shoot cluster which must be
+// ready on a node before workload may be scheduled onto it.
+var criticalDaemonSets = sets.NewString("calico-node", "kube-proxy")
+
+// RemoveCriticalComponentsTaints removes the taint with which the nodes of worker groups waiting for the critical
+// components register from all nodes on which the critical DaemonSets are ready.
+func (b *Botanist) RemoveCriticalComponentsTaints() error {
+	_, err := b.removeCriticalComponentsTaints()
+	return err
+}
+
+// removeCriticalComponentsTaints removes the critical components taint from all nodes on which the critical DaemonSets
+// are ready. It returns the number of ready nodes which still carry the taint.
+func (b *Botanist) removeCriticalComponentsTaints() (int, error) {
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+	podList, err := b.K8sShootClient.ListPods(metav1.NamespaceSystem, metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	pending := 0
+	for _, node := range nodeList.Items {
+		if !hasCriticalComponentsTaint(node) || !nodeReady(node) {
+			continue
+		}
+		if !criticalComponentsReady(node, podList.Items) {
+			pending++
+			continue
+		}
+
+		newNode := node.DeepCopy()
+		newNode.Spec.Taints = nil
+		for _, taint := range node.Spec.Taints {
+			if taint.Key != common.CriticalComponentsNotReadyTaint {
+				newNode.Spec.Taints = append(newNode.Spec.Taints, taint)
+			}
+		}
+		if _, err := b.K8sShootClient.Clientset().CoreV1().Nodes().Update(newNode); err != nil && !apierrors.IsNotFound(err) {
+			return 0, fmt.Errorf("Removing the critical components taint from node %s failed: %s", node.Name, err.Error())
+		}
+		b.Logger.Infof("Critical components are ready on node %s, removed taint %s", node.Name, common.CriticalComponentsNotReadyTaint)
+	}
+
+	return pending, nil
+}
+
+// hasCriticalComponentsTaint checks whether the given <node> carries the critical components taint.
+func hasCriticalComponentsTaint(node corev1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == common.CriticalComponentsNotReadyTaint {
+			return true
+		}
+	}
+	return false
+}
+
+// criticalComponentsReady checks whether the given <node> is ready and whether a ready pod of every critical DaemonSet
+// runs on it. Only the critical DaemonSets which have pods in the cluster are considered (e.g., calico-node is not
+// deployed if another CNI is used), but at least one of them must exist.
+func criticalComponentsReady(node corev1.Node, pods []corev1.Pod) bool {
+	if !nodeReady(node) {
+		return false
+	}
+
+	var (
+		deployed = sets.NewString()
+		ready    = sets.NewString()
+	)
+	for _, pod := range pods {
+		controllerRef := metav1.GetControllerOf(&pod)
+		if controllerRef == nil || controllerRef.Kind != "DaemonSet" || !criticalDaemonSets.Has(controllerRef.Name) {
+			continue
+		}
+		deployed.Insert(controllerRef.Name)
+		if pod.Spec.NodeName == node.Name && podReady(pod) {
+			ready.Insert(controllerRef.Name)
+		}
+	}
+
+	return deployed.Len() > 0 && ready.IsSuperset(deployed)
+}
+
+// podReady checks whether the Ready condition of the given <pod> is true.
+func podReady(pod corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("nodes", func() {
+	Describe("#computeNodeMetadata", func() {
+		var (
+			node   corev1.Node
+			worker gardenv1beta1.Worker
+		)
+
+		BeforeEach(func() {
+			node = corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "node",
+					Labels: map[string]string{
+						common.WorkerGroupLabel: "gpu",
+					},
+				},
+				Spec: corev1.NodeSpec{
+					Taints: []corev1.Taint{
+						{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+					},
+				},
+			}
+			worker = gardenv1beta1.Worker{
+				Name:            "gpu",
+				NodeLabels:      map[string]string{"accelerator": "v100"},
+				NodeAnnotations: map[string]string{"owner": "ml-team"},
+				NodeTaints: []corev1.Taint{
+					{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule},
+				},
+			}
+		})
+
+		It("should apply the labels, annotations and taints of the worker group", func() {
+			newNode, changed := ExportComputeNodeMetadata(node, worker)
+
+			Expect(changed).To(BeTrue())
+			Expect(newNode.Labels).To(Equal(map[string]string{
+				common.WorkerGroupLabel: "gpu",
+				"accelerator":           "v100",
+			}))
+			Expect(newNode.Annotations).To(Equal(map[string]string{
+				"owner":                          "ml-team",
+				common.NodeLabelsAnnotation:      "accelerator",
+				common.NodeAnnotationsAnnotation: "owner",
+				common.NodeTaintsAnnotation:      "nvidia.com/gpu:NoSchedule",
+			}))
+			Expect(newNode.Spec.Taints).To(Equal([]corev1.Taint{
+				{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+				{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule},
+			}))
+		})
+
+		It("should not change a node which already carries the metadata of the worker group", func() {
+			newNode, _ := ExportComputeNodeMetadata(node, worker)
+
+			_, changed := ExportComputeNodeMetadata(*newNode, worker)
+
+			Expect(changed).To(BeFalse())
+		})
+
+		It("should update the values of existing labels and taints", func() {
+			newNode, _ := ExportComputeNodeMetadata(node, worker)
+			worker.NodeLabels["accelerator"] = "p100"
+			worker.NodeTaints[0].Value = "dedicated"
+
+			newNode, changed := ExportComputeNodeMetadata(*newNode, worker)
+
+			Expect(changed).To(BeTrue())
+			Expect(newNode.Labels).To(HaveKeyWithValue("accelerator", "p100"))
+			Expect(newNode.Spec.Taints).To(ConsistOf(
+				corev1.Taint{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+				corev1.Taint{Key: "nvidia.com/gpu", Value: "dedicated", Effect: corev1.TaintEffectNoSchedule},
+			))
+		})
+
+		It("should only remove the metadata which has been applied by the Gardener", func() {
+			newNode, _ := ExportComputeNodeMetadata(node, worker)
+			newNode.Labels["team"] = "ml"
+			worker.NodeLabels = nil
+			worker.NodeAnnotations = nil
+			worker.NodeTaints = nil
+
+			newNode, changed := ExportComputeNodeMetadata(*newNode, worker)
+
+			Expect(changed).To(BeTrue())
+			Expect(newNode.Labels).To(Equal(map[string]string{
+				common.WorkerGroupLabel: "gpu",
+				"team":                  "ml",
+			}))
+			Expect(newNode.Annotations).To(BeEmpty())
+			Expect(newNode.Spec.Taints).To(Equal([]corev1.Taint{
+				{Key: "node.kubernetes.io/not-ready", Effect: corev1.TaintEffectNoExecute},
+			}))
+		})
+
+		It("should disable the scale-down of protected nodes as long as the protection is set", func() {
+			node.Annotations = map[string]string{common.ScaleDownProtectionAnnotation: "true"}
+
+			newNode, changed := ExportComputeNodeMetadata(node, worker)
+
+			Expect(changed).To(BeTrue())
+			Expect(newNode.Annotations).To(HaveKeyWithValue(common.ClusterAutoscalerScaleDownDisabledAnnotation, "true"))
+
+			delete(newNode.Annotations, common.ScaleDownProtectionAnnotation)
+			newNode, changed = ExportComputeNodeMetadata(*newNode, worker)
+
+			Expect(changed).To(BeTrue())
+			Expect(newNode.Annotations).NotTo(HaveKey(common.ClusterAutoscalerScaleDownDisabledAnnotation))
+			Expect(newNode.Annotations).To(HaveKeyWithValue("owner", "ml-team"))
+		})
+	})
+
+	Describe("#criticalComponentsReady", func() {
+		var (
+			node corev1.Node
+			pods []corev1.Pod
+		)
+
+		newDaemonSetPod := func(daemonSet, nodeName string, ready corev1.ConditionStatus) corev1.Pod {
+			controller := true
+			return corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: daemonSet, Controller: &controller}},
+				},
+				Spec: corev1.PodSpec{NodeName: nodeName},
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			node = corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node"},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+				},
+			}
+			pods = []corev1.Pod{
+				newDaemonSetPod("calico-node", "node", corev1.ConditionTrue),
+				newDaemonSetPod("kube-proxy", "node", corev1.ConditionTrue),
+				newDaemonSetPod("kube-proxy", "other-node", corev1.ConditionFalse),
+				newDaemonSetPod("node-exporter", "node", corev1.ConditionFalse),
+			}
+		})
+
+		It("should return true if all critical DaemonSets are ready on the node", func() {
+			Expect(ExportCriticalComponentsReady(node, pods)).To(BeTrue())
+		})
+
+		It("should return false if a critical DaemonSet is not ready on the node", func() {
+			pods[1].Status.Conditions[0].Status = corev1.ConditionFalse
+
+			Expect(ExportCriticalComponentsReady(node, pods)).To(BeFalse())
+		})
+
+		It("should return false if a critical DaemonSet has no pod on the node yet", func() {
+			pods[0].Spec.NodeName = "other-node"
+
+			Expect(ExportCriticalComponentsReady(node, pods)).To(BeFalse())
+		})
+
+		It("should ignore critical DaemonSets which are not deployed", func() {
+			Expect(ExportCriticalComponentsReady(node, pods[1:])).To(BeTrue())
+		})
+
+		It("should return false if no critical DaemonSet is deployed yet", func() {
+			Expect(ExportCriticalComponentsReady(node, pods[3:])).To(BeFalse())
+		})
+
+		It("should return false if the node is not ready", func() {
+			node.Status.Conditions[0].Status = corev1.ConditionFalse
+
+			Expect(ExportCriticalComponentsReady(node, pods)).To(BeFalse())
+		})
+	})
+})
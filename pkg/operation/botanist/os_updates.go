@@ -0,0 +1,88 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// CheckConditionOSUpdatesApplied checks whether all nodes of the worker groups with automated operating system updates
+// have been rebooted into the installed updates. The node reboot coordinator labels the nodes which wait for their
+// reboot (see common.NodeRebootRequiredLabel).
+func (b *Botanist) CheckConditionOSUpdatesApplied(condition *gardenv1beta1.Condition) *gardenv1beta1.Condition {
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return helper.ModifyCondition(condition, corev1.ConditionUnknown, "FetchNodeListFailed", err.Error())
+	}
+
+	if pending := nodesPendingReboot(nodeList.Items); len(pending) > 0 {
+		return helper.ModifyCondition(condition, corev1.ConditionFalse, "RebootsPending", fmt.Sprintf("The following nodes are rebooted into operating system updates within the next maintenance time window: %s.", strings.Join(pending, ", ")))
+	}
+	return helper.ModifyCondition(condition, corev1.ConditionTrue, "UpdatesApplied", "All nodes run the installed operating system updates.")
+}
+
+// ReleaseStaleNodeRebootLock releases the reboot lock of the node reboot coordinator if it is held by a node which does
+// not exist anymore (e.g., because its machine has been replaced while it was drained). The nodes release the lock
+// themselves once they have been rebooted, hence, a stale lock would block the reboots of all other nodes.
+func (b *Botanist) ReleaseStaleNodeRebootLock() error {
+	daemonSets := b.K8sShootClient.Clientset().AppsV1beta2().DaemonSets(metav1.NamespaceSystem)
+
+	daemonSet, err := daemonSets.Get(common.NodeRebootCoordinatorName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	holder, ok := daemonSet.Annotations[common.NodeRebootLockAnnotation]
+	if !ok {
+		return nil
+	}
+
+	if _, err := b.K8sShootClient.Clientset().CoreV1().Nodes().Get(holder, metav1.GetOptions{}); err == nil || !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// The resource version ensures that a lock which has been taken over by another node in the meantime is kept.
+	body := fmt.Sprintf(`{"metadata":{"resourceVersion":"%s","annotations":{"%s":null}}}`, daemonSet.ResourceVersion, common.NodeRebootLockAnnotation)
+	if _, err := daemonSets.Patch(daemonSet.Name, types.MergePatchType, []byte(body)); err != nil && !apierrors.IsConflict(err) {
+		return err
+	}
+	b.Logger.Infof("Released the reboot lock of node %s which does not exist anymore", holder)
+	return nil
+}
+
+// nodesPendingReboot returns the sorted names of the given <nodes> which wait for being rebooted into the installed
+// updates of their operating system.
+func nodesPendingReboot(nodes []corev1.Node) []string {
+	var names []string
+	for _, node := range nodes {
+		if node.Labels[common.NodeRebootRequiredLabel] == "true" {
+			names = append(names, node.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
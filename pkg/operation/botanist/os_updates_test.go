@@ -0,0 +1,48 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("os updates", func() {
+	Describe("#nodesPendingReboot", func() {
+		node := func(name string, labels map[string]string) corev1.Node {
+			return corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		}
+
+		It("should return the sorted names of the nodes waiting for their reboot", func() {
+			nodes := []corev1.Node{
+				node("node-c", map[string]string{common.NodeRebootRequiredLabel: "true"}),
+				node("node-b", nil),
+				node("node-a", map[string]string{common.NodeRebootRequiredLabel: "true"}),
+				node("node-d", map[string]string{common.NodeRebootRequiredLabel: "false"}),
+			}
+
+			Expect(ExportNodesPendingReboot(nodes)).To(Equal([]string{"node-a", "node-c"}))
+		})
+
+		It("should return nothing if all nodes run the installed updates", func() {
+			Expect(ExportNodesPendingReboot([]corev1.Node{node("node-a", nil)})).To(BeEmpty())
+		})
+	})
+})
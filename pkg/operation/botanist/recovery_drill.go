@@ -0,0 +1,178 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// recoveryDrillPollInterval is the interval in which the recovery of the Shoot is checked during a recovery drill.
+const recoveryDrillPollInterval = 10 * time.Second
+
+// DrillKubeAPIServerRecovery deletes a random kube-apiserver pod of the Shoot and waits until the kube-apiserver
+// deployment is available again without the deleted pod and the API server of the Shoot responds. It fails if the
+// Shoot has not recovered before the given <ctx> is done.
+func (b *Botanist) DrillKubeAPIServerRecovery(ctx context.Context) error {
+	podList, err := b.K8sSeedClient.ListPods(b.Shoot.SeedNamespace, metav1.ListOptions{
+		LabelSelector: "app=kubernetes,role=apiserver",
+	})
+	if err != nil {
+		return err
+	}
+	candidates := recoveryDrillPods(podList.Items)
+	if len(candidates) == 0 {
+		return errors.New("there is no running kube-apiserver pod which could be deleted")
+	}
+
+	victim := candidates[rand.Intn(len(candidates))]
+	b.Logger.Infof("[RECOVERY DRILL] Deleting kube-apiserver pod %s", victim)
+	if err := b.K8sSeedClient.DeletePod(b.Shoot.SeedNamespace, victim); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	var lastState string
+	if err := wait.PollUntil(recoveryDrillPollInterval, func() (bool, error) {
+		if _, err := b.K8sSeedClient.GetPod(b.Shoot.SeedNamespace, victim); err == nil {
+			lastState = fmt.Sprintf("pod %s has not been deleted yet", victim)
+			return false, nil
+		} else if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+
+		deployment, err := b.K8sSeedClient.GetDeployment(b.Shoot.SeedNamespace, common.KubeAPIServerDeploymentName)
+		if err != nil {
+			return false, err
+		}
+		if replicas := deployment.Spec.Replicas; replicas != nil && deployment.Status.AvailableReplicas < *replicas {
+			lastState = fmt.Sprintf("%d of %d kube-apiserver replicas are available", deployment.Status.AvailableReplicas, *replicas)
+			return false, nil
+		}
+
+		if _, err := b.K8sShootClient.Clientset().Discovery().ServerVersion(); err != nil {
+			lastState = fmt.Sprintf("the API server does not respond: %s", err.Error())
+			return false, nil
+		}
+		return true, nil
+	}, ctx.Done()); err != nil {
+		return recoveryDrillError(err, lastState)
+	}
+	return nil
+}
+
+// DrillMachineRecovery deletes a random running machine of the Shoot and waits until the machine-controller-manager
+// has deleted it and the Shoot has at least as many ready nodes as before. It fails if the Shoot has not recovered
+// before the given <ctx> is done.
+func (b *Botanist) DrillMachineRecovery(ctx context.Context) error {
+	machines := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace)
+
+	machineList, err := machines.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	candidates := recoveryDrillMachines(machineList.Items)
+	if len(candidates) == 0 {
+		return errors.New("there is no running machine which could be deleted")
+	}
+
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	readyNodesBefore := readyNodes(nodeList.Items)
+
+	victim := candidates[rand.Intn(len(candidates))]
+	b.Logger.Infof("[RECOVERY DRILL] Deleting machine %s", victim)
+	if err := machines.Delete(victim, nil); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	var lastState string
+	if err := wait.PollUntil(recoveryDrillPollInterval, func() (bool, error) {
+		if _, err := machines.Get(victim, metav1.GetOptions{}); err == nil {
+			lastState = fmt.Sprintf("machine %s has not been deleted yet", victim)
+			return false, nil
+		} else if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+
+		nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+		if err != nil {
+			return false, err
+		}
+		if ready := readyNodes(nodeList.Items); ready < readyNodesBefore {
+			lastState = fmt.Sprintf("%d of %d nodes are ready", ready, readyNodesBefore)
+			return false, nil
+		}
+		return true, nil
+	}, ctx.Done()); err != nil {
+		return recoveryDrillError(err, lastState)
+	}
+	return nil
+}
+
+// recoveryDrillError returns the error of a recovery drill whose wait for the recovery has failed with <err>. If the
+// wait has timed out, the error contains the <lastState> of the recovery.
+func recoveryDrillError(err error, lastState string) error {
+	if err == wait.ErrWaitTimeout && len(lastState) > 0 {
+		return fmt.Errorf("the Shoot has not recovered in time: %s", lastState)
+	}
+	return err
+}
+
+// recoveryDrillPods returns the names of the <pods> which may be deleted by a recovery drill, i.e. the running pods
+// which are not already being deleted.
+func recoveryDrillPods(pods []corev1.Pod) []string {
+	var names []string
+	for _, pod := range pods {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			names = append(names, pod.Name)
+		}
+	}
+	return names
+}
+
+// recoveryDrillMachines returns the names of the <machines> which may be deleted by a recovery drill, i.e. the
+// running machines which are not already being deleted.
+func recoveryDrillMachines(machines []machinev1alpha1.Machine) []string {
+	var names []string
+	for _, machine := range machines {
+		if machine.DeletionTimestamp == nil && machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineRunning {
+			names = append(names, machine.Name)
+		}
+	}
+	return names
+}
+
+// readyNodes returns the number of the <nodes> which are ready.
+func readyNodes(nodes []corev1.Node) int {
+	ready := 0
+	for _, node := range nodes {
+		if nodeReady(node) {
+			ready++
+		}
+	}
+	return ready
+}
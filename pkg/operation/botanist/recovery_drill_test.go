@@ -0,0 +1,65 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("recovery drills", func() {
+	deletionTimestamp := metav1.Now()
+
+	Describe("#recoveryDrillPods", func() {
+		It("should only return the running pods which are not being deleted", func() {
+			pods := []corev1.Pod{
+				{ObjectMeta: metav1.ObjectMeta{Name: "running"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "pending"}, Status: corev1.PodStatus{Phase: corev1.PodPending}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "deleting", DeletionTimestamp: &deletionTimestamp}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+			}
+
+			Expect(ExportRecoveryDrillPods(pods)).To(Equal([]string{"running"}))
+		})
+	})
+
+	Describe("#recoveryDrillMachines", func() {
+		machine := func(name string, phase machinev1alpha1.MachinePhase, deletionTimestamp *metav1.Time) machinev1alpha1.Machine {
+			return machinev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: name, DeletionTimestamp: deletionTimestamp},
+				Status:     machinev1alpha1.MachineStatus{CurrentStatus: machinev1alpha1.CurrentStatus{Phase: phase}},
+			}
+		}
+
+		It("should only return the running machines which are not being deleted", func() {
+			machines := []machinev1alpha1.Machine{
+				machine("running", machinev1alpha1.MachineRunning, nil),
+				machine("pending", machinev1alpha1.MachinePending, nil),
+				machine("deleting", machinev1alpha1.MachineRunning, &deletionTimestamp),
+			}
+
+			Expect(ExportRecoveryDrillMachines(machines)).To(Equal([]string{"running"}))
+		})
+
+		It("should return nothing if no machine is running", func() {
+			Expect(ExportRecoveryDrillMachines([]machinev1alpha1.Machine{machine("failed", machinev1alpha1.MachineFailed, nil)})).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,47 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/seed"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// NewPassiveReplicaSeedClient creates a Kubernetes client for the Seed cluster which hosts the passive replica of the
+// Shoot's control plane.
+func (b *Botanist) NewPassiveReplicaSeedClient() (kubernetes.Client, error) {
+	passiveSeed, err := seed.NewFromName(b.K8sGardenClient, b.K8sGardenInformers, b.Shoot.PassiveReplicaSeedName())
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewSeedClientFromSecretObject(passiveSeed.Info.Name, passiveSeed.Secret)
+}
+
+// DeletePassiveControlPlaneReplica deletes the namespace in the Seed cluster hosting the passive replica of the Shoot's
+// control plane. The built-in garbage collection in Kubernetes will automatically delete all resources which belong
+// to this namespace.
+func (b *Botanist) DeletePassiveControlPlaneReplica() error {
+	k8sPassiveSeedClient, err := b.NewPassiveReplicaSeedClient()
+	if err != nil {
+		return err
+	}
+
+	err = k8sPassiveSeedClient.DeleteNamespace(b.Shoot.SeedNamespace)
+	if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+		return nil
+	}
+	return err
+}
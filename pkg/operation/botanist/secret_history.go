@@ -0,0 +1,403 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	"github.com/gardener/gardener/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// secretHistoryDataKey is the key of the encrypted snapshot in the data of a secret holding a version of a bundle.
+const secretHistoryDataKey = "snapshot"
+
+// SecretVersions lists the retained versions of the bundles of generated secrets of a Shoot.
+type SecretVersions struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Bundles are the bundles of generated secrets of the Shoot.
+	Bundles []SecretBundle `json:"bundles"`
+}
+
+// SecretBundle is a bundle of generated secrets of a Shoot which are stored in the same namespace.
+type SecretBundle struct {
+	// Name is the name of the bundle (seed or project).
+	Name string `json:"name"`
+	// Namespace is the namespace in which the secrets of the bundle and its versions are stored.
+	Namespace string `json:"namespace"`
+	// Versions are the retained versions of the bundle, the most recent one first.
+	Versions []SecretVersion `json:"versions"`
+}
+
+// SecretVersion is a retained version of a bundle of generated secrets.
+type SecretVersion struct {
+	// Version is the number of the version.
+	Version int `json:"version"`
+	// CreationTimestamp is the time when the version has been recorded.
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// Secrets are the names of the secrets contained in the version.
+	Secrets []string `json:"secrets"`
+	// Current indicates whether the version is the one which is currently in use.
+	Current bool `json:"current"`
+}
+
+// secretSnapshot maps the names of the secrets of a bundle to their types and data.
+type secretSnapshot map[string]snapshotSecret
+
+type snapshotSecret struct {
+	Type corev1.SecretType `json:"type"`
+	Data map[string][]byte `json:"data"`
+}
+
+// secretHistory stores the versions of a bundle of generated secrets of a Shoot encrypted in secrets in the
+// namespace of the bundle.
+type secretHistory struct {
+	client    kubernetes.Client
+	namespace string
+	shootName string
+	bundle    string
+	key       []byte
+}
+
+// secretHistoryKey returns the key of the secret history encryption secret, or nil if no such secret exists.
+func (b *Botanist) secretHistoryKey() []byte {
+	if secret, ok := b.Secrets[common.GardenRoleSecretHistoryEncryption]; ok {
+		return secret.Data["key"]
+	}
+	return nil
+}
+
+func (b *Botanist) seedSecretHistory() *secretHistory {
+	return &secretHistory{b.K8sSeedClient, b.Shoot.SeedNamespace, b.Shoot.Info.Name, common.SecretBundleSeed, b.secretHistoryKey()}
+}
+
+func (b *Botanist) projectSecretHistory() *secretHistory {
+	return &secretHistory{b.K8sGardenClient, b.Shoot.Info.Namespace, b.Shoot.Info.Name, common.SecretBundleProject, b.secretHistoryKey()}
+}
+
+// recordSecretHistory records a new version of the secrets with the given names of the Seed bundle and of the
+// garden secrets of the project bundle if they have changed since the most recent version. Only the most recent
+// version and SecretHistoryLimit previous versions are retained. Nothing is recorded if the history is disabled
+// or no secret history encryption secret exists.
+func (b *Botanist) recordSecretHistory(seedSecretNames []string) error {
+	if b.SecretHistoryLimit <= 0 || b.secretHistoryKey() == nil {
+		return nil
+	}
+
+	seedSecrets := make(map[string]*corev1.Secret, len(seedSecretNames))
+	for _, name := range seedSecretNames {
+		seedSecrets[name] = b.Secrets[name]
+	}
+	if err := b.seedSecretHistory().record(seedSecrets, b.SecretHistoryLimit); err != nil {
+		return fmt.Errorf("could not record the version of the %s secrets: %v", common.SecretBundleSeed, err)
+	}
+
+	projectSecrets := make(map[string]*corev1.Secret, len(gardenSecrets))
+	for key, value := range gardenSecrets {
+		projectSecrets[generateGardenSecretName(b.Shoot.Info.Name, key)] = b.Secrets[value]
+	}
+	if err := b.projectSecretHistory().record(projectSecrets, b.SecretHistoryLimit); err != nil {
+		return fmt.Errorf("could not record the version of the %s secrets: %v", common.SecretBundleProject, err)
+	}
+	return nil
+}
+
+// rollbackSecrets restores the generated secrets in the Shoot namespace in the Seed (given as <existingSecrets>) to
+// the given <version> of the Seed bundle. Secrets which have been generated after this version are deleted, so that
+// they are generated again (and signed by the restored CA). It returns the secrets which exist after the rollback.
+func (b *Botanist) rollbackSecrets(version string, existingSecrets map[string]*corev1.Secret) (map[string]*corev1.Secret, error) {
+	if b.secretHistoryKey() == nil {
+		return nil, operationerrors.Configuration(errors.New("cannot roll back the secrets as no secret history encryption secret exists"))
+	}
+	number, err := strconv.Atoi(version)
+	if err != nil {
+		return nil, operationerrors.Configuration(fmt.Errorf("cannot roll back the secrets to the invalid version '%s'", version))
+	}
+
+	var (
+		history = b.seedSecretHistory()
+		secrets = make(map[string]*corev1.Secret, len(existingSecrets))
+	)
+	snapshot, err := history.get(number)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := history.list()
+	if err != nil {
+		return nil, err
+	}
+	for name, secret := range existingSecrets {
+		secrets[name] = secret
+	}
+
+	if len(versions) > 0 {
+		for _, name := range secretVersionSecrets(versions[len(versions)-1]) {
+			if _, ok := snapshot[name]; ok {
+				continue
+			}
+			if err := b.K8sSeedClient.DeleteSecret(b.Shoot.SeedNamespace, name); err != nil && !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			delete(secrets, name)
+		}
+	}
+	for name, secret := range snapshot {
+		restored, err := b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, name, secret.Type, secret.Data, true)
+		if err != nil {
+			return nil, err
+		}
+		secrets[name] = restored
+	}
+
+	b.Logger.Infof("Rolled back the generated secrets to version %d", number)
+	return secrets, nil
+}
+
+// ListSecretVersions lists the retained versions of the bundles of generated secrets of the Shoot.
+func (b *Botanist) ListSecretVersions() (*SecretVersions, error) {
+	secretVersions := &SecretVersions{
+		Namespace: b.Shoot.Info.Namespace,
+		Name:      b.Shoot.Info.Name,
+	}
+
+	for _, history := range []*secretHistory{b.seedSecretHistory(), b.projectSecretHistory()} {
+		versions, err := history.list()
+		if err != nil {
+			return nil, err
+		}
+
+		bundle := SecretBundle{
+			Name:      history.bundle,
+			Namespace: history.namespace,
+			Versions:  make([]SecretVersion, 0, len(versions)),
+		}
+		for i := len(versions) - 1; i >= 0; i-- {
+			bundle.Versions = append(bundle.Versions, SecretVersion{
+				Version:           secretVersionNumber(versions[i]),
+				CreationTimestamp: versions[i].CreationTimestamp,
+				Secrets:           secretVersionSecrets(versions[i]),
+				Current:           i == len(versions)-1,
+			})
+		}
+		secretVersions.Bundles = append(secretVersions.Bundles, bundle)
+	}
+
+	return secretVersions, nil
+}
+
+// deleteAll deletes all versions of the bundle.
+func (h *secretHistory) deleteAll() error {
+	versions, err := h.list()
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		if err := h.client.DeleteSecret(h.namespace, version.Name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// list returns the versions of the bundle sorted by their number, the most recent one last.
+func (h *secretHistory) list() ([]corev1.Secret, error) {
+	secretList, err := h.client.ListSecrets(h.namespace, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s,%s=%s", common.GardenRole, common.GardenRoleSecretHistory, common.SecretHistoryShoot, h.shootName, common.SecretHistoryBundle, h.bundle),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sortSecretVersions(secretList.Items), nil
+}
+
+// get returns the decrypted snapshot of the given <version> of the bundle.
+func (h *secretHistory) get(version int) (secretSnapshot, error) {
+	secret, err := h.client.GetSecret(h.namespace, h.secretName(version))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, operationerrors.Configuration(fmt.Errorf("version %d of the %s secrets does not exist", version, h.bundle))
+		}
+		return nil, err
+	}
+	return decodeSecretSnapshot(h.key, secret.Data[secretHistoryDataKey])
+}
+
+// record stores the given <secrets> as a new version of the bundle unless they equal the most recent version, and
+// deletes all but the most recent version and <limit> previous versions.
+func (h *secretHistory) record(secrets map[string]*corev1.Secret, limit int) error {
+	snapshot := make(secretSnapshot, len(secrets))
+	for name, secret := range secrets {
+		if secret == nil {
+			continue
+		}
+		snapshot[name] = snapshotSecret{Type: secret.Type, Data: secret.Data}
+	}
+
+	data, checksum, err := encodeSecretSnapshot(h.key, snapshot)
+	if err != nil {
+		return err
+	}
+	versions, err := h.list()
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 || versions[len(versions)-1].Annotations["checksum/data"] != checksum {
+		version := 1
+		if len(versions) > 0 {
+			version = secretVersionNumber(versions[len(versions)-1]) + 1
+		}
+
+		secret, err := h.client.CreateSecretObject(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      h.secretName(version),
+				Namespace: h.namespace,
+				Labels: map[string]string{
+					common.GardenRole:           common.GardenRoleSecretHistory,
+					common.SecretHistoryShoot:   h.shootName,
+					common.SecretHistoryBundle:  h.bundle,
+					common.SecretHistoryVersion: strconv.Itoa(version),
+				},
+				Annotations: map[string]string{
+					"checksum/data":             checksum,
+					common.SecretHistorySecrets: strings.Join(snapshot.names(), ","),
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{
+				secretHistoryDataKey: data,
+			},
+		}, false)
+		if err != nil {
+			return err
+		}
+		versions = append(versions, *secret)
+	}
+
+	for _, version := range obsoleteSecretVersions(versions, limit) {
+		if err := h.client.DeleteSecret(h.namespace, version.Name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *secretHistory) secretName(version int) string {
+	return generateGardenSecretName(h.shootName, fmt.Sprintf("%s-secrets.v%d", h.bundle, version))
+}
+
+// names returns the sorted names of the secrets of the snapshot.
+func (s secretSnapshot) names() []string {
+	names := make([]string, 0, len(s))
+	for name := range s {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// encodeSecretSnapshot encrypts the given <snapshot> with the given <key>. It returns the encrypted snapshot and
+// the checksum of the unencrypted one.
+func encodeSecretSnapshot(key []byte, snapshot secretSnapshot) ([]byte, string, error) {
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, "", err
+	}
+	data, err := utils.Encrypt(key, raw)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, secretSnapshotChecksum(key, raw), nil
+}
+
+// secretSnapshotChecksum computes the checksum of the unencrypted <raw> snapshot as HMAC-SHA256 with a MAC key
+// derived from the given <key>. Unlike a plain hash, the checksum cannot be used to guess low-entropy secret
+// values without the key.
+func secretSnapshotChecksum(key, raw []byte) string {
+	derivation := hmac.New(sha256.New, key)
+	derivation.Write([]byte("checksum/data"))
+
+	mac := hmac.New(sha256.New, derivation.Sum(nil))
+	mac.Write(raw)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// decodeSecretSnapshot decrypts the given <data> with the given <key> and returns the snapshot.
+func decodeSecretSnapshot(key, data []byte) (secretSnapshot, error) {
+	raw, err := utils.Decrypt(key, data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt the secret snapshot: %v", err)
+	}
+	snapshot := secretSnapshot{}
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// sortSecretVersions sorts the given <versions> by their number, the most recent one last. Secrets without a valid
+// version number are dropped.
+func sortSecretVersions(versions []corev1.Secret) []corev1.Secret {
+	sorted := make([]corev1.Secret, 0, len(versions))
+	for _, version := range versions {
+		if secretVersionNumber(version) > 0 {
+			sorted = append(sorted, version)
+		}
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return secretVersionNumber(sorted[i]) < secretVersionNumber(sorted[j])
+	})
+	return sorted
+}
+
+// obsoleteSecretVersions returns the versions of the given sorted <versions> which are older than the most recent
+// version and <limit> previous versions.
+func obsoleteSecretVersions(versions []corev1.Secret, limit int) []corev1.Secret {
+	if retained := limit + 1; len(versions) > retained {
+		return versions[:len(versions)-retained]
+	}
+	return nil
+}
+
+func secretVersionNumber(version corev1.Secret) int {
+	number, err := strconv.Atoi(version.Labels[common.SecretHistoryVersion])
+	if err != nil {
+		return 0
+	}
+	return number
+}
+
+func secretVersionSecrets(version corev1.Secret) []string {
+	if secrets := version.Annotations[common.SecretHistorySecrets]; len(secrets) > 0 {
+		return strings.Split(secrets, ",")
+	}
+	return nil
+}
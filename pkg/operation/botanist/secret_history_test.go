@@ -0,0 +1,144 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"bytes"
+
+	. "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("secret history", func() {
+	newVersion := func(name, version string) corev1.Secret {
+		return corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{common.SecretHistoryVersion: version},
+			},
+		}
+	}
+
+	names := func(versions []corev1.Secret) []string {
+		var out []string
+		for _, version := range versions {
+			out = append(out, version.Name)
+		}
+		return out
+	}
+
+	Describe("#sortSecretVersions", func() {
+		It("should sort the versions by their number and drop invalid ones", func() {
+			versions := []corev1.Secret{
+				newVersion("v10", "10"),
+				newVersion("v2", "2"),
+				newVersion("invalid", "foo"),
+				newVersion("v9", "9"),
+			}
+
+			Expect(names(ExportSortSecretVersions(versions))).To(Equal([]string{"v2", "v9", "v10"}))
+		})
+	})
+
+	Describe("#obsoleteSecretVersions", func() {
+		versions := []corev1.Secret{
+			newVersion("v1", "1"),
+			newVersion("v2", "2"),
+			newVersion("v3", "3"),
+			newVersion("v4", "4"),
+		}
+
+		It("should retain the most recent version and the given number of previous versions", func() {
+			Expect(names(ExportObsoleteSecretVersions(versions, 2))).To(Equal([]string{"v1"}))
+		})
+
+		It("should only retain the most recent version if no previous versions are retained", func() {
+			Expect(names(ExportObsoleteSecretVersions(versions, 0))).To(Equal([]string{"v1", "v2", "v3"}))
+		})
+
+		It("should not delete anything if there are not more versions than retained", func() {
+			Expect(ExportObsoleteSecretVersions(versions, 3)).To(BeEmpty())
+		})
+	})
+
+	Describe("#encodeSecretSnapshot", func() {
+		var (
+			key     = bytes.Repeat([]byte("k"), 32)
+			secrets = map[string]map[string][]byte{
+				"ca":          {"ca.crt": []byte("cert"), "ca.key": []byte("key")},
+				"ssh-keypair": {"id_rsa": []byte("private"), "id_rsa.pub": []byte("public")},
+			}
+		)
+
+		It("should restore the encrypted secrets", func() {
+			decrypted, checksum, err := ExportEncodeDecodeSecretSnapshot(key, key, secrets)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(decrypted).To(Equal(secrets))
+			Expect(checksum).NotTo(BeEmpty())
+		})
+
+		It("should compute the same checksum for the same secrets", func() {
+			_, first, err := ExportEncodeDecodeSecretSnapshot(key, key, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			_, second, err := ExportEncodeDecodeSecretSnapshot(key, key, secrets)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first).To(Equal(second))
+		})
+
+		It("should key the checksum with the key of the history", func() {
+			_, first, err := ExportEncodeDecodeSecretSnapshot(key, key, secrets)
+			Expect(err).NotTo(HaveOccurred())
+			otherKey := bytes.Repeat([]byte("x"), 32)
+			_, second, err := ExportEncodeDecodeSecretSnapshot(otherKey, otherKey, secrets)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first).NotTo(Equal(second))
+		})
+
+		It("should fail to decrypt the secrets with another key", func() {
+			_, _, err := ExportEncodeDecodeSecretSnapshot(key, bytes.Repeat([]byte("x"), 32), secrets)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#persistedSecretNames", func() {
+		It("should return the names of the secrets which are stored in the Seed", func() {
+			secretList := []interface{}{
+				RSASecret{Secret: Secret{Name: "ssh-keypair"}},
+				TLSSecret{Secret: Secret{Name: "metrics-server"}},
+				TLSSecret{Secret: Secret{Name: "vpn-shoot", DoNotApply: true}},
+				ControlPlaneSecret{TLSSecret: TLSSecret{Secret: Secret{Name: "kube-apiserver"}}},
+			}
+
+			Expect(ExportPersistedSecretNames(secretList)).To(Equal([]string{
+				"ca",
+				"kube-apiserver-basic-auth",
+				"vpn-seed-tlsauth",
+				"prometheus-federation-basic-auth",
+				"ssh-keypair",
+				"metrics-server",
+				"kube-apiserver",
+			}))
+		})
+	})
+})
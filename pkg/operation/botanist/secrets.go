@@ -106,11 +106,22 @@ type ControlPlaneSecret struct {
 	RunsInSeed                         bool
 }
 
+// gardenSecrets maps the suffixes of the Shoot-specific secrets in the project namespace in the Garden cluster to
+// the names of the secrets in the Seed cluster whose data they contain.
+var gardenSecrets = map[string]string{
+	"kubeconfig":            "kubecfg",
+	"kubeconfig-viewer":     "kubecfg-viewer",
+	"ssh-keypair":           "ssh-keypair",
+	"monitoring-federation": common.PrometheusFederationSecretName,
+}
+
 // DeploySecrets creates a CA certificate for the Shoot cluster and uses it to sign the server certificate
 // used by the kube-apiserver, and all client certificates used for communcation. It also creates RSA key
 // pairs for SSH connections to the nodes/VMs and for the VPN tunnel. Moreover, basic authentication
-// credentials are computed which will be used to secure the Ingress resources and the kube-apiserver itself.
-// Server certificates for the exposed monitoring endpoints (via Ingress) are generated as well.
+// credentials are computed which will be used to secure the Ingress resources and the kube-apiserver itself, and
+// separate ones for the federation endpoint of the Prometheus.
+// Server certificates for the exposed monitoring endpoints (via Ingress) are generated as well. Every change of
+// the generated secrets is recorded as a new version in the secret history (see recordSecretHistory).
 func (b *Botanist) DeploySecrets() error {
 	var (
 		name                  string
@@ -132,6 +143,13 @@ func (b *Botanist) DeploySecrets() error {
 		secretsMap[secret.ObjectMeta.Name] = &secretObj
 	}
 
+	// A requested rollback restores a previous version of the generated secrets before the missing ones are generated.
+	if version := b.Shoot.Info.Annotations[common.ShootRollbackSecrets]; len(version) > 0 {
+		if secretsMap, err = b.rollbackSecrets(version, secretsMap); err != nil {
+			return err
+		}
+	}
+
 	// First we have to generate a CA certificate in order to sign the remaining server/client certificates.
 	name = "ca"
 	if val, ok := secretsMap[name]; ok {
@@ -217,6 +235,23 @@ func (b *Botanist) DeploySecrets() error {
 		b.Secrets[name] = tlsAuthSecret
 	}
 
+	// The federation endpoint of the Prometheus gets dedicated Basic Authentication credentials so that they can be
+	// handed out to the monitoring systems of the Shoot owners without granting access to the cluster.
+	name = common.PrometheusFederationSecretName
+	if val, ok := secretsMap[name]; ok {
+		b.Secrets[name] = val
+	} else {
+		username, password := generateFederationBasicAuthData()
+		data = map[string][]byte{
+			"username": []byte(username),
+			"password": []byte(password),
+		}
+		b.Secrets[name], err = b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, name, corev1.SecretTypeOpaque, data, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Now we are prepared enough to generate the remaining secrets, i.e. server certificates, client certificates,
 	// and SSH key pairs.
 	secretList, err := b.generateSecrets()
@@ -282,17 +317,44 @@ func (b *Botanist) DeploySecrets() error {
 		return fmt.Errorf("Errors occurred during secret generation: %+v", e)
 	}
 
-	// Create kubeconfig and ssh-keypair secrets also in the project namespace in the Garden cluster
-	for key, value := range map[string]string{"kubeconfig": "kubecfg", "ssh-keypair": "ssh-keypair"} {
+	// Create kubeconfig, viewer kubeconfig, ssh-keypair and monitoring federation secrets also in the project namespace
+	// in the Garden cluster
+	for key, value := range gardenSecrets {
 		if _, err := b.K8sGardenClient.CreateSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, key), corev1.SecretTypeOpaque, b.Secrets[value].Data, true); err != nil {
 			return err
 		}
 	}
 
+	if err := b.recordSecretHistory(persistedSecretNames(secretList)); err != nil {
+		return err
+	}
+
 	b.computeSecretsCheckSums()
 	return nil
 }
 
+// persistedSecretNames returns the names of the generated secrets which are stored in the Shoot namespace in the
+// Seed, i.e. the CA, the basic authentication credentials, the OpenVPN TLS auth key and the secrets of the given
+// <secretList> which are applied.
+func persistedSecretNames(secretList []interface{}) []string {
+	names := []string{"ca", "kube-apiserver-basic-auth", "vpn-seed-tlsauth", common.PrometheusFederationSecretName}
+	for _, s := range secretList {
+		var secret Secret
+		switch v := s.(type) {
+		case RSASecret:
+			secret = v.Secret
+		case TLSSecret:
+			secret = v.Secret
+		case ControlPlaneSecret:
+			secret = v.Secret
+		}
+		if len(secret.Name) > 0 && !secret.DoNotApply {
+			names = append(names, secret.Name)
+		}
+	}
+	return names
+}
+
 // deployCloudProviderSecret creates or updates the cloud provider secret in the Shoot namespace
 // in the Seed cluster.
 func (b *Botanist) deployCloudProviderSecret() error {
@@ -316,18 +378,16 @@ func (b *Botanist) deployCloudProviderSecret() error {
 	return nil
 }
 
-// DeleteGardenSecrets deletes the Shoot-specific secrets from the project namespace in the Garden cluster.
+// DeleteGardenSecrets deletes the Shoot-specific secrets (and their previous versions) from the project namespace in
+// the Garden cluster.
 // TODO: Switch to putting an ownerReference of the Shoot into the Secret's metadata once garbage collection works properly.
 func (b *Botanist) DeleteGardenSecrets() error {
-	err := b.K8sGardenClient.DeleteSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, "kubeconfig"))
-	if apierrors.IsNotFound(err) {
-		return nil
-	}
-	err = b.K8sGardenClient.DeleteSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, "ssh-keypair"))
-	if apierrors.IsNotFound(err) {
-		return nil
+	for key := range gardenSecrets {
+		if err := b.K8sGardenClient.DeleteSecret(b.Shoot.Info.Namespace, generateGardenSecretName(b.Shoot.Info.Name, key)); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
 	}
-	return err
+	return b.projectSecretHistory().deleteAll()
 }
 
 // createRSASecret takes a RSASecret object, and it generates a new RSA private key using the specified
@@ -533,6 +593,12 @@ func generateBasicAuthData() (string, string) {
 	return "admin", utils.GenerateRandomString(32)
 }
 
+// generateFederationBasicAuthData computes a username/password keypair for the federation endpoint of the
+// Prometheus. It uses "federation" as username and generates a random password of length 32.
+func generateFederationBasicAuthData() (string, string) {
+	return "federation", utils.GenerateRandomString(32)
+}
+
 // generateKubeconfig generates a Kubernetes Kubeconfig for communicating with the kube-apiserver by using
 // a client certificate. If <basicAuthUser> and <basicAuthPass> are non-empty string, a second user object
 // containing the Basic Authentication credentials is added to the Kubeconfig.
@@ -619,6 +685,8 @@ func (b *Botanist) generateSecrets() ([]interface{}, error) {
 		alertManagerHost = b.Seed.GetIngressFQDN("a", b.Shoot.Info.Name, b.Garden.ProjectName)
 		grafanaHost      = b.Seed.GetIngressFQDN("g", b.Shoot.Info.Name, b.Garden.ProjectName)
 		prometheusHost   = b.Seed.GetIngressFQDN("p", b.Shoot.Info.Name, b.Garden.ProjectName)
+
+		prometheusFederationHost = b.Seed.GetIngressFQDN(common.PrometheusFederationIngressSubDomain, b.Shoot.Info.Name, b.Garden.ProjectName)
 	)
 
 	apiServerCertDNSNames := []string{
@@ -745,6 +813,22 @@ func (b *Botanist) generateSecrets() ([]interface{}, error) {
 			RunsInSeed:         true,
 		},
 
+		// Secret definition for cluster-autoscaler
+		ControlPlaneSecret{
+			TLSSecret: TLSSecret{
+				Secret: Secret{
+					Name: "cluster-autoscaler",
+				},
+				CommonName:   "system:cluster-autoscaler",
+				Organization: nil,
+				DNSNames:     nil,
+				IPAddresses:  nil,
+				CertType:     ClientCert,
+			},
+			KubeconfigRequired: true,
+			RunsInSeed:         true,
+		},
+
 		// Secret definition for kube-addon-manager
 		ControlPlaneSecret{
 			TLSSecret: TLSSecret{
@@ -828,6 +912,24 @@ func (b *Botanist) generateSecrets() ([]interface{}, error) {
 			RunsInSeed:                         false,
 		},
 
+		// Secret definition for kubecfg-viewer (read-only access bound to the 'view' ClusterRole)
+		ControlPlaneSecret{
+			TLSSecret: TLSSecret{
+				Secret: Secret{
+					Name: "kubecfg-viewer",
+				},
+				CommonName:   fmt.Sprintf("%s:viewer", garden.GroupName),
+				Organization: []string{fmt.Sprintf("%s:viewers", garden.GroupName)},
+				DNSNames:     nil,
+				IPAddresses:  nil,
+				CertType:     ClientCert,
+			},
+			KubeconfigRequired:                 true,
+			KubeconfigWithBasicAuth:            false,
+			KubeconfigUseInternalClusterDomain: false,
+			RunsInSeed:                         false,
+		},
+
 		// Secret definition for gardener
 		ControlPlaneSecret{
 			TLSSecret: TLSSecret{
@@ -965,6 +1067,18 @@ func (b *Botanist) generateSecrets() ([]interface{}, error) {
 			IPAddresses:  nil,
 			CertType:     ServerCert,
 		},
+
+		// Secret definition for the federation endpoint of prometheus (ingress)
+		TLSSecret{
+			Secret: Secret{
+				Name: "prometheus-federation-tls",
+			},
+			CommonName:   "prometheus-federation",
+			Organization: []string{fmt.Sprintf("%s:monitoring:ingress", garden.GroupName)},
+			DNSNames:     []string{prometheusFederationHost},
+			IPAddresses:  nil,
+			CertType:     ServerCert,
+		},
 	}
 
 	if b.Shoot.MonocularEnabled() && b.Shoot.Info.Spec.DNS.Domain != nil {
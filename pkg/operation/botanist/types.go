@@ -15,6 +15,7 @@
 package botanist
 
 import (
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
 	"github.com/gardener/gardener/pkg/operation"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -23,4 +24,14 @@ import (
 type Botanist struct {
 	*operation.Operation
 	DefaultDomainSecret *corev1.Secret
+	// SecretHistoryLimit is the number of previous versions of the generated secrets which are retained. The
+	// history is disabled if it is 0.
+	SecretHistoryLimit int
+	// Extensions are the extensions which are reconciled at defined points of the flow of the Shoot.
+	Extensions []componentconfig.ShootExtension
+	// ControlPlaneResourceLimits are the resource limits of the namespace of the control plane by Shoot purpose.
+	ControlPlaneResourceLimits map[string]componentconfig.ControlPlaneResourceLimits
+
+	// releaseEtcdOperationLock releases the etcd operation lock of the Shoot if the Botanist holds it.
+	releaseEtcdOperationLock func() error
 }
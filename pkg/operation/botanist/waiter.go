@@ -108,6 +108,23 @@ func (b *Botanist) WaitUntilBackupInfrastructureReconciled() error {
 	})
 }
 
+// WaitUntilCriticalComponentsReady waits until the critical components taint has been removed from all ready nodes
+// of the Shoot cluster, i.e. until the critical DaemonSets are ready on them. Nodes which are not ready are not
+// waited for because the critical DaemonSets cannot become ready on them.
+func (b *Botanist) WaitUntilCriticalComponentsReady() error {
+	return wait.PollImmediate(5*time.Second, 600*time.Second, func() (bool, error) {
+		pending, err := b.removeCriticalComponentsTaints()
+		if err != nil {
+			return false, err
+		}
+		if pending > 0 {
+			b.Logger.Infof("Waiting until the critical components are ready on %d node(s)...", pending)
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
 // WaitUntilVPNConnectionExists waits until a port forward connection to the vpn-shoot pod in the kube-system
 // namespace of the Shoot cluster can be established.
 func (b *Botanist) WaitUntilVPNConnectionExists() error {
@@ -21,6 +21,7 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // DeployKube2IAMResources creates the respective IAM roles which have been specified in the Shoot manifest
@@ -92,7 +93,7 @@ func (b *AWSBotanist) createKube2IAMRoles(customRoles []gardenv1beta1.Kube2IAMRo
 	}
 
 	for _, role := range tmpRoles {
-		role.Name = fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, role.Name)
+		role.Name = utils.TruncateName(fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, role.Name), iamRoleNameMaxLength)
 		roles = append(roles, role)
 	}
 	return roles, nil
@@ -22,5 +22,8 @@ import (
 func (b *AWSBotanist) GenerateCloudConfigUserDataConfig() *common.CloudConfigUserDataConfig {
 	return &common.CloudConfigUserDataConfig{
 		WorkerNames: b.Shoot.GetWorkerNames(),
+		// The instance store volumes are either NVMe devices or mapped to the device names of the block devices of the
+		// machine classes (/dev/sdb, /dev/sdc, ...), which appear as /dev/xvdb, /dev/xvdc, ... on the machines.
+		LocalSSDDevices: []string{"/dev/disk/by-id/nvme-Amazon_EC2_NVMe_Instance_Storage_*", "/dev/xvd[b-y]"},
 	}
 }
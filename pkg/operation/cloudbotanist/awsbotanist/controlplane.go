@@ -15,6 +15,7 @@
 package awsbotanist
 
 import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
@@ -99,10 +100,19 @@ func getAWSCredentialsEnvironment() []map[string]interface{} {
 
 // GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart.
 func (b *AWSBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID), common.BackupSecretName)
+}
+
+// GenerateEtcdRestoreConfig returns the configuration for restoring the etcd of a cloned Shoot from the backups of the
+// <source> Shoot for the etcd Helm chart.
+func (b *AWSBotanist) GenerateEtcdRestoreConfig(source *gardenv1beta1.Shoot) (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(source.Status.TechnicalID, source.Status.UID), common.RestoreSecretName)
+}
+
+func (b *AWSBotanist) generateEtcdBackupConfig(backupInfrastructureName, secretName string) (map[string][]byte, map[string]interface{}, error) {
 	var (
-		bucketName               = "bucketName"
-		backupInfrastructureName = common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
-		backupNamespace          = common.GenerateBackupNamespaceName(backupInfrastructureName)
+		bucketName      = "bucketName"
+		backupNamespace = common.GenerateBackupNamespaceName(backupInfrastructureName)
 	)
 
 	stateVariables, err := terraformer.New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, backupInfrastructureName, backupNamespace, b.ImageVector).GetStateOutputVariables(bucketName)
@@ -121,13 +131,13 @@ func (b *AWSBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]
 		"maxBackups":       b.Shoot.Info.Spec.Backup.Maximum,
 		"storageProvider":  "S3",
 		"storageContainer": stateVariables[bucketName],
-		"backupSecret":     common.BackupSecretName,
+		"backupSecret":     secretName,
 		"env": []map[string]interface{}{
 			{
 				"name": "AWS_REGION",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  Region,
 					},
 				},
@@ -136,7 +146,7 @@ func (b *AWSBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]
 				"name": "AWS_SECRET_ACCESS_KEY",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  SecretAccessKey,
 					},
 				},
@@ -145,7 +155,7 @@ func (b *AWSBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]
 				"name": "AWS_ACCESS_KEY_ID",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  AccessKeyID,
 					},
 				},
@@ -16,13 +16,21 @@ package awsbotanist
 
 import (
 	"fmt"
+	"regexp"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
 func (b *AWSBotanist) DeployInfrastructure() error {
+	defer b.infrastructureState.Invalidate()
+
 	var (
 		createVPC         = true
 		vpcID             = "${aws_vpc.vpc.id}"
@@ -50,12 +58,114 @@ func (b *AWSBotanist) DeployInfrastructure() error {
 		Apply()
 }
 
-// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
+// dependencyViolationRegexp matches the identifiers of the resources which AWS refuses to delete because other
+// objects still depend on them.
+var dependencyViolationRegexp = regexp.MustCompile(`(?:resource|The [a-z]+) '?([a-z]+-[0-9a-f]+)'? has (?:a dependent object|dependencies)`)
+
+// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure. If Terraform fails, leftover
+// network interfaces and security groups of the cluster (e.g. those of load balancers which have been created by the
+// cloud-controller-manager) are deleted and Terraform is retried once. If the infrastructure still cannot be destroyed,
+// the returned error lists the resources which block the deletion.
 func (b *AWSBotanist) DestroyInfrastructure() error {
-	return terraformer.
+	defer b.infrastructureState.Invalidate()
+
+	tf := terraformer.
 		NewFromOperation(b.Operation, common.TerraformerPurposeInfra).
-		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
-		Destroy()
+		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment())
+
+	err := tf.Destroy()
+	if err == nil {
+		return nil
+	}
+
+	blockers := sets.NewString()
+	if stateVariables, stateErr := tf.GetStateOutputVariables("vpc_id"); stateErr == nil {
+		deleted, remaining, cleanupErr := b.cleanupBlockingResources(stateVariables["vpc_id"])
+		if cleanupErr != nil {
+			b.Logger.Errorf("Could not clean up the resources blocking the infrastructure deletion: %+v", cleanupErr)
+		}
+		if deleted {
+			b.Logger.Info("Retrying the infrastructure deletion after the blocking resources have been cleaned up")
+			if err = tf.Destroy(); err == nil {
+				return nil
+			}
+		}
+		blockers.Insert(remaining...)
+	}
+	blockers.Insert(terraformer.FindBlockingResources(err, dependencyViolationRegexp)...)
+
+	return terraformer.DependenciesError(err, blockers.List())
+}
+
+// cleanupBlockingResources deletes the detached network interfaces and the security groups in the VPC with the given
+// <vpcID> which belong to the cluster but are not managed by Terraform. Only resources which carry the cluster tag or
+// use a security group carrying it are touched, so that foreign resources in user-provided VPCs are left alone. It
+// returns whether any resource has been deleted and a description of each resource which could not be deleted.
+func (b *AWSBotanist) cleanupBlockingResources(vpcID string) (bool, []string, error) {
+	var (
+		clusterTag              = fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace)
+		terraformSecurityGroups = sets.NewString(b.Shoot.SeedNamespace+"-nodes", b.Shoot.SeedNamespace+"-bastions")
+		clusterSecurityGroupIDs = sets.NewString()
+		deleted                 = false
+		blockers                []string
+	)
+
+	securityGroups, err := b.AWSClient.GetSecurityGroupsWithTag(vpcID, clusterTag)
+	if err != nil {
+		return false, nil, err
+	}
+	for _, securityGroup := range securityGroups {
+		clusterSecurityGroupIDs.Insert(aws.StringValue(securityGroup.GroupId))
+	}
+
+	networkInterfaces, err := b.AWSClient.GetNetworkInterfaces(vpcID)
+	if err != nil {
+		return false, nil, err
+	}
+	for _, networkInterface := range networkInterfaces {
+		if !usesSecurityGroup(networkInterface, clusterSecurityGroupIDs) {
+			continue
+		}
+
+		id := aws.StringValue(networkInterface.NetworkInterfaceId)
+		if aws.StringValue(networkInterface.Status) != ec2.NetworkInterfaceStatusAvailable {
+			blockers = append(blockers, fmt.Sprintf("%s (attached network interface: %s)", id, aws.StringValue(networkInterface.Description)))
+			continue
+		}
+		if err := b.AWSClient.DeleteNetworkInterface(id); err != nil {
+			blockers = append(blockers, fmt.Sprintf("%s (network interface: %s)", id, err.Error()))
+			continue
+		}
+		b.Logger.Infof("Deleted network interface %s which blocked the infrastructure deletion", id)
+		deleted = true
+	}
+
+	for _, securityGroup := range securityGroups {
+		name := aws.StringValue(securityGroup.GroupName)
+		if terraformSecurityGroups.Has(name) {
+			continue
+		}
+
+		id := aws.StringValue(securityGroup.GroupId)
+		if err := b.AWSClient.DeleteSecurityGroup(id); err != nil {
+			blockers = append(blockers, fmt.Sprintf("%s (security group %s: %s)", id, name, err.Error()))
+			continue
+		}
+		b.Logger.Infof("Deleted security group %s (%s) which blocked the infrastructure deletion", id, name)
+		deleted = true
+	}
+
+	return deleted, blockers, nil
+}
+
+// usesSecurityGroup returns true if the given <networkInterface> is associated with one of the <securityGroupIDs>.
+func usesSecurityGroup(networkInterface *ec2.NetworkInterface, securityGroupIDs sets.String) bool {
+	for _, group := range networkInterface.Groups {
+		if securityGroupIDs.Has(aws.StringValue(group.GroupId)) {
+			return true
+		}
+	}
+	return false
 }
 
 // generateTerraformInfraVariablesEnvironment generates the environment containing the credentials which
@@ -97,7 +207,7 @@ func (b *AWSBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, intern
 			"region": b.Shoot.Info.Spec.Cloud.Region,
 		},
 		"create": map[string]interface{}{
-			"vpc": createVPC,
+			"vpc":                       createVPC,
 			"clusterAutoscalerPolicies": b.Shoot.ClusterAutoscalerEnabled() && !b.Shoot.Kube2IAMEnabled(),
 		},
 		"sshPublicKey": string(sshSecret.Data["id_rsa.pub"]),
@@ -107,9 +217,107 @@ func (b *AWSBotanist) generateTerraformInfraConfig(createVPC bool, vpcID, intern
 			"dhcpDomainName":    dhcpDomainName,
 			"internetGatewayID": internetGatewayID,
 		},
-		"clusterName": b.Shoot.SeedNamespace,
-		"zones":       zones,
+		"clusterName":         b.Shoot.SeedNamespace,
+		"zones":               zones,
+		"workerFirewallRules": common.GenerateWorkerFirewallRulesConfig(b.Shoot.GetWorkers()),
+	}
+}
+
+// GetInfrastructureStatus reads the identifiers of the provisioned infrastructure resources (VPC, subnets, security
+// group, NAT IPs and IAM role) from the Terraform state.
+func (b *AWSBotanist) GetInfrastructureStatus() (*gardenv1beta1.ShootCloudStatus, error) {
+	var (
+		vpcID           = "vpc_id"
+		securityGroup   = "security_group_nodes"
+		nodesRoleARN    = "nodes_role_arn"
+		outputVariables = []string{vpcID, securityGroup, nodesRoleARN}
+		zones           = b.Shoot.Info.Spec.Cloud.AWS.Zones
+		status          = &gardenv1beta1.ShootCloudStatus{}
+
+		tfOutputNameSubnetNodes = func(zoneIndex int) string {
+			return fmt.Sprintf("subnet_nodes_z%d", zoneIndex)
+		}
+		tfOutputNameSubnetPublicUtility = func(zoneIndex int) string {
+			return fmt.Sprintf("subnet_public_utility_z%d", zoneIndex)
+		}
+		tfOutputNameNATIP = func(zoneIndex int) string {
+			return fmt.Sprintf("nat_ip_z%d", zoneIndex)
+		}
+	)
+
+	for zoneIndex := range zones {
+		outputVariables = append(outputVariables, tfOutputNameSubnetNodes(zoneIndex), tfOutputNameSubnetPublicUtility(zoneIndex), tfOutputNameNATIP(zoneIndex))
+	}
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(outputVariables...)
+	if err != nil {
+		return nil, err
+	}
+
+	status.Network = stateVariables[vpcID]
+	status.SecurityGroups = []string{stateVariables[securityGroup]}
+	status.IAMRoles = []string{stateVariables[nodesRoleARN]}
+	for zoneIndex := range zones {
+		status.Subnets = append(status.Subnets, stateVariables[tfOutputNameSubnetNodes(zoneIndex)], stateVariables[tfOutputNameSubnetPublicUtility(zoneIndex)])
+		status.NATIPs = append(status.NATIPs, stateVariables[tfOutputNameNATIP(zoneIndex)])
+	}
+
+	return status, nil
+}
+
+// GetInfrastructureState returns the outputs of the infrastructure which are required to create the machines (subnets,
+// security groups, IAM instance profile and key pair). They are read from the Terraform state once and cached until
+// the infrastructure is applied or destroyed again.
+func (b *AWSBotanist) GetInfrastructureState() (*operation.InfrastructureState, error) {
+	return b.infrastructureState.Get(b.readInfrastructureState)
+}
+
+func (b *AWSBotanist) readInfrastructureState() (*operation.InfrastructureState, error) {
+	var (
+		iamInstanceProfile = "iamInstanceProfileNodes"
+		keyName            = "keyName"
+		securityGroup      = "security_group_nodes"
+		outputVariables    = []string{iamInstanceProfile, keyName, securityGroup}
+		workers            = b.Shoot.Info.Spec.Cloud.AWS.Workers
+		zones              = b.Shoot.Info.Spec.Cloud.AWS.Zones
+
+		tfOutputNameSubnet = func(zoneIndex int) string {
+			return fmt.Sprintf("subnet_nodes_z%d", zoneIndex)
+		}
+		tfOutputNameSecurityGroupWorker = func(workerName string) string {
+			return fmt.Sprintf("security_group_nodes_%s", workerName)
+		}
+	)
+
+	for zoneIndex := range zones {
+		outputVariables = append(outputVariables, tfOutputNameSubnet(zoneIndex))
+	}
+	for _, worker := range workers {
+		if len(worker.FirewallRules) > 0 {
+			outputVariables = append(outputVariables, tfOutputNameSecurityGroupWorker(worker.Name))
+		}
+	}
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(outputVariables...)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &operation.InfrastructureState{
+		SecurityGroups:       []string{stateVariables[securityGroup]},
+		WorkerSecurityGroups: map[string]string{},
+		ServiceAccount:       stateVariables[iamInstanceProfile],
+		KeyName:              stateVariables[keyName],
+	}
+	for zoneIndex := range zones {
+		state.Subnets = append(state.Subnets, stateVariables[tfOutputNameSubnet(zoneIndex)])
+	}
+	for _, worker := range workers {
+		if len(worker.FirewallRules) > 0 {
+			state.WorkerSecurityGroups[worker.Name] = stateVariables[tfOutputNameSecurityGroupWorker(worker.Name)]
+		}
 	}
+	return state, nil
 }
 
 // DeployBackupInfrastructure kicks off a Terraform job which deploys the infrastructure resources for backup.
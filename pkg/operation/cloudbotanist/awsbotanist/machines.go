@@ -16,13 +16,24 @@ package awsbotanist
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
+// maxUserDataSize is the maximum size in bytes of the user data of EC2 instances (16 KiB before the base64 encoding).
+const maxUserDataSize = 16384
+
 // GetMachineClassInfo returns the name of the class kind, the plural of it and the name of the Helm chart which
 // contains the machine class template.
 func (b *AWSBotanist) GetMachineClassInfo() (classKind, classPlural, classChartName string) {
@@ -35,9 +46,10 @@ func (b *AWSBotanist) GetMachineClassInfo() (classKind, classPlural, classChartN
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
 func (b *AWSBotanist) GenerateMachineClassSecretData() map[string][]byte {
+	credentials := b.Shoot.GetMachineCredentials()
 	return map[string][]byte{
-		machinev1alpha1.AWSAccessKeyID:     b.Shoot.Secret.Data[AccessKeyID],
-		machinev1alpha1.AWSSecretAccessKey: b.Shoot.Secret.Data[SecretAccessKey],
+		machinev1alpha1.AWSAccessKeyID:     credentials[AccessKeyID],
+		machinev1alpha1.AWSSecretAccessKey: credentials[SecretAccessKey],
 	}
 }
 
@@ -46,48 +58,58 @@ func (b *AWSBotanist) GenerateMachineClassSecretData() map[string][]byte {
 // the desired availability zones. It returns the computed list of MachineClasses and MachineDeployments.
 func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
 	var (
-		iamInstanceProfile = "iamInstanceProfileNodes"
-		keyName            = "keyName"
-		securityGroup      = "security_group_nodes"
-		outputVariables    = []string{iamInstanceProfile, keyName, securityGroup}
-		workers            = b.Shoot.Info.Spec.Cloud.AWS.Workers
-		zones              = b.Shoot.Info.Spec.Cloud.AWS.Zones
-		zoneLen            = len(zones)
+		workers = b.Shoot.Info.Spec.Cloud.AWS.Workers
+		zones   = b.Shoot.Info.Spec.Cloud.AWS.Zones
+		zoneLen = len(zones)
 
 		machineDeployments = []operation.MachineDeployment{}
 		machineClasses     = []map[string]interface{}{}
-
-		tfOutputNameSubnet = func(zoneIndex int) string {
-			return fmt.Sprintf("subnet_nodes_z%d", zoneIndex)
-		}
 	)
 
-	for zoneIndex := range zones {
-		outputVariables = append(outputVariables, tfOutputNameSubnet(zoneIndex))
-	}
-
-	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(outputVariables...)
+	infrastructureState, err := b.GetInfrastructureState()
 	if err != nil {
 		return nil, nil, err
 	}
 
 	for zoneIndex := range zones {
 		for _, worker := range workers {
-			cloudConfig, err := b.ComputeDownloaderCloudConfig(worker.Name)
+			userData, err := b.ComputeDownloaderUserData(worker.Name, maxUserDataSize)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			image, err := b.Shoot.GetWorkerMachineImage(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+			machineImage := image.(*gardenv1beta1.AWSMachineImage)
+
+			annotations, err := b.Shoot.ComputeMachineDeploymentAnnotations(worker.Worker)
 			if err != nil {
 				return nil, nil, err
 			}
 
+			gpus, err := b.Shoot.GetWorkerGPUs(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// Worker groups with firewall rules get their own security group in addition to the one of all nodes.
+			securityGroupIDs := append([]string{}, infrastructureState.SecurityGroups...)
+			if securityGroupID, ok := infrastructureState.WorkerSecurityGroups[worker.Name]; ok {
+				securityGroupIDs = append(securityGroupIDs, securityGroupID)
+			}
+
 			machineClassSpec := map[string]interface{}{
-				"ami":                b.Shoot.Info.Spec.Cloud.AWS.MachineImage.AMI,
+				"ami":                machineImage.AMI,
 				"region":             b.Shoot.Info.Spec.Cloud.Region,
 				"machineType":        worker.MachineType,
-				"iamInstanceProfile": stateVariables[iamInstanceProfile],
-				"keyName":            stateVariables[keyName],
+				"iamInstanceProfile": infrastructureState.ServiceAccount,
+				"keyName":            infrastructureState.KeyName,
 				"networkInterfaces": []map[string]interface{}{
 					{
-						"subnetID":         stateVariables[tfOutputNameSubnet(zoneIndex)],
-						"securityGroupIDs": []string{stateVariables[securityGroup]},
+						"subnetID":         infrastructureState.Subnet(zoneIndex),
+						"securityGroupIDs": securityGroupIDs,
 					},
 				},
 				"tags": map[string]string{
@@ -95,7 +117,7 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 					"kubernetes.io/role/node":                                      "1",
 				},
 				"secret": map[string]interface{}{
-					"cloudConfig": cloudConfig.FileContent("cloud-config.yaml"),
+					"cloudConfig": userData,
 				},
 				"blockDevices": []map[string]interface{}{
 					{
@@ -106,27 +128,218 @@ func (b *AWSBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 					},
 				},
 			}
+			if worker.LocalSSDs != nil {
+				machineClassSpec["blockDevices"] = append(machineClassSpec["blockDevices"].([]map[string]interface{}), localSSDBlockDevices(*worker.LocalSSDs)...)
+			}
+
+			// The machines of spot worker groups are requested as spot instances. Worker groups which fall back to
+			// on-demand instances while there is no spare capacity get an additional machine class without spot price.
+			var fallbackClassSpec map[string]interface{}
+			if worker.Spot != nil {
+				if worker.Spot.FallbackToOnDemand != nil && *worker.Spot.FallbackToOnDemand {
+					fallbackClassSpec = utils.MergeMaps(machineClassSpec, map[string]interface{}{
+						"secret": map[string]interface{}{
+							"cloudConfig": userData,
+						},
+					})
+				}
+				machineClassSpec["spotPrice"] = spotPrice(worker.Spot)
+			}
 
 			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 				secretData           = b.GenerateMachineClassSecretData()
+				machineClassSpecHash = b.Shoot.ComputeMachineClassHash(worker.Name, machineClassSpec, secretData)
+				deploymentName       = common.ZonedMachineDeploymentName(b.Shoot.SeedNamespace, worker.Name, zoneIndex)
+				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				fallbackClassName    string
 			)
+			if fallbackClassSpec != nil {
+				fallbackClassName = fmt.Sprintf("%s-%s", deploymentName, b.Shoot.ComputeMachineClassHash(worker.Name, fallbackClassSpec, secretData))
+			}
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:      deploymentName,
-				ClassName: className,
-				Replicas:  common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Name:              deploymentName,
+				WorkerName:        worker.Name,
+				ClassName:         className,
+				Minimum:           common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, zoneLen),
+				Maximum:           common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Labels:            worker.Labels,
+				Annotations:       annotations,
+				Cordoned:          worker.Cordoned != nil && *worker.Cordoned,
+				MaxSurge:          worker.MaxSurge,
+				MaxUnavailable:    worker.MaxUnavailable,
+				MinReadySeconds:   worker.MinReadySeconds,
+				FallbackClassName: fallbackClassName,
+				GPUs:              gpus,
 			})
 
-			machineClassSpec["name"] = className
-			machineClassSpec["secret"].(map[string]interface{})["accessKeyID"] = string(secretData[machinev1alpha1.AWSAccessKeyID])
-			machineClassSpec["secret"].(map[string]interface{})["secretAccessKey"] = string(secretData[machinev1alpha1.AWSSecretAccessKey])
-
-			machineClasses = append(machineClasses, machineClassSpec)
+			addMachineClass := func(name string, spec map[string]interface{}) {
+				spec["name"] = name
+				spec["secret"].(map[string]interface{})["accessKeyID"] = string(secretData[machinev1alpha1.AWSAccessKeyID])
+				spec["secret"].(map[string]interface{})["secretAccessKey"] = string(secretData[machinev1alpha1.AWSSecretAccessKey])
+				machineClasses = append(machineClasses, spec)
+			}
+			addMachineClass(className, machineClassSpec)
+			if fallbackClassSpec != nil {
+				addMachineClass(fallbackClassName, fallbackClassSpec)
+			}
 		}
 	}
 
 	return machineClasses, machineDeployments, nil
 }
+
+// spotPrice returns the maximum price for the spot instances of a worker group with the given <spot> configuration. An
+// empty price caps it at the on-demand price.
+func spotPrice(spot *gardenv1beta1.WorkerSpot) string {
+	if spot.MaxPrice != nil {
+		return *spot.MaxPrice
+	}
+	return ""
+}
+
+// localSSDBlockDevices returns the block devices of a machine class for the given <localSSDs>, i.e. the mappings of the
+// instance store volumes of the machine type. Machine types with NVMe instance store volumes attach them regardless
+// of the device names.
+func localSSDBlockDevices(localSSDs gardenv1beta1.WorkerLocalSSDs) []map[string]interface{} {
+	blockDevices := make([]map[string]interface{}, 0, localSSDs.Count)
+	for i := int32(0); i < localSSDs.Count; i++ {
+		blockDevices = append(blockDevices, map[string]interface{}{
+			"deviceName":  fmt.Sprintf("/dev/sd%c", 'b'+i),
+			"virtualName": fmt.Sprintf("ephemeral%d", i),
+		})
+	}
+	return blockDevices
+}
+
+// ListMachineInstanceIDs returns the IDs of the EC2 instances which exist for the machines of the Shoot. They are
+// identified by the cluster tag which the machine classes add to all instances.
+func (b *AWSBotanist) ListMachineInstanceIDs() ([]string, bool, error) {
+	instanceIDs, err := b.AWSClient.GetInstanceIDsWithTag(fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace), "1")
+	return instanceIDs, true, err
+}
+
+// CheckMachineCapacity checks whether the machine images of the given machine classes are available in the region of
+// the Shoot, whether the limit of on-demand instances of the account allows the minimum number of machines of the given
+// machine deployments, and whether their subnets have enough free IP addresses for them. Machines of the Shoot which
+// already exist are taken into account.
+func (b *AWSBotanist) CheckMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) (bool, error) {
+	var (
+		region     = b.Shoot.Info.Spec.Cloud.Region
+		clusterTag = fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace)
+
+		classes  = make(map[string]map[string]interface{}, len(machineClasses))
+		imageIDs = sets.NewString()
+	)
+
+	for _, machineClass := range machineClasses {
+		classes[machineClass["name"].(string)] = machineClass
+		imageIDs.Insert(machineClass["ami"].(string))
+	}
+
+	availableImageIDs, err := b.AWSClient.GetAvailableImageIDs(imageIDs.List())
+	if err != nil {
+		return true, err
+	}
+	if missing := imageIDs.Difference(sets.NewString(availableImageIDs...)); missing.Len() > 0 {
+		return true, operationerrors.Errorf(operationerrors.ClassConfiguration, "machine image(s) %s do not exist or are not available in region %s", strings.Join(missing.List(), ", "), region)
+	}
+
+	var (
+		requiredOnDemand int
+		requiredBySubnet = map[string]int{}
+	)
+	for _, deployment := range machineDeployments {
+		machineClass, ok := classes[deployment.ClassName]
+		if !ok {
+			continue
+		}
+		subnetID := machineClass["networkInterfaces"].([]map[string]interface{})[0]["subnetID"].(string)
+		requiredBySubnet[subnetID] += deployment.Minimum
+		if _, spot := machineClass["spotPrice"]; !spot {
+			requiredOnDemand += deployment.Minimum
+		}
+	}
+
+	instances, err := b.AWSClient.GetRunningInstances()
+	if err != nil {
+		return true, err
+	}
+	var (
+		usedOnDemand, existingOnDemand int
+		existingBySubnet               = map[string]int{}
+	)
+	for _, instance := range instances {
+		// Spot instances do not count against the limit of on-demand instances.
+		onDemand := instance.InstanceLifecycle == nil
+		if onDemand {
+			usedOnDemand++
+		}
+		if !hasTag(instance.Tags, clusterTag) {
+			continue
+		}
+		existingBySubnet[aws.StringValue(instance.SubnetId)]++
+		if onDemand {
+			existingOnDemand++
+		}
+	}
+
+	var problems []string
+
+	maxInstances, err := b.maxOnDemandInstances()
+	if err != nil {
+		return true, err
+	}
+	if additional := requiredOnDemand - existingOnDemand; maxInstances > 0 && additional > 0 && usedOnDemand+additional > maxInstances {
+		left := maxInstances - usedOnDemand
+		if left < 0 {
+			left = 0
+		}
+		problems = append(problems, fmt.Sprintf("the worker groups require %d more on-demand instances, but only %d of the limit of %d on-demand instances in region %s are left", additional, left, maxInstances, region))
+	}
+
+	subnetIDs := make([]string, 0, len(requiredBySubnet))
+	for subnetID := range requiredBySubnet {
+		subnetIDs = append(subnetIDs, subnetID)
+	}
+	sort.Strings(subnetIDs)
+	subnets, err := b.AWSClient.GetSubnets(subnetIDs)
+	if err != nil {
+		return true, err
+	}
+	for _, subnet := range subnets {
+		subnetID := aws.StringValue(subnet.SubnetId)
+		if additional := requiredBySubnet[subnetID] - existingBySubnet[subnetID]; additional > 0 && int64(additional) > aws.Int64Value(subnet.AvailableIpAddressCount) {
+			problems = append(problems, fmt.Sprintf("the worker groups require %d more machines in subnet %s (zone %s), but it has only %d free IP addresses left", additional, subnetID, aws.StringValue(subnet.AvailabilityZone), aws.Int64Value(subnet.AvailableIpAddressCount)))
+		}
+	}
+
+	if len(problems) > 0 {
+		return true, operationerrors.Errorf(operationerrors.ClassQuotaExceeded, "%s", strings.Join(problems, "; "))
+	}
+	return true, nil
+}
+
+// maxOnDemandInstances returns the limit of on-demand instances of the account in the region of the Shoot, or 0 if AWS
+// does not report it.
+func (b *AWSBotanist) maxOnDemandInstances() (int, error) {
+	values, err := b.AWSClient.GetAccountAttribute("max-instances")
+	if err != nil || len(values) == 0 {
+		return 0, err
+	}
+	maxInstances, err := strconv.Atoi(values[0])
+	if err != nil {
+		return 0, nil
+	}
+	return maxInstances, nil
+}
+
+// hasTag returns true if the given <tags> contain a tag with the given <key>.
+func hasTag(tags []*ec2.Tag, key string) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
@@ -16,11 +16,13 @@ package awsbotanist
 
 import (
 	"path/filepath"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/operation/common"
 )
 
-// ApplyCreateHook updates the AWS ELB health check to SSL and deploys the aws-lb-readvertiser.
+// ApplyCreateHook updates the AWS ELB health check to SSL (unless it already is an HTTP health check against the
+// kube-proxy health check node port) and deploys the aws-lb-readvertiser.
 // https://github.com/gardener/aws-lb-readvertiser
 func (b *AWSBotanist) ApplyCreateHook() error {
 	var (
@@ -45,6 +47,10 @@ func (b *AWSBotanist) ApplyCreateHook() error {
 	if err != nil {
 		return err
 	}
-	targetPort := (*elb.LoadBalancerDescriptions[0].HealthCheck.Target)[4:]
+	target := *elb.LoadBalancerDescriptions[0].HealthCheck.Target
+	if strings.HasPrefix(target, "HTTP:") {
+		return nil
+	}
+	targetPort := target[4:]
 	return b.AWSClient.UpdateELBHealthCheck(loadBalancerName, targetPort)
 }
@@ -25,6 +25,8 @@ type AWSBotanist struct {
 	CloudProviderName string
 	AWSClient         aws.ClientInterface
 	AMI               string
+
+	infrastructureState operation.InfrastructureStateCache
 }
 
 const (
@@ -34,4 +36,7 @@ const (
 	SecretAccessKey = "secretAccessKey"
 	// Region is a constant for the key in a backup secret that holds the AWS region.
 	Region = "region"
+
+	// iamRoleNameMaxLength is the maximum length of AWS IAM role names.
+	iamRoleNameMaxLength = 64
 )
@@ -18,18 +18,27 @@ import (
 	"errors"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // New takes an operation object <o> and creates a new AzureBotanist object.
 func New(o *operation.Operation, purpose string) (*AzureBotanist, error) {
-	var cloudProvider gardenv1beta1.CloudProvider
+	var (
+		cloudProvider gardenv1beta1.CloudProvider
+		secret        *corev1.Secret
+	)
+
 	switch purpose {
 	case common.CloudPurposeShoot:
 		cloudProvider = o.Shoot.CloudProvider
+		secret = o.Shoot.Secret
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
+		secret = o.Seed.Secret
 	}
 
 	if cloudProvider != gardenv1beta1.CloudProviderAzure {
@@ -39,6 +48,7 @@ func New(o *operation.Operation, purpose string) (*AzureBotanist, error) {
 	return &AzureBotanist{
 		Operation:         o,
 		CloudProviderName: "azure",
+		guard:             cloudapi.GuardFor(string(gardenv1beta1.CloudProviderAzure), terraformer.IsAPIFailure, string(secret.Data[SubscriptionID]), string(secret.Data[ClientID])),
 	}, nil
 }
 
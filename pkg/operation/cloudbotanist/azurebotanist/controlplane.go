@@ -17,6 +17,7 @@ package azurebotanist
 import (
 	"fmt"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils"
@@ -108,12 +109,21 @@ func (b *AzureBotanist) GenerateKubeSchedulerConfig() (map[string]interface{}, e
 
 // GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart.
 func (b *AzureBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID), common.BackupSecretName)
+}
+
+// GenerateEtcdRestoreConfig returns the configuration for restoring the etcd of a cloned Shoot from the backups of the
+// <source> Shoot for the etcd Helm chart.
+func (b *AzureBotanist) GenerateEtcdRestoreConfig(source *gardenv1beta1.Shoot) (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(source.Status.TechnicalID, source.Status.UID), common.RestoreSecretName)
+}
+
+func (b *AzureBotanist) generateEtcdBackupConfig(backupInfrastructureName, secretName string) (map[string][]byte, map[string]interface{}, error) {
 	var (
-		storageAccountName       = "storageAccountName"
-		storageAccessKey         = "storageAccessKey"
-		containerName            = "containerName"
-		backupInfrastructureName = common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
-		backupNamespace          = common.GenerateBackupNamespaceName(backupInfrastructureName)
+		storageAccountName = "storageAccountName"
+		storageAccessKey   = "storageAccessKey"
+		containerName      = "containerName"
+		backupNamespace    = common.GenerateBackupNamespaceName(backupInfrastructureName)
 	)
 
 	stateVariables, err := terraformer.New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, backupInfrastructureName, backupNamespace, b.ImageVector).GetStateOutputVariables(storageAccountName, storageAccessKey, containerName)
@@ -130,14 +140,14 @@ func (b *AzureBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[strin
 		"schedule":         b.Shoot.Info.Spec.Backup.Schedule,
 		"maxBackups":       b.Shoot.Info.Spec.Backup.Maximum,
 		"storageProvider":  "ABS",
-		"backupSecret":     common.BackupSecretName,
+		"backupSecret":     secretName,
 		"storageContainer": stateVariables[containerName],
 		"env": []map[string]interface{}{
 			{
 				"name": "STORAGE_ACCOUNT",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  "storage-account",
 					},
 				},
@@ -146,7 +156,7 @@ func (b *AzureBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[strin
 				"name": "STORAGE_KEY",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  "storage-key",
 					},
 				},
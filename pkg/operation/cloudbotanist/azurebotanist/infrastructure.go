@@ -15,9 +15,12 @@
 package azurebotanist
 
 import (
+	"regexp"
+
 	"fmt"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils"
@@ -25,6 +28,8 @@ import (
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
 func (b *AzureBotanist) DeployInfrastructure() error {
+	defer b.infrastructureState.Invalidate()
+
 	var (
 		createResourceGroup = true
 		createVNet          = true
@@ -61,15 +66,31 @@ func (b *AzureBotanist) DeployInfrastructure() error {
 		NewFromOperation(b.Operation, common.TerraformerPurposeInfra).
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
 		DefineConfig("azure-infra", b.generateTerraformInfraConfig(createResourceGroup, createVNet, resourceGroupName, vnetName, vnetCIDR, countUpdateDomains, countFaultDomains)).
+		SetGuard(b.guard).
 		Apply()
 }
 
-// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
+// dependencyRegexps match the identifiers of the resources which Azure reports as blocking the deletion of a subnet,
+// network security group or route table.
+var dependencyRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`is in use by (\S+) and cannot be deleted`),
+	regexp.MustCompile(`in use by the following resources: \[?([^\],\s]+)`),
+}
+
+// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure. If the deletion fails because
+// of dependency conflicts, the returned error lists the resources which are involved.
 func (b *AzureBotanist) DestroyInfrastructure() error {
-	return terraformer.
+	defer b.infrastructureState.Invalidate()
+
+	err := terraformer.
 		NewFromOperation(b.Operation, common.TerraformerPurposeInfra).
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
+		SetGuard(b.guard).
 		Destroy()
+	if err != nil {
+		return terraformer.DependenciesError(err, terraformer.FindBlockingResources(err, dependencyRegexps...))
+	}
+	return nil
 }
 
 // generateTerraformInfraVariablesEnvironment generates the environment containing the credentials which
@@ -111,12 +132,62 @@ func (b *AzureBotanist) generateTerraformInfraConfig(createResourceGroup, create
 	}
 }
 
+// GetInfrastructureStatus reads the identifiers of the provisioned infrastructure resources (VNet, subnet and
+// security group) from the Terraform state.
+func (b *AzureBotanist) GetInfrastructureStatus() (*gardenv1beta1.ShootCloudStatus, error) {
+	var (
+		vnetName          = "vnetName"
+		subnetName        = "subnetName"
+		securityGroupName = "securityGroupName"
+	)
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(vnetName, subnetName, securityGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gardenv1beta1.ShootCloudStatus{
+		Network:        stateVariables[vnetName],
+		Subnets:        []string{stateVariables[subnetName]},
+		SecurityGroups: []string{stateVariables[securityGroupName]},
+	}, nil
+}
+
+// GetInfrastructureState returns the outputs of the infrastructure which are required to create the machines
+// (resource group, VNet, subnet and availability set). They are read from the Terraform state once and cached until
+// the infrastructure is applied or destroyed again.
+func (b *AzureBotanist) GetInfrastructureState() (*operation.InfrastructureState, error) {
+	return b.infrastructureState.Get(b.readInfrastructureState)
+}
+
+func (b *AzureBotanist) readInfrastructureState() (*operation.InfrastructureState, error) {
+	var (
+		resourceGroupName = "resourceGroupName"
+		vnetName          = "vnetName"
+		subnetName        = "subnetName"
+		availabilitySetID = "availabilitySetID"
+	)
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(resourceGroupName, vnetName, subnetName, availabilitySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operation.InfrastructureState{
+		ResourceGroup:     stateVariables[resourceGroupName],
+		Network:           stateVariables[vnetName],
+		Subnets:           []string{stateVariables[subnetName]},
+		AvailabilitySetID: stateVariables[availabilitySetID],
+	}, nil
+}
+
 // DeployBackupInfrastructure kicks off a Terraform job which creates the infrastructure resources for backup.
 func (b *AzureBotanist) DeployBackupInfrastructure() error {
 	return terraformer.
 		New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, b.BackupInfrastructure.Name, common.GenerateBackupNamespaceName(b.BackupInfrastructure.Name), b.ImageVector).
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
 		DefineConfig("azure-backup", b.generateTerraformBackupConfig()).
+		SetGuard(b.guard).
 		Apply()
 }
 
@@ -125,6 +196,7 @@ func (b *AzureBotanist) DestroyBackupInfrastructure() error {
 	return terraformer.
 		New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, b.BackupInfrastructure.Name, common.GenerateBackupNamespaceName(b.BackupInfrastructure.Name), b.ImageVector).
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
+		SetGuard(b.guard).
 		Destroy()
 }
 
@@ -17,12 +17,15 @@ package azurebotanist
 import (
 	"fmt"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 )
 
+// maxUserDataSize is the maximum size in bytes of the custom data of Azure virtual machines (64 KiB).
+const maxUserDataSize = 65536
+
 // GetMachineClassInfo returns the name of the class kind, the plural of it and the name of the Helm chart which
 // contains the machine class template.
 func (b *AzureBotanist) GetMachineClassInfo() (classKind, classPlural, classChartName string) {
@@ -35,11 +38,12 @@ func (b *AzureBotanist) GetMachineClassInfo() (classKind, classPlural, classChar
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
 func (b *AzureBotanist) GenerateMachineClassSecretData() map[string][]byte {
+	credentials := b.Shoot.GetMachineCredentials()
 	return map[string][]byte{
-		machinev1alpha1.AzureClientID:       b.Shoot.Secret.Data[ClientID],
-		machinev1alpha1.AzureClientSecret:   b.Shoot.Secret.Data[ClientSecret],
-		machinev1alpha1.AzureSubscriptionID: b.Shoot.Secret.Data[SubscriptionID],
-		machinev1alpha1.AzureTenantID:       b.Shoot.Secret.Data[TenantID],
+		machinev1alpha1.AzureClientID:       credentials[ClientID],
+		machinev1alpha1.AzureClientSecret:   credentials[ClientSecret],
+		machinev1alpha1.AzureSubscriptionID: credentials[SubscriptionID],
+		machinev1alpha1.AzureTenantID:       credentials[TenantID],
 	}
 }
 
@@ -48,64 +52,84 @@ func (b *AzureBotanist) GenerateMachineClassSecretData() map[string][]byte {
 // MachineDeployments.
 func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
 	var (
-		resourceGroupName = "resourceGroupName"
-		vnetName          = "vnetName"
-		subnetName        = "subnetName"
-		availabilitySetID = "availabilitySetID"
-		outputVariables   = []string{resourceGroupName, vnetName, subnetName, availabilitySetID}
-		workers           = b.Shoot.Info.Spec.Cloud.Azure.Workers
+		workers = b.Shoot.Info.Spec.Cloud.Azure.Workers
 
 		machineDeployments = []operation.MachineDeployment{}
 		machineClasses     = []map[string]interface{}{}
 	)
 
-	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(outputVariables...)
+	infrastructureState, err := b.GetInfrastructureState()
 	if err != nil {
 		return nil, nil, err
 	}
 
 	for _, worker := range workers {
-		cloudConfig, err := b.ComputeDownloaderCloudConfig(worker.Name)
+		userData, err := b.ComputeDownloaderUserData(worker.Name, maxUserDataSize)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		image, err := b.Shoot.GetWorkerMachineImage(worker.Worker)
+		if err != nil {
+			return nil, nil, err
+		}
+		machineImage := image.(*gardenv1beta1.AzureMachineImage)
+
+		annotations, err := b.Shoot.ComputeMachineDeploymentAnnotations(worker.Worker)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		gpus, err := b.Shoot.GetWorkerGPUs(worker.Worker)
 		if err != nil {
 			return nil, nil, err
 		}
 
 		machineClassSpec := map[string]interface{}{
 			"region":            b.Shoot.Info.Spec.Cloud.Region,
-			"resourceGroup":     stateVariables[resourceGroupName],
-			"vnetName":          stateVariables[vnetName],
-			"subnetName":        stateVariables[subnetName],
-			"availabilitySetID": stateVariables[availabilitySetID],
+			"resourceGroup":     infrastructureState.ResourceGroup,
+			"vnetName":          infrastructureState.Network,
+			"subnetName":        infrastructureState.Subnet(0),
+			"availabilitySetID": infrastructureState.AvailabilitySetID,
 			"tags": map[string]interface{}{
 				"Name": b.Shoot.SeedNamespace,
 				fmt.Sprintf("kubernetes.io-cluster-%s", b.Shoot.SeedNamespace): "1",
 				"kubernetes.io-role-node":                                      "1",
 			},
 			"secret": map[string]interface{}{
-				"cloudConfig": cloudConfig.FileContent("cloud-config.yaml"),
+				"cloudConfig": userData,
 			},
 			"machineType": worker.MachineType,
 			"image": map[string]interface{}{
-				"publisher": b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Publisher,
-				"offer":     b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Offer,
-				"sku":       b.Shoot.Info.Spec.Cloud.Azure.MachineImage.SKU,
-				"version":   b.Shoot.Info.Spec.Cloud.Azure.MachineImage.Version,
+				"publisher": machineImage.Publisher,
+				"offer":     machineImage.Offer,
+				"sku":       machineImage.SKU,
+				"version":   machineImage.Version,
 			},
 			"volumeSize":   common.DiskSize(worker.VolumeSize),
 			"sshPublicKey": string(b.Secrets["ssh-keypair"].Data["id_rsa.pub"]),
 		}
 
 		var (
-			machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
-			deploymentName       = fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, worker.Name)
-			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			secretData           = b.GenerateMachineClassSecretData()
+			machineClassSpecHash = b.Shoot.ComputeMachineClassHash(worker.Name, machineClassSpec, secretData)
+			deploymentName       = common.MachineDeploymentName(b.Shoot.SeedNamespace, worker.Name)
+			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 		)
 
 		machineDeployments = append(machineDeployments, operation.MachineDeployment{
-			Name:      deploymentName,
-			ClassName: className,
-			Replicas:  worker.AutoScalerMax,
+			Name:            deploymentName,
+			WorkerName:      worker.Name,
+			ClassName:       className,
+			Minimum:         worker.AutoScalerMin,
+			Maximum:         worker.AutoScalerMax,
+			Labels:          worker.Labels,
+			Annotations:     annotations,
+			Cordoned:        worker.Cordoned != nil && *worker.Cordoned,
+			MaxSurge:        worker.MaxSurge,
+			MaxUnavailable:  worker.MaxUnavailable,
+			MinReadySeconds: worker.MinReadySeconds,
+			GPUs:            gpus,
 		})
 
 		machineClassSpec["name"] = className
@@ -119,3 +143,15 @@ func (b *AzureBotanist) GenerateMachineConfig() ([]map[string]interface{}, []ope
 
 	return machineClasses, machineDeployments, nil
 }
+
+// ListMachineInstanceIDs returns the IDs of the instances which exist for the machines of the Shoot. Listing the
+// instances is not yet supported for Azure, hence, the second return value is false.
+func (b *AzureBotanist) ListMachineInstanceIDs() ([]string, bool, error) {
+	return nil, false, nil
+}
+
+// CheckMachineCapacity checks whether the cloud provider has the capacity for the given machine classes and
+// deployments. The check is not yet supported for Azure, hence, the first return value is false.
+func (b *AzureBotanist) CheckMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) (bool, error) {
+	return false, nil
+}
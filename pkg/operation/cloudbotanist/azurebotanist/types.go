@@ -14,12 +14,20 @@
 
 package azurebotanist
 
-import "github.com/gardener/gardener/pkg/operation"
+import (
+	"github.com/gardener/gardener/pkg/client/cloudapi"
+	"github.com/gardener/gardener/pkg/operation"
+)
 
 // AzureBotanist is a struct which has methods that perform Azure cloud-specific operations for a Shoot cluster.
 type AzureBotanist struct {
 	*operation.Operation
 	CloudProviderName string
+
+	infrastructureState operation.InfrastructureStateCache
+	// guard rate limits and circuit breaks the Terraform runs, which are shared by all botanists with the same
+	// credentials.
+	guard *cloudapi.Guard
 }
 
 const (
@@ -23,8 +23,11 @@ import (
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist/azurebotanist"
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist/gcpbotanist"
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist/localbotanist"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/mockbotanist"
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist/openstackbotanist"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/packetbotanist"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
 )
 
 // New creates a Cloud Botanist for the specific cloud provider of the operation.
@@ -51,9 +54,15 @@ func New(o *operation.Operation, purpose string) (CloudBotanist, error) {
 		return gcpbotanist.New(o, purpose)
 	case gardenv1beta1.CloudProviderOpenStack:
 		return openstackbotanist.New(o, purpose)
+	case gardenv1beta1.CloudProviderPacket:
+		return packetbotanist.New(o, purpose)
 	case gardenv1beta1.CloudProviderLocal:
+		// Local Shoots with worker groups simulate their machines instead of asking the local service for a node.
+		if purpose == common.CloudPurposeShoot && o.Shoot.SimulatesMachines() {
+			return mockbotanist.New(o)
+		}
 		return localbotanist.New(o)
 	default:
-		return nil, errors.New("unsupported cloud provider")
+		return nil, operationerrors.Errorf(operationerrors.ClassConfiguration, "unsupported cloud provider %q", cloudProvider)
 	}
 }
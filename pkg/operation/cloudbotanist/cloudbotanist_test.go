@@ -0,0 +1,79 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudbotanist_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/localbotanist"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/mockbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CloudBotanist", func() {
+	Describe("#New", func() {
+		var (
+			domain = "bar.foo.example.com"
+			o      *operation.Operation
+		)
+
+		BeforeEach(func() {
+			o = &operation.Operation{
+				Shoot: &shoot.Shoot{
+					Info: &gardenv1beta1.Shoot{
+						Spec: gardenv1beta1.ShootSpec{
+							Cloud: gardenv1beta1.Cloud{
+								Local: &gardenv1beta1.Local{Endpoint: "localhost:3777"},
+							},
+							DNS: gardenv1beta1.DNS{Domain: &domain},
+						},
+					},
+					CloudProvider: gardenv1beta1.CloudProviderLocal,
+				},
+			}
+		})
+
+		It("should return the local botanist for a local Shoot without workers", func() {
+			cloudBotanist, err := New(o, common.CloudPurposeShoot)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cloudBotanist).To(BeAssignableToTypeOf(&localbotanist.LocalBotanist{}))
+		})
+
+		It("should return the mock botanist for a local Shoot whose machines are simulated", func() {
+			o.Shoot.Info.Spec.Cloud.Local.Workers = []gardenv1beta1.LocalWorker{
+				{
+					Worker: gardenv1beta1.Worker{Name: "small", MachineType: "small", AutoScalerMin: 1, AutoScalerMax: 1},
+				},
+			}
+
+			cloudBotanist, err := New(o, common.CloudPurposeShoot)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cloudBotanist).To(BeAssignableToTypeOf(&mockbotanist.MockBotanist{}))
+		})
+
+		It("should fail for an unsupported purpose", func() {
+			_, err := New(o, "foo")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -21,5 +21,6 @@ func (b *GCPBotanist) GenerateCloudConfigUserDataConfig() *common.CloudConfigUse
 	return &common.CloudConfigUserDataConfig{
 		WorkerNames:      b.Shoot.GetWorkerNames(),
 		HostnameOverride: true,
+		LocalSSDDevices:  []string{"/dev/disk/by-id/google-local-ssd-*", "/dev/disk/by-id/google-local-nvme-ssd-*"},
 	}
 }
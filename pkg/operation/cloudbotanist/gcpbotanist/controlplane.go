@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"path"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
@@ -81,11 +82,20 @@ func (b *GCPBotanist) GenerateKubeSchedulerConfig() (map[string]interface{}, err
 
 // GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart.
 func (b *GCPBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID), common.BackupSecretName)
+}
+
+// GenerateEtcdRestoreConfig returns the configuration for restoring the etcd of a cloned Shoot from the backups of the
+// <source> Shoot for the etcd Helm chart.
+func (b *GCPBotanist) GenerateEtcdRestoreConfig(source *gardenv1beta1.Shoot) (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(source.Status.TechnicalID, source.Status.UID), common.RestoreSecretName)
+}
+
+func (b *GCPBotanist) generateEtcdBackupConfig(backupInfrastructureName, secretName string) (map[string][]byte, map[string]interface{}, error) {
 	var (
-		mountPath                = "/root/.gcp/"
-		bucketName               = "bucketName"
-		backupInfrastructureName = common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
-		backupNamespace          = common.GenerateBackupNamespaceName(backupInfrastructureName)
+		mountPath       = "/root/.gcp/"
+		bucketName      = "bucketName"
+		backupNamespace = common.GenerateBackupNamespaceName(backupInfrastructureName)
 	)
 
 	stateVariables, err := terraformer.New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, backupInfrastructureName, backupNamespace, b.ImageVector).GetStateOutputVariables(bucketName)
@@ -111,7 +121,7 @@ func (b *GCPBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]
 		"volumeMounts": []map[string]interface{}{
 			{
 				"mountPath": mountPath,
-				"name":      common.BackupSecretName,
+				"name":      secretName,
 			},
 		},
 	}
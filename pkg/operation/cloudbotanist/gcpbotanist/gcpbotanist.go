@@ -20,8 +20,10 @@ import (
 	"errors"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
 
 // New takes an operation object <o> and creates a new GCPBotanist object.
@@ -74,6 +76,7 @@ func New(o *operation.Operation, purpose string) (*GCPBotanist, error) {
 		VPCName:                vpcName,
 		Project:                project,
 		MinifiedServiceAccount: minifiedServiceAccount,
+		guard:                  cloudapi.GuardFor(string(gardenv1beta1.CloudProviderGCP), terraformer.IsAPIFailure, project, minifiedServiceAccount),
 	}, nil
 }
 
@@ -15,12 +15,18 @@
 package gcpbotanist
 
 import (
+	"regexp"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
 func (b *GCPBotanist) DeployInfrastructure() error {
+	defer b.infrastructureState.Invalidate()
+
 	var (
 		vpcName   = "${google_compute_network.network.name}"
 		createVPC = true
@@ -36,15 +42,30 @@ func (b *GCPBotanist) DeployInfrastructure() error {
 		NewFromOperation(b.Operation, common.TerraformerPurposeInfra).
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
 		DefineConfig("gcp-infra", b.generateTerraformInfraConfig(createVPC, vpcName)).
+		SetGuard(b.guard).
 		Apply()
 }
 
-// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
+// dependencyRegexps match the identifiers of the resources which GCP reports as still using a network, subnetwork
+// or firewall rule.
+var dependencyRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`is already being used by '([^']+)'`),
+}
+
+// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure. If the deletion fails because
+// of dependency conflicts, the returned error lists the resources which are involved.
 func (b *GCPBotanist) DestroyInfrastructure() error {
-	return terraformer.
+	defer b.infrastructureState.Invalidate()
+
+	err := terraformer.
 		NewFromOperation(b.Operation, common.TerraformerPurposeInfra).
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
+		SetGuard(b.guard).
 		Destroy()
+	if err != nil {
+		return terraformer.DependenciesError(err, terraformer.FindBlockingResources(err, dependencyRegexps...))
+	}
+	return nil
 }
 
 // generateTerraformInfraVariablesEnvironment generates the environment containing the credentials which
@@ -79,15 +100,62 @@ func (b *GCPBotanist) generateTerraformInfraConfig(createVPC bool, vpcName strin
 			"services": b.Shoot.GetServiceNetwork(),
 			"worker":   b.Shoot.Info.Spec.Cloud.GCP.Networks.Workers[0],
 		},
+		"workerFirewallRules": common.GenerateWorkerFirewallRulesConfig(b.Shoot.GetWorkers()),
 	}
 }
 
+// GetInfrastructureStatus reads the identifiers of the provisioned infrastructure resources (VPC, subnet and
+// service account) from the Terraform state.
+func (b *GCPBotanist) GetInfrastructureStatus() (*gardenv1beta1.ShootCloudStatus, error) {
+	var (
+		vpcName             = "vpc_name"
+		subnetNodes         = "subnet_nodes"
+		serviceAccountEmail = "service_account_email"
+	)
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(vpcName, subnetNodes, serviceAccountEmail)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gardenv1beta1.ShootCloudStatus{
+		Network:  stateVariables[vpcName],
+		Subnets:  []string{stateVariables[subnetNodes]},
+		IAMRoles: []string{stateVariables[serviceAccountEmail]},
+	}, nil
+}
+
+// GetInfrastructureState returns the outputs of the infrastructure which are required to create the machines (subnet
+// and service account). They are read from the Terraform state once and cached until the infrastructure is applied or
+// destroyed again.
+func (b *GCPBotanist) GetInfrastructureState() (*operation.InfrastructureState, error) {
+	return b.infrastructureState.Get(b.readInfrastructureState)
+}
+
+func (b *GCPBotanist) readInfrastructureState() (*operation.InfrastructureState, error) {
+	var (
+		serviceAccountEmail = "service_account_email"
+		subnetNodes         = "subnet_nodes"
+	)
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(serviceAccountEmail, subnetNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operation.InfrastructureState{
+		Subnets:        []string{stateVariables[subnetNodes]},
+		ServiceAccount: stateVariables[serviceAccountEmail],
+	}, nil
+}
+
 // DeployBackupInfrastructure kicks off a Terraform job which deploys the infrastructure resources for backup.
 func (b *GCPBotanist) DeployBackupInfrastructure() error {
 	return terraformer.
 		New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, b.BackupInfrastructure.Name, common.GenerateBackupNamespaceName(b.BackupInfrastructure.Name), b.ImageVector).
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
 		DefineConfig("gcp-backup", b.generateTerraformBackupConfig()).
+		SetGuard(b.guard).
 		Apply()
 }
 
@@ -96,6 +164,7 @@ func (b *GCPBotanist) DestroyBackupInfrastructure() error {
 	return terraformer.
 		New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, b.BackupInfrastructure.Name, common.GenerateBackupNamespaceName(b.BackupInfrastructure.Name), b.ImageVector).
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
+		SetGuard(b.guard).
 		Destroy()
 }
 
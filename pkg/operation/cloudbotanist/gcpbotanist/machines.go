@@ -17,12 +17,17 @@ package gcpbotanist
 import (
 	"fmt"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 )
 
+// maxUserDataSize is the maximum size in bytes of a metadata value of GCE instances (256 KiB).
+const maxUserDataSize = 262144
+
 // GetMachineClassInfo returns the name of the class kind, the plural of it and the name of the Helm chart which
 // contains the machine class template.
 func (b *GCPBotanist) GetMachineClassInfo() (classKind, classPlural, classChartName string) {
@@ -35,8 +40,9 @@ func (b *GCPBotanist) GetMachineClassInfo() (classKind, classPlural, classChartN
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
 func (b *GCPBotanist) GenerateMachineClassSecretData() map[string][]byte {
+	credentials := b.Shoot.GetMachineCredentials()
 	return map[string][]byte{
-		machinev1alpha1.GCPServiceAccountJSON: b.Shoot.Secret.Data[ServiceAccountJSON],
+		machinev1alpha1.GCPServiceAccountJSON: credentials[ServiceAccountJSON],
 	}
 }
 
@@ -45,29 +51,57 @@ func (b *GCPBotanist) GenerateMachineClassSecretData() map[string][]byte {
 // the desired availability zones. It returns the computed list of MachineClasses and MachineDeployments.
 func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
 	var (
-		serviceAccountEmail = "service_account_email"
-		subnetNodes         = "subnet_nodes"
-		outputVariables     = []string{serviceAccountEmail, subnetNodes}
-		workers             = b.Shoot.Info.Spec.Cloud.GCP.Workers
-		zones               = b.Shoot.Info.Spec.Cloud.GCP.Zones
-		zoneLen             = len(zones)
+		workers = b.Shoot.Info.Spec.Cloud.GCP.Workers
+		zones   = b.Shoot.Info.Spec.Cloud.GCP.Zones
+		zoneLen = len(zones)
 
 		machineDeployments = []operation.MachineDeployment{}
 		machineClasses     = []map[string]interface{}{}
 	)
 
-	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(outputVariables...)
+	infrastructureState, err := b.GetInfrastructureState()
 	if err != nil {
 		return nil, nil, err
 	}
 
 	for zoneIndex, zone := range zones {
 		for _, worker := range workers {
-			cloudConfig, err := b.ComputeDownloaderCloudConfig(worker.Name)
+			userData, err := b.ComputeDownloaderUserData(worker.Name, maxUserDataSize)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			image, err := b.Shoot.GetWorkerMachineImage(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+			machineImage := image.(*gardenv1beta1.GCPMachineImage)
+
+			annotations, err := b.Shoot.ComputeMachineDeploymentAnnotations(worker.Worker)
 			if err != nil {
 				return nil, nil, err
 			}
 
+			// GPUs are attached to GCE instances as accelerators which are not part of the machine type, and the machine
+			// classes do not support them.
+			gpus, err := b.Shoot.GetWorkerGPUs(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+			if gpus > 0 {
+				return nil, nil, operationerrors.Errorf(operationerrors.ClassConfiguration, "GPU machine type %s of worker %s is not supported on GCP", worker.MachineType, worker.Name)
+			}
+
+			// The nodes of worker groups with firewall rules carry an additional tag which is targeted by the rules.
+			tags := []string{
+				b.Shoot.SeedNamespace,
+				fmt.Sprintf("kubernetes-io-cluster-%s", b.Shoot.SeedNamespace),
+				"kubernetes-io-role-node",
+			}
+			if len(worker.FirewallRules) > 0 {
+				tags = append(tags, fmt.Sprintf("%s-%s", b.Shoot.SeedNamespace, worker.Name))
+			}
+
 			machineClassSpec := map[string]interface{}{
 				"region":             b.Shoot.Info.Spec.Cloud.Region,
 				"zone":               zone,
@@ -80,7 +114,7 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 						"boot":       true,
 						"sizeGb":     common.DiskSize(worker.VolumeSize),
 						"type":       worker.VolumeType,
-						"image":      b.Shoot.Info.Spec.Cloud.GCP.MachineImage.Image,
+						"image":      machineImage.Image,
 						"labels": map[string]interface{}{
 							"name": b.Shoot.Info.Name,
 						},
@@ -92,51 +126,132 @@ func (b *GCPBotanist) GenerateMachineConfig() ([]map[string]interface{}, []opera
 				"machineType": worker.MachineType,
 				"networkInterfaces": []map[string]interface{}{
 					{
-						"subnetwork": stateVariables[subnetNodes],
+						"subnetwork": infrastructureState.Subnet(zoneIndex),
 					},
 				},
-				"scheduling": map[string]interface{}{
-					"automaticRestart":  true,
-					"onHostMaintenance": "MIGRATE",
-					"preemptible":       false,
-				},
+				"scheduling": scheduling(preemptible(worker)),
 				"secret": map[string]interface{}{
-					"cloudConfig": cloudConfig.FileContent("cloud-config.yaml"),
+					"cloudConfig": userData,
 				},
 				"serviceAccounts": []map[string]interface{}{
 					{
-						"email": stateVariables[serviceAccountEmail],
+						"email": infrastructureState.ServiceAccount,
 						"scopes": []string{
 							"https://www.googleapis.com/auth/compute",
 						},
 					},
 				},
-				"tags": []string{
-					b.Shoot.SeedNamespace,
-					fmt.Sprintf("kubernetes-io-cluster-%s", b.Shoot.SeedNamespace),
-					"kubernetes-io-role-node",
-				},
+				"tags": tags,
+			}
+			if worker.LocalSSDs != nil {
+				machineClassSpec["disks"] = append(machineClassSpec["disks"].([]map[string]interface{}), localSSDDisks(*worker.LocalSSDs)...)
+			}
+
+			// Spot worker groups which fall back to on-demand VMs while there is no spare capacity get an additional
+			// machine class for regular VMs.
+			var fallbackClassSpec map[string]interface{}
+			if worker.Spot != nil && worker.Spot.FallbackToOnDemand != nil && *worker.Spot.FallbackToOnDemand {
+				fallbackClassSpec = utils.MergeMaps(machineClassSpec, map[string]interface{}{
+					"scheduling": scheduling(false),
+					"secret": map[string]interface{}{
+						"cloudConfig": userData,
+					},
+				})
 			}
 
 			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 				secretData           = b.GenerateMachineClassSecretData()
+				machineClassSpecHash = b.Shoot.ComputeMachineClassHash(worker.Name, machineClassSpec, secretData)
+				deploymentName       = common.ZonedMachineDeploymentName(b.Shoot.SeedNamespace, worker.Name, zoneIndex)
+				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+				fallbackClassName    string
 			)
+			if fallbackClassSpec != nil {
+				fallbackClassName = fmt.Sprintf("%s-%s", deploymentName, b.Shoot.ComputeMachineClassHash(worker.Name, fallbackClassSpec, secretData))
+			}
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:      deploymentName,
-				ClassName: className,
-				Replicas:  common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Name:              deploymentName,
+				WorkerName:        worker.Name,
+				ClassName:         className,
+				Minimum:           common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, zoneLen),
+				Maximum:           common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Labels:            worker.Labels,
+				Annotations:       annotations,
+				Cordoned:          worker.Cordoned != nil && *worker.Cordoned,
+				MaxSurge:          worker.MaxSurge,
+				MaxUnavailable:    worker.MaxUnavailable,
+				MinReadySeconds:   worker.MinReadySeconds,
+				FallbackClassName: fallbackClassName,
 			})
 
-			machineClassSpec["name"] = className
-			machineClassSpec["secret"].(map[string]interface{})["serviceAccountJSON"] = string(secretData[machinev1alpha1.GCPServiceAccountJSON])
-
-			machineClasses = append(machineClasses, machineClassSpec)
+			addMachineClass := func(name string, spec map[string]interface{}) {
+				spec["name"] = name
+				spec["secret"].(map[string]interface{})["serviceAccountJSON"] = string(secretData[machinev1alpha1.GCPServiceAccountJSON])
+				machineClasses = append(machineClasses, spec)
+			}
+			addMachineClass(className, machineClassSpec)
+			if fallbackClassSpec != nil {
+				addMachineClass(fallbackClassName, fallbackClassSpec)
+			}
 		}
 	}
 
 	return machineClasses, machineDeployments, nil
 }
+
+// preemptible returns whether the given <worker> uses preemptible VMs, i.e. whether it is a spot worker group or
+// has set the preemptible flag.
+func preemptible(worker gardenv1beta1.GCPWorker) bool {
+	return worker.Spot != nil || (worker.Preemptible != nil && *worker.Preemptible)
+}
+
+// localSSDDisks returns the disks of a machine class for the given <localSSDs>. Local SSDs are scratch disks of a fixed
+// size which are deleted together with the VM.
+func localSSDDisks(localSSDs gardenv1beta1.WorkerLocalSSDs) []map[string]interface{} {
+	diskInterface := gardenv1beta1.LocalSSDInterfaceNVME
+	if localSSDs.Interface != nil {
+		diskInterface = *localSSDs.Interface
+	}
+
+	disks := make([]map[string]interface{}, 0, localSSDs.Count)
+	for i := int32(0); i < localSSDs.Count; i++ {
+		disks = append(disks, map[string]interface{}{
+			"autoDelete": true,
+			"boot":       false,
+			"sizeGb":     375,
+			"type":       "SCRATCH",
+			"interface":  diskInterface,
+		})
+	}
+	return disks
+}
+
+// scheduling returns the scheduling configuration of a machine class for <preemptible> or regular VMs. Preemptible
+// VMs can neither be restarted automatically nor be migrated during host maintenance.
+func scheduling(preemptible bool) map[string]interface{} {
+	if preemptible {
+		return map[string]interface{}{
+			"automaticRestart":  false,
+			"onHostMaintenance": "TERMINATE",
+			"preemptible":       true,
+		}
+	}
+	return map[string]interface{}{
+		"automaticRestart":  true,
+		"onHostMaintenance": "MIGRATE",
+		"preemptible":       false,
+	}
+}
+
+// ListMachineInstanceIDs returns the IDs of the instances which exist for the machines of the Shoot. Listing the
+// instances is not yet supported for GCP, hence, the second return value is false.
+func (b *GCPBotanist) ListMachineInstanceIDs() ([]string, bool, error) {
+	return nil, false, nil
+}
+
+// CheckMachineCapacity checks whether the cloud provider has the capacity for the given machine classes and
+// deployments. The check is not yet supported for GCP, hence, the first return value is false.
+func (b *GCPBotanist) CheckMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) (bool, error) {
+	return false, nil
+}
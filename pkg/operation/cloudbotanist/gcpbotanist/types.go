@@ -14,7 +14,10 @@
 
 package gcpbotanist
 
-import "github.com/gardener/gardener/pkg/operation"
+import (
+	"github.com/gardener/gardener/pkg/client/cloudapi"
+	"github.com/gardener/gardener/pkg/operation"
+)
 
 // GCPBotanist is a struct which has methods that perform GCP cloud-specific operations for a Shoot cluster.
 type GCPBotanist struct {
@@ -23,6 +26,11 @@ type GCPBotanist struct {
 	VPCName                string
 	Project                string
 	MinifiedServiceAccount string
+
+	infrastructureState operation.InfrastructureStateCache
+	// guard rate limits and circuit breaks the Terraform runs, which are shared by all botanists with the same
+	// credentials.
+	guard *cloudapi.Guard
 }
 
 const (
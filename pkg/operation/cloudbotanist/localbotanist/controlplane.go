@@ -14,6 +14,10 @@
 
 package localbotanist
 
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
 // GenerateCloudProviderConfig returns a cloud provider config for the Local cloud provider.
 // Not needed on Local.
 func (b *LocalBotanist) GenerateCloudProviderConfig() (string, error) {
@@ -60,3 +64,10 @@ func (b *LocalBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[strin
 
 	return nil, backupConfigData, nil
 }
+
+// GenerateEtcdRestoreConfig returns the configuration for restoring the etcd of a cloned Shoot from the backups of the
+// <source> Shoot for the etcd Helm chart.
+// Not needed on Local as it does not support backups.
+func (b *LocalBotanist) GenerateEtcdRestoreConfig(source *gardenv1beta1.Shoot) (map[string][]byte, map[string]interface{}, error) {
+	return nil, nil, nil
+}
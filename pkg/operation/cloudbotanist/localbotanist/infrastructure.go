@@ -19,8 +19,10 @@ import (
 
 	"path/filepath"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/local"
 	pb "github.com/gardener/gardener/pkg/localprovider"
+	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"golang.org/x/net/context"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -72,6 +74,17 @@ func (b *LocalBotanist) DestroyInfrastructure() error {
 	return err
 }
 
+// GetInfrastructureStatus returns nil as the local provider does not provision any infrastructure resources.
+func (b *LocalBotanist) GetInfrastructureStatus() (*gardenv1beta1.ShootCloudStatus, error) {
+	return nil, nil
+}
+
+// GetInfrastructureState returns an empty state as the machines of the local provider do not need any infrastructure
+// resources.
+func (b *LocalBotanist) GetInfrastructureState() (*operation.InfrastructureState, error) {
+	return &operation.InfrastructureState{}, nil
+}
+
 // DeployBackupInfrastructure kicks off a Terraform job which creates the infrastructure resources for backup.
 func (b *LocalBotanist) DeployBackupInfrastructure() error {
 	return nil
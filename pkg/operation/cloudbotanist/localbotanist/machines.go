@@ -39,3 +39,15 @@ func (b *LocalBotanist) GenerateMachineClassSecretData() map[string][]byte {
 func (b *LocalBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
 	return nil, nil, nil
 }
+
+// ListMachineInstanceIDs returns the IDs of the instances which exist for the machines of the Shoot. Listing the
+// instances is not yet supported for the local provider, hence, the second return value is false.
+func (b *LocalBotanist) ListMachineInstanceIDs() ([]string, bool, error) {
+	return nil, false, nil
+}
+
+// CheckMachineCapacity checks whether the cloud provider has the capacity for the given machine classes and
+// deployments. The check is not yet supported for the local provider, hence, the first return value is false.
+func (b *LocalBotanist) CheckMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) (bool, error) {
+	return false, nil
+}
@@ -0,0 +1,28 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockbotanist
+
+// DeployInfrastructure does nothing as the simulated machines do not need any infrastructure. In particular, the
+// local service is not asked to create a node.
+func (b *MockBotanist) DeployInfrastructure() error {
+	return nil
+}
+
+// DestroyInfrastructure stops the fake machine-controller-manager of the Shoot. It is called once all machines have
+// been deleted, hence, it is not needed anymore.
+func (b *MockBotanist) DestroyInfrastructure() error {
+	StopMachineControllerManager(b.Shoot.SeedNamespace)
+	return nil
+}
@@ -0,0 +1,450 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockbotanist
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	machineclientset "github.com/gardener/gardener/pkg/client/machine/clientset/versioned"
+	"github.com/gardener/gardener/pkg/logger"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// machineControllerManagerSyncPeriod is the interval in which the fake machine-controller-managers reconcile the
+// machine resources.
+const machineControllerManagerSyncPeriod = 5 * time.Second
+
+var (
+	machineControllerManagers      = map[string]*MachineControllerManager{}
+	machineControllerManagersMutex sync.Mutex
+)
+
+// StartMachineControllerManager starts a fake machine-controller-manager for the machine resources in the given
+// <namespace> of the Seed unless one is already running for it. It runs in the Gardener itself until it is stopped,
+// hence, it has to be started again after a restart of the Gardener.
+func StartMachineControllerManager(client machineclientset.Interface, namespace string) {
+	machineControllerManagersMutex.Lock()
+	defer machineControllerManagersMutex.Unlock()
+
+	if _, ok := machineControllerManagers[namespace]; ok {
+		return
+	}
+
+	m := NewMachineControllerManager(client, namespace)
+	m.start(machineControllerManagerSyncPeriod)
+	machineControllerManagers[namespace] = m
+}
+
+// StopMachineControllerManager stops the fake machine-controller-manager for the given <namespace> if one is running.
+func StopMachineControllerManager(namespace string) {
+	machineControllerManagersMutex.Lock()
+	defer machineControllerManagersMutex.Unlock()
+
+	if m, ok := machineControllerManagers[namespace]; ok {
+		m.stop()
+		delete(machineControllerManagers, namespace)
+	}
+}
+
+// MachineControllerManager simulates the machine-controller-manager for the machine resources in a namespace of a
+// Seed. In every reconciliation, it creates a machine set per machine class of every machine deployment and moves the
+// machines of the deployment to the machine set of its current class in a rolling update (new machines are created
+// first, old machines are only removed once the new ones are running). It moves the machines from Pending to Running,
+// and from Terminating to deleted, and it maintains the status of the machine sets and machine deployments. The
+// machines are not backed by any instance, hence, they never join the Shoot as nodes.
+type MachineControllerManager struct {
+	client    machineclientset.Interface
+	namespace string
+
+	mutex   sync.Mutex
+	counter int
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// NewMachineControllerManager creates a new fake machine-controller-manager for the machine resources in the given
+// <namespace>. It does not reconcile anything until it is started or Reconcile is called.
+func NewMachineControllerManager(client machineclientset.Interface, namespace string) *MachineControllerManager {
+	return &MachineControllerManager{
+		client:    client,
+		namespace: namespace,
+	}
+}
+
+// start reconciles the machine resources in the given <interval> until the fake machine-controller-manager is stopped.
+func (m *MachineControllerManager) start(interval time.Duration) {
+	m.stopCh = make(chan struct{})
+	m.doneCh = make(chan struct{})
+
+	go func() {
+		defer close(m.doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				if err := m.Reconcile(); err != nil {
+					logger.Logger.Errorf("Failed to reconcile the simulated machines in namespace %s: %v", m.namespace, err)
+				}
+			case <-m.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// stop stops the reconciliations and waits until the current one has finished.
+func (m *MachineControllerManager) stop() {
+	close(m.stopCh)
+	<-m.doneCh
+}
+
+// Reconcile performs a single reconciliation of all machine resources. Changes which conflict with concurrent changes
+// of the Gardener are dropped, they are retried in the next reconciliation.
+func (m *MachineControllerManager) Reconcile() error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if err := m.reconcileMachines(); err != nil {
+		return err
+	}
+
+	deploymentList, err := m.client.MachineV1alpha1().MachineDeployments(m.namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	machineSetList, err := m.client.MachineV1alpha1().MachineSets(m.namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	deployments := map[string]*machinev1alpha1.MachineDeployment{}
+	for i := range deploymentList.Items {
+		deployments[deploymentList.Items[i].Name] = &deploymentList.Items[i]
+	}
+	setsByDeployment := map[string][]*machinev1alpha1.MachineSet{}
+	for i := range machineSetList.Items {
+		machineSet := &machineSetList.Items[i]
+		name := ownerName(machineSet.OwnerReferences, "MachineDeployment")
+		setsByDeployment[name] = append(setsByDeployment[name], machineSet)
+	}
+
+	// Machine sets whose machine deployment has been deleted are scaled down and deleted once their machines are gone.
+	for name, machineSets := range setsByDeployment {
+		if _, ok := deployments[name]; ok {
+			continue
+		}
+		for _, machineSet := range machineSets {
+			machines, err := m.machinesOf(machineSet.Name)
+			if err != nil {
+				return err
+			}
+			if len(machines) == 0 {
+				if err := m.client.MachineV1alpha1().MachineSets(m.namespace).Delete(machineSet.Name, nil); ignoreConflict(err) != nil {
+					return err
+				}
+				continue
+			}
+			if err := m.scaleMachineSet(machineSet, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, deployment := range deployments {
+		if err := m.reconcileMachineDeployment(deployment, setsByDeployment[deployment.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileMachines moves the pending machines to Running and deletes the terminating machines.
+func (m *MachineControllerManager) reconcileMachines() error {
+	machineList, err := m.client.MachineV1alpha1().Machines(m.namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for i := range machineList.Items {
+		machine := &machineList.Items[i]
+
+		switch machine.Status.CurrentStatus.Phase {
+		case machinev1alpha1.MachinePending:
+			machine.Status.CurrentStatus = machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineRunning, LastUpdateTime: metav1.Now()}
+			machine.Status.LastOperation = machinev1alpha1.LastOperation{
+				Description:    "Machine is simulated",
+				State:          machinev1alpha1.MachineStateSuccessful,
+				Type:           "Create",
+				LastUpdateTime: metav1.Now(),
+			}
+			if _, err := m.client.MachineV1alpha1().Machines(m.namespace).Update(machine); ignoreConflict(err) != nil {
+				return err
+			}
+		case machinev1alpha1.MachineTerminating:
+			if err := m.client.MachineV1alpha1().Machines(m.namespace).Delete(machine.Name, nil); ignoreConflict(err) != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reconcileMachineDeployment rolls the machines of the given <deployment> out to the machine set of its current machine
+// class. The other (old) <machineSets> of the deployment are scaled down by the number of running machines of the new
+// machine set, hence, the deployment never has less running machines than desired during the rolling update.
+func (m *MachineControllerManager) reconcileMachineDeployment(deployment *machinev1alpha1.MachineDeployment, machineSets []*machinev1alpha1.MachineSet) error {
+	var (
+		className = deployment.Spec.Template.Spec.Class.Name
+		newSet    *machinev1alpha1.MachineSet
+		oldSets   []*machinev1alpha1.MachineSet
+	)
+	for _, machineSet := range machineSets {
+		if machineSet.Spec.Template.Spec.Class.Name == className {
+			newSet = machineSet
+		} else {
+			oldSets = append(oldSets, machineSet)
+		}
+	}
+
+	if newSet == nil {
+		created, err := m.client.MachineV1alpha1().MachineSets(m.namespace).Create(&machinev1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            className,
+				Namespace:       m.namespace,
+				Labels:          deployment.Spec.Template.Labels,
+				OwnerReferences: []metav1.OwnerReference{ownerReference(deployment.Name, deployment.UID, "MachineDeployment")},
+			},
+			Spec: machinev1alpha1.MachineSetSpec{
+				Replicas:     deployment.Spec.Replicas,
+				Selector:     deployment.Spec.Selector,
+				MachineClass: deployment.Spec.Template.Spec.Class,
+				Template:     deployment.Spec.Template,
+			},
+		})
+		if err != nil {
+			return ignoreConflict(err)
+		}
+		newSet = created
+	}
+	if err := m.scaleMachineSet(newSet, deployment.Spec.Replicas); err != nil {
+		return err
+	}
+
+	newMachines, err := m.machinesOf(newSet.Name)
+	if err != nil {
+		return err
+	}
+	remaining := deployment.Spec.Replicas - runningMachines(newMachines)
+	if remaining < 0 {
+		remaining = 0
+	}
+	sort.Slice(oldSets, func(i, j int) bool { return oldSets[i].Name < oldSets[j].Name })
+	for _, oldSet := range oldSets {
+		replicas := oldSet.Spec.Replicas
+		if replicas > remaining {
+			replicas = remaining
+		}
+		remaining -= replicas
+		if err := m.scaleMachineSet(oldSet, replicas); err != nil {
+			return err
+		}
+	}
+
+	// The status of the machine sets is updated before the status of the machine deployment, hence, a machine
+	// deployment is only reported to be rolled out once its old machine sets have been scaled down.
+	status := machinev1alpha1.MachineDeploymentStatus{ObservedGeneration: deployment.Generation}
+	for _, machineSet := range append(oldSets, newSet) {
+		machines, err := m.machinesOf(machineSet.Name)
+		if err != nil {
+			return err
+		}
+		if err := m.updateMachineSetStatus(machineSet, machines); err != nil {
+			return err
+		}
+
+		status.Replicas += int32(len(machines))
+		status.ReadyReplicas += runningMachines(machines)
+		if machineSet == newSet {
+			status.UpdatedReplicas = int32(len(machines)) - terminatingMachines(machines)
+		}
+	}
+	status.AvailableReplicas = status.ReadyReplicas
+	if status.UnavailableReplicas = deployment.Spec.Replicas - status.AvailableReplicas; status.UnavailableReplicas < 0 {
+		status.UnavailableReplicas = 0
+	}
+
+	if equality.Semantic.DeepEqual(deployment.Status, status) {
+		return nil
+	}
+	// The machine CRDs of the Seed have no status subresource, hence, the status is written with a regular update.
+	deployment.Status = status
+	_, err = m.client.MachineV1alpha1().MachineDeployments(m.namespace).Update(deployment)
+	return ignoreConflict(err)
+}
+
+// scaleMachineSet sets the replicas of the given <machineSet> and creates or terminates its machines accordingly. The
+// machines which are not running are terminated first.
+func (m *MachineControllerManager) scaleMachineSet(machineSet *machinev1alpha1.MachineSet, replicas int32) error {
+	if machineSet.Spec.Replicas != replicas {
+		machineSet.Spec.Replicas = replicas
+		updated, err := m.client.MachineV1alpha1().MachineSets(m.namespace).Update(machineSet)
+		if err != nil {
+			return ignoreConflict(err)
+		}
+		*machineSet = *updated
+	}
+
+	machines, err := m.machinesOf(machineSet.Name)
+	if err != nil {
+		return err
+	}
+	var active []*machinev1alpha1.Machine
+	for _, machine := range machines {
+		if machine.Status.CurrentStatus.Phase != machinev1alpha1.MachineTerminating {
+			active = append(active, machine)
+		}
+	}
+
+	for i := int32(len(active)); i < replicas; i++ {
+		m.counter++
+		name := fmt.Sprintf("%s-%d-%d", machineSet.Name, time.Now().Unix(), m.counter)
+		machine := &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       m.namespace,
+				Labels:          machineSet.Spec.Template.Labels,
+				OwnerReferences: []metav1.OwnerReference{ownerReference(machineSet.Name, machineSet.UID, "MachineSet")},
+			},
+			Spec: machinev1alpha1.MachineSpec{
+				Class:      machineSet.Spec.Template.Spec.Class,
+				ProviderID: fmt.Sprintf("local:///%s/%s", m.namespace, name),
+			},
+			Status: machinev1alpha1.MachineStatus{
+				CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachinePending, LastUpdateTime: metav1.Now()},
+			},
+		}
+		if _, err := m.client.MachineV1alpha1().Machines(m.namespace).Create(machine); err != nil {
+			return err
+		}
+	}
+
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].Status.CurrentStatus.Phase != machinev1alpha1.MachineRunning && active[j].Status.CurrentStatus.Phase == machinev1alpha1.MachineRunning
+	})
+	for i := 0; i < len(active)-int(replicas); i++ {
+		machine := active[i]
+		machine.Status.CurrentStatus = machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineTerminating, LastUpdateTime: metav1.Now()}
+		if _, err := m.client.MachineV1alpha1().Machines(m.namespace).Update(machine); ignoreConflict(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateMachineSetStatus updates the status of the given <machineSet> with its <machines> if it has changed.
+func (m *MachineControllerManager) updateMachineSetStatus(machineSet *machinev1alpha1.MachineSet, machines []*machinev1alpha1.Machine) error {
+	running := runningMachines(machines)
+	status := machinev1alpha1.MachineSetStatus{
+		Replicas:             int32(len(machines)),
+		FullyLabeledReplicas: int32(len(machines)),
+		ReadyReplicas:        running,
+		AvailableReplicas:    running,
+		ObservedGeneration:   machineSet.Generation,
+	}
+
+	if equality.Semantic.DeepEqual(machineSet.Status, status) {
+		return nil
+	}
+	machineSet.Status = status
+	_, err := m.client.MachineV1alpha1().MachineSets(m.namespace).Update(machineSet)
+	return ignoreConflict(err)
+}
+
+// machinesOf returns the machines which are owned by the machine set with the given <name>.
+func (m *MachineControllerManager) machinesOf(name string) ([]*machinev1alpha1.Machine, error) {
+	machineList, err := m.client.MachineV1alpha1().Machines(m.namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var machines []*machinev1alpha1.Machine
+	for i := range machineList.Items {
+		if ownerName(machineList.Items[i].OwnerReferences, "MachineSet") == name {
+			machines = append(machines, &machineList.Items[i])
+		}
+	}
+	return machines, nil
+}
+
+func runningMachines(machines []*machinev1alpha1.Machine) int32 {
+	var running int32
+	for _, machine := range machines {
+		if machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineRunning {
+			running++
+		}
+	}
+	return running
+}
+
+func terminatingMachines(machines []*machinev1alpha1.Machine) int32 {
+	var terminating int32
+	for _, machine := range machines {
+		if machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineTerminating {
+			terminating++
+		}
+	}
+	return terminating
+}
+
+// ownerName returns the name of the owner of the given <kind> in the given <ownerReferences>, or an empty string.
+func ownerName(ownerReferences []metav1.OwnerReference, kind string) string {
+	for _, owner := range ownerReferences {
+		if owner.Kind == kind {
+			return owner.Name
+		}
+	}
+	return ""
+}
+
+func ownerReference(name string, uid types.UID, kind string) metav1.OwnerReference {
+	controller := true
+	return metav1.OwnerReference{
+		APIVersion: machinev1alpha1.SchemeGroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+		UID:        uid,
+		Controller: &controller,
+	}
+}
+
+// ignoreConflict returns nil if the given <err> is a conflict with a concurrent change or if the object is already
+// gone, otherwise it returns <err>.
+func ignoreConflict(err error) error {
+	if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/client/machine/clientset/versioned/fake"
+	. "github.com/gardener/gardener/pkg/operation/cloudbotanist/mockbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("MachineControllerManager", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		client *fake.Clientset
+		mcm    *MachineControllerManager
+
+		deployment = func(className string, replicas int32) *machinev1alpha1.MachineDeployment {
+			return &machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar-small-z1", Namespace: namespace, UID: types.UID("1234")},
+				Spec: machinev1alpha1.MachineDeploymentSpec{
+					Replicas: replicas,
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"name": "shoot--foo--bar-small-z1"}},
+					Template: machinev1alpha1.MachineTemplateSpec{
+						ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"name": "shoot--foo--bar-small-z1"}},
+						Spec: machinev1alpha1.MachineSpec{
+							Class: machinev1alpha1.ClassSpec{Kind: "LocalMachineClass", Name: className},
+						},
+					},
+				},
+			}
+		}
+		reconcile = func(times int) {
+			for i := 0; i < times; i++ {
+				Expect(mcm.Reconcile()).To(Succeed())
+			}
+		}
+		machines = func() []machinev1alpha1.Machine {
+			machineList, err := client.MachineV1alpha1().Machines(namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return machineList.Items
+		}
+		machineSets = func() []machinev1alpha1.MachineSet {
+			machineSetList, err := client.MachineV1alpha1().MachineSets(namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return machineSetList.Items
+		}
+		deploymentStatus = func() machinev1alpha1.MachineDeploymentStatus {
+			machineDeployment, err := client.MachineV1alpha1().MachineDeployments(namespace).Get("shoot--foo--bar-small-z1", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			return machineDeployment.Status
+		}
+		classesOfRunningMachines = func() []string {
+			var classes []string
+			for _, machine := range machines() {
+				if machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineRunning {
+					classes = append(classes, machine.Spec.Class.Name)
+				}
+			}
+			return classes
+		}
+	)
+
+	BeforeEach(func() {
+		client = fake.NewSimpleClientset()
+		mcm = NewMachineControllerManager(client, namespace)
+	})
+
+	It("should create the machines of a machine deployment and report them as available", func() {
+		_, err := client.MachineV1alpha1().MachineDeployments(namespace).Create(deployment("class-1", 2))
+		Expect(err).NotTo(HaveOccurred())
+
+		reconcile(1)
+		Expect(machineSets()).To(HaveLen(1))
+		Expect(machineSets()[0].Name).To(Equal("class-1"))
+		Expect(machineSets()[0].OwnerReferences[0].UID).To(Equal(types.UID("1234")))
+		Expect(machines()).To(HaveLen(2))
+		for _, machine := range machines() {
+			Expect(machine.Status.CurrentStatus.Phase).To(Equal(machinev1alpha1.MachinePending))
+			Expect(machine.Labels).To(Equal(map[string]string{"name": "shoot--foo--bar-small-z1"}))
+		}
+
+		reconcile(1)
+		Expect(classesOfRunningMachines()).To(Equal([]string{"class-1", "class-1"}))
+		Expect(deploymentStatus()).To(Equal(machinev1alpha1.MachineDeploymentStatus{
+			Replicas:          2,
+			ReadyReplicas:     2,
+			UpdatedReplicas:   2,
+			AvailableReplicas: 2,
+		}))
+	})
+
+	It("should roll the machines out to a new machine class without undercutting the desired replicas", func() {
+		_, err := client.MachineV1alpha1().MachineDeployments(namespace).Create(deployment("class-1", 2))
+		Expect(err).NotTo(HaveOccurred())
+		reconcile(2)
+
+		_, err = client.MachineV1alpha1().MachineDeployments(namespace).Update(deployment("class-2", 2))
+		Expect(err).NotTo(HaveOccurred())
+
+		reconcile(1)
+		Expect(classesOfRunningMachines()).To(ConsistOf("class-1", "class-1"))
+
+		reconcile(1)
+		Expect(classesOfRunningMachines()).To(ConsistOf("class-2", "class-2"))
+
+		reconcile(2)
+		Expect(machines()).To(HaveLen(2))
+		Expect(deploymentStatus().UpdatedReplicas).To(Equal(int32(2)))
+		Expect(deploymentStatus().Replicas).To(Equal(int32(2)))
+	})
+
+	It("should delete the machines and machine sets of a deleted machine deployment", func() {
+		_, err := client.MachineV1alpha1().MachineDeployments(namespace).Create(deployment("class-1", 2))
+		Expect(err).NotTo(HaveOccurred())
+		reconcile(2)
+
+		Expect(client.MachineV1alpha1().MachineDeployments(namespace).Delete("shoot--foo--bar-small-z1", nil)).To(Succeed())
+
+		reconcile(3)
+		Expect(machines()).To(BeEmpty())
+		Expect(machineSets()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockbotanist
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+)
+
+// GetMachineClassInfo returns the name of the class kind, the plural of it and the name of the Helm chart which
+// contains the machine class template.
+func (b *MockBotanist) GetMachineClassInfo() (classKind, classPlural, classChartName string) {
+	classKind = "LocalMachineClass"
+	classPlural = "localmachineclasses"
+	classChartName = "local-machineclass"
+	return
+}
+
+// GenerateMachineConfig generates the configuration values for the local machine class Helm chart. It also generates
+// a list of corresponding MachineDeployments, one per worker group as the simulated machines have no zones. It returns
+// the computed list of MachineClasses and MachineDeployments.
+func (b *MockBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
+	var (
+		workers = b.Shoot.Info.Spec.Cloud.Local.Workers
+
+		machineDeployments = []operation.MachineDeployment{}
+		machineClasses     = []map[string]interface{}{}
+	)
+
+	for _, worker := range workers {
+		userData, err := b.ComputeDownloaderUserData(worker.Name, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		annotations, err := b.Shoot.ComputeMachineDeploymentAnnotations(worker.Worker)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		machineClassSpec := map[string]interface{}{
+			"machineType": worker.MachineType,
+			"secret": map[string]interface{}{
+				"cloudConfig": userData,
+			},
+		}
+
+		var (
+			secretData           = b.GenerateMachineClassSecretData()
+			machineClassSpecHash = b.Shoot.ComputeMachineClassHash(worker.Name, machineClassSpec, secretData)
+			deploymentName       = common.ZonedMachineDeploymentName(b.Shoot.SeedNamespace, worker.Name, 0)
+			className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+		)
+
+		machineDeployments = append(machineDeployments, operation.MachineDeployment{
+			Name:            deploymentName,
+			WorkerName:      worker.Name,
+			ClassName:       className,
+			Minimum:         worker.AutoScalerMin,
+			Maximum:         worker.AutoScalerMax,
+			Labels:          worker.Labels,
+			Annotations:     annotations,
+			Cordoned:        worker.Cordoned != nil && *worker.Cordoned,
+			MaxSurge:        worker.MaxSurge,
+			MaxUnavailable:  worker.MaxUnavailable,
+			MinReadySeconds: worker.MinReadySeconds,
+		})
+
+		machineClassSpec["name"] = className
+		machineClasses = append(machineClasses, machineClassSpec)
+	}
+
+	return machineClasses, machineDeployments, nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockbotanist_test
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/cloudbotanist/mockbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// downloaderChartRenderer renders the cloud config downloader chart into a cloud config which names the rendered
+// release and the secret of the worker group.
+type downloaderChartRenderer struct{}
+
+func (downloaderChartRenderer) Render(chartPath, releaseName, namespace string, values map[string]interface{}) (*chartrenderer.RenderedChart, error) {
+	return &chartrenderer.RenderedChart{
+		ChartName: "downloader",
+		Files: map[string]string{
+			"downloader/templates/cloud-config.yaml": fmt.Sprintf("#cloud-config %s %s", releaseName, values["secretName"]),
+		},
+	}, nil
+}
+
+var _ = Describe("MockBotanist", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		domain = "bar.foo.example.com"
+		o      *operation.Operation
+
+		worker = func(name, machineType string, minimum, maximum int) gardenv1beta1.LocalWorker {
+			return gardenv1beta1.LocalWorker{
+				Worker: gardenv1beta1.Worker{
+					Name:          name,
+					MachineType:   machineType,
+					AutoScalerMin: minimum,
+					AutoScalerMax: maximum,
+				},
+			}
+		}
+	)
+
+	BeforeEach(func() {
+		o = &operation.Operation{
+			Shoot: &shoot.Shoot{
+				Info: &gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+					Spec: gardenv1beta1.ShootSpec{
+						Cloud: gardenv1beta1.Cloud{
+							Local: &gardenv1beta1.Local{
+								Endpoint: "localhost:3777",
+								Workers:  []gardenv1beta1.LocalWorker{worker("small", "small", 1, 2)},
+							},
+						},
+						DNS:        gardenv1beta1.DNS{Domain: &domain},
+						Kubernetes: gardenv1beta1.Kubernetes{Version: "1.10.1"},
+					},
+				},
+				CloudProfile: &gardenv1beta1.CloudProfile{
+					Spec: gardenv1beta1.CloudProfileSpec{Local: &gardenv1beta1.LocalProfile{}},
+				},
+				SeedNamespace: namespace,
+				CloudProvider: gardenv1beta1.CloudProviderLocal,
+			},
+			Secrets: map[string]*corev1.Secret{
+				"cloud-config-downloader": {Data: map[string][]byte{"kubeconfig": []byte("kubeconfig")}},
+			},
+			ChartShootRenderer: downloaderChartRenderer{},
+		}
+	})
+
+	Describe("#New", func() {
+		It("should fail for a local Shoot without workers", func() {
+			o.Shoot.Info.Spec.Cloud.Local.Workers = nil
+
+			_, err := New(o)
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#DeployInfrastructure", func() {
+		It("should not ask the local service for a node", func() {
+			botanist, err := New(o)
+			Expect(err).NotTo(HaveOccurred())
+
+			// The endpoint of the local service is unreachable, hence, the call would fail if it was contacted.
+			o.Shoot.Info.Spec.Cloud.Local.Endpoint = "unreachable:0"
+			Expect(botanist.DeployInfrastructure()).To(Succeed())
+		})
+	})
+
+	Describe("#GenerateMachineConfig", func() {
+		It("should generate a local machine class and a machine deployment per worker group", func() {
+			o.Shoot.Info.Spec.Cloud.Local.Workers = append(o.Shoot.Info.Spec.Cloud.Local.Workers, worker("large", "large", 3, 3))
+			botanist, err := New(o)
+			Expect(err).NotTo(HaveOccurred())
+
+			classKind, classPlural, classChartName := botanist.GetMachineClassInfo()
+			Expect(classKind).To(Equal("LocalMachineClass"))
+			Expect(classPlural).To(Equal("localmachineclasses"))
+			Expect(classChartName).To(Equal("local-machineclass"))
+
+			machineClasses, machineDeployments, err := botanist.GenerateMachineConfig()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(machineDeployments).To(HaveLen(2))
+			Expect(machineClasses).To(HaveLen(2))
+			for i, expected := range []struct {
+				workerName, machineType string
+				minimum, maximum        int
+			}{
+				{"small", "small", 1, 2},
+				{"large", "large", 3, 3},
+			} {
+				deployment := machineDeployments[i]
+				Expect(deployment.Name).To(Equal(common.ZonedMachineDeploymentName(namespace, expected.workerName, 0)))
+				Expect(deployment.WorkerName).To(Equal(expected.workerName))
+				Expect(deployment.ClassName).To(HavePrefix(deployment.Name + "-"))
+				Expect(deployment.Minimum).To(Equal(expected.minimum))
+				Expect(deployment.Maximum).To(Equal(expected.maximum))
+
+				Expect(machineClasses[i]).To(Equal(map[string]interface{}{
+					"name":        deployment.ClassName,
+					"machineType": expected.machineType,
+					"secret": map[string]interface{}{
+						"cloudConfig": fmt.Sprintf("#cloud-config shoot-cloud-config-downloader %s", o.Shoot.ComputeCloudConfigSecretName(expected.workerName)),
+					},
+				}))
+			}
+		})
+
+		It("should change the machine class names only if the machine classes change", func() {
+			botanist, err := New(o)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, machineDeployments, err := botanist.GenerateMachineConfig()
+			Expect(err).NotTo(HaveOccurred())
+			className := machineDeployments[0].ClassName
+
+			o.Shoot.Info.Spec.Cloud.Local.Workers[0].AutoScalerMax = 5
+			_, machineDeployments, err = botanist.GenerateMachineConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machineDeployments[0].ClassName).To(Equal(className))
+
+			o.Shoot.Info.Spec.Cloud.Local.Workers[0].MachineType = "large"
+			_, machineDeployments, err = botanist.GenerateMachineConfig()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machineDeployments[0].ClassName).NotTo(Equal(className))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockbotanist
+
+import (
+	"errors"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/localbotanist"
+)
+
+// New takes an operation object <o> and creates a new MockBotanist object.
+func New(o *operation.Operation) (*MockBotanist, error) {
+	localBotanist, err := localbotanist.New(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(o.Shoot.Info.Spec.Cloud.Local.Workers) == 0 {
+		return nil, errors.New("cannot instantiate a Mock botanist if `.spec.cloud.local.workers` is empty")
+	}
+
+	return &MockBotanist{
+		LocalBotanist: localBotanist,
+	}, nil
+}
@@ -0,0 +1,26 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mockbotanist
+
+import (
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist/localbotanist"
+)
+
+// MockBotanist is a struct which has methods that perform the operations for a Shoot cluster of the Local cloud
+// provider whose machines are simulated. It behaves like the LocalBotanist except for the infrastructure, which is not
+// provisioned at all, and for the machines, which are managed by a fake machine-controller-manager.
+type MockBotanist struct {
+	*localbotanist.LocalBotanist
+}
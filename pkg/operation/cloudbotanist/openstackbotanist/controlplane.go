@@ -17,6 +17,7 @@ package openstackbotanist
 import (
 	"fmt"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 	"github.com/gardener/gardener/pkg/utils"
@@ -116,10 +117,19 @@ func (b *OpenStackBotanist) GenerateKubeSchedulerConfig() (map[string]interface{
 
 // GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart.
 func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID), common.BackupSecretName)
+}
+
+// GenerateEtcdRestoreConfig returns the configuration for restoring the etcd of a cloned Shoot from the backups of the
+// <source> Shoot for the etcd Helm chart.
+func (b *OpenStackBotanist) GenerateEtcdRestoreConfig(source *gardenv1beta1.Shoot) (map[string][]byte, map[string]interface{}, error) {
+	return b.generateEtcdBackupConfig(common.GenerateBackupInfrastructureName(source.Status.TechnicalID, source.Status.UID), common.RestoreSecretName)
+}
+
+func (b *OpenStackBotanist) generateEtcdBackupConfig(backupInfrastructureName, secretName string) (map[string][]byte, map[string]interface{}, error) {
 	var (
-		containerName            = "containerName"
-		backupInfrastructureName = common.GenerateBackupInfrastructureName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
-		backupNamespace          = common.GenerateBackupNamespaceName(backupInfrastructureName)
+		containerName   = "containerName"
+		backupNamespace = common.GenerateBackupNamespaceName(backupInfrastructureName)
 	)
 
 	stateVariables, err := terraformer.New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, backupInfrastructureName, backupNamespace, b.ImageVector).GetStateOutputVariables(containerName)
@@ -145,7 +155,7 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[s
 				"name": "OS_AUTH_URL",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  AuthURL,
 					},
 				},
@@ -154,7 +164,7 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[s
 				"name": "OS_DOMAIN_NAME",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  DomainName,
 					},
 				},
@@ -163,7 +173,7 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[s
 				"name": "OS_USERNAME",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  UserName,
 					},
 				},
@@ -172,7 +182,7 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[s
 				"name": "OS_PASSWORD",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  Password,
 					},
 				},
@@ -181,7 +191,7 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[s
 				"name": "OS_TENANT_NAME",
 				"valueFrom": map[string]interface{}{
 					"secretKeyRef": map[string]interface{}{
-						"name": common.BackupSecretName,
+						"name": secretName,
 						"key":  TenantName,
 					},
 				},
@@ -15,12 +15,19 @@
 package openstackbotanist
 
 import (
+	"fmt"
+	"regexp"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/operation/terraformer"
 )
 
 // DeployInfrastructure kicks off a Terraform job which deploys the infrastructure.
 func (b *OpenStackBotanist) DeployInfrastructure() error {
+	defer b.infrastructureState.Invalidate()
+
 	var (
 		routerID     = "${openstack_networking_router_v2.router.id}"
 		createRouter = true
@@ -36,15 +43,32 @@ func (b *OpenStackBotanist) DeployInfrastructure() error {
 		NewFromOperation(b.Operation, common.TerraformerPurposeInfra).
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
 		DefineConfig("openstack-infra", b.generateTerraformInfraConfig(createRouter, routerID)).
+		SetGuard(b.guard).
 		Apply()
 }
 
-// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure.
+// dependencyRegexps match the identifiers of the subnets, routers and security groups which OpenStack refuses to
+// delete because ports are still allocated in or attached to them.
+var dependencyRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`Unable to complete operation on subnet ([0-9a-f-]+)`),
+	regexp.MustCompile(`Router ([0-9a-f-]+) still has ports`),
+	regexp.MustCompile(`Security Group ([0-9a-f-]+) in use`),
+}
+
+// DestroyInfrastructure kicks off a Terraform job which destroys the infrastructure. If the deletion fails because
+// of dependency conflicts, the returned error lists the resources which are involved.
 func (b *OpenStackBotanist) DestroyInfrastructure() error {
-	return terraformer.
+	defer b.infrastructureState.Invalidate()
+
+	err := terraformer.
 		NewFromOperation(b.Operation, common.TerraformerPurposeInfra).
 		SetVariablesEnvironment(b.generateTerraformInfraVariablesEnvironment()).
+		SetGuard(b.guard).
 		Destroy()
+	if err != nil {
+		return terraformer.DependenciesError(err, terraformer.FindBlockingResources(err, dependencyRegexps...))
+	}
+	return nil
 }
 
 // generateTerraformInfraVariablesEnvironment generates the environment containing the credentials which
@@ -81,7 +105,74 @@ func (b *OpenStackBotanist) generateTerraformInfraConfig(createRouter bool, rout
 		"networks": map[string]interface{}{
 			"worker": b.Shoot.Info.Spec.Cloud.OpenStack.Networks.Workers[0],
 		},
+		"workerFirewallRules": common.GenerateWorkerFirewallRulesConfig(b.Shoot.GetWorkers()),
+	}
+}
+
+// GetInfrastructureStatus reads the identifiers of the provisioned infrastructure resources (network, subnet and
+// security group) from the Terraform state.
+func (b *OpenStackBotanist) GetInfrastructureStatus() (*gardenv1beta1.ShootCloudStatus, error) {
+	var (
+		networkID       = "network_id"
+		subnetID        = "subnet_id"
+		securityGroupID = "security_group_id"
+	)
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(networkID, subnetID, securityGroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gardenv1beta1.ShootCloudStatus{
+		Network:        stateVariables[networkID],
+		Subnets:        []string{stateVariables[subnetID]},
+		SecurityGroups: []string{stateVariables[securityGroupID]},
+	}, nil
+}
+
+// GetInfrastructureState returns the outputs of the infrastructure which are required to create the machines
+// (network, security groups and key pair). They are read from the Terraform state once and cached until the
+// infrastructure is applied or destroyed again.
+func (b *OpenStackBotanist) GetInfrastructureState() (*operation.InfrastructureState, error) {
+	return b.infrastructureState.Get(b.readInfrastructureState)
+}
+
+func (b *OpenStackBotanist) readInfrastructureState() (*operation.InfrastructureState, error) {
+	var (
+		networkID         = "network_id"
+		keyName           = "key_name"
+		securityGroupName = "security_group_name"
+		outputVariables   = []string{networkID, keyName, securityGroupName}
+		workers           = b.Shoot.Info.Spec.Cloud.OpenStack.Workers
+
+		tfOutputNameSecurityGroupWorker = func(workerName string) string {
+			return fmt.Sprintf("security_group_name_%s", workerName)
+		}
+	)
+
+	for _, worker := range workers {
+		if len(worker.FirewallRules) > 0 {
+			outputVariables = append(outputVariables, tfOutputNameSecurityGroupWorker(worker.Name))
+		}
+	}
+
+	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(outputVariables...)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &operation.InfrastructureState{
+		Network:              stateVariables[networkID],
+		SecurityGroups:       []string{stateVariables[securityGroupName]},
+		WorkerSecurityGroups: map[string]string{},
+		KeyName:              stateVariables[keyName],
+	}
+	for _, worker := range workers {
+		if len(worker.FirewallRules) > 0 {
+			state.WorkerSecurityGroups[worker.Name] = stateVariables[tfOutputNameSecurityGroupWorker(worker.Name)]
+		}
 	}
+	return state, nil
 }
 
 // DeployBackupInfrastructure kicks off a Terraform job which creates the infrastructure resources for backup.
@@ -90,6 +181,7 @@ func (b *OpenStackBotanist) DeployBackupInfrastructure() error {
 		New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, b.BackupInfrastructure.Name, common.GenerateBackupNamespaceName(b.BackupInfrastructure.Name), b.ImageVector).
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
 		DefineConfig("openstack-backup", b.generateTerraformBackupConfig()).
+		SetGuard(b.guard).
 		Apply()
 }
 
@@ -98,6 +190,7 @@ func (b *OpenStackBotanist) DestroyBackupInfrastructure() error {
 	return terraformer.
 		New(b.Logger, b.K8sSeedClient, common.TerraformerPurposeBackup, b.BackupInfrastructure.Name, common.GenerateBackupNamespaceName(b.BackupInfrastructure.Name), b.ImageVector).
 		SetVariablesEnvironment(b.generateTerraformBackupVariablesEnvironment()).
+		SetGuard(b.guard).
 		Destroy()
 }
 
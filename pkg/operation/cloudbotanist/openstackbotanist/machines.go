@@ -17,12 +17,16 @@ package openstackbotanist
 import (
 	"fmt"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
-	"github.com/gardener/gardener/pkg/operation/terraformer"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 )
 
+// maxUserDataSize is the maximum size in bytes of the user data of OpenStack servers (65535 bytes after the
+// base64 encoding).
+const maxUserDataSize = 49149
+
 // GetMachineClassInfo returns the name of the class kind, the plural of it and the name of the Helm chart which
 // contains the machine class template.
 func (b *OpenStackBotanist) GetMachineClassInfo() (classKind, classPlural, classChartName string) {
@@ -35,13 +39,14 @@ func (b *OpenStackBotanist) GetMachineClassInfo() (classKind, classPlural, class
 // GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
 // which is computed elsewhere).
 func (b *OpenStackBotanist) GenerateMachineClassSecretData() map[string][]byte {
+	credentials := b.Shoot.GetMachineCredentials()
 	return map[string][]byte{
 		machinev1alpha1.OpenStackAuthURL:    []byte(b.Shoot.CloudProfile.Spec.OpenStack.KeyStoneURL),
 		machinev1alpha1.OpenStackInsecure:   []byte("true"),
-		machinev1alpha1.OpenStackDomainName: b.Shoot.Secret.Data[DomainName],
-		machinev1alpha1.OpenStackTenantName: b.Shoot.Secret.Data[TenantName],
-		machinev1alpha1.OpenStackUsername:   b.Shoot.Secret.Data[UserName],
-		machinev1alpha1.OpenStackPassword:   b.Shoot.Secret.Data[Password],
+		machinev1alpha1.OpenStackDomainName: credentials[DomainName],
+		machinev1alpha1.OpenStackTenantName: credentials[TenantName],
+		machinev1alpha1.OpenStackUsername:   credentials[UserName],
+		machinev1alpha1.OpenStackPassword:   credentials[Password],
 	}
 }
 
@@ -50,58 +55,85 @@ func (b *OpenStackBotanist) GenerateMachineClassSecretData() map[string][]byte {
 // the desired availability zones. It returns the computed list of MachineClasses and MachineDeployments.
 func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
 	var (
-		networkID         = "network_id"
-		keyName           = "key_name"
-		securityGroupName = "security_group_name"
-		outputVariables   = []string{networkID, keyName, securityGroupName}
-		workers           = b.Shoot.Info.Spec.Cloud.OpenStack.Workers
-		zones             = b.Shoot.Info.Spec.Cloud.OpenStack.Zones
-		zoneLen           = len(zones)
+		workers = b.Shoot.Info.Spec.Cloud.OpenStack.Workers
+		zones   = b.Shoot.Info.Spec.Cloud.OpenStack.Zones
+		zoneLen = len(zones)
 
 		machineDeployments = []operation.MachineDeployment{}
 		machineClasses     = []map[string]interface{}{}
 	)
 
-	stateVariables, err := terraformer.NewFromOperation(b.Operation, common.TerraformerPurposeInfra).GetStateOutputVariables(outputVariables...)
+	infrastructureState, err := b.GetInfrastructureState()
 	if err != nil {
 		return nil, nil, err
 	}
 
 	for zoneIndex, zone := range zones {
 		for _, worker := range workers {
-			cloudConfig, err := b.ComputeDownloaderCloudConfig(worker.Name)
+			userData, err := b.ComputeDownloaderUserData(worker.Name, maxUserDataSize)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			image, err := b.Shoot.GetWorkerMachineImage(worker.Worker)
 			if err != nil {
 				return nil, nil, err
 			}
+			machineImage := image.(*gardenv1beta1.OpenStackMachineImage)
+
+			annotations, err := b.Shoot.ComputeMachineDeploymentAnnotations(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			gpus, err := b.Shoot.GetWorkerGPUs(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// Worker groups with firewall rules get their own security group in addition to the one of all nodes.
+			securityGroups := append([]string{}, infrastructureState.SecurityGroups...)
+			if securityGroup, ok := infrastructureState.WorkerSecurityGroups[worker.Name]; ok {
+				securityGroups = append(securityGroups, securityGroup)
+			}
 
 			machineClassSpec := map[string]interface{}{
 				"region":           b.Shoot.Info.Spec.Cloud.Region,
 				"availabilityZone": zone,
 				"machineType":      worker.MachineType,
-				"keyName":          stateVariables[keyName],
-				"imageName":        b.Shoot.Info.Spec.Cloud.OpenStack.MachineImage.Image,
-				"networkID":        stateVariables[networkID],
-				"securityGroups":   []string{stateVariables[securityGroupName]},
+				"keyName":          infrastructureState.KeyName,
+				"imageName":        machineImage.Image,
+				"networkID":        infrastructureState.Network,
+				"securityGroups":   securityGroups,
 				"tags": map[string]string{
 					fmt.Sprintf("kubernetes.io-cluster-%s", b.Shoot.SeedNamespace): "1",
 					"kubernetes.io-role-node":                                      "1",
 				},
 				"secret": map[string]interface{}{
-					"cloudConfig": cloudConfig.FileContent("cloud-config.yaml"),
+					"cloudConfig": userData,
 				},
 			}
 
 			var (
-				machineClassSpecHash = common.MachineClassHash(machineClassSpec, b.Shoot.KubernetesMajorMinorVersion)
-				deploymentName       = fmt.Sprintf("%s-%s-z%d", b.Shoot.SeedNamespace, worker.Name, zoneIndex+1)
-				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 				secretData           = b.GenerateMachineClassSecretData()
+				machineClassSpecHash = b.Shoot.ComputeMachineClassHash(worker.Name, machineClassSpec, secretData)
+				deploymentName       = common.ZonedMachineDeploymentName(b.Shoot.SeedNamespace, worker.Name, zoneIndex)
+				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
 			)
 
 			machineDeployments = append(machineDeployments, operation.MachineDeployment{
-				Name:      deploymentName,
-				ClassName: className,
-				Replicas:  common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Name:            deploymentName,
+				WorkerName:      worker.Name,
+				ClassName:       className,
+				Minimum:         common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, zoneLen),
+				Maximum:         common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Labels:          worker.Labels,
+				Annotations:     annotations,
+				Cordoned:        worker.Cordoned != nil && *worker.Cordoned,
+				MaxSurge:        worker.MaxSurge,
+				MaxUnavailable:  worker.MaxUnavailable,
+				MinReadySeconds: worker.MinReadySeconds,
+				GPUs:            gpus,
 			})
 
 			machineClassSpec["name"] = className
@@ -117,3 +149,15 @@ func (b *OpenStackBotanist) GenerateMachineConfig() ([]map[string]interface{}, [
 
 	return machineClasses, machineDeployments, nil
 }
+
+// ListMachineInstanceIDs returns the IDs of the instances which exist for the machines of the Shoot. Listing the
+// instances is not yet supported for OpenStack, hence, the second return value is false.
+func (b *OpenStackBotanist) ListMachineInstanceIDs() ([]string, bool, error) {
+	return nil, false, nil
+}
+
+// CheckMachineCapacity checks whether the cloud provider has the capacity for the given machine classes and
+// deployments. The check is not yet supported for OpenStack, hence, the first return value is false.
+func (b *OpenStackBotanist) CheckMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) (bool, error) {
+	return false, nil
+}
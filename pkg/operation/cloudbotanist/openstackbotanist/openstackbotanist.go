@@ -18,18 +18,34 @@ import (
 	"errors"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/terraformer"
+	corev1 "k8s.io/api/core/v1"
 )
 
 // New takes an operation object <o> and creates a new OpenStackBotanist object.
 func New(o *operation.Operation, purpose string) (*OpenStackBotanist, error) {
-	var cloudProvider gardenv1beta1.CloudProvider
+	var (
+		cloudProvider gardenv1beta1.CloudProvider
+		secret        *corev1.Secret
+		authURL       string
+	)
+
 	switch purpose {
 	case common.CloudPurposeShoot:
 		cloudProvider = o.Shoot.CloudProvider
+		secret = o.Shoot.Secret
+		if o.Shoot.CloudProfile.Spec.OpenStack != nil {
+			authURL = o.Shoot.CloudProfile.Spec.OpenStack.KeyStoneURL
+		}
 	case common.CloudPurposeSeed:
 		cloudProvider = o.Seed.CloudProvider
+		secret = o.Seed.Secret
+		if o.Seed.CloudProfile.Spec.OpenStack != nil {
+			authURL = o.Seed.CloudProfile.Spec.OpenStack.KeyStoneURL
+		}
 	}
 
 	if cloudProvider != gardenv1beta1.CloudProviderOpenStack {
@@ -39,6 +55,7 @@ func New(o *operation.Operation, purpose string) (*OpenStackBotanist, error) {
 	return &OpenStackBotanist{
 		Operation:         o,
 		CloudProviderName: "openstack",
+		guard:             cloudapi.GuardFor(string(gardenv1beta1.CloudProviderOpenStack), terraformer.IsAPIFailure, authURL, string(secret.Data[DomainName]), string(secret.Data[TenantName]), string(secret.Data[UserName])),
 	}, nil
 }
 
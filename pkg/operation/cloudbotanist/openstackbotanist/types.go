@@ -14,12 +14,20 @@
 
 package openstackbotanist
 
-import "github.com/gardener/gardener/pkg/operation"
+import (
+	"github.com/gardener/gardener/pkg/client/cloudapi"
+	"github.com/gardener/gardener/pkg/operation"
+)
 
 // OpenStackBotanist is a struct which has methods that perform OpenStack cloud-specific operations for a Shoot cluster.
 type OpenStackBotanist struct {
 	*operation.Operation
 	CloudProviderName string
+
+	infrastructureState operation.InfrastructureStateCache
+	// guard rate limits and circuit breaks the Terraform runs, which are shared by all botanists with the same
+	// credentials.
+	guard *cloudapi.Guard
 }
 
 const (
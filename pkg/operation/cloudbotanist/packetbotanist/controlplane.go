@@ -0,0 +1,72 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbotanist
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// GenerateCloudProviderConfig returns a cloud provider config for the Packet cloud provider.
+// Not needed on Packet as Kubernetes does not ship an in-tree cloud provider for it.
+func (b *PacketBotanist) GenerateCloudProviderConfig() (string, error) {
+	return "", nil
+}
+
+// RefreshCloudProviderConfig refreshes the cloud provider credentials in the existing cloud
+// provider config.
+// Not needed on Packet, hence, the original is returned back.
+func (b *PacketBotanist) RefreshCloudProviderConfig(currentConfig map[string]string) map[string]string {
+	return currentConfig
+}
+
+// GenerateKubeAPIServerConfig generates the cloud provider specific values which are required to render the
+// Deployment manifest of the kube-apiserver properly.
+func (b *PacketBotanist) GenerateKubeAPIServerConfig() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+// GenerateKubeControllerManagerConfig generates the cloud provider specific values which are required to
+// render the Deployment manifest of the kube-controller-manager properly.
+func (b *PacketBotanist) GenerateKubeControllerManagerConfig() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+// GenerateKubeSchedulerConfig generates the cloud provider specific values which are required to render the
+// Deployment manifest of the kube-scheduler properly.
+func (b *PacketBotanist) GenerateKubeSchedulerConfig() (map[string]interface{}, error) {
+	return nil, nil
+}
+
+// GenerateEtcdBackupConfig returns the etcd backup configuration for the etcd Helm chart. Packet does not offer an
+// object store, hence, the backups are only kept on the volume of the etcd.
+func (b *PacketBotanist) GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error) {
+	backupConfigData := map[string]interface{}{
+		"schedule":         b.Shoot.Info.Spec.Backup.Schedule,
+		"maxBackups":       b.Shoot.Info.Spec.Backup.Maximum,
+		"storageProvider":  "",
+		"storageContainer": "/var/etcd/default.bkp",
+		"env":              []map[string]interface{}{},
+		"volumeMount":      []map[string]interface{}{},
+	}
+
+	return nil, backupConfigData, nil
+}
+
+// GenerateEtcdRestoreConfig returns the configuration for restoring the etcd of a cloned Shoot from the backups of the
+// <source> Shoot for the etcd Helm chart.
+// Not needed on Packet as it does not support backups.
+func (b *PacketBotanist) GenerateEtcdRestoreConfig(source *gardenv1beta1.Shoot) (map[string][]byte, map[string]interface{}, error) {
+	return nil, nil, nil
+}
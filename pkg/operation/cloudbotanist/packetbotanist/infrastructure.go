@@ -0,0 +1,51 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbotanist
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+)
+
+// DeployInfrastructure does nothing as the servers of the Shoot are provisioned into an existing Packet project
+// without any further infrastructure resources.
+func (b *PacketBotanist) DeployInfrastructure() error {
+	return nil
+}
+
+// DestroyInfrastructure does nothing as no infrastructure resources are created on Packet.
+func (b *PacketBotanist) DestroyInfrastructure() error {
+	return nil
+}
+
+// GetInfrastructureStatus returns nil as no infrastructure resources are created on Packet.
+func (b *PacketBotanist) GetInfrastructureStatus() (*gardenv1beta1.ShootCloudStatus, error) {
+	return nil, nil
+}
+
+// GetInfrastructureState returns an empty state as no infrastructure resources are created on Packet.
+func (b *PacketBotanist) GetInfrastructureState() (*operation.InfrastructureState, error) {
+	return &operation.InfrastructureState{}, nil
+}
+
+// DeployBackupInfrastructure does nothing as Packet does not offer an object store for the etcd backups.
+func (b *PacketBotanist) DeployBackupInfrastructure() error {
+	return nil
+}
+
+// DestroyBackupInfrastructure does nothing as Packet does not offer an object store for the etcd backups.
+func (b *PacketBotanist) DestroyBackupInfrastructure() error {
+	return nil
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbotanist
+
+import (
+	"fmt"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+)
+
+// GetMachineClassInfo returns the name of the class kind, the plural of it and the name of the Helm chart which
+// contains the machine class template.
+func (b *PacketBotanist) GetMachineClassInfo() (classKind, classPlural, classChartName string) {
+	classKind = "PacketMachineClass"
+	classPlural = "packetmachineclasses"
+	classChartName = "packet-machineclass"
+	return
+}
+
+// GenerateMachineClassSecretData generates the secret data for the machine class secret (except the userData field
+// which is computed elsewhere).
+func (b *PacketBotanist) GenerateMachineClassSecretData() map[string][]byte {
+	credentials := b.Shoot.GetMachineCredentials()
+	return map[string][]byte{
+		APIToken: credentials[APIToken],
+	}
+}
+
+// GenerateMachineConfig generates the configuration values for the cloud-specific machine class Helm chart. It
+// also generates a list of corresponding MachineDeployments. The provided worker groups will be distributed over
+// the desired facilities. It returns the computed list of MachineClasses and MachineDeployments.
+func (b *PacketBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
+	var (
+		workers = b.Shoot.Info.Spec.Cloud.Packet.Workers
+		zones   = b.Shoot.Info.Spec.Cloud.Packet.Zones
+		zoneLen = len(zones)
+
+		machineDeployments = []operation.MachineDeployment{}
+		machineClasses     = []map[string]interface{}{}
+	)
+
+	for zoneIndex, zone := range zones {
+		for _, worker := range workers {
+			// Packet does not document a limit for the size of the user data.
+			userData, err := b.ComputeDownloaderUserData(worker.Name, 0)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			image, err := b.Shoot.GetWorkerMachineImage(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+			machineImage := image.(*gardenv1beta1.PacketMachineImage)
+
+			annotations, err := b.Shoot.ComputeMachineDeploymentAnnotations(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			// Packet does not offer GPU machine types which are supported by the machine classes.
+			gpus, err := b.Shoot.GetWorkerGPUs(worker.Worker)
+			if err != nil {
+				return nil, nil, err
+			}
+			if gpus > 0 {
+				return nil, nil, operationerrors.Errorf(operationerrors.ClassConfiguration, "GPU machine type %s of worker %s is not supported on Packet", worker.MachineType, worker.Name)
+			}
+
+			machineClassSpec := map[string]interface{}{
+				"projectID":    string(b.Shoot.Secret.Data[ProjectID]),
+				"facility":     []string{zone},
+				"machineType":  worker.MachineType,
+				"OS":           machineImage.OperatingSystem,
+				"billingCycle": "hourly",
+				"tags": []string{
+					fmt.Sprintf("kubernetes.io/cluster/%s", b.Shoot.SeedNamespace),
+					"kubernetes.io/role/node",
+				},
+				"secret": map[string]interface{}{
+					"cloudConfig": userData,
+				},
+			}
+
+			var (
+				secretData           = b.GenerateMachineClassSecretData()
+				machineClassSpecHash = b.Shoot.ComputeMachineClassHash(worker.Name, machineClassSpec, secretData)
+				deploymentName       = common.ZonedMachineDeploymentName(b.Shoot.SeedNamespace, worker.Name, zoneIndex)
+				className            = fmt.Sprintf("%s-%s", deploymentName, machineClassSpecHash)
+			)
+
+			machineDeployments = append(machineDeployments, operation.MachineDeployment{
+				Name:            deploymentName,
+				WorkerName:      worker.Name,
+				ClassName:       className,
+				Minimum:         common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, zoneLen),
+				Maximum:         common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, zoneLen),
+				Labels:          worker.Labels,
+				Annotations:     annotations,
+				Cordoned:        worker.Cordoned != nil && *worker.Cordoned,
+				MaxSurge:        worker.MaxSurge,
+				MaxUnavailable:  worker.MaxUnavailable,
+				MinReadySeconds: worker.MinReadySeconds,
+			})
+
+			machineClassSpec["name"] = className
+			machineClassSpec["secret"].(map[string]interface{})[APIToken] = string(secretData[APIToken])
+
+			machineClasses = append(machineClasses, machineClassSpec)
+		}
+	}
+
+	return machineClasses, machineDeployments, nil
+}
+
+// ListMachineInstanceIDs returns the IDs of the instances which exist for the machines of the Shoot. Listing the
+// instances is not yet supported for Packet, hence, the second return value is false.
+func (b *PacketBotanist) ListMachineInstanceIDs() ([]string, bool, error) {
+	return nil, false, nil
+}
+
+// CheckMachineCapacity checks whether the cloud provider has the capacity for the given machine classes and
+// deployments. The check is not yet supported for Packet, hence, the first return value is false.
+func (b *PacketBotanist) CheckMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) (bool, error) {
+	return false, nil
+}
@@ -15,6 +15,7 @@
 package cloudbotanist
 
 import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
 )
@@ -29,12 +30,15 @@ type CloudBotanist interface {
 	DestroyInfrastructure() error
 	DeployBackupInfrastructure() error
 	DestroyBackupInfrastructure() error
+	GetInfrastructureStatus() (*gardenv1beta1.ShootCloudStatus, error)
+	GetInfrastructureState() (*operation.InfrastructureState, error)
 
 	// Control Plane
 	GenerateCloudProviderConfig() (string, error)
 	RefreshCloudProviderConfig(map[string]string) map[string]string
 	GenerateCloudConfigUserDataConfig() *common.CloudConfigUserDataConfig
 	GenerateEtcdBackupConfig() (map[string][]byte, map[string]interface{}, error)
+	GenerateEtcdRestoreConfig(*gardenv1beta1.Shoot) (map[string][]byte, map[string]interface{}, error)
 	GenerateKubeAPIServerConfig() (map[string]interface{}, error)
 	GenerateKubeControllerManagerConfig() (map[string]interface{}, error)
 	GenerateKubeSchedulerConfig() (map[string]interface{}, error)
@@ -43,6 +47,8 @@ type CloudBotanist interface {
 	GetMachineClassInfo() (string, string, string)
 	GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error)
 	GenerateMachineClassSecretData() map[string][]byte
+	ListMachineInstanceIDs() ([]string, bool, error)
+	CheckMachineCapacity([]map[string]interface{}, []operation.MachineDeployment) (bool, error)
 
 	// Addons
 	DeployKube2IAMResources() error
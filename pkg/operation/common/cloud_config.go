@@ -0,0 +1,110 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// cloudConfigWriteFilesKey is the key of the section of a cloud config which lists the files to be written.
+const cloudConfigWriteFilesKey = "write_files"
+
+// cloudConfigFile is a file which is written by a cloud config.
+type cloudConfigFile struct {
+	Path        string `yaml:"path"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Owner       string `yaml:"owner,omitempty"`
+	Encoding    string `yaml:"encoding,omitempty"`
+	Content     string `yaml:"content"`
+}
+
+// CompressCloudConfigFiles compresses the content of all files written by the given <cloudConfig> with gzip and
+// encodes them with base64 (encoding `gzip+base64`). Files which are already compressed and all other sections of the
+// cloud config are kept as they are.
+func CompressCloudConfigFiles(cloudConfig string) (string, error) {
+	var (
+		lines             = strings.SplitAfter(cloudConfig, "\n")
+		start, end        = -1, len(lines)
+		writeFilesSection = cloudConfigWriteFilesKey + ":"
+	)
+
+	// The write_files section spans from its key to the next top-level key (or the end of the cloud config).
+	for i, line := range lines {
+		isTopLevelKey := len(line) > 0 && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "-") && strings.TrimSpace(line) != ""
+		if start >= 0 && isTopLevelKey {
+			end = i
+			break
+		}
+		if strings.HasPrefix(line, writeFilesSection) {
+			start = i
+		}
+	}
+	if start < 0 {
+		return cloudConfig, nil
+	}
+
+	section := struct {
+		Files []cloudConfigFile `yaml:"write_files"`
+	}{}
+	if err := yaml.Unmarshal([]byte(strings.Join(lines[start:end], "")), &section); err != nil {
+		return "", fmt.Errorf("could not parse the files of the cloud config: %v", err)
+	}
+
+	for i, file := range section.Files {
+		if strings.HasPrefix(file.Encoding, "gz") {
+			continue
+		}
+
+		content, err := decodeCloudConfigFileContent(file)
+		if err != nil {
+			return "", fmt.Errorf("could not decode the content of file %s: %v", file.Path, err)
+		}
+
+		var buffer bytes.Buffer
+		writer := gzip.NewWriter(&buffer)
+		if _, err := writer.Write(content); err != nil {
+			return "", err
+		}
+		if err := writer.Close(); err != nil {
+			return "", err
+		}
+
+		section.Files[i].Encoding = "gzip+base64"
+		section.Files[i].Content = base64.StdEncoding.EncodeToString(buffer.Bytes())
+	}
+
+	compressed, err := yaml.Marshal(section)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(lines[:start], "") + string(compressed) + strings.Join(lines[end:], ""), nil
+}
+
+// decodeCloudConfigFileContent returns the decoded content of the given cloud config <file>.
+func decodeCloudConfigFileContent(file cloudConfigFile) ([]byte, error) {
+	switch file.Encoding {
+	case "":
+		return []byte(file.Content), nil
+	case "b64", "base64":
+		return base64.StdEncoding.DecodeString(file.Content)
+	}
+	return nil, fmt.Errorf("unsupported encoding %q", file.Encoding)
+}
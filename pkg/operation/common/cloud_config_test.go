@@ -0,0 +1,129 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"strings"
+
+	. "github.com/gardener/gardener/pkg/operation/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var _ = Describe("cloud config", func() {
+	Describe("#CompressCloudConfigFiles", func() {
+		const header = `#cloud-config
+
+coreos:
+  update:
+    reboot-strategy: off
+  units:
+  - name: foo.service
+    content: |
+      [Service]
+      ExecStart=/bin/sh /var/lib/foo/foo.sh
+`
+
+		type file struct {
+			Path        string `yaml:"path"`
+			Permissions string `yaml:"permissions"`
+			Encoding    string `yaml:"encoding"`
+			Content     string `yaml:"content"`
+		}
+
+		var (
+			script = strings.Repeat("echo foo\n", 100)
+
+			decompress = func(content string) string {
+				compressed, err := base64.StdEncoding.DecodeString(content)
+				Expect(err).NotTo(HaveOccurred())
+				reader, err := gzip.NewReader(bytes.NewReader(compressed))
+				Expect(err).NotTo(HaveOccurred())
+				decompressed, err := ioutil.ReadAll(reader)
+				Expect(err).NotTo(HaveOccurred())
+				return string(decompressed)
+			}
+
+			files = func(cloudConfig string) []file {
+				section := struct {
+					Files []file `yaml:"write_files"`
+				}{}
+				Expect(yaml.Unmarshal([]byte(cloudConfig), &section)).To(Succeed())
+				return section.Files
+			}
+		)
+
+		It("should compress all files and keep the other sections", func() {
+			cloudConfig := header + `write_files:
+- path: /var/lib/foo/foo.sh
+  permissions: 0644
+  encoding: b64
+  content: ` + base64.StdEncoding.EncodeToString([]byte(script)) + `
+- path: /var/lib/foo/bar
+  permissions: 0600
+  content: bar
+`
+
+			compressed, err := CompressCloudConfigFiles(cloudConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(compressed).To(HavePrefix(header))
+			Expect(len(compressed)).To(BeNumerically("<", len(cloudConfig)))
+			Expect(compressed).To(ContainSubstring("permissions: \"0644\""))
+
+			result := files(compressed)
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].Path).To(Equal("/var/lib/foo/foo.sh"))
+			Expect(result[0].Permissions).To(Equal("0644"))
+			Expect(result[0].Encoding).To(Equal("gzip+base64"))
+			Expect(decompress(result[0].Content)).To(Equal(script))
+			Expect(result[1].Permissions).To(Equal("0600"))
+			Expect(result[1].Encoding).To(Equal("gzip+base64"))
+			Expect(decompress(result[1].Content)).To(Equal("bar"))
+		})
+
+		It("should keep the sections following the files", func() {
+			compressed, err := CompressCloudConfigFiles("#cloud-config\nwrite_files:\n- path: /foo\n  content: foo\nhostname: bar\n")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(compressed).To(HaveSuffix("\nhostname: bar\n"))
+			Expect(files(compressed)).To(HaveLen(1))
+		})
+
+		It("should keep files which are already compressed", func() {
+			cloudConfig := "#cloud-config\nwrite_files:\n- path: /foo\n  encoding: gzip+base64\n  content: Zm9v\n"
+
+			compressed, err := CompressCloudConfigFiles(cloudConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(files(compressed)[0].Content).To(Equal("Zm9v"))
+		})
+
+		It("should return cloud configs without files unchanged", func() {
+			Expect(CompressCloudConfigFiles(header)).To(Equal(header))
+		})
+
+		It("should fail for files with an unsupported encoding", func() {
+			_, err := CompressCloudConfigFiles("#cloud-config\nwrite_files:\n- path: /foo\n  encoding: foo\n  content: foo\n")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -18,10 +18,17 @@ const (
 	// AlertManagerDeploymentName is the name of the AlertManager deployment.
 	AlertManagerDeploymentName = "alertmanager"
 
+	// AlertManagerIngressSubDomain is the sub domain of the Seed's ingress domain under which the AlertManager is exposed.
+	AlertManagerIngressSubDomain = "a"
+
 	// BackupSecretName defines the name of the secret containing the credentials which are required to
 	// authenticate against the respective cloud provider (required to store the backups of Shoot clusters).
 	BackupSecretName = "etcd-backup"
 
+	// RestoreSecretName defines the name of the secret containing the credentials which are required to
+	// restore the etcd of a cloned Shoot from the backups of its source Shoot.
+	RestoreSecretName = "etcd-restore"
+
 	// ChartPath is the path to the Helm charts.
 	ChartPath = "charts"
 
@@ -32,6 +39,18 @@ const (
 	// CloudProviderSecretName is the name of the secret containing the cloud provider credentials.
 	CloudProviderSecretName = "cloudprovider"
 
+	// MachineCredentialsSecretName is the name of the secret in the Shoot namespace of the Seed which contains the
+	// cloud provider credentials for the machine class secrets which have been issued by an external secret backend.
+	MachineCredentialsSecretName = "machine-credentials"
+
+	// MachineCredentialsExpiry is a constant for an annotation on the machine credentials secret holding the time
+	// (RFC3339) when the credentials expire. It is missing if the credentials do not expire.
+	MachineCredentialsExpiry = "machine-credentials.garden.sapcloud.io/expiry"
+
+	// MachineCredentialsLease is a constant for an annotation on the machine credentials secret holding the identifier
+	// of the lease of the credentials in the external secret backend.
+	MachineCredentialsLease = "machine-credentials.garden.sapcloud.io/lease"
+
 	// CloudProviderConfigName is the name of the configmap containing the cloud provider config.
 	CloudProviderConfigName = "cloud-provider-config"
 
@@ -70,6 +89,14 @@ const (
 	// EtcdRoleEvents is the constant defining the role for etcd storing events in Shoot.
 	EtcdRoleEvents = "events"
 
+	// ExtensionOperation is a constant for an annotation on an Extension resource in the Shoot namespace of the Seed
+	// which requests its controller to reconcile it. The controller removes the annotation after it has reported the
+	// result in the status of the Extension.
+	ExtensionOperation = "extensions.garden.sapcloud.io/operation"
+
+	// ExtensionOperationReconcile is the value of the ExtensionOperation annotation which requests a reconciliation.
+	ExtensionOperationReconcile = "reconcile"
+
 	// GardenNamespace is the namespace in which the configuration and secrets for
 	// the Gardener controller manager will be stored (e.g., secrets for the Seed clusters).
 	// It is also used by the gardener-apiserver.
@@ -105,6 +132,21 @@ const (
 	//GardenRoleBackup is the value of GardenRole key indicating type 'backup'.
 	GardenRoleBackup = "backup"
 
+	//GardenRolePassiveReplica is the value of GardenRole key indicating type 'passive-replica'.
+	GardenRolePassiveReplica = "passive-replica"
+
+	// GardenRoleBaselineNetworkPolicy is the value of GardenRole key indicating type 'baseline-network-policy'.
+	GardenRoleBaselineNetworkPolicy = "baseline-network-policy"
+
+	// GardenRoleSecretHistory is the value of GardenRole key indicating type 'secret-history'.
+	GardenRoleSecretHistory = "secret-history"
+
+	// GardenRoleSecretHistoryEncryption is the value of GardenRole key indicating type 'secret-history-encryption'.
+	GardenRoleSecretHistoryEncryption = "secret-history-encryption"
+
+	// GardenRoleExternalWorkerUserData is the value of GardenRole key indicating type 'external-worker-user-data'.
+	GardenRoleExternalWorkerUserData = "external-worker-user-data"
+
 	// GardenCreatedBy is the key for an annotation of a Shoot cluster whose value indicates contains the username
 	// of the user that created the resource.
 	GardenCreatedBy = "garden.sapcloud.io/createdBy"
@@ -113,9 +155,17 @@ const (
 	// is used to send alerts to.
 	GardenOperatedBy = "garden.sapcloud.io/operatedBy"
 
-	// GardenPurpose is a key for a label describing the purpose of the respective object.
+	// GardenPurpose is a key for a label describing the purpose of the respective object. On Shoots it is used as
+	// annotation whose value (e.g. 'evaluation' or 'production') selects the resource limits of the control plane.
 	GardenPurpose = "garden.sapcloud.io/purpose"
 
+	// GardenerLeader is the key for a label of the pod of the Gardener controller manager replica which holds the
+	// leader election. Only this pod serves the Shoot endpoints (e.g. the reconcile plan) of the webhook server.
+	GardenerLeader = "garden.sapcloud.io/leader"
+
+	// GrafanaIngressSubDomain is the sub domain of the Seed's ingress domain under which the Grafana is exposed.
+	GrafanaIngressSubDomain = "g"
+
 	// IngressPrefix is the part of a FQDN which will be used to construct the domain name for an ingress controller of
 	// a Shoot cluster. For example, when a Shoot specifies domain 'cluster.example.com', the ingress domain would be
 	// '*.<IngressPrefix>.cluster.example.com'.
@@ -135,6 +185,35 @@ const (
 	// KubeAddonManagerDeploymentName is the name of the kube-addon-manager deployment.
 	KubeAddonManagerDeploymentName = "kube-addon-manager"
 
+	// MachineDeploymentNameMaxLength is the maximum length of the names of MachineDeployments. The names are used as
+	// label values which must not exceed 63 characters.
+	MachineDeploymentNameMaxLength = 63
+
+	// ClusterAutoscalerDeploymentName is the name of the cluster-autoscaler deployment.
+	ClusterAutoscalerDeploymentName = "cluster-autoscaler"
+
+	// MachineControllerManagerDeploymentName is the name of the machine-controller-manager deployment.
+	MachineControllerManagerDeploymentName = "machine-controller-manager"
+
+	// MachineHistoryConfigMapName is the name of the config map in the Shoot namespace of the Seed which records the
+	// deleted MachineDeployments and MachineClasses.
+	MachineHistoryConfigMapName = "machine-history"
+
+	// MachineHistoryConfigMapKey is the key storing the records as value in the machine history config map.
+	MachineHistoryConfigMapKey = "history"
+
+	// MachineScaleDownsConfigMapName is the name of the config map in the Shoot namespace of the Seed which records the
+	// machines removed by scale-downs of the MachineDeployments, for the scale-down budgets.
+	MachineScaleDownsConfigMapName = "machine-scale-downs"
+
+	// MachineScaleDownsConfigMapKey is the key storing the records as value in the machine scale-downs config map.
+	MachineScaleDownsConfigMapKey = "scaleDowns"
+
+	// PersistentVolumeClaimOrphanedSince is the key of an annotation on PersistentVolumeClaims in the Shoot namespace
+	// of the Seed whose value holds the time (RFC3339) when the garbage collection has first seen the claim without
+	// its StatefulSet. The retention of orphaned claims is measured from this time.
+	PersistentVolumeClaimOrphanedSince = "garden.sapcloud.io/orphaned-since"
+
 	// ProjectName is they key of a label on namespaces whose value holds the project name. Usually, the label is set
 	// by the Gardener Dashboard.
 	ProjectName = "project.garden.sapcloud.io/name"
@@ -142,6 +221,103 @@ const (
 	// PrometheusDeploymentName is the name of the Prometheus deployment.
 	PrometheusDeploymentName = "prometheus"
 
+	// PrometheusIngressSubDomain is the sub domain of the Seed's ingress domain under which the Prometheus is exposed.
+	PrometheusIngressSubDomain = "p"
+
+	// PrometheusFederationIngressSubDomain is the sub domain of the Seed's ingress domain under which the federation
+	// endpoint of the Prometheus is exposed.
+	PrometheusFederationIngressSubDomain = "f"
+
+	// PrometheusFederationSecretName is the name of the secret which contains the basic authentication credentials
+	// for the federation endpoint of the Prometheus.
+	PrometheusFederationSecretName = "prometheus-federation-basic-auth"
+
+	// ShardLabel is the key of a label on Shoots and Seeds whose value holds the index of the shard (i.e., of the
+	// Gardener controller manager instance) they are explicitly assigned to.
+	ShardLabel = "garden.sapcloud.io/shard"
+
+	// WorkerGroupLabel is the key of a label on Shoot nodes whose value holds the name of the worker group the node
+	// belongs to.
+	WorkerGroupLabel = "worker.garden.sapcloud.io/group"
+
+	// NodeTerminatingLabel is the key of a label on Shoot nodes indicating that the VM of the node is about to be
+	// terminated by the cloud provider (e.g., because it is preemptible). The machine of such a node is replaced
+	// without waiting for the machine health timeout.
+	NodeTerminatingLabel = "worker.garden.sapcloud.io/terminating"
+
+	// NodeRebootRequiredLabel is the key of a label on Shoot nodes indicating that the node waits for being rebooted into
+	// the installed updates of its operating system. It is maintained by the node reboot coordinator.
+	NodeRebootRequiredLabel = "worker.garden.sapcloud.io/reboot-required"
+
+	// NodeRebootLockAnnotation is the key of an annotation on the node reboot coordinator DaemonSet whose value holds
+	// the name of the node which is currently drained and rebooted. Only one node of a Shoot is rebooted at a time.
+	NodeRebootLockAnnotation = "worker.garden.sapcloud.io/reboot-lock"
+
+	// NodeRebootCoordinatorName is the name of the DaemonSet which reboots the nodes of the worker groups with automated
+	// operating system updates into the installed updates.
+	NodeRebootCoordinatorName = "node-reboot-coordinator"
+
+	// NodeLabelsAnnotation is the key of an annotation on Shoot nodes whose value holds the comma-separated keys of the
+	// node labels of the worker group which the Gardener has applied to the node.
+	NodeLabelsAnnotation = "worker.garden.sapcloud.io/node-labels"
+
+	// NodeAnnotationsAnnotation is the key of an annotation on Shoot nodes whose value holds the comma-separated keys of
+	// the node annotations of the worker group which the Gardener has applied to the node.
+	NodeAnnotationsAnnotation = "worker.garden.sapcloud.io/node-annotations"
+
+	// NodeTaintsAnnotation is the key of an annotation on Shoot nodes whose value holds the comma-separated
+	// `<key>:<effect>` pairs of the node taints of the worker group which the Gardener has applied to the node.
+	NodeTaintsAnnotation = "worker.garden.sapcloud.io/node-taints"
+
+	// ScaleDownProtectionAnnotation is the key of an annotation on Shoot nodes which protects the node from being
+	// selected for a scale-down or a rolling update as long as its value is "true". The Gardener propagates it to the
+	// cluster-autoscaler and to the machine of the node.
+	ScaleDownProtectionAnnotation = "worker.garden.sapcloud.io/scale-down-protected"
+
+	// ClusterAutoscalerScaleDownDisabledAnnotation is the key of an annotation on nodes which prevents the
+	// cluster-autoscaler from removing the node.
+	ClusterAutoscalerScaleDownDisabledAnnotation = "cluster-autoscaler.kubernetes.io/scale-down-disabled"
+
+	// MachinePriorityAnnotation is the key of an annotation on machines whose value determines the order in which the
+	// machine-controller-manager deletes the machines of a machine set (machines with a lower value are deleted first,
+	// the default is "3").
+	MachinePriorityAnnotation = "machinepriority.machine.sapcloud.io"
+
+	// MachinePriorityAppliedAnnotation is the key of an annotation on machines whose value holds the machine priority
+	// which the Gardener has set, so that priorities which have been set by others are kept.
+	MachinePriorityAppliedAnnotation = "worker.garden.sapcloud.io/machine-priority"
+
+	// ClusterAutoscalerSafeToEvictAnnotation is the key of an annotation on pods which prevents the cluster-autoscaler
+	// from removing the node of the pod as long as its value is "false".
+	ClusterAutoscalerSafeToEvictAnnotation = "cluster-autoscaler.kubernetes.io/safe-to-evict"
+
+	// CriticalComponentsNotReadyTaint is the key of a taint with which the nodes of worker groups waiting for critical
+	// components register. The Gardener removes it once the critical DaemonSets are ready on the node.
+	CriticalComponentsNotReadyTaint = "worker.garden.sapcloud.io/critical-components-not-ready"
+
+	// SpotTaint is the key of a taint with which the nodes of spot worker groups register if requested, so that only
+	// pods tolerating interruptions are scheduled onto them.
+	SpotTaint = "worker.garden.sapcloud.io/spot"
+
+	// GPUTaint is the key of the taint with which the nodes of worker groups with GPU machine types register, so that
+	// only pods which request GPUs (and tolerate it) are scheduled onto them.
+	GPUTaint = "nvidia.com/gpu"
+
+	// AcceleratorLabel is the key of a label on the nodes (and the MachineDeployments) of worker groups with GPU machine
+	// types whose value holds the vendor of the GPUs. The NVIDIA device plugin is scheduled onto the nodes with this label.
+	AcceleratorLabel = "worker.garden.sapcloud.io/accelerator"
+
+	// GPUCountLabel is the key of a label on the nodes (and the MachineDeployments) of worker groups with GPU machine
+	// types whose value holds the number of GPUs of the machine type.
+	GPUCountLabel = "worker.garden.sapcloud.io/gpu-count"
+
+	// ExternalWorkerShootLabel is the key of a label on the secrets in the project namespace which hold the user data for
+	// the nodes of an external worker group (see WorkerGroupLabel). Its value is the name of the Shoot.
+	ExternalWorkerShootLabel = "external-worker.garden.sapcloud.io/shoot"
+
+	// ExternalWorkerUserDataKey is the key of the user data in the secrets of the external worker groups.
+	ExternalWorkerUserDataKey = "userData"
+
 	// TerraformerConfigSuffix is the suffix used for the ConfigMap which stores the Terraform configuration and variables declaration.
 	TerraformerConfigSuffix = ".tf-config"
 
@@ -175,6 +351,15 @@ const (
 	// TerraformerPurposeIngress is a constant for the complete Terraform setup with purpose 'ingress'.
 	TerraformerPurposeIngress = "ingress"
 
+	// SeedMachineClassValidation is a constant for an annotation on a Seed resource indicating that a validating webhook
+	// shall be deployed into the Seed cluster which rejects machine classes with invalid provider fields.
+	SeedMachineClassValidation = "seed.garden.sapcloud.io/machine-class-validation"
+
+	// SeedRotateKubeconfigFrom is a constant for an annotation on a Seed resource whose value is the name of a secret in
+	// the namespace of the Seed's secret. Its kubeconfig replaces the kubeconfig of the Seed's secret once it has been
+	// validated, and the annotation is removed afterwards.
+	SeedRotateKubeconfigFrom = "seed.garden.sapcloud.io/rotate-kubeconfig-from"
+
 	// ShootExpirationTimestamp is an annotation on a Shoot resource whose value represents the time when the Shoot lifetime
 	// is expired. The lifetime can be extended, but at most by the minimal value of the 'clusterLifetimeDays' property
 	// of referenced quotas.
@@ -184,13 +369,100 @@ const (
 	// Garden cluster once successfully created.
 	ShootUseAsSeed = "shoot.garden.sapcloud.io/use-as-seed"
 
+	// ShootPassiveReplicaSeed is a constant for an (experimental) annotation on a Shoot resource whose value is the name of a
+	// second Seed cluster. A passive replica of the Shoot's control plane will be maintained in this Seed cluster whose etcd
+	// restores the most recent backup of the active etcd once it is promoted.
+	ShootPassiveReplicaSeed = "shoot.garden.sapcloud.io/passive-replica-seed"
+
 	// ShootUnhealthy is a constant for a label on a Shoot resource indicating that the Shoot is unhealthy. It is set and unset by the
 	// Shoot Care controller and can be used to easily identify Shoot clusters with issues.
 	ShootUnhealthy = "shoot.garden.sapcloud.io/unhealthy"
 
 	// ShootOperation is a constant for an annotation on a Shoot in a failed state indicating that the operation should be retried.
+	// It may also carry the value "reconcile" to request a new reconciliation of a Shoot in any state.
 	ShootOperation = "shoot.garden.sapcloud.io/operation"
 
+	// ShootCloneOf is a constant for an annotation on a Shoot resource containing the name of another Shoot in the same
+	// namespace. The etcd of the annotated Shoot is restored from the most recent backup of that Shoot when it is created.
+	ShootCloneOf = "shoot.garden.sapcloud.io/clone-of"
+
+	// ShootMachineWaitTimeout is a constant for an annotation on a Shoot which overwrites the global timeout for the
+	// rollout and the deletion of its machines. The value must be a duration.
+	ShootMachineWaitTimeout = "shoot.garden.sapcloud.io/machine-wait-timeout"
+
+	// ShootMachineWaitPollInterval is a constant for an annotation on a Shoot which overwrites the global initial
+	// interval in which its machine resources are re-checked while waiting for them. The value must be a duration.
+	ShootMachineWaitPollInterval = "shoot.garden.sapcloud.io/machine-wait-poll-interval"
+
+	// ShootMachineDeploymentProgressTimeout is a constant for an annotation on a Shoot which overwrites the global
+	// timeout after which a machine deployment which does not make any progress fails the rollout. The value must be
+	// a duration, 0s disables the check.
+	ShootMachineDeploymentProgressTimeout = "shoot.garden.sapcloud.io/machine-deployment-progress-timeout"
+
+	// ShootRollbackSecrets is a constant for an annotation on a Shoot whose value is a version of the generated
+	// secrets of the Shoot (see SecretHistoryVersion). The generated secrets are rolled back to this version during
+	// the next reconciliation, afterwards the annotation is removed.
+	ShootRollbackSecrets = "shoot.garden.sapcloud.io/rollback-secrets"
+
+	// ShootRestartWorkers is a constant for an annotation on a Shoot whose value is a comma-separated list of names of
+	// worker groups. The machines of these worker groups are replaced by a rolling update during the next
+	// reconciliation, afterwards the annotation is removed.
+	ShootRestartWorkers = "shoot.garden.sapcloud.io/restart-workers"
+
+	// ShootRenameWorkers is a constant for an annotation on a Shoot whose value is a comma-separated list of renames of
+	// worker groups (<old-name>=<new-name>, see ParseWorkerRenames). The workload of the worker groups with the old
+	// names is migrated gradually to the worker groups with the new names during the next reconciliation, afterwards
+	// the annotation is removed.
+	ShootRenameWorkers = "shoot.garden.sapcloud.io/rename-workers"
+
+	// ShootRecoveryDrills is a constant for an annotation on a Shoot whose value is a comma-separated list of recovery
+	// drills (RecoveryDrillKubeAPIServer, RecoveryDrillMachine and RecoveryDrillEtcdRestore, see ParseRecoveryDrills).
+	// The drills are performed periodically for the Shoot if the Gardener controller manager has enabled them.
+	ShootRecoveryDrills = "shoot.garden.sapcloud.io/recovery-drills"
+
+	// RecoveryDrillKubeAPIServer is the name of the recovery drill which deletes a kube-apiserver pod of a Shoot and
+	// waits until the API server of the Shoot is available again.
+	RecoveryDrillKubeAPIServer = "kube-apiserver"
+
+	// RecoveryDrillMachine is the name of the recovery drill which deletes a random machine of a Shoot and waits until
+	// it has been replaced by the machine-controller-manager.
+	RecoveryDrillMachine = "machine"
+
+	// RecoveryDrillEtcdRestore is the name of the recovery drill which restores the latest backup of the main etcd of a
+	// Shoot into a scratch namespace in the Seed and verifies that the restored etcd contains the data of the Shoot.
+	RecoveryDrillEtcdRestore = "etcd-restore"
+
+	// SecretHistoryShoot is a constant for a label on a secret holding a version of the generated secrets of a Shoot
+	// whose value is the name of the Shoot.
+	SecretHistoryShoot = "secret-history.garden.sapcloud.io/shoot"
+
+	// SecretHistoryBundle is a constant for a label on a secret holding a version of the generated secrets of a Shoot
+	// whose value is the name of the bundle of secrets it contains (see SecretBundleSeed and SecretBundleProject).
+	SecretHistoryBundle = "secret-history.garden.sapcloud.io/bundle"
+
+	// SecretHistoryVersion is a constant for a label on a secret holding a version of the generated secrets of a
+	// Shoot whose value is the number of the version.
+	SecretHistoryVersion = "secret-history.garden.sapcloud.io/version"
+
+	// SecretHistorySecrets is a constant for an annotation on a secret holding a version of the generated secrets of
+	// a Shoot whose value is the comma-separated list of the names of the secrets it contains.
+	SecretHistorySecrets = "secret-history.garden.sapcloud.io/secrets"
+
+	// SecretBundleSeed is the name of the bundle of the generated secrets of a Shoot in its namespace in the Seed.
+	SecretBundleSeed = "seed"
+
+	// SecretBundleProject is the name of the bundle of the generated secrets of a Shoot in its project namespace in
+	// the Garden cluster (kubeconfigs and SSH key pair).
+	SecretBundleProject = "project"
+
+	// ShootOperationReconcile is a constant for the value of the ShootOperation annotation indicating that the Shoot shall
+	// be reconciled again although its specification did not change.
+	ShootOperationReconcile = "reconcile"
+
+	// ShootOperationRetry is a constant for the value of the ShootOperation annotation indicating that the failed operation
+	// of a Shoot shall be retried.
+	ShootOperationRetry = "retry"
+
 	// ShootSyncPeriod is a constant for an annotation on a Shoot which may be used to overwrite the global Shoot controller sync period.
 	// The value must be a duration. It can also be used to disable the reconciliation at all by setting it to 0m. Disabling the reconciliation
 	// does only mean that the period reconciliation is disabled. However, when the Gardener is restarted/redeployed or the specification is
@@ -202,6 +474,86 @@ const (
 	// delete)).
 	ShootIgnore = "shoot.garden.sapcloud.io/ignore"
 
+	// ShootDisableZoneRebalancing is a constant for an annotation on a Shoot which may be used to tell the Gardener not to
+	// restore the even distribution of the machines of its autoscaled worker groups over their zones (value "true").
+	ShootDisableZoneRebalancing = "shoot.garden.sapcloud.io/disable-zone-rebalancing"
+
+	// MachineDeploymentScaleUpDisabled is a constant for an annotation on a MachineDeployment in the Seed indicating that
+	// autoscalers must not scale it up. It is set for the MachineDeployments of cordoned worker groups.
+	MachineDeploymentScaleUpDisabled = "machinedeployment.garden.sapcloud.io/scale-up-disabled"
+
+	// MachineDeploymentAutoscaled is a constant for an annotation on a MachineDeployment in the Seed indicating whether
+	// its number of replicas is managed by the cluster-autoscaler (and not reconciled by the Gardener).
+	MachineDeploymentAutoscaled = "machinedeployment.garden.sapcloud.io/autoscaled"
+
+	// MachineDeploymentAutoscalerMin is a constant for an annotation on a MachineDeployment in the Seed holding the
+	// minimum number of replicas the cluster-autoscaler may scale it down to.
+	MachineDeploymentAutoscalerMin = "machinedeployment.garden.sapcloud.io/autoscaler-min"
+
+	// MachineDeploymentAutoscalerMax is a constant for an annotation on a MachineDeployment in the Seed holding the
+	// maximum number of replicas the cluster-autoscaler may scale it up to.
+	MachineDeploymentAutoscalerMax = "machinedeployment.garden.sapcloud.io/autoscaler-max"
+
+	// MachineDeploymentCapacityCPU is a constant for an annotation on a MachineDeployment in the Seed holding the number
+	// of CPUs of its machine type. The cluster-autoscaler uses it to build a node template for MachineDeployments without
+	// any machines, hence, they can be scaled up from zero.
+	MachineDeploymentCapacityCPU = "capacity.cluster-autoscaler.kubernetes.io/cpu"
+
+	// MachineDeploymentCapacityMemory is a constant for an annotation on a MachineDeployment in the Seed holding the
+	// amount of memory of its machine type (see MachineDeploymentCapacityCPU).
+	MachineDeploymentCapacityMemory = "capacity.cluster-autoscaler.kubernetes.io/memory"
+
+	// MachineDeploymentCapacityGPU is a constant for an annotation on a MachineDeployment in the Seed holding the number
+	// of GPUs of its machine type (see MachineDeploymentCapacityCPU).
+	MachineDeploymentCapacityGPU = "capacity.cluster-autoscaler.kubernetes.io/gpu-count"
+
+	// MachineDeploymentMachineType is a constant for an annotation on a MachineDeployment in the Seed holding the name
+	// of its machine type.
+	MachineDeploymentMachineType = "machinedeployment.garden.sapcloud.io/machine-type"
+
+	// MachineDeploymentArchitecture is a constant for an annotation on a MachineDeployment in the Seed holding the CPU
+	// architecture of its machine type.
+	MachineDeploymentArchitecture = "machinedeployment.garden.sapcloud.io/architecture"
+
+	// MachineDeploymentNodeTaints is a constant for an annotation on a MachineDeployment in the Seed holding the
+	// comma-separated taints (<key>=<value>:<effect>) with which the nodes of its machines register. The
+	// cluster-autoscaler uses it for the node template of MachineDeployments without any machines (see
+	// MachineDeploymentCapacityCPU), hence, only pods which tolerate them trigger a scale-up from zero.
+	MachineDeploymentNodeTaints = "machinedeployment.garden.sapcloud.io/node-taints"
+
+	// MachineClassContentHash is a constant for an annotation on the MachineClasses and their secrets in the Seed holding
+	// a hash of their rendered content. Only objects whose content hash differs from the one of the live object are
+	// applied, hence, unchanged machine classes are not updated on every reconciliation.
+	MachineClassContentHash = "machineclass.garden.sapcloud.io/content-hash"
+
+	// MachineClassCloudConfigChecksum is a constant for an annotation on the MachineClasses and their secrets in the
+	// Seed holding the checksum of the cloud config of the worker group which the machines of the class are running.
+	MachineClassCloudConfigChecksum = "machineclass.garden.sapcloud.io/cloud-config-checksum"
+
+	// MachineDeploymentSpotFallback is a constant for an annotation on a MachineDeployment of a spot worker group in the
+	// Seed holding the time (in RFC3339 format) since which it uses the on-demand fallback machine class because the
+	// cloud provider had no spare capacity. The spot machine class is retried an hour later.
+	MachineDeploymentSpotFallback = "machinedeployment.garden.sapcloud.io/spot-fallback"
+
+	// MachineDeploymentCanaryHalted is a constant for an annotation on the MachineDeployment of the canary machines of a
+	// worker group in the Seed holding the reason why its canary rollout has been halted. The rollout stays halted until
+	// the machine class of the worker group changes again.
+	MachineDeploymentCanaryHalted = "machinedeployment.garden.sapcloud.io/canary-halted"
+
+	// MachineDeploymentHibernatedReplicas is a constant for an annotation on a MachineDeployment in the Seed holding the
+	// number of replicas it had before the Shoot was hibernated. It is used to restore the size when the Shoot is woken up.
+	MachineDeploymentHibernatedReplicas = "machinedeployment.garden.sapcloud.io/hibernated-replicas"
+
+	// MachineDeploymentPaused is a constant for an annotation on a MachineDeployment in the Seed which can be set to
+	// "true" by operators, e.g. to debug a worker group. Paused MachineDeployments and their machine classes are neither
+	// updated nor deleted by the Gardener until the annotation is removed.
+	MachineDeploymentPaused = "machinedeployment.garden.sapcloud.io/paused"
+
+	// MachineDeploymentRestartedAt is a constant for an annotation on a MachineDeployment in the Seed holding the time
+	// (in RFC3339 format) of the last restart of its worker group which has been requested with the ShootRestartWorkers
+	// annotation. It is considered for the names of the machine classes of the worker group.
+	MachineDeploymentRestartedAt = "machinedeployment.garden.sapcloud.io/restarted-at"
+
 	// BackupNamespacePrefix is a constant for backup namespace created for shoot's backup infrastructure related resources.
 	BackupNamespacePrefix = "backup"
 )
@@ -213,4 +565,6 @@ type CloudConfigUserDataConfig struct {
 	KubeletParameters            []string
 	WorkerNames                  []string
 	HostnameOverride             bool
+	// LocalSSDDevices are the glob patterns of the device paths of the local SSDs of the machines.
+	LocalSSDDevices []string
 }
@@ -26,10 +26,12 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 // ApplyChart takes a Kubernetes client <k8sClient>, chartRender <renderer>, path to a chart <chartPath>, name of the release <name>,
@@ -60,8 +62,8 @@ func GetSecretKeysWithPrefix(kind string, m map[string]*corev1.Secret) []string
 // index of the current zone (<zoneIndex>) and the number of nodes which must be distributed
 // over the zones (<size>) and returns the number of nodes which should be placed in the zone
 // of index <zoneIndex>.
-// The distribution happens equally. In case of an uneven number <size>, the last zone will have
-// one more node than the others.
+// The distribution happens equally. If <size> cannot be divided evenly, the remaining nodes are
+// placed in the first zones, one each, so that the result is deterministic.
 func DistributeOverZones(zoneIndex, size, zoneSize int) int {
 	first := size / zoneSize
 	second := 0
@@ -111,6 +113,89 @@ func MachineClassHash(machineClassSpec map[string]interface{}, version string) s
 	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s-%s", utils.HashForMap(machineClassSpec), version)))[:5]
 }
 
+// MachineClassCredentialsHash returns the MachineClassHash of the <machineClassSpec> which additionally comprises the
+// <secretData> of the machine class secret, hence, a change of the cloud provider credentials results in a new hash.
+func MachineClassCredentialsHash(machineClassSpec map[string]interface{}, secretData map[string][]byte, version string) string {
+	credentials := make(map[string]interface{}, len(secretData))
+	for key, value := range secretData {
+		credentials[key] = string(value)
+	}
+	return MachineClassHash(map[string]interface{}{"spec": machineClassSpec, "credentials": credentials}, version)
+}
+
+// MachineDeploymentName returns the name of the MachineDeployment for the worker group <workerName> of the Shoot
+// with the given <technicalID>.
+func MachineDeploymentName(technicalID, workerName string) string {
+	return fmt.Sprintf("%s-%s", technicalID, workerName)
+}
+
+// ZonedMachineDeploymentName returns the name of the MachineDeployment for the worker group <workerName> of the
+// Shoot with the given <technicalID> in the availability zone with the given <zoneIndex>.
+func ZonedMachineDeploymentName(technicalID, workerName string, zoneIndex int) string {
+	return fmt.Sprintf("%s-%s-z%d", technicalID, workerName, zoneIndex+1)
+}
+
+// CanaryMachineDeploymentName returns the name of the MachineDeployment of the canary machines of the worker group
+// <workerName> of the Shoot with the given <technicalID>. It is truncated to the maximum length of the names of
+// MachineDeployments.
+func CanaryMachineDeploymentName(technicalID, workerName string) string {
+	return utils.TruncateName(fmt.Sprintf("%s-%s-canary", technicalID, workerName), MachineDeploymentNameMaxLength)
+}
+
+// AutoscaledMachineDeploymentReplicas returns the number of replicas of a MachineDeployment whose size is managed
+// by the cluster-autoscaler. The <current> number of replicas of an existing MachineDeployment is kept (within the
+// bounds <minimum> and <maximum>) so that the scaling decisions of the cluster-autoscaler are not reverted. New
+// MachineDeployments (<current> is nil) start with <minimum> replicas.
+func AutoscaledMachineDeploymentReplicas(current *int, minimum, maximum int) int {
+	switch {
+	case current == nil || *current < minimum:
+		return minimum
+	case *current > maximum:
+		return maximum
+	default:
+		return *current
+	}
+}
+
+// GenerateWorkerFirewallRulesConfig returns the firewall rules of all <workers> in the format of the Terraform
+// infrastructure charts. Worker groups without rules are contained as well, because their security groups must not be
+// deleted before their machines are replaced. The protocol defaults to tcp and the end port to the port of the rule.
+func GenerateWorkerFirewallRulesConfig(workers []gardenv1beta1.Worker) []map[string]interface{} {
+	values := make([]map[string]interface{}, 0, len(workers))
+
+	for _, worker := range workers {
+		rules := make([]map[string]interface{}, 0, len(worker.FirewallRules))
+		for _, rule := range worker.FirewallRules {
+			protocol := corev1.ProtocolTCP
+			if rule.Protocol != nil {
+				protocol = *rule.Protocol
+			}
+			toPort := rule.Port
+			if rule.EndPort != nil {
+				toPort = *rule.EndPort
+			}
+			cidrs := make([]string, 0, len(rule.SourceCIDRs))
+			for _, cidr := range rule.SourceCIDRs {
+				cidrs = append(cidrs, string(cidr))
+			}
+
+			rules = append(rules, map[string]interface{}{
+				"protocol": strings.ToLower(string(protocol)),
+				"fromPort": rule.Port,
+				"toPort":   toPort,
+				"cidrs":    cidrs,
+			})
+		}
+
+		values = append(values, map[string]interface{}{
+			"name":  worker.Name,
+			"rules": rules,
+		})
+	}
+
+	return values
+}
+
 // GenerateAddonConfig returns the provided <values> in case <enabled> is true. Otherwise, nil is
 // being returned.
 func GenerateAddonConfig(values map[string]interface{}, enabled bool) map[string]interface{} {
@@ -142,7 +227,7 @@ func GetLoadBalancerIngress(client kubernetes.Client, namespace, name string) (s
 	serviceStatusIngress = service.Status.LoadBalancer.Ingress
 	length := len(serviceStatusIngress)
 	if length == 0 {
-		return "", nil, errors.New("`.status.loadBalancer.ingress[]` has no elements yet, i.e. external load balancer has not been created (is your quota limit exceeded/reached?)")
+		return "", nil, operationerrors.Errorf(operationerrors.ClassTransient, "`.status.loadBalancer.ingress[]` has no elements yet, i.e. external load balancer has not been created (is your quota limit exceeded/reached?)")
 	}
 
 	if serviceStatusIngress[length-1].IP != "" {
@@ -215,3 +300,84 @@ func IsFollowingNewNamingConvention(seedNamespace string) bool {
 func ReplaceCloudProviderConfigKey(cloudProviderConfig, separator, key, value string) string {
 	return regexp.MustCompile(fmt.Sprintf("%s%s(.*)\n", key, separator)).ReplaceAllString(cloudProviderConfig, fmt.Sprintf("%s%s%s\n", key, separator, value))
 }
+
+// ShootDependencyKey returns the key (<namespace>/<name>) of the Shoot referenced by the given <dependency> of a Shoot
+// in the namespace <namespace>. Dependencies without a namespace refer to Shoots in the same namespace.
+func ShootDependencyKey(namespace string, dependency corev1.ObjectReference) string {
+	if len(dependency.Namespace) > 0 {
+		namespace = dependency.Namespace
+	}
+	return fmt.Sprintf("%s/%s", namespace, dependency.Name)
+}
+
+// ScaleDownProtected returns true if the given node <annotations> protect the node from being selected for a
+// scale-down or a rolling update.
+func ScaleDownProtected(annotations map[string]string) bool {
+	return annotations[ScaleDownProtectionAnnotation] == "true"
+}
+
+// ParseWorkerRenames parses the value of the ShootRenameWorkers annotation, a comma-separated list of renames of
+// worker groups (<old-name>=<new-name>), and returns the new names keyed by the old names. Every worker group may only
+// be renamed once, and a new name must not be renamed again.
+func ParseWorkerRenames(value string) (map[string]string, error) {
+	var (
+		renames  = map[string]string{}
+		newNames = sets.NewString()
+	)
+
+	for _, rename := range strings.Split(value, ",") {
+		if rename = strings.TrimSpace(rename); len(rename) == 0 {
+			continue
+		}
+
+		names := strings.Split(rename, "=")
+		if len(names) != 2 {
+			return nil, fmt.Errorf("rename %q must have the format <old-name>=<new-name>", rename)
+		}
+		oldName, newName := strings.TrimSpace(names[0]), strings.TrimSpace(names[1])
+		if len(oldName) == 0 || len(newName) == 0 {
+			return nil, fmt.Errorf("rename %q must have the format <old-name>=<new-name>", rename)
+		}
+		if oldName == newName {
+			return nil, fmt.Errorf("rename %q must change the name of the worker group", rename)
+		}
+		if _, ok := renames[oldName]; ok {
+			return nil, fmt.Errorf("worker group %q must not be renamed more than once", oldName)
+		}
+		if newNames.Has(newName) {
+			return nil, fmt.Errorf("worker group %q must not be the new name of more than one worker group", newName)
+		}
+
+		renames[oldName] = newName
+		newNames.Insert(newName)
+	}
+
+	for oldName := range renames {
+		if newNames.Has(oldName) {
+			return nil, fmt.Errorf("worker group %q must not be renamed again", oldName)
+		}
+	}
+	return renames, nil
+}
+
+// ParseRecoveryDrills parses the value of the ShootRecoveryDrills annotation, a comma-separated list of recovery
+// drills, and returns the drills in the given order without duplicates. Unknown drills are rejected.
+func ParseRecoveryDrills(value string) ([]string, error) {
+	var (
+		drills = []string{}
+		known  = sets.NewString(RecoveryDrillKubeAPIServer, RecoveryDrillMachine, RecoveryDrillEtcdRestore)
+		seen   = sets.NewString()
+	)
+
+	for _, drill := range strings.Split(value, ",") {
+		if drill = strings.TrimSpace(drill); len(drill) == 0 || seen.Has(drill) {
+			continue
+		}
+		if !known.Has(drill) {
+			return nil, fmt.Errorf("unknown recovery drill %q, supported are %s", drill, strings.Join(known.List(), ", "))
+		}
+		drills = append(drills, drill)
+		seen.Insert(drill)
+	}
+	return drills, nil
+}
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	corev1 "k8s.io/api/core/v1"
 )
 
 var _ = Describe("common", func() {
@@ -160,5 +161,146 @@ var _ = Describe("common", func() {
 				})
 			})
 		})
+
+		Describe("#GenerateWorkerFirewallRulesConfig", func() {
+			It("should return the firewall rules of all worker groups", func() {
+				var (
+					udp     = corev1.ProtocolUDP
+					endPort = int32(8090)
+					workers = []gardenv1beta1.Worker{
+						{Name: "cpu-worker"},
+						{
+							Name: "ingress",
+							FirewallRules: []gardenv1beta1.WorkerFirewallRule{
+								{Port: 443, SourceCIDRs: []gardenv1beta1.CIDR{"0.0.0.0/0"}},
+								{Protocol: &udp, Port: 8080, EndPort: &endPort, SourceCIDRs: []gardenv1beta1.CIDR{"10.0.0.0/8", "192.168.0.0/16"}},
+							},
+						},
+					}
+				)
+
+				Expect(GenerateWorkerFirewallRulesConfig(workers)).To(Equal([]map[string]interface{}{
+					{
+						"name":  "cpu-worker",
+						"rules": []map[string]interface{}{},
+					},
+					{
+						"name": "ingress",
+						"rules": []map[string]interface{}{
+							{"protocol": "tcp", "fromPort": int32(443), "toPort": int32(443), "cidrs": []string{"0.0.0.0/0"}},
+							{"protocol": "udp", "fromPort": int32(8080), "toPort": int32(8090), "cidrs": []string{"10.0.0.0/8", "192.168.0.0/16"}},
+						},
+					},
+				}))
+			})
+		})
+
+		Describe("#DistributeOverZones", func() {
+			It("should distribute the nodes equally over the zones", func() {
+				Expect(DistributeOverZones(0, 6, 3)).To(Equal(2))
+				Expect(DistributeOverZones(1, 6, 3)).To(Equal(2))
+				Expect(DistributeOverZones(2, 6, 3)).To(Equal(2))
+			})
+
+			It("should place the remaining nodes in the first zones", func() {
+				Expect(DistributeOverZones(0, 5, 3)).To(Equal(2))
+				Expect(DistributeOverZones(1, 5, 3)).To(Equal(2))
+				Expect(DistributeOverZones(2, 5, 3)).To(Equal(1))
+			})
+		})
+
+		Describe("#MachineClassCredentialsHash", func() {
+			var (
+				spec       = map[string]interface{}{"machineType": "m4.large"}
+				secretData = map[string][]byte{"accessKeyID": []byte("foo")}
+			)
+
+			It("should be stable", func() {
+				Expect(MachineClassCredentialsHash(spec, secretData, "1.10")).To(Equal(MachineClassCredentialsHash(spec, map[string][]byte{"accessKeyID": []byte("foo")}, "1.10")))
+			})
+
+			It("should change when the credentials change", func() {
+				Expect(MachineClassCredentialsHash(spec, secretData, "1.10")).NotTo(Equal(MachineClassCredentialsHash(spec, map[string][]byte{"accessKeyID": []byte("bar")}, "1.10")))
+			})
+
+			It("should differ from the hash without credentials", func() {
+				Expect(MachineClassCredentialsHash(spec, secretData, "1.10")).NotTo(Equal(MachineClassHash(spec, "1.10")))
+			})
+		})
+
+		Describe("#ZonedMachineDeploymentName", func() {
+			It("should suffix the name with the number of the zone", func() {
+				Expect(ZonedMachineDeploymentName("shoot--dev--foo", "cpu-worker", 0)).To(Equal("shoot--dev--foo-cpu-worker-z1"))
+			})
+		})
+
+		Describe("#AutoscaledMachineDeploymentReplicas", func() {
+			intPtr := func(i int) *int { return &i }
+
+			It("should start new machine deployments with the minimum", func() {
+				Expect(AutoscaledMachineDeploymentReplicas(nil, 1, 3)).To(Equal(1))
+			})
+
+			It("should keep the current number of replicas", func() {
+				Expect(AutoscaledMachineDeploymentReplicas(intPtr(2), 1, 3)).To(Equal(2))
+			})
+
+			It("should keep the number of replicas within the bounds", func() {
+				Expect(AutoscaledMachineDeploymentReplicas(intPtr(0), 1, 3)).To(Equal(1))
+				Expect(AutoscaledMachineDeploymentReplicas(intPtr(5), 1, 3)).To(Equal(3))
+			})
+		})
+
+		Describe("#ShootDependencyKey", func() {
+			It("should use the namespace of the dependency", func() {
+				Expect(ShootDependencyKey("garden-dev", corev1.ObjectReference{Namespace: "garden", Name: "seed"})).To(Equal("garden/seed"))
+			})
+
+			It("should default to the namespace of the Shoot", func() {
+				Expect(ShootDependencyKey("garden-dev", corev1.ObjectReference{Name: "base"})).To(Equal("garden-dev/base"))
+			})
+		})
+
+		Describe("#ParseWorkerRenames", func() {
+			It("should return the new names keyed by the old names", func() {
+				renames, err := ParseWorkerRenames("cpu-worker=cpu-worker-v2, gpu=gpu-v2,")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(renames).To(Equal(map[string]string{"cpu-worker": "cpu-worker-v2", "gpu": "gpu-v2"}))
+			})
+
+			It("should return an empty map for an empty value", func() {
+				Expect(ParseWorkerRenames("")).To(BeEmpty())
+			})
+
+			It("should reject malformed renames", func() {
+				for _, value := range []string{"cpu-worker", "cpu-worker=", "=cpu-worker-v2", "a=b=c", "a=a"} {
+					_, err := ParseWorkerRenames(value)
+					Expect(err).To(HaveOccurred(), value)
+				}
+			})
+
+			It("should reject conflicting renames", func() {
+				for _, value := range []string{"a=b,a=c", "a=c,b=c", "a=b,b=c", "a=b,b=a"} {
+					_, err := ParseWorkerRenames(value)
+					Expect(err).To(HaveOccurred(), value)
+				}
+			})
+		})
+
+		Describe("#ParseRecoveryDrills", func() {
+			It("should return the drills in the given order without duplicates", func() {
+				drills, err := ParseRecoveryDrills("machine, etcd-restore,,machine,kube-apiserver")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(drills).To(Equal([]string{RecoveryDrillMachine, RecoveryDrillEtcdRestore, RecoveryDrillKubeAPIServer}))
+			})
+
+			It("should reject unknown drills", func() {
+				_, err := ParseRecoveryDrills("machine,delete-everything")
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 })
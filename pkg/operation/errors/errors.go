@@ -15,25 +15,198 @@
 package errors
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 )
 
 // New creates a new Error object from a given Golang error. It extracts 'CODE:<some-code>' from the beginning
-// of the error description. An error without a CODE is also valid.
+// of the error description. An error without a CODE is also valid, in which case the code is derived from the
+// class of the error (if it has one).
 func New(err error) *Error {
 	regex := regexp.MustCompile(`(?s)(CODE\:([^ ]*) )?(.*)`)
 	match := regex.FindStringSubmatch(err.Error())
 
 	e := &Error{
+		Class:       ClassOf(err),
 		Description: match[3],
 	}
 
 	if len(match[2]) != 0 {
 		code := gardenv1beta1.ErrorCode(match[2])
 		e.Code = &code
+	} else if code, ok := classErrorCodes[e.Class]; ok {
+		e.Code = &code
 	}
 
 	return e
 }
+
+// classErrorCodes maps the classes of errors to the error codes which are reported in the status of a Shoot.
+var classErrorCodes = map[Class]gardenv1beta1.ErrorCode{
+	ClassUnauthorized:  gardenv1beta1.ErrorInfraUnauthorized,
+	ClassQuotaExceeded: gardenv1beta1.ErrorInfraQuotaExceeded,
+	ClassConfiguration: gardenv1beta1.ErrorConfigurationProblem,
+}
+
+// Wrap assigns the given <class> to <err>. It returns nil if <err> is nil.
+func Wrap(class Class, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{class: class, message: err.Error(), cause: err}
+}
+
+// Wrapf returns an error with the message formatted according to <format> and <args> which has the same class as
+// <err>. It is meant to add context to an error without losing its class, hence, the message should contain the
+// description of <err>. It returns nil if <err> is nil.
+func Wrapf(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return &classifiedError{class: ClassOf(err), message: fmt.Sprintf(format, args...), cause: err}
+}
+
+// Errorf returns an error of the given <class> with the message formatted according to <format> and <args>.
+func Errorf(class Class, format string, args ...interface{}) error {
+	return &classifiedError{class: class, message: fmt.Sprintf(format, args...)}
+}
+
+// Transient marks <err> as transient, see ClassTransient.
+func Transient(err error) error {
+	return Wrap(ClassTransient, err)
+}
+
+// Unauthorized marks <err> as caused by invalid or insufficient credentials, see ClassUnauthorized.
+func Unauthorized(err error) error {
+	return Wrap(ClassUnauthorized, err)
+}
+
+// QuotaExceeded marks <err> as caused by exceeded quota limits, see ClassQuotaExceeded.
+func QuotaExceeded(err error) error {
+	return Wrap(ClassQuotaExceeded, err)
+}
+
+// Configuration marks <err> as caused by an invalid configuration, see ClassConfiguration.
+func Configuration(err error) error {
+	return Wrap(ClassConfiguration, err)
+}
+
+// Canceled marks <err> as caused by an aborted operation, see ClassCanceled.
+func Canceled(err error) error {
+	return Wrap(ClassCanceled, err)
+}
+
+// classifier is implemented by errors which determine their class themselves, e.g. aggregates of several errors.
+type classifier interface {
+	Class() Class
+}
+
+// ClassOf returns the class of the given <err>. Errors returned by the Kubernetes API server are classified by
+// their status reason, and context.Canceled is of the class ClassCanceled. All other errors which have not been classified with one of the functions of this package
+// are of the class ClassUnknown.
+func ClassOf(err error) Class {
+	if e, ok := err.(*classifiedError); ok {
+		return e.class
+	}
+	if e, ok := err.(classifier); ok {
+		return e.Class()
+	}
+
+	switch {
+	case err == nil:
+		return ClassUnknown
+	case err == context.Canceled:
+		return ClassCanceled
+	case apierrors.IsUnauthorized(err), apierrors.IsForbidden(err):
+		return ClassUnauthorized
+	case apierrors.IsConflict(err), apierrors.IsServerTimeout(err), apierrors.IsTimeout(err), apierrors.IsTooManyRequests(err), apierrors.IsInternalError(err):
+		return ClassTransient
+	}
+	return ClassUnknown
+}
+
+// IsTransient returns true if the given <err> is of the class ClassTransient.
+func IsTransient(err error) bool {
+	return ClassOf(err) == ClassTransient
+}
+
+// IsCanceled returns true if the given <err> is of the class ClassCanceled.
+func IsCanceled(err error) bool {
+	return ClassOf(err) == ClassCanceled
+}
+
+// IsUnauthorized returns true if the given <err> is of the class ClassUnauthorized.
+func IsUnauthorized(err error) bool {
+	return ClassOf(err) == ClassUnauthorized
+}
+
+// IsQuotaExceeded returns true if the given <err> is of the class ClassQuotaExceeded.
+func IsQuotaExceeded(err error) bool {
+	return ClassOf(err) == ClassQuotaExceeded
+}
+
+// IsConfiguration returns true if the given <err> is of the class ClassConfiguration.
+func IsConfiguration(err error) bool {
+	return ClassOf(err) == ClassConfiguration
+}
+
+// IsRetriable returns true if an operation which failed with an error of the given <class> may succeed when it is
+// retried right away. Errors caused by the credentials, the quota or the configuration require an action of the
+// user first.
+func IsRetriable(class Class) bool {
+	return class == ClassUnknown || class == ClassTransient
+}
+
+// classPrecedence lists the classes which take precedence over the others when several errors are aggregated, the
+// most important one first.
+var classPrecedence = []Class{ClassConfiguration, ClassUnauthorized, ClassQuotaExceeded, ClassCanceled, ClassUnknown}
+
+// AggregateClass returns the class which decides how an operation that failed with errors of the given <classes> is
+// handled. Errors which require an action of the user take precedence, and the aggregate is only of the class
+// ClassTransient if all errors are transient. It returns ClassUnknown if no classes are given.
+func AggregateClass(classes ...Class) Class {
+	if len(classes) == 0 {
+		return ClassUnknown
+	}
+	for _, class := range classPrecedence {
+		for _, c := range classes {
+			if c == class {
+				return class
+			}
+		}
+	}
+	return ClassTransient
+}
+
+// HasRetriableErrorCodes returns false if the given <lastError> contains an error code which indicates that the
+// failed operation cannot succeed unless the configuration of the Shoot is changed.
+func HasRetriableErrorCodes(lastError *gardenv1beta1.LastError) bool {
+	if lastError == nil {
+		return true
+	}
+	for _, code := range lastError.Codes {
+		if code == gardenv1beta1.ErrorConfigurationProblem {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *classifiedError) Error() string {
+	return e.message
+}
+
+// Cause returns the error which has been classified, or nil if the error has been created with Errorf.
+func (e *classifiedError) Cause() error {
+	return e.cause
+}
+
+// Retriable returns whether the operation which failed with this error may succeed when it is retried right away.
+// It is used by utils.Retry to stop retrying early.
+func (e *classifiedError) Retriable() bool {
+	return IsRetriable(e.class)
+}
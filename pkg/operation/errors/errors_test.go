@@ -0,0 +1,173 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors_test
+
+import (
+	"context"
+	"errors"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/operation/errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var _ = Describe("errors", func() {
+	Describe("#New", func() {
+		It("should extract the code from the description", func() {
+			err := New(errors.New("CODE:ERR_INFRA_DEPENDENCIES terraform failed"))
+
+			Expect(err.Description).To(Equal("terraform failed"))
+			Expect(*err.Code).To(Equal(gardenv1beta1.ErrorInfraDependencies))
+			Expect(err.Class).To(Equal(ClassUnknown))
+		})
+
+		It("should derive the code from the class of the error", func() {
+			err := New(Configuration(errors.New("machine image not offered")))
+
+			Expect(err.Description).To(Equal("machine image not offered"))
+			Expect(*err.Code).To(Equal(gardenv1beta1.ErrorConfigurationProblem))
+			Expect(err.Class).To(Equal(ClassConfiguration))
+		})
+
+		It("should prefer the code of the description over the one of the class", func() {
+			err := New(Unauthorized(errors.New("CODE:ERR_INFRA_INSUFFICIENT_PRIVILEGES access denied")))
+
+			Expect(*err.Code).To(Equal(gardenv1beta1.ErrorInfraInsufficientPrivileges))
+			Expect(err.Class).To(Equal(ClassUnauthorized))
+		})
+
+		It("should not set a code for transient, canceled and unclassified errors", func() {
+			Expect(New(Transient(errors.New("timeout"))).Code).To(BeNil())
+			Expect(New(Canceled(context.Canceled)).Code).To(BeNil())
+			Expect(New(errors.New("timeout")).Code).To(BeNil())
+		})
+	})
+
+	Describe("#Wrapf", func() {
+		It("should keep the class of the wrapped error", func() {
+			cause := QuotaExceeded(errors.New("limit exceeded"))
+
+			err := Wrapf(cause, "failed to create machines: %s", cause.Error())
+
+			Expect(err.Error()).To(Equal("failed to create machines: limit exceeded"))
+			Expect(IsQuotaExceeded(err)).To(BeTrue())
+		})
+
+		It("should return nil for a nil error", func() {
+			Expect(Wrapf(nil, "failed")).To(BeNil())
+			Expect(Wrap(ClassTransient, nil)).To(BeNil())
+		})
+	})
+
+	Describe("#ClassOf", func() {
+		It("should classify errors of the Kubernetes API server by their reason", func() {
+			resource := schema.GroupResource{Resource: "pods"}
+
+			Expect(ClassOf(apierrors.NewUnauthorized("invalid token"))).To(Equal(ClassUnauthorized))
+			Expect(ClassOf(apierrors.NewForbidden(resource, "pod", errors.New("forbidden")))).To(Equal(ClassUnauthorized))
+			Expect(ClassOf(apierrors.NewConflict(resource, "pod", errors.New("conflict")))).To(Equal(ClassTransient))
+			Expect(ClassOf(apierrors.NewNotFound(resource, "pod"))).To(Equal(ClassUnknown))
+		})
+
+		It("should classify the error of a canceled context", func() {
+			Expect(ClassOf(context.Canceled)).To(Equal(ClassCanceled))
+			Expect(IsCanceled(Canceled(errors.New("aborted")))).To(BeTrue())
+		})
+
+		It("should return ClassUnknown for unclassified and nil errors", func() {
+			Expect(ClassOf(errors.New("error"))).To(Equal(ClassUnknown))
+			Expect(ClassOf(nil)).To(Equal(ClassUnknown))
+		})
+	})
+
+	Describe("#IsRetriable", func() {
+		It("should only consider transient and unclassified errors retriable", func() {
+			Expect(IsRetriable(ClassUnknown)).To(BeTrue())
+			Expect(IsRetriable(ClassTransient)).To(BeTrue())
+			Expect(IsRetriable(ClassUnauthorized)).To(BeFalse())
+			Expect(IsRetriable(ClassQuotaExceeded)).To(BeFalse())
+			Expect(IsRetriable(ClassConfiguration)).To(BeFalse())
+			Expect(IsRetriable(ClassCanceled)).To(BeFalse())
+		})
+	})
+
+	Describe("#AggregateClass", func() {
+		It("should prefer the classes of errors which require an action of the user", func() {
+			Expect(AggregateClass(ClassTransient, ClassQuotaExceeded, ClassUnauthorized)).To(Equal(ClassUnauthorized))
+			Expect(AggregateClass(ClassQuotaExceeded, ClassConfiguration)).To(Equal(ClassConfiguration))
+			Expect(AggregateClass(ClassTransient, ClassUnknown)).To(Equal(ClassUnknown))
+		})
+
+		It("should only return ClassTransient if all errors are transient", func() {
+			Expect(AggregateClass(ClassTransient, ClassTransient)).To(Equal(ClassTransient))
+			Expect(AggregateClass()).To(Equal(ClassUnknown))
+		})
+	})
+
+	Describe("#WrapKind", func() {
+		It("should assign the class implied by the kind", func() {
+			err := WrapKind(ErrQuotaExceeded, errors.New("InstanceLimitExceeded"), "machines not created: %s", "InstanceLimitExceeded")
+
+			Expect(err.Error()).To(Equal("machines not created: InstanceLimitExceeded"))
+			Expect(IsQuotaExceeded(err)).To(BeTrue())
+			Expect(IsUnauthorized(WrapKind(ErrInvalidCredentials, errors.New("AuthFailure"), "failed"))).To(BeTrue())
+			Expect(IsTransient(WrapKind(ErrWaitTimeout, errors.New("timeout"), "failed"))).To(BeTrue())
+		})
+
+		It("should keep the class of the cause if the kind does not imply one", func() {
+			err := WrapKind(ErrMachineClassGeneration, Configuration(errors.New("unknown machine image")), "failed")
+
+			Expect(IsConfiguration(err)).To(BeTrue())
+			Expect(err.(interface{ Retriable() bool }).Retriable()).To(BeFalse())
+			Expect(ClassOf(WrapKind(ErrMachineDeployment, errors.New("error"), "failed"))).To(Equal(ClassUnknown))
+		})
+
+		It("should return nil for a nil error", func() {
+			Expect(WrapKind(ErrMachineDeployment, nil, "failed")).To(BeNil())
+		})
+	})
+
+	Describe("#Is", func() {
+		It("should find the kind in the chain of causes", func() {
+			err := Wrapf(WrapKind(ErrQuotaExceeded, errors.New("quota"), "failed"), "deployment failed")
+
+			Expect(Is(err, ErrQuotaExceeded)).To(BeTrue())
+			Expect(Is(err, ErrWaitTimeout)).To(BeFalse())
+			Expect(Is(ErrWaitTimeout, ErrWaitTimeout)).To(BeTrue())
+			Expect(Is(errors.New("error"), ErrWaitTimeout)).To(BeFalse())
+			Expect(Is(nil, ErrWaitTimeout)).To(BeFalse())
+		})
+	})
+
+	Describe("#HasRetriableErrorCodes", func() {
+		It("should return false if the last error contains a configuration problem", func() {
+			Expect(HasRetriableErrorCodes(&gardenv1beta1.LastError{
+				Codes: []gardenv1beta1.ErrorCode{gardenv1beta1.ErrorInfraQuotaExceeded, gardenv1beta1.ErrorConfigurationProblem},
+			})).To(BeFalse())
+		})
+
+		It("should return true for other codes", func() {
+			Expect(HasRetriableErrorCodes(&gardenv1beta1.LastError{
+				Codes: []gardenv1beta1.ErrorCode{gardenv1beta1.ErrorInfraUnauthorized},
+			})).To(BeTrue())
+			Expect(HasRetriableErrorCodes(nil)).To(BeTrue())
+		})
+	})
+})
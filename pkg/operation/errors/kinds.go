@@ -0,0 +1,84 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The kinds of errors of the operations on the machines of a Shoot. The errors returned by these operations can be
+// checked against them with Is. Errors of the kinds ErrQuotaExceeded, ErrInvalidCredentials and ErrWaitTimeout have
+// the class implied by their kind, all other errors keep the class of their cause.
+var (
+	// ErrMachineClassGeneration indicates that the cloud specific machine classes could not be generated.
+	ErrMachineClassGeneration = errors.New("machine class generation failed")
+	// ErrMachineCapacity indicates that the cloud provider lacks the capacity for the machines or does not offer their
+	// machine images.
+	ErrMachineCapacity = errors.New("machine capacity check failed")
+	// ErrMachineClassDeployment indicates that the machine classes could not be deployed into the Seed cluster.
+	ErrMachineClassDeployment = errors.New("machine class deployment failed")
+	// ErrMachineDeployment indicates that the machine deployments could not be deployed or rolled out.
+	ErrMachineDeployment = errors.New("machine deployment failed")
+	// ErrMachineCleanup indicates that outdated machine resources could not be deleted.
+	ErrMachineCleanup = errors.New("machine cleanup failed")
+	// ErrQuotaExceeded indicates that the machines could not be created because of exceeded quota limits of the cloud
+	// provider.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+	// ErrInvalidCredentials indicates that the machines could not be created because the cloud provider credentials are
+	// invalid or lack privileges.
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrWaitTimeout indicates that the machines did not become ready (or were not deleted) in time.
+	ErrWaitTimeout = errors.New("timed out waiting for the machines")
+)
+
+// kindClasses maps the kinds of errors to the classes they imply.
+var kindClasses = map[error]Class{
+	ErrQuotaExceeded:      ClassQuotaExceeded,
+	ErrInvalidCredentials: ClassUnauthorized,
+	ErrWaitTimeout:        ClassTransient,
+}
+
+// WrapKind returns an error of the given <kind> with the message formatted according to <format> and <args>, caused
+// by <err>. Its class is the one implied by <kind>, or the class of <err> if <kind> does not imply one. It returns
+// nil if <err> is nil.
+func WrapKind(kind, err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	class, ok := kindClasses[kind]
+	if !ok {
+		class = ClassOf(err)
+	}
+	return &classifiedError{class: class, kind: kind, message: fmt.Sprintf(format, args...), cause: err}
+}
+
+// Is returns true if the given <err> or one of its causes is of the given <kind>, or is <kind> itself.
+func Is(err, kind error) bool {
+	for err != nil {
+		if err == kind {
+			return true
+		}
+		e, ok := err.(*classifiedError)
+		if !ok {
+			return false
+		}
+		if e.kind == kind {
+			return true
+		}
+		err = e.cause
+	}
+	return false
+}
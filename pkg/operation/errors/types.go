@@ -18,8 +18,39 @@ import (
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
-// Error is a representation of an error (a description along with a well-defined error code).
+// Error is a representation of an error (a description along with a well-defined error code and its class).
 type Error struct {
 	Code        *gardenv1beta1.ErrorCode
+	Class       Class
 	Description string
 }
+
+// Class describes how an error should be handled, e.g. whether the failed operation should be retried.
+type Class string
+
+const (
+	// ClassUnknown is the class of errors which have not been classified. They are treated like transient errors.
+	ClassUnknown Class = ""
+	// ClassTransient is the class of errors which are expected to disappear when the operation is retried, e.g.
+	// timeouts or resources which are not ready yet.
+	ClassTransient Class = "Transient"
+	// ClassUnauthorized is the class of errors which are caused by invalid or insufficient credentials.
+	ClassUnauthorized Class = "Unauthorized"
+	// ClassQuotaExceeded is the class of errors which are caused by exceeded quota limits of the cloud provider.
+	ClassQuotaExceeded Class = "QuotaExceeded"
+	// ClassConfiguration is the class of errors which are caused by an invalid or unsupported configuration of the
+	// Shoot, its cloud profile or the Gardener. Only a change of the configuration resolves them.
+	ClassConfiguration Class = "Configuration"
+	// ClassCanceled is the class of errors which are caused by an aborted operation, e.g. because the Shoot has been
+	// deleted or changed in the meantime, or because the Gardener is shutting down. The operation must not be retried
+	// right away, but it is started again with the next reconciliation.
+	ClassCanceled Class = "Canceled"
+)
+
+// classifiedError is an error which has been assigned a class.
+type classifiedError struct {
+	class   Class
+	kind    error
+	message string
+	cause   error
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdlock
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	dataKeyHolder        = "holder"
+	dataKeyReason        = "reason"
+	dataKeyAcquireTime   = "acquireTime"
+	dataKeyRenewTime     = "renewTime"
+	dataKeyLeaseDuration = "leaseDurationSeconds"
+)
+
+// New returns the etcd operation lock which is stored in the ConfigMap ConfigMapName of the given <client> (the
+// ConfigMaps of the namespace of the Shoot in the Seed) for the given <holder>.
+func New(client corev1client.ConfigMapInterface, holder string, leaseDuration time.Duration) *Lock {
+	return &Lock{
+		client:        client,
+		holder:        holder,
+		leaseDuration: leaseDuration,
+		now:           time.Now,
+	}
+}
+
+// Get returns the current holder of the lock, or nil if the lock is not held (or has expired).
+func (l *Lock) Get() (*Record, error) {
+	configMap, err := l.client.Get(ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	record := decodeRecord(configMap)
+	if record == nil || record.Expired(l.now()) {
+		return nil, nil
+	}
+	return record, nil
+}
+
+// Acquire acquires the lock for the given <reason>, or renews it if it is already held by the holder of the Lock.
+// It returns a HeldError if the lock is held by another holder and has not expired yet. Concurrent attempts to
+// acquire the lock are resolved by the API server, all but one of them fail with a conflict.
+func (l *Lock) Acquire(reason Reason) (*Record, error) {
+	now := l.now()
+
+	configMap, err := l.client.Get(ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		record := &Record{Holder: l.holder, Reason: reason, AcquireTime: now, RenewTime: now, LeaseDuration: l.leaseDuration}
+		if _, err := l.client.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName},
+			Data:       encodeRecord(record),
+		}); err != nil {
+			return nil, err
+		}
+		return record, nil
+	}
+
+	record := decodeRecord(configMap)
+	switch {
+	case record == nil || record.Holder != l.holder && record.Expired(now):
+		record = &Record{Holder: l.holder, Reason: reason, AcquireTime: now}
+	case record.Holder != l.holder:
+		return nil, &HeldError{Record: record}
+	case record.Reason != reason:
+		record.Reason = reason
+		record.AcquireTime = now
+	}
+	record.RenewTime = now
+	record.LeaseDuration = l.leaseDuration
+
+	configMap = configMap.DeepCopy()
+	configMap.Data = encodeRecord(record)
+	if _, err := l.client.Update(configMap); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Release releases the lock if it is held by the holder of the Lock.
+func (l *Lock) Release() error {
+	configMap, err := l.client.Get(ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if record := decodeRecord(configMap); record == nil || record.Holder != l.holder {
+		return nil
+	}
+
+	// The precondition ensures that a lock which has been taken over by another holder in the meantime is kept.
+	if err := l.client.Delete(ConfigMapName, &metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &configMap.UID},
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// Hold acquires the lock for the given <reason> and renews it in the background until the returned function is
+// called, which releases it.
+func (l *Lock) Hold(reason Reason, logger *logrus.Entry) (*Record, func() error, error) {
+	record, err := l.Acquire(reason)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		stopCh = make(chan struct{})
+		done   sync.WaitGroup
+	)
+	done.Add(1)
+	go func() {
+		defer done.Done()
+		ticker := time.NewTicker(l.leaseDuration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopCh:
+				return
+			case <-ticker.C:
+				if _, err := l.Acquire(reason); err != nil {
+					logger.Warnf("Could not renew the etcd operation lock: %v", err)
+				}
+			}
+		}
+	}()
+
+	var stopOnce sync.Once
+	release := func() error {
+		stopOnce.Do(func() {
+			close(stopCh)
+			done.Wait()
+		})
+		return l.Release()
+	}
+	return record, release, nil
+}
+
+func encodeRecord(record *Record) map[string]string {
+	return map[string]string{
+		dataKeyHolder:        record.Holder,
+		dataKeyReason:        string(record.Reason),
+		dataKeyAcquireTime:   record.AcquireTime.UTC().Format(time.RFC3339),
+		dataKeyRenewTime:     record.RenewTime.UTC().Format(time.RFC3339),
+		dataKeyLeaseDuration: strconv.Itoa(int(record.LeaseDuration / time.Second)),
+	}
+}
+
+// decodeRecord returns the record stored in the given <configMap>, or nil if it does not contain a valid record,
+// e.g. because it has been modified manually. Such a lock is considered to be free.
+func decodeRecord(configMap *corev1.ConfigMap) *Record {
+	data := configMap.Data
+	if len(data[dataKeyHolder]) == 0 {
+		return nil
+	}
+	acquireTime, err := time.Parse(time.RFC3339, data[dataKeyAcquireTime])
+	if err != nil {
+		return nil
+	}
+	renewTime, err := time.Parse(time.RFC3339, data[dataKeyRenewTime])
+	if err != nil {
+		return nil
+	}
+	leaseDuration, err := strconv.Atoi(data[dataKeyLeaseDuration])
+	if err != nil {
+		return nil
+	}
+	return &Record{
+		Holder:        data[dataKeyHolder],
+		Reason:        Reason(data[dataKeyReason]),
+		AcquireTime:   acquireTime,
+		RenewTime:     renewTime,
+		LeaseDuration: time.Duration(leaseDuration) * time.Second,
+	}
+}
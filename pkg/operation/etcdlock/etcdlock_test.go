@@ -0,0 +1,192 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdlock_test
+
+import (
+	"strconv"
+	"time"
+
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	. "github.com/gardener/gardener/pkg/operation/etcdlock"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// fakeConfigMaps stores at most one ConfigMap and rejects updates of outdated versions like the API server.
+type fakeConfigMaps struct {
+	corev1client.ConfigMapInterface
+	configMap *corev1.ConfigMap
+	version   int
+}
+
+var configMapsResource = schema.GroupResource{Resource: "configmaps"}
+
+func (f *fakeConfigMaps) Get(name string, options metav1.GetOptions) (*corev1.ConfigMap, error) {
+	if f.configMap == nil {
+		return nil, apierrors.NewNotFound(configMapsResource, name)
+	}
+	return f.configMap.DeepCopy(), nil
+}
+
+func (f *fakeConfigMaps) Create(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	if f.configMap != nil {
+		return nil, apierrors.NewAlreadyExists(configMapsResource, configMap.Name)
+	}
+	f.version++
+	f.configMap = configMap.DeepCopy()
+	f.configMap.UID = types.UID(strconv.Itoa(f.version))
+	f.configMap.ResourceVersion = strconv.Itoa(f.version)
+	return f.configMap.DeepCopy(), nil
+}
+
+func (f *fakeConfigMaps) Update(configMap *corev1.ConfigMap) (*corev1.ConfigMap, error) {
+	if f.configMap == nil {
+		return nil, apierrors.NewNotFound(configMapsResource, configMap.Name)
+	}
+	if configMap.ResourceVersion != f.configMap.ResourceVersion {
+		return nil, apierrors.NewConflict(configMapsResource, configMap.Name, nil)
+	}
+	f.version++
+	f.configMap = configMap.DeepCopy()
+	f.configMap.ResourceVersion = strconv.Itoa(f.version)
+	return f.configMap.DeepCopy(), nil
+}
+
+func (f *fakeConfigMaps) Delete(name string, options *metav1.DeleteOptions) error {
+	if f.configMap == nil {
+		return apierrors.NewNotFound(configMapsResource, name)
+	}
+	if options != nil && options.Preconditions != nil && options.Preconditions.UID != nil && *options.Preconditions.UID != f.configMap.UID {
+		return apierrors.NewConflict(configMapsResource, name, nil)
+	}
+	f.configMap = nil
+	return nil
+}
+
+var _ = Describe("etcd operation lock", func() {
+	var (
+		configMaps *fakeConfigMaps
+		now        time.Time
+
+		newLock = func(holder string) *Lock {
+			lock := New(configMaps, holder, 5*time.Minute)
+			ExportSetNow(lock, func() time.Time { return now })
+			return lock
+		}
+	)
+
+	BeforeEach(func() {
+		configMaps = &fakeConfigMaps{}
+		now = time.Date(2018, 7, 2, 10, 0, 0, 0, time.UTC)
+	})
+
+	It("should acquire a free lock", func() {
+		record, err := newLock("gardener/shoot").Acquire(ReasonHibernation)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.Holder).To(Equal("gardener/shoot"))
+		Expect(record.Reason).To(Equal(ReasonHibernation))
+		Expect(record.AcquireTime).To(Equal(now))
+		Expect(configMaps.configMap.Name).To(Equal(ConfigMapName))
+		Expect(configMaps.configMap.Data).To(HaveKeyWithValue("holder", "gardener/shoot"))
+		Expect(configMaps.configMap.Data).To(HaveKeyWithValue("reason", "Hibernation"))
+	})
+
+	It("should report the holder of the lock", func() {
+		_, err := newLock("gardener/shoot").Acquire(ReasonRestore)
+		Expect(err).NotTo(HaveOccurred())
+
+		record, err := newLock("gardener/backupinfrastructure").Get()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.Holder).To(Equal("gardener/shoot"))
+		Expect(record.Reason).To(Equal(ReasonRestore))
+	})
+
+	It("should not acquire a lock which is held by another holder", func() {
+		_, err := newLock("gardener/shoot").Acquire(ReasonHibernation)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(time.Minute)
+		_, err = newLock("gardener/backupinfrastructure").Acquire(ReasonBackup)
+
+		Expect(err).To(BeAssignableToTypeOf(&HeldError{}))
+		Expect(err.Error()).To(Equal("the etcd operation lock is held by gardener/shoot for the Hibernation since 2018-07-02T10:00:00Z"))
+		Expect(operationerrors.ClassOf(err)).To(Equal(operationerrors.ClassTransient))
+	})
+
+	It("should renew a lock which is held by the same holder and keep its acquire time", func() {
+		lock := newLock("gardener/shoot")
+		_, err := lock.Acquire(ReasonHibernation)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(4 * time.Minute)
+		record, err := lock.Acquire(ReasonHibernation)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.AcquireTime).To(Equal(now.Add(-4 * time.Minute)))
+		Expect(record.RenewTime).To(Equal(now))
+
+		now = now.Add(4 * time.Minute)
+		_, err = newLock("gardener/backupinfrastructure").Acquire(ReasonBackup)
+		Expect(err).To(BeAssignableToTypeOf(&HeldError{}))
+	})
+
+	It("should take over an expired lock", func() {
+		_, err := newLock("gardener/shoot").Acquire(ReasonHibernation)
+		Expect(err).NotTo(HaveOccurred())
+
+		now = now.Add(6 * time.Minute)
+		Expect(newLock("gardener/shoot").Get()).To(BeNil())
+		record, err := newLock("gardener/backupinfrastructure").Acquire(ReasonBackup)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.Holder).To(Equal("gardener/backupinfrastructure"))
+		Expect(record.AcquireTime).To(Equal(now))
+	})
+
+	It("should release the lock only for its holder", func() {
+		_, err := newLock("gardener/shoot").Acquire(ReasonRestore)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(newLock("gardener/backupinfrastructure").Release()).To(Succeed())
+		Expect(configMaps.configMap).NotTo(BeNil())
+
+		Expect(newLock("gardener/shoot").Release()).To(Succeed())
+		Expect(configMaps.configMap).To(BeNil())
+		Expect(newLock("gardener/shoot").Release()).To(Succeed())
+	})
+
+	It("should consider a lock without a valid record as free", func() {
+		_, err := configMaps.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName},
+			Data:       map[string]string{"holder": "someone"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		record, err := newLock("gardener/shoot").Acquire(ReasonHibernation)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(record.Holder).To(Equal("gardener/shoot"))
+	})
+})
@@ -0,0 +1,91 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdlock
+
+import (
+	"fmt"
+	"time"
+
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+// ConfigMapName is the name of the ConfigMap in the namespace of a Shoot in the Seed which holds its etcd operation
+// lock.
+const ConfigMapName = "etcd-operation-lock"
+
+// DefaultLeaseDuration is the duration after which a lock which has not been renewed can be taken over by another
+// holder, e.g. because the Gardener which held it has been restarted.
+const DefaultLeaseDuration = 5 * time.Minute
+
+// Reason is the operation for which the etcd operation lock of a Shoot is held.
+type Reason string
+
+const (
+	// ReasonHibernation is the reason of the lock while a hibernated Shoot is reconciled, i.e. while its machines
+	// are scaled down or up.
+	ReasonHibernation Reason = "Hibernation"
+	// ReasonBackup is the reason of the lock while the backup infrastructure of the etcd of a Shoot is reconciled
+	// or deleted.
+	ReasonBackup Reason = "Backup"
+	// ReasonRestore is the reason of the lock while the etcd of a cloned Shoot is restored from the backups of its
+	// source Shoot.
+	ReasonRestore Reason = "Restore"
+	// ReasonRecoveryDrill is the reason of the lock while the backups of the etcd of a Shoot are restored into a scratch
+	// namespace to verify them.
+	ReasonRecoveryDrill Reason = "RecoveryDrill"
+)
+
+// Record describes the holder of an etcd operation lock.
+type Record struct {
+	// Holder is the identity of the holder of the lock.
+	Holder string
+	// Reason is the operation for which the lock is held.
+	Reason Reason
+	// AcquireTime is the time when the holder has acquired the lock.
+	AcquireTime time.Time
+	// RenewTime is the time when the holder has renewed the lock the last time.
+	RenewTime time.Time
+	// LeaseDuration is the duration after which the lock expires if it is not renewed.
+	LeaseDuration time.Duration
+}
+
+// Expired returns true if the lock has not been renewed within its lease duration before <now>.
+func (r *Record) Expired(now time.Time) bool {
+	return now.After(r.RenewTime.Add(r.LeaseDuration))
+}
+
+// Lock is the etcd operation lock of a Shoot. It prevents that operations which must not overlap, e.g. the
+// hibernation and the restore of the etcd, run concurrently for the same Shoot.
+type Lock struct {
+	client        corev1client.ConfigMapInterface
+	holder        string
+	leaseDuration time.Duration
+	now           func() time.Time
+}
+
+// HeldError is returned when the lock is held by another holder.
+type HeldError struct {
+	Record *Record
+}
+
+func (e *HeldError) Error() string {
+	return fmt.Sprintf("the etcd operation lock is held by %s for the %s since %s", e.Record.Holder, e.Record.Reason, e.Record.AcquireTime.UTC().Format(time.RFC3339))
+}
+
+// Class returns the class of the error. The operation is retried later, when the lock might have been released.
+func (e *HeldError) Class() operationerrors.Class {
+	return operationerrors.ClassTransient
+}
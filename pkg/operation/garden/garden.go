@@ -52,6 +52,7 @@ func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory, running
 		secretsMap                          = make(map[string]*corev1.Secret)
 		numberOfInternalDomainSecrets       = 0
 		numberOfOpenVPNDiffieHellmanSecrets = 0
+		numberOfSecretHistoryKeySecrets     = 0
 	)
 
 	selector, err := labels.Parse(common.GardenRole)
@@ -117,6 +118,19 @@ func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory, running
 			logger.Logger.Infof("Found OpenVPN Diffie Hellman secret %s.", name)
 			numberOfOpenVPNDiffieHellmanSecrets++
 		}
+
+		// Retrieving the key for the encryption of the secret history based on all secrets in the Garden namespace
+		// which have a label indicating the Garden role secret-history-encryption.
+		if labels[common.GardenRole] == common.GardenRoleSecretHistoryEncryption {
+			secretHistoryKey := secret
+			key := "key"
+			if length := len(secret.Data[key]); length != 16 && length != 24 && length != 32 {
+				return nil, fmt.Errorf("cannot use secret history encryption secret '%s' as its key '%s' must be 16, 24 or 32 bytes long, but it is %d bytes long", secret.Name, key, length)
+			}
+			secretsMap[common.GardenRoleSecretHistoryEncryption] = secretHistoryKey
+			logger.Logger.Infof("Found secret history encryption secret %s.", name)
+			numberOfSecretHistoryKeySecrets++
+		}
 	}
 
 	// For each Shoot we create a LoadBalancer(LB) pointing to the API server of the Shoot. Because the technical address
@@ -140,6 +154,13 @@ func ReadGardenSecrets(k8sInformers kubeinformers.SharedInformerFactory, running
 		return nil, fmt.Errorf("can only accept at most one OpenVPN Diffie Hellman secret, but found %d", numberOfOpenVPNDiffieHellmanSecrets)
 	}
 
+	// The previous versions of the generated secrets of the Shoots are only retained if they can be encrypted with the
+	// key of the secret history encryption secret. There can only be one such secret, otherwise older versions could
+	// not be decrypted anymore.
+	if numberOfSecretHistoryKeySecrets > 1 {
+		return nil, fmt.Errorf("can only accept at most one secret history encryption secret, but found %d", numberOfSecretHistoryKeySecrets)
+	}
+
 	return secretsMap, nil
 }
 
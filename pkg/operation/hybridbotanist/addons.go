@@ -15,11 +15,13 @@
 package hybridbotanist
 
 import (
+	"fmt"
 	"path/filepath"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -60,9 +62,36 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 				"checksum/secret-vpn-shoot": b.CheckSums["vpn-shoot"],
 			},
 		}
-		nodeExporterConfig = map[string]interface{}{}
+		nodeExporterConfig           = map[string]interface{}{}
+		nodeTerminationHandlerConfig = map[string]interface{}{
+			"workerGroups": b.Shoot.GetPreemptibleWorkerNames(),
+			"provider":     "gcp",
+		}
 	)
 
+	// AWS announces the reclamation of spot instances two minutes in advance, hence, the pods can be drained more
+	// gracefully than on GCP.
+	if b.Shoot.CloudProvider == gardenv1beta1.CloudProviderAWS {
+		nodeTerminationHandlerConfig["provider"] = "aws"
+		nodeTerminationHandlerConfig["drain"] = map[string]interface{}{
+			"gracePeriod": 30,
+			"timeout":     "90s",
+		}
+	}
+
+	nodeRebootCoordinatorConfig, err := b.generateNodeRebootCoordinatorConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	gpuWorkerNames, err := b.Shoot.GetGPUWorkerNames()
+	if err != nil {
+		return nil, err
+	}
+	nvidiaDevicePluginConfig := map[string]interface{}{
+		"workerGroups": gpuWorkerNames,
+	}
+
 	proxyConfig := b.Shoot.Info.Spec.Kubernetes.KubeProxy
 	if proxyConfig != nil {
 		kubeProxyConfig["featureGates"] = proxyConfig.FeatureGates
@@ -92,6 +121,35 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 	if err != nil {
 		return nil, err
 	}
+	nodeTerminationHandler, err := b.Botanist.InjectImages(nodeTerminationHandlerConfig, b.K8sShootClient.Version(), map[string]string{"busybox": "busybox", "hyperkube": "hyperkube"})
+	if err != nil {
+		return nil, err
+	}
+	nodeRebootCoordinator, err := b.Botanist.InjectImages(nodeRebootCoordinatorConfig, b.K8sShootClient.Version(), map[string]string{"hyperkube": "hyperkube"})
+	if err != nil {
+		return nil, err
+	}
+	nvidiaDevicePlugin, err := b.Botanist.InjectImages(nvidiaDevicePluginConfig, b.K8sShootClient.Version(), map[string]string{"nvidia-device-plugin": "nvidia-device-plugin"})
+	if err != nil {
+		return nil, err
+	}
+
+	// The DaemonSets must only be scheduled onto nodes whose CPU architecture is supported by all of their images.
+	if calico["architectures"], err = b.Botanist.ComputeImageArchitectures(b.K8sShootClient.Version(), "calico-node", "calico-cni"); err != nil {
+		return nil, err
+	}
+	if kubeProxy["architectures"], err = b.Botanist.ComputeImageArchitectures(b.K8sShootClient.Version(), "hyperkube"); err != nil {
+		return nil, err
+	}
+	if nodeExporter["architectures"], err = b.Botanist.ComputeImageArchitectures(b.K8sShootClient.Version(), "node-exporter"); err != nil {
+		return nil, err
+	}
+	if nodeTerminationHandler["architectures"], err = b.Botanist.ComputeImageArchitectures(b.K8sShootClient.Version(), "busybox", "hyperkube"); err != nil {
+		return nil, err
+	}
+	if nodeRebootCoordinator["architectures"], err = b.Botanist.ComputeImageArchitectures(b.K8sShootClient.Version(), "hyperkube"); err != nil {
+		return nil, err
+	}
 
 	if _, err := b.K8sShootClient.CreateSecret(metav1.NamespaceSystem, "vpn-shoot", corev1.SecretTypeOpaque, vpnShootSecret.Data, true); err != nil {
 		return nil, err
@@ -106,9 +164,54 @@ func (b *HybridBotanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart
 		"monitoring": map[string]interface{}{
 			"node-exporter": nodeExporter,
 		},
+		"node-termination-handler": nodeTerminationHandler,
+		"node-reboot-coordinator":  nodeRebootCoordinator,
+		"nvidia-device-plugin":     nvidiaDevicePlugin,
+		"cluster-autoscaler": map[string]interface{}{
+			"enabled": b.Shoot.ClusterAutoscalerEnabled(),
+		},
 	})
 }
 
+// generateNodeRebootCoordinatorConfig computes the configuration of the node reboot coordinator which reboots the
+// nodes of the worker groups with automated operating system updates into the installed updates. The coordinator
+// compares the maintenance time window with the UTC time of the nodes.
+func (b *HybridBotanist) generateNodeRebootCoordinatorConfig() (map[string]interface{}, error) {
+	config := map[string]interface{}{
+		"workerGroups": b.Shoot.GetOSUpdatesWorkerNames(),
+	}
+
+	maintenance := b.Shoot.Info.Spec.Maintenance
+	if maintenance == nil || maintenance.TimeWindow == nil {
+		return config, nil
+	}
+
+	begin, err := maintenanceTimeUTC(maintenance.TimeWindow.Begin)
+	if err != nil {
+		return nil, err
+	}
+	end, err := maintenanceTimeUTC(maintenance.TimeWindow.End)
+	if err != nil {
+		return nil, err
+	}
+
+	config["timeWindow"] = map[string]interface{}{
+		"begin": begin,
+		"end":   end,
+	}
+	return config, nil
+}
+
+// maintenanceTimeUTC converts the given <value> in the maintenance time format (HHMMSS+ZONE) into the UTC time of day
+// in the format HHMMSS.
+func maintenanceTimeUTC(value string) (string, error) {
+	t, err := utils.ParseMaintenanceTime(value)
+	if err != nil {
+		return "", fmt.Errorf("Could not parse the maintenance time %q: %s", value, err.Error())
+	}
+	return t.UTC().Format("150405"), nil
+}
+
 // generateOptionalAddonsChart renders the kube-addon-manager chart for the optional addons. It
 // will be stored as a Secret (as it may contain credentials) and mounted into the Pod. The configuration
 // contains specially labelled Kubernetes manifests which will be created and periodically reconciled.
@@ -0,0 +1,37 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("addons", func() {
+	Describe("#maintenanceTimeUTC", func() {
+		It("should convert the maintenance time into the UTC time of day", func() {
+			Expect(ExportMaintenanceTimeUTC("220000+0000")).To(Equal("220000"))
+			Expect(ExportMaintenanceTimeUTC("013000+0200")).To(Equal("233000"))
+			Expect(ExportMaintenanceTimeUTC("230000-0130")).To(Equal("003000"))
+		})
+
+		It("should fail for invalid maintenance times", func() {
+			_, err := ExportMaintenanceTimeUTC("22:00")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
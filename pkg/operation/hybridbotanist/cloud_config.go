@@ -15,19 +15,26 @@
 package hybridbotanist
 
 import (
+	"encoding/base64"
 	"fmt"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	bootstraptokenapi "k8s.io/client-go/tools/bootstrap/token/api"
 )
 
+// rotateKubeletServerCertificateFeatureGate is the name of the feature gate which enables the kubelets to request their
+// serving certificates from the API server (and to rotate them when they are about to expire).
+const rotateKubeletServerCertificateFeatureGate = "RotateKubeletServerCertificate"
+
 // generateCloudConfigChart renders the kube-addon-manager configuration for the cloud config user data.
 // It will be stored as a Secret and mounted into the Pod. The configuration contains
 // specially labelled Kubernetes manifests which will be created and periodically reconciled.
@@ -54,16 +61,74 @@ func (b *HybridBotanist) generateCloudConfigChart() (*chartrenderer.RenderedChar
 		cloudProvider["config"] = cloudProviderConfig
 	}
 
-	hyperKube, err := b.ImageVector.FindImage("hyperkube", b.Shoot.Info.Spec.Kubernetes.Version)
-	if err != nil {
-		return nil, err
+	workersByName := map[string]gardenv1beta1.Worker{}
+	for _, worker := range b.Shoot.GetWorkers() {
+		workersByName[worker.Name] = worker
 	}
 
-	workers := []map[string]interface{}{}
+	cloudConfigWorkers := []gardenv1beta1.Worker{}
 	for _, workerName := range userDataConfig.WorkerNames {
+		worker := workersByName[workerName]
+		worker.Name = workerName
+		cloudConfigWorkers = append(cloudConfigWorkers, worker)
+	}
+	// The nodes of the external worker groups run the same cloud config, but without the cloud provider integration of
+	// the kubelet, as their machines are not managed by the cloud provider.
+	cloudConfigWorkers = append(cloudConfigWorkers, b.Shoot.GetExternalWorkers()...)
+	externalWorkerNames := b.Shoot.GetExternalWorkerNames()
+
+	workers := []map[string]interface{}{}
+	for _, worker := range cloudConfigWorkers {
+		var (
+			workerName        = worker.Name
+			architecture      = helper.GetMachineArchitecture(worker.Architecture)
+			kubernetesVersion = b.Shoot.GetWorkerKubernetesVersion(workerName)
+		)
+
+		// The kubelet binary is copied out of the hyperkube image, hence, it must match the CPU architecture of the machines
+		// and the Kubernetes version of the worker group.
+		hyperKube, err := b.ImageVector.FindImageForArchitecture("hyperkube", kubernetesVersion, string(architecture))
+		if err != nil {
+			return nil, err
+		}
+		images := map[string]interface{}{
+			"hyperkube": hyperKube.String(),
+		}
+
+		// The machines of worker groups with GPU machine types install the NVIDIA driver and container runtime before the
+		// kubelet is started.
+		gpus, err := b.Shoot.GetWorkerGPUs(worker)
+		if err != nil {
+			return nil, err
+		}
+		if gpus > 0 {
+			driverInstaller, err := b.ImageVector.FindImageForArchitecture("nvidia-driver-installer", kubernetesVersion, string(architecture))
+			if err != nil {
+				return nil, err
+			}
+			images["nvidia-driver-installer"] = driverInstaller.String()
+		}
+
+		var localSSDs map[string]interface{}
+		if worker.LocalSSDs != nil {
+			localSSDs = map[string]interface{}{
+				"count":   worker.LocalSSDs.Count,
+				"devices": userDataConfig.LocalSSDDevices,
+			}
+		}
+
 		workers = append(workers, map[string]interface{}{
-			"name":       workerName,
-			"secretName": b.Shoot.ComputeCloudConfigSecretName(workerName),
+			"name":              workerName,
+			"secretName":        b.Shoot.ComputeCloudConfigSecretName(workerName),
+			"kubernetesVersion": kubernetesVersion,
+			"images":            images,
+			"nodeLabels":        computeWorkerNodeLabels(worker, gpus),
+			"nodeTaints":        computeKubeletTaints(computeWorkerTaints(worker, gpus)),
+			"kubelet":           computeWorkerKubeletConfig(worker),
+			"osUpdates":         worker.OSUpdates != nil && worker.OSUpdates.Enabled,
+			"gpu":               gpus > 0,
+			"external":          utils.ValueExists(workerName, externalWorkerNames),
+			"localSSDs":         localSSDs,
 		})
 	}
 
@@ -82,16 +147,14 @@ func (b *HybridBotanist) generateCloudConfigChart() (*chartrenderer.RenderedChar
 			},
 			"version": b.Shoot.Info.Spec.Kubernetes.Version,
 		},
-		"images": map[string]interface{}{
-			"hyperkube": hyperKube.String(),
-		},
 		"workers": workers,
 	}
 
-	kubeletConfig := b.Shoot.Info.Spec.Kubernetes.Kubelet
-	if kubeletConfig != nil {
-		config["kubernetes"].(map[string]interface{})["kubelet"].(map[string]interface{})["featureGates"] = kubeletConfig.FeatureGates
+	featureGates, err := b.computeKubeletFeatureGates()
+	if err != nil {
+		return nil, err
 	}
+	config["kubernetes"].(map[string]interface{})["kubelet"].(map[string]interface{})["featureGates"] = featureGates
 
 	if b.Shoot.CloudProfile.Spec.CABundle != nil {
 		config["caBundle"] = *(b.Shoot.CloudProfile.Spec.CABundle)
@@ -100,6 +163,80 @@ func (b *HybridBotanist) generateCloudConfigChart() (*chartrenderer.RenderedChar
 	return b.ComputeOriginalCloudConfig(config)
 }
 
+// computeCloudConfigChecksums renders the cloud configs of the worker groups and stores their checksums (keyed by the
+// names of the worker groups) in the Shoot, so that they can be considered for the names of the machine classes.
+func (b *HybridBotanist) computeCloudConfigChecksums() error {
+	cloudConfig, err := b.generateCloudConfigChart()
+	if err != nil {
+		return err
+	}
+	objects, err := decodeManifest(cloudConfig.Manifest())
+	if err != nil {
+		return err
+	}
+
+	secretNames := map[string]string{}
+	for _, workerName := range b.Shoot.GetWorkerNames() {
+		secretNames[b.Shoot.ComputeCloudConfigSecretName(workerName)] = workerName
+	}
+
+	checksums, err := cloudConfigChecksums(objects, secretNames)
+	if err != nil {
+		return err
+	}
+	b.Shoot.CloudConfigChecksums = checksums
+	return nil
+}
+
+// cloudConfigChecksums returns the checksums of the cloud configs in the given rendered cloud config secret <objects>,
+// keyed by the name of the worker group which is mapped to the name of the secret in <secretNames>.
+func cloudConfigChecksums(objects []*unstructured.Unstructured, secretNames map[string]string) (map[string]string, error) {
+	checksums := map[string]string{}
+	for _, obj := range objects {
+		workerName, ok := secretNames[obj.GetName()]
+		if !ok || obj.GetKind() != "Secret" {
+			continue
+		}
+
+		encoded, _, err := unstructured.NestedString(obj.Object, "data", "checksum")
+		if err != nil {
+			return nil, err
+		}
+		checksum, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid checksum of the cloud config of worker group %s: %s", workerName, err.Error())
+		}
+		checksums[workerName] = string(checksum)
+	}
+	return checksums, nil
+}
+
+// computeKubeletFeatureGates returns the feature gates of the kubelets. The rotation of the kubelet serving certificates
+// is enabled by default if any worker group runs a Kubernetes version in which the respective feature gate is not yet
+// enabled by default, unless the user has explicitly configured it.
+func (b *HybridBotanist) computeKubeletFeatureGates() (map[string]bool, error) {
+	featureGates := map[string]bool{}
+	if kubeletConfig := b.Shoot.Info.Spec.Kubernetes.Kubelet; kubeletConfig != nil {
+		for feature, enabled := range kubeletConfig.FeatureGates {
+			featureGates[feature] = enabled
+		}
+	}
+
+	rotationEnabledByDefault := true
+	for _, worker := range append(b.Shoot.GetWorkers(), b.Shoot.GetExternalWorkers()...) {
+		enabled, err := utils.CompareVersions(b.Shoot.GetWorkerKubernetesVersion(worker.Name), ">=", "1.12")
+		if err != nil {
+			return nil, err
+		}
+		rotationEnabledByDefault = rotationEnabledByDefault && enabled
+	}
+	if _, ok := featureGates[rotateKubeletServerCertificateFeatureGate]; !ok && !rotationEnabledByDefault {
+		featureGates[rotateKubeletServerCertificateFeatureGate] = true
+	}
+
+	return featureGates, nil
+}
+
 func (b *HybridBotanist) computeBootstrapToken() (secret *corev1.Secret, err error) {
 	var (
 		key        = "bootstrap-token"
@@ -122,3 +259,94 @@ func (b *HybridBotanist) computeBootstrapToken() (secret *corev1.Secret, err err
 
 	return secret, err
 }
+
+// computeWorkerNodeLabels returns the labels with which the nodes of the given <worker> register, i.e. its node labels
+// and, if its machine type has <gpus>, the accelerator labels.
+func computeWorkerNodeLabels(worker gardenv1beta1.Worker, gpus int) map[string]string {
+	if gpus == 0 {
+		return worker.NodeLabels
+	}
+	labels := gpuNodeLabels(gpus)
+	for key, value := range worker.NodeLabels {
+		labels[key] = value
+	}
+	return labels
+}
+
+// computeWorkerTaints returns the taints with which the nodes of the given <worker> register. Nodes of worker groups
+// which wait for the critical components, of spot worker groups which request it and of worker groups whose machine
+// type has <gpus> additionally register with the respective taints.
+func computeWorkerTaints(worker gardenv1beta1.Worker, gpus int) []corev1.Taint {
+	taints := worker.NodeTaints
+	if worker.WaitForCriticalComponents != nil && *worker.WaitForCriticalComponents {
+		taints = append(append([]corev1.Taint{}, taints...), corev1.Taint{
+			Key:    common.CriticalComponentsNotReadyTaint,
+			Value:  "true",
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+	}
+	if worker.Spot != nil && worker.Spot.Taint != nil && *worker.Spot.Taint {
+		taints = append(append([]corev1.Taint{}, taints...), corev1.Taint{
+			Key:    common.SpotTaint,
+			Value:  "true",
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+	}
+	if gpus > 0 && !hasTaintKey(taints, common.GPUTaint) {
+		taints = append(append([]corev1.Taint{}, taints...), gpuTaint)
+	}
+	return taints
+}
+
+// computeKubeletTaints returns the taints in the format expected by the `--register-with-taints` flag of the kubelet,
+// i.e. `<key>=<value>:<effect>`.
+func computeKubeletTaints(taints []corev1.Taint) []string {
+	kubeletTaints := make([]string, 0, len(taints))
+	for _, taint := range taints {
+		kubeletTaints = append(kubeletTaints, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+	}
+	return kubeletTaints
+}
+
+// computeWorkerKubeletConfig returns the kubelet configuration of the given <worker> for the cloud config chart. The
+// eviction thresholds and the reserved resources are always contained (possibly empty), so that the chart can fall
+// back to its defaults for those which are not configured.
+func computeWorkerKubeletConfig(worker gardenv1beta1.Worker) map[string]interface{} {
+	config := map[string]interface{}{
+		"evictionHard":   map[string]string{},
+		"evictionSoft":   map[string]string{},
+		"kubeReserved":   map[string]string{},
+		"systemReserved": map[string]string{},
+	}
+
+	kubelet := worker.Kubelet
+	if kubelet == nil {
+		return config
+	}
+
+	if kubelet.EvictionHard != nil {
+		config["evictionHard"] = kubelet.EvictionHard
+	}
+	if kubelet.EvictionSoft != nil {
+		config["evictionSoft"] = kubelet.EvictionSoft
+	}
+	config["kubeReserved"] = reservedResources(kubelet.KubeReserved)
+	config["systemReserved"] = reservedResources(kubelet.SystemReserved)
+	if kubelet.MaxPods != nil {
+		config["maxPods"] = *kubelet.MaxPods
+	}
+	if kubelet.CPUManagerPolicy != nil {
+		config["cpuManagerPolicy"] = *kubelet.CPUManagerPolicy
+	}
+
+	return config
+}
+
+// reservedResources returns the given <resources> in the format of the reservations in the kubelet config file.
+func reservedResources(resources corev1.ResourceList) map[string]string {
+	reserved := make(map[string]string, len(resources))
+	for name, quantity := range resources {
+		reserved[string(name)] = quantity.String()
+	}
+	return reserved
+}
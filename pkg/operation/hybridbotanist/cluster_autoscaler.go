@@ -0,0 +1,68 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+)
+
+// DeployClusterAutoscaler deploys the cluster-autoscaler into the Shoot namespace in the Seed cluster. It scales the
+// machine deployments of all worker groups whose bounds allow scaling. The cluster-autoscaler is deleted if the addon
+// is disabled, the Shoot is hibernated, or no worker group can be scaled.
+func (b *HybridBotanist) DeployClusterAutoscaler() error {
+	if !b.Shoot.ClusterAutoscalerEnabled() || b.Shoot.Hibernated {
+		return b.Botanist.DeleteClusterAutoscaler()
+	}
+
+	_, machineDeployments, err := b.ShootCloudBotanist.GenerateMachineConfig()
+	if err != nil {
+		return operationerrors.Wrapf(err, "The CloudBotanist failed to generate the machine config: '%s'", err.Error())
+	}
+
+	var workerPools []map[string]interface{}
+	for _, deployment := range machineDeployments {
+		if !b.machineDeploymentAutoscaled(deployment) {
+			continue
+		}
+		workerPools = append(workerPools, map[string]interface{}{
+			"name": deployment.Name,
+			"min":  deployment.Minimum,
+			"max":  deployment.Maximum,
+		})
+	}
+	if len(workerPools) == 0 {
+		return b.Botanist.DeleteClusterAutoscaler()
+	}
+
+	var (
+		name          = common.ClusterAutoscalerDeploymentName
+		defaultValues = map[string]interface{}{
+			"podAnnotations": map[string]interface{}{
+				"checksum/secret-cluster-autoscaler": b.CheckSums[name],
+			},
+			"workerPools": workerPools,
+		}
+	)
+
+	values, err := b.Botanist.InjectImages(defaultValues, b.K8sSeedClient.Version(), map[string]string{name: name})
+	if err != nil {
+		return err
+	}
+
+	return b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-controlplane", "charts", name), name, b.Shoot.SeedNamespace, nil, values)
+}
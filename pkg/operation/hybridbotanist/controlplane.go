@@ -17,7 +17,9 @@ package hybridbotanist
 import (
 	"fmt"
 	"path/filepath"
+	"time"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
@@ -56,6 +58,12 @@ func (b *HybridBotanist) DeployETCD() error {
 		etcdConfig["backup"] = backupConfigData
 	}
 
+	// A cloned Shoot restores the main etcd from the most recent backup of its source Shoot while it is created.
+	restoreConfigData, err := b.generateEtcdRestoreConfig()
+	if err != nil {
+		return err
+	}
+
 	etcd, err := b.Botanist.InjectImages(etcdConfig, b.K8sSeedClient.Version(), map[string]string{"etcd": "etcd", "etcd-backup-restore": "etcd-backup-restore"})
 	if err != nil {
 		return err
@@ -63,10 +71,14 @@ func (b *HybridBotanist) DeployETCD() error {
 
 	for _, role := range []string{common.EtcdRoleMain, common.EtcdRoleEvents} {
 		etcd["role"] = role
+		if role == common.EtcdRoleMain && restoreConfigData != nil {
+			etcd["restore"] = restoreConfigData
+		}
 		if role == common.EtcdRoleEvents {
 			etcd["backup"] = map[string]interface{}{
 				"storageProvider": "", //No storage provider means no backup
 			}
+			delete(etcd, "restore")
 		}
 		if err := b.ApplyChartSeed(filepath.Join(chartPathControlPlane, "etcd"), fmt.Sprintf("etcd-%s", role), b.Shoot.SeedNamespace, nil, etcd); err != nil {
 			return err
@@ -79,6 +91,38 @@ func (b *HybridBotanist) DeployETCD() error {
 	return nil
 }
 
+// generateEtcdRestoreConfig returns the restore configuration for the main etcd of a cloned Shoot and creates the
+// secret containing the credentials for accessing the backups of the source Shoot. It returns nil if the Shoot is not
+// a clone or if its creation has already been completed.
+func (b *HybridBotanist) generateEtcdRestoreConfig() (map[string]interface{}, error) {
+	sourceName := b.Shoot.CloneSourceName()
+	if len(sourceName) == 0 {
+		return nil, nil
+	}
+
+	source, err := b.K8sGardenInformers.Shoots().Lister().Shoots(b.Shoot.Info.Namespace).Get(sourceName)
+	if err != nil {
+		return nil, fmt.Errorf("could not find the clone source %s: %v", sourceName, err)
+	}
+
+	secretData, restoreConfigData, err := b.SeedCloudBotanist.GenerateEtcdRestoreConfig(source)
+	if err != nil {
+		return nil, err
+	}
+	// Some cloud botanists do not yet support backup and won't return secret or restore config data.
+	if restoreConfigData == nil {
+		return nil, nil
+	}
+	if secretData != nil {
+		if _, err := b.K8sSeedClient.CreateSecret(b.Shoot.SeedNamespace, common.RestoreSecretName, corev1.SecretTypeOpaque, secretData, true); err != nil {
+			return nil, err
+		}
+	}
+
+	restoreConfigData["restoreSecret"] = common.RestoreSecretName
+	return restoreConfigData, nil
+}
+
 // DeployCloudProviderConfig asks the Cloud Botanist to provide the cloud specific values for the cloud
 // provider configuration. It will create a ConfigMap for it and store it in the Seed cluster.
 func (b *HybridBotanist) DeployCloudProviderConfig() error {
@@ -158,6 +202,9 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 			defaultValues["oidcConfig"] = apiServerConfig.OIDCConfig
 		}
 	}
+	for key, value := range computeKubeAPIServerThroughputConfig(apiServerConfig, b.Shoot.GetNodeCount()) {
+		defaultValues[key] = value
+	}
 
 	values, err := b.Botanist.InjectImages(defaultValues, b.K8sSeedClient.Version(), map[string]string{
 		"hyperkube":         "hyperkube",
@@ -176,6 +223,60 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 	return b.ApplyChartSeed(filepath.Join(chartPathControlPlane, common.KubeAPIServerDeploymentName), common.KubeAPIServerDeploymentName, b.Shoot.SeedNamespace, values, cloudValues)
 }
 
+// computeKubeAPIServerThroughputConfig computes the request throughput settings of the kube-apiserver. Settings which
+// are not configured in the Shoot manifest default to values derived from the maximum number of nodes of the Shoot, as
+// the number of requests and watched objects grows with the cluster size.
+func computeKubeAPIServerThroughputConfig(apiServerConfig *gardenv1beta1.KubeAPIServerConfig, nodeCount int) map[string]interface{} {
+	var (
+		maxNonMutatingInflight = 400
+		maxMutatingInflight    = 200
+		defaultWatchCacheSize  = 100
+		watchCacheSizes        = map[string]int{}
+		eventTTL               = time.Hour
+	)
+
+	switch {
+	case nodeCount > 100:
+		maxNonMutatingInflight, maxMutatingInflight, defaultWatchCacheSize = 1600, 800, 500
+	case nodeCount > 10:
+		maxNonMutatingInflight, maxMutatingInflight, defaultWatchCacheSize = 800, 400, 250
+	}
+
+	if apiServerConfig != nil {
+		if requests := apiServerConfig.Requests; requests != nil {
+			if requests.MaxNonMutatingInflight != nil {
+				maxNonMutatingInflight = *requests.MaxNonMutatingInflight
+			}
+			if requests.MaxMutatingInflight != nil {
+				maxMutatingInflight = *requests.MaxMutatingInflight
+			}
+		}
+		if sizes := apiServerConfig.WatchCacheSizes; sizes != nil {
+			if sizes.Default != nil {
+				defaultWatchCacheSize = *sizes.Default
+			}
+			for resource, size := range sizes.Resources {
+				watchCacheSizes[resource] = size
+			}
+		}
+		if apiServerConfig.EventTTL != nil {
+			eventTTL = apiServerConfig.EventTTL.Duration
+		}
+	}
+
+	return map[string]interface{}{
+		"requests": map[string]interface{}{
+			"maxNonMutatingInflight": maxNonMutatingInflight,
+			"maxMutatingInflight":    maxMutatingInflight,
+		},
+		"watchCacheSizes": map[string]interface{}{
+			"default":   defaultWatchCacheSize,
+			"resources": watchCacheSizes,
+		},
+		"eventTTL": eventTTL.String(),
+	}
+}
+
 // DeployKubeControllerManager asks the Cloud Botanist to provide the cloud specific configuration values for the
 // kube-controller-manager deployment.
 func (b *HybridBotanist) DeployKubeControllerManager() error {
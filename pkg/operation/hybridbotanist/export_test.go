@@ -0,0 +1,94 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+var (
+	ExportMachineDeploymentReplicas            = (*HybridBotanist).machineDeploymentReplicas
+	ExportRemoveHibernatedReplicas             = (*HybridBotanist).removeHibernatedReplicas
+	ExportCleanupMachineDeployments            = (*HybridBotanist).cleanupMachineDeployments
+	ExportLabelMachinesForForceDeletion        = (*HybridBotanist).labelMachinesForForceDeletion
+	ExportWaitUntilMachineDeploymentsAvailable = (*HybridBotanist).waitUntilMachineDeploymentsAvailable
+	ExportWaitUntilMachineResourcesDeleted     = (*HybridBotanist).waitUntilMachineResourcesDeleted
+	ExportComputeMachinesStatus                = computeMachinesStatus
+	ExportMachineClassesInUse                  = machineClassesInUse
+	ExportAttributeMachineDeploymentsWaitError = (*HybridBotanist).attributeMachineDeploymentsWaitError
+	ExportOrphanedMachines                     = orphanedMachines
+	ExportOrphanedNodes                        = orphanedNodes
+	ExportComputeMachinePriority               = computeMachinePriority
+	ExportProtectedNodes                       = protectedNodes
+	ExportNodeMachinePriorities                = nodeMachinePriorities
+	ExportMachineConfiguration                 = machineConfiguration
+	ExportComputeMachinePlan                   = computeMachinePlan
+	ExportNextMachineWaitPollInterval          = nextMachineWaitPollInterval
+	ExportMachineDeploymentRolloutStages       = machineDeploymentRolloutStages
+	ExportChangedMachineClassObjects           = changedMachineClassObjects
+	ExportDecodeManifest                       = decodeManifest
+	ExportSpotFallbackMachineDeployments       = spotFallbackMachineDeployments
+	ExportDeferredMachineDeployments           = deferredMachineDeployments
+	ExportZoneRebalancedReplicas               = zoneRebalancedReplicas
+	ExportMachinesProvisionedSince             = machinesProvisionedSince
+	ExportMachineCredentialsNeedRenewal        = machineCredentialsNeedRenewal
+	ExportCloudConfigChecksums                 = cloudConfigChecksums
+	ExportComputeWorkerKubeletConfig           = computeWorkerKubeletConfig
+	ExportMachineClassCloudConfigChecksums     = machineClassCloudConfigChecksums
+	ExportAnnotateMachineClassObjects          = annotateMachineClassObjects
+	ExportCloudConfigDriftedWorkers            = cloudConfigDriftedWorkers
+	ExportMachineClassFinalizerPatch           = machineClassFinalizerPatch
+	ExportWaitUntilMachineInstancesDeleted     = (*HybridBotanist).waitUntilMachineInstancesDeleted
+	ExportMaintenanceTimeUTC                   = maintenanceTimeUTC
+	ExportComputeWorkerNodeLabels              = computeWorkerNodeLabels
+	ExportComputeWorkerTaints                  = computeWorkerTaints
+	ExportNodeTemplateTaints                   = nodeTemplateTaints
+	ExportCanaryMachineDeployments             = canaryMachineDeployments
+	ExportExternalWorkerUserDataSecret         = externalWorkerUserDataSecret
+	ExportPausedMachineDeployments             = pausedMachineDeployments
+	ExportRestartRequestedWorkerNames          = restartRequestedWorkerNames
+	ExportMachineRestarts                      = machineRestarts
+	ExportMachineDeploymentOfWorker            = machineDeploymentOfWorker
+	ExportCheckMachineCapacity                 = (*HybridBotanist).checkMachineCapacity
+	ExportScaleDownLimits                      = scaleDownLimits
+	ExportPruneScaleDownHistory                = pruneScaleDownHistory
+	ExportWorkerRenames                        = workerRenames
+	ExportRenamedWorkerMachineDeployments      = renamedWorkerMachineDeployments
+)
+
+// ExportScaleDownRecord is a record of the machines removed by a scale-down of a machine deployment.
+type ExportScaleDownRecord = scaleDownRecord
+
+// ExportCounterValue returns the value of the machine metric counter <name> with the given label values.
+func ExportCounterValue(name string, labelValues ...string) float64 {
+	counters := map[string]*prometheus.CounterVec{
+		"cleanedUp":    machineResourcesCleanedUp,
+		"waitTimeouts": machineWaitTimeouts,
+	}
+
+	var metric dto.Metric
+	if err := counters[name].WithLabelValues(labelValues...).Write(&metric); err != nil {
+		panic(err)
+	}
+	return metric.GetCounter().GetValue()
+}
+
+// ExportMachineProvisioning returns the name of the machine deployment and the duration of the given machine provisioning.
+func ExportMachineProvisioning(p machineProvisioning) (string, time.Duration) {
+	return p.deploymentName, p.duration
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// DeployExternalWorkerUserData publishes the user data for the nodes of every external worker group of the Shoot in a
+// secret in the project namespace in the Garden cluster, and deletes the secrets of the removed external worker groups.
+// The user data is the downloader cloud config, i.e., a node which boots with it fetches the cloud config of its worker
+// group and the current bootstrap token from the Shoot cluster, just like the machines managed by the Gardener.
+func (b *HybridBotanist) DeployExternalWorkerUserData() error {
+	workerNames := b.Shoot.GetExternalWorkerNames()
+
+	for _, workerName := range workerNames {
+		userData, err := b.ComputeDownloaderUserData(workerName, 0)
+		if err != nil {
+			return err
+		}
+		if _, err := b.K8sGardenClient.CreateSecretObject(externalWorkerUserDataSecret(b.Shoot.Info.Namespace, b.Shoot.Info.Name, workerName, userData), true); err != nil {
+			return err
+		}
+	}
+
+	return b.deleteExternalWorkerUserData(sets.NewString(workerNames...))
+}
+
+// DeleteExternalWorkerUserData deletes the secrets with the user data of the external worker groups of the Shoot from
+// the project namespace in the Garden cluster.
+func (b *HybridBotanist) DeleteExternalWorkerUserData() error {
+	return b.deleteExternalWorkerUserData(sets.NewString())
+}
+
+// deleteExternalWorkerUserData deletes the secrets with the user data of the external worker groups of the Shoot
+// except for those of the worker groups in <keep>.
+func (b *HybridBotanist) deleteExternalWorkerUserData(keep sets.String) error {
+	secretList, err := b.K8sGardenClient.ListSecrets(b.Shoot.Info.Namespace, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s,%s=%s", common.GardenRole, common.GardenRoleExternalWorkerUserData, common.ExternalWorkerShootLabel, b.Shoot.Info.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, secret := range secretList.Items {
+		if keep.Has(secret.Labels[common.WorkerGroupLabel]) {
+			continue
+		}
+		if err := b.K8sGardenClient.DeleteSecret(secret.Namespace, secret.Name); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// externalWorkerUserDataSecret returns the secret in the project <namespace> which holds the <userData> for the nodes
+// of the external worker group <workerName> of the Shoot <shootName>.
+func externalWorkerUserDataSecret(namespace, shootName, workerName, userData string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.user-data-%s", shootName, workerName),
+			Namespace: namespace,
+			Labels: map[string]string{
+				common.GardenRole:               common.GardenRoleExternalWorkerUserData,
+				common.ExternalWorkerShootLabel: shootName,
+				common.WorkerGroupLabel:         workerName,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			common.ExternalWorkerUserDataKey: []byte(userData),
+		},
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("External worker groups", func() {
+	Describe("#externalWorkerUserDataSecret", func() {
+		It("should return the secret with the user data of the external worker group in the project namespace", func() {
+			secret := ExportExternalWorkerUserDataSecret("garden-dev", "crazy-botany", "on-premises", "#cloud-config")
+
+			Expect(secret.Name).To(Equal("crazy-botany.user-data-on-premises"))
+			Expect(secret.Namespace).To(Equal("garden-dev"))
+			Expect(secret.Labels).To(Equal(map[string]string{
+				common.GardenRole:               common.GardenRoleExternalWorkerUserData,
+				common.ExternalWorkerShootLabel: "crazy-botany",
+				common.WorkerGroupLabel:         "on-premises",
+			}))
+			Expect(secret.Type).To(Equal(corev1.SecretTypeOpaque))
+			Expect(secret.Data).To(Equal(map[string][]byte{common.ExternalWorkerUserDataKey: []byte("#cloud-config")}))
+		})
+	})
+})
@@ -0,0 +1,422 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// machineControllerManagerFinalizer is the finalizer which the machine-controller-manager adds to the machine classes
+// which are used by machines.
+const machineControllerManagerFinalizer = "machine.sapcloud.io/machine-controller-manager"
+
+// fakeMachineControllerManager simulates the machine-controller-manager for the machine resources in a namespace of a
+// fake Seed. In every reconciliation, it creates a machine set per machine class of every machine deployment and moves
+// the machines of the deployment to the machine set of its current class in a rolling update (new machines are created
+// first, old machines are only removed once the new ones are running). It moves the machines from Pending to Running
+// (or Failed, see fail), and from Terminating to deleted, and it maintains the status of the machine sets and machine
+// deployments as well as its finalizer of the machine classes. Every machine gets an instance at the simulated cloud
+// provider which is released one reconciliation after the machine has been deleted.
+type fakeMachineControllerManager struct {
+	seed               *fakeSeed
+	namespace          string
+	machineClassPlural string
+
+	mutex     sync.Mutex
+	failures  map[string]string
+	instances sets.String
+	released  []string
+	counter   int
+
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+// newFakeMachineControllerManager starts a new simulated machine-controller-manager which reconciles the machine
+// resources in the given <namespace> of the given fake <seed> in the given <interval>. The machine classes have the
+// given <machineClassPlural>. It must be stopped once it is not needed anymore.
+func newFakeMachineControllerManager(seed *fakeSeed, namespace, machineClassPlural string, interval time.Duration) *fakeMachineControllerManager {
+	m := &fakeMachineControllerManager{
+		seed:               seed,
+		namespace:          namespace,
+		machineClassPlural: machineClassPlural,
+		failures:           map[string]string{},
+		instances:          sets.NewString(),
+		stopCh:             make(chan struct{}),
+		doneCh:             make(chan struct{}),
+	}
+
+	go func() {
+		defer close(m.doneCh)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.reconcile()
+			case <-m.stopCh:
+				return
+			}
+		}
+	}()
+	return m
+}
+
+// stop stops the reconciliations and waits until the current one has finished.
+func (m *fakeMachineControllerManager) stop() {
+	close(m.stopCh)
+	<-m.doneCh
+}
+
+// fail makes the machines of the machine deployment with the given <name> fail with the given <message>, e.g. to
+// simulate an exceeded quota of the cloud provider.
+func (m *fakeMachineControllerManager) fail(name, message string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.failures[name] = message
+}
+
+// instanceIDs returns the IDs of the instances of the machines which still exist at the simulated cloud provider.
+func (m *fakeMachineControllerManager) instanceIDs() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.instances.List()
+}
+
+// reconcile performs a single reconciliation of all machine resources. Changes which conflict with concurrent changes
+// of the Gardener are dropped, they are retried in the next reconciliation.
+func (m *fakeMachineControllerManager) reconcile() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.instances.Delete(m.released...)
+	m.released = nil
+
+	m.reconcileMachines()
+
+	deployments := map[string]*machinev1alpha1.MachineDeployment{}
+	for _, obj := range m.seed.list("machinedeployments", m.namespace) {
+		deployment := obj.(*machinev1alpha1.MachineDeployment)
+		deployments[deployment.Name] = deployment
+	}
+
+	setsByDeployment := map[string][]*machinev1alpha1.MachineSet{}
+	for _, obj := range m.seed.list("machinesets", m.namespace) {
+		machineSet := obj.(*machinev1alpha1.MachineSet)
+		setsByDeployment[machineSet.Labels["name"]] = append(setsByDeployment[machineSet.Labels["name"]], machineSet)
+	}
+
+	// Machine sets whose machine deployment has been deleted are scaled down and deleted once their machines are gone.
+	for name, machineSets := range setsByDeployment {
+		if _, ok := deployments[name]; ok {
+			continue
+		}
+		for _, machineSet := range machineSets {
+			if len(m.machinesOf(machineSet.Name)) == 0 {
+				m.seed.delete("machinesets", m.namespace, machineSet.Name)
+				continue
+			}
+			m.scaleMachineSet(machineSet, 0)
+		}
+	}
+
+	for _, deployment := range deployments {
+		m.reconcileMachineDeployment(deployment, setsByDeployment[deployment.Name])
+	}
+
+	m.reconcileMachineClasses()
+}
+
+// reconcileMachines moves the pending machines to Running or Failed and deletes the terminating machines.
+func (m *fakeMachineControllerManager) reconcileMachines() {
+	for _, obj := range m.seed.list("machines", m.namespace) {
+		machine := obj.(*machinev1alpha1.Machine)
+
+		switch machine.Status.CurrentStatus.Phase {
+		case machinev1alpha1.MachinePending:
+			if message, ok := m.failures[machine.Labels["name"]]; ok {
+				machine.Status.CurrentStatus = machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineFailed, LastUpdateTime: metav1.Now()}
+				machine.Status.LastOperation = machinev1alpha1.LastOperation{
+					Description:    message,
+					State:          machinev1alpha1.MachineStateFailed,
+					Type:           "Create",
+					LastUpdateTime: metav1.Now(),
+				}
+			} else {
+				machine.Status.CurrentStatus = machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineRunning, LastUpdateTime: metav1.Now()}
+			}
+			m.seed.update("machines", machine, "status")
+		case machinev1alpha1.MachineTerminating:
+			if err := m.seed.delete("machines", m.namespace, machine.Name); err == nil {
+				m.released = append(m.released, machineInstanceID(machine))
+			}
+		}
+	}
+}
+
+// reconcileMachineDeployment rolls the machines of the given <deployment> out to the machine set of its current machine
+// class. The other (old) <machineSets> of the deployment are scaled down by the number of running machines of the new
+// machine set, hence, the deployment never has less running machines than desired during the rolling update.
+func (m *fakeMachineControllerManager) reconcileMachineDeployment(deployment *machinev1alpha1.MachineDeployment, machineSets []*machinev1alpha1.MachineSet) {
+	var (
+		className = deployment.Spec.Template.Spec.Class.Name
+		newSet    *machinev1alpha1.MachineSet
+		oldSets   []*machinev1alpha1.MachineSet
+	)
+	for _, machineSet := range machineSets {
+		if machineSet.Spec.Template.Spec.Class.Name == className {
+			newSet = machineSet
+		} else {
+			oldSets = append(oldSets, machineSet)
+		}
+	}
+
+	if newSet == nil {
+		created, err := m.seed.create("machinesets", &machinev1alpha1.MachineSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            className,
+				Namespace:       m.namespace,
+				Labels:          map[string]string{"name": deployment.Name},
+				OwnerReferences: []metav1.OwnerReference{ownerReference(deployment.Name, "MachineDeployment")},
+			},
+			Spec: machinev1alpha1.MachineSetSpec{
+				Replicas:     deployment.Spec.Replicas,
+				MachineClass: deployment.Spec.Template.Spec.Class,
+				Template:     deployment.Spec.Template,
+			},
+		})
+		if err != nil {
+			return
+		}
+		newSet = created.(*machinev1alpha1.MachineSet)
+	} else {
+		m.scaleMachineSet(newSet, deployment.Spec.Replicas)
+	}
+
+	remaining := deployment.Spec.Replicas - runningMachines(m.machinesOf(newSet.Name))
+	if remaining < 0 {
+		remaining = 0
+	}
+	sort.Slice(oldSets, func(i, j int) bool { return oldSets[i].Name < oldSets[j].Name })
+	for _, oldSet := range oldSets {
+		replicas := oldSet.Spec.Replicas
+		if replicas > remaining {
+			replicas = remaining
+		}
+		remaining -= replicas
+		m.scaleMachineSet(oldSet, replicas)
+	}
+
+	// The status of the machine sets is updated before the status of the machine deployment, hence, a machine
+	// deployment is only reported to be rolled out once its old machine sets have been scaled down.
+	var (
+		status   = machinev1alpha1.MachineDeploymentStatus{ObservedGeneration: deployment.Generation}
+		failures []string
+	)
+	for _, machineSet := range append(oldSets, newSet) {
+		machines := m.machinesOf(machineSet.Name)
+		m.updateMachineSetStatus(machineSet, machines)
+
+		status.Replicas += int32(len(machines))
+		status.ReadyReplicas += runningMachines(machines)
+		if machineSet == newSet {
+			status.UpdatedReplicas = int32(len(machines)) - terminatingMachines(machines)
+		}
+		for _, machine := range machines {
+			if machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineFailed {
+				failures = append(failures, machine.Status.LastOperation.Description)
+			}
+		}
+	}
+	status.AvailableReplicas = status.ReadyReplicas
+	if status.UnavailableReplicas = deployment.Spec.Replicas - status.AvailableReplicas; status.UnavailableReplicas < 0 {
+		status.UnavailableReplicas = 0
+	}
+	if len(failures) > 0 {
+		status.Conditions = []machinev1alpha1.MachineDeploymentCondition{{
+			Type:    machinev1alpha1.MachineDeploymentReplicaFailure,
+			Status:  machinev1alpha1.ConditionTrue,
+			Reason:  "FailedCreate",
+			Message: failures[0],
+		}}
+	}
+
+	if !equality.Semantic.DeepEqual(deployment.Status, status) {
+		deployment.Status = status
+		m.seed.update("machinedeployments", deployment, "status")
+	}
+}
+
+// scaleMachineSet sets the replicas of the given <machineSet> and creates or terminates its machines accordingly. The
+// machines which are not running are terminated first.
+func (m *fakeMachineControllerManager) scaleMachineSet(machineSet *machinev1alpha1.MachineSet, replicas int32) {
+	if machineSet.Spec.Replicas != replicas {
+		machineSet.Spec.Replicas = replicas
+		updated, err := m.seed.update("machinesets", machineSet, "")
+		if err != nil {
+			return
+		}
+		*machineSet = *updated.(*machinev1alpha1.MachineSet)
+	}
+
+	var active []*machinev1alpha1.Machine
+	for _, machine := range m.machinesOf(machineSet.Name) {
+		if machine.Status.CurrentStatus.Phase != machinev1alpha1.MachineTerminating {
+			active = append(active, machine)
+		}
+	}
+
+	for i := int32(len(active)); i < replicas; i++ {
+		m.counter++
+		name := fmt.Sprintf("%s-%d", machineSet.Name, m.counter)
+		machine := &machinev1alpha1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       m.namespace,
+				Labels:          map[string]string{"name": machineSet.Labels["name"]},
+				OwnerReferences: []metav1.OwnerReference{ownerReference(machineSet.Name, "MachineSet")},
+			},
+			Spec: machinev1alpha1.MachineSpec{
+				Class:      machineSet.Spec.Template.Spec.Class,
+				ProviderID: fmt.Sprintf("aws:///eu-west-1/i-%d", m.counter),
+			},
+			Status: machinev1alpha1.MachineStatus{
+				CurrentStatus: machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachinePending, LastUpdateTime: metav1.Now()},
+			},
+		}
+		if _, err := m.seed.create("machines", machine); err == nil {
+			m.instances.Insert(machineInstanceID(machine))
+		}
+	}
+
+	sort.SliceStable(active, func(i, j int) bool {
+		return active[i].Status.CurrentStatus.Phase != machinev1alpha1.MachineRunning && active[j].Status.CurrentStatus.Phase == machinev1alpha1.MachineRunning
+	})
+	for i := 0; i < len(active)-int(replicas); i++ {
+		machine := active[i]
+		machine.Status.CurrentStatus = machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineTerminating, LastUpdateTime: metav1.Now()}
+		m.seed.update("machines", machine, "status")
+	}
+}
+
+// updateMachineSetStatus updates the status of the given <machineSet> with its <machines> if it has changed.
+func (m *fakeMachineControllerManager) updateMachineSetStatus(machineSet *machinev1alpha1.MachineSet, machines []*machinev1alpha1.Machine) {
+	running := runningMachines(machines)
+	status := machinev1alpha1.MachineSetStatus{
+		Replicas:             int32(len(machines)),
+		FullyLabeledReplicas: int32(len(machines)),
+		ReadyReplicas:        running,
+		AvailableReplicas:    running,
+		ObservedGeneration:   machineSet.Generation,
+	}
+
+	if !equality.Semantic.DeepEqual(machineSet.Status, status) {
+		machineSet.Status = status
+		m.seed.update("machinesets", machineSet, "status")
+	}
+}
+
+// reconcileMachineClasses adds the finalizer of the machine-controller-manager to all machine classes which are used
+// by machine sets or machines, and removes it from the unused machine classes which are being deleted.
+func (m *fakeMachineControllerManager) reconcileMachineClasses() {
+	used := sets.NewString()
+	for _, obj := range m.seed.list("machinesets", m.namespace) {
+		machineSet := obj.(*machinev1alpha1.MachineSet)
+		if machineSet.Spec.Replicas > 0 || machineSet.Status.Replicas > 0 {
+			used.Insert(machineSet.Spec.Template.Spec.Class.Name)
+		}
+	}
+	for _, obj := range m.seed.list("machines", m.namespace) {
+		used.Insert(obj.(*machinev1alpha1.Machine).Spec.Class.Name)
+	}
+
+	for _, obj := range m.seed.list(m.machineClassPlural, m.namespace) {
+		var (
+			accessor   = obj.(metav1.Object)
+			finalizers = sets.NewString(accessor.GetFinalizers()...)
+		)
+
+		switch {
+		case used.Has(accessor.GetName()) && accessor.GetDeletionTimestamp() == nil && !finalizers.Has(machineControllerManagerFinalizer):
+			finalizers.Insert(machineControllerManagerFinalizer)
+		case !used.Has(accessor.GetName()) && accessor.GetDeletionTimestamp() != nil && finalizers.Has(machineControllerManagerFinalizer):
+			finalizers.Delete(machineControllerManagerFinalizer)
+		default:
+			continue
+		}
+
+		accessor.SetFinalizers(finalizers.List())
+		m.seed.update(m.machineClassPlural, obj, "")
+	}
+}
+
+// machinesOf returns the machines which are owned by the machine set with the given <name>.
+func (m *fakeMachineControllerManager) machinesOf(name string) []*machinev1alpha1.Machine {
+	var machines []*machinev1alpha1.Machine
+	for _, obj := range m.seed.list("machines", m.namespace) {
+		machine := obj.(*machinev1alpha1.Machine)
+		for _, owner := range machine.OwnerReferences {
+			if owner.Kind == "MachineSet" && owner.Name == name {
+				machines = append(machines, machine)
+			}
+		}
+	}
+	return machines
+}
+
+func runningMachines(machines []*machinev1alpha1.Machine) int32 {
+	var running int32
+	for _, machine := range machines {
+		if machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineRunning {
+			running++
+		}
+	}
+	return running
+}
+
+func terminatingMachines(machines []*machinev1alpha1.Machine) int32 {
+	var terminating int32
+	for _, machine := range machines {
+		if machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineTerminating {
+			terminating++
+		}
+	}
+	return terminating
+}
+
+// machineInstanceID returns the ID of the instance of the given <machine> at the simulated cloud provider.
+func machineInstanceID(machine *machinev1alpha1.Machine) string {
+	return machine.Spec.ProviderID[len("aws:///eu-west-1/"):]
+}
+
+func ownerReference(name, kind string) metav1.OwnerReference {
+	controller := true
+	return metav1.OwnerReference{
+		APIVersion: machinev1alpha1.SchemeGroupVersion.String(),
+		Kind:       kind,
+		Name:       name,
+		Controller: &controller,
+	}
+}
@@ -0,0 +1,715 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	kubernetesbase "github.com/gardener/gardener/pkg/client/kubernetes/base"
+	machineclientset "github.com/gardener/gardener/pkg/client/machine/clientset/versioned"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	jsonpatch "github.com/evanphx/json-patch"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// fakeSeedResource is a resource which is served by the fake Seed.
+type fakeSeedResource struct {
+	schema.GroupVersionResource
+	Kind string
+}
+
+// GroupVersionKind returns the kind of the objects of the resource.
+func (r fakeSeedResource) GroupVersionKind() schema.GroupVersionKind {
+	return r.GroupVersion().WithKind(r.Kind)
+}
+
+var fakeSeedResources = []fakeSeedResource{
+	{corev1.SchemeGroupVersion.WithResource("secrets"), "Secret"},
+	{corev1.SchemeGroupVersion.WithResource("configmaps"), "ConfigMap"},
+	{machinev1alpha1.SchemeGroupVersion.WithResource("machinedeployments"), "MachineDeployment"},
+	{machinev1alpha1.SchemeGroupVersion.WithResource("machinesets"), "MachineSet"},
+	{machinev1alpha1.SchemeGroupVersion.WithResource("machines"), "Machine"},
+	{machinev1alpha1.SchemeGroupVersion.WithResource("awsmachineclasses"), "AWSMachineClass"},
+}
+
+// fakeSeedEvent is a change of an object of the fake Seed.
+type fakeSeedEvent struct {
+	resourceVersion int
+	resource        string
+	namespace       string
+	eventType       watch.EventType
+	object          runtime.Object
+}
+
+// fakeSeedWatcher receives the events of the objects of a resource in a namespace.
+type fakeSeedWatcher struct {
+	resource  string
+	namespace string
+	events    chan fakeSeedEvent
+}
+
+func (w *fakeSeedWatcher) matches(event fakeSeedEvent) bool {
+	return w.resource == event.resource && (len(w.namespace) == 0 || w.namespace == event.namespace)
+}
+
+// fakeSeed is an in-memory API server of a Seed cluster for the machine resources of the Shoots and the secrets and
+// config maps of their namespaces. It serves the discovery, the creation, update, patch, deletion, listing and watching
+// of objects over HTTP, hence, the Kubernetes clients of the Gardener (including Apply and the shared informers) are
+// used unchanged. Like a real API server, it manages the resource versions, generations and deletion timestamps of
+// the objects, keeps objects with finalizers until they have been released, and ignores the status of objects which is
+// sent to the main resource.
+type fakeSeed struct {
+	scheme *runtime.Scheme
+	server *httptest.Server
+	stopCh chan struct{}
+
+	mutex           sync.Mutex
+	resourceVersion int
+	objects         map[string]map[string]runtime.Object
+	events          []fakeSeedEvent
+	watchers        map[*fakeSeedWatcher]struct{}
+}
+
+// newFakeSeed starts a new fake Seed API server. It must be closed once it is not needed anymore.
+func newFakeSeed() *fakeSeed {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+	if err := machinev1alpha1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+
+	s := &fakeSeed{
+		scheme:   scheme,
+		stopCh:   make(chan struct{}),
+		objects:  map[string]map[string]runtime.Object{},
+		watchers: map[*fakeSeedWatcher]struct{}{},
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// close stops all watches and the server.
+func (s *fakeSeed) close() {
+	close(s.stopCh)
+	s.server.Close()
+}
+
+// client returns a Kubernetes client for the fake Seed whose API groups have been discovered. Its requests are not
+// throttled by the client-side rate limiter.
+func (s *fakeSeed) client() *kubernetesbase.Client {
+	config := &rest.Config{Host: s.server.URL, QPS: 1000, Burst: 1000}
+	clientset := kubernetes.NewForConfigOrDie(config)
+
+	client := &kubernetesbase.Client{}
+	client.SetConfig(config)
+	client.SetClientset(clientset)
+	client.SetRESTClient(clientset.Discovery().RESTClient())
+	client.SetMachineClientset(machineclientset.NewForConfigOrDie(config))
+	if err := client.DiscoverAPIGroups(); err != nil {
+		panic(err)
+	}
+	return client
+}
+
+func fakeSeedResourceFor(resource string) fakeSeedResource {
+	for _, r := range fakeSeedResources {
+		if r.Resource == resource {
+			return r
+		}
+	}
+	panic(fmt.Sprintf("resource %s is not served by the fake Seed", resource))
+}
+
+func fakeSeedObjectKey(namespace, name string) string {
+	return namespace + "/" + name
+}
+
+// list returns copies of the objects of the given <resource> in the given <namespace> in the order of their names.
+func (s *fakeSeed) list(resource, namespace string) []runtime.Object {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.listLocked(resource, namespace, labels.Everything())
+}
+
+func (s *fakeSeed) listLocked(resource, namespace string, selector labels.Selector) []runtime.Object {
+	var objects []runtime.Object
+	for _, obj := range s.objects[resource] {
+		accessor, _ := meta.Accessor(obj)
+		if accessor.GetNamespace() == namespace && selector.Matches(labels.Set(accessor.GetLabels())) {
+			objects = append(objects, obj.DeepCopyObject())
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		a, _ := meta.Accessor(objects[i])
+		b, _ := meta.Accessor(objects[j])
+		return a.GetName() < b.GetName()
+	})
+	return objects
+}
+
+// get returns a copy of the object of the given <resource> with the given <namespace> and <name>.
+func (s *fakeSeed) get(resource, namespace, name string) (runtime.Object, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	obj, ok := s.objects[resource][fakeSeedObjectKey(namespace, name)]
+	if !ok {
+		return nil, apierrors.NewNotFound(fakeSeedResourceFor(resource).GroupResource(), name)
+	}
+	return obj.DeepCopyObject(), nil
+}
+
+// create stores the given new <obj> of the given <resource>.
+func (s *fakeSeed) create(resource string, obj runtime.Object) (runtime.Object, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r := fakeSeedResourceFor(resource)
+	content, err := s.toContent(obj)
+	if err != nil {
+		return nil, err
+	}
+	metadata := contentMetadata(content)
+	name, _ := metadata["name"].(string)
+	namespace, _ := metadata["namespace"].(string)
+	if len(name) == 0 {
+		return nil, apierrors.NewBadRequest("the name of the object is missing")
+	}
+	if _, ok := s.objects[resource][fakeSeedObjectKey(namespace, name)]; ok {
+		return nil, apierrors.NewAlreadyExists(r.GroupResource(), name)
+	}
+
+	s.resourceVersion++
+	metadata["uid"] = fmt.Sprintf("uid-%d", s.resourceVersion)
+	metadata["resourceVersion"] = strconv.Itoa(s.resourceVersion)
+	metadata["creationTimestamp"] = time.Now().UTC().Format(time.RFC3339)
+	metadata["generation"] = 1
+	delete(metadata, "deletionTimestamp")
+
+	created, err := s.fromContent(r, content)
+	if err != nil {
+		return nil, err
+	}
+	s.storeLocked(resource, namespace, name, created, watch.Added)
+	return created.DeepCopyObject(), nil
+}
+
+// update replaces the existing object of the given <resource> by the given <obj>, or only its status if the
+// <subresource> is "status". It fails with a conflict if the <obj> carries a resource version which is outdated.
+func (s *fakeSeed) update(resource string, obj runtime.Object, subresource string) (runtime.Object, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateLocked(resource, accessor.GetNamespace(), accessor.GetName(), obj, subresource)
+}
+
+func (s *fakeSeed) updateLocked(resource, namespace, name string, obj runtime.Object, subresource string) (runtime.Object, error) {
+	r := fakeSeedResourceFor(resource)
+	existing, ok := s.objects[resource][fakeSeedObjectKey(namespace, name)]
+	if !ok {
+		return nil, apierrors.NewNotFound(r.GroupResource(), name)
+	}
+
+	existingContent, err := s.toContent(existing)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.toContent(obj)
+	if err != nil {
+		return nil, err
+	}
+	existingMetadata := contentMetadata(existingContent)
+	if resourceVersion, _ := contentMetadata(content)["resourceVersion"].(string); len(resourceVersion) > 0 && resourceVersion != existingMetadata["resourceVersion"] {
+		return nil, apierrors.NewConflict(r.GroupResource(), name, fmt.Errorf("the object has been modified"))
+	}
+
+	// The status is only changed via the status subresource, and only the status is changed via it.
+	if subresource == "status" {
+		status := content["status"]
+		content = existingContent
+		content["status"] = status
+	} else if status, ok := existingContent["status"]; ok {
+		content["status"] = status
+	}
+
+	metadata := contentMetadata(content)
+	for _, field := range []string{"name", "namespace", "uid", "creationTimestamp", "deletionTimestamp", "generation"} {
+		if value, ok := existingMetadata[field]; ok {
+			metadata[field] = value
+		} else {
+			delete(metadata, field)
+		}
+	}
+	if generation, ok := existingMetadata["generation"].(float64); ok && !reflect.DeepEqual(existingContent["spec"], content["spec"]) {
+		metadata["generation"] = generation + 1
+	}
+	s.resourceVersion++
+	metadata["resourceVersion"] = strconv.Itoa(s.resourceVersion)
+
+	updated, err := s.fromContent(r, content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Objects which are being deleted are removed once all finalizers have been removed.
+	accessor, _ := meta.Accessor(updated)
+	if accessor.GetDeletionTimestamp() != nil && len(accessor.GetFinalizers()) == 0 {
+		s.storeLocked(resource, namespace, name, updated, watch.Deleted)
+	} else {
+		s.storeLocked(resource, namespace, name, updated, watch.Modified)
+	}
+	return updated.DeepCopyObject(), nil
+}
+
+// patch applies the given patch <data> of the given <patchType> to the existing object of the given <resource>. Strategic
+// merge patches are treated like merge patches. It fails with a conflict if the patched object carries a resource
+// version which is outdated.
+func (s *fakeSeed) patch(resource, namespace, name string, patchType types.PatchType, data []byte, subresource string) (runtime.Object, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	r := fakeSeedResourceFor(resource)
+	existing, ok := s.objects[resource][fakeSeedObjectKey(namespace, name)]
+	if !ok {
+		return nil, apierrors.NewNotFound(r.GroupResource(), name)
+	}
+	original, err := s.encode(r, existing)
+	if err != nil {
+		return nil, err
+	}
+
+	var patched []byte
+	switch patchType {
+	case types.MergePatchType, types.StrategicMergePatchType:
+		patched, err = jsonpatch.MergePatch(original, data)
+	case types.JSONPatchType:
+		var p jsonpatch.Patch
+		if p, err = jsonpatch.DecodePatch(data); err == nil {
+			patched, err = p.Apply(original)
+		}
+	default:
+		err = fmt.Errorf("unsupported patch type %s", patchType)
+	}
+	if err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+
+	obj, err := s.scheme.New(r.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(patched, obj); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	return s.updateLocked(resource, namespace, name, obj, subresource)
+}
+
+// delete deletes the object of the given <resource> with the given <namespace> and <name>. Objects with finalizers
+// only get a deletion timestamp.
+func (s *fakeSeed) delete(resource, namespace, name string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, ok := s.objects[resource][fakeSeedObjectKey(namespace, name)]
+	if !ok {
+		return apierrors.NewNotFound(fakeSeedResourceFor(resource).GroupResource(), name)
+	}
+
+	obj := existing.DeepCopyObject()
+	accessor, _ := meta.Accessor(obj)
+	if len(accessor.GetFinalizers()) > 0 && accessor.GetDeletionTimestamp() != nil {
+		return nil
+	}
+	if len(accessor.GetFinalizers()) > 0 {
+		now := metav1.Now()
+		accessor.SetDeletionTimestamp(&now)
+	}
+	s.resourceVersion++
+	accessor.SetResourceVersion(strconv.Itoa(s.resourceVersion))
+
+	if len(accessor.GetFinalizers()) > 0 {
+		s.storeLocked(resource, namespace, name, obj, watch.Modified)
+	} else {
+		s.storeLocked(resource, namespace, name, obj, watch.Deleted)
+	}
+	return nil
+}
+
+// storeLocked stores (or removes, for watch.Deleted) the given <obj> and notifies the watchers.
+func (s *fakeSeed) storeLocked(resource, namespace, name string, obj runtime.Object, eventType watch.EventType) {
+	if _, ok := s.objects[resource]; !ok {
+		s.objects[resource] = map[string]runtime.Object{}
+	}
+	if eventType == watch.Deleted {
+		delete(s.objects[resource], fakeSeedObjectKey(namespace, name))
+	} else {
+		s.objects[resource][fakeSeedObjectKey(namespace, name)] = obj
+	}
+
+	event := fakeSeedEvent{
+		resourceVersion: s.resourceVersion,
+		resource:        resource,
+		namespace:       namespace,
+		eventType:       eventType,
+		object:          obj.DeepCopyObject(),
+	}
+	s.events = append(s.events, event)
+
+	// A watcher which does not keep up is closed, its client starts a new watch after listing the objects again.
+	for watcher := range s.watchers {
+		if !watcher.matches(event) {
+			continue
+		}
+		select {
+		case watcher.events <- event:
+		default:
+			close(watcher.events)
+			delete(s.watchers, watcher)
+		}
+	}
+}
+
+func (s *fakeSeed) toContent(obj runtime.Object) (map[string]interface{}, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	content := map[string]interface{}{}
+	if err := json.Unmarshal(raw, &content); err != nil {
+		return nil, err
+	}
+	if _, ok := content["metadata"].(map[string]interface{}); !ok {
+		content["metadata"] = map[string]interface{}{}
+	}
+	return content, nil
+}
+
+func (s *fakeSeed) fromContent(r fakeSeedResource, content map[string]interface{}) (runtime.Object, error) {
+	raw, err := json.Marshal(content)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.scheme.New(r.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(raw, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func contentMetadata(content map[string]interface{}) map[string]interface{} {
+	return content["metadata"].(map[string]interface{})
+}
+
+// encode returns the JSON representation of the given <obj> of the resource <r> including its API version and kind.
+func (s *fakeSeed) encode(r fakeSeedResource, obj runtime.Object) ([]byte, error) {
+	obj = obj.DeepCopyObject()
+	obj.GetObjectKind().SetGroupVersionKind(r.GroupVersionKind())
+	return json.Marshal(obj)
+}
+
+func (s *fakeSeed) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case r.URL.Path == "/version":
+		writeFakeSeedResponse(w, http.StatusOK, version.Info{Major: "1", Minor: "10", GitVersion: "v1.10.5"})
+	case r.URL.Path == "/api":
+		writeFakeSeedResponse(w, http.StatusOK, metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case r.URL.Path == "/apis":
+		groupVersion := metav1.GroupVersionForDiscovery{GroupVersion: machinev1alpha1.SchemeGroupVersion.String(), Version: machinev1alpha1.SchemeGroupVersion.Version}
+		writeFakeSeedResponse(w, http.StatusOK, metav1.APIGroupList{
+			TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"},
+			Groups: []metav1.APIGroup{{
+				Name:             machinev1alpha1.SchemeGroupVersion.Group,
+				Versions:         []metav1.GroupVersionForDiscovery{groupVersion},
+				PreferredVersion: groupVersion,
+			}},
+		})
+	case r.URL.Path == "/api/v1":
+		s.serveDiscovery(w, corev1.SchemeGroupVersion)
+	case r.URL.Path == "/apis/"+machinev1alpha1.SchemeGroupVersion.String():
+		s.serveDiscovery(w, machinev1alpha1.SchemeGroupVersion)
+	case len(segments) >= 5 && segments[0] == "api" && segments[2] == "namespaces":
+		s.serveResource(w, r, schema.GroupVersion{Version: segments[1]}, segments[3], segments[4:])
+	case len(segments) >= 6 && segments[0] == "apis" && segments[3] == "namespaces":
+		s.serveResource(w, r, schema.GroupVersion{Group: segments[1], Version: segments[2]}, segments[4], segments[5:])
+	default:
+		writeFakeSeedError(w, apierrors.NewNotFound(schema.GroupResource{}, r.URL.Path))
+	}
+}
+
+func (s *fakeSeed) serveDiscovery(w http.ResponseWriter, groupVersion schema.GroupVersion) {
+	list := metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: groupVersion.String(),
+	}
+	for _, r := range fakeSeedResources {
+		if r.GroupVersion() == groupVersion {
+			list.APIResources = append(list.APIResources, metav1.APIResource{
+				Name:       r.Resource,
+				Namespaced: true,
+				Kind:       r.Kind,
+				Verbs:      metav1.Verbs{"create", "delete", "get", "list", "patch", "update", "watch"},
+			})
+		}
+	}
+	writeFakeSeedResponse(w, http.StatusOK, list)
+}
+
+func (s *fakeSeed) serveResource(w http.ResponseWriter, r *http.Request, groupVersion schema.GroupVersion, namespace string, segments []string) {
+	var res *fakeSeedResource
+	for i := range fakeSeedResources {
+		if fakeSeedResources[i].GroupVersion() == groupVersion && fakeSeedResources[i].Resource == segments[0] {
+			res = &fakeSeedResources[i]
+		}
+	}
+	if res == nil {
+		writeFakeSeedError(w, apierrors.NewNotFound(groupVersion.WithResource(segments[0]).GroupResource(), ""))
+		return
+	}
+
+	var name, subresource string
+	if len(segments) > 1 {
+		name = segments[1]
+	}
+	if len(segments) > 2 {
+		subresource = segments[2]
+	}
+
+	var (
+		obj runtime.Object
+		err error
+	)
+	switch {
+	case r.Method == http.MethodGet && len(name) == 0 && r.URL.Query().Get("watch") == "true":
+		s.serveWatch(w, r, *res, namespace)
+		return
+	case r.Method == http.MethodGet && len(name) == 0:
+		s.serveList(w, r, *res, namespace)
+		return
+	case r.Method == http.MethodGet:
+		obj, err = s.get(res.Resource, namespace, name)
+	case r.Method == http.MethodPost:
+		if obj, err = s.decodeBody(r, *res, namespace); err == nil {
+			obj, err = s.create(res.Resource, obj)
+		}
+	case r.Method == http.MethodPut:
+		// Updates via the API are applied regardless of their resource version (like `kubectl apply`), hence, the
+		// tests do not depend on whether the simulated machine-controller-manager changed the object in the meantime.
+		if obj, err = s.decodeBody(r, *res, namespace); err == nil {
+			accessor, _ := meta.Accessor(obj)
+			accessor.SetResourceVersion("")
+			obj, err = s.update(res.Resource, obj, subresource)
+		}
+	case r.Method == http.MethodPatch:
+		var data []byte
+		if data, err = readFakeSeedBody(r); err == nil {
+			patchType := types.PatchType(strings.Split(r.Header.Get("Content-Type"), ";")[0])
+			obj, err = s.patch(res.Resource, namespace, name, patchType, data, subresource)
+		}
+	case r.Method == http.MethodDelete:
+		if err = s.delete(res.Resource, namespace, name); err == nil {
+			writeFakeSeedResponse(w, http.StatusOK, metav1.Status{
+				TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+				Status:   metav1.StatusSuccess,
+			})
+			return
+		}
+	default:
+		err = apierrors.NewMethodNotSupported(res.GroupResource(), r.Method)
+	}
+	if err != nil {
+		writeFakeSeedError(w, err)
+		return
+	}
+
+	raw, err := s.encode(*res, obj)
+	if err != nil {
+		writeFakeSeedError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(raw)
+}
+
+func (s *fakeSeed) serveList(w http.ResponseWriter, r *http.Request, res fakeSeedResource, namespace string) {
+	selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		writeFakeSeedError(w, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+
+	s.mutex.Lock()
+	var (
+		objects         = s.listLocked(res.Resource, namespace, selector)
+		resourceVersion = strconv.Itoa(s.resourceVersion)
+	)
+	s.mutex.Unlock()
+
+	items := make([]json.RawMessage, 0, len(objects))
+	for _, obj := range objects {
+		raw, err := s.encode(res, obj)
+		if err != nil {
+			writeFakeSeedError(w, err)
+			return
+		}
+		items = append(items, raw)
+	}
+	writeFakeSeedResponse(w, http.StatusOK, map[string]interface{}{
+		"apiVersion": res.GroupVersion().String(),
+		"kind":       res.Kind + "List",
+		"metadata":   map[string]interface{}{"resourceVersion": resourceVersion},
+		"items":      items,
+	})
+}
+
+// serveWatch streams the events of the objects of the resource <res> in the given <namespace> which happened after
+// the requested resource version.
+func (s *fakeSeed) serveWatch(w http.ResponseWriter, r *http.Request, res fakeSeedResource, namespace string) {
+	since, _ := strconv.Atoi(r.URL.Query().Get("resourceVersion"))
+
+	s.mutex.Lock()
+	if since == 0 {
+		since = s.resourceVersion
+	}
+	var missed []fakeSeedEvent
+	watcher := &fakeSeedWatcher{resource: res.Resource, namespace: namespace}
+	for _, event := range s.events {
+		if event.resourceVersion > since && watcher.matches(event) {
+			missed = append(missed, event)
+		}
+	}
+	watcher.events = make(chan fakeSeedEvent, len(missed)+1024)
+	for _, event := range missed {
+		watcher.events <- event
+	}
+	s.watchers[watcher] = struct{}{}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		delete(s.watchers, watcher)
+		s.mutex.Unlock()
+	}()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher := w.(http.Flusher)
+	flusher.Flush()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case event, ok := <-watcher.events:
+			if !ok {
+				return
+			}
+			raw, err := s.encode(res, event.object)
+			if err != nil {
+				return
+			}
+			if err := encoder.Encode(map[string]interface{}{"type": event.eventType, "object": json.RawMessage(raw)}); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		case <-s.stopCh:
+			return
+		}
+	}
+}
+
+// decodeBody decodes the object of the resource <res> in the body of the request <r>. The object gets the given
+// <namespace> unless it specifies one.
+func (s *fakeSeed) decodeBody(r *http.Request, res fakeSeedResource, namespace string) (runtime.Object, error) {
+	data, err := readFakeSeedBody(r)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := s.scheme.New(res.GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	accessor, _ := meta.Accessor(obj)
+	if len(accessor.GetNamespace()) == 0 {
+		accessor.SetNamespace(namespace)
+	}
+	return obj, nil
+}
+
+func readFakeSeedBody(r *http.Request) ([]byte, error) {
+	defer r.Body.Close()
+	var data json.RawMessage
+	if err := json.NewDecoder(r.Body).Decode(&data); err != nil {
+		return nil, apierrors.NewBadRequest(err.Error())
+	}
+	return data, nil
+}
+
+func writeFakeSeedResponse(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeFakeSeedError(w http.ResponseWriter, err error) {
+	statusErr, ok := err.(*apierrors.StatusError)
+	if !ok {
+		statusErr = apierrors.NewInternalError(err)
+	}
+	status := statusErr.ErrStatus
+	status.Kind, status.APIVersion = "Status", "v1"
+	writeFakeSeedResponse(w, int(status.Code), status)
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// deployMachineClasses renders the machine class chart with the given <chartName> and <values> and applies only those
+// machine classes and machine class secrets whose rendered content differs from the content of the live objects in
+// the Shoot namespace of the Seed. Re-applying unchanged objects would bump their resource versions and might cause
+// the machine-controller-manager to re-evaluate all machines referencing them. The cloud config checksums of the given
+// <classChecksums> (keyed by the names of the machine classes) are stored in annotations of the rendered objects.
+func (b *HybridBotanist) deployMachineClasses(machineClassPlural, chartName string, values map[string]interface{}, classChecksums map[string]string) error {
+	release, err := b.ChartSeedRenderer.Render(filepath.Join(common.ChartPath, "seed-machines", "charts", chartName), chartName, b.Shoot.SeedNamespace, values)
+	if err != nil {
+		return err
+	}
+	objects, err := decodeManifest(release.Manifest())
+	if err != nil {
+		return err
+	}
+	annotateMachineClassObjects(objects, classChecksums)
+
+	liveContentHashes, err := b.liveMachineClassContentHashes(machineClassPlural)
+	if err != nil {
+		return err
+	}
+
+	changed, err := changedMachineClassObjects(objects, liveContentHashes)
+	if err != nil {
+		return err
+	}
+	if len(changed) == 0 {
+		b.Logger.Debugf("All %d machine class objects are up-to-date", len(objects))
+		return nil
+	}
+
+	var manifest bytes.Buffer
+	for _, obj := range changed {
+		raw, err := json.Marshal(obj.UnstructuredContent())
+		if err != nil {
+			return err
+		}
+		manifest.Write(raw)
+		manifest.WriteString("\n")
+	}
+
+	b.Logger.Infof("Applying %d of %d machine class objects, the others are unchanged", len(changed), len(objects))
+	return b.K8sSeedClient.Apply(manifest.Bytes())
+}
+
+// liveMachineClassContentHashes returns the content hashes of the machine classes and machine class secrets which
+// currently exist in the Shoot namespace of the Seed, keyed by machineClassObjectKey.
+func (b *HybridBotanist) liveMachineClassContentHashes(machineClassPlural string) (map[string]string, error) {
+	var (
+		hashes           = map[string]string{}
+		machineClassList unstructured.Unstructured
+	)
+
+	if err := b.K8sSeedClient.MachineV1alpha1("GET", machineClassPlural, b.Shoot.SeedNamespace).Do().Into(&machineClassList); err != nil {
+		return nil, err
+	}
+	if err := machineClassList.EachListItem(func(o runtime.Object) error {
+		obj := o.(*unstructured.Unstructured)
+		if hash, ok := obj.GetAnnotations()[common.MachineClassContentHash]; ok {
+			hashes[machineClassObjectKey(obj.GetKind(), obj.GetName())] = hash
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	secretList, err := b.listMachineClassSecrets()
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secretList.Items {
+		if hash, ok := secret.Annotations[common.MachineClassContentHash]; ok {
+			hashes[machineClassObjectKey("Secret", secret.Name)] = hash
+		}
+	}
+
+	return hashes, nil
+}
+
+// changedMachineClassObjects computes the content hashes of the given rendered <objects>, stores them in the
+// MachineClassContentHash annotation and returns those objects whose hash differs from the one in the given
+// <liveContentHashes> (keyed by machineClassObjectKey). Objects which do not exist yet are always returned.
+func changedMachineClassObjects(objects []*unstructured.Unstructured, liveContentHashes map[string]string) ([]*unstructured.Unstructured, error) {
+	var changed []*unstructured.Unstructured
+
+	for _, obj := range objects {
+		annotations := obj.GetAnnotations()
+		delete(annotations, common.MachineClassContentHash)
+		obj.SetAnnotations(annotations)
+
+		content, err := json.Marshal(obj.UnstructuredContent())
+		if err != nil {
+			return nil, err
+		}
+		hash := utils.ComputeSHA256Hex(content)
+
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[common.MachineClassContentHash] = hash
+		obj.SetAnnotations(annotations)
+
+		if liveHash, ok := liveContentHashes[machineClassObjectKey(obj.GetKind(), obj.GetName())]; !ok || liveHash != hash {
+			changed = append(changed, obj)
+		}
+	}
+
+	return changed, nil
+}
+
+// machineClassObjectKey returns the key identifying the object of the given <kind> with the given <name>.
+func machineClassObjectKey(kind, name string) string {
+	return fmt.Sprintf("%s/%s", kind, name)
+}
+
+// decodeManifest decodes the given multi-document <manifest> into a list of unstructured objects.
+func decodeManifest(manifest []byte) ([]*unstructured.Unstructured, error) {
+	var (
+		decoder = yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 1024)
+		objects []*unstructured.Unstructured
+	)
+
+	for {
+		var decodedObj map[string]interface{}
+		if err := decoder.Decode(&decodedObj); err != nil {
+			if err == io.EOF {
+				return objects, nil
+			}
+			return nil, err
+		}
+		if decodedObj == nil {
+			continue
+		}
+		objects = append(objects, &unstructured.Unstructured{Object: decodedObj})
+	}
+}
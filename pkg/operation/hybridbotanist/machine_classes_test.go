@@ -0,0 +1,132 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("machine classes", func() {
+	const manifest = `---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: class-1
+  namespace: shoot--foo--bar
+  labels:
+    garden.sapcloud.io/purpose: machineclass
+type: Opaque
+data:
+  userData: Zm9v
+---
+apiVersion: machine.sapcloud.io/v1alpha1
+kind: AWSMachineClass
+metadata:
+  name: class-1
+  namespace: shoot--foo--bar
+spec:
+  ami: ami-12345678
+  secretRef:
+    name: class-1
+    namespace: shoot--foo--bar
+`
+
+	Describe("#decodeManifest", func() {
+		It("should decode all documents of the manifest", func() {
+			objects, err := ExportDecodeManifest([]byte(manifest))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(2))
+			Expect(objects[0].GetKind()).To(Equal("Secret"))
+			Expect(objects[1].GetKind()).To(Equal("AWSMachineClass"))
+			Expect(objects[1].GetName()).To(Equal("class-1"))
+		})
+
+		It("should return no objects for an empty manifest", func() {
+			objects, err := ExportDecodeManifest([]byte("---\n"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(BeEmpty())
+		})
+	})
+
+	Describe("#changedMachineClassObjects", func() {
+		decode := func() []*unstructured.Unstructured {
+			objects, err := ExportDecodeManifest([]byte(manifest))
+			Expect(err).NotTo(HaveOccurred())
+			return objects
+		}
+
+		hashesOf := func(objects []*unstructured.Unstructured) map[string]string {
+			hashes := map[string]string{}
+			for _, obj := range objects {
+				hashes[obj.GetKind()+"/"+obj.GetName()] = obj.GetAnnotations()[common.MachineClassContentHash]
+			}
+			return hashes
+		}
+
+		It("should return and annotate all objects if none exists yet", func() {
+			changed, err := ExportChangedMachineClassObjects(decode(), map[string]string{})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(HaveLen(2))
+			for _, obj := range changed {
+				Expect(obj.GetAnnotations()).To(HaveKeyWithValue(common.MachineClassContentHash, HaveLen(64)))
+			}
+		})
+
+		It("should return no objects if the content did not change", func() {
+			live, err := ExportChangedMachineClassObjects(decode(), map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+
+			changed, err := ExportChangedMachineClassObjects(decode(), hashesOf(live))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeEmpty())
+		})
+
+		It("should ignore a content hash annotation of the rendered objects", func() {
+			live, err := ExportChangedMachineClassObjects(decode(), map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+
+			objects := decode()
+			objects[1].SetAnnotations(map[string]string{common.MachineClassContentHash: "outdated"})
+			changed, err := ExportChangedMachineClassObjects(objects, hashesOf(live))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeEmpty())
+		})
+
+		It("should only return the objects whose content changed", func() {
+			live, err := ExportChangedMachineClassObjects(decode(), map[string]string{})
+			Expect(err).NotTo(HaveOccurred())
+
+			objects := decode()
+			Expect(unstructured.SetNestedField(objects[1].Object, "ami-87654321", "spec", "ami")).To(Succeed())
+			changed, err := ExportChangedMachineClassObjects(objects, hashesOf(live))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(HaveLen(1))
+			Expect(changed[0].GetKind()).To(Equal("AWSMachineClass"))
+			Expect(changed[0].GetAnnotations()[common.MachineClassContentHash]).NotTo(Equal(hashesOf(live)["AWSMachineClass/class-1"]))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/machinecredentials"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ensureMachineCredentials provides the cloud provider credentials which have been issued by the external secret
+// backend for the machine class secrets of the Shoot (if one is configured for its cloud provider). The credentials
+// are kept in a secret in the Shoot namespace of the Seed, so that they are only re-issued once they are about to
+// expire instead of with every operation.
+func (b *HybridBotanist) ensureMachineCredentials() error {
+	issuer := b.MachineCredentialsIssuer
+	if issuer == nil || !issuer.Issues(b.Shoot.CloudProvider) {
+		return nil
+	}
+
+	secret, err := b.K8sSeedClient.GetSecret(b.Shoot.SeedNamespace, common.MachineCredentialsSecretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil && !machineCredentialsNeedRenewal(secret, time.Now(), issuer.RenewBefore()) {
+		b.Shoot.MachineCredentials = secret.Data
+		return nil
+	}
+
+	lease, err := issuer.Issue(b.Shoot.CloudProvider)
+	if err != nil {
+		return fmt.Errorf("Issuing the machine credentials failed: %s", err.Error())
+	}
+
+	annotations := map[string]string{common.MachineCredentialsLease: lease.ID}
+	if !lease.Expiry.IsZero() {
+		annotations[common.MachineCredentialsExpiry] = lease.Expiry.UTC().Format(time.RFC3339)
+	}
+	if _, err := b.K8sSeedClient.CreateSecretObject(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        common.MachineCredentialsSecretName,
+			Namespace:   b.Shoot.SeedNamespace,
+			Annotations: annotations,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: lease.Data,
+	}, true); err != nil {
+		return err
+	}
+
+	b.Shoot.MachineCredentials = lease.Data
+	b.Logger.Infof("Issued new machine credentials (lease %q, expiry %q)", lease.ID, annotations[common.MachineCredentialsExpiry])
+	return nil
+}
+
+// machineCredentialsNeedRenewal returns true if the credentials in the given machine credentials <secret> must be
+// re-issued at <now>, i.e. if they expire within <renewBefore> or their expiry cannot be determined.
+func machineCredentialsNeedRenewal(secret *corev1.Secret, now time.Time, renewBefore time.Duration) bool {
+	if len(secret.Data) == 0 {
+		return true
+	}
+	value, ok := secret.Annotations[common.MachineCredentialsExpiry]
+	if !ok {
+		return false
+	}
+	expiry, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return true
+	}
+	return machinecredentials.NeedsRenewal(expiry, now, renewBefore)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("machine credentials", func() {
+	Describe("#machineCredentialsNeedRenewal", func() {
+		var (
+			now    = time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+			secret *corev1.Secret
+		)
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{Data: map[string][]byte{"accessKeyID": []byte("AKIA")}}
+			secret.Annotations = map[string]string{common.MachineCredentialsExpiry: now.Add(3 * time.Hour).Format(time.RFC3339)}
+		})
+
+		It("should keep credentials which expire after the renewal window", func() {
+			Expect(ExportMachineCredentialsNeedRenewal(secret, now, 2*time.Hour)).To(BeFalse())
+		})
+
+		It("should renew credentials which expire within the renewal window", func() {
+			Expect(ExportMachineCredentialsNeedRenewal(secret, now, 4*time.Hour)).To(BeTrue())
+		})
+
+		It("should keep credentials without an expiry", func() {
+			delete(secret.Annotations, common.MachineCredentialsExpiry)
+
+			Expect(ExportMachineCredentialsNeedRenewal(secret, now, 2*time.Hour)).To(BeFalse())
+		})
+
+		It("should renew credentials with an invalid expiry", func() {
+			secret.Annotations[common.MachineCredentialsExpiry] = "tomorrow"
+
+			Expect(ExportMachineCredentialsNeedRenewal(secret, now, 2*time.Hour)).To(BeTrue())
+		})
+
+		It("should renew empty credentials", func() {
+			secret.Data = nil
+
+			Expect(ExportMachineCredentialsNeedRenewal(secret, now, 2*time.Hour)).To(BeTrue())
+		})
+	})
+})
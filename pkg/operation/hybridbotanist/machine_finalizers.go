@@ -0,0 +1,153 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+)
+
+// ensureMachineClassFinalizers adds the Gardener finalizer to all machine classes in the Shoot namespace of the Seed
+// which are not being deleted. The machine-controller-manager requires the machine classes (and their secrets) to
+// delete the instances of the machines, hence, they must not disappear before the Gardener has confirmed that all
+// instances are gone.
+func (b *HybridBotanist) ensureMachineClassFinalizers(ctx context.Context, machineClassPlural string) error {
+	var machineClassList unstructured.Unstructured
+
+	if err := b.K8sSeedClient.MachineV1alpha1("GET", machineClassPlural, b.Shoot.SeedNamespace).Context(ctx).Do().Into(&machineClassList); err != nil {
+		return err
+	}
+
+	return machineClassList.EachListItem(func(o runtime.Object) error {
+		obj := o.(*unstructured.Unstructured)
+		if obj.GetDeletionTimestamp() != nil {
+			return nil
+		}
+		return b.updateMachineClassFinalizer(ctx, machineClassPlural, obj, true)
+	})
+}
+
+// removeMachineClassFinalizer removes the Gardener finalizer from the given machine class <obj>, hence, it can be
+// deleted once the machine-controller-manager has released it as well.
+func (b *HybridBotanist) removeMachineClassFinalizer(ctx context.Context, machineClassPlural string, obj *unstructured.Unstructured) error {
+	return b.updateMachineClassFinalizer(ctx, machineClassPlural, obj, false)
+}
+
+// updateMachineClassFinalizer adds (if <add> is true) or removes the Gardener finalizer of the given machine class
+// <obj>. The finalizers are patched together with the resource version of the object, hence, the finalizers of the
+// machine-controller-manager are never overwritten. In case of a conflict, the patch is retried with the latest
+// version of the machine class.
+func (b *HybridBotanist) updateMachineClassFinalizer(ctx context.Context, machineClassPlural string, obj *unstructured.Unstructured, add bool) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		patch, changed, err := machineClassFinalizerPatch(obj, add)
+		if err != nil || !changed {
+			return err
+		}
+
+		err = b.K8sSeedClient.MachineV1alpha1Patch(machineClassPlural, b.Shoot.SeedNamespace, obj.GetName(), types.MergePatchType, patch).Context(ctx).Do().Error()
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		if !apierrors.IsConflict(err) {
+			return err
+		}
+
+		var latest unstructured.Unstructured
+		if err := b.K8sSeedClient.MachineV1alpha1("GET", machineClassPlural, b.Shoot.SeedNamespace).Name(obj.GetName()).Context(ctx).Do().Into(&latest); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		obj = &latest
+		return err
+	})
+}
+
+// machineClassFinalizerPatch computes a merge patch which adds (if <add> is true) or removes the Gardener finalizer
+// to/from the finalizers of the given machine class <obj>. The patch contains the resource version of the object, so
+// it is rejected with a conflict if the finalizers have been changed concurrently. The second return value is false if
+// the finalizers do not need to be changed.
+func machineClassFinalizerPatch(obj *unstructured.Unstructured, add bool) ([]byte, bool, error) {
+	finalizers := sets.NewString(obj.GetFinalizers()...)
+	if finalizers.Has(gardenv1beta1.ExternalGardenerName) == add {
+		return nil, false, nil
+	}
+
+	if add {
+		finalizers.Insert(gardenv1beta1.ExternalGardenerName)
+	} else {
+		finalizers.Delete(gardenv1beta1.ExternalGardenerName)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"finalizers":      finalizers.List(),
+			"resourceVersion": obj.GetResourceVersion(),
+		},
+	})
+	return patch, true, err
+}
+
+// waitUntilMachineInstancesDeleted waits until the cloud provider does not report any instances for the machines of
+// the Shoot anymore. The machine-controller-manager might have dropped machine objects without terminating their
+// instances, hence, the deletion of the machine objects alone does not prove that the instances are gone. It returns
+// immediately if the cloud provider does not support listing the instances. It returns the error of the given <ctx>
+// once it has been canceled.
+func (b *HybridBotanist) waitUntilMachineInstancesDeleted(ctx context.Context) error {
+	waitCtx, cancel := context.WithTimeout(ctx, b.machineWaitTimeout())
+	defer cancel()
+
+	var (
+		lastCount    = -1
+		pollInterval = b.machineWaitPollInterval()
+	)
+
+	for {
+		instanceIDs, supported, err := b.ShootCloudBotanist.ListMachineInstanceIDs()
+		if err != nil {
+			return err
+		}
+		if !supported || len(instanceIDs) == 0 {
+			return nil
+		}
+
+		if len(instanceIDs) != lastCount {
+			b.Logger.Infof("Waiting until the %d remaining instance(s) of the machines have been deleted by the cloud provider", len(instanceIDs))
+			lastCount = len(instanceIDs)
+		}
+
+		select {
+		case <-time.After(wait.Jitter(pollInterval, machineWaitPollJitter)):
+			pollInterval = nextMachineWaitPollInterval(pollInterval, b.machineWaitPollInterval())
+		case <-waitCtx.Done():
+			err := machineWaitError(ctx)
+			if err == wait.ErrWaitTimeout {
+				b.countMachineWaitTimeout(machineWaitDeletion)
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// fakeInstanceLister is a CloudBotanist which reports the given instance IDs, one list per call.
+type fakeInstanceLister struct {
+	cloudbotanist.CloudBotanist
+	instanceIDs [][]string
+	supported   bool
+	err         error
+	calls       int
+}
+
+func (f *fakeInstanceLister) ListMachineInstanceIDs() ([]string, bool, error) {
+	defer func() { f.calls++ }()
+	if f.calls >= len(f.instanceIDs) {
+		return f.instanceIDs[len(f.instanceIDs)-1], f.supported, f.err
+	}
+	return f.instanceIDs[f.calls], f.supported, f.err
+}
+
+var _ = Describe("machine finalizers", func() {
+	Describe("#machineClassFinalizerPatch", func() {
+		var (
+			machineClass = func(finalizers ...string) *unstructured.Unstructured {
+				obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+				obj.SetName("class-a")
+				obj.SetResourceVersion("42")
+				obj.SetFinalizers(finalizers)
+				return obj
+			}
+			decode = func(patch []byte) map[string]interface{} {
+				var result map[string]interface{}
+				Expect(json.Unmarshal(patch, &result)).To(Succeed())
+				return result["metadata"].(map[string]interface{})
+			}
+		)
+
+		It("should add the Gardener finalizer and keep the other finalizers", func() {
+			patch, changed, err := ExportMachineClassFinalizerPatch(machineClass("machine.sapcloud.io/machine-controller-manager"), true)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			metadata := decode(patch)
+			Expect(metadata["finalizers"]).To(ConsistOf("machine.sapcloud.io/machine-controller-manager", gardenv1beta1.ExternalGardenerName))
+			Expect(metadata["resourceVersion"]).To(Equal("42"))
+		})
+
+		It("should not change the finalizers if the Gardener finalizer is already present", func() {
+			_, changed, err := ExportMachineClassFinalizerPatch(machineClass(gardenv1beta1.ExternalGardenerName), true)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+		})
+
+		It("should remove the Gardener finalizer and keep the other finalizers", func() {
+			patch, changed, err := ExportMachineClassFinalizerPatch(machineClass("machine.sapcloud.io/machine-controller-manager", gardenv1beta1.ExternalGardenerName), false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeTrue())
+			Expect(decode(patch)["finalizers"]).To(ConsistOf("machine.sapcloud.io/machine-controller-manager"))
+		})
+
+		It("should not change the finalizers if the Gardener finalizer is not present", func() {
+			_, changed, err := ExportMachineClassFinalizerPatch(machineClass(), false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changed).To(BeFalse())
+		})
+	})
+
+	Describe("#waitUntilMachineInstancesDeleted", func() {
+		var newHybridBotanist = func(lister *fakeInstanceLister, timeout time.Duration) *HybridBotanist {
+			return &HybridBotanist{
+				Operation: &operation.Operation{
+					Logger: logger.NewFieldLogger(logger.NewLogger("info"), "test", "machines"),
+					Shoot: &shoot.Shoot{
+						Info: &gardenv1beta1.Shoot{
+							ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+						},
+						SeedNamespace: "shoot--foo--bar",
+					},
+				},
+				ShootCloudBotanist:      lister,
+				MachineWaitTimeout:      timeout,
+				MachineWaitPollInterval: 10 * time.Millisecond,
+			}
+		}
+
+		It("should return once the cloud provider does not report any instances anymore", func() {
+			lister := &fakeInstanceLister{instanceIDs: [][]string{{"i-1", "i-2"}, {"i-2"}, {}}, supported: true}
+
+			Expect(ExportWaitUntilMachineInstancesDeleted(newHybridBotanist(lister, 5*time.Second), context.TODO())).To(Succeed())
+			Expect(lister.calls).To(Equal(3))
+		})
+
+		It("should return immediately if the cloud provider does not support listing the instances", func() {
+			lister := &fakeInstanceLister{instanceIDs: [][]string{nil}}
+
+			Expect(ExportWaitUntilMachineInstancesDeleted(newHybridBotanist(lister, 5*time.Second), context.TODO())).To(Succeed())
+			Expect(lister.calls).To(Equal(1))
+		})
+
+		It("should return the error of the cloud provider", func() {
+			lister := &fakeInstanceLister{instanceIDs: [][]string{nil}, supported: true, err: errors.New("unauthorized")}
+
+			Expect(ExportWaitUntilMachineInstancesDeleted(newHybridBotanist(lister, 5*time.Second), context.TODO())).To(MatchError("unauthorized"))
+		})
+
+		It("should time out if the instances are not deleted", func() {
+			lister := &fakeInstanceLister{instanceIDs: [][]string{{"i-1"}}, supported: true}
+
+			Expect(ExportWaitUntilMachineInstancesDeleted(newHybridBotanist(lister, 200*time.Millisecond), context.TODO())).To(Equal(wait.ErrWaitTimeout))
+		})
+
+		It("should not wait if the context has already been canceled", func() {
+			lister := &fakeInstanceLister{instanceIDs: [][]string{{"i-1"}}, supported: true}
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			Expect(ExportWaitUntilMachineInstancesDeleted(newHybridBotanist(lister, time.Minute), ctx)).To(Equal(context.Canceled))
+		})
+	})
+})
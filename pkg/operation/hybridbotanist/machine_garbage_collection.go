@@ -0,0 +1,134 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// CollectOrphanedMachines deletes the machines whose instances do not exist at the cloud provider anymore (so that
+// the machine-controller-manager creates replacements) as well as the nodes of the Shoot which do not belong to any
+// machine and have not been ready for longer than the respective grace period.
+func (b *HybridBotanist) CollectOrphanedMachines() error {
+	machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	if b.OrphanedMachineGracePeriod > 0 {
+		instanceIDs, supported, err := b.ShootCloudBotanist.ListMachineInstanceIDs()
+		if err != nil {
+			return err
+		}
+		if supported {
+			for _, name := range orphanedMachines(machineList.Items, sets.NewString(instanceIDs...), b.OrphanedMachineGracePeriod, time.Now()) {
+				b.Logger.Infof("Deleting machine %s as its instance does not exist anymore", name)
+				if err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).Delete(name, nil); err != nil && !apierrors.IsNotFound(err) {
+					return err
+				}
+			}
+		}
+	}
+
+	if b.OrphanedNodeGracePeriod > 0 && b.K8sShootClient != nil {
+		selector := common.WorkerGroupLabel
+		if externalWorkerNames := b.Shoot.GetExternalWorkerNames(); len(externalWorkerNames) > 0 {
+			// The nodes of the external worker groups never belong to a machine.
+			selector = fmt.Sprintf("%s,%s notin (%s)", common.WorkerGroupLabel, common.WorkerGroupLabel, strings.Join(externalWorkerNames, ","))
+		}
+		nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return err
+		}
+		for _, name := range orphanedNodes(nodeList.Items, machineList.Items, b.OrphanedNodeGracePeriod, time.Now()) {
+			b.Logger.Infof("Deleting node %s as it does not belong to any machine", name)
+			if err := b.K8sShootClient.Clientset().CoreV1().Nodes().Delete(name, nil); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// orphanedMachines returns the names of the given <machines> which are older than <gracePeriod> and whose provider
+// ID does not refer to any of the existing <instanceIDs>. Machines which have not been assigned an instance yet are
+// never considered orphaned.
+func orphanedMachines(machines []machinev1alpha1.Machine, instanceIDs sets.String, gracePeriod time.Duration, now time.Time) []string {
+	var names []string
+	for _, machine := range machines {
+		if len(machine.Spec.ProviderID) == 0 || machine.DeletionTimestamp != nil {
+			continue
+		}
+		if now.Sub(machine.CreationTimestamp.Time) < gracePeriod {
+			continue
+		}
+		if !instanceIDs.Has(providerIDInstance(machine.Spec.ProviderID)) {
+			names = append(names, machine.Name)
+		}
+	}
+	return names
+}
+
+// orphanedNodes returns the names of the given <nodes> which are not referenced by any of the given <machines> and
+// have not been ready for longer than <gracePeriod>. Nodes which are protected from scale-downs are never returned.
+func orphanedNodes(nodes []corev1.Node, machines []machinev1alpha1.Machine, gracePeriod time.Duration, now time.Time) []string {
+	machineNodes := sets.NewString()
+	for _, machine := range machines {
+		if len(machine.Status.Node) > 0 {
+			machineNodes.Insert(machine.Status.Node)
+		}
+	}
+
+	var names []string
+	for _, node := range nodes {
+		if machineNodes.Has(node.Name) || node.DeletionTimestamp != nil || common.ScaleDownProtected(node.Annotations) {
+			continue
+		}
+		if notReadySince, ok := nodeNotReadySince(node); ok && now.Sub(notReadySince) >= gracePeriod {
+			names = append(names, node.Name)
+		}
+	}
+	return names
+}
+
+// nodeNotReadySince returns the time since when the given <node> has not been ready. The second return value is
+// false if the node is ready.
+func nodeNotReadySince(node corev1.Node) (time.Time, bool) {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			if condition.Status == corev1.ConditionTrue {
+				return time.Time{}, false
+			}
+			return condition.LastTransitionTime.Time, true
+		}
+	}
+	return node.CreationTimestamp.Time, true
+}
+
+// providerIDInstance returns the instance identifier of the given <providerID>, i.e., its last path segment
+// (e.g., "i-0123456789" for "aws:///eu-west-1a/i-0123456789").
+func providerIDInstance(providerID string) string {
+	return providerID[strings.LastIndex(providerID, "/")+1:]
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("machine garbage collection", func() {
+	var (
+		now         = time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+		gracePeriod = 10 * time.Minute
+
+		machine = func(name, providerID, node string, age time.Duration) machinev1alpha1.Machine {
+			return machinev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(now.Add(-age))},
+				Spec:       machinev1alpha1.MachineSpec{ProviderID: providerID},
+				Status:     machinev1alpha1.MachineStatus{Node: node},
+			}
+		}
+		node = func(name string, ready corev1.ConditionStatus, since time.Duration) corev1.Node {
+			return corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: corev1.NodeStatus{
+					Conditions: []corev1.NodeCondition{
+						{Type: corev1.NodeReady, Status: ready, LastTransitionTime: metav1.NewTime(now.Add(-since))},
+					},
+				},
+			}
+		}
+	)
+
+	Describe("#orphanedMachines", func() {
+		It("should return the machines older than the grace period whose instances do not exist", func() {
+			machines := []machinev1alpha1.Machine{
+				machine("existing", "aws:///eu-west-1a/i-1", "", time.Hour),
+				machine("orphaned", "aws:///eu-west-1a/i-2", "", time.Hour),
+				machine("young", "aws:///eu-west-1a/i-3", "", time.Minute),
+				machine("pending", "", "", time.Hour),
+			}
+
+			Expect(ExportOrphanedMachines(machines, sets.NewString("i-1"), gracePeriod, now)).To(Equal([]string{"orphaned"}))
+		})
+	})
+
+	Describe("#orphanedNodes", func() {
+		It("should return the nodes without machine which have not been ready for longer than the grace period", func() {
+			nodes := []corev1.Node{
+				node("owned", corev1.ConditionFalse, time.Hour),
+				node("ready", corev1.ConditionTrue, time.Hour),
+				node("recent", corev1.ConditionUnknown, time.Minute),
+				node("protected", corev1.ConditionUnknown, time.Hour),
+				node("orphaned", corev1.ConditionUnknown, time.Hour),
+			}
+			nodes[3].Annotations = map[string]string{common.ScaleDownProtectionAnnotation: "true"}
+			machines := []machinev1alpha1.Machine{
+				machine("machine", "aws:///eu-west-1a/i-1", "owned", time.Hour),
+			}
+
+			Expect(ExportOrphanedNodes(nodes, machines, gracePeriod, now)).To(Equal([]string{"orphaned"}))
+		})
+	})
+})
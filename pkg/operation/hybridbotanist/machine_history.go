@@ -0,0 +1,94 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// machineHistoryLimit is the maximum number of records kept in the machine history config map.
+	machineHistoryLimit = 100
+
+	// machineHistoryReasonNotDesired is the reason for the deletion of machine resources which are no longer
+	// computed from the Shoot specification.
+	machineHistoryReasonNotDesired = "NotDesired"
+	// machineHistoryReasonShootDeletion is the reason for the deletion of machine resources while the Shoot is
+	// deleted.
+	machineHistoryReasonShootDeletion = "ShootDeletion"
+)
+
+// machineHistoryRecord is a compact record of a deleted MachineDeployment or MachineClass.
+type machineHistoryRecord struct {
+	Kind      string      `json:"kind"`
+	Name      string      `json:"name"`
+	Class     string      `json:"class,omitempty"`
+	Replicas  *int32      `json:"replicas,omitempty"`
+	Reason    string      `json:"reason"`
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// recordMachineHistory appends the given <records> to the machine history config map in the Shoot namespace of
+// the Seed before the machine resources are deleted, so that accidental deletions (e.g., of a worker group which
+// was removed by a typo in the Shoot specification) can be reconstructed.
+func (b *HybridBotanist) recordMachineHistory(records []machineHistoryRecord) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var history []machineHistoryRecord
+
+	configMap, err := b.K8sSeedClient.GetConfigMap(b.Shoot.SeedNamespace, common.MachineHistoryConfigMapName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		if data, ok := configMap.Data[common.MachineHistoryConfigMapKey]; ok {
+			if err := json.Unmarshal([]byte(data), &history); err != nil {
+				b.Logger.Warnf("Discarding the unreadable machine history: %s", err.Error())
+				history = nil
+			}
+		}
+	}
+
+	for _, record := range records {
+		b.Logger.Infof("Deleting %s %s (reason: %s)", record.Kind, record.Name, record.Reason)
+	}
+
+	history = appendMachineHistory(history, records, machineHistoryLimit)
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+
+	_, err = b.K8sSeedClient.CreateConfigMap(b.Shoot.SeedNamespace, common.MachineHistoryConfigMapName, map[string]string{
+		common.MachineHistoryConfigMapKey: string(data),
+	}, true)
+	return err
+}
+
+// appendMachineHistory appends the <records> to the <history> and drops the oldest records so that at most <limit>
+// records are kept.
+func appendMachineHistory(history, records []machineHistoryRecord, limit int) []machineHistoryRecord {
+	history = append(history, records...)
+	if len(history) > limit {
+		history = history[len(history)-limit:]
+	}
+	return history
+}
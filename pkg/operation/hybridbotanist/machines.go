@@ -15,106 +15,450 @@
 package hybridbotanist
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	machineinformers "github.com/gardener/gardener/pkg/client/machine/informers/externalversions"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
 )
 
 var chartPathMachines = filepath.Join(common.ChartPath, "seed-machines", "charts", "machines")
 
+const (
+	// maxMachineWaitPollInterval is the maximum interval in which the machine resources are re-checked while they do
+	// not change (unless the configured poll interval is larger).
+	maxMachineWaitPollInterval = time.Minute
+	// machineWaitPollJitter is the maximum factor by which the poll interval is extended randomly.
+	machineWaitPollJitter = 0.2
+)
+
 // DeployMachines asks the CloudBotanist to provide the specific configuration for MachineClasses and MachineDeployments.
-// It deploys the machine specifications, waits until it is ready and cleans old specifications.
-func (b *HybridBotanist) DeployMachines() error {
+// It deploys the machine specifications, waits until it is ready and cleans old specifications. It returns early once
+// the given <ctx> has been canceled, e.g. because the Shoot has been deleted or changed in the meantime.
+func (b *HybridBotanist) DeployMachines(ctx context.Context) (err error) {
+	defer b.observeMachineOperation(machineOperationDeploy, time.Now(), &err)
+
 	machineClassKind, machineClassPlural, machineClassChartName := b.ShootCloudBotanist.GetMachineClassInfo()
 
+	if err := b.ensureMachineCredentials(); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineClassGeneration, err, "Failed to provide the machine credentials: '%s'", err.Error())
+	}
+
+	// Consider the checksums of the cloud configs for the machine class names, hence, the machines of a worker group are
+	// replaced by a rolling update of its machine deployments once its cloud config changes.
+	if b.Shoot.ReplaceMachinesOnCloudConfigChange() {
+		if err := b.computeCloudConfigChecksums(); err != nil {
+			return operationerrors.WrapKind(operationerrors.ErrMachineClassGeneration, err, "Failed to compute the checksums of the cloud configs: '%s'", err.Error())
+		}
+	}
+
+	// Consider the times of the requested restarts for the machine class names, hence, the machines of a worker group
+	// whose restart has been requested are replaced by a rolling update of its machine deployments.
+	if err := b.computeMachineRestarts(); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineClassGeneration, err, "Failed to determine the restarts of the worker groups: '%s'", err.Error())
+	}
+
 	// Generate machine classes configuration and list of corresponding machine deployments.
 	machineClassChartValues, machineDeployments, err := b.ShootCloudBotanist.GenerateMachineConfig()
 	if err != nil {
-		return fmt.Errorf("The CloudBotanist failed to generate the machine config: '%s'", err.Error())
+		return operationerrors.WrapKind(operationerrors.ErrMachineClassGeneration, err, "The CloudBotanist failed to generate the machine config: '%s'", err.Error())
+	}
+
+	if b.Shoot.ReplaceMachinesOnCloudConfigChange() {
+		if err := b.reportCloudConfigDrift(machineDeployments); err != nil {
+			return operationerrors.WrapKind(operationerrors.ErrMachineClassDeployment, err, "Failed to detect the worker groups with changed cloud configs: '%s'", err.Error())
+		}
+	}
+
+	// Fail fast if the cloud provider lacks the capacity for the machines or does not offer their images.
+	if err := b.checkMachineCapacity(machineClassChartValues, machineDeployments); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineCapacity, err, "The pre-flight check of the machines failed: '%s'", err.Error())
 	}
 
 	// Deploy generated machine classes.
 	values := map[string]interface{}{
 		"machineClasses": machineClassChartValues,
 	}
-	if err := b.ApplyChartSeed(filepath.Join(common.ChartPath, "seed-machines", "charts", machineClassChartName), machineClassChartName, b.Shoot.SeedNamespace, values, nil); err != nil {
-		return fmt.Errorf("Failed to deploy the generated machine classes: '%s'", err.Error())
+	classChecksums := machineClassCloudConfigChecksums(machineDeployments, b.Shoot.CloudConfigChecksums)
+	if err := b.deployMachineClasses(machineClassPlural, machineClassChartName, values, classChecksums); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineClassDeployment, err, "Failed to deploy the generated machine classes: '%s'", err.Error())
+	}
+	if err := b.ensureMachineClassFinalizers(ctx, machineClassPlural); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineClassDeployment, err, "Failed to add the finalizers to the machine classes: '%s'", err.Error())
+	}
+
+	// Determine the current number of replicas of the existing machine deployments (required for those whose size is
+	// managed by the cluster-autoscaler and for those which are woken up after a hibernation).
+	existingReplicas, err := b.machineDeploymentReplicas()
+	if err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to determine the replicas of the existing machine deployments: '%s'", err.Error())
+	}
+
+	// Restore the even distribution of the machines of autoscaled worker groups over their zones once all zones are
+	// healthy again, e.g. after the cluster-autoscaler compensated a zone outage with machines in the other zones.
+	if existingReplicas, err = b.rebalanceZoneReplicas(machineDeployments, existingReplicas); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to rebalance the machine deployments over the zones: '%s'", err.Error())
+	}
+
+	// Drain the nodes before the machine deployments are scaled down to zero replicas when the Shoot is hibernated.
+	if b.Shoot.Hibernated {
+		if err := b.drainMachines(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Machine deployments which have been paused by an operator are neither updated nor waited for. They are kept
+	// together with their machine classes by the cleanup.
+	deployedDeployments, pausedDeployments, err := b.pauseMachineDeployments(machineDeployments)
+	if err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to determine the paused machine deployments: '%s'", err.Error())
 	}
+	machineDeployments = append(machineDeployments, pausedDeployments...)
 
-	// Generate machien deployment configuration based on previously computed list of deployments.
-	machineDeploymentChartValues, err := b.generateMachineDeploymentConfig(machineDeployments, machineClassKind)
+	// Machine deployments whose machines would be replaced keep their current machine classes until the maintenance
+	// time window if the Shoot requests it. All other changes are applied immediately.
+	deployedDeployments, err = b.deferMachineReplacements(deployedDeployments)
 	if err != nil {
-		return fmt.Errorf("Failed to generate the machine deployment config: '%s'", err.Error())
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to determine the machine deployments whose machine replacement is deferred: '%s'", err.Error())
 	}
 
-	// Deploy generated machine deployments.
-	if err := b.ApplyChartSeed(filepath.Join(chartPathMachines), "machines", b.Shoot.SeedNamespace, machineDeploymentChartValues, nil); err != nil {
-		return fmt.Errorf("Failed to deploy the generated machine deployments: '%s'", err.Error())
+	// Spot worker groups use their on-demand fallback machine classes while the cloud provider has no spare capacity.
+	deployedDeployments, err = b.applySpotFallback(deployedDeployments)
+	if err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to determine the machine deployments which fall back to on-demand machines: '%s'", err.Error())
+	}
+
+	// Worker groups with canary rollouts try changed machine classes on a few canary machines first. Their machine
+	// deployments keep the current machine classes until the canary machines have been healthy for the soak period.
+	deployedDeployments, canaryDeployments, err := b.applyCanaryRollouts(deployedDeployments)
+	if err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to determine the progress of the canary rollouts: '%s'", err.Error())
+	}
+	machineDeployments = append(machineDeployments, canaryDeployments...)
+
+	// Machine deployments whose replicas decrease by more machines than the scale-down budgets of the Shoot and of
+	// their worker groups allow are stepped down gradually over the following reconciliations.
+	if err := b.limitScaleDowns(deployedDeployments, existingReplicas); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to apply the scale-down budgets to the machine deployments: '%s'", err.Error())
+	}
+
+	// Deploy generated machine deployments stage by stage, concurrently for all worker groups of a stage. The worker
+	// groups of a stage are only rolled out once those of all lower stages are available, hence, a failed rollout (e.g.
+	// of a new machine image on a canary worker group) does not touch the worker groups of the higher stages.
+	var (
+		workerErrors = workerGroupErrors{}
+		stages       = machineDeploymentRolloutStages(deployedDeployments, b.Shoot.GetWorkers())
+	)
+	for i, stage := range stages {
+		if len(stages) > 1 {
+			b.Logger.Infof("Rolling out the machines of rollout stage %d of %d", i+1, len(stages))
+		}
+
+		deployedMachineDeployments, stageErrors := b.deployMachineDeployments(ctx, stage, machineClassKind, existingReplicas)
+
+		// Forget the replicas recorded during a previous hibernation once all machine deployments have been scaled up again.
+		if i == len(stages)-1 && !b.Shoot.Hibernated {
+			if err := b.removeHibernatedReplicas(); err != nil {
+				return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to remove the hibernated replicas of the machine deployments: '%s'", err.Error())
+			}
+		}
+
+		// Wait until all deployed machine deployments of the stage are healthy/available. They are watched together,
+		// hence, a slow worker group does not delay noticing that the others have been rolled out.
+		if err := b.waitUntilMachineDeploymentsAvailable(ctx, deployedMachineDeployments); err != nil {
+			if ctx.Err() != nil {
+				return err
+			}
+			for workerName, err := range b.attributeMachineDeploymentsWaitError(deployedMachineDeployments, err) {
+				stageErrors[workerName] = err
+			}
+		}
+
+		for workerName, err := range stageErrors {
+			workerErrors[workerName] = err
+		}
+		if len(workerErrors) > 0 {
+			break
+		}
 	}
 
-	// Wait until all generated machine deployments are healthy/available.
-	if err := b.waitUntilMachineDeploymentsAvailable(machineDeployments); err != nil {
-		return fmt.Errorf("Failed while waiting for all machine deployments to be ready: '%s'", err.Error())
+	// The old machine resources are only cleaned up once all worker groups have been rolled out successfully.
+	if len(workerErrors) > 0 {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, workerErrors, "Failed to roll out the machines of %d worker group(s): %s", len(workerErrors), workerErrors.Error())
+	}
+
+	// The old machine resources are kept while a canary rollout is halted, its canary machines are kept for the analysis.
+	if err := b.haltedCanaryRolloutsError(); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to roll out the machines: '%s'", err.Error())
+	}
+
+	// The workload of renamed worker groups is migrated gradually to the worker groups with the new names, which have
+	// been rolled out above, before the machine deployments of the old names are deleted.
+	if err := b.migrateRenamedWorkers(ctx); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to migrate the renamed worker groups: '%s'", err.Error())
 	}
 
 	// Delete all old machine deployments (i.e. those which were not previously computed by exist in the cluster).
-	if err := b.cleanupMachineDeployments(machineDeployments); err != nil {
-		return fmt.Errorf("Failed to cleanup the machine deployments: '%s'", err.Error())
+	if err := b.cleanupMachineDeployments(ctx, machineDeployments, machineHistoryReasonNotDesired); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineCleanup, err, "Failed to cleanup the machine deployments: '%s'", err.Error())
 	}
 
 	// Delete all old machine classes (i.e. those which were not previously computed by exist in the cluster).
-	usedSecrets, err := b.cleanupMachineClasses(machineClassPlural, machineDeployments)
+	usedSecrets, err := b.cleanupMachineClasses(ctx, machineClassPlural, machineDeployments, machineHistoryReasonNotDesired)
 	if err != nil {
-		return fmt.Errorf("The CloudBotanist failed to cleanup the machine classes: '%s'", err.Error())
+		return operationerrors.WrapKind(operationerrors.ErrMachineCleanup, err, "The CloudBotanist failed to cleanup the machine classes: '%s'", err.Error())
 	}
 
 	// Delete all old machine class secrets (i.e. those which were not previously computed by exist in the cluster).
-	if err := b.cleanupMachineClassSecrets(usedSecrets); err != nil {
-		return fmt.Errorf("The CloudBotanist failed to cleanup the orphaned machine class secrets: '%s'", err.Error())
+	if err := b.cleanupMachineClassSecrets(ctx, usedSecrets); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineCleanup, err, "The CloudBotanist failed to cleanup the orphaned machine class secrets: '%s'", err.Error())
 	}
 
 	return nil
 }
 
-// DestroyMachines deletes all existing MachineDeployments. As it won't trigger the drain of nodes it needs to label
-// the existing machines. In case an errors occurs, it will return it.
-func (b *HybridBotanist) DestroyMachines() error {
+// machineDeploymentRolloutStages groups the given <machineDeployments> by the rollout stages of their worker groups
+// (see the <workers>) and returns the groups in ascending order of the stages.
+func machineDeploymentRolloutStages(machineDeployments []operation.MachineDeployment, workers []gardenv1beta1.Worker) [][]operation.MachineDeployment {
 	var (
-		machineList unstructured.Unstructured
-		errorList   []error
-		wg          sync.WaitGroup
+		stageByWorkerName  = map[string]int32{}
+		deploymentsByStage = map[int32][]operation.MachineDeployment{}
+		stageNumbers       []int
+		rolloutStages      [][]operation.MachineDeployment
 	)
 
-	if err := b.K8sSeedClient.MachineV1alpha1("GET", "machines", b.Shoot.SeedNamespace).Do().Into(&machineList); err != nil {
-		return err
+	for _, worker := range workers {
+		if worker.RolloutStage != nil {
+			stageByWorkerName[worker.Name] = *worker.RolloutStage
+		}
+	}
+	for _, deployment := range machineDeployments {
+		stage := stageByWorkerName[deployment.WorkerName]
+		if _, ok := deploymentsByStage[stage]; !ok {
+			stageNumbers = append(stageNumbers, int(stage))
+		}
+		deploymentsByStage[stage] = append(deploymentsByStage[stage], deployment)
+	}
+
+	sort.Ints(stageNumbers)
+	for _, stage := range stageNumbers {
+		rolloutStages = append(rolloutStages, deploymentsByStage[int32(stage)])
+	}
+	return rolloutStages
+}
+
+// workerGroupErrors maps the names of worker groups to the errors which occurred while their machines were rolled out.
+type workerGroupErrors map[string]error
+
+// Error returns the errors of all worker groups ordered by the names of the worker groups.
+func (e workerGroupErrors) Error() string {
+	workerNames := make([]string, 0, len(e))
+	for workerName := range e {
+		workerNames = append(workerNames, workerName)
 	}
+	sort.Strings(workerNames)
 
-	machineList.EachListItem(func(o runtime.Object) error {
+	messages := make([]string, 0, len(workerNames))
+	for _, workerName := range workerNames {
+		messages = append(messages, fmt.Sprintf("worker group %s: '%s'", workerName, e[workerName].Error()))
+	}
+	return strings.Join(messages, ", ")
+}
+
+// Class returns the class of the errors of all worker groups, see operationerrors.AggregateClass.
+func (e workerGroupErrors) Class() operationerrors.Class {
+	classes := make([]operationerrors.Class, 0, len(e))
+	for _, err := range e {
+		classes = append(classes, operationerrors.ClassOf(err))
+	}
+	return operationerrors.AggregateClass(classes...)
+}
+
+// deployMachineDeployments deploys the given <machineDeployments>, concurrently for all worker groups. A worker group
+// whose machine deployments cannot be deployed does not prevent the others from being deployed. It returns the
+// machine deployments which have been deployed successfully and the errors of the other worker groups. The machine
+// deployments are not applied anymore once the given <ctx> has been canceled.
+func (b *HybridBotanist) deployMachineDeployments(ctx context.Context, machineDeployments []operation.MachineDeployment, classKind string, existingReplicas map[string]int) ([]operation.MachineDeployment, workerGroupErrors) {
+	var (
+		workerNames          []string
+		deploymentsByWorker  = map[string][]operation.MachineDeployment{}
+		manifestsByWorker    = map[string][]byte{}
+		workerErrors         = workerGroupErrors{}
+		deployedByWorkerName = map[string]bool{}
+		mutex                sync.Mutex
+		wg                   sync.WaitGroup
+	)
+
+	for _, deployment := range machineDeployments {
+		if _, ok := deploymentsByWorker[deployment.WorkerName]; !ok {
+			workerNames = append(workerNames, deployment.WorkerName)
+		}
+		deploymentsByWorker[deployment.WorkerName] = append(deploymentsByWorker[deployment.WorkerName], deployment)
+	}
+
+	// The chart renderer must not be used concurrently, hence, only the manifests are applied concurrently.
+	for _, workerName := range workerNames {
+		values, err := b.generateMachineDeploymentConfig(deploymentsByWorker[workerName], classKind, existingReplicas)
+		if err != nil {
+			workerErrors[workerName] = operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to generate the machine deployment config: '%s'", err.Error())
+			continue
+		}
+		release, err := b.ChartSeedRenderer.Render(chartPathMachines, "machines", b.Shoot.SeedNamespace, values)
+		if err != nil {
+			workerErrors[workerName] = operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to render the generated machine deployments: '%s'", err.Error())
+			continue
+		}
+		manifestsByWorker[workerName] = release.Manifest()
+	}
+
+	for workerName, manifest := range manifestsByWorker {
 		wg.Add(1)
-		go func(obj *unstructured.Unstructured) {
+		go func(workerName string, manifest []byte) {
 			defer wg.Done()
-			if err := b.labelMachine(obj); err != nil {
-				errorList = append(errorList, err)
+			err := ctx.Err()
+			if err == nil {
+				err = b.K8sSeedClient.Apply(manifest)
 			}
-		}(o.(*unstructured.Unstructured))
-		return nil
-	})
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				workerErrors[workerName] = operationerrors.WrapKind(operationerrors.ErrMachineDeployment, err, "Failed to deploy the generated machine deployments: '%s'", err.Error())
+				return
+			}
+			deployedByWorkerName[workerName] = true
+		}(workerName, manifest)
+	}
 	wg.Wait()
 
-	if len(errorList) > 0 {
-		return fmt.Errorf("Labelling machines failed: %v", errorList)
+	var deployed []operation.MachineDeployment
+	for _, workerName := range workerNames {
+		if deployedByWorkerName[workerName] {
+			deployed = append(deployed, deploymentsByWorker[workerName]...)
+		}
+	}
+	return deployed, workerErrors
+}
+
+// attributeMachineDeploymentsWaitError attributes the error <err> which occurred while waiting for the given
+// <machineDeployments> to the worker groups whose machine deployments have not been rolled out. If the machine
+// deployments cannot be listed, the error is attributed to all worker groups. The errors of the worker groups are
+// classified by the failures reported by the machine-controller-manager, see machineFailureKind.
+func (b *HybridBotanist) attributeMachineDeploymentsWaitError(machineDeployments []operation.MachineDeployment, err error) workerGroupErrors {
+	existing := map[string]*machinev1alpha1.MachineDeployment{}
+	machineDeploymentList, listErr := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if listErr == nil {
+		for i := range machineDeploymentList.Items {
+			existing[machineDeploymentList.Items[i].Name] = &machineDeploymentList.Items[i]
+		}
+	}
+
+	var (
+		unavailable = map[string][]string{}
+		failures    = map[string][]string{}
+	)
+	for _, machineDeployment := range machineDeployments {
+		deployment, ok := existing[machineDeployment.Name]
+		if ok && machineDeploymentRolledOut(deployment) {
+			continue
+		}
+		unavailable[machineDeployment.WorkerName] = append(unavailable[machineDeployment.WorkerName], machineDeployment.Name)
+		if ok {
+			for _, condition := range deployment.Status.Conditions {
+				if condition.Type == machinev1alpha1.MachineDeploymentReplicaFailure && condition.Status == machinev1alpha1.ConditionTrue {
+					failures[machineDeployment.WorkerName] = append(failures[machineDeployment.WorkerName], condition.Message)
+				}
+			}
+		}
+	}
+
+	workerErrors := workerGroupErrors{}
+	for workerName, names := range unavailable {
+		workerErrors[workerName] = machineDeploymentsWaitError(err, names, failures[workerName])
+	}
+	return workerErrors
+}
+
+var (
+	// machineQuotaFailure matches the errors of the cloud providers which are reported by the
+	// machine-controller-manager if machines cannot be created because quota limits have been exceeded.
+	machineQuotaFailure = regexp.MustCompile(`(?i)quota|instancelimitexceeded|vcpulimitexceeded`)
+	// machineCredentialsFailure matches the errors of the cloud providers which are reported by the
+	// machine-controller-manager if machines cannot be created because the credentials are invalid or lack privileges.
+	machineCredentialsFailure = regexp.MustCompile(`(?i)authfailure|unauthorizedoperation|invalidclienttokenid|signaturedoesnotmatch|authorizationfailed|invalidauthenticationtoken|invalid_grant|unauthorized|forbidden`)
+)
+
+// machineFailureKind returns the kind of the given <failure> reported by the machine-controller-manager, i.e.
+// operationerrors.ErrQuotaExceeded or operationerrors.ErrInvalidCredentials, or nil if it cannot be classified.
+func machineFailureKind(failure string) error {
+	switch {
+	case machineQuotaFailure.MatchString(failure):
+		return operationerrors.ErrQuotaExceeded
+	case machineCredentialsFailure.MatchString(failure):
+		return operationerrors.ErrInvalidCredentials
+	}
+	return nil
+}
+
+// machineWaitErrorKind returns operationerrors.ErrWaitTimeout if the given <err> occurred because the machine
+// resources did not reach the desired state in time, and the given <kind> otherwise.
+func machineWaitErrorKind(err, kind error) error {
+	if _, stuck := err.(*machineDeploymentsStuckError); stuck || err == wait.ErrWaitTimeout {
+		return operationerrors.ErrWaitTimeout
+	}
+	return kind
+}
+
+// machineDeploymentsWaitError returns the error of a worker group whose machine deployments with the given <names>
+// have not been rolled out because of the error <err>. Its kind is the one of the first of the given <failures>
+// (reported by the machine-controller-manager) which can be classified, and the kind of <err> otherwise.
+func machineDeploymentsWaitError(err error, names, failures []string) error {
+	for _, failure := range failures {
+		if kind := machineFailureKind(failure); kind != nil {
+			return operationerrors.WrapKind(kind, err, "%s (machine deployments not rolled out: %s): %s", err.Error(), strings.Join(names, ", "), failure)
+		}
+	}
+	return operationerrors.WrapKind(machineWaitErrorKind(err, operationerrors.ErrMachineDeployment), err, "%s (machine deployments not rolled out: %s)", err.Error(), strings.Join(names, ", "))
+}
+
+// DestroyMachines deletes all existing MachineDeployments. It only succeeds once both the machine resources and the
+// instances at the cloud provider are gone. Before, it drains the nodes of the Shoot cluster (if its
+// API server is reachable) so that the workload is evicted with respect to PodDisruptionBudgets. Only if the drain
+// did not finish within the configured timeout, it labels the existing machines for a forceful deletion (which skips
+// the drain performed by the machine-controller-manager). In case an errors occurs, it will return it. It returns early
+// once the given <ctx> has been canceled, e.g. because the Gardener is shutting down.
+func (b *HybridBotanist) DestroyMachines(ctx context.Context) (err error) {
+	defer b.observeMachineOperation(machineOperationDestroy, time.Now(), &err)
+
+	if err := b.drainMachines(ctx); err != nil {
+		return err
 	}
 
 	var (
@@ -122,24 +466,55 @@ func (b *HybridBotanist) DestroyMachines() error {
 		emptyMachineDeployments  = []operation.MachineDeployment{}
 	)
 
-	if err := b.cleanupMachineDeployments(emptyMachineDeployments); err != nil {
-		return fmt.Errorf("Cleaning up machine deployments failed: %s", err.Error())
+	if err := b.cleanupMachineDeployments(ctx, emptyMachineDeployments, machineHistoryReasonShootDeletion); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineCleanup, err, "Cleaning up machine deployments failed: %s", err.Error())
+	}
+
+	// Wait until all machines have been properly deleted. The machine classes are required by the
+	// machine-controller-manager to delete the machines, hence, they can only be deleted afterwards.
+	if err := b.waitUntilMachineResourcesDeleted(ctx, "machinedeployments", "machinesets", "machines"); err != nil {
+		return operationerrors.WrapKind(machineWaitErrorKind(err, operationerrors.ErrMachineCleanup), err, "Failed while waiting for all machine resources to be deleted: '%s'", err.Error())
 	}
-	if _, err := b.cleanupMachineClasses(machineClassPlural, emptyMachineDeployments); err != nil {
-		return fmt.Errorf("Cleaning up machine classes failed: %s", err.Error())
+	// The machine classes carry the finalizer of the Gardener, hence, they are only released once the cloud provider
+	// has confirmed that the instances of the machines are gone as well.
+	if err := b.waitUntilMachineInstancesDeleted(ctx); err != nil {
+		return operationerrors.WrapKind(machineWaitErrorKind(err, operationerrors.ErrMachineCleanup), err, "Failed while waiting for all machine instances to be deleted by the cloud provider: '%s'", err.Error())
 	}
+	if _, err := b.cleanupMachineClasses(ctx, machineClassPlural, emptyMachineDeployments, machineHistoryReasonShootDeletion); err != nil {
+		return operationerrors.WrapKind(operationerrors.ErrMachineCleanup, err, "Cleaning up machine classes failed: %s", err.Error())
+	}
+	if err := b.waitUntilMachineResourcesDeleted(ctx, machineClassPlural); err != nil {
+		return operationerrors.WrapKind(machineWaitErrorKind(err, operationerrors.ErrMachineCleanup), err, "Failed while waiting for all machine classes to be deleted: '%s'", err.Error())
+	}
+
+	return nil
+}
 
-	// Wait until all machine resources have been properly deleted.
-	if err := b.waitUntilMachineResourcesDeleted(machineClassPlural); err != nil {
-		return fmt.Errorf("Failed while waiting for all machine resources to be deleted: '%s'", err.Error())
+// drainMachines drains the nodes of the Shoot cluster (if its API server is reachable) before its machines are deleted.
+// Only if the drain did not finish within the configured timeout, it labels the existing machines for a forceful
+// deletion (which skips the drain performed by the machine-controller-manager).
+func (b *HybridBotanist) drainMachines(ctx context.Context) error {
+	drained := false
+	if b.K8sShootClient != nil && b.NodeDrainTimeout > 0 {
+		var err error
+		if drained, err = b.Botanist.DrainNodes(ctx, b.NodeDrainTimeout); err != nil {
+			return operationerrors.Wrapf(err, "Draining nodes failed: %s", err.Error())
+		}
 	}
 
+	if !drained {
+		return b.labelMachinesForForceDeletion(ctx)
+	}
 	return nil
 }
 
 // RefreshMachineClassSecrets updates all existing machine class secrets to reflect the latest
-// cloud provider credentials.
+// cloud provider credentials. Credentials of an external secret backend are re-issued if they are about to expire.
 func (b *HybridBotanist) RefreshMachineClassSecrets() error {
+	if err := b.ensureMachineCredentials(); err != nil {
+		return err
+	}
+
 	secretList, err := b.listMachineClassSecrets()
 	if err != nil {
 		return err
@@ -161,18 +536,97 @@ func (b *HybridBotanist) RefreshMachineClassSecrets() error {
 }
 
 // generateMachineDeploymentConfig generates the configuration values for the machine deployment Helm chart. It
-// does that based on the provided list of to-be-deployed <machineDeployments>.
-func (b *HybridBotanist) generateMachineDeploymentConfig(machineDeployments []operation.MachineDeployment, classKind string) (map[string]interface{}, error) {
-	var values = []map[string]interface{}{}
+// does that based on the provided list of to-be-deployed <machineDeployments>. The replicas of machine deployments
+// managed by the cluster-autoscaler are taken from the <existingReplicas> so that its scaling decisions are kept.
+func (b *HybridBotanist) generateMachineDeploymentConfig(machineDeployments []operation.MachineDeployment, classKind string, existingReplicas map[string]int) (map[string]interface{}, error) {
+	var (
+		values  = []map[string]interface{}{}
+		workers = map[string]gardenv1beta1.Worker{}
+	)
+	for _, worker := range b.Shoot.GetWorkers() {
+		workers[worker.Name] = worker
+	}
 
 	for _, deployment := range machineDeployments {
-		values = append(values, map[string]interface{}{
+		// The additional labels of the worker group must not overwrite the label which is used as selector.
+		metadataLabels := map[string]interface{}{}
+		for key, value := range deployment.Labels {
+			metadataLabels[key] = value
+		}
+		metadataLabels["name"] = deployment.Name
+
+		metadataAnnotations := map[string]interface{}{}
+		for key, value := range deployment.Annotations {
+			metadataAnnotations[key] = value
+		}
+
+		var (
+			maxSurge        = intstr.FromInt(1)
+			maxUnavailable  = intstr.FromInt(1)
+			minReadySeconds = int32(500)
+		)
+		if deployment.MaxSurge != nil {
+			maxSurge = *deployment.MaxSurge
+		}
+		if deployment.MaxUnavailable != nil {
+			maxUnavailable = *deployment.MaxUnavailable
+		}
+		if deployment.MinReadySeconds != nil {
+			minReadySeconds = *deployment.MinReadySeconds
+		}
+
+		// Cordoned worker groups must not get any new machines, hence, a rolling update must not surge and
+		// autoscalers are told not to scale up the machine deployment. The annotation is always set explicitly
+		// because existing annotations are preserved when the machine deployment is updated.
+		if deployment.Cordoned {
+			maxSurge = intstr.FromInt(0)
+		}
+		metadataAnnotations[common.MachineDeploymentScaleUpDisabled] = strconv.FormatBool(deployment.Cordoned)
+
+		// The time of the last requested restart is recorded so that it is kept for the names of the machine classes.
+		if restartedAt, ok := b.Shoot.MachineRestarts[deployment.WorkerName]; ok {
+			metadataAnnotations[common.MachineDeploymentRestartedAt] = restartedAt
+		}
+
+		replicas, autoscaled := b.desiredMachineDeploymentReplicas(deployment, existingReplicas)
+		metadataAnnotations[common.MachineDeploymentAutoscaled] = strconv.FormatBool(autoscaled)
+
+		// Large scale-downs are spread over several reconciliations so that they stay within the scale-down budgets.
+		if limit, ok := b.scaleDownLimits[deployment.Name]; ok && replicas < limit {
+			replicas = limit
+		}
+
+		// Hibernated Shoots do not have any machines. The replicas the machine deployment would have otherwise are
+		// recorded so that they can be restored when the Shoot is woken up.
+		if b.Shoot.Hibernated {
+			metadataAnnotations[common.MachineDeploymentHibernatedReplicas] = strconv.Itoa(replicas)
+			replicas = 0
+		}
+		metadataAnnotations[common.MachineDeploymentAutoscalerMin] = strconv.Itoa(deployment.Minimum)
+		metadataAnnotations[common.MachineDeploymentAutoscalerMax] = strconv.Itoa(deployment.Maximum)
+
+		// The cluster-autoscaler builds the node templates of machine deployments without any machines from their labels
+		// and annotations, hence, GPU machine deployments carry the labels and the taint of their nodes.
+		if deployment.GPUs > 0 {
+			for key, value := range gpuNodeLabels(deployment.GPUs) {
+				metadataLabels[key] = value
+			}
+		}
+		metadataAnnotations[common.MachineDeploymentNodeTaints] = strings.Join(nodeTemplateTaints(workers[deployment.WorkerName], deployment.GPUs), ",")
+
+		metadata := map[string]interface{}{
+			"labels":      metadataLabels,
+			"annotations": metadataAnnotations,
+		}
+
+		deploymentValues := map[string]interface{}{
 			"name":            deployment.Name,
-			"replicas":        deployment.Replicas,
-			"minReadySeconds": 500,
+			"metadata":        metadata,
+			"replicas":        replicas,
+			"minReadySeconds": minReadySeconds,
 			"rollingUpdate": map[string]interface{}{
-				"maxSurge":       1,
-				"maxUnavailable": 1,
+				"maxSurge":       intOrStringValue(maxSurge),
+				"maxUnavailable": intOrStringValue(maxUnavailable),
 			},
 			"labels": map[string]interface{}{
 				"name": deployment.Name,
@@ -181,7 +635,12 @@ func (b *HybridBotanist) generateMachineDeploymentConfig(machineDeployments []op
 				"kind": classKind,
 				"name": deployment.ClassName,
 			},
-		})
+		}
+		if configuration := machineConfiguration(workers[deployment.WorkerName]); configuration != nil {
+			deploymentValues["machineConfiguration"] = configuration
+		}
+
+		values = append(values, deploymentValues)
 	}
 
 	return map[string]interface{}{
@@ -189,125 +648,640 @@ func (b *HybridBotanist) generateMachineDeploymentConfig(machineDeployments []op
 	}, nil
 }
 
-// labelMachine labels a machine object to be forcefully deleted.
-func (b *HybridBotanist) labelMachine(obj *unstructured.Unstructured) error {
-	var (
-		labels      = obj.GetLabels()
-		machineName = obj.GetName()
-	)
+// machineConfiguration returns the machine health settings of the given <worker> in the format of the machine
+// configuration of machine deployments. The machine-controller-manager uses them instead of its own settings for the
+// machines of the machine deployment. It returns nil if the worker group does not configure any of them.
+func machineConfiguration(worker gardenv1beta1.Worker) map[string]interface{} {
+	configuration := map[string]interface{}{}
 
-	if val, ok := labels["force-deletion"]; ok && val == "True" {
+	if worker.MachineHealthTimeout != nil {
+		configuration["healthTimeout"] = worker.MachineHealthTimeout.Duration.String()
+	}
+	if worker.MaxEvictRetries != nil {
+		configuration["maxEvictRetries"] = *worker.MaxEvictRetries
+	}
+	if len(worker.UnhealthyNodeConditions) > 0 {
+		configuration["nodeConditions"] = strings.Join(worker.UnhealthyNodeConditions, ",")
+	}
+
+	if len(configuration) == 0 {
 		return nil
 	}
+	return configuration
+}
 
-	labels["force-deletion"] = "True"
-	obj.SetLabels(labels)
+// desiredMachineDeploymentReplicas returns the number of replicas of the given <deployment> and whether they are
+// managed by the cluster-autoscaler. The replicas of autoscaled machine deployments are taken from the
+// <existingReplicas>, limited to their bounds, all others run with their maximum.
+func (b *HybridBotanist) desiredMachineDeploymentReplicas(deployment operation.MachineDeployment, existingReplicas map[string]int) (int, bool) {
+	if !b.machineDeploymentAutoscaled(deployment) {
+		return deployment.Maximum, false
+	}
 
-	body, err := json.Marshal(obj.UnstructuredContent())
+	var current *int
+	if value, ok := existingReplicas[deployment.Name]; ok {
+		current = &value
+	}
+	return common.AutoscaledMachineDeploymentReplicas(current, deployment.Minimum, deployment.Maximum), true
+}
+
+// machineDeploymentAutoscaled checks whether the number of replicas of the given <deployment> is managed by the
+// cluster-autoscaler. This is the case if the addon is enabled and the bounds of the (not cordoned) worker group allow
+// scaling.
+func (b *HybridBotanist) machineDeploymentAutoscaled(deployment operation.MachineDeployment) bool {
+	return b.Shoot.ClusterAutoscalerEnabled() && !deployment.Cordoned && deployment.Minimum < deployment.Maximum
+}
+
+// machineDeploymentReplicas returns a map whose keys are the names of the existing machine deployments in the Shoot
+// namespace of the Seed and whose values are their number of replicas.
+func (b *HybridBotanist) machineDeploymentReplicas() (map[string]int, error) {
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	replicas := map[string]int{}
+	for _, deployment := range machineDeploymentList.Items {
+		replicas[deployment.Name] = int(deployment.Spec.Replicas)
+
+		// Machine deployments of hibernated Shoots have been scaled down to zero, their previous replicas are recorded.
+		if value, ok := deployment.Annotations[common.MachineDeploymentHibernatedReplicas]; ok {
+			if hibernatedReplicas, err := strconv.Atoi(value); err == nil {
+				replicas[deployment.Name] = hibernatedReplicas
+			}
+		}
+	}
+	return replicas, nil
+}
+
+// removeHibernatedReplicas removes the annotation holding the replicas recorded during a hibernation from all existing
+// machine deployments. The annotation must be removed explicitly because existing annotations are preserved when the
+// machine deployments are updated. It is removed with a patch so that fields which are not known to the machine API
+// types of the Gardener (e.g., the machine configuration) are kept.
+func (b *HybridBotanist) removeHibernatedReplicas() error {
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, deployment := range machineDeploymentList.Items {
+		if _, ok := deployment.Annotations[common.MachineDeploymentHibernatedReplicas]; !ok {
+			continue
+		}
+
+		body := fmt.Sprintf(`{"metadata":{"annotations":{%q:null}}}`, common.MachineDeploymentHibernatedReplicas)
+		if _, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).Patch(deployment.Name, types.MergePatchType, []byte(body)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// intOrStringValue returns the integer or the string (e.g. a percentage) held by the given <value> so that it is
+// rendered correctly into the chart values.
+func intOrStringValue(value intstr.IntOrString) interface{} {
+	if value.Type == intstr.Int {
+		return value.IntVal
+	}
+	return value.StrVal
+}
+
+// labelMachinesForForceDeletion labels all existing machines with <force-deletion=True> which makes the
+// machine-controller-manager delete them without draining their nodes. The machines are labeled by a pool of at most
+// MachineForceDeletionConcurrency workers, and the errors of all machines are collected. No further machines are
+// labeled once the given <ctx> has been canceled.
+func (b *HybridBotanist) labelMachinesForForceDeletion(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
 	if err != nil {
-		return fmt.Errorf("Marshalling machine %s object failed: %s", machineName, err.Error())
+		return err
+	}
+
+	var (
+		machineNames = make(chan string)
+		errorList    []error
+		mutex        sync.Mutex
+		wg           sync.WaitGroup
+		workers      = b.MachineForceDeletionConcurrency
+	)
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(machineList.Items) {
+		workers = len(machineList.Items)
+	}
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range machineNames {
+				if err := b.labelMachine(name); err != nil {
+					mutex.Lock()
+					errorList = append(errorList, err)
+					mutex.Unlock()
+				}
+			}
+		}()
 	}
 
-	return b.K8sSeedClient.MachineV1alpha1("PUT", "machines", b.Shoot.SeedNamespace).Name(machineName).Body(body).Do().Error()
+	for _, machine := range machineList.Items {
+		if val, ok := machine.Labels["force-deletion"]; ok && val == "True" {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		machineNames <- machine.Name
+	}
+	close(machineNames)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(errorList) > 0 {
+		return fmt.Errorf("Labelling machines failed: %v", errorList)
+	}
+	return nil
+}
+
+// forceDeletionPatch is the merge patch which labels a machine to be forcefully deleted.
+const forceDeletionPatch = `{"metadata":{"labels":{"force-deletion":"True"}}}`
+
+// labelMachine labels the machine with the given <name> to be forcefully deleted. The label is added with a merge
+// patch, so that the machine does not need to be sent completely and the status updates of the
+// machine-controller-manager do not cause conflicts. The patch is retried if the API server reports a conflict anyway.
+// Machines which have been deleted in the meantime are ignored.
+func (b *HybridBotanist) labelMachine(name string) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).Patch(name, types.MergePatchType, []byte(forceDeletionPatch))
+		return err
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
 }
 
-// waitUntilMachineDeploymentsAvailable waits for a maximum of 30 minutes until all the desired <machineDeployments>
-// were marked as healthy/available by the machine-controller-manager. It polls the status every 10 seconds.
-func (b *HybridBotanist) waitUntilMachineDeploymentsAvailable(machineDeployments []operation.MachineDeployment) error {
+// waitUntilMachineDeploymentsAvailable waits until all the desired <machineDeployments> were rolled out completely
+// and marked as healthy/available by the machine-controller-manager. A machine deployment is rolled out once all of
+// its machines use the current machine class (e.g., after the machine type of the worker group has changed) and no
+// surplus machines are left. The condition is evaluated whenever a machine deployment changes, and the progress is
+// reported to the MachineRolloutReporter whenever it changes. It returns the error of the given <ctx> once it has been
+// canceled.
+func (b *HybridBotanist) waitUntilMachineDeploymentsAvailable(ctx context.Context, machineDeployments []operation.MachineDeployment) error {
 	var (
-		numReady   int64
-		numDesired int64
+		lastMessage string
+		lastStatus  *gardenv1beta1.ShootMachinesStatus
+		start       = time.Now()
+		rolledOut   = sets.NewString()
+		provisioned = sets.NewString()
+		progress    = map[string]machineDeploymentProgress{}
 	)
-	return wait.Poll(5*time.Second, 1800*time.Second, func() (bool, error) {
-		numReady, numDesired = 0, 0
-		var machineDeploymentList unstructured.Unstructured
 
-		if err := b.K8sSeedClient.MachineV1alpha1("GET", "machinedeployments", b.Shoot.SeedNamespace).Do().Into(&machineDeploymentList); err != nil {
+	err := b.waitForMachineResources(ctx, func(listers map[string]cache.GenericLister) (bool, error) {
+		var numReady, numUpdated, numDesired int32
+
+		objects, err := listers["machinedeployments"].List(labels.Everything())
+		if err != nil {
 			return false, err
 		}
 
-		if err := machineDeploymentList.EachListItem(func(o runtime.Object) error {
-			for _, machineDeployment := range machineDeployments {
-				var (
-					obj                             = o.(*unstructured.Unstructured)
-					deploymentName                  = obj.GetName()
-					deploymentDesiredReplicas, _, _ = unstructured.NestedInt64(obj.UnstructuredContent(), "spec", "replicas")
-					deploymentReadyReplicas, _, _   = unstructured.NestedInt64(obj.UnstructuredContent(), "status", "readyReplicas")
-				)
-
-				if machineDeployment.Name == deploymentName {
-					numDesired += deploymentDesiredReplicas
-					numReady += deploymentReadyReplicas
-				}
+		deployments := make([]*machinev1alpha1.MachineDeployment, 0, len(objects))
+		for _, object := range objects {
+			if deployment, ok := object.(*machinev1alpha1.MachineDeployment); ok {
+				deployments = append(deployments, deployment)
 			}
-			return nil
-		}); err != nil {
+		}
+
+		b.observeMachineDeploymentsRolledOut(deployments, machineDeployments, rolledOut, start)
+		if err := b.observeMachinesProvisioned(listers["machines"], machineDeployments, provisioned, start); err != nil {
 			return false, err
 		}
 
-		b.Logger.Infof("Waiting until all machines are healthy/ready (%d/%d OK)...", numReady, numDesired)
-		if numReady >= numDesired {
+		status := computeMachinesStatus(deployments, machineDeployments)
+		if lastStatus == nil || !machinesStatusEqual(lastStatus, status) {
+			b.reportMachineRollout(status)
+			lastStatus = status
+		}
+
+		if status.Phase == gardenv1beta1.MachineRolloutPhaseAvailable {
 			return true, nil
 		}
+
+		if timeout := b.MachineDeploymentProgressTimeout; timeout > 0 {
+			if stuck := stuckMachineDeployments(deployments, machineDeployments, progress, time.Now(), timeout); len(stuck) > 0 {
+				return false, &machineDeploymentsStuckError{names: stuck, timeout: timeout}
+			}
+		}
+
+		for _, deployment := range status.Deployments {
+			numDesired += deployment.Desired
+			numReady += deployment.Ready
+			numUpdated += deployment.Updated
+		}
+		if msg := fmt.Sprintf("%d/%d updated, %d/%d ready", numUpdated, numDesired, numReady, numDesired); msg != lastMessage {
+			b.Logger.Infof("Waiting until all machines are updated and healthy/ready (%s)...", msg)
+			lastMessage = msg
+		}
 		return false, nil
-	})
+	}, "machinedeployments", "machines")
+
+	_, stuck := err.(*machineDeploymentsStuckError)
+	if err == wait.ErrWaitTimeout || stuck {
+		b.countMachineWaitTimeout(machineWaitRollout)
+	}
+	if (err == wait.ErrWaitTimeout || stuck) && lastStatus != nil {
+		status := lastStatus.DeepCopy()
+		status.Phase = gardenv1beta1.MachineRolloutPhaseFailed
+		b.reportMachineRollout(status)
+	}
+	return err
 }
 
-// waitUntilMachineResourcesDeleted waits for a maximum of 30 minutes until all machine resoures have been properly
-// deleted by the machine-controller-manager. It polls the status every 10 seconds.
-func (b *HybridBotanist) waitUntilMachineResourcesDeleted(classKind string) error {
+// observeMachineDeploymentsRolledOut records the time since <start> for every desired machine deployment which has
+// been rolled out completely and is not contained in <rolledOut> yet, and adds it to <rolledOut>.
+func (b *HybridBotanist) observeMachineDeploymentsRolledOut(deployments []*machinev1alpha1.MachineDeployment, machineDeployments []operation.MachineDeployment, rolledOut sets.String, start time.Time) {
+	existing := make(map[string]*machinev1alpha1.MachineDeployment, len(deployments))
+	for _, deployment := range deployments {
+		existing[deployment.Name] = deployment
+	}
+
+	for _, machineDeployment := range machineDeployments {
+		deployment, ok := existing[machineDeployment.Name]
+		if !ok || rolledOut.Has(machineDeployment.Name) || !machineDeploymentRolledOut(deployment) {
+			continue
+		}
+		rolledOut.Insert(machineDeployment.Name)
+		b.observeMachineDeploymentReady(machineDeployment.WorkerName, start)
+	}
+}
+
+// observeMachinesProvisioned records the provisioning duration of every machine of the desired <machineDeployments>
+// which has become running since <start> and is not contained in <provisioned> yet, and adds it to <provisioned>.
+func (b *HybridBotanist) observeMachinesProvisioned(lister cache.GenericLister, machineDeployments []operation.MachineDeployment, provisioned sets.String, start time.Time) error {
+	objects, err := lister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	machines := make([]*machinev1alpha1.Machine, 0, len(objects))
+	for _, object := range objects {
+		if machine, ok := object.(*machinev1alpha1.Machine); ok {
+			machines = append(machines, machine)
+		}
+	}
+
 	var (
-		resources         = []string{classKind, "machinedeployments", "machinesets", "machines"}
-		numberOfResources = map[string]int{}
+		workers      = map[string]gardenv1beta1.Worker{}
+		machineTypes = make(map[string]string, len(machineDeployments))
 	)
+	for _, worker := range b.Shoot.GetWorkers() {
+		workers[worker.Name] = worker
+	}
+	for _, deployment := range machineDeployments {
+		machineTypes[deployment.Name] = workers[deployment.WorkerName].MachineType
+	}
 
-	for _, resource := range resources {
-		numberOfResources[resource] = -1
+	for _, machine := range machinesProvisionedSince(machines, provisioned, start) {
+		if machineType, ok := machineTypes[machine.deploymentName]; ok {
+			b.observeMachineProvisioned(machineType, machine.duration)
+		}
 	}
+	return nil
+}
 
-	return wait.Poll(5*time.Second, 1800*time.Second, func() (bool, error) {
-		for _, resource := range resources {
-			if numberOfResources[resource] == 0 {
+// machineProvisioning is the time a machine of a machine deployment took from its creation until it was running.
+type machineProvisioning struct {
+	deploymentName string
+	duration       time.Duration
+}
+
+// machinesProvisionedSince returns the provisioning durations of the given <machines> which have become running since
+// <since> and are not contained in <provisioned> yet, and adds them to <provisioned>. The machine deployment of a
+// machine is determined by its name label.
+func machinesProvisionedSince(machines []*machinev1alpha1.Machine, provisioned sets.String, since time.Time) []machineProvisioning {
+	var result []machineProvisioning
+	for _, machine := range machines {
+		status := machine.Status.CurrentStatus
+		if status.Phase != machinev1alpha1.MachineRunning || status.LastUpdateTime.Time.Before(since) || provisioned.Has(machine.Name) {
+			continue
+		}
+		provisioned.Insert(machine.Name)
+
+		if duration := status.LastUpdateTime.Sub(machine.CreationTimestamp.Time); duration >= 0 {
+			result = append(result, machineProvisioning{deploymentName: machine.Labels["name"], duration: duration})
+		}
+	}
+	return result
+}
+
+// machineDeploymentProgress is the rollout state of a machine deployment and the time since when it has not changed.
+type machineDeploymentProgress struct {
+	status machinev1alpha1.MachineDeploymentStatus
+	spec   int32
+	since  time.Time
+}
+
+// machineDeploymentsStuckError is returned while waiting for the machine deployments if some of them did not make any
+// progress within the machine deployment progress timeout.
+type machineDeploymentsStuckError struct {
+	names   []string
+	timeout time.Duration
+}
+
+func (e *machineDeploymentsStuckError) Error() string {
+	return fmt.Sprintf("machine deployments did not make any progress for %s: %s", e.timeout, strings.Join(e.names, ", "))
+}
+
+// stuckMachineDeployments returns the names of the desired <machineDeployments> which have not been rolled out and
+// whose replicas (desired, current, updated, ready and available) have not changed for longer than <timeout>. The
+// last change of every machine deployment is tracked in <progress>, which is updated with the given <deployments>.
+func stuckMachineDeployments(deployments []*machinev1alpha1.MachineDeployment, machineDeployments []operation.MachineDeployment, progress map[string]machineDeploymentProgress, now time.Time, timeout time.Duration) []string {
+	existing := make(map[string]*machinev1alpha1.MachineDeployment, len(deployments))
+	for _, deployment := range deployments {
+		existing[deployment.Name] = deployment
+	}
+
+	var stuck []string
+	for _, machineDeployment := range machineDeployments {
+		current := machineDeploymentProgress{since: now}
+		if deployment, ok := existing[machineDeployment.Name]; ok {
+			if machineDeploymentRolledOut(deployment) {
 				continue
 			}
-
-			var list unstructured.Unstructured
-			if err := b.K8sSeedClient.MachineV1alpha1("GET", resource, b.Shoot.SeedNamespace).Do().Into(&list); err != nil {
-				return false, err
+			current.spec = deployment.Spec.Replicas
+			current.status = machinev1alpha1.MachineDeploymentStatus{
+				Replicas:          deployment.Status.Replicas,
+				UpdatedReplicas:   deployment.Status.UpdatedReplicas,
+				ReadyReplicas:     deployment.Status.ReadyReplicas,
+				AvailableReplicas: deployment.Status.AvailableReplicas,
 			}
+		}
 
-			if field, ok := list.Object["items"]; ok {
-				if items, ok := field.([]interface{}); ok {
-					numberOfResources[resource] = len(items)
-				}
+		last, ok := progress[machineDeployment.Name]
+		if !ok || last.spec != current.spec || !apiequality.Semantic.DeepEqual(last.status, current.status) {
+			progress[machineDeployment.Name] = current
+			continue
+		}
+		if now.Sub(last.since) > timeout {
+			stuck = append(stuck, machineDeployment.Name)
+		}
+	}
+	return stuck
+}
+
+// computeMachinesStatus computes the progress of the rollout of the desired <machineDeployments> based on the
+// existing <deployments>. The phase is Available once all of them have been rolled out completely, i.e. all of their
+// machines are ready and use the current machine class, and no surplus machines are left. Errors reported by the
+// machine-controller-manager via the ReplicaFailure condition are taken over.
+func computeMachinesStatus(deployments []*machinev1alpha1.MachineDeployment, machineDeployments []operation.MachineDeployment) *gardenv1beta1.ShootMachinesStatus {
+	status := &gardenv1beta1.ShootMachinesStatus{
+		Phase:       gardenv1beta1.MachineRolloutPhaseAvailable,
+		Deployments: []gardenv1beta1.ShootMachineDeploymentStatus{},
+	}
+
+	existing := make(map[string]*machinev1alpha1.MachineDeployment, len(deployments))
+	for _, deployment := range deployments {
+		existing[deployment.Name] = deployment
+	}
+
+	for _, machineDeployment := range machineDeployments {
+		deployment, ok := existing[machineDeployment.Name]
+		if !ok {
+			status.Phase = gardenv1beta1.MachineRolloutPhaseProgressing
+			continue
+		}
+
+		deploymentStatus := gardenv1beta1.ShootMachineDeploymentStatus{
+			Name:        deployment.Name,
+			Desired:     deployment.Spec.Replicas,
+			Ready:       deployment.Status.ReadyReplicas,
+			Updated:     deployment.Status.UpdatedReplicas,
+			Unavailable: deployment.Status.UnavailableReplicas,
+		}
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type == machinev1alpha1.MachineDeploymentReplicaFailure && condition.Status == machinev1alpha1.ConditionTrue {
+				deploymentStatus.LastError = condition.Message
 			}
 		}
+		if len(deploymentStatus.LastError) > 0 && len(status.LastError) == 0 {
+			status.LastError = fmt.Sprintf("%s: %s", deployment.Name, deploymentStatus.LastError)
+		}
+
+		if !machineDeploymentRolledOut(deployment) {
+			status.Phase = gardenv1beta1.MachineRolloutPhaseProgressing
+		}
+
+		status.Deployments = append(status.Deployments, deploymentStatus)
+	}
+
+	sort.Slice(status.Deployments, func(i, j int) bool { return status.Deployments[i].Name < status.Deployments[j].Name })
+	return status
+}
+
+// machineDeploymentRolledOut checks whether the given <deployment> has been rolled out completely, i.e. whether all of
+// its machines are ready and use the current machine class, and no surplus machines are left.
+func machineDeploymentRolledOut(deployment *machinev1alpha1.MachineDeployment) bool {
+	outdated := deployment.Status.ObservedGeneration < deployment.Generation || deployment.Status.Replicas > deployment.Spec.Replicas
+	return !outdated && deployment.Status.ReadyReplicas >= deployment.Spec.Replicas && deployment.Status.UpdatedReplicas >= deployment.Spec.Replicas
+}
+
+// machinesStatusEqual returns true if both given rollout progresses are equal apart from their update time.
+func machinesStatusEqual(a, b *gardenv1beta1.ShootMachinesStatus) bool {
+	a, b = a.DeepCopy(), b.DeepCopy()
+	a.LastUpdateTime, b.LastUpdateTime = metav1.Time{}, metav1.Time{}
+	return apiequality.Semantic.DeepEqual(a, b)
+}
+
+// reportMachineRollout sets the update time and the pending machine replacements of the given rollout progress <status>
+// and passes it to the MachineRolloutReporter (if any).
+func (b *HybridBotanist) reportMachineRollout(status *gardenv1beta1.ShootMachinesStatus) {
+	if b.MachineRolloutReporter == nil {
+		return
+	}
+	status.PendingReplacements = b.pendingReplacements
+	status.Canaries = b.canaries
+	status.LastUpdateTime = metav1.Now()
+	b.MachineRolloutReporter(status)
+}
+
+// waitUntilMachineResourcesDeleted waits until all machine resources of the given kinds <resources> have been
+// properly deleted by the machine-controller-manager. The condition is evaluated whenever one of them changes. It
+// returns the error of the given <ctx> once it has been canceled.
+func (b *HybridBotanist) waitUntilMachineResourcesDeleted(ctx context.Context, resources ...string) error {
+	var lastMessage string
 
+	err := b.waitForMachineResources(ctx, func(listers map[string]cache.GenericLister) (bool, error) {
 		msg := ""
-		for resource, count := range numberOfResources {
-			if numberOfResources[resource] != 0 {
-				msg += fmt.Sprintf("%d %s, ", count, resource)
+		for _, resource := range resources {
+			objects, err := listers[resource].List(labels.Everything())
+			if err != nil {
+				return false, err
+			}
+			if len(objects) > 0 {
+				msg += fmt.Sprintf("%d %s, ", len(objects), resource)
 			}
 		}
 
-		if msg != "" {
+		if msg == "" {
+			return true, nil
+		}
+
+		if msg != lastMessage {
 			b.Logger.Infof("Waiting until the following machine resources have been deleted: %s", strings.TrimSuffix(msg, ", "))
-			return false, nil
+			lastMessage = msg
 		}
-		return true, nil
-	})
+		return false, nil
+	}, resources...)
+
+	if err == wait.ErrWaitTimeout {
+		b.countMachineWaitTimeout(machineWaitDeletion)
+	}
+	return err
+}
+
+// waitForMachineResources starts shared informers for the machine resources of the given kinds <resources> in the
+// Shoot namespace of the Seed and evaluates the <condition> whenever one of them is added, updated or deleted. It
+// returns once the condition is met, or with an error once the machine wait timeout has expired or the given <ctx> has
+// been canceled. Compared to polling, the Seed's API server is only requested to list and watch every kind once.
+func (b *HybridBotanist) waitForMachineResources(ctx context.Context, condition func(map[string]cache.GenericLister) (bool, error), resources ...string) error {
+	var (
+		factory = machineinformers.NewFilteredSharedInformerFactory(b.K8sSeedClient.MachineClientset(), 0, b.Shoot.SeedNamespace, nil)
+		listers = make(map[string]cache.GenericLister, len(resources))
+		events  = make(chan struct{}, 1)
+		notify  = func() {
+			select {
+			case events <- struct{}{}:
+			default:
+			}
+		}
+		stopCh          = make(chan struct{})
+		minPollInterval = b.machineWaitPollInterval()
+		pollInterval    = minPollInterval
+		pollTimer       = time.NewTimer(wait.Jitter(pollInterval, machineWaitPollJitter))
+	)
+	defer close(stopCh)
+	defer pollTimer.Stop()
+
+	waitCtx, cancel := context.WithTimeout(ctx, b.machineWaitTimeout())
+	defer cancel()
+
+	for _, resource := range resources {
+		informer, err := factory.ForResource(machinev1alpha1.SchemeGroupVersion.WithResource(resource))
+		if err != nil {
+			return err
+		}
+		informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    func(interface{}) { notify() },
+			UpdateFunc: func(interface{}, interface{}) { notify() },
+			DeleteFunc: func(interface{}) { notify() },
+		})
+		listers[resource] = informer.Lister()
+	}
+
+	factory.Start(stopCh)
+	for _, synced := range factory.WaitForCacheSync(waitCtx.Done()) {
+		if !synced {
+			return machineWaitError(ctx)
+		}
+	}
+
+	for {
+		done, err := condition(listers)
+		if err != nil || done {
+			return err
+		}
+
+		// The condition is also re-evaluated periodically in case no events are received, e.g. to detect machine
+		// deployments which do not make progress. The period is backed off exponentially (with jitter) while nothing
+		// changes, and it is reset once the machine resources change again.
+		select {
+		case <-events:
+			pollInterval = minPollInterval
+		case <-pollTimer.C:
+			pollInterval = nextMachineWaitPollInterval(pollInterval, minPollInterval)
+		case <-waitCtx.Done():
+			return machineWaitError(ctx)
+		}
+		if !pollTimer.Stop() {
+			select {
+			case <-pollTimer.C:
+			default:
+			}
+		}
+		pollTimer.Reset(wait.Jitter(pollInterval, machineWaitPollJitter))
+	}
+}
+
+// machineWaitError returns the error of the given <ctx> if it has been canceled, and wait.ErrWaitTimeout otherwise
+// (i.e., if the machine wait timeout has expired).
+func machineWaitError(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return wait.ErrWaitTimeout
+}
+
+// nextMachineWaitPollInterval doubles the given poll <interval> up to the maximum poll interval, or up to
+// <minInterval> if that is larger.
+func nextMachineWaitPollInterval(interval, minInterval time.Duration) time.Duration {
+	maxInterval := maxMachineWaitPollInterval
+	if minInterval > maxInterval {
+		maxInterval = minInterval
+	}
+	if interval *= 2; interval > maxInterval {
+		return maxInterval
+	}
+	return interval
+}
+
+// machineWaitTimeout returns the maximum duration to wait for the machine resources of the Shoot.
+func (b *HybridBotanist) machineWaitTimeout() time.Duration {
+	if b.MachineWaitTimeout > 0 {
+		return b.MachineWaitTimeout
+	}
+	return 30 * time.Minute
+}
+
+// machineWaitPollInterval returns the initial interval in which the machine resources of the Shoot are re-checked
+// if they do not change.
+func (b *HybridBotanist) machineWaitPollInterval() time.Duration {
+	if b.MachineWaitPollInterval > 0 {
+		return b.MachineWaitPollInterval
+	}
+	return 5 * time.Second
 }
 
 // cleanupMachineClasses deletes all machine classes which are not part of the provided list <machineDeployments>.
-// It also computes a list of used secrets which contain the credentials and the cloud configuration. The list is
-// returned in order that its items can be deleted by the HelperBotanist.
-func (b *HybridBotanist) cleanupMachineClasses(machineClassPlural string, machineDeployments []operation.MachineDeployment) (sets.String, error) {
+// Machine classes which are still used by existing machines or machine sets (e.g., during a rolling update) are kept
+// because the machine-controller-manager requires them to create or delete the machines. The deleted machine classes are recorded in the
+// machine history with the given <reason> beforehand, and the finalizer of the Gardener is removed from them. It also
+// computes a list of used secrets which contain the credentials and the cloud configuration. The list is returned in
+// order that its items can be deleted by the HelperBotanist. No further machine classes are deleted once the given
+// <ctx> has been canceled.
+func (b *HybridBotanist) cleanupMachineClasses(ctx context.Context, machineClassPlural string, machineDeployments []operation.MachineDeployment, reason string) (sets.String, error) {
 	var (
 		machineClassList unstructured.Unstructured
 		usedSecrets      = sets.NewString()
+		obsoleteClasses  []*unstructured.Unstructured
+		records          []machineHistoryRecord
 	)
 
-	if err := b.K8sSeedClient.MachineV1alpha1("GET", machineClassPlural, b.Shoot.SeedNamespace).Do().Into(&machineClassList); err != nil {
+	machineSetList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineSets(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	usedClasses := machineClassesInUse(machineSetList.Items, machineList.Items)
+
+	if err := b.K8sSeedClient.MachineV1alpha1("GET", machineClassPlural, b.Shoot.SeedNamespace).Context(ctx).Do().Into(&machineClassList); err != nil {
 		return nil, err
 	}
 
@@ -323,37 +1297,104 @@ func (b *HybridBotanist) cleanupMachineClasses(machineClassPlural string, machin
 		}
 
 		usedSecrets.Insert(secretRefName)
-		if !operation.ClassContainedInMachineDeploymentList(className, machineDeployments) {
-			return b.K8sSeedClient.MachineV1alpha1("DELETE", machineClassPlural, b.Shoot.SeedNamespace).Name(className).Do().Error()
+		if !operation.ClassContainedInMachineDeploymentList(className, machineDeployments) && !usedClasses.Has(className) {
+			obsoleteClasses = append(obsoleteClasses, obj)
+			records = append(records, machineHistoryRecord{
+				Kind:      obj.GetKind(),
+				Name:      className,
+				Reason:    reason,
+				Timestamp: metav1.Now(),
+			})
 		}
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
+	if err := b.recordMachineHistory(records); err != nil {
+		return nil, fmt.Errorf("Failed to record the machine history: '%s'", err.Error())
+	}
+	for _, obj := range obsoleteClasses {
+		if err := b.removeMachineClassFinalizer(ctx, machineClassPlural, obj); err != nil {
+			return nil, err
+		}
+		if err := b.K8sSeedClient.MachineV1alpha1("DELETE", machineClassPlural, b.Shoot.SeedNamespace).Name(obj.GetName()).Context(ctx).Do().Error(); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	b.countMachineResourcesCleanedUp("machineclass", len(obsoleteClasses))
+
 	return usedSecrets, nil
 }
 
-// cleanupMachineDeployments deletes all machine deployments which are not part of the provided list
-// <machineDeployments>.
-func (b *HybridBotanist) cleanupMachineDeployments(machineDeployments []operation.MachineDeployment) error {
-	var machineDeploymentList unstructured.Unstructured
+// machineClassesInUse returns the names of the machine classes which are referenced by the given <machines> or by the
+// given <machineSets> which still have or should have machines. During a rolling update, the old machine sets keep
+// their machine class until they have been scaled down completely, hence, it must not be deleted before.
+func machineClassesInUse(machineSets []machinev1alpha1.MachineSet, machines []machinev1alpha1.Machine) sets.String {
+	usedClasses := sets.NewString()
 
-	if err := b.K8sSeedClient.MachineV1alpha1("GET", "machinedeployments", b.Shoot.SeedNamespace).Do().Into(&machineDeploymentList); err != nil {
+	for _, machineSet := range machineSets {
+		if machineSet.Spec.Replicas == 0 && machineSet.Status.Replicas == 0 {
+			continue
+		}
+		if name := machineSet.Spec.Template.Spec.Class.Name; len(name) > 0 {
+			usedClasses.Insert(name)
+		}
+	}
+	for _, machine := range machines {
+		if len(machine.Spec.Class.Name) > 0 {
+			usedClasses.Insert(machine.Spec.Class.Name)
+		}
+	}
+
+	return usedClasses
+}
+
+// cleanupMachineDeployments deletes all machine deployments which are not part of the provided list
+// <machineDeployments>. The deleted machine deployments are recorded in the machine history with the given <reason>
+// beforehand. No further machine deployments are deleted once the given <ctx> has been canceled.
+func (b *HybridBotanist) cleanupMachineDeployments(ctx context.Context, machineDeployments []operation.MachineDeployment, reason string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
 		return err
 	}
 
-	return machineDeploymentList.EachListItem(func(o runtime.Object) error {
-		var (
-			obj                    = o.(*unstructured.Unstructured)
-			existingDeploymentName = obj.GetName()
-		)
+	var (
+		obsoleteDeployments []string
+		records             []machineHistoryRecord
+	)
 
-		if !operation.NameContainedInMachineDeploymentList(existingDeploymentName, machineDeployments) {
-			return b.K8sSeedClient.MachineV1alpha1("DELETE", "machinedeployments", b.Shoot.SeedNamespace).Name(existingDeploymentName).Do().Error()
+	for _, deployment := range machineDeploymentList.Items {
+		if !operation.NameContainedInMachineDeploymentList(deployment.Name, machineDeployments) {
+			replicas := deployment.Spec.Replicas
+			obsoleteDeployments = append(obsoleteDeployments, deployment.Name)
+			records = append(records, machineHistoryRecord{
+				Kind:      "MachineDeployment",
+				Name:      deployment.Name,
+				Class:     deployment.Spec.Template.Spec.Class.Name,
+				Replicas:  &replicas,
+				Reason:    reason,
+				Timestamp: metav1.Now(),
+			})
 		}
-		return nil
-	})
+	}
+
+	if err := b.recordMachineHistory(records); err != nil {
+		return fmt.Errorf("Failed to record the machine history: '%s'", err.Error())
+	}
+	for _, name := range obsoleteDeployments {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).Delete(name, nil); err != nil {
+			return err
+		}
+	}
+	b.countMachineResourcesCleanedUp("machinedeployment", len(obsoleteDeployments))
+	return nil
 }
 
 func (b *HybridBotanist) listMachineClassSecrets() (*corev1.SecretList, error) {
@@ -363,21 +1404,27 @@ func (b *HybridBotanist) listMachineClassSecrets() (*corev1.SecretList, error) {
 }
 
 // cleanupMachineClassSecrets deletes all unused machine class secrets (i.e., those which are not part
-// of the provided list <usedSecrets>.
-func (b *HybridBotanist) cleanupMachineClassSecrets(usedSecrets sets.String) error {
+// of the provided list <usedSecrets>. No further secrets are deleted once the given <ctx> has been canceled.
+func (b *HybridBotanist) cleanupMachineClassSecrets(ctx context.Context, usedSecrets sets.String) error {
 	secretList, err := b.listMachineClassSecrets()
 	if err != nil {
 		return err
 	}
 
 	// Cleanup all secrets which were used for machine classes that do not exist anymore.
+	deleted := 0
 	for _, secret := range secretList.Items {
 		if !usedSecrets.Has(secret.Name) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
 			if err := b.K8sSeedClient.DeleteSecret(secret.Namespace, secret.Name); err != nil {
 				return err
 			}
+			deleted++
 		}
 	}
+	b.countMachineResourcesCleanedUp("secret", deleted)
 
 	return nil
 }
@@ -0,0 +1,295 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultCanarySoakPeriod is the duration for which the nodes of the canary machines of a worker group must be ready
+// and healthy if the worker group does not configure a soak period.
+const defaultCanarySoakPeriod = 10 * time.Minute
+
+// canaryNodePressureConditions are the conditions of nodes which indicate that a canary node is unhealthy if they are
+// true.
+var canaryNodePressureConditions = []corev1.NodeConditionType{
+	corev1.NodeMemoryPressure,
+	corev1.NodeDiskPressure,
+	corev1.NodePIDPressure,
+	corev1.NodeNetworkUnavailable,
+}
+
+// applyCanaryRollouts returns the given <machineDeployments> where the machine deployments of worker groups with
+// canary rollouts keep their current machine classes while the changed machine classes are tried on canary machines,
+// together with the machine deployments of the canary machines. The latter are also returned separately because they
+// must not be cleaned up. The progress of the canary rollouts is remembered for the reported rollout progress.
+func (b *HybridBotanist) applyCanaryRollouts(machineDeployments []operation.MachineDeployment) ([]operation.MachineDeployment, []operation.MachineDeployment, error) {
+	b.canaries = nil
+	if b.Shoot.Hibernated {
+		return machineDeployments, nil, nil
+	}
+
+	canary := false
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.Canary != nil {
+			canary = true
+			break
+		}
+	}
+	if !canary {
+		return machineDeployments, nil, nil
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	podList, err := b.K8sShootClient.Clientset().CoreV1().Pods(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result, canaryDeployments, canaries := canaryMachineDeployments(b.Shoot.SeedNamespace, machineDeployments, b.Shoot.GetWorkers(), machineDeploymentList.Items, machineList.Items, nodeList.Items, podList.Items, time.Now())
+	for _, canary := range canaries {
+		switch canary.Phase {
+		case gardenv1beta1.MachineCanaryPhaseHalted:
+			b.Logger.Infof("The canary rollout of worker group %s has been halted: %s", canary.WorkerName, canary.Reason)
+		case gardenv1beta1.MachineCanaryPhaseSoaking:
+			if canary.SoakEndTime != nil {
+				b.Logger.Infof("The canary machines of worker group %s are soaking until %s", canary.WorkerName, canary.SoakEndTime.UTC().Format(time.RFC3339))
+			} else {
+				b.Logger.Infof("Rolling out the canary machines of worker group %s", canary.WorkerName)
+			}
+		}
+	}
+	b.canaries = canaries
+	return result, canaryDeployments, nil
+}
+
+// haltedCanaryRolloutsError returns an error with the reasons of the canary rollouts which have been halted by the
+// last DeployMachines call, or nil if there are none. The rollouts are not retried before the machine classes of the
+// worker groups change again, hence, the error is a configuration error.
+func (b *HybridBotanist) haltedCanaryRolloutsError() error {
+	var messages []string
+	for _, canary := range b.canaries {
+		if canary.Phase == gardenv1beta1.MachineCanaryPhaseHalted {
+			messages = append(messages, fmt.Sprintf("worker group %s: '%s'", canary.WorkerName, canary.Reason))
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return operationerrors.Errorf(operationerrors.ClassConfiguration, "The canary rollout of %d worker group(s) has been halted: %s", len(messages), strings.Join(messages, ", "))
+}
+
+// canaryMachineDeployments returns the given <machineDeployments> where the machine deployments of worker groups
+// with canary rollouts (see <workers>) whose machines would be replaced (i.e., which use another machine class than
+// the corresponding ones of the <existingDeployments> while those still have machines) keep their current machine
+// classes, and the machine deployments of the canary machines of these worker groups with the changed machine
+// classes. Once all canary machines have been healthy for the soak period before <now>, the machine deployments of
+// the worker group are returned unchanged and its canary machine deployment is not returned anymore. A change to or
+// from the fallback class of a spot worker group is not rolled out to canary machines. It also returns the progress
+// of the canary rollouts which are soaking or have been halted, ordered by the names of the worker groups.
+func canaryMachineDeployments(technicalID string, machineDeployments []operation.MachineDeployment, workers []gardenv1beta1.Worker, existingDeployments []machinev1alpha1.MachineDeployment, machines []machinev1alpha1.Machine, nodes []corev1.Node, pods []corev1.Pod, now time.Time) ([]operation.MachineDeployment, []operation.MachineDeployment, []gardenv1beta1.ShootMachineCanaryStatus) {
+	var (
+		existing          = make(map[string]*machinev1alpha1.MachineDeployment, len(existingDeployments))
+		replacing         = map[string]operation.MachineDeployment{}
+		currentClasses    = map[string]string{}
+		canaryDeployments []operation.MachineDeployment
+		canaries          []gardenv1beta1.ShootMachineCanaryStatus
+		result            = make([]operation.MachineDeployment, 0, len(machineDeployments))
+	)
+
+	for i := range existingDeployments {
+		existing[existingDeployments[i].Name] = &existingDeployments[i]
+	}
+	for _, deployment := range machineDeployments {
+		if existingDeployment, ok := existing[deployment.Name]; ok && existingDeployment.Spec.Replicas > 0 {
+			currentClass := existingDeployment.Spec.Template.Spec.Class.Name
+			if currentClass != deployment.ClassName && currentClass != deployment.FallbackClassName {
+				currentClasses[deployment.Name] = currentClass
+				if _, ok := replacing[deployment.WorkerName]; !ok {
+					replacing[deployment.WorkerName] = deployment
+				}
+			}
+		}
+	}
+
+	held := map[string]bool{}
+	for _, worker := range workers {
+		deployment, ok := replacing[worker.Name]
+		if worker.Canary == nil || !ok {
+			continue
+		}
+
+		soakPeriod := defaultCanarySoakPeriod
+		if worker.Canary.SoakPeriod != nil {
+			soakPeriod = worker.Canary.SoakPeriod.Duration
+		}
+
+		canaryDeployment := operation.MachineDeployment{
+			Name:            common.CanaryMachineDeploymentName(technicalID, worker.Name),
+			WorkerName:      worker.Name,
+			ClassName:       deployment.ClassName,
+			Minimum:         worker.Canary.Machines,
+			Maximum:         worker.Canary.Machines,
+			Labels:          deployment.Labels,
+			MaxSurge:        deployment.MaxSurge,
+			MaxUnavailable:  deployment.MaxUnavailable,
+			MinReadySeconds: deployment.MinReadySeconds,
+			GPUs:            deployment.GPUs,
+		}
+		status := gardenv1beta1.ShootMachineCanaryStatus{
+			WorkerName: worker.Name,
+			Phase:      gardenv1beta1.MachineCanaryPhaseSoaking,
+		}
+
+		// A canary machine deployment which uses another machine class is rolled out anew, its previous health does not
+		// matter anymore.
+		if existingCanary, ok := existing[canaryDeployment.Name]; ok && existingCanary.Spec.Template.Spec.Class.Name == canaryDeployment.ClassName {
+			status.Reason = existingCanary.Annotations[common.MachineDeploymentCanaryHalted]
+			if len(status.Reason) == 0 {
+				var readySince *time.Time
+				readySince, status.Reason = canaryHealth(canaryDeployment, machines, nodes, pods)
+				if readySince != nil {
+					if soakEnd := readySince.Add(soakPeriod); now.Before(soakEnd) {
+						status.SoakEndTime = &metav1.Time{Time: soakEnd}
+					} else if len(status.Reason) == 0 {
+						// The canary machines have been healthy for the soak period, the change is rolled out to all machines.
+						continue
+					}
+				}
+			}
+			if len(status.Reason) > 0 {
+				status.Phase = gardenv1beta1.MachineCanaryPhaseHalted
+				status.SoakEndTime = nil
+			}
+		}
+
+		// The annotations are always set explicitly because existing annotations are preserved when the machine
+		// deployment is updated.
+		canaryDeployment.Annotations = make(map[string]string, len(deployment.Annotations)+1)
+		for key, value := range deployment.Annotations {
+			canaryDeployment.Annotations[key] = value
+		}
+		canaryDeployment.Annotations[common.MachineDeploymentCanaryHalted] = status.Reason
+
+		held[worker.Name] = true
+		canaryDeployments = append(canaryDeployments, canaryDeployment)
+		canaries = append(canaries, status)
+	}
+
+	for _, deployment := range machineDeployments {
+		if currentClass, ok := currentClasses[deployment.Name]; ok && held[deployment.WorkerName] {
+			deployment.ClassName = currentClass
+			deployment.FallbackClassName = ""
+		}
+		result = append(result, deployment)
+	}
+
+	sort.Slice(canaries, func(i, j int) bool { return canaries[i].WorkerName < canaries[j].WorkerName })
+	return append(result, canaryDeployments...), canaryDeployments, canaries
+}
+
+// canaryHealth checks the health of the machines of the given <canaryDeployment> which use its machine class (see
+// <machines>). It returns the time since which the nodes of all of its machines have been ready (nil if not all of
+// them are ready yet), and the reason why the canary machines are unhealthy, i.e. if a machine has failed or has lost
+// its node, if a node is not ready anymore or reports a pressure condition, or if a pod on one of the nodes (see
+// <pods>) is crash-looping. The reason is empty for healthy canary machines.
+func canaryHealth(canaryDeployment operation.MachineDeployment, machines []machinev1alpha1.Machine, nodes []corev1.Node, pods []corev1.Pod) (*time.Time, string) {
+	var (
+		nodesByName = make(map[string]*corev1.Node, len(nodes))
+		canaryNodes = map[string]string{}
+		readySince  time.Time
+		ready       = 0
+	)
+
+	for i := range nodes {
+		nodesByName[nodes[i].Name] = &nodes[i]
+	}
+
+	for _, machine := range machines {
+		if machine.Labels["name"] != canaryDeployment.Name || machine.Spec.Class.Name != canaryDeployment.ClassName {
+			continue
+		}
+
+		switch machine.Status.CurrentStatus.Phase {
+		case machinev1alpha1.MachineFailed:
+			return nil, fmt.Sprintf("canary machine %s has failed: %s", machine.Name, machine.Status.LastOperation.Description)
+		case machinev1alpha1.MachineUnknown:
+			return nil, fmt.Sprintf("the node of canary machine %s is not ready anymore", machine.Name)
+		case machinev1alpha1.MachineRunning:
+		default:
+			continue
+		}
+
+		node, ok := nodesByName[machine.Status.Node]
+		if !ok {
+			return nil, fmt.Sprintf("the node of canary machine %s does not exist", machine.Name)
+		}
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				if condition.Status != corev1.ConditionTrue {
+					return nil, fmt.Sprintf("canary node %s is not ready anymore: %s", node.Name, condition.Message)
+				}
+				if condition.LastTransitionTime.After(readySince) {
+					readySince = condition.LastTransitionTime.Time
+				}
+				ready++
+			}
+			for _, pressure := range canaryNodePressureConditions {
+				if condition.Type == pressure && condition.Status == corev1.ConditionTrue {
+					return nil, fmt.Sprintf("canary node %s reports %s: %s", node.Name, condition.Type, condition.Message)
+				}
+			}
+		}
+		canaryNodes[node.Name] = machine.Name
+	}
+
+	for _, pod := range pods {
+		if _, ok := canaryNodes[pod.Spec.NodeName]; !ok {
+			continue
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff" {
+				return nil, fmt.Sprintf("container %s of pod %s/%s on canary node %s is crash-looping", status.Name, pod.Namespace, pod.Name, pod.Spec.NodeName)
+			}
+		}
+	}
+
+	if ready < canaryDeployment.Maximum {
+		return nil, ""
+	}
+	return &readySince, ""
+}
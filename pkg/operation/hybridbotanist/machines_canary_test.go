@@ -0,0 +1,214 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("canary rollouts", func() {
+	Describe("#canaryMachineDeployments", func() {
+		var (
+			now = time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+
+			deployments []operation.MachineDeployment
+			workers     []gardenv1beta1.Worker
+			existing    []machinev1alpha1.MachineDeployment
+
+			existingDeployment = func(name, class string, replicas int32, annotations map[string]string) machinev1alpha1.MachineDeployment {
+				d := machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+				d.Spec.Replicas = replicas
+				d.Spec.Template.Spec.Class.Name = class
+				return d
+			}
+			machine = func(name, class string, phase machinev1alpha1.MachinePhase) machinev1alpha1.Machine {
+				m := machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"name": "shoot-cpu-canary"}}}
+				m.Spec.Class.Name = class
+				m.Status.CurrentStatus.Phase = phase
+				m.Status.Node = "node-" + name
+				return m
+			}
+			node = func(name string, readySince time.Time, conditions ...corev1.NodeCondition) corev1.Node {
+				n := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				n.Status.Conditions = append([]corev1.NodeCondition{{
+					Type:               corev1.NodeReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: readySince},
+				}}, conditions...)
+				return n
+			}
+			canaryDeployment = func(halted string) operation.MachineDeployment {
+				return operation.MachineDeployment{
+					Name:        "shoot-cpu-canary",
+					WorkerName:  "cpu",
+					ClassName:   "shoot-cpu-z1-fghij",
+					Minimum:     2,
+					Maximum:     2,
+					Annotations: map[string]string{common.MachineDeploymentCanaryHalted: halted},
+				}
+			}
+			unchanged = func() []operation.MachineDeployment {
+				return []operation.MachineDeployment{
+					{Name: "shoot-cpu-z1", WorkerName: "cpu", ClassName: "shoot-cpu-z1-fghij", Minimum: 3, Maximum: 5},
+					{Name: "shoot-cpu-z2", WorkerName: "cpu", ClassName: "shoot-cpu-z2-fghij", Minimum: 3, Maximum: 5},
+					{Name: "shoot-gpu-z1", WorkerName: "gpu", ClassName: "shoot-gpu-z1-fghij", Minimum: 1, Maximum: 1},
+				}
+			}
+			held = func(canary operation.MachineDeployment) []operation.MachineDeployment {
+				return []operation.MachineDeployment{
+					{Name: "shoot-cpu-z1", WorkerName: "cpu", ClassName: "shoot-cpu-z1-abcde", Minimum: 3, Maximum: 5},
+					{Name: "shoot-cpu-z2", WorkerName: "cpu", ClassName: "shoot-cpu-z2-abcde", Minimum: 3, Maximum: 5},
+					{Name: "shoot-gpu-z1", WorkerName: "gpu", ClassName: "shoot-gpu-z1-fghij", Minimum: 1, Maximum: 1},
+					canary,
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			deployments = unchanged()
+			workers = []gardenv1beta1.Worker{
+				{Name: "cpu", Canary: &gardenv1beta1.WorkerCanary{Machines: 2}},
+				{Name: "gpu"},
+			}
+			existing = []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z1", "shoot-cpu-z1-abcde", 3, nil),
+				existingDeployment("shoot-cpu-z2", "shoot-cpu-z2-abcde", 3, nil),
+				existingDeployment("shoot-gpu-z1", "shoot-gpu-z1-abcde", 1, nil),
+			}
+		})
+
+		It("should roll out a changed machine class to the canary machines first", func() {
+			result, canaryDeployments, canaries := ExportCanaryMachineDeployments("shoot", deployments, workers, existing, nil, nil, nil, now)
+
+			Expect(result).To(Equal(held(canaryDeployment(""))))
+			Expect(canaryDeployments).To(Equal([]operation.MachineDeployment{canaryDeployment("")}))
+			Expect(canaries).To(Equal([]gardenv1beta1.ShootMachineCanaryStatus{
+				{WorkerName: "cpu", Phase: gardenv1beta1.MachineCanaryPhaseSoaking},
+			}))
+		})
+
+		It("should soak the canary machines once their nodes are ready", func() {
+			existing = append(existing, existingDeployment("shoot-cpu-canary", "shoot-cpu-z1-fghij", 2, nil))
+
+			result, _, canaries := ExportCanaryMachineDeployments("shoot", deployments, workers, existing,
+				[]machinev1alpha1.Machine{
+					machine("a", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+					machine("b", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+				},
+				[]corev1.Node{
+					node("node-a", now.Add(-8*time.Minute)),
+					node("node-b", now.Add(-5*time.Minute)),
+				}, nil, now)
+
+			Expect(result).To(Equal(held(canaryDeployment(""))))
+			Expect(canaries).To(Equal([]gardenv1beta1.ShootMachineCanaryStatus{
+				{WorkerName: "cpu", Phase: gardenv1beta1.MachineCanaryPhaseSoaking, SoakEndTime: &metav1.Time{Time: now.Add(5 * time.Minute)}},
+			}))
+		})
+
+		It("should roll out the changed machine class to all machines once the soak period has ended", func() {
+			existing = append(existing, existingDeployment("shoot-cpu-canary", "shoot-cpu-z1-fghij", 2, nil))
+
+			result, canaryDeployments, canaries := ExportCanaryMachineDeployments("shoot", deployments, workers, existing,
+				[]machinev1alpha1.Machine{
+					machine("a", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+					machine("b", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+				},
+				[]corev1.Node{
+					node("node-a", now.Add(-15*time.Minute)),
+					node("node-b", now.Add(-10*time.Minute)),
+				}, nil, now)
+
+			Expect(result).To(Equal(unchanged()))
+			Expect(canaryDeployments).To(BeEmpty())
+			Expect(canaries).To(BeEmpty())
+		})
+
+		It("should halt the rollout if a canary node reports a pressure condition", func() {
+			existing = append(existing, existingDeployment("shoot-cpu-canary", "shoot-cpu-z1-fghij", 2, nil))
+
+			result, _, canaries := ExportCanaryMachineDeployments("shoot", deployments, workers, existing,
+				[]machinev1alpha1.Machine{
+					machine("a", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+					machine("b", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+				},
+				[]corev1.Node{
+					node("node-a", now.Add(-15*time.Minute), corev1.NodeCondition{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue, Message: "disk full"}),
+					node("node-b", now.Add(-15*time.Minute)),
+				}, nil, now)
+
+			reason := "canary node node-a reports DiskPressure: disk full"
+			Expect(result).To(Equal(held(canaryDeployment(reason))))
+			Expect(canaries).To(Equal([]gardenv1beta1.ShootMachineCanaryStatus{
+				{WorkerName: "cpu", Phase: gardenv1beta1.MachineCanaryPhaseHalted, Reason: reason},
+			}))
+		})
+
+		It("should halt the rollout if a pod on a canary node is crash-looping", func() {
+			existing = append(existing, existingDeployment("shoot-cpu-canary", "shoot-cpu-z1-fghij", 2, nil))
+			pod := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "calico-node-x"}}
+			pod.Spec.NodeName = "node-b"
+			pod.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "calico-node",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			}}
+
+			_, _, canaries := ExportCanaryMachineDeployments("shoot", deployments, workers, existing,
+				[]machinev1alpha1.Machine{
+					machine("a", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+					machine("b", "shoot-cpu-z1-fghij", machinev1alpha1.MachineRunning),
+				},
+				[]corev1.Node{
+					node("node-a", now.Add(-2*time.Minute)),
+					node("node-b", now.Add(-2*time.Minute)),
+				}, []corev1.Pod{pod}, now)
+
+			Expect(canaries).To(Equal([]gardenv1beta1.ShootMachineCanaryStatus{
+				{WorkerName: "cpu", Phase: gardenv1beta1.MachineCanaryPhaseHalted, Reason: "container calico-node of pod kube-system/calico-node-x on canary node node-b is crash-looping"},
+			}))
+		})
+
+		It("should keep a halted rollout halted until the machine class changes again", func() {
+			existing = append(existing, existingDeployment("shoot-cpu-canary", "shoot-cpu-z1-fghij", 2, map[string]string{common.MachineDeploymentCanaryHalted: "broken"}))
+
+			result, _, canaries := ExportCanaryMachineDeployments("shoot", deployments, workers, existing, nil, nil, nil, now)
+
+			Expect(result).To(Equal(held(canaryDeployment("broken"))))
+			Expect(canaries).To(Equal([]gardenv1beta1.ShootMachineCanaryStatus{
+				{WorkerName: "cpu", Phase: gardenv1beta1.MachineCanaryPhaseHalted, Reason: "broken"},
+			}))
+
+			deployments[0].ClassName, deployments[1].ClassName = "shoot-cpu-z1-klmno", "shoot-cpu-z2-klmno"
+			_, canaryDeployments, canaries := ExportCanaryMachineDeployments("shoot", deployments, workers, existing, nil, nil, nil, now)
+
+			Expect(canaryDeployments[0].ClassName).To(Equal("shoot-cpu-z1-klmno"))
+			Expect(canaries).To(Equal([]gardenv1beta1.ShootMachineCanaryStatus{
+				{WorkerName: "cpu", Phase: gardenv1beta1.MachineCanaryPhaseSoaking},
+			}))
+		})
+	})
+})
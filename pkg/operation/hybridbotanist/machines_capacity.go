@@ -0,0 +1,41 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"github.com/gardener/gardener/pkg/operation"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+)
+
+// checkMachineCapacity asks the CloudBotanist whether the cloud provider has the capacity for the given machine classes
+// and deployments and offers their machine images, so that the operation fails right away with a quota or
+// configuration error instead of timing out while the machines are stuck in Pending. Only these errors fail the
+// operation, the check is skipped if it cannot be performed, e.g. because the credentials lack the privileges for it.
+func (b *HybridBotanist) checkMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) error {
+	if b.Shoot.Hibernated {
+		return nil
+	}
+
+	supported, err := b.ShootCloudBotanist.CheckMachineCapacity(machineClasses, machineDeployments)
+	if !supported || err == nil {
+		return nil
+	}
+	if operationerrors.IsQuotaExceeded(err) || operationerrors.IsConfiguration(err) {
+		return err
+	}
+
+	b.Logger.Warnf("Could not check the capacity of the cloud provider for the machines: %v", err)
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"errors"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeCapacityChecker is a CloudBotanist which reports the given result of the capacity check.
+type fakeCapacityChecker struct {
+	cloudbotanist.CloudBotanist
+	supported bool
+	err       error
+	calls     int
+}
+
+func (f *fakeCapacityChecker) CheckMachineCapacity(machineClasses []map[string]interface{}, machineDeployments []operation.MachineDeployment) (bool, error) {
+	f.calls++
+	return f.supported, f.err
+}
+
+var _ = Describe("machine capacity", func() {
+	Describe("#checkMachineCapacity", func() {
+		var (
+			machineDeployments = []operation.MachineDeployment{{Name: "shoot--foo--bar-cpu-worker-z1", ClassName: "shoot--foo--bar-cpu-worker-z1-abcde", Minimum: 3}}
+
+			newHybridBotanist = func(checker *fakeCapacityChecker, hibernated bool) *HybridBotanist {
+				return &HybridBotanist{
+					Operation: &operation.Operation{
+						Logger: logger.NewFieldLogger(logger.NewLogger("info"), "test", "capacity"),
+						Shoot: &shoot.Shoot{
+							Info:       &gardenv1beta1.Shoot{},
+							Hibernated: hibernated,
+						},
+					},
+					ShootCloudBotanist: checker,
+				}
+			}
+		)
+
+		It("should succeed if the cloud provider has the capacity", func() {
+			checker := &fakeCapacityChecker{supported: true}
+
+			Expect(ExportCheckMachineCapacity(newHybridBotanist(checker, false), nil, machineDeployments)).To(Succeed())
+			Expect(checker.calls).To(Equal(1))
+		})
+
+		It("should fail with a quota error", func() {
+			checker := &fakeCapacityChecker{supported: true, err: operationerrors.Errorf(operationerrors.ClassQuotaExceeded, "only 2 of the limit of 20 on-demand instances are left")}
+
+			err := ExportCheckMachineCapacity(newHybridBotanist(checker, false), nil, machineDeployments)
+
+			Expect(err).To(HaveOccurred())
+			Expect(operationerrors.IsQuotaExceeded(err)).To(BeTrue())
+		})
+
+		It("should fail with a configuration error", func() {
+			checker := &fakeCapacityChecker{supported: true, err: operationerrors.Errorf(operationerrors.ClassConfiguration, "machine image(s) ami-1234 do not exist")}
+
+			err := ExportCheckMachineCapacity(newHybridBotanist(checker, false), nil, machineDeployments)
+
+			Expect(err).To(HaveOccurred())
+			Expect(operationerrors.IsConfiguration(err)).To(BeTrue())
+		})
+
+		It("should skip the check if it cannot be performed", func() {
+			checker := &fakeCapacityChecker{supported: true, err: errors.New("UnauthorizedOperation: not authorized to perform ec2:DescribeAccountAttributes")}
+
+			Expect(ExportCheckMachineCapacity(newHybridBotanist(checker, false), nil, machineDeployments)).To(Succeed())
+		})
+
+		It("should ignore the result if the check is not supported", func() {
+			checker := &fakeCapacityChecker{supported: false, err: operationerrors.Errorf(operationerrors.ClassQuotaExceeded, "quota exceeded")}
+
+			Expect(ExportCheckMachineCapacity(newHybridBotanist(checker, false), nil, machineDeployments)).To(Succeed())
+		})
+
+		It("should not check the capacity of hibernated Shoots", func() {
+			checker := &fakeCapacityChecker{supported: true, err: operationerrors.Errorf(operationerrors.ClassQuotaExceeded, "quota exceeded")}
+
+			Expect(ExportCheckMachineCapacity(newHybridBotanist(checker, true), nil, machineDeployments)).To(Succeed())
+			Expect(checker.calls).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,94 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"strings"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// machineClassCloudConfigChecksums returns the checksums of the cloud configs of the worker groups of the given
+// <machineDeployments> keyed by the names of their machine classes (including the fallback classes).
+func machineClassCloudConfigChecksums(machineDeployments []operation.MachineDeployment, checksums map[string]string) map[string]string {
+	classChecksums := map[string]string{}
+	for _, deployment := range machineDeployments {
+		checksum, ok := checksums[deployment.WorkerName]
+		if !ok {
+			continue
+		}
+		classChecksums[deployment.ClassName] = checksum
+		if len(deployment.FallbackClassName) > 0 {
+			classChecksums[deployment.FallbackClassName] = checksum
+		}
+	}
+	return classChecksums
+}
+
+// annotateMachineClassObjects stores the cloud config checksums of the given <classChecksums> (keyed by the names of
+// the machine classes) in the MachineClassCloudConfigChecksum annotation of the respective rendered machine classes
+// and machine class secrets in <objects>.
+func annotateMachineClassObjects(objects []*unstructured.Unstructured, classChecksums map[string]string) {
+	for _, obj := range objects {
+		checksum, ok := classChecksums[obj.GetName()]
+		if !ok {
+			continue
+		}
+		annotations := obj.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[common.MachineClassCloudConfigChecksum] = checksum
+		obj.SetAnnotations(annotations)
+	}
+}
+
+// cloudConfigDriftedWorkers returns the names of the worker groups of the given <machineDeployments> for which a live
+// machine class secret in <secrets> carries a cloud config checksum differing from the current one in <checksums>,
+// i.e. whose machines still run an outdated cloud config and are replaced by the rolling update.
+func cloudConfigDriftedWorkers(secrets []corev1.Secret, machineDeployments []operation.MachineDeployment, checksums map[string]string) []string {
+	drifted := sets.NewString()
+	for _, deployment := range machineDeployments {
+		checksum, ok := checksums[deployment.WorkerName]
+		if !ok {
+			continue
+		}
+		for _, secret := range secrets {
+			liveChecksum, ok := secret.Annotations[common.MachineClassCloudConfigChecksum]
+			if ok && liveChecksum != checksum && strings.HasPrefix(secret.Name, deployment.Name+"-") {
+				drifted.Insert(deployment.WorkerName)
+				break
+			}
+		}
+	}
+	return drifted.List()
+}
+
+// reportCloudConfigDrift logs the worker groups of the given <machineDeployments> whose machines are replaced because
+// their cloud config has changed.
+func (b *HybridBotanist) reportCloudConfigDrift(machineDeployments []operation.MachineDeployment) error {
+	secretList, err := b.listMachineClassSecrets()
+	if err != nil {
+		return err
+	}
+	for _, workerName := range cloudConfigDriftedWorkers(secretList.Items, machineDeployments, b.Shoot.CloudConfigChecksums) {
+		b.Logger.Infof("The cloud config of worker group %s has changed, its machines are replaced by a rolling update", workerName)
+	}
+	return nil
+}
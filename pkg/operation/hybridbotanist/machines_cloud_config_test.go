@@ -0,0 +1,183 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"encoding/base64"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ = Describe("cloud config changes", func() {
+	var (
+		deployments = []operation.MachineDeployment{
+			{Name: "shoot--foo--bar-cpu-z1", WorkerName: "cpu", ClassName: "shoot--foo--bar-cpu-z1-abcde"},
+			{Name: "shoot--foo--bar-cpu-z2", WorkerName: "cpu", ClassName: "shoot--foo--bar-cpu-z2-abcde"},
+			{Name: "shoot--foo--bar-spot-z1", WorkerName: "spot", ClassName: "shoot--foo--bar-spot-z1-abcde", FallbackClassName: "shoot--foo--bar-spot-z1-fghij"},
+		}
+		checksums = map[string]string{"cpu": "cpu-checksum", "spot": "spot-checksum"}
+
+		secret = func(name, checksum string) corev1.Secret {
+			s := corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			if len(checksum) > 0 {
+				s.Annotations = map[string]string{common.MachineClassCloudConfigChecksum: checksum}
+			}
+			return s
+		}
+	)
+
+	Describe("#cloudConfigChecksums", func() {
+		It("should return the decoded checksums of the cloud config secrets keyed by worker name", func() {
+			objects, err := ExportDecodeManifest([]byte(`apiVersion: v1
+kind: Secret
+metadata:
+  name: cloud-config-cpu
+data:
+  checksum: ` + base64.StdEncoding.EncodeToString([]byte("cpu-checksum")) + `
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: unrelated
+data:
+  checksum: ` + base64.StdEncoding.EncodeToString([]byte("other")) + `
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := ExportCloudConfigChecksums(objects, map[string]string{"cloud-config-cpu": "cpu"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(map[string]string{"cpu": "cpu-checksum"}))
+		})
+
+		It("should fail for an invalid checksum", func() {
+			objects, err := ExportDecodeManifest([]byte(`apiVersion: v1
+kind: Secret
+metadata:
+  name: cloud-config-cpu
+data:
+  checksum: "not base64!"
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = ExportCloudConfigChecksums(objects, map[string]string{"cloud-config-cpu": "cpu"})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#machineClassCloudConfigChecksums", func() {
+		It("should key the checksums by the names of the machine classes and fallback classes", func() {
+			Expect(ExportMachineClassCloudConfigChecksums(deployments, checksums)).To(Equal(map[string]string{
+				"shoot--foo--bar-cpu-z1-abcde":  "cpu-checksum",
+				"shoot--foo--bar-cpu-z2-abcde":  "cpu-checksum",
+				"shoot--foo--bar-spot-z1-abcde": "spot-checksum",
+				"shoot--foo--bar-spot-z1-fghij": "spot-checksum",
+			}))
+		})
+
+		It("should return no checksums if none have been computed", func() {
+			Expect(ExportMachineClassCloudConfigChecksums(deployments, nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("#annotateMachineClassObjects", func() {
+		It("should annotate the machine classes and secrets with the checksums", func() {
+			class := &unstructured.Unstructured{}
+			class.SetKind("AWSMachineClass")
+			class.SetName("shoot--foo--bar-cpu-z1-abcde")
+			class.SetAnnotations(map[string]string{"foo": "bar"})
+			classSecret := &unstructured.Unstructured{}
+			classSecret.SetKind("Secret")
+			classSecret.SetName("shoot--foo--bar-cpu-z1-abcde")
+			other := &unstructured.Unstructured{}
+			other.SetKind("Secret")
+			other.SetName("other")
+
+			ExportAnnotateMachineClassObjects([]*unstructured.Unstructured{class, classSecret, other}, map[string]string{"shoot--foo--bar-cpu-z1-abcde": "cpu-checksum"})
+
+			Expect(class.GetAnnotations()).To(Equal(map[string]string{"foo": "bar", common.MachineClassCloudConfigChecksum: "cpu-checksum"}))
+			Expect(classSecret.GetAnnotations()).To(Equal(map[string]string{common.MachineClassCloudConfigChecksum: "cpu-checksum"}))
+			Expect(other.GetAnnotations()).To(BeEmpty())
+		})
+	})
+
+	Describe("#cloudConfigDriftedWorkers", func() {
+		It("should return the worker groups whose live machine classes carry an outdated checksum", func() {
+			secrets := []corev1.Secret{
+				secret("shoot--foo--bar-cpu-z1-abcde", "old-checksum"),
+				secret("shoot--foo--bar-cpu-z2-abcde", "old-checksum"),
+				secret("shoot--foo--bar-spot-z1-abcde", "spot-checksum"),
+			}
+
+			Expect(ExportCloudConfigDriftedWorkers(secrets, deployments, checksums)).To(Equal([]string{"cpu"}))
+		})
+
+		It("should ignore machine class secrets without checksum", func() {
+			secrets := []corev1.Secret{
+				secret("shoot--foo--bar-cpu-z1-abcde", ""),
+				secret("shoot--foo--bar-spot-z1-abcde", ""),
+			}
+
+			Expect(ExportCloudConfigDriftedWorkers(secrets, deployments, checksums)).To(BeEmpty())
+		})
+	})
+
+	Describe("#computeWorkerKubeletConfig", func() {
+		It("should only contain the empty eviction thresholds and reservations if the worker has no kubelet config", func() {
+			Expect(ExportComputeWorkerKubeletConfig(gardenv1beta1.Worker{Name: "cpu"})).To(Equal(map[string]interface{}{
+				"evictionHard":   map[string]string{},
+				"evictionSoft":   map[string]string{},
+				"kubeReserved":   map[string]string{},
+				"systemReserved": map[string]string{},
+			}))
+		})
+
+		It("should contain the kubelet config of the worker", func() {
+			var (
+				maxPods = int32(250)
+				policy  = "static"
+			)
+
+			Expect(ExportComputeWorkerKubeletConfig(gardenv1beta1.Worker{
+				Name: "cpu",
+				Kubelet: &gardenv1beta1.WorkerKubeletConfig{
+					EvictionHard:     map[string]string{"memory.available": "500Mi"},
+					MaxPods:          &maxPods,
+					KubeReserved:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+					CPUManagerPolicy: &policy,
+				},
+			})).To(Equal(map[string]interface{}{
+				"evictionHard":     map[string]string{"memory.available": "500Mi"},
+				"evictionSoft":     map[string]string{},
+				"kubeReserved":     map[string]string{"cpu": "100m", "memory": "2Gi"},
+				"systemReserved":   map[string]string{},
+				"maxPods":          int32(250),
+				"cpuManagerPolicy": "static",
+			}))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"strconv"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// gpuTaint is the taint with which the nodes of worker groups with GPU machine types register.
+var gpuTaint = corev1.Taint{
+	Key:    common.GPUTaint,
+	Value:  "present",
+	Effect: corev1.TaintEffectNoSchedule,
+}
+
+// gpuNodeLabels returns the accelerator labels of the nodes of a worker group whose machine type has the given number
+// of <gpus>. The NVIDIA device plugin is scheduled onto the nodes with these labels.
+func gpuNodeLabels(gpus int) map[string]string {
+	return map[string]string{
+		common.AcceleratorLabel: "nvidia",
+		common.GPUCountLabel:    strconv.Itoa(gpus),
+	}
+}
+
+// nodeTemplateTaints returns the taints with which the nodes of the given <worker> (whose machine type has <gpus>)
+// register permanently, in the format of the kubelet. The taint which is removed once the critical components are
+// ready is not contained.
+func nodeTemplateTaints(worker gardenv1beta1.Worker, gpus int) []string {
+	var taints []corev1.Taint
+	for _, taint := range computeWorkerTaints(worker, gpus) {
+		if taint.Key != common.CriticalComponentsNotReadyTaint {
+			taints = append(taints, taint)
+		}
+	}
+	return computeKubeletTaints(taints)
+}
+
+// hasTaintKey checks whether one of the given <taints> has the given <key>.
+func hasTaintKey(taints []corev1.Taint, key string) bool {
+	for _, taint := range taints {
+		if taint.Key == key {
+			return true
+		}
+	}
+	return false
+}
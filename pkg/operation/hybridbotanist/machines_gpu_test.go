@@ -0,0 +1,77 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("GPU machines", func() {
+	var (
+		trueVar = true
+
+		gpuTaint = corev1.Taint{Key: common.GPUTaint, Value: "present", Effect: corev1.TaintEffectNoSchedule}
+	)
+
+	Describe("#computeWorkerNodeLabels", func() {
+		It("should return the node labels of the worker if its machine type has no GPUs", func() {
+			labels := map[string]string{"foo": "bar"}
+
+			Expect(ExportComputeWorkerNodeLabels(gardenv1beta1.Worker{Name: "cpu", NodeLabels: labels}, 0)).To(Equal(labels))
+		})
+
+		It("should add the accelerator labels if the machine type of the worker has GPUs", func() {
+			Expect(ExportComputeWorkerNodeLabels(gardenv1beta1.Worker{Name: "gpu", NodeLabels: map[string]string{"foo": "bar"}}, 4)).To(Equal(map[string]string{
+				"foo":                   "bar",
+				common.AcceleratorLabel: "nvidia",
+				common.GPUCountLabel:    "4",
+			}))
+		})
+	})
+
+	Describe("#computeWorkerTaints", func() {
+		It("should add the GPU taint if the machine type of the worker has GPUs", func() {
+			taint := corev1.Taint{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoExecute}
+
+			Expect(ExportComputeWorkerTaints(gardenv1beta1.Worker{Name: "gpu", NodeTaints: []corev1.Taint{taint}}, 1)).To(Equal([]corev1.Taint{taint, gpuTaint}))
+			Expect(ExportComputeWorkerTaints(gardenv1beta1.Worker{Name: "cpu", NodeTaints: []corev1.Taint{taint}}, 0)).To(Equal([]corev1.Taint{taint}))
+		})
+
+		It("should not add the GPU taint if the worker already has a taint with its key", func() {
+			taint := corev1.Taint{Key: common.GPUTaint, Value: "true", Effect: corev1.TaintEffectNoExecute}
+
+			Expect(ExportComputeWorkerTaints(gardenv1beta1.Worker{Name: "gpu", NodeTaints: []corev1.Taint{taint}}, 1)).To(Equal([]corev1.Taint{taint}))
+		})
+	})
+
+	Describe("#nodeTemplateTaints", func() {
+		It("should not contain the taint which is removed once the critical components are ready", func() {
+			Expect(ExportNodeTemplateTaints(gardenv1beta1.Worker{Name: "gpu", WaitForCriticalComponents: &trueVar}, 2)).To(Equal([]string{
+				"nvidia.com/gpu=present:NoSchedule",
+			}))
+		})
+
+		It("should be empty for workers without permanent taints", func() {
+			Expect(ExportNodeTemplateTaints(gardenv1beta1.Worker{Name: "cpu"}, 0)).To(BeEmpty())
+		})
+	})
+})
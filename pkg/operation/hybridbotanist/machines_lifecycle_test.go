@@ -0,0 +1,366 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// repositoryChartRenderer renders the charts of the repository while the tests run in the directory of the package.
+type repositoryChartRenderer struct {
+	chartrenderer.ChartRenderer
+}
+
+func (r repositoryChartRenderer) Render(chartPath, releaseName, namespace string, values map[string]interface{}) (*chartrenderer.RenderedChart, error) {
+	return r.ChartRenderer.Render(filepath.Join("..", "..", "..", chartPath), releaseName, namespace, values)
+}
+
+// fakeMachineCloudBotanist generates the AWS machine classes and machine deployments of the workers of a Shoot and
+// reports the instances of the simulated machine-controller-manager. The names of the machine classes only depend on
+// the machine types of the workers.
+type fakeMachineCloudBotanist struct {
+	cloudbotanist.CloudBotanist
+
+	shoot           *shoot.Shoot
+	mcm             *fakeMachineControllerManager
+	accessKeyID     string
+	secretAccessKey string
+}
+
+func (b *fakeMachineCloudBotanist) GetMachineClassInfo() (string, string, string) {
+	return "AWSMachineClass", "awsmachineclasses", "aws-machineclass"
+}
+
+func (b *fakeMachineCloudBotanist) GenerateMachineClassSecretData() map[string][]byte {
+	return map[string][]byte{
+		machinev1alpha1.AWSAccessKeyID:     []byte(b.accessKeyID),
+		machinev1alpha1.AWSSecretAccessKey: []byte(b.secretAccessKey),
+	}
+}
+
+func (b *fakeMachineCloudBotanist) GenerateMachineConfig() ([]map[string]interface{}, []operation.MachineDeployment, error) {
+	var (
+		zones              = b.shoot.Info.Spec.Cloud.AWS.Zones
+		machineClasses     []map[string]interface{}
+		machineDeployments []operation.MachineDeployment
+	)
+
+	for zoneIndex := range zones {
+		for _, worker := range b.shoot.Info.Spec.Cloud.AWS.Workers {
+			var (
+				deploymentName = common.ZonedMachineDeploymentName(b.shoot.SeedNamespace, worker.Name, zoneIndex)
+				className      = fmt.Sprintf("%s-%s", deploymentName, utils.ComputeSHA256Hex([]byte(worker.MachineType))[:5])
+			)
+
+			machineDeployments = append(machineDeployments, operation.MachineDeployment{
+				Name:       deploymentName,
+				WorkerName: worker.Name,
+				ClassName:  className,
+				Minimum:    common.DistributeOverZones(zoneIndex, worker.AutoScalerMin, len(zones)),
+				Maximum:    common.DistributeOverZones(zoneIndex, worker.AutoScalerMax, len(zones)),
+			})
+			machineClasses = append(machineClasses, map[string]interface{}{
+				"name":               className,
+				"ami":                "ami-1234",
+				"region":             "eu-west-1",
+				"machineType":        worker.MachineType,
+				"iamInstanceProfile": "nodes",
+				"keyName":            "ssh",
+				"networkInterfaces": []map[string]interface{}{
+					{"subnetID": fmt.Sprintf("subnet-%d", zoneIndex), "securityGroupIDs": []string{"sg-nodes"}},
+				},
+				"tags": map[string]string{"kubernetes.io/role/node": "1"},
+				"blockDevices": []map[string]interface{}{
+					{"ebs": map[string]interface{}{"volumeSize": 20, "volumeType": worker.VolumeType}},
+				},
+				"secret": map[string]interface{}{
+					"cloudConfig":     "#cloud-config for " + worker.Name,
+					"accessKeyID":     b.accessKeyID,
+					"secretAccessKey": b.secretAccessKey,
+				},
+			})
+		}
+	}
+
+	return machineClasses, machineDeployments, nil
+}
+
+func (b *fakeMachineCloudBotanist) ListMachineInstanceIDs() ([]string, bool, error) {
+	return b.mcm.instanceIDs(), true, nil
+}
+
+func (b *fakeMachineCloudBotanist) CheckMachineCapacity([]map[string]interface{}, []operation.MachineDeployment) (bool, error) {
+	return false, nil
+}
+
+var _ = Describe("machine lifecycle", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		// timeout is the maximum duration the simulated machine-controller-manager may take to catch up.
+		timeout = 10 * time.Second
+	)
+
+	var (
+		ctx            context.Context
+		seed           *fakeSeed
+		mcm            *fakeMachineControllerManager
+		cloudBotanist  *fakeMachineCloudBotanist
+		hybridBotanist *HybridBotanist
+
+		worker = func(name, machineType string, replicas int) gardenv1beta1.AWSWorker {
+			return gardenv1beta1.AWSWorker{
+				Worker: gardenv1beta1.Worker{
+					Name:          name,
+					MachineType:   machineType,
+					AutoScalerMin: replicas,
+					AutoScalerMax: replicas,
+				},
+				VolumeType: "gp2",
+				VolumeSize: "20Gi",
+			}
+		}
+		setWorkers = func(workers ...gardenv1beta1.AWSWorker) {
+			hybridBotanist.Shoot.Info.Spec.Cloud.AWS.Workers = workers
+		}
+
+		names = func(objects []runtime.Object) []string {
+			result := make([]string, 0, len(objects))
+			for _, obj := range objects {
+				accessor, err := meta.Accessor(obj)
+				Expect(err).NotTo(HaveOccurred())
+				result = append(result, accessor.GetName())
+			}
+			return result
+		}
+		namesOf = func(resource string) func() []string {
+			return func() []string {
+				return names(seed.list(resource, namespace))
+			}
+		}
+		machines = func() []*machinev1alpha1.Machine {
+			var result []*machinev1alpha1.Machine
+			for _, obj := range seed.list("machines", namespace) {
+				result = append(result, obj.(*machinev1alpha1.Machine))
+			}
+			return result
+		}
+		machineClassesOfMachines = func() []string {
+			var result []string
+			for _, machine := range machines() {
+				result = append(result, machine.Spec.Class.Name)
+			}
+			return result
+		}
+		deploymentName = func(workerName string, zoneIndex int) string {
+			return common.ZonedMachineDeploymentName(namespace, workerName, zoneIndex)
+		}
+		className = func(workerName string, zoneIndex int, machineType string) string {
+			return fmt.Sprintf("%s-%s", deploymentName(workerName, zoneIndex), utils.ComputeSHA256Hex([]byte(machineType))[:5])
+		}
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		seed = newFakeSeed()
+		mcm = newFakeMachineControllerManager(seed, namespace, "awsmachineclasses", 10*time.Millisecond)
+
+		seedClient := seed.client()
+		shootObj := &shoot.Shoot{
+			Info: &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				Spec: gardenv1beta1.ShootSpec{
+					Cloud: gardenv1beta1.Cloud{
+						AWS: &gardenv1beta1.AWSCloud{Zones: []string{"eu-west-1a", "eu-west-1b"}},
+					},
+				},
+			},
+			SeedNamespace: namespace,
+			CloudProvider: gardenv1beta1.CloudProviderAWS,
+		}
+		cloudBotanist = &fakeMachineCloudBotanist{
+			shoot:           shootObj,
+			mcm:             mcm,
+			accessKeyID:     "access-key-id",
+			secretAccessKey: "secret-access-key",
+		}
+
+		hybridBotanist = &HybridBotanist{
+			Operation: &operation.Operation{
+				Logger:            logger.NewFieldLogger(logger.NewLogger("info"), "test", "machine-lifecycle"),
+				K8sSeedClient:     seedClient,
+				ChartSeedRenderer: repositoryChartRenderer{chartrenderer.New(seedClient)},
+				Shoot:             shootObj,
+			},
+			ShootCloudBotanist:      cloudBotanist,
+			MachineWaitTimeout:      20 * time.Second,
+			MachineWaitPollInterval: 50 * time.Millisecond,
+		}
+		setWorkers(worker("cpu", "m5.large", 4))
+	})
+
+	AfterEach(func() {
+		mcm.stop()
+		seed.close()
+	})
+
+	Describe("#DeployMachines", func() {
+		It("should create the machine classes, their secrets and the machine deployments and wait for the machines", func() {
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+
+			Expect(namesOf("machinedeployments")()).To(ConsistOf(deploymentName("cpu", 0), deploymentName("cpu", 1)))
+			Expect(namesOf("awsmachineclasses")()).To(ConsistOf(className("cpu", 0, "m5.large"), className("cpu", 1, "m5.large")))
+			Expect(namesOf("secrets")()).To(ConsistOf(className("cpu", 0, "m5.large"), className("cpu", 1, "m5.large")))
+
+			for _, obj := range seed.list("awsmachineclasses", namespace) {
+				Expect(obj.(metav1.Object).GetFinalizers()).To(ContainElement(gardenv1beta1.ExternalGardenerName))
+			}
+			for _, obj := range seed.list("machinedeployments", namespace) {
+				deployment := obj.(*machinev1alpha1.MachineDeployment)
+				Expect(deployment.Spec.Replicas).To(Equal(int32(2)))
+				Expect(deployment.Status.ReadyReplicas).To(Equal(int32(2)))
+			}
+
+			Expect(machines()).To(HaveLen(4))
+			for _, machine := range machines() {
+				Expect(machine.Status.CurrentStatus.Phase).To(Equal(machinev1alpha1.MachineRunning))
+			}
+			Expect(mcm.instanceIDs()).To(HaveLen(4))
+		})
+
+		It("should replace the machines in a rolling update and clean up the old machine classes and secrets", func() {
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+			oldClasses := namesOf("awsmachineclasses")()
+
+			setWorkers(worker("cpu", "m5.xlarge", 4))
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+
+			newClasses := []string{className("cpu", 0, "m5.xlarge"), className("cpu", 1, "m5.xlarge")}
+			Expect(machines()).To(HaveLen(4))
+			Expect(machineClassesOfMachines()).To(ConsistOf(newClasses[0], newClasses[0], newClasses[1], newClasses[1]))
+			for _, machine := range machines() {
+				Expect(machine.Status.CurrentStatus.Phase).To(Equal(machinev1alpha1.MachineRunning))
+			}
+			Eventually(mcm.instanceIDs, timeout).Should(HaveLen(4))
+
+			// The old machine classes are released by the machine-controller-manager, but the secrets of the classes
+			// which existed during the cleanup are only deleted by the next reconciliation.
+			Eventually(namesOf("awsmachineclasses"), timeout).Should(ConsistOf(newClasses))
+			Expect(namesOf("secrets")()).To(ContainElement(oldClasses[0]))
+
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+			Expect(namesOf("secrets")()).To(ConsistOf(newClasses))
+
+			configMap, err := seed.get("configmaps", namespace, common.MachineHistoryConfigMapName)
+			Expect(err).NotTo(HaveOccurred())
+			var history []map[string]interface{}
+			Expect(json.Unmarshal([]byte(configMap.(*corev1.ConfigMap).Data[common.MachineHistoryConfigMapKey]), &history)).To(Succeed())
+			var recorded []interface{}
+			for _, record := range history {
+				recorded = append(recorded, record["name"])
+			}
+			Expect(recorded).To(ContainElement(oldClasses[0]))
+			Expect(recorded).To(ContainElement(oldClasses[1]))
+		})
+
+		It("should delete the machine resources of removed worker groups", func() {
+			setWorkers(worker("cpu", "m5.large", 2), worker("gpu", "p2.xlarge", 2))
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+			Expect(machines()).To(HaveLen(4))
+
+			setWorkers(worker("cpu", "m5.large", 2))
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+
+			cpuClasses := []string{className("cpu", 0, "m5.large"), className("cpu", 1, "m5.large")}
+			Expect(namesOf("machinedeployments")()).To(ConsistOf(deploymentName("cpu", 0), deploymentName("cpu", 1)))
+			Eventually(machineClassesOfMachines, timeout).Should(ConsistOf(cpuClasses))
+			Eventually(namesOf("machinesets"), timeout).Should(ConsistOf(cpuClasses))
+			Eventually(mcm.instanceIDs, timeout).Should(HaveLen(2))
+
+			// The machine classes of the removed worker group are only deleted once its machines are gone, and their
+			// secrets are deleted by the reconciliation after the machine classes have been deleted.
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+			Eventually(namesOf("awsmachineclasses"), timeout).Should(ConsistOf(cpuClasses))
+
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+			Expect(namesOf("secrets")()).To(ConsistOf(cpuClasses))
+		})
+
+		It("should fail with a quota error if the machines of a worker group cannot be created", func() {
+			hybridBotanist.MachineDeploymentProgressTimeout = 500 * time.Millisecond
+			mcm.fail(deploymentName("cpu", 1), "InstanceLimitExceeded: Your quota allows for 0 more running instance(s).")
+
+			err := hybridBotanist.DeployMachines(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(operationerrors.IsQuotaExceeded(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(deploymentName("cpu", 1)))
+		})
+	})
+
+	Describe("#RefreshMachineClassSecrets", func() {
+		It("should update the credentials of the machine class secrets and keep their user data", func() {
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+
+			cloudBotanist.accessKeyID = "new-access-key-id"
+			Expect(hybridBotanist.RefreshMachineClassSecrets()).To(Succeed())
+
+			secrets := seed.list("secrets", namespace)
+			Expect(secrets).To(HaveLen(2))
+			for _, obj := range secrets {
+				secret := obj.(*corev1.Secret)
+				Expect(secret.Data).To(HaveKeyWithValue(machinev1alpha1.AWSAccessKeyID, []byte("new-access-key-id")))
+				Expect(secret.Data).To(HaveKeyWithValue(machinev1alpha1.AWSSecretAccessKey, []byte("secret-access-key")))
+				Expect(secret.Data).To(HaveKeyWithValue("userData", []byte("#cloud-config for cpu")))
+			}
+		})
+	})
+
+	Describe("#DestroyMachines", func() {
+		It("should delete all machine resources and wait until the instances are gone", func() {
+			Expect(hybridBotanist.DeployMachines(ctx)).To(Succeed())
+
+			Expect(hybridBotanist.DestroyMachines(ctx)).To(Succeed())
+
+			for _, resource := range []string{"machinedeployments", "machinesets", "machines", "awsmachineclasses"} {
+				Expect(namesOf(resource)()).To(BeEmpty(), resource)
+			}
+			Expect(mcm.instanceIDs()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/utils"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// deferMachineReplacements returns the given <machineDeployments> where every existing machine deployment whose
+// machines would be replaced keeps its current machine class if the Shoot defers the replacement of its machines until
+// its maintenance time window and the current time is outside of it. The names of the worker groups with deferred
+// replacements are remembered for the reported rollout progress.
+func (b *HybridBotanist) deferMachineReplacements(machineDeployments []operation.MachineDeployment) ([]operation.MachineDeployment, error) {
+	b.pendingReplacements = nil
+	if !b.Shoot.ReplaceMachinesInTimeWindow() || b.Shoot.Hibernated {
+		return machineDeployments, nil
+	}
+
+	timeWindow := b.Shoot.Info.Spec.Maintenance.TimeWindow
+	withinTimeWindow, err := utils.NowWithinTimeWindow(timeWindow.Begin, timeWindow.End, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if withinTimeWindow {
+		return machineDeployments, nil
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result, pendingReplacements := deferredMachineDeployments(machineDeployments, machineDeploymentList.Items)
+	if len(pendingReplacements) > 0 {
+		b.Logger.Infof("Deferring the replacement of the machines of the worker groups %s until the maintenance time window (%s - %s)", strings.Join(pendingReplacements, ", "), timeWindow.Begin, timeWindow.End)
+	}
+	b.pendingReplacements = pendingReplacements
+	return result, nil
+}
+
+// deferredMachineDeployments returns the given <machineDeployments> where every machine deployment which replaces the
+// machines of the corresponding one of the <existingDeployments> (i.e., which uses another machine class while the
+// existing one still has machines) keeps the current machine class. A change to or from the fallback class of a spot
+// worker group is not deferred. It also returns the sorted names of the worker groups whose replacement is deferred.
+func deferredMachineDeployments(machineDeployments []operation.MachineDeployment, existingDeployments []machinev1alpha1.MachineDeployment) ([]operation.MachineDeployment, []string) {
+	var (
+		existing            = make(map[string]*machinev1alpha1.MachineDeployment, len(existingDeployments))
+		pendingReplacements = sets.NewString()
+		result              = make([]operation.MachineDeployment, 0, len(machineDeployments))
+	)
+
+	for i := range existingDeployments {
+		existing[existingDeployments[i].Name] = &existingDeployments[i]
+	}
+
+	for _, deployment := range machineDeployments {
+		if existingDeployment, ok := existing[deployment.Name]; ok && existingDeployment.Spec.Replicas > 0 {
+			currentClass := existingDeployment.Spec.Template.Spec.Class.Name
+			if currentClass != deployment.ClassName && currentClass != deployment.FallbackClassName {
+				deployment.ClassName = currentClass
+				deployment.FallbackClassName = ""
+				pendingReplacements.Insert(deployment.WorkerName)
+			}
+		}
+		result = append(result, deployment)
+	}
+
+	return result, pendingReplacements.List()
+}
@@ -0,0 +1,83 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("maintenance time window", func() {
+	Describe("#deferredMachineDeployments", func() {
+		var (
+			deployments []operation.MachineDeployment
+
+			existingDeployment = func(name, class string, replicas int32) machinev1alpha1.MachineDeployment {
+				d := machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				d.Spec.Replicas = replicas
+				d.Spec.Template.Spec.Class.Name = class
+				return d
+			}
+		)
+
+		BeforeEach(func() {
+			deployments = []operation.MachineDeployment{
+				{Name: "shoot-cpu-z1", WorkerName: "cpu", ClassName: "shoot-cpu-z1-fghij", Minimum: 3, Maximum: 5},
+				{Name: "shoot-cpu-z2", WorkerName: "cpu", ClassName: "shoot-cpu-z2-fghij", Minimum: 3, Maximum: 5},
+				{Name: "shoot-spot-z1", WorkerName: "spot", ClassName: "shoot-spot-z1-fghij", FallbackClassName: "shoot-spot-z1-klmno"},
+			}
+		})
+
+		It("should keep the current machine classes of the machine deployments whose machines would be replaced", func() {
+			result, pending := ExportDeferredMachineDeployments(deployments, []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z1", "shoot-cpu-z1-abcde", 2),
+				existingDeployment("shoot-cpu-z2", "shoot-cpu-z2-abcde", 2),
+				existingDeployment("shoot-spot-z1", "shoot-spot-z1-abcde", 1),
+			})
+
+			Expect(pending).To(Equal([]string{"cpu", "spot"}))
+			Expect(result).To(Equal([]operation.MachineDeployment{
+				{Name: "shoot-cpu-z1", WorkerName: "cpu", ClassName: "shoot-cpu-z1-abcde", Minimum: 3, Maximum: 5},
+				{Name: "shoot-cpu-z2", WorkerName: "cpu", ClassName: "shoot-cpu-z2-abcde", Minimum: 3, Maximum: 5},
+				{Name: "shoot-spot-z1", WorkerName: "spot", ClassName: "shoot-spot-z1-abcde"},
+			}))
+			Expect(deployments[0].ClassName).To(Equal("shoot-cpu-z1-fghij"))
+		})
+
+		It("should apply the changes which do not replace machines immediately", func() {
+			result, pending := ExportDeferredMachineDeployments(deployments, []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z1", "shoot-cpu-z1-fghij", 2),
+				existingDeployment("shoot-cpu-z2", "shoot-cpu-z2-abcde", 0),
+				existingDeployment("shoot-spot-z1", "shoot-spot-z1-klmno", 1),
+			})
+
+			Expect(pending).To(BeEmpty())
+			Expect(result).To(Equal(deployments))
+		})
+
+		It("should not defer new machine deployments", func() {
+			result, pending := ExportDeferredMachineDeployments(deployments, nil)
+
+			Expect(pending).To(BeEmpty())
+			Expect(result).To(Equal(deployments))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"strings"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// pauseMachineDeployments returns the given <machineDeployments> without those whose existing machine deployments
+// have been paused by an operator (see common.MachineDeploymentPaused). It also returns the paused machine
+// deployments as they exist in the Seed, they must be kept together with their machine classes by the cleanup.
+func (b *HybridBotanist) pauseMachineDeployments(machineDeployments []operation.MachineDeployment) ([]operation.MachineDeployment, []operation.MachineDeployment, error) {
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	active, paused := pausedMachineDeployments(machineDeployments, machineDeploymentList.Items)
+	if len(paused) > 0 {
+		names := make([]string, 0, len(paused))
+		for _, deployment := range paused {
+			names = append(names, deployment.Name)
+		}
+		b.Logger.Infof("Skipping the paused machine deployments %s, they are neither updated nor deleted until they are resumed", strings.Join(names, ", "))
+	}
+	return active, paused, nil
+}
+
+// pausedMachineDeployments splits the given <machineDeployments> into those which are rolled out and those whose
+// corresponding one of the <existingDeployments> carries the common.MachineDeploymentPaused annotation. The paused
+// machine deployments are returned with the machine classes they currently use. Paused machine deployments which
+// are not desired anymore (e.g. of removed worker groups) are returned as well, hence, they are not deleted either.
+func pausedMachineDeployments(machineDeployments []operation.MachineDeployment, existingDeployments []machinev1alpha1.MachineDeployment) ([]operation.MachineDeployment, []operation.MachineDeployment) {
+	var (
+		workerNames = make(map[string]string, len(machineDeployments))
+		pausedNames = map[string]bool{}
+		active      []operation.MachineDeployment
+		paused      []operation.MachineDeployment
+	)
+
+	for _, deployment := range machineDeployments {
+		workerNames[deployment.Name] = deployment.WorkerName
+	}
+
+	for _, existingDeployment := range existingDeployments {
+		if existingDeployment.Annotations[common.MachineDeploymentPaused] != "true" {
+			continue
+		}
+		pausedNames[existingDeployment.Name] = true
+		paused = append(paused, operation.MachineDeployment{
+			Name:       existingDeployment.Name,
+			WorkerName: workerNames[existingDeployment.Name],
+			ClassName:  existingDeployment.Spec.Template.Spec.Class.Name,
+		})
+	}
+
+	for _, deployment := range machineDeployments {
+		if !pausedNames[deployment.Name] {
+			active = append(active, deployment)
+		}
+	}
+	return active, paused
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("paused machine deployments", func() {
+	Describe("#pausedMachineDeployments", func() {
+		var (
+			deployments []operation.MachineDeployment
+
+			existingDeployment = func(name, class string, annotations map[string]string) machinev1alpha1.MachineDeployment {
+				d := machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations}}
+				d.Spec.Template.Spec.Class.Name = class
+				return d
+			}
+		)
+
+		BeforeEach(func() {
+			deployments = []operation.MachineDeployment{
+				{Name: "shoot-cpu-z1", WorkerName: "cpu", ClassName: "shoot-cpu-z1-fghij", Minimum: 3, Maximum: 5},
+				{Name: "shoot-cpu-z2", WorkerName: "cpu", ClassName: "shoot-cpu-z2-fghij", Minimum: 3, Maximum: 5},
+			}
+		})
+
+		It("should skip the paused machine deployments and keep their current machine classes", func() {
+			active, paused := ExportPausedMachineDeployments(deployments, []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z1", "shoot-cpu-z1-abcde", map[string]string{common.MachineDeploymentPaused: "true"}),
+				existingDeployment("shoot-cpu-z2", "shoot-cpu-z2-abcde", nil),
+			})
+
+			Expect(active).To(Equal([]operation.MachineDeployment{deployments[1]}))
+			Expect(paused).To(Equal([]operation.MachineDeployment{
+				{Name: "shoot-cpu-z1", WorkerName: "cpu", ClassName: "shoot-cpu-z1-abcde"},
+			}))
+		})
+
+		It("should keep the paused machine deployments which are not desired anymore", func() {
+			active, paused := ExportPausedMachineDeployments(deployments, []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-old-z1", "shoot-old-z1-abcde", map[string]string{common.MachineDeploymentPaused: "true"}),
+			})
+
+			Expect(active).To(Equal(deployments))
+			Expect(paused).To(Equal([]operation.MachineDeployment{
+				{Name: "shoot-old-z1", ClassName: "shoot-old-z1-abcde"},
+			}))
+		})
+
+		It("should not skip machine deployments whose pause annotation is not true", func() {
+			active, paused := ExportPausedMachineDeployments(deployments, []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z1", "shoot-cpu-z1-abcde", map[string]string{common.MachineDeploymentPaused: "false"}),
+			})
+
+			Expect(active).To(Equal(deployments))
+			Expect(paused).To(BeEmpty())
+		})
+	})
+})
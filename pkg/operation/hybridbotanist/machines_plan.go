@@ -0,0 +1,243 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/operation"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// MachinePlanAction is the action DeployMachines would perform for a machine resource.
+type MachinePlanAction string
+
+const (
+	// MachinePlanActionCreate indicates that the machine resource would be created.
+	MachinePlanActionCreate MachinePlanAction = "Create"
+	// MachinePlanActionUpdate indicates that the machine resource would be updated.
+	MachinePlanActionUpdate MachinePlanAction = "Update"
+	// MachinePlanActionDelete indicates that the machine resource would be deleted.
+	MachinePlanActionDelete MachinePlanAction = "Delete"
+	// MachinePlanActionNone indicates that the machine resource would not be changed.
+	MachinePlanActionNone MachinePlanAction = "None"
+)
+
+// MachinePlan describes the changes DeployMachines would apply to the machine classes and machine deployments of
+// the Shoot in the Seed for the current Shoot specification.
+type MachinePlan struct {
+	// MachineClasses are the desired and the obsolete machine classes.
+	MachineClasses []MachineClassPlan `json:"machineClasses"`
+	// MachineDeployments are the desired and the obsolete machine deployments.
+	MachineDeployments []MachineDeploymentPlan `json:"machineDeployments"`
+	// ReplacesMachines indicates whether existing machines would be replaced by new ones.
+	ReplacesMachines bool `json:"replacesMachines"`
+}
+
+// MachineClassPlan describes the change of a single machine class. Obsolete machine classes are deleted once the
+// machines of all worker groups have been rolled out.
+type MachineClassPlan struct {
+	// Name is the name of the machine class.
+	Name string `json:"name"`
+	// Action is the action which would be performed for the machine class.
+	Action MachinePlanAction `json:"action"`
+}
+
+// MachineDeploymentPlan describes the change of a single machine deployment.
+type MachineDeploymentPlan struct {
+	// Name is the name of the machine deployment.
+	Name string `json:"name"`
+	// WorkerName is the name of the worker group the machine deployment belongs to. It is empty for obsolete machine
+	// deployments.
+	WorkerName string `json:"workerName,omitempty"`
+	// Action is the action which would be performed for the machine deployment.
+	Action MachinePlanAction `json:"action"`
+	// CurrentClass is the name of the machine class the existing machine deployment uses.
+	CurrentClass string `json:"currentClass,omitempty"`
+	// DesiredClass is the name of the machine class the machine deployment would use.
+	DesiredClass string `json:"desiredClass,omitempty"`
+	// CurrentReplicas is the number of replicas of the existing machine deployment.
+	CurrentReplicas *int32 `json:"currentReplicas,omitempty"`
+	// DesiredReplicas is the number of replicas the machine deployment would have.
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+	// ReplacesMachines indicates whether the existing machines of the machine deployment would be replaced by new
+	// ones because its machine class changes.
+	ReplacesMachines bool `json:"replacesMachines"`
+}
+
+// Summary returns a short description of the changes of the plan.
+func (p *MachinePlan) Summary() string {
+	var (
+		classActions      = map[MachinePlanAction]int{}
+		deploymentActions = map[MachinePlanAction]int{}
+		replacing         []string
+	)
+
+	for _, class := range p.MachineClasses {
+		classActions[class.Action]++
+	}
+	for _, deployment := range p.MachineDeployments {
+		deploymentActions[deployment.Action]++
+		if deployment.ReplacesMachines {
+			replacing = append(replacing, deployment.Name)
+		}
+	}
+
+	summary := fmt.Sprintf("machine classes: %d to create, %d to delete; machine deployments: %d to create, %d to update, %d to delete",
+		classActions[MachinePlanActionCreate], classActions[MachinePlanActionDelete],
+		deploymentActions[MachinePlanActionCreate], deploymentActions[MachinePlanActionUpdate], deploymentActions[MachinePlanActionDelete])
+	if len(replacing) > 0 {
+		summary += fmt.Sprintf("; machines are replaced in: %s", strings.Join(replacing, ", "))
+	}
+	return summary
+}
+
+// PlanMachines computes the machine classes and machine deployments DeployMachines would create, update and delete
+// for the current Shoot specification, without changing any of them. The existing machine resources are read from
+// the Shoot namespace in the Seed.
+func (b *HybridBotanist) PlanMachines() (*MachinePlan, error) {
+	machineClassKind, machineClassPlural, _ := b.ShootCloudBotanist.GetMachineClassInfo()
+
+	machineClassChartValues, machineDeployments, err := b.ShootCloudBotanist.GenerateMachineConfig()
+	if err != nil {
+		return nil, operationerrors.Wrapf(err, "The CloudBotanist failed to generate the machine config: '%s'", err.Error())
+	}
+
+	existingReplicas, err := b.machineDeploymentReplicas()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to determine the replicas of the existing machine deployments: '%s'", err.Error())
+	}
+	values, err := b.generateMachineDeploymentConfig(machineDeployments, machineClassKind, existingReplicas)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to generate the machine deployment config: '%s'", err.Error())
+	}
+	desiredReplicas := map[string]int32{}
+	for _, deploymentValues := range values["machineDeployments"].([]map[string]interface{}) {
+		desiredReplicas[deploymentValues["name"].(string)] = int32(deploymentValues["replicas"].(int))
+	}
+
+	desiredClasses := make([]string, 0, len(machineClassChartValues))
+	for _, classValues := range machineClassChartValues {
+		desiredClasses = append(desiredClasses, classValues["name"].(string))
+	}
+
+	var (
+		machineClassList unstructured.Unstructured
+		existingClasses  []string
+	)
+	if err := b.K8sSeedClient.MachineV1alpha1("GET", machineClassPlural, b.Shoot.SeedNamespace).Do().Into(&machineClassList); err != nil {
+		return nil, err
+	}
+	if err := machineClassList.EachListItem(func(o runtime.Object) error {
+		existingClasses = append(existingClasses, o.(*unstructured.Unstructured).GetName())
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	plan := computeMachinePlan(desiredClasses, existingClasses, machineDeployments, desiredReplicas, machineDeploymentList.Items)
+	b.Logger.Infof("Computed the machine plan (%s)", plan.Summary())
+	return plan, nil
+}
+
+// computeMachinePlan compares the <desiredClasses> with the <existingClasses> and the desired <machineDeployments>
+// (whose number of replicas are given by <desiredReplicas>) with the <existingDeployments> and returns the resulting
+// plan. The desired resources are listed in the given order, followed by the obsolete ones ordered by name.
+func computeMachinePlan(desiredClasses, existingClasses []string, machineDeployments []operation.MachineDeployment, desiredReplicas map[string]int32, existingDeployments []machinev1alpha1.MachineDeployment) *MachinePlan {
+	plan := &MachinePlan{
+		MachineClasses:     []MachineClassPlan{},
+		MachineDeployments: []MachineDeploymentPlan{},
+	}
+
+	existingClassNames := map[string]bool{}
+	for _, name := range existingClasses {
+		existingClassNames[name] = true
+	}
+	desiredClassNames := map[string]bool{}
+	for _, name := range desiredClasses {
+		desiredClassNames[name] = true
+		action := MachinePlanActionCreate
+		if existingClassNames[name] {
+			action = MachinePlanActionNone
+		}
+		plan.MachineClasses = append(plan.MachineClasses, MachineClassPlan{Name: name, Action: action})
+	}
+	obsoleteClasses := []string{}
+	for name := range existingClassNames {
+		if !desiredClassNames[name] {
+			obsoleteClasses = append(obsoleteClasses, name)
+		}
+	}
+	sort.Strings(obsoleteClasses)
+	for _, name := range obsoleteClasses {
+		plan.MachineClasses = append(plan.MachineClasses, MachineClassPlan{Name: name, Action: MachinePlanActionDelete})
+	}
+
+	existing := map[string]*machinev1alpha1.MachineDeployment{}
+	for i := range existingDeployments {
+		existing[existingDeployments[i].Name] = &existingDeployments[i]
+	}
+	for _, machineDeployment := range machineDeployments {
+		replicas := desiredReplicas[machineDeployment.Name]
+		deploymentPlan := MachineDeploymentPlan{
+			Name:            machineDeployment.Name,
+			WorkerName:      machineDeployment.WorkerName,
+			Action:          MachinePlanActionCreate,
+			DesiredClass:    machineDeployment.ClassName,
+			DesiredReplicas: &replicas,
+		}
+		if deployment, ok := existing[machineDeployment.Name]; ok {
+			currentReplicas := deployment.Spec.Replicas
+			deploymentPlan.CurrentClass = deployment.Spec.Template.Spec.Class.Name
+			deploymentPlan.CurrentReplicas = &currentReplicas
+			deploymentPlan.ReplacesMachines = deploymentPlan.CurrentClass != deploymentPlan.DesiredClass && currentReplicas > 0
+			deploymentPlan.Action = MachinePlanActionNone
+			if deploymentPlan.CurrentClass != deploymentPlan.DesiredClass || currentReplicas != replicas {
+				deploymentPlan.Action = MachinePlanActionUpdate
+			}
+		}
+		plan.ReplacesMachines = plan.ReplacesMachines || deploymentPlan.ReplacesMachines
+		plan.MachineDeployments = append(plan.MachineDeployments, deploymentPlan)
+	}
+
+	obsoleteDeployments := []MachineDeploymentPlan{}
+	for name, deployment := range existing {
+		if operation.NameContainedInMachineDeploymentList(name, machineDeployments) {
+			continue
+		}
+		currentReplicas := deployment.Spec.Replicas
+		obsoleteDeployments = append(obsoleteDeployments, MachineDeploymentPlan{
+			Name:            name,
+			Action:          MachinePlanActionDelete,
+			CurrentClass:    deployment.Spec.Template.Spec.Class.Name,
+			CurrentReplicas: &currentReplicas,
+		})
+	}
+	sort.Slice(obsoleteDeployments, func(i, j int) bool { return obsoleteDeployments[i].Name < obsoleteDeployments[j].Name })
+	plan.MachineDeployments = append(plan.MachineDeployments, obsoleteDeployments...)
+
+	return plan
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("machines plan", func() {
+	Describe("#computeMachinePlan", func() {
+		var (
+			int32Ptr = func(i int32) *int32 { return &i }
+
+			existingDeployment = func(name, class string, replicas int32) machinev1alpha1.MachineDeployment {
+				deployment := machinev1alpha1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+				}
+				deployment.Spec.Template.Spec.Class.Name = class
+				return deployment
+			}
+
+			machineDeployments = []operation.MachineDeployment{
+				{Name: "pool-a-z1", WorkerName: "pool-a", ClassName: "pool-a-z1-22222"},
+				{Name: "pool-b-z1", WorkerName: "pool-b", ClassName: "pool-b-z1-33333"},
+				{Name: "pool-c-z1", WorkerName: "pool-c", ClassName: "pool-c-z1-44444"},
+			}
+			desiredReplicas = map[string]int32{"pool-a-z1": 2, "pool-b-z1": 3, "pool-c-z1": 1}
+		)
+
+		It("should compute the resources to create, update and delete", func() {
+			plan := ExportComputeMachinePlan(
+				[]string{"pool-a-z1-22222", "pool-b-z1-33333", "pool-c-z1-44444"},
+				[]string{"pool-old-z1-00000", "pool-a-z1-11111", "pool-b-z1-33333"},
+				machineDeployments,
+				desiredReplicas,
+				[]machinev1alpha1.MachineDeployment{
+					existingDeployment("pool-a-z1", "pool-a-z1-11111", 2),
+					existingDeployment("pool-b-z1", "pool-b-z1-33333", 3),
+					existingDeployment("pool-old-z1", "pool-old-z1-00000", 1),
+				},
+			)
+
+			Expect(plan.MachineClasses).To(Equal([]MachineClassPlan{
+				{Name: "pool-a-z1-22222", Action: MachinePlanActionCreate},
+				{Name: "pool-b-z1-33333", Action: MachinePlanActionNone},
+				{Name: "pool-c-z1-44444", Action: MachinePlanActionCreate},
+				{Name: "pool-a-z1-11111", Action: MachinePlanActionDelete},
+				{Name: "pool-old-z1-00000", Action: MachinePlanActionDelete},
+			}))
+			Expect(plan.MachineDeployments).To(Equal([]MachineDeploymentPlan{
+				{Name: "pool-a-z1", WorkerName: "pool-a", Action: MachinePlanActionUpdate, CurrentClass: "pool-a-z1-11111", DesiredClass: "pool-a-z1-22222", CurrentReplicas: int32Ptr(2), DesiredReplicas: int32Ptr(2), ReplacesMachines: true},
+				{Name: "pool-b-z1", WorkerName: "pool-b", Action: MachinePlanActionNone, CurrentClass: "pool-b-z1-33333", DesiredClass: "pool-b-z1-33333", CurrentReplicas: int32Ptr(3), DesiredReplicas: int32Ptr(3)},
+				{Name: "pool-c-z1", WorkerName: "pool-c", Action: MachinePlanActionCreate, DesiredClass: "pool-c-z1-44444", DesiredReplicas: int32Ptr(1)},
+				{Name: "pool-old-z1", Action: MachinePlanActionDelete, CurrentClass: "pool-old-z1-00000", CurrentReplicas: int32Ptr(1)},
+			}))
+			Expect(plan.ReplacesMachines).To(BeTrue())
+			Expect(plan.Summary()).To(Equal("machine classes: 2 to create, 2 to delete; machine deployments: 1 to create, 1 to update, 1 to delete; machines are replaced in: pool-a-z1"))
+		})
+
+		It("should not replace machines if only the number of replicas changes", func() {
+			plan := ExportComputeMachinePlan(
+				[]string{"pool-a-z1-22222"},
+				[]string{"pool-a-z1-22222"},
+				machineDeployments[:1],
+				map[string]int32{"pool-a-z1": 4},
+				[]machinev1alpha1.MachineDeployment{existingDeployment("pool-a-z1", "pool-a-z1-22222", 2)},
+			)
+
+			Expect(plan.MachineDeployments).To(HaveLen(1))
+			Expect(plan.MachineDeployments[0].Action).To(Equal(MachinePlanActionUpdate))
+			Expect(plan.ReplacesMachines).To(BeFalse())
+		})
+
+		It("should not report a replacement for machine deployments without machines", func() {
+			plan := ExportComputeMachinePlan(
+				[]string{"pool-a-z1-22222"},
+				nil,
+				machineDeployments[:1],
+				map[string]int32{"pool-a-z1": 0},
+				[]machinev1alpha1.MachineDeployment{existingDeployment("pool-a-z1", "pool-a-z1-11111", 0)},
+			)
+
+			Expect(plan.MachineDeployments[0].Action).To(Equal(MachinePlanActionUpdate))
+			Expect(plan.ReplacesMachines).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,171 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"strings"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// rebalanceZoneReplicas returns the given <existingReplicas> where the replicas of the autoscaled machine deployments
+// of every worker group are distributed evenly over its zones again, e.g. after a zone has recovered from an outage
+// during which the cluster-autoscaler scaled up the machine deployments of the other zones. Nothing is rebalanced for
+// hibernated Shoots and for Shoots with the annotation common.ShootDisableZoneRebalancing.
+func (b *HybridBotanist) rebalanceZoneReplicas(machineDeployments []operation.MachineDeployment, existingReplicas map[string]int) (map[string]int, error) {
+	if b.Shoot.Hibernated || b.Shoot.Info.Annotations[common.ShootDisableZoneRebalancing] == "true" {
+		return existingReplicas, nil
+	}
+
+	autoscaled := sets.NewString()
+	for _, deployment := range machineDeployments {
+		if b.machineDeploymentAutoscaled(deployment) {
+			autoscaled.Insert(deployment.Name)
+		}
+	}
+	if autoscaled.Len() < 2 {
+		return existingReplicas, nil
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, rebalanced := zoneRebalancedReplicas(machineDeployments, autoscaled, machineDeploymentList.Items, existingReplicas)
+	if len(rebalanced) > 0 {
+		b.Logger.Infof("Restoring the distribution of the machines over the zones of the worker group(s) %s", strings.Join(rebalanced, ", "))
+	}
+	return replicas, nil
+}
+
+// zoneRebalancedReplicas returns the given <existingReplicas> where the replicas of the <autoscaled> machine
+// deployments of every worker group are distributed evenly over its zones, along with the names of the worker groups
+// which have been rebalanced. A worker group is only rebalanced if all of its autoscaled machine deployments exist,
+// have been rolled out completely and do not report any failures (i.e., all of its zones are healthy), and if their
+// replicas differ by more than one. The total number of replicas of the worker group and the bounds of every machine
+// deployment are kept.
+func zoneRebalancedReplicas(machineDeployments []operation.MachineDeployment, autoscaled sets.String, existingDeployments []machinev1alpha1.MachineDeployment, existingReplicas map[string]int) (map[string]int, []string) {
+	var (
+		existing    = make(map[string]*machinev1alpha1.MachineDeployment, len(existingDeployments))
+		workerNames []string
+		workerZones = map[string][]operation.MachineDeployment{}
+		result      = make(map[string]int, len(existingReplicas))
+		rebalanced  = sets.NewString()
+	)
+
+	for i := range existingDeployments {
+		existing[existingDeployments[i].Name] = &existingDeployments[i]
+	}
+	for name, replicas := range existingReplicas {
+		result[name] = replicas
+	}
+	for _, deployment := range machineDeployments {
+		if !autoscaled.Has(deployment.Name) {
+			continue
+		}
+		if _, ok := workerZones[deployment.WorkerName]; !ok {
+			workerNames = append(workerNames, deployment.WorkerName)
+		}
+		workerZones[deployment.WorkerName] = append(workerZones[deployment.WorkerName], deployment)
+	}
+
+	for _, workerName := range workerNames {
+		zones := workerZones[workerName]
+		if len(zones) < 2 || !zonesHealthy(zones, existing) {
+			continue
+		}
+
+		var (
+			current  = make([]int, len(zones))
+			total    = 0
+			smallest = -1
+			largest  = -1
+		)
+		for i, deployment := range zones {
+			value := existingReplicas[deployment.Name]
+			current[i] = common.AutoscaledMachineDeploymentReplicas(&value, deployment.Minimum, deployment.Maximum)
+			total += current[i]
+			if smallest < 0 || current[i] < smallest {
+				smallest = current[i]
+			}
+			if current[i] > largest {
+				largest = current[i]
+			}
+		}
+		if largest-smallest <= 1 {
+			continue
+		}
+
+		target := evenZoneDistribution(zones, total)
+		for i, deployment := range zones {
+			if target[i] != current[i] {
+				result[deployment.Name] = target[i]
+				rebalanced.Insert(workerName)
+			}
+		}
+	}
+
+	return result, rebalanced.List()
+}
+
+// zonesHealthy returns true if all given machine deployments of the zones of a worker group exist, have been rolled
+// out completely and do not report any failures.
+func zonesHealthy(zones []operation.MachineDeployment, existing map[string]*machinev1alpha1.MachineDeployment) bool {
+	for _, zone := range zones {
+		deployment, ok := existing[zone.Name]
+		if !ok || !machineDeploymentRolledOut(deployment) {
+			return false
+		}
+		if _, hibernated := deployment.Annotations[common.MachineDeploymentHibernatedReplicas]; hibernated {
+			return false
+		}
+		for _, condition := range deployment.Status.Conditions {
+			if condition.Type == machinev1alpha1.MachineDeploymentReplicaFailure && condition.Status == machinev1alpha1.ConditionTrue {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// evenZoneDistribution distributes <total> replicas as evenly as possible over the given machine deployments of the
+// zones of a worker group, within the bounds of every machine deployment. Surplus replicas are assigned to the zones in
+// their order, like the bounds themselves.
+func evenZoneDistribution(zones []operation.MachineDeployment, total int) []int {
+	target := make([]int, len(zones))
+	for i, zone := range zones {
+		target[i] = zone.Minimum
+		total -= zone.Minimum
+	}
+
+	for ; total > 0; total-- {
+		next := -1
+		for i, zone := range zones {
+			if target[i] < zone.Maximum && (next < 0 || target[i] < target[next]) {
+				next = i
+			}
+		}
+		if next < 0 {
+			break
+		}
+		target[next]++
+	}
+	return target
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("zone rebalancing", func() {
+	Describe("#zoneRebalancedReplicas", func() {
+		var (
+			deployments []operation.MachineDeployment
+			autoscaled  sets.String
+
+			healthyDeployment = func(name string, replicas int32) machinev1alpha1.MachineDeployment {
+				return machinev1alpha1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name},
+					Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+					Status: machinev1alpha1.MachineDeploymentStatus{
+						Replicas:        replicas,
+						ReadyReplicas:   replicas,
+						UpdatedReplicas: replicas,
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			deployments = []operation.MachineDeployment{
+				{Name: "pool-a-z1", WorkerName: "pool-a", Minimum: 1, Maximum: 4},
+				{Name: "pool-a-z2", WorkerName: "pool-a", Minimum: 1, Maximum: 4},
+				{Name: "pool-a-z3", WorkerName: "pool-a", Minimum: 0, Maximum: 3},
+				{Name: "pool-b-z1", WorkerName: "pool-b", Minimum: 1, Maximum: 1},
+			}
+			autoscaled = sets.NewString("pool-a-z1", "pool-a-z2", "pool-a-z3")
+		})
+
+		It("should restore the even distribution once all zones are healthy", func() {
+			existing := []machinev1alpha1.MachineDeployment{
+				healthyDeployment("pool-a-z1", 4),
+				healthyDeployment("pool-a-z2", 3),
+				healthyDeployment("pool-a-z3", 0),
+				healthyDeployment("pool-b-z1", 1),
+			}
+
+			replicas, rebalanced := ExportZoneRebalancedReplicas(deployments, autoscaled, existing, map[string]int{"pool-a-z1": 4, "pool-a-z2": 3, "pool-a-z3": 0, "pool-b-z1": 1})
+
+			Expect(rebalanced).To(ConsistOf("pool-a"))
+			Expect(replicas).To(Equal(map[string]int{"pool-a-z1": 3, "pool-a-z2": 2, "pool-a-z3": 2, "pool-b-z1": 1}))
+		})
+
+		It("should keep the bounds of the machine deployments", func() {
+			deployments[2].Maximum = 1
+			existing := []machinev1alpha1.MachineDeployment{
+				healthyDeployment("pool-a-z1", 4),
+				healthyDeployment("pool-a-z2", 1),
+				healthyDeployment("pool-a-z3", 0),
+			}
+
+			replicas, _ := ExportZoneRebalancedReplicas(deployments, autoscaled, existing, map[string]int{"pool-a-z1": 4, "pool-a-z2": 1, "pool-a-z3": 0})
+
+			Expect(replicas).To(Equal(map[string]int{"pool-a-z1": 2, "pool-a-z2": 2, "pool-a-z3": 1}))
+		})
+
+		It("should not rebalance as long as a zone is unhealthy", func() {
+			unhealthy := healthyDeployment("pool-a-z3", 1)
+			unhealthy.Status.ReadyReplicas = 0
+			failing := healthyDeployment("pool-a-z3", 0)
+			failing.Status.Conditions = []machinev1alpha1.MachineDeploymentCondition{
+				{Type: machinev1alpha1.MachineDeploymentReplicaFailure, Status: machinev1alpha1.ConditionTrue},
+			}
+			existingReplicas := map[string]int{"pool-a-z1": 4, "pool-a-z2": 3, "pool-a-z3": 0}
+
+			for _, zone := range []machinev1alpha1.MachineDeployment{unhealthy, failing} {
+				replicas, rebalanced := ExportZoneRebalancedReplicas(deployments, autoscaled, []machinev1alpha1.MachineDeployment{
+					healthyDeployment("pool-a-z1", 4),
+					healthyDeployment("pool-a-z2", 3),
+					zone,
+				}, existingReplicas)
+
+				Expect(rebalanced).To(BeEmpty())
+				Expect(replicas).To(Equal(existingReplicas))
+			}
+
+			_, rebalanced := ExportZoneRebalancedReplicas(deployments, autoscaled, []machinev1alpha1.MachineDeployment{
+				healthyDeployment("pool-a-z1", 4),
+				healthyDeployment("pool-a-z2", 3),
+			}, existingReplicas)
+			Expect(rebalanced).To(BeEmpty())
+		})
+
+		It("should not rebalance worker groups whose replicas differ by at most one", func() {
+			existing := []machinev1alpha1.MachineDeployment{
+				healthyDeployment("pool-a-z1", 2),
+				healthyDeployment("pool-a-z2", 3),
+				healthyDeployment("pool-a-z3", 2),
+			}
+			existingReplicas := map[string]int{"pool-a-z1": 2, "pool-a-z2": 3, "pool-a-z3": 2}
+
+			replicas, rebalanced := ExportZoneRebalancedReplicas(deployments, autoscaled, existing, existingReplicas)
+
+			Expect(rebalanced).To(BeEmpty())
+			Expect(replicas).To(Equal(existingReplicas))
+		})
+	})
+})
@@ -0,0 +1,165 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+)
+
+// migrateRenamedWorkers migrates the workload of the worker groups which have been renamed (see
+// common.ShootRenameWorkers) to the worker groups with the new names, which must have been rolled out before. The
+// nodes of a renamed worker group are cordoned, and its machine deployments are scaled down machine by machine, so
+// that the machine-controller-manager drains one node after the other instead of all at once. The machine deployments
+// are left with zero replicas and are deleted by the cleanup afterwards.
+func (b *HybridBotanist) migrateRenamedWorkers(ctx context.Context) error {
+	if b.Shoot.Hibernated {
+		return nil
+	}
+	renames := workerRenames(b.Shoot.Info.Annotations[common.ShootRenameWorkers], b.Shoot.GetWorkerNames())
+	if len(renames) == 0 {
+		return nil
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	oldNames := make([]string, 0, len(renames))
+	for oldName := range renames {
+		oldNames = append(oldNames, oldName)
+	}
+	sort.Strings(oldNames)
+
+	for _, oldName := range oldNames {
+		deployments := renamedWorkerMachineDeployments(b.Shoot.SeedNamespace, oldName, machineDeploymentList.Items)
+		if len(deployments) == 0 {
+			continue
+		}
+
+		b.Logger.Infof("Migrating the workload of worker group %s to the worker group %s", oldName, renames[oldName])
+		if err := b.cordonWorkerNodes(oldName); err != nil {
+			return fmt.Errorf("cordoning the nodes of worker group %s failed: %s", oldName, err.Error())
+		}
+
+		for _, deployment := range deployments {
+			for replicas := deployment.Spec.Replicas - 1; replicas >= 0; replicas-- {
+				b.Logger.Infof("Scaling down machine deployment %s of the renamed worker group %s to %d machine(s)", deployment.Name, oldName, replicas)
+				if err := b.scaleDownRenamedMachineDeployment(ctx, deployment.Name, replicas); err != nil {
+					return fmt.Errorf("scaling down machine deployment %s of the renamed worker group %s failed: %s", deployment.Name, oldName, err.Error())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// workerRenames returns the renames of worker groups requested with the common.ShootRenameWorkers annotation with the
+// given <value> (new names keyed by old names) whose new names are contained in the <workerNames> while their old
+// names are not. An invalid value does not rename any worker group.
+func workerRenames(value string, workerNames []string) map[string]string {
+	renames, err := common.ParseWorkerRenames(value)
+	if err != nil {
+		return nil
+	}
+
+	var (
+		known  = sets.NewString(workerNames...)
+		result = map[string]string{}
+	)
+	for oldName, newName := range renames {
+		if known.Has(newName) && !known.Has(oldName) {
+			result[oldName] = newName
+		}
+	}
+	return result
+}
+
+// renamedWorkerMachineDeployments returns those of the <existingDeployments> of the Shoot with the given
+// <technicalID> which belong to the worker group with the given <oldName> and still have machines, in the order of
+// their names. Machine deployments which have been paused by an operator are not migrated.
+func renamedWorkerMachineDeployments(technicalID, oldName string, existingDeployments []machinev1alpha1.MachineDeployment) []machinev1alpha1.MachineDeployment {
+	var deployments []machinev1alpha1.MachineDeployment
+	for _, deployment := range existingDeployments {
+		if machineDeploymentOfWorker(technicalID, oldName, deployment.Name) && deployment.Spec.Replicas > 0 && deployment.Annotations[common.MachineDeploymentPaused] != "true" {
+			deployments = append(deployments, deployment)
+		}
+	}
+	sort.Slice(deployments, func(i, j int) bool {
+		return deployments[i].Name < deployments[j].Name
+	})
+	return deployments
+}
+
+// cordonWorkerNodes marks the nodes of the worker group with the given <workerName> as unschedulable, so that the
+// pods which are evicted from them are scheduled onto the nodes of other worker groups.
+func (b *HybridBotanist) cordonWorkerNodes(workerName string) error {
+	if b.K8sShootClient == nil {
+		return nil
+	}
+
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", common.WorkerGroupLabel, workerName)})
+	if err != nil {
+		return err
+	}
+	for _, node := range nodeList.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if _, err := b.K8sShootClient.Clientset().CoreV1().Nodes().Patch(node.Name, types.StrategicMergePatchType, []byte(`{"spec":{"unschedulable":true}}`)); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// scaleDownRenamedMachineDeployment sets the replicas of the machine deployment with the given <name> to <replicas>
+// and waits until the machine-controller-manager has drained and deleted the superfluous machines.
+func (b *HybridBotanist) scaleDownRenamedMachineDeployment(ctx context.Context, name string, replicas int32) error {
+	body := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	if _, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).Patch(name, types.MergePatchType, []byte(body)); err != nil {
+		return err
+	}
+
+	err := b.waitForMachineResources(ctx, func(listers map[string]cache.GenericLister) (bool, error) {
+		objects, err := listers["machinedeployments"].List(labels.Everything())
+		if err != nil {
+			return false, err
+		}
+		for _, object := range objects {
+			if deployment, ok := object.(*machinev1alpha1.MachineDeployment); ok && deployment.Name == name {
+				return deployment.Status.Replicas <= replicas, nil
+			}
+		}
+		return true, nil
+	}, "machinedeployments")
+
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("the machines have not been deleted within %s", b.machineWaitTimeout())
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("renames of worker groups", func() {
+	Describe("#workerRenames", func() {
+		It("should return the renames whose new names are worker groups while their old names are not", func() {
+			Expect(ExportWorkerRenames("cpu=compute,gpu=accelerated,spot=cheap", []string{"compute", "gpu", "accelerated"})).To(Equal(map[string]string{
+				"cpu": "compute",
+			}))
+		})
+
+		It("should not rename worker groups for an invalid annotation", func() {
+			Expect(ExportWorkerRenames("cpu=compute,cpu=other", []string{"compute", "other"})).To(BeEmpty())
+		})
+
+		It("should not rename worker groups for an empty annotation", func() {
+			Expect(ExportWorkerRenames("", []string{"cpu"})).To(BeEmpty())
+		})
+	})
+
+	Describe("#renamedWorkerMachineDeployments", func() {
+		var existingDeployment = func(name string, replicas int32, paused bool) machinev1alpha1.MachineDeployment {
+			deployment := machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+			}
+			if paused {
+				deployment.Annotations = map[string]string{common.MachineDeploymentPaused: "true"}
+			}
+			return deployment
+		}
+
+		It("should return the machine deployments of the old worker group which still have machines", func() {
+			deployments := ExportRenamedWorkerMachineDeployments("shoot", "cpu", []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z2", 2, false),
+				existingDeployment("shoot-cpu-z1", 1, false),
+				existingDeployment("shoot-cpu-z3", 0, false),
+				existingDeployment("shoot-cpu-z4", 3, true),
+				existingDeployment("shoot-compute-z1", 3, false),
+				existingDeployment("shoot-cpu-z1-z1", 3, false),
+			})
+
+			var names []string
+			for _, deployment := range deployments {
+				names = append(names, deployment.Name)
+			}
+			Expect(names).To(Equal([]string{"shoot-cpu-z1", "shoot-cpu-z2"}))
+		})
+	})
+})
@@ -0,0 +1,98 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// computeMachineRestarts determines the times of the last requested restarts of the worker groups and stores them
+// (keyed by the names of the worker groups) in the Shoot so that they are considered for the names of the machine
+// classes. They are only determined once per operation, hence, a retried rollout does not restart the machines again.
+func (b *HybridBotanist) computeMachineRestarts() error {
+	if b.Shoot.MachineRestarts != nil {
+		return nil
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	requested := restartRequestedWorkerNames(b.Shoot.Info.Annotations[common.ShootRestartWorkers], b.Shoot.GetWorkerNames())
+	if len(requested) > 0 {
+		b.Logger.Infof("A restart of the worker groups %s has been requested, their machines are replaced by a rolling update", strings.Join(requested.List(), ", "))
+	}
+
+	b.Shoot.MachineRestarts = machineRestarts(b.Shoot.SeedNamespace, b.Shoot.GetWorkerNames(), requested, machineDeploymentList.Items, time.Now())
+	return nil
+}
+
+// restartRequestedWorkerNames returns the names of the given <workerNames> which are contained in the comma-separated
+// list of the common.ShootRestartWorkers annotation with the given <value>. Unknown names are ignored.
+func restartRequestedWorkerNames(value string, workerNames []string) sets.String {
+	var (
+		known     = sets.NewString(workerNames...)
+		requested = sets.NewString()
+	)
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); known.Has(name) {
+			requested.Insert(name)
+		}
+	}
+	return requested
+}
+
+// machineRestarts returns the times of the last restarts of the worker groups with the given <workerNames> keyed by
+// their names. The worker groups whose restart has been <requested> are restarted at <now>, the others keep the time
+// recorded on their <existingDeployments> in the Seed of the Shoot with the given <technicalID> (if any).
+func machineRestarts(technicalID string, workerNames []string, requested sets.String, existingDeployments []machinev1alpha1.MachineDeployment, now time.Time) map[string]string {
+	restarts := map[string]string{}
+	for _, workerName := range workerNames {
+		if requested.Has(workerName) {
+			restarts[workerName] = now.UTC().Format(time.RFC3339)
+			continue
+		}
+		for _, deployment := range existingDeployments {
+			if restartedAt := deployment.Annotations[common.MachineDeploymentRestartedAt]; len(restartedAt) > 0 && machineDeploymentOfWorker(technicalID, workerName, deployment.Name) {
+				restarts[workerName] = restartedAt
+				break
+			}
+		}
+	}
+	return restarts
+}
+
+// machineDeploymentOfWorker returns true if the machine deployment with the given <name> belongs to the worker group
+// with the given <workerName> of the Shoot with the given <technicalID>, i.e. if it is named after the worker group
+// with or without the suffix of an availability zone.
+func machineDeploymentOfWorker(technicalID, workerName, name string) bool {
+	prefix := common.MachineDeploymentName(technicalID, workerName)
+	if name == prefix {
+		return true
+	}
+	if !strings.HasPrefix(name, prefix+"-z") {
+		return false
+	}
+	zone, err := strconv.Atoi(strings.TrimPrefix(name, prefix+"-z"))
+	return err == nil && zone > 0
+}
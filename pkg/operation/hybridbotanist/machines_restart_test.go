@@ -0,0 +1,88 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("restarts of worker groups", func() {
+	Describe("#restartRequestedWorkerNames", func() {
+		It("should return the known worker groups of the annotation", func() {
+			Expect(ExportRestartRequestedWorkerNames(" cpu, gpu ,unknown,", []string{"cpu", "gpu", "spot"}).List()).To(Equal([]string{"cpu", "gpu"}))
+		})
+
+		It("should return no worker groups for an empty annotation", func() {
+			Expect(ExportRestartRequestedWorkerNames("", []string{"cpu"}).Len()).To(BeZero())
+		})
+	})
+
+	Describe("#machineRestarts", func() {
+		var (
+			now = time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+			existingDeployment = func(name, restartedAt string) machinev1alpha1.MachineDeployment {
+				return machinev1alpha1.MachineDeployment{ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Annotations: map[string]string{common.MachineDeploymentRestartedAt: restartedAt},
+				}}
+			}
+		)
+
+		It("should restart the requested worker groups now and keep the recorded restarts of the others", func() {
+			restarts := ExportMachineRestarts("shoot", []string{"cpu", "gpu", "spot"}, sets.NewString("cpu"), []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z1", "2018-05-01T10:00:00Z"),
+				existingDeployment("shoot-gpu-z2", "2018-05-02T10:00:00Z"),
+				existingDeployment("shoot-spot-z1", ""),
+			}, now)
+
+			Expect(restarts).To(Equal(map[string]string{
+				"cpu": "2018-06-01T12:00:00Z",
+				"gpu": "2018-05-02T10:00:00Z",
+			}))
+		})
+
+		It("should not mix up worker groups with common name prefixes", func() {
+			restarts := ExportMachineRestarts("shoot", []string{"cpu", "cpu-z1"}, sets.NewString(), []machinev1alpha1.MachineDeployment{
+				existingDeployment("shoot-cpu-z1-z1", "2018-05-01T10:00:00Z"),
+			}, now)
+
+			Expect(restarts).To(Equal(map[string]string{"cpu-z1": "2018-05-01T10:00:00Z"}))
+		})
+	})
+
+	Describe("#machineDeploymentOfWorker", func() {
+		It("should match the zoned and unzoned machine deployments of the worker group", func() {
+			Expect(ExportMachineDeploymentOfWorker("shoot", "cpu", "shoot-cpu")).To(BeTrue())
+			Expect(ExportMachineDeploymentOfWorker("shoot", "cpu", "shoot-cpu-z3")).To(BeTrue())
+		})
+
+		It("should not match the machine deployments of other worker groups", func() {
+			Expect(ExportMachineDeploymentOfWorker("shoot", "cpu", "shoot-cpu-canary")).To(BeFalse())
+			Expect(ExportMachineDeploymentOfWorker("shoot", "cpu", "shoot-cpu-zone-z1")).To(BeFalse())
+			Expect(ExportMachineDeploymentOfWorker("shoot", "cpu", "shoot-gpu-z1")).To(BeFalse())
+		})
+	})
+})
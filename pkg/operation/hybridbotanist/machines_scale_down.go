@@ -0,0 +1,223 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// defaultScaleDownBudgetPeriod is the period of a scale-down budget which does not configure one.
+const defaultScaleDownBudgetPeriod = 10 * time.Minute
+
+// scaleDownRecord is a record of the machines which have been removed by a scale-down of a MachineDeployment.
+type scaleDownRecord struct {
+	WorkerName string      `json:"workerName"`
+	Name       string      `json:"name"`
+	Machines   int         `json:"machines"`
+	Timestamp  metav1.Time `json:"timestamp"`
+}
+
+// limitScaleDowns computes the minimum replicas of the given <machineDeployments> whose desired replicas decrease by
+// more machines than the scale-down budgets of the Shoot and of their worker groups allow. The machines removed by
+// scale-downs are recorded in the machine scale-downs config map in the Shoot namespace of the Seed, so that the
+// budgets hold across reconciliations. Hibernated Shoots are scaled down without limits.
+func (b *HybridBotanist) limitScaleDowns(machineDeployments []operation.MachineDeployment, existingReplicas map[string]int) error {
+	b.scaleDownLimits = nil
+	if b.Shoot.Hibernated {
+		return nil
+	}
+
+	var (
+		clusterBudget = b.Shoot.Info.Spec.Cloud.ScaleDownBudget
+		workers       = b.Shoot.GetWorkers()
+		budgets       = []*gardenv1beta1.ScaleDownBudget{clusterBudget}
+	)
+	for _, worker := range workers {
+		budgets = append(budgets, worker.ScaleDownBudget)
+	}
+	maxPeriod := scaleDownBudgetsMaxPeriod(budgets)
+	if maxPeriod == 0 {
+		return nil
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	desired := make(map[string]int, len(machineDeployments))
+	for _, deployment := range machineDeployments {
+		desired[deployment.Name], _ = b.desiredMachineDeploymentReplicas(deployment, existingReplicas)
+	}
+
+	var history []scaleDownRecord
+	configMap, err := b.K8sSeedClient.GetConfigMap(b.Shoot.SeedNamespace, common.MachineScaleDownsConfigMapName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err == nil {
+		if data, ok := configMap.Data[common.MachineScaleDownsConfigMapKey]; ok {
+			if err := json.Unmarshal([]byte(data), &history); err != nil {
+				b.Logger.Warnf("Discarding the unreadable machine scale-downs: %s", err.Error())
+				history = nil
+			}
+		}
+	}
+
+	now := time.Now()
+	limits, records := scaleDownLimits(machineDeployments, workers, clusterBudget, machineDeploymentList.Items, desired, history, now)
+	for name, limit := range limits {
+		b.Logger.Infof("Scaling down machine deployment %s to %d instead of %d replicas because of the scale-down budgets", name, limit, desired[name])
+	}
+
+	if len(records) > 0 {
+		history = pruneScaleDownHistory(append(history, records...), now.Add(-maxPeriod))
+		data, err := json.Marshal(history)
+		if err != nil {
+			return err
+		}
+		if _, err := b.K8sSeedClient.CreateConfigMap(b.Shoot.SeedNamespace, common.MachineScaleDownsConfigMapName, map[string]string{
+			common.MachineScaleDownsConfigMapKey: string(data),
+		}, true); err != nil {
+			return err
+		}
+	}
+
+	b.scaleDownLimits = limits
+	return nil
+}
+
+// scaleDownLimits returns the minimum replicas of the <machineDeployments> whose <desired> replicas are lower than
+// the replicas of the <existingDeployments> by more machines than the budgets allow, along with the records of the
+// machines which are removed now. A budget allows to remove its maximum number of machines minus the machines which
+// have been removed according to the <history> within its period before <now>. The <clusterBudget> applies to all
+// machine deployments, the budgets of the <workers> to the machine deployments of the respective worker group.
+func scaleDownLimits(machineDeployments []operation.MachineDeployment, workers []gardenv1beta1.Worker, clusterBudget *gardenv1beta1.ScaleDownBudget, existingDeployments []machinev1alpha1.MachineDeployment, desired map[string]int, history []scaleDownRecord, now time.Time) (map[string]int, []scaleDownRecord) {
+	var (
+		limits  = map[string]int{}
+		records []scaleDownRecord
+
+		current          = make(map[string]int, len(existingDeployments))
+		clusterRemaining = scaleDownBudgetRemaining(clusterBudget, "", history, now)
+		workerRemaining  = make(map[string]int, len(workers))
+	)
+
+	for _, deployment := range existingDeployments {
+		current[deployment.Name] = int(deployment.Spec.Replicas)
+	}
+	for _, worker := range workers {
+		workerRemaining[worker.Name] = scaleDownBudgetRemaining(worker.ScaleDownBudget, worker.Name, history, now)
+	}
+
+	sorted := append([]operation.MachineDeployment{}, machineDeployments...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	for _, deployment := range sorted {
+		replicas, ok := current[deployment.Name]
+		if !ok || desired[deployment.Name] >= replicas {
+			continue
+		}
+
+		machines := replicas - desired[deployment.Name]
+		if remaining, ok := workerRemaining[deployment.WorkerName]; ok && remaining < machines {
+			machines = remaining
+		}
+		if clusterRemaining < machines {
+			machines = clusterRemaining
+		}
+
+		if machines < replicas-desired[deployment.Name] {
+			limits[deployment.Name] = replicas - machines
+		}
+		if machines > 0 {
+			records = append(records, scaleDownRecord{
+				WorkerName: deployment.WorkerName,
+				Name:       deployment.Name,
+				Machines:   machines,
+				Timestamp:  metav1.NewTime(now),
+			})
+			clusterRemaining -= machines
+			if _, ok := workerRemaining[deployment.WorkerName]; ok {
+				workerRemaining[deployment.WorkerName] -= machines
+			}
+		}
+	}
+
+	return limits, records
+}
+
+// scaleDownBudgetRemaining returns the number of machines which the <budget> still allows to remove at <now>, given
+// the machines removed according to the <history>. Only the records of the worker group <workerName> are considered
+// unless it is empty. A nil budget is unlimited.
+func scaleDownBudgetRemaining(budget *gardenv1beta1.ScaleDownBudget, workerName string, history []scaleDownRecord, now time.Time) int {
+	if budget == nil {
+		return math.MaxInt32
+	}
+
+	var (
+		since   = now.Add(-scaleDownBudgetPeriod(budget))
+		removed = 0
+	)
+	for _, record := range history {
+		if (len(workerName) == 0 || record.WorkerName == workerName) && record.Timestamp.Time.After(since) {
+			removed += record.Machines
+		}
+	}
+
+	if removed >= budget.MaxMachines {
+		return 0
+	}
+	return budget.MaxMachines - removed
+}
+
+// scaleDownBudgetPeriod returns the period of the given <budget>.
+func scaleDownBudgetPeriod(budget *gardenv1beta1.ScaleDownBudget) time.Duration {
+	if budget.Period != nil {
+		return budget.Period.Duration
+	}
+	return defaultScaleDownBudgetPeriod
+}
+
+// scaleDownBudgetsMaxPeriod returns the longest period of the given <budgets>, or zero if all of them are nil.
+func scaleDownBudgetsMaxPeriod(budgets []*gardenv1beta1.ScaleDownBudget) time.Duration {
+	var maxPeriod time.Duration
+	for _, budget := range budgets {
+		if budget != nil && scaleDownBudgetPeriod(budget) > maxPeriod {
+			maxPeriod = scaleDownBudgetPeriod(budget)
+		}
+	}
+	return maxPeriod
+}
+
+// pruneScaleDownHistory drops the records of the <history> which are not newer than <since>, as they do not count
+// against any budget anymore.
+func pruneScaleDownHistory(history []scaleDownRecord, since time.Time) []scaleDownRecord {
+	var result []scaleDownRecord
+	for _, record := range history {
+		if record.Timestamp.Time.After(since) {
+			result = append(result, record)
+		}
+	}
+	return result
+}
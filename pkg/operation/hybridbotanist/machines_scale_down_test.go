@@ -0,0 +1,135 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("scale-down budgets", func() {
+	var (
+		now = time.Date(2018, 9, 1, 12, 0, 0, 0, time.UTC)
+
+		deployments []operation.MachineDeployment
+		workers     []gardenv1beta1.Worker
+		existing    []machinev1alpha1.MachineDeployment
+
+		existingDeployment = func(name string, replicas int32) machinev1alpha1.MachineDeployment {
+			return machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+			}
+		}
+		record = func(workerName, name string, machines int, age time.Duration) ExportScaleDownRecord {
+			return ExportScaleDownRecord{WorkerName: workerName, Name: name, Machines: machines, Timestamp: metav1.NewTime(now.Add(-age))}
+		}
+	)
+
+	BeforeEach(func() {
+		deployments = []operation.MachineDeployment{
+			{Name: "pool-a-z1", WorkerName: "pool-a"},
+			{Name: "pool-a-z2", WorkerName: "pool-a"},
+			{Name: "pool-b-z1", WorkerName: "pool-b"},
+		}
+		workers = []gardenv1beta1.Worker{{Name: "pool-a"}, {Name: "pool-b"}}
+		existing = []machinev1alpha1.MachineDeployment{
+			existingDeployment("pool-a-z1", 10),
+			existingDeployment("pool-a-z2", 10),
+			existingDeployment("pool-b-z1", 10),
+		}
+	})
+
+	Describe("#scaleDownLimits", func() {
+		It("should not limit anything without budgets", func() {
+			limits, records := ExportScaleDownLimits(deployments, workers, nil, existing, map[string]int{"pool-a-z1": 0, "pool-a-z2": 2, "pool-b-z1": 10}, nil, now)
+
+			Expect(limits).To(BeEmpty())
+			Expect(records).To(ConsistOf(
+				record("pool-a", "pool-a-z1", 10, 0),
+				record("pool-a", "pool-a-z2", 8, 0),
+			))
+		})
+
+		It("should limit the scale-downs of a worker group to its budget", func() {
+			workers[0].ScaleDownBudget = &gardenv1beta1.ScaleDownBudget{MaxMachines: 5}
+
+			limits, records := ExportScaleDownLimits(deployments, workers, nil, existing, map[string]int{"pool-a-z1": 6, "pool-a-z2": 6, "pool-b-z1": 4}, nil, now)
+
+			Expect(limits).To(Equal(map[string]int{"pool-a-z2": 9}))
+			Expect(records).To(ConsistOf(
+				record("pool-a", "pool-a-z1", 4, 0),
+				record("pool-a", "pool-a-z2", 1, 0),
+				record("pool-b", "pool-b-z1", 6, 0),
+			))
+		})
+
+		It("should limit the scale-downs of all worker groups to the budget of the Shoot", func() {
+			clusterBudget := &gardenv1beta1.ScaleDownBudget{MaxMachines: 3}
+
+			limits, records := ExportScaleDownLimits(deployments, workers, clusterBudget, existing, map[string]int{"pool-a-z1": 8, "pool-a-z2": 8, "pool-b-z1": 8}, nil, now)
+
+			Expect(limits).To(Equal(map[string]int{"pool-a-z2": 9, "pool-b-z1": 10}))
+			Expect(records).To(ConsistOf(
+				record("pool-a", "pool-a-z1", 2, 0),
+				record("pool-a", "pool-a-z2", 1, 0),
+			))
+		})
+
+		It("should consider the machines removed within the period of the budgets", func() {
+			workers[0].ScaleDownBudget = &gardenv1beta1.ScaleDownBudget{MaxMachines: 5, Period: &metav1.Duration{Duration: time.Hour}}
+			history := []ExportScaleDownRecord{
+				record("pool-a", "pool-a-z1", 3, 30*time.Minute),
+				record("pool-a", "pool-a-z2", 4, 2*time.Hour),
+				record("pool-b", "pool-b-z1", 5, time.Minute),
+			}
+
+			limits, records := ExportScaleDownLimits(deployments, workers, nil, existing, map[string]int{"pool-a-z1": 5, "pool-a-z2": 10, "pool-b-z1": 10}, history, now)
+
+			Expect(limits).To(Equal(map[string]int{"pool-a-z1": 8}))
+			Expect(records).To(ConsistOf(record("pool-a", "pool-a-z1", 2, 0)))
+		})
+
+		It("should ignore scale-ups and machine deployments which do not exist yet", func() {
+			clusterBudget := &gardenv1beta1.ScaleDownBudget{MaxMachines: 1}
+			existing = existing[:1]
+
+			limits, records := ExportScaleDownLimits(deployments, workers, clusterBudget, existing, map[string]int{"pool-a-z1": 12, "pool-a-z2": 0, "pool-b-z1": 0}, nil, now)
+
+			Expect(limits).To(BeEmpty())
+			Expect(records).To(BeEmpty())
+		})
+	})
+
+	Describe("#pruneScaleDownHistory", func() {
+		It("should drop the records which do not count against any budget anymore", func() {
+			history := []ExportScaleDownRecord{
+				record("pool-a", "pool-a-z1", 3, 30*time.Minute),
+				record("pool-a", "pool-a-z2", 4, 2*time.Hour),
+			}
+
+			Expect(ExportPruneScaleDownHistory(history, now.Add(-time.Hour))).To(ConsistOf(history[0]))
+		})
+	})
+})
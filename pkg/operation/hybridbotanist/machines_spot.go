@@ -0,0 +1,112 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// spotFallbackRetryInterval is the duration after which a machine deployment which has fallen back to on-demand
+// machines uses the spot machine class again.
+const spotFallbackRetryInterval = time.Hour
+
+// applySpotFallback returns the given <machineDeployments> where the machine classes of the machine deployments of spot
+// worker groups are replaced by their on-demand fallback classes while the cloud provider has no spare capacity.
+func (b *HybridBotanist) applySpotFallback(machineDeployments []operation.MachineDeployment) ([]operation.MachineDeployment, error) {
+	fallback := false
+	for _, deployment := range machineDeployments {
+		if len(deployment.FallbackClassName) > 0 {
+			fallback = true
+			break
+		}
+	}
+	if !fallback {
+		return machineDeployments, nil
+	}
+
+	machineDeploymentList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().MachineDeployments(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	machineList, err := b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	result := spotFallbackMachineDeployments(machineDeployments, machineDeploymentList.Items, machineList.Items, time.Now())
+	for i, deployment := range result {
+		if deployment.ClassName != machineDeployments[i].ClassName {
+			b.Logger.Infof("Machine deployment %s uses on-demand machines because there is no spare capacity for spot machines", deployment.Name)
+		}
+	}
+	return result, nil
+}
+
+// spotFallbackMachineDeployments returns the given <machineDeployments> where the machine class of every machine
+// deployment with a fallback class is replaced by it if a machine of its spot class has failed (e.g., because the cloud
+// provider has no spare capacity), or if it has fallen back less than spotFallbackRetryInterval before <now> according
+// to the <existingDeployments>. The time since which a machine deployment has fallen back is recorded in an annotation,
+// which is always set explicitly because existing annotations are preserved when the machine deployment is updated.
+func spotFallbackMachineDeployments(machineDeployments []operation.MachineDeployment, existingDeployments []machinev1alpha1.MachineDeployment, machines []machinev1alpha1.Machine, now time.Time) []operation.MachineDeployment {
+	var (
+		fallbackSince = map[string]time.Time{}
+		failedClasses = sets.NewString()
+		result        = make([]operation.MachineDeployment, 0, len(machineDeployments))
+	)
+
+	for _, deployment := range existingDeployments {
+		if since, err := time.Parse(time.RFC3339, deployment.Annotations[common.MachineDeploymentSpotFallback]); err == nil {
+			fallbackSince[deployment.Name] = since
+		}
+	}
+	for _, machine := range machines {
+		if machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineFailed {
+			failedClasses.Insert(machine.Spec.Class.Name)
+		}
+	}
+
+	for _, deployment := range machineDeployments {
+		if len(deployment.FallbackClassName) == 0 {
+			result = append(result, deployment)
+			continue
+		}
+
+		annotations := make(map[string]string, len(deployment.Annotations)+1)
+		for key, value := range deployment.Annotations {
+			annotations[key] = value
+		}
+		annotations[common.MachineDeploymentSpotFallback] = ""
+
+		since, fallenBack := fallbackSince[deployment.Name]
+		if !fallenBack || now.Sub(since) >= spotFallbackRetryInterval {
+			fallenBack, since = failedClasses.Has(deployment.ClassName), now
+		}
+		if fallenBack {
+			deployment.ClassName = deployment.FallbackClassName
+			annotations[common.MachineDeploymentSpotFallback] = since.UTC().Format(time.RFC3339)
+		}
+
+		deployment.Annotations = annotations
+		result = append(result, deployment)
+	}
+
+	return result
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("spot machines", func() {
+	Describe("#spotFallbackMachineDeployments", func() {
+		var (
+			now = time.Date(2018, 7, 1, 12, 0, 0, 0, time.UTC)
+
+			deployments []operation.MachineDeployment
+
+			machine = func(class string, phase machinev1alpha1.MachinePhase) machinev1alpha1.Machine {
+				m := machinev1alpha1.Machine{}
+				m.Spec.Class.Name = class
+				m.Status.CurrentStatus.Phase = phase
+				return m
+			}
+			existingDeployment = func(name, fallbackSince string) machinev1alpha1.MachineDeployment {
+				return machinev1alpha1.MachineDeployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:        name,
+						Annotations: map[string]string{common.MachineDeploymentSpotFallback: fallbackSince},
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			deployments = []operation.MachineDeployment{
+				{Name: "regular", ClassName: "regular-abcde"},
+				{Name: "spot", ClassName: "spot-abcde", FallbackClassName: "spot-fghij", Annotations: map[string]string{"foo": "bar"}},
+			}
+		})
+
+		It("should keep the spot machine classes if no spot machine has failed", func() {
+			result := ExportSpotFallbackMachineDeployments(deployments, nil, []machinev1alpha1.Machine{
+				machine("spot-abcde", machinev1alpha1.MachineRunning),
+				machine("regular-abcde", machinev1alpha1.MachineFailed),
+			}, now)
+
+			Expect(result).To(HaveLen(2))
+			Expect(result[0]).To(Equal(deployments[0]))
+			Expect(result[1].ClassName).To(Equal("spot-abcde"))
+			Expect(result[1].Annotations).To(Equal(map[string]string{"foo": "bar", common.MachineDeploymentSpotFallback: ""}))
+		})
+
+		It("should fall back to the on-demand machine class if a spot machine has failed", func() {
+			result := ExportSpotFallbackMachineDeployments(deployments, nil, []machinev1alpha1.Machine{
+				machine("spot-abcde", machinev1alpha1.MachineFailed),
+			}, now)
+
+			Expect(result[1].ClassName).To(Equal("spot-fghij"))
+			Expect(result[1].Annotations).To(HaveKeyWithValue(common.MachineDeploymentSpotFallback, "2018-07-01T12:00:00Z"))
+			Expect(deployments[1].ClassName).To(Equal("spot-abcde"))
+			Expect(deployments[1].Annotations).NotTo(HaveKey(common.MachineDeploymentSpotFallback))
+		})
+
+		It("should keep the on-demand machine class until the retry interval has passed", func() {
+			result := ExportSpotFallbackMachineDeployments(deployments, []machinev1alpha1.MachineDeployment{
+				existingDeployment("spot", "2018-07-01T11:30:00Z"),
+			}, nil, now)
+
+			Expect(result[1].ClassName).To(Equal("spot-fghij"))
+			Expect(result[1].Annotations).To(HaveKeyWithValue(common.MachineDeploymentSpotFallback, "2018-07-01T11:30:00Z"))
+		})
+
+		It("should retry the spot machine class once the retry interval has passed", func() {
+			result := ExportSpotFallbackMachineDeployments(deployments, []machinev1alpha1.MachineDeployment{
+				existingDeployment("spot", "2018-07-01T11:00:00Z"),
+			}, nil, now)
+
+			Expect(result[1].ClassName).To(Equal("spot-abcde"))
+			Expect(result[1].Annotations).To(HaveKeyWithValue(common.MachineDeploymentSpotFallback, ""))
+		})
+	})
+})
@@ -0,0 +1,763 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes/base"
+	machinefake "github.com/gardener/gardener/pkg/client/machine/clientset/versioned/fake"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/testing"
+)
+
+var _ = Describe("machines", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		machineClientset *machinefake.Clientset
+		hybridBotanist   *HybridBotanist
+
+		// configMaps are served by the configMapServer which backs the clientset of the Seed client.
+		configMaps      map[string]*corev1.ConfigMap
+		configMapServer *httptest.Server
+
+		writeStatus = func(w http.ResponseWriter, err *apierrors.StatusError) {
+			status := err.ErrStatus
+			status.Kind, status.APIVersion = "Status", "v1"
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(int(status.Code))
+			json.NewEncoder(w).Encode(status)
+		}
+
+		serveConfigMaps = func(w http.ResponseWriter, r *http.Request) {
+			resource := schema.GroupResource{Resource: "configmaps"}
+
+			if r.Method == http.MethodGet {
+				name := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+				configMap, ok := configMaps[name]
+				if !ok {
+					writeStatus(w, apierrors.NewNotFound(resource, name))
+					return
+				}
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(configMap)
+				return
+			}
+
+			configMap := &corev1.ConfigMap{}
+			if err := json.NewDecoder(r.Body).Decode(configMap); err != nil {
+				writeStatus(w, apierrors.NewBadRequest(err.Error()))
+				return
+			}
+			if _, ok := configMaps[configMap.Name]; ok && r.Method == http.MethodPost {
+				writeStatus(w, apierrors.NewAlreadyExists(resource, configMap.Name))
+				return
+			}
+			configMaps[configMap.Name] = configMap
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(configMap)
+		}
+
+		// patchMachineObjects applies merge patches to the <resource> objects of the fake clientset, whose object reaction
+		// does not support patches, by getting and updating them with the given <objectReaction>.
+		patchMachineObjects = func(objectReaction testing.Reactor, resource string, newObject func() runtime.Object) testing.ReactionFunc {
+			return func(action testing.Action) (bool, runtime.Object, error) {
+				var (
+					patch = action.(testing.PatchAction)
+					gvr   = machinev1alpha1.SchemeGroupVersion.WithResource(resource)
+				)
+
+				_, obj, err := objectReaction.React(testing.NewGetAction(gvr, patch.GetNamespace(), patch.GetName()))
+				if err != nil {
+					return true, nil, err
+				}
+				original, err := json.Marshal(obj)
+				if err != nil {
+					return true, nil, err
+				}
+				patched, err := jsonpatch.MergePatch(original, patch.GetPatch())
+				if err != nil {
+					return true, nil, err
+				}
+				object := newObject()
+				if err := json.Unmarshal(patched, object); err != nil {
+					return true, nil, err
+				}
+				return objectReaction.React(testing.NewUpdateAction(gvr, patch.GetNamespace(), object))
+			}
+		}
+
+		newHybridBotanist = func(objects ...runtime.Object) {
+			machineClientset = machinefake.NewSimpleClientset(objects...)
+			objectReaction := machineClientset.ReactionChain[0]
+			machineClientset.PrependReactor("patch", "machinedeployments", patchMachineObjects(objectReaction, "machinedeployments", func() runtime.Object {
+				return &machinev1alpha1.MachineDeployment{}
+			}))
+			machineClientset.PrependReactor("patch", "machines", patchMachineObjects(objectReaction, "machines", func() runtime.Object {
+				return &machinev1alpha1.Machine{}
+			}))
+
+			configMaps = map[string]*corev1.ConfigMap{}
+			configMapServer = httptest.NewServer(http.HandlerFunc(serveConfigMaps))
+
+			seedClient := &kubernetesbase.Client{}
+			seedClient.SetMachineClientset(machineClientset)
+			seedClient.SetClientset(kubernetes.NewForConfigOrDie(&rest.Config{Host: configMapServer.URL}))
+
+			hybridBotanist = &HybridBotanist{
+				Operation: &operation.Operation{
+					Logger:        logger.NewFieldLogger(logger.NewLogger("info"), "test", "machines"),
+					K8sSeedClient: seedClient,
+					Shoot: &shoot.Shoot{
+						Info: &gardenv1beta1.Shoot{
+							ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+						},
+						SeedNamespace: namespace,
+					},
+				},
+			}
+		}
+
+		machineHistory = func() string {
+			configMap, ok := configMaps[common.MachineHistoryConfigMapName]
+			if !ok {
+				return ""
+			}
+			return configMap.Data[common.MachineHistoryConfigMapKey]
+		}
+
+		machineDeployment = func(name string, replicas int32) *machinev1alpha1.MachineDeployment {
+			return &machinev1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec:       machinev1alpha1.MachineDeploymentSpec{Replicas: replicas},
+			}
+		}
+		machine = func(name string, labels map[string]string) *machinev1alpha1.Machine {
+			return &machinev1alpha1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+			}
+		}
+	)
+
+	AfterEach(func() {
+		if configMapServer != nil {
+			configMapServer.Close()
+		}
+	})
+
+	Describe("#machineDeploymentReplicas", func() {
+		It("should return the replicas of the existing machine deployments", func() {
+			newHybridBotanist(machineDeployment("pool-a", 2), machineDeployment("pool-b", 0))
+
+			replicas, err := ExportMachineDeploymentReplicas(hybridBotanist)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicas).To(Equal(map[string]int{"pool-a": 2, "pool-b": 0}))
+		})
+
+		It("should return the replicas recorded for hibernated machine deployments", func() {
+			hibernated := machineDeployment("pool-a", 0)
+			hibernated.Annotations = map[string]string{common.MachineDeploymentHibernatedReplicas: "3"}
+			newHybridBotanist(hibernated, machineDeployment("pool-b", 1))
+
+			replicas, err := ExportMachineDeploymentReplicas(hybridBotanist)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replicas).To(Equal(map[string]int{"pool-a": 3, "pool-b": 1}))
+		})
+	})
+
+	Describe("#removeHibernatedReplicas", func() {
+		It("should remove the recorded replicas from the machine deployments", func() {
+			woken := machineDeployment("pool-a", 3)
+			woken.Annotations = map[string]string{common.MachineDeploymentHibernatedReplicas: "3", "foo": "bar"}
+			newHybridBotanist(woken, machineDeployment("pool-b", 1))
+
+			Expect(ExportRemoveHibernatedReplicas(hybridBotanist)).To(Succeed())
+
+			deployment, err := machineClientset.MachineV1alpha1().MachineDeployments(namespace).Get("pool-a", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.Annotations).To(Equal(map[string]string{"foo": "bar"}))
+			Expect(deployment.Spec.Replicas).To(Equal(int32(3)))
+		})
+	})
+
+	Describe("#cleanupMachineDeployments", func() {
+		It("should delete the machine deployments which are no longer desired", func() {
+			newHybridBotanist(machineDeployment("pool-a", 1), machineDeployment("pool-b", 1))
+			cleanedUp := ExportCounterValue("cleanedUp", "bar", "garden-foo", "machinedeployment")
+
+			Expect(ExportCleanupMachineDeployments(hybridBotanist, context.TODO(), []operation.MachineDeployment{{Name: "pool-a"}}, "NotDesired")).To(Succeed())
+			Expect(ExportCounterValue("cleanedUp", "bar", "garden-foo", "machinedeployment")).To(Equal(cleanedUp + 1))
+
+			list, err := machineClientset.MachineV1alpha1().MachineDeployments(namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.Items).To(HaveLen(1))
+			Expect(list.Items[0].Name).To(Equal("pool-a"))
+		})
+
+		It("should delete the machine deployments of zones which are no longer desired", func() {
+			newHybridBotanist(machineDeployment("pool-a-z1", 1), machineDeployment("pool-a-z2", 1), machineDeployment("pool-a-z3", 1))
+
+			Expect(ExportCleanupMachineDeployments(hybridBotanist, context.TODO(), []operation.MachineDeployment{{Name: "pool-a-z1"}, {Name: "pool-a-z2"}}, "NotDesired")).To(Succeed())
+
+			list, err := machineClientset.MachineV1alpha1().MachineDeployments(namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.Items).To(HaveLen(2))
+			Expect([]string{list.Items[0].Name, list.Items[1].Name}).To(ConsistOf("pool-a-z1", "pool-a-z2"))
+		})
+
+		It("should record the deleted machine deployments in the machine history", func() {
+			deployment := machineDeployment("pool-b", 3)
+			deployment.Spec.Template.Spec.Class.Name = "pool-b-1234"
+			newHybridBotanist(machineDeployment("pool-a", 1), deployment)
+
+			Expect(ExportCleanupMachineDeployments(hybridBotanist, context.TODO(), []operation.MachineDeployment{{Name: "pool-a"}}, "NotDesired")).To(Succeed())
+
+			var history []map[string]interface{}
+			Expect(json.Unmarshal([]byte(machineHistory()), &history)).To(Succeed())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0]).To(HaveKeyWithValue("kind", "MachineDeployment"))
+			Expect(history[0]).To(HaveKeyWithValue("name", "pool-b"))
+			Expect(history[0]).To(HaveKeyWithValue("class", "pool-b-1234"))
+			Expect(history[0]).To(HaveKeyWithValue("replicas", BeNumerically("==", 3)))
+			Expect(history[0]).To(HaveKeyWithValue("reason", "NotDesired"))
+			Expect(history[0]).To(HaveKey("timestamp"))
+		})
+
+		It("should append to the existing machine history", func() {
+			newHybridBotanist(machineDeployment("pool-a", 1), machineDeployment("pool-b", 1))
+
+			Expect(ExportCleanupMachineDeployments(hybridBotanist, context.TODO(), []operation.MachineDeployment{{Name: "pool-a"}}, "NotDesired")).To(Succeed())
+			Expect(ExportCleanupMachineDeployments(hybridBotanist, context.TODO(), nil, "ShootDeletion")).To(Succeed())
+
+			var history []map[string]interface{}
+			Expect(json.Unmarshal([]byte(machineHistory()), &history)).To(Succeed())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0]).To(HaveKeyWithValue("name", "pool-b"))
+			Expect(history[1]).To(HaveKeyWithValue("name", "pool-a"))
+			Expect(history[1]).To(HaveKeyWithValue("reason", "ShootDeletion"))
+		})
+
+		It("should not record anything if no machine deployment is deleted", func() {
+			newHybridBotanist(machineDeployment("pool-a", 1))
+
+			Expect(ExportCleanupMachineDeployments(hybridBotanist, context.TODO(), []operation.MachineDeployment{{Name: "pool-a"}}, "NotDesired")).To(Succeed())
+
+			Expect(configMaps).NotTo(HaveKey(common.MachineHistoryConfigMapName))
+		})
+	})
+
+	Describe("#labelMachinesForForceDeletion", func() {
+		It("should label all machines for the force deletion", func() {
+			newHybridBotanist(machine("machine-a", nil), machine("machine-b", map[string]string{"foo": "bar"}))
+
+			Expect(ExportLabelMachinesForForceDeletion(hybridBotanist, context.TODO())).To(Succeed())
+
+			list, err := machineClientset.MachineV1alpha1().Machines(namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.Items).To(HaveLen(2))
+			for _, m := range list.Items {
+				Expect(m.Labels).To(HaveKeyWithValue("force-deletion", "True"))
+			}
+
+			m, err := machineClientset.MachineV1alpha1().Machines(namespace).Get("machine-b", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Labels).To(HaveKeyWithValue("foo", "bar"))
+		})
+
+		It("should label the machines with a single worker and skip already labeled machines", func() {
+			newHybridBotanist(
+				machine("machine-a", nil),
+				machine("machine-b", map[string]string{"force-deletion": "True"}),
+				machine("machine-c", nil),
+			)
+			hybridBotanist.MachineForceDeletionConcurrency = 1
+
+			Expect(ExportLabelMachinesForForceDeletion(hybridBotanist, context.TODO())).To(Succeed())
+
+			var patched []string
+			for _, action := range machineClientset.Actions() {
+				if action.GetVerb() == "patch" {
+					patched = append(patched, action.(testing.PatchAction).GetName())
+				}
+			}
+			Expect(patched).To(ConsistOf("machine-a", "machine-c"))
+
+			list, err := machineClientset.MachineV1alpha1().Machines(namespace).List(metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			for _, m := range list.Items {
+				Expect(m.Labels).To(HaveKeyWithValue("force-deletion", "True"))
+			}
+		})
+
+		It("should retry the patch of a machine on conflicts", func() {
+			newHybridBotanist(machine("machine-a", nil))
+			conflicts := 0
+			machineClientset.PrependReactor("patch", "machines", func(action testing.Action) (bool, runtime.Object, error) {
+				if conflicts < 2 {
+					conflicts++
+					return true, nil, apierrors.NewConflict(machinev1alpha1.Resource("machines"), "machine-a", fmt.Errorf("the object has been modified"))
+				}
+				return false, nil, nil
+			})
+
+			Expect(ExportLabelMachinesForForceDeletion(hybridBotanist, context.TODO())).To(Succeed())
+
+			m, err := machineClientset.MachineV1alpha1().Machines(namespace).Get("machine-a", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Labels).To(HaveKeyWithValue("force-deletion", "True"))
+			Expect(conflicts).To(Equal(2))
+		})
+
+		It("should ignore machines which have been deleted in the meantime", func() {
+			newHybridBotanist(machine("machine-a", nil))
+			machineClientset.PrependReactor("patch", "machines", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, nil, apierrors.NewNotFound(machinev1alpha1.Resource("machines"), "machine-a")
+			})
+
+			Expect(ExportLabelMachinesForForceDeletion(hybridBotanist, context.TODO())).To(Succeed())
+		})
+
+		It("should not label any machine if the context has been canceled", func() {
+			newHybridBotanist(machine("machine-a", nil))
+			ctx, cancel := context.WithCancel(context.TODO())
+			cancel()
+
+			Expect(ExportLabelMachinesForForceDeletion(hybridBotanist, ctx)).To(Equal(context.Canceled))
+
+			m, err := machineClientset.MachineV1alpha1().Machines(namespace).Get("machine-a", metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(m.Labels).NotTo(HaveKey("force-deletion"))
+		})
+	})
+
+	Describe("#waitUntilMachineDeploymentsAvailable", func() {
+		var (
+			desired = []operation.MachineDeployment{{Name: "pool-a"}}
+
+			availableMachineDeployment = func(replicas int32) *machinev1alpha1.MachineDeployment {
+				deployment := machineDeployment("pool-a", replicas)
+				deployment.Status = machinev1alpha1.MachineDeploymentStatus{
+					Replicas:        replicas,
+					ReadyReplicas:   replicas,
+					UpdatedReplicas: replicas,
+				}
+				return deployment
+			}
+		)
+
+		It("should return once the machine deployments are available", func() {
+			newHybridBotanist(availableMachineDeployment(2))
+
+			Expect(ExportWaitUntilMachineDeploymentsAvailable(hybridBotanist, context.TODO(), desired)).To(Succeed())
+		})
+
+		It("should return once the machine deployments have become available", func() {
+			var phases []gardenv1beta1.MachineRolloutPhase
+
+			newHybridBotanist(machineDeployment("pool-a", 2))
+			hybridBotanist.MachineWaitTimeout = 5 * time.Second
+			hybridBotanist.MachineRolloutReporter = func(status *gardenv1beta1.ShootMachinesStatus) {
+				phases = append(phases, status.Phase)
+			}
+
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(time.Second)
+				_, err := machineClientset.MachineV1alpha1().MachineDeployments(namespace).Update(availableMachineDeployment(2))
+				Expect(err).NotTo(HaveOccurred())
+			}()
+
+			Expect(ExportWaitUntilMachineDeploymentsAvailable(hybridBotanist, context.TODO(), desired)).To(Succeed())
+			Expect(phases).To(Equal([]gardenv1beta1.MachineRolloutPhase{
+				gardenv1beta1.MachineRolloutPhaseProgressing,
+				gardenv1beta1.MachineRolloutPhaseAvailable,
+			}))
+		})
+
+		It("should time out if the machine deployments do not become available", func() {
+			var lastStatus *gardenv1beta1.ShootMachinesStatus
+
+			newHybridBotanist(machineDeployment("pool-a", 2))
+			hybridBotanist.MachineWaitTimeout = 200 * time.Millisecond
+			hybridBotanist.MachineRolloutReporter = func(status *gardenv1beta1.ShootMachinesStatus) {
+				lastStatus = status
+			}
+
+			timeouts := ExportCounterValue("waitTimeouts", "bar", "garden-foo", "rollout")
+
+			Expect(ExportWaitUntilMachineDeploymentsAvailable(hybridBotanist, context.TODO(), desired)).To(Equal(wait.ErrWaitTimeout))
+			Expect(lastStatus).NotTo(BeNil())
+			Expect(lastStatus.Phase).To(Equal(gardenv1beta1.MachineRolloutPhaseFailed))
+			Expect(ExportCounterValue("waitTimeouts", "bar", "garden-foo", "rollout")).To(Equal(timeouts + 1))
+		})
+
+		It("should return promptly once the context has been canceled", func() {
+			var lastStatus *gardenv1beta1.ShootMachinesStatus
+
+			newHybridBotanist(machineDeployment("pool-a", 2))
+			hybridBotanist.MachineWaitTimeout = time.Minute
+			hybridBotanist.MachineRolloutReporter = func(status *gardenv1beta1.ShootMachinesStatus) {
+				lastStatus = status
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(200*time.Millisecond, cancel)
+
+			timeouts := ExportCounterValue("waitTimeouts", "bar", "garden-foo", "rollout")
+			start := time.Now()
+
+			Expect(ExportWaitUntilMachineDeploymentsAvailable(hybridBotanist, ctx, desired)).To(Equal(context.Canceled))
+			Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+			Expect(lastStatus.Phase).To(Equal(gardenv1beta1.MachineRolloutPhaseProgressing))
+			Expect(ExportCounterValue("waitTimeouts", "bar", "garden-foo", "rollout")).To(Equal(timeouts))
+		})
+	})
+
+	Describe("#waitUntilMachineDeploymentsAvailable with a progress timeout", func() {
+		It("should fail early if a machine deployment does not make any progress", func() {
+			newHybridBotanist(machineDeployment("pool-a", 2))
+			hybridBotanist.MachineWaitTimeout = 5 * time.Second
+			hybridBotanist.MachineWaitPollInterval = 50 * time.Millisecond
+			hybridBotanist.MachineDeploymentProgressTimeout = 200 * time.Millisecond
+
+			err := ExportWaitUntilMachineDeploymentsAvailable(hybridBotanist, context.TODO(), []operation.MachineDeployment{{Name: "pool-a", WorkerName: "pool-a"}})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err).NotTo(Equal(wait.ErrWaitTimeout))
+			Expect(err.Error()).To(ContainSubstring("did not make any progress"))
+		})
+	})
+
+	Describe("#nextMachineWaitPollInterval", func() {
+		It("should double the interval", func() {
+			Expect(ExportNextMachineWaitPollInterval(5*time.Second, 5*time.Second)).To(Equal(10 * time.Second))
+		})
+
+		It("should not exceed the maximum interval", func() {
+			Expect(ExportNextMachineWaitPollInterval(40*time.Second, 5*time.Second)).To(Equal(time.Minute))
+		})
+
+		It("should not fall below a larger minimum interval", func() {
+			Expect(ExportNextMachineWaitPollInterval(2*time.Minute, 2*time.Minute)).To(Equal(2 * time.Minute))
+		})
+	})
+
+	Describe("#computeMachinesStatus", func() {
+		var (
+			desired = []operation.MachineDeployment{{Name: "pool-b"}, {Name: "pool-a"}}
+
+			deployment = func(name string, replicas, ready, updated int32) *machinev1alpha1.MachineDeployment {
+				d := machineDeployment(name, replicas)
+				d.Status = machinev1alpha1.MachineDeploymentStatus{
+					Replicas:            replicas,
+					ReadyReplicas:       ready,
+					UpdatedReplicas:     updated,
+					UnavailableReplicas: replicas - ready,
+				}
+				return d
+			}
+		)
+
+		It("should report the progress of every machine deployment", func() {
+			status := ExportComputeMachinesStatus([]*machinev1alpha1.MachineDeployment{deployment("pool-b", 3, 1, 2), deployment("pool-a", 2, 2, 2)}, desired)
+
+			Expect(status.Phase).To(Equal(gardenv1beta1.MachineRolloutPhaseProgressing))
+			Expect(status.Deployments).To(Equal([]gardenv1beta1.ShootMachineDeploymentStatus{
+				{Name: "pool-a", Desired: 2, Ready: 2, Updated: 2},
+				{Name: "pool-b", Desired: 3, Ready: 1, Updated: 2, Unavailable: 2},
+			}))
+			Expect(status.LastError).To(BeEmpty())
+		})
+
+		It("should be available once all machine deployments have been rolled out", func() {
+			status := ExportComputeMachinesStatus([]*machinev1alpha1.MachineDeployment{deployment("pool-b", 3, 3, 3), deployment("pool-a", 2, 2, 2), deployment("pool-c", 1, 0, 0)}, desired)
+
+			Expect(status.Phase).To(Equal(gardenv1beta1.MachineRolloutPhaseAvailable))
+			Expect(status.Deployments).To(HaveLen(2))
+		})
+
+		It("should be progressing as long as a machine deployment does not exist or is outdated", func() {
+			Expect(ExportComputeMachinesStatus([]*machinev1alpha1.MachineDeployment{deployment("pool-a", 2, 2, 2)}, desired).Phase).To(Equal(gardenv1beta1.MachineRolloutPhaseProgressing))
+
+			outdated := deployment("pool-b", 3, 3, 3)
+			outdated.Generation = 2
+			outdated.Status.ObservedGeneration = 1
+			Expect(ExportComputeMachinesStatus([]*machinev1alpha1.MachineDeployment{deployment("pool-a", 2, 2, 2), outdated}, desired).Phase).To(Equal(gardenv1beta1.MachineRolloutPhaseProgressing))
+		})
+
+		It("should take over the errors reported by the machine-controller-manager", func() {
+			failed := deployment("pool-b", 3, 1, 1)
+			failed.Status.Conditions = []machinev1alpha1.MachineDeploymentCondition{
+				{Type: machinev1alpha1.MachineDeploymentReplicaFailure, Status: machinev1alpha1.ConditionTrue, Message: "quota exceeded"},
+			}
+
+			status := ExportComputeMachinesStatus([]*machinev1alpha1.MachineDeployment{deployment("pool-a", 2, 2, 2), failed}, desired)
+
+			Expect(status.Deployments[1].LastError).To(Equal("quota exceeded"))
+			Expect(status.LastError).To(Equal("pool-b: quota exceeded"))
+		})
+	})
+
+	Describe("#machineClassesInUse", func() {
+		var (
+			machineSet = func(name, class string, replicas, statusReplicas int32) machinev1alpha1.MachineSet {
+				set := machinev1alpha1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Spec:       machinev1alpha1.MachineSetSpec{Replicas: replicas},
+					Status:     machinev1alpha1.MachineSetStatus{Replicas: statusReplicas},
+				}
+				set.Spec.Template.Spec.Class.Name = class
+				return set
+			}
+			machineWithClass = func(name, class string) machinev1alpha1.Machine {
+				m := machine(name, nil)
+				m.Spec.Class.Name = class
+				return *m
+			}
+		)
+
+		It("should return the classes of machine sets which still have machines and of existing machines", func() {
+			machineSets := []machinev1alpha1.MachineSet{
+				machineSet("pool-a-new", "pool-a-22222", 2, 1),
+				machineSet("pool-a-old", "pool-a-11111", 0, 1),
+				machineSet("pool-a-older", "pool-a-00000", 0, 0),
+				machineSet("pool-b", "pool-b-33333", 1, 0),
+			}
+			machines := []machinev1alpha1.Machine{
+				machineWithClass("pool-a-old-1", "pool-a-11111"),
+				machineWithClass("pool-c-1", "pool-c-44444"),
+			}
+
+			Expect(ExportMachineClassesInUse(machineSets, machines).List()).To(Equal([]string{"pool-a-11111", "pool-a-22222", "pool-b-33333", "pool-c-44444"}))
+		})
+	})
+
+	Describe("#machineDeploymentRolloutStages", func() {
+		It("should group the machine deployments by the rollout stages of their worker groups", func() {
+			var (
+				stage1 int32 = 1
+				stage2 int32 = 2
+
+				canary   = operation.MachineDeployment{Name: "canary-z1", WorkerName: "canary"}
+				poolAZ1  = operation.MachineDeployment{Name: "pool-a-z1", WorkerName: "pool-a"}
+				poolAZ2  = operation.MachineDeployment{Name: "pool-a-z2", WorkerName: "pool-a"}
+				poolB    = operation.MachineDeployment{Name: "pool-b-z1", WorkerName: "pool-b"}
+				explicit = operation.MachineDeployment{Name: "pool-c-z1", WorkerName: "pool-c"}
+				workers  = []gardenv1beta1.Worker{
+					{Name: "canary"},
+					{Name: "pool-a", RolloutStage: &stage2},
+					{Name: "pool-b", RolloutStage: &stage1},
+					{Name: "pool-c", RolloutStage: &stage2},
+				}
+			)
+
+			Expect(ExportMachineDeploymentRolloutStages([]operation.MachineDeployment{poolAZ1, canary, poolB, poolAZ2, explicit}, workers)).To(Equal([][]operation.MachineDeployment{
+				{canary},
+				{poolB},
+				{poolAZ1, poolAZ2, explicit},
+			}))
+		})
+
+		It("should return a single stage if no worker group configures a rollout stage", func() {
+			machineDeployments := []operation.MachineDeployment{
+				{Name: "pool-a-z1", WorkerName: "pool-a"},
+				{Name: "pool-b-z1", WorkerName: "pool-b"},
+			}
+
+			Expect(ExportMachineDeploymentRolloutStages(machineDeployments, []gardenv1beta1.Worker{{Name: "pool-a"}, {Name: "pool-b"}})).To(Equal([][]operation.MachineDeployment{machineDeployments}))
+		})
+	})
+
+	Describe("#machineConfiguration", func() {
+		It("should return nil if the worker group does not configure any machine health settings", func() {
+			Expect(ExportMachineConfiguration(gardenv1beta1.Worker{Name: "pool-a"})).To(BeNil())
+		})
+
+		It("should return the machine health settings of the worker group", func() {
+			var (
+				healthTimeout   = metav1.Duration{Duration: 20 * time.Minute}
+				maxEvictRetries = int32(30)
+			)
+
+			Expect(ExportMachineConfiguration(gardenv1beta1.Worker{
+				Name:                    "pool-a",
+				MachineHealthTimeout:    &healthTimeout,
+				MaxEvictRetries:         &maxEvictRetries,
+				UnhealthyNodeConditions: []string{"KernelDeadlock", "ReadonlyFilesystem"},
+			})).To(Equal(map[string]interface{}{
+				"healthTimeout":   "20m0s",
+				"maxEvictRetries": int32(30),
+				"nodeConditions":  "KernelDeadlock,ReadonlyFilesystem",
+			}))
+		})
+	})
+
+	Describe("#attributeMachineDeploymentsWaitError", func() {
+		It("should attribute the error to the worker groups whose machine deployments have not been rolled out", func() {
+			available := machineDeployment("pool-a-z1", 1)
+			available.Status = machinev1alpha1.MachineDeploymentStatus{Replicas: 1, ReadyReplicas: 1, UpdatedReplicas: 1}
+			newHybridBotanist(available, machineDeployment("pool-a-z2", 1), machineDeployment("pool-b-z1", 1))
+
+			workerErrors := ExportAttributeMachineDeploymentsWaitError(hybridBotanist, []operation.MachineDeployment{
+				{Name: "pool-a-z1", WorkerName: "pool-a"},
+				{Name: "pool-a-z2", WorkerName: "pool-a"},
+				{Name: "pool-b-z1", WorkerName: "pool-b"},
+				{Name: "pool-b-z2", WorkerName: "pool-b"},
+				{Name: "pool-c-z1", WorkerName: "pool-c"},
+			}, wait.ErrWaitTimeout)
+
+			Expect(workerErrors).To(HaveLen(3))
+			Expect(workerErrors.Error()).To(Equal("worker group pool-a: 'timed out waiting for the condition (machine deployments not rolled out: pool-a-z2)', " +
+				"worker group pool-b: 'timed out waiting for the condition (machine deployments not rolled out: pool-b-z1, pool-b-z2)', " +
+				"worker group pool-c: 'timed out waiting for the condition (machine deployments not rolled out: pool-c-z1)'"))
+			Expect(operationerrors.Is(workerErrors["pool-a"], operationerrors.ErrWaitTimeout)).To(BeTrue())
+			Expect(operationerrors.ClassOf(workerErrors)).To(Equal(operationerrors.ClassTransient))
+		})
+
+		It("should classify the errors by the failures reported for the machine deployments", func() {
+			quotaExceeded := machineDeployment("pool-a-z1", 1)
+			quotaExceeded.Status.Conditions = []machinev1alpha1.MachineDeploymentCondition{
+				{Type: machinev1alpha1.MachineDeploymentReplicaFailure, Status: machinev1alpha1.ConditionTrue, Message: "InstanceLimitExceeded: Your quota allows for 0 more running instance(s)."},
+			}
+			unauthorized := machineDeployment("pool-b-z1", 1)
+			unauthorized.Status.Conditions = []machinev1alpha1.MachineDeploymentCondition{
+				{Type: machinev1alpha1.MachineDeploymentReplicaFailure, Status: machinev1alpha1.ConditionTrue, Message: "AuthFailure: AWS was not able to validate the provided access credentials"},
+			}
+			newHybridBotanist(quotaExceeded, unauthorized)
+
+			workerErrors := ExportAttributeMachineDeploymentsWaitError(hybridBotanist, []operation.MachineDeployment{
+				{Name: "pool-a-z1", WorkerName: "pool-a"},
+				{Name: "pool-b-z1", WorkerName: "pool-b"},
+			}, wait.ErrWaitTimeout)
+
+			Expect(workerErrors).To(HaveLen(2))
+			Expect(operationerrors.Is(workerErrors["pool-a"], operationerrors.ErrQuotaExceeded)).To(BeTrue())
+			Expect(workerErrors["pool-a"].Error()).To(Equal("timed out waiting for the condition (machine deployments not rolled out: pool-a-z1): InstanceLimitExceeded: Your quota allows for 0 more running instance(s)."))
+			Expect(operationerrors.IsUnauthorized(workerErrors["pool-b"])).To(BeTrue())
+			Expect(operationerrors.ClassOf(workerErrors)).To(Equal(operationerrors.ClassUnauthorized))
+		})
+
+		It("should not attribute the error to worker groups which have been rolled out meanwhile", func() {
+			available := machineDeployment("pool-a-z1", 1)
+			available.Status = machinev1alpha1.MachineDeploymentStatus{Replicas: 1, ReadyReplicas: 1, UpdatedReplicas: 1}
+			newHybridBotanist(available)
+
+			workerErrors := ExportAttributeMachineDeploymentsWaitError(hybridBotanist, []operation.MachineDeployment{
+				{Name: "pool-a-z1", WorkerName: "pool-a"},
+			}, wait.ErrWaitTimeout)
+
+			Expect(workerErrors).To(BeEmpty())
+		})
+	})
+
+	Describe("#machinesProvisionedSince", func() {
+		var (
+			since = time.Date(2018, 10, 1, 12, 0, 0, 0, time.UTC)
+
+			runningMachine = func(name string, created, running time.Time) *machinev1alpha1.Machine {
+				m := machine(name, map[string]string{"name": "pool-a"})
+				m.CreationTimestamp = metav1.NewTime(created)
+				m.Status.CurrentStatus = machinev1alpha1.CurrentStatus{Phase: machinev1alpha1.MachineRunning, LastUpdateTime: metav1.NewTime(running)}
+				return m
+			}
+		)
+
+		It("should return the machines which have become running since the given time only once", func() {
+			var (
+				provisioned = sets.NewString()
+				pending     = machine("pending", map[string]string{"name": "pool-a"})
+				machines    = []*machinev1alpha1.Machine{
+					runningMachine("old", since.Add(-time.Hour), since.Add(-time.Minute)),
+					runningMachine("new", since.Add(-time.Minute), since.Add(2*time.Minute)),
+					pending,
+				}
+			)
+			pending.Status.CurrentStatus.Phase = machinev1alpha1.MachinePending
+
+			result := ExportMachinesProvisionedSince(machines, provisioned, since)
+
+			Expect(result).To(HaveLen(1))
+			deploymentName, duration := ExportMachineProvisioning(result[0])
+			Expect(deploymentName).To(Equal("pool-a"))
+			Expect(duration).To(Equal(3 * time.Minute))
+			Expect(provisioned.List()).To(ConsistOf("new"))
+
+			Expect(ExportMachinesProvisionedSince(machines, provisioned, since)).To(BeEmpty())
+		})
+	})
+
+	Describe("#waitUntilMachineResourcesDeleted", func() {
+		It("should return once the machine resources have been deleted", func() {
+			newHybridBotanist(machineDeployment("pool-a", 1), machine("machine-a", nil))
+			hybridBotanist.MachineWaitTimeout = 5 * time.Second
+
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(200 * time.Millisecond)
+				Expect(machineClientset.MachineV1alpha1().MachineDeployments(namespace).Delete("pool-a", nil)).To(Succeed())
+				Expect(machineClientset.MachineV1alpha1().Machines(namespace).Delete("machine-a", nil)).To(Succeed())
+			}()
+
+			Expect(ExportWaitUntilMachineResourcesDeleted(hybridBotanist, context.TODO(), "machinedeployments", "machinesets", "machines")).To(Succeed())
+		})
+
+		It("should time out if the machine resources are not deleted", func() {
+			newHybridBotanist(machine("machine-a", nil))
+			hybridBotanist.MachineWaitTimeout = 200 * time.Millisecond
+
+			Expect(ExportWaitUntilMachineResourcesDeleted(hybridBotanist, context.TODO(), "machines")).To(Equal(wait.ErrWaitTimeout))
+		})
+
+		It("should not wait if the context has already been canceled", func() {
+			newHybridBotanist(machine("machine-a", nil))
+			hybridBotanist.MachineWaitTimeout = time.Minute
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			Expect(ExportWaitUntilMachineResourcesDeleted(hybridBotanist, ctx, "machines")).To(Equal(context.Canceled))
+		})
+	})
+})
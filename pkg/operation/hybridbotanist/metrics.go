@@ -0,0 +1,103 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	machineOperationDeploy  = "deploy"
+	machineOperationDestroy = "destroy"
+
+	machineWaitRollout  = "rollout"
+	machineWaitDeletion = "deletion"
+)
+
+var (
+	machineOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_shoot_machines_operation_duration_seconds",
+		Help:    "Duration of the deployment and the destruction of the machines of a Shoot",
+		Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+	}, []string{"shoot", "project", "operation", "result"})
+
+	machineDeploymentReadyDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_shoot_machine_deployment_ready_duration_seconds",
+		Help:    "Time until a machine deployment of a Shoot has been rolled out completely",
+		Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+	}, []string{"shoot", "project", "worker"})
+
+	machineProvisioningDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "garden_machine_provisioning_duration_seconds",
+		Help:    "Time from the creation of a machine until it is running, i.e. until its node is ready",
+		Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+	}, []string{"provider", "region", "machine_type"})
+
+	machineResourcesCleanedUp = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_shoot_machine_resources_cleaned_up_total",
+		Help: "Count of the obsolete machine classes, machine deployments and machine class secrets which have been deleted",
+	}, []string{"shoot", "project", "kind"})
+
+	machineWaitTimeouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "garden_shoot_machine_wait_timeouts_total",
+		Help: "Count of the timeouts while waiting for the rollout or the deletion of the machines of a Shoot",
+	}, []string{"shoot", "project", "wait"})
+)
+
+func init() {
+	prometheus.MustRegister(machineOperationDuration)
+	prometheus.MustRegister(machineDeploymentReadyDuration)
+	prometheus.MustRegister(machineProvisioningDuration)
+	prometheus.MustRegister(machineResourcesCleanedUp)
+	prometheus.MustRegister(machineWaitTimeouts)
+}
+
+// observeMachineOperation records the duration of the machine <operation> which started at <start> and finished with
+// the given <err>. It is meant to be deferred.
+func (b *HybridBotanist) observeMachineOperation(operation string, start time.Time, err *error) {
+	result := "success"
+	if *err != nil {
+		result = "error"
+	}
+	machineOperationDuration.WithLabelValues(b.Shoot.Info.Name, b.Shoot.Info.Namespace, operation, result).Observe(time.Since(start).Seconds())
+}
+
+// observeMachineDeploymentReady records the time since <start> after which the machine deployment of the worker
+// group <workerName> has been rolled out.
+func (b *HybridBotanist) observeMachineDeploymentReady(workerName string, start time.Time) {
+	machineDeploymentReadyDuration.WithLabelValues(b.Shoot.Info.Name, b.Shoot.Info.Namespace, workerName).Observe(time.Since(start).Seconds())
+}
+
+// observeMachineProvisioned records the <duration> after which a machine of the given <machineType> has been running.
+// The metric is not labeled with the Shoot, hence, it aggregates the machines of all Shoots per provider, region and
+// machine type.
+func (b *HybridBotanist) observeMachineProvisioned(machineType string, duration time.Duration) {
+	machineProvisioningDuration.WithLabelValues(string(b.Shoot.CloudProvider), b.Shoot.Info.Spec.Cloud.Region, machineType).Observe(duration.Seconds())
+}
+
+// countMachineResourcesCleanedUp records that <count> obsolete machine resources of the given <kind> have been deleted.
+func (b *HybridBotanist) countMachineResourcesCleanedUp(kind string, count int) {
+	if count == 0 {
+		return
+	}
+	machineResourcesCleanedUp.WithLabelValues(b.Shoot.Info.Name, b.Shoot.Info.Namespace, kind).Add(float64(count))
+}
+
+// countMachineWaitTimeout records that waiting for the <wait> of the machines has timed out.
+func (b *HybridBotanist) countMachineWaitTimeout(wait string) {
+	machineWaitTimeouts.WithLabelValues(b.Shoot.Info.Name, b.Shoot.Info.Namespace, wait).Inc()
+}
@@ -0,0 +1,197 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/etcdlock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const (
+	// etcdRecoveryDrillName is the name of the chart and the job which verify the backups of the etcd of a Shoot.
+	etcdRecoveryDrillName = "etcd-recovery-drill"
+	// etcdRecoveryDrillPollInterval is the interval in which the job of the etcd restore drill is checked.
+	etcdRecoveryDrillPollInterval = 10 * time.Second
+	// etcdRecoveryDrillLogLines is the number of log lines of each container of a failed job which are reported.
+	etcdRecoveryDrillLogLines = 10
+)
+
+// DrillEtcdRestore restores the most recent backup of the main etcd of the Shoot into a scratch namespace in the Seed
+// cluster and checks that the restored etcd contains the data of the Shoot. The running etcd of the Shoot is not
+// touched. The etcd operation lock of the Shoot is held for the whole drill, so that its backups are not changed by a
+// concurrent operation. The scratch namespace is always deleted afterwards; the logs of a failed job are part of the
+// returned error.
+func (b *HybridBotanist) DrillEtcdRestore(ctx context.Context) error {
+	secretData, restoreConfig, err := b.SeedCloudBotanist.GenerateEtcdRestoreConfig(b.Shoot.Info)
+	if err != nil {
+		return err
+	}
+	// Some cloud botanists do not yet support backup and won't return secret or restore config data.
+	if restoreConfig == nil {
+		return fmt.Errorf("the etcd restore drill is not supported for cloud provider %s", b.Shoot.CloudProvider)
+	}
+
+	lock := etcdlock.New(b.K8sSeedClient.Clientset().CoreV1().ConfigMaps(b.Shoot.SeedNamespace), fmt.Sprintf("%s/recovery-drill", b.GardenerInfo.Name), etcdlock.DefaultLeaseDuration)
+	_, releaseLock, err := lock.Hold(etcdlock.ReasonRecoveryDrill, b.Logger)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := releaseLock(); err != nil {
+			b.Logger.Errorf("Could not release the etcd operation lock: %v", err)
+		}
+	}()
+
+	namespace := etcdRecoveryDrillNamespace(b.Shoot.SeedNamespace)
+	if err := b.waitUntilEtcdRecoveryDrillNamespaceDeleted(ctx, namespace); err != nil {
+		return fmt.Errorf("could not delete the namespace %s of a previous etcd restore drill: %v", namespace, err)
+	}
+
+	if _, err := b.K8sSeedClient.CreateNamespace(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+			Labels: map[string]string{
+				"garden.sapcloud.io/role": etcdRecoveryDrillName,
+			},
+		},
+	}, false); err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.DeleteEtcdRecoveryDrillNamespace(); err != nil {
+			b.Logger.Errorf("Could not delete the namespace %s of the etcd restore drill: %v", namespace, err)
+		}
+	}()
+
+	if secretData != nil {
+		if _, err := b.K8sSeedClient.CreateSecret(namespace, common.RestoreSecretName, corev1.SecretTypeOpaque, secretData, true); err != nil {
+			return err
+		}
+	}
+	restoreConfig["restoreSecret"] = common.RestoreSecretName
+
+	activeDeadlineSeconds := int64(15 * 60)
+	if deadline, ok := ctx.Deadline(); ok {
+		activeDeadlineSeconds = int64(time.Until(deadline).Seconds())
+	}
+	if activeDeadlineSeconds < 1 {
+		return errors.New("the etcd restore drill has no time left to run")
+	}
+
+	values, err := b.Botanist.InjectImages(map[string]interface{}{
+		"restore":               restoreConfig,
+		"activeDeadlineSeconds": activeDeadlineSeconds,
+	}, b.K8sSeedClient.Version(), map[string]string{"etcd": "etcd", "etcd-backup-restore": "etcd-backup-restore"})
+	if err != nil {
+		return err
+	}
+	if err := b.ApplyChartSeed(filepath.Join(chartPathControlPlane, etcdRecoveryDrillName), etcdRecoveryDrillName, namespace, nil, values); err != nil {
+		return err
+	}
+
+	if err := wait.PollUntil(etcdRecoveryDrillPollInterval, func() (bool, error) {
+		job, err := b.K8sSeedClient.GetJob(namespace, etcdRecoveryDrillName)
+		if err != nil {
+			return false, err
+		}
+		if job.Status.Failed > 0 {
+			return false, fmt.Errorf("job %s/%s has failed: %s", namespace, etcdRecoveryDrillName, b.etcdRecoveryDrillLogs(namespace))
+		}
+		return job.Status.Succeeded > 0, nil
+	}, ctx.Done()); err != nil {
+		if err == wait.ErrWaitTimeout {
+			return fmt.Errorf("the etcd restore drill has timed out: job %s/%s has not completed yet", namespace, etcdRecoveryDrillName)
+		}
+		return err
+	}
+
+	b.Logger.Infof("[RECOVERY DRILL] The backup of the etcd has been restored successfully")
+	return nil
+}
+
+// DeleteEtcdRecoveryDrillNamespace deletes the scratch namespace of the etcd restore drill of the Shoot. It is left
+// behind if the Gardener is restarted during a drill.
+func (b *HybridBotanist) DeleteEtcdRecoveryDrillNamespace() error {
+	if err := b.K8sSeedClient.DeleteNamespace(etcdRecoveryDrillNamespace(b.Shoot.SeedNamespace)); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// etcdRecoveryDrillNamespace returns the name of the scratch namespace in which the etcd restore drill of the Shoot
+// with the given <seedNamespace> runs.
+func etcdRecoveryDrillNamespace(seedNamespace string) string {
+	return fmt.Sprintf("%s--drill", seedNamespace)
+}
+
+// waitUntilEtcdRecoveryDrillNamespaceDeleted deletes the given scratch <namespace> of a previous etcd restore drill
+// and waits until it is gone.
+func (b *HybridBotanist) waitUntilEtcdRecoveryDrillNamespaceDeleted(ctx context.Context, namespace string) error {
+	if err := b.K8sSeedClient.DeleteNamespace(namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	return wait.PollUntil(etcdRecoveryDrillPollInterval, func() (bool, error) {
+		if _, err := b.K8sSeedClient.GetNamespace(namespace); err != nil {
+			if apierrors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}
+		return false, nil
+	}, ctx.Done())
+}
+
+// etcdRecoveryDrillLogs returns the last log lines of the containers of the pods of the etcd restore drill job in the
+// given <namespace>, as the namespace is deleted after the drill.
+func (b *HybridBotanist) etcdRecoveryDrillLogs(namespace string) string {
+	podList, err := b.K8sSeedClient.ListPods(namespace, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("app=%s", etcdRecoveryDrillName),
+	})
+	if err != nil {
+		return fmt.Sprintf("could not list the pods of the job: %v", err)
+	}
+
+	var (
+		tailLines = int64(etcdRecoveryDrillLogLines)
+		logs      []string
+	)
+	for _, pod := range podList.Items {
+		for _, container := range []string{"restore", "verify"} {
+			buffer, err := b.K8sSeedClient.GetPodLogs(namespace, pod.Name, &corev1.PodLogOptions{Container: container, TailLines: &tailLines})
+			if err != nil || buffer.Len() == 0 {
+				continue
+			}
+			logs = append(logs, fmt.Sprintf("%s/%s: %s", pod.Name, container, strings.TrimSpace(buffer.String())))
+		}
+	}
+	if len(logs) == 0 {
+		return "no logs are available"
+	}
+	return strings.Join(logs, "; ")
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"path/filepath"
+
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DeployPassiveETCDReplica deploys a passive replica of the main etcd of the Shoot cluster into the Seed cluster which
+// is referenced by the passive replica annotation (experimental). The replica is scaled down to zero and only reads the
+// backups of the active etcd: once it is promoted (i.e., scaled up), it restores the most recent snapshot which has been
+// taken by the active etcd. It never uploads backups itself, hence, it cannot overwrite or delete the snapshots of the
+// active etcd while both are running.
+func (b *HybridBotanist) DeployPassiveETCDReplica() error {
+	k8sPassiveSeedClient, err := b.Botanist.NewPassiveReplicaSeedClient()
+	if err != nil {
+		return err
+	}
+
+	if _, err := k8sPassiveSeedClient.CreateNamespace(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: b.Shoot.SeedNamespace,
+			Labels: map[string]string{
+				common.GardenRole: common.GardenRolePassiveReplica,
+			},
+		},
+	}, true); err != nil {
+		return err
+	}
+
+	// The passive etcd must be able to serve the same certificates as the active one.
+	for _, name := range []string{"ca", "etcd-server-tls", "etcd-client-tls"} {
+		secret, err := b.K8sSeedClient.GetSecret(b.Shoot.SeedNamespace, name)
+		if err != nil {
+			return err
+		}
+		if _, err := k8sPassiveSeedClient.CreateSecret(b.Shoot.SeedNamespace, name, secret.Type, secret.Data, true); err != nil {
+			return err
+		}
+	}
+
+	etcdConfig := map[string]interface{}{
+		"role":     common.EtcdRoleMain,
+		"replicas": 0,
+		"backup": map[string]interface{}{
+			"storageProvider": "", // No storage provider means no backup
+		},
+		"podAnnotations": map[string]interface{}{
+			"checksum/secret-ca":              b.CheckSums["ca"],
+			"checksum/secret-etcd-server-tls": b.CheckSums["etcd-server-tls"],
+			"checksum/secret-etcd-client-tls": b.CheckSums["etcd-client-tls"],
+		},
+	}
+
+	secretData, restoreConfigData, err := b.SeedCloudBotanist.GenerateEtcdRestoreConfig(b.Shoot.Info)
+	if err != nil {
+		return err
+	}
+	// Some cloud botanists do not yet support backup and won't return secret or restore config data.
+	if restoreConfigData != nil {
+		if secretData != nil {
+			if _, err := k8sPassiveSeedClient.CreateSecret(b.Shoot.SeedNamespace, common.RestoreSecretName, corev1.SecretTypeOpaque, secretData, true); err != nil {
+				return err
+			}
+		}
+		restoreConfigData["restoreSecret"] = common.RestoreSecretName
+		etcdConfig["restore"] = restoreConfigData
+	}
+
+	etcd, err := b.Botanist.InjectImages(etcdConfig, k8sPassiveSeedClient.Version(), map[string]string{"etcd": "etcd", "etcd-backup-restore": "etcd-backup-restore"})
+	if err != nil {
+		return err
+	}
+
+	return common.ApplyChart(k8sPassiveSeedClient, chartrenderer.New(k8sPassiveSeedClient), filepath.Join(chartPathControlPlane, "etcd"), "etcd-"+common.EtcdRoleMain, b.Shoot.SeedNamespace, nil, etcd)
+}
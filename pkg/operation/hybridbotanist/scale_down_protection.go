@@ -0,0 +1,173 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// protectedMachinePriority is the machine priority of machines whose nodes are protected from scale-downs. It is
+// above the default priority and above the scale-down priorities of the worker groups, hence the
+// machine-controller-manager deletes such machines last when it scales down a machine set (e.g., during a rolling
+// update).
+const protectedMachinePriority = "5"
+
+// ReconcileMachinePriorities raises the priority of the machines whose nodes are protected from scale-downs (see
+// common.ScaleDownProtectionAnnotation) or run workload which must not be evicted (see
+// common.ClusterAutoscalerSafeToEvictAnnotation), sets the scale-down priority of the worker groups on the other
+// machines and resets the priority of the machines which no longer need one.
+func (b *HybridBotanist) ReconcileMachinePriorities() error {
+	nodeList, err := b.K8sShootClient.ListNodes(metav1.ListOptions{LabelSelector: common.WorkerGroupLabel})
+	if err != nil {
+		return err
+	}
+	podList, err := b.K8sShootClient.ListPods(metav1.NamespaceAll, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	var (
+		protected       = protectedNodes(nodeList.Items, podList.Items)
+		nodePriorities  = nodeMachinePriorities(nodeList.Items, b.Shoot.GetWorkers())
+		machineClient   = b.K8sSeedClient.MachineClientset().MachineV1alpha1().Machines(b.Shoot.SeedNamespace)
+		annotationValue = func(annotations map[string]string, key string) interface{} {
+			if value, ok := annotations[key]; ok {
+				return value
+			}
+			return nil
+		}
+	)
+
+	machineList, err := machineClient.List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, machine := range machineList.Items {
+		newMachine, changed := computeMachinePriority(machine, protected, nodePriorities)
+		if !changed {
+			continue
+		}
+
+		// The machine is patched so that fields which are not known to the machine API types of the Gardener are kept.
+		body, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]interface{}{
+					common.MachinePriorityAnnotation:        annotationValue(newMachine.Annotations, common.MachinePriorityAnnotation),
+					common.MachinePriorityAppliedAnnotation: annotationValue(newMachine.Annotations, common.MachinePriorityAppliedAnnotation),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := machineClient.Patch(machine.Name, types.MergePatchType, body); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("Updating the priority of machine %s failed: %s", machine.Name, err.Error())
+		}
+		b.Logger.Infof("Set the priority of machine %s to %q", machine.Name, newMachine.Annotations[common.MachinePriorityAnnotation])
+	}
+
+	return nil
+}
+
+// protectedNodes returns the names of the given <nodes> which are protected from scale-downs, either by their own
+// annotation or because one of the given <pods> which has not terminated yet runs on them and must not be evicted.
+func protectedNodes(nodes []corev1.Node, pods []corev1.Pod) sets.String {
+	protected := sets.NewString()
+	for _, node := range nodes {
+		if common.ScaleDownProtected(node.Annotations) {
+			protected.Insert(node.Name)
+		}
+	}
+	for _, pod := range pods {
+		if len(pod.Spec.NodeName) == 0 || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if pod.Annotations[common.ClusterAutoscalerSafeToEvictAnnotation] == "false" {
+			protected.Insert(pod.Spec.NodeName)
+		}
+	}
+	return protected
+}
+
+// nodeMachinePriorities returns a map from the names of the given <nodes> to the scale-down priority of their worker
+// groups among the given <workers>. Nodes of worker groups without a scale-down priority are omitted.
+func nodeMachinePriorities(nodes []corev1.Node, workers []gardenv1beta1.Worker) map[string]string {
+	workerPriorities := map[string]string{}
+	for _, worker := range workers {
+		if worker.ScaleDownPriority != nil {
+			workerPriorities[worker.Name] = strconv.Itoa(int(*worker.ScaleDownPriority))
+		}
+	}
+
+	priorities := map[string]string{}
+	for _, node := range nodes {
+		if priority, ok := workerPriorities[node.Labels[common.WorkerGroupLabel]]; ok {
+			priorities[node.Name] = priority
+		}
+	}
+	return priorities
+}
+
+// computeMachinePriority returns a copy of the given <machine> whose priority reflects whether its node is among the
+// <protectedNodes> or else the priority of its node in <nodePriorities>. The priority of an unprotected machine is
+// only changed if it has been set by the Gardener before (see common.MachinePriorityAppliedAnnotation), so that
+// priorities set by others (e.g., by the cluster-autoscaler) are kept. It also returns whether the copy differs from
+// the original machine.
+func computeMachinePriority(machine machinev1alpha1.Machine, protectedNodes sets.String, nodePriorities map[string]string) (*machinev1alpha1.Machine, bool) {
+	var (
+		newMachine          = machine.DeepCopy()
+		node                = machine.Status.Node
+		current, hasCurrent = machine.Annotations[common.MachinePriorityAnnotation]
+		applied, hasApplied = machine.Annotations[common.MachinePriorityAppliedAnnotation]
+		// Machines which have been protected before the Gardener recorded the priorities it has set carry the protected
+		// priority without the applied annotation.
+		owned     = !hasCurrent || (hasApplied && applied == current) || (!hasApplied && current == protectedMachinePriority)
+		protected = len(node) > 0 && protectedNodes.Has(node)
+	)
+
+	if !owned && !protected {
+		return newMachine, false
+	}
+
+	priority := nodePriorities[node]
+	if protected {
+		priority = protectedMachinePriority
+	}
+
+	if len(priority) == 0 {
+		delete(newMachine.Annotations, common.MachinePriorityAnnotation)
+		delete(newMachine.Annotations, common.MachinePriorityAppliedAnnotation)
+	} else {
+		if newMachine.Annotations == nil {
+			newMachine.Annotations = map[string]string{}
+		}
+		newMachine.Annotations[common.MachinePriorityAnnotation] = priority
+		newMachine.Annotations[common.MachinePriorityAppliedAnnotation] = priority
+	}
+
+	return newMachine, !reflect.DeepEqual(machine.Annotations, newMachine.Annotations)
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+var _ = Describe("scale-down protection", func() {
+	Describe("#computeMachinePriority", func() {
+		var (
+			protectedNodes = sets.NewString("protected")
+
+			machine = func(node string, annotations map[string]string) machinev1alpha1.Machine {
+				return machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: "machine", Annotations: annotations},
+					Status:     machinev1alpha1.MachineStatus{Node: node},
+				}
+			}
+		)
+
+		It("should raise the priority of machines whose nodes are protected", func() {
+			newMachine, changed := ExportComputeMachinePriority(machine("protected", nil), protectedNodes, nil)
+
+			Expect(changed).To(BeTrue())
+			Expect(newMachine.Annotations).To(HaveKeyWithValue(common.MachinePriorityAnnotation, "5"))
+
+			_, changed = ExportComputeMachinePriority(*newMachine, protectedNodes, nil)
+
+			Expect(changed).To(BeFalse())
+		})
+
+		It("should reset the raised priority of machines whose nodes are no longer protected", func() {
+			newMachine, changed := ExportComputeMachinePriority(machine("node", map[string]string{common.MachinePriorityAnnotation: "5"}), protectedNodes, nil)
+
+			Expect(changed).To(BeTrue())
+			Expect(newMachine.Annotations).NotTo(HaveKey(common.MachinePriorityAnnotation))
+		})
+
+		It("should keep priorities which have not been set by the Gardener", func() {
+			_, changed := ExportComputeMachinePriority(machine("node", map[string]string{common.MachinePriorityAnnotation: "1"}), protectedNodes, nil)
+
+			Expect(changed).To(BeFalse())
+
+			_, changed = ExportComputeMachinePriority(machine("node", map[string]string{common.MachinePriorityAnnotation: "1"}), protectedNodes, map[string]string{"node": "4"})
+
+			Expect(changed).To(BeFalse())
+		})
+
+		It("should set the scale-down priority of the worker group and reset it once it is removed", func() {
+			newMachine, changed := ExportComputeMachinePriority(machine("node", nil), protectedNodes, map[string]string{"node": "4"})
+
+			Expect(changed).To(BeTrue())
+			Expect(newMachine.Annotations).To(HaveKeyWithValue(common.MachinePriorityAnnotation, "4"))
+			Expect(newMachine.Annotations).To(HaveKeyWithValue(common.MachinePriorityAppliedAnnotation, "4"))
+
+			newMachine, changed = ExportComputeMachinePriority(*newMachine, protectedNodes, map[string]string{"node": "1"})
+
+			Expect(changed).To(BeTrue())
+			Expect(newMachine.Annotations).To(HaveKeyWithValue(common.MachinePriorityAnnotation, "1"))
+
+			newMachine, changed = ExportComputeMachinePriority(*newMachine, protectedNodes, nil)
+
+			Expect(changed).To(BeTrue())
+			Expect(newMachine.Annotations).NotTo(HaveKey(common.MachinePriorityAnnotation))
+			Expect(newMachine.Annotations).NotTo(HaveKey(common.MachinePriorityAppliedAnnotation))
+		})
+
+		It("should prefer the protection over the scale-down priority of the worker group", func() {
+			newMachine, changed := ExportComputeMachinePriority(machine("protected", map[string]string{common.MachinePriorityAnnotation: "1"}), protectedNodes, map[string]string{"protected": "2"})
+
+			Expect(changed).To(BeTrue())
+			Expect(newMachine.Annotations).To(HaveKeyWithValue(common.MachinePriorityAnnotation, "5"))
+
+			newMachine, changed = ExportComputeMachinePriority(*newMachine, sets.NewString(), map[string]string{"protected": "2"})
+
+			Expect(changed).To(BeTrue())
+			Expect(newMachine.Annotations).To(HaveKeyWithValue(common.MachinePriorityAnnotation, "2"))
+		})
+	})
+
+	Describe("#protectedNodes", func() {
+		It("should protect annotated nodes and nodes running pods which must not be evicted", func() {
+			var (
+				noEviction = map[string]string{common.ClusterAutoscalerSafeToEvictAnnotation: "false"}
+				nodes      = []corev1.Node{
+					{ObjectMeta: metav1.ObjectMeta{Name: "annotated", Annotations: map[string]string{common.ScaleDownProtectionAnnotation: "true"}}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "job"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "finished-job"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+				}
+				pods = []corev1.Pod{
+					{ObjectMeta: metav1.ObjectMeta{Name: "job", Annotations: noEviction}, Spec: corev1.PodSpec{NodeName: "job"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "finished-job", Annotations: noEviction}, Spec: corev1.PodSpec{NodeName: "finished-job"}, Status: corev1.PodStatus{Phase: corev1.PodSucceeded}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "pending-job", Annotations: noEviction}, Status: corev1.PodStatus{Phase: corev1.PodPending}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "web", Annotations: map[string]string{common.ClusterAutoscalerSafeToEvictAnnotation: "true"}}, Spec: corev1.PodSpec{NodeName: "other"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+				}
+			)
+
+			Expect(ExportProtectedNodes(nodes, pods).List()).To(ConsistOf("annotated", "job"))
+		})
+	})
+
+	Describe("#nodeMachinePriorities", func() {
+		It("should map the nodes to the scale-down priorities of their worker groups", func() {
+			var (
+				priority = int32(4)
+				workers  = []gardenv1beta1.Worker{{Name: "batch", ScaleDownPriority: &priority}, {Name: "default"}}
+				nodes    = []corev1.Node{
+					{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{common.WorkerGroupLabel: "batch"}}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "node-2", Labels: map[string]string{common.WorkerGroupLabel: "default"}}},
+				}
+			)
+
+			Expect(ExportNodeMachinePriorities(nodes, workers)).To(Equal(map[string]string{"node-1": "4"}))
+		})
+	})
+})
@@ -15,9 +15,13 @@
 package hybridbotanist
 
 import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/botanist"
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/machinecredentials"
 )
 
 // HybridBotanist is a struct which contains the "normal" Botanist as well as the CloudBotanist.
@@ -28,4 +32,42 @@ type HybridBotanist struct {
 	Botanist           *botanist.Botanist
 	SeedCloudBotanist  cloudbotanist.CloudBotanist
 	ShootCloudBotanist cloudbotanist.CloudBotanist
+
+	// MachineWaitTimeout is the maximum duration the HybridBotanist waits until the machines of the Shoot have
+	// been rolled out or deleted. A zero value means the default of 30 minutes.
+	MachineWaitTimeout time.Duration
+	// MachineWaitPollInterval is the initial interval in which the machine resources are re-checked while waiting for
+	// them if they do not change. It is backed off exponentially. A zero value means the default of 5 seconds.
+	MachineWaitPollInterval time.Duration
+	// MachineDeploymentProgressTimeout is the maximum duration a machine deployment may not make any progress before
+	// the rollout of the machines fails. A zero value disables the check.
+	MachineDeploymentProgressTimeout time.Duration
+	// NodeDrainTimeout is the maximum duration the nodes of the Shoot are drained before its machines are
+	// forcefully deleted. A zero value disables the drain.
+	NodeDrainTimeout time.Duration
+	// MachineForceDeletionConcurrency is the maximum number of machines which are labeled for the forceful deletion
+	// concurrently. Values below one are treated as one.
+	MachineForceDeletionConcurrency int
+	// OrphanedMachineGracePeriod is the minimum age of a machine whose instance does not exist at the cloud provider
+	// anymore before it is deleted. A zero value disables the deletion.
+	OrphanedMachineGracePeriod time.Duration
+	// OrphanedNodeGracePeriod is the minimum duration a node which does not belong to any machine must have been not
+	// ready before it is deleted. A zero value disables the deletion.
+	OrphanedNodeGracePeriod time.Duration
+	// MachineCredentialsIssuer issues the cloud provider credentials for the machine class secrets from an external
+	// secret backend. A nil value means that the static credentials of the Shoot are used.
+	MachineCredentialsIssuer machinecredentials.Issuer
+	// MachineRolloutReporter is called with the progress of the rollout of the machines whenever it changes while
+	// the HybridBotanist waits until the machine deployments are available. A nil value disables the reporting.
+	MachineRolloutReporter func(*gardenv1beta1.ShootMachinesStatus)
+
+	// pendingReplacements are the names of the worker groups whose machine replacement has been deferred until the
+	// maintenance time window by the last DeployMachines call.
+	pendingReplacements []string
+	// canaries is the progress of the canary rollouts which are soaking or have been halted by the last DeployMachines
+	// call.
+	canaries []gardenv1beta1.ShootMachineCanaryStatus
+	// scaleDownLimits are the minimum replicas of the machine deployments whose scale-down has been limited by the
+	// scale-down budgets in the last DeployMachines call.
+	scaleDownLimits map[string]int
 }
@@ -0,0 +1,84 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operation
+
+import "sync"
+
+// InfrastructureState contains the outputs of the infrastructure of a Shoot cluster which are required to create its
+// machines. The fields which a cloud provider does not use are empty.
+type InfrastructureState struct {
+	// ResourceGroup is the name of the resource group of the Shoot (Azure).
+	ResourceGroup string
+	// Network is the name or ID of the network of the Shoot (the VNet name on Azure, the network ID on OpenStack).
+	Network string
+	// Subnets are the names or IDs of the subnets of the nodes. Cloud providers with a subnet per zone (AWS) have one
+	// per zone index, the others have a single subnet for all zones.
+	Subnets []string
+	// SecurityGroups are the names or IDs of the security groups of all nodes (AWS, OpenStack).
+	SecurityGroups []string
+	// WorkerSecurityGroups are the names or IDs of the additional security groups of the worker groups with firewall
+	// rules, keyed by the names of the worker groups (AWS, OpenStack).
+	WorkerSecurityGroups map[string]string
+	// ServiceAccount is the identity of the machines (the IAM instance profile on AWS, the service account e-mail
+	// on GCP).
+	ServiceAccount string
+	// KeyName is the name of the SSH key pair of the machines (AWS, OpenStack).
+	KeyName string
+	// AvailabilitySetID is the ID of the availability set of the machines (Azure).
+	AvailabilitySetID string
+}
+
+// Subnet returns the subnet of the nodes in the zone with the given <zoneIndex>, or the single subnet of all zones.
+func (s *InfrastructureState) Subnet(zoneIndex int) string {
+	if len(s.Subnets) == 1 {
+		return s.Subnets[0]
+	}
+	if zoneIndex < len(s.Subnets) {
+		return s.Subnets[zoneIndex]
+	}
+	return ""
+}
+
+// InfrastructureStateCache caches the InfrastructureState of a Shoot cluster for the duration of an operation, so that
+// it is read only once per reconciliation although several steps need it. It must be invalidated whenever the
+// infrastructure is applied or destroyed. The zero value is an empty cache which is safe for concurrent use.
+type InfrastructureStateCache struct {
+	mutex sync.Mutex
+	state *InfrastructureState
+}
+
+// Get returns the cached InfrastructureState. If the cache is empty, the state is read with <read> and cached. Errors
+// are not cached. The returned state is shared and must not be modified.
+func (c *InfrastructureStateCache) Get(read func() (*InfrastructureState, error)) (*InfrastructureState, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.state == nil {
+		state, err := read()
+		if err != nil {
+			return nil, err
+		}
+		c.state = state
+	}
+	return c.state, nil
+}
+
+// Invalidate empties the cache, so that the InfrastructureState is read again when it is needed the next time.
+func (c *InfrastructureStateCache) Invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.state = nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machinecredentials
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// Issuer issues short-lived cloud provider credentials for the machine class secrets of Shoots from an external
+// secret backend, so that the machine classes do not contain the static credentials of the Shoots.
+type Issuer interface {
+	// Issues returns true if the issuer issues credentials for Shoots of the given <cloudProvider>.
+	Issues(cloudProvider gardenv1beta1.CloudProvider) bool
+	// Issue issues new credentials for a Shoot of the given <cloudProvider>.
+	Issue(cloudProvider gardenv1beta1.CloudProvider) (*Lease, error)
+	// RenewBefore returns the duration before the expiry of issued credentials in which new credentials are issued.
+	RenewBefore() time.Duration
+}
+
+// Lease is a set of credentials which has been issued by an Issuer.
+type Lease struct {
+	// ID is the identifier of the lease in the secret backend.
+	ID string
+	// Data contains the credentials with the keys of the cloud provider secret of a Shoot (e.g., accessKeyID). They
+	// overwrite the static credentials of the Shoot.
+	Data map[string][]byte
+	// Expiry is the time when the credentials expire. It is zero if they do not expire.
+	Expiry time.Time
+}
+
+// NewIssuer creates an Issuer for the secret backend of the given <config>. It returns nil if no secret backend is
+// configured.
+func NewIssuer(config *componentconfig.MachineCredentialsConfiguration) (Issuer, error) {
+	if config == nil || config.Vault == nil {
+		return nil, nil
+	}
+	return newVaultIssuer(config.Vault)
+}
+
+// NeedsRenewal returns true if the credentials which expire at <expiry> must be renewed at <now>, i.e. they expire
+// within <renewBefore>. Credentials without an expiry never need a renewal.
+func NeedsRenewal(expiry, now time.Time, renewBefore time.Duration) bool {
+	return !expiry.IsZero() && !now.Add(renewBefore).Before(expiry)
+}
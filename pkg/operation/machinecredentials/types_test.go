@@ -0,0 +1,54 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machinecredentials_test
+
+import (
+	"time"
+
+	. "github.com/gardener/gardener/pkg/operation/machinecredentials"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("machinecredentials", func() {
+	Describe("#NewIssuer", func() {
+		It("should not create an issuer if no secret backend is configured", func() {
+			issuer, err := NewIssuer(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issuer).To(BeNil())
+		})
+	})
+
+	Describe("#NeedsRenewal", func() {
+		var now = time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+		It("should not renew credentials without an expiry", func() {
+			Expect(NeedsRenewal(time.Time{}, now, time.Hour)).To(BeFalse())
+		})
+
+		It("should not renew credentials which expire after the renewal window", func() {
+			Expect(NeedsRenewal(now.Add(2*time.Hour), now, time.Hour)).To(BeFalse())
+		})
+
+		It("should renew credentials which expire within the renewal window", func() {
+			Expect(NeedsRenewal(now.Add(30*time.Minute), now, time.Hour)).To(BeTrue())
+		})
+
+		It("should renew expired credentials", func() {
+			Expect(NeedsRenewal(now.Add(-time.Minute), now, 0)).To(BeTrue())
+		})
+	})
+})
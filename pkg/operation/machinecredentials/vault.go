@@ -0,0 +1,140 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machinecredentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+)
+
+// vaultIssuer reads the credentials from the paths of a HashiCorp Vault, e.g. from its AWS secrets engine.
+type vaultIssuer struct {
+	address     *url.URL
+	tokenFile   string
+	paths       map[string]string
+	dataKeys    map[string]string
+	renewBefore time.Duration
+	client      *http.Client
+}
+
+// vaultSecret is the part of the response of Vault to a read request which is relevant for the Gardener.
+type vaultSecret struct {
+	LeaseID       string                 `json:"lease_id"`
+	LeaseDuration int64                  `json:"lease_duration"`
+	Data          map[string]interface{} `json:"data"`
+}
+
+func newVaultIssuer(config *componentconfig.VaultMachineCredentialsConfiguration) (*vaultIssuer, error) {
+	address, err := url.Parse(config.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Vault address %q: %s", config.Address, err.Error())
+	}
+	if len(config.TokenFile) == 0 {
+		return nil, fmt.Errorf("the token file of the Vault %s must be set", config.Address)
+	}
+
+	var renewBefore time.Duration
+	if config.RenewBefore != nil {
+		renewBefore = config.RenewBefore.Duration
+	}
+
+	return &vaultIssuer{
+		address:     address,
+		tokenFile:   config.TokenFile,
+		paths:       config.Paths,
+		dataKeys:    config.DataKeys,
+		renewBefore: renewBefore,
+		client:      &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Issues returns true if a Vault path is configured for the given <cloudProvider>.
+func (v *vaultIssuer) Issues(cloudProvider gardenv1beta1.CloudProvider) bool {
+	_, ok := v.paths[string(cloudProvider)]
+	return ok
+}
+
+// Issue reads the Vault path of the given <cloudProvider>. Dynamic secrets engines issue new credentials with every
+// read.
+func (v *vaultIssuer) Issue(cloudProvider gardenv1beta1.CloudProvider) (*Lease, error) {
+	path, ok := v.paths[string(cloudProvider)]
+	if !ok {
+		return nil, fmt.Errorf("no Vault path is configured for cloud provider %s", cloudProvider)
+	}
+
+	token, err := ioutil.ReadFile(v.tokenFile)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequest(http.MethodGet, v.address.ResolveReference(&url.URL{Path: "/v1/" + strings.TrimPrefix(path, "/")}).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+
+	now := time.Now()
+	response, err := v.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("reading the Vault path %s failed with status %d: %s", path, response.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	secret := &vaultSecret{}
+	if err := json.Unmarshal(body, secret); err != nil {
+		return nil, fmt.Errorf("could not decode the response of the Vault path %s: %s", path, err.Error())
+	}
+
+	lease := &Lease{ID: secret.LeaseID, Data: map[string][]byte{}}
+	if secret.LeaseDuration > 0 {
+		lease.Expiry = now.Add(time.Duration(secret.LeaseDuration) * time.Second)
+	}
+	for key, value := range secret.Data {
+		stringValue, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("the value of key %s of the Vault path %s is not a string", key, path)
+		}
+		if mappedKey, ok := v.dataKeys[key]; ok {
+			key = mappedKey
+		}
+		lease.Data[key] = []byte(stringValue)
+	}
+	if len(lease.Data) == 0 {
+		return nil, fmt.Errorf("the Vault path %s does not contain any credentials", path)
+	}
+
+	return lease, nil
+}
+
+// RenewBefore returns the configured duration before the expiry of the credentials in which new ones are issued.
+func (v *vaultIssuer) RenewBefore() time.Duration {
+	return v.renewBefore
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machinecredentials_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/componentconfig"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/operation/machinecredentials"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("vault", func() {
+	var (
+		server   *httptest.Server
+		tmpDir   string
+		config   *componentconfig.MachineCredentialsConfiguration
+		status   int
+		response string
+		token    string
+		path     string
+	)
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		response = `{"lease_id":"aws/creds/shoots/abc","lease_duration":3600,"data":{"access_key":"AKIA","secret_key":"secret"}}`
+
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token = r.Header.Get("X-Vault-Token")
+			path = r.URL.Path
+			w.WriteHeader(status)
+			w.Write([]byte(response))
+		}))
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "machinecredentials")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(filepath.Join(tmpDir, "token"), []byte("s.token\n"), 0600)).To(Succeed())
+
+		config = &componentconfig.MachineCredentialsConfiguration{
+			Vault: &componentconfig.VaultMachineCredentialsConfiguration{
+				Address:   server.URL,
+				TokenFile: filepath.Join(tmpDir, "token"),
+				Paths: map[string]string{
+					string(gardenv1beta1.CloudProviderAWS): "aws/creds/shoots",
+				},
+				DataKeys: map[string]string{
+					"access_key": "accessKeyID",
+					"secret_key": "secretAccessKey",
+				},
+				RenewBefore: &metav1.Duration{Duration: time.Hour},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+		os.RemoveAll(tmpDir)
+	})
+
+	It("should only issue credentials for cloud providers with a configured path", func() {
+		issuer, err := NewIssuer(config)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issuer.Issues(gardenv1beta1.CloudProviderAWS)).To(BeTrue())
+		Expect(issuer.Issues(gardenv1beta1.CloudProviderGCP)).To(BeFalse())
+		Expect(issuer.RenewBefore()).To(Equal(time.Hour))
+	})
+
+	It("should fail to create an issuer without a token file", func() {
+		config.Vault.TokenFile = ""
+
+		_, err := NewIssuer(config)
+
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should issue credentials with the keys of the cloud provider secret", func() {
+		issuer, err := NewIssuer(config)
+		Expect(err).NotTo(HaveOccurred())
+
+		before := time.Now()
+		lease, err := issuer.Issue(gardenv1beta1.CloudProviderAWS)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token).To(Equal("s.token"))
+		Expect(path).To(Equal("/v1/aws/creds/shoots"))
+		Expect(lease.ID).To(Equal("aws/creds/shoots/abc"))
+		Expect(lease.Data).To(Equal(map[string][]byte{
+			"accessKeyID":     []byte("AKIA"),
+			"secretAccessKey": []byte("secret"),
+		}))
+		Expect(lease.Expiry).To(BeTemporally(">=", before.Add(time.Hour)))
+		Expect(lease.Expiry).To(BeTemporally("<=", time.Now().Add(time.Hour)))
+	})
+
+	It("should not set an expiry for credentials without a lease duration", func() {
+		response = `{"data":{"accessKeyID":"AKIA","secretAccessKey":"secret"}}`
+		issuer, err := NewIssuer(config)
+		Expect(err).NotTo(HaveOccurred())
+
+		lease, err := issuer.Issue(gardenv1beta1.CloudProviderAWS)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lease.Expiry.IsZero()).To(BeTrue())
+		Expect(lease.Data).To(HaveKey("accessKeyID"))
+	})
+
+	It("should fail if Vault rejects the request", func() {
+		status = http.StatusForbidden
+		response = `{"errors":["permission denied"]}`
+		issuer, err := NewIssuer(config)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = issuer.Issue(gardenv1beta1.CloudProviderAWS)
+
+		Expect(err).To(MatchError(ContainSubstring("permission denied")))
+	})
+
+	It("should fail if the path does not contain any credentials", func() {
+		response = `{"data":{}}`
+		issuer, err := NewIssuer(config)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = issuer.Issue(gardenv1beta1.CloudProviderAWS)
+
+		Expect(err).To(HaveOccurred())
+	})
+})
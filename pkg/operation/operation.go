@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
@@ -94,7 +95,7 @@ func (o *Operation) InitializeSeedClients() error {
 		return nil
 	}
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(o.Seed.Secret)
+	k8sSeedClient, err := kubernetes.NewSeedClientFromSecretObject(o.Seed.Info.Name, o.Seed.Secret)
 	if err != nil {
 		return err
 	}
@@ -164,6 +165,41 @@ func (o *Operation) ReportShootProgress(progress int, currentFunctions string) {
 	}
 }
 
+// ReportShootStep will append the duration of a completed step of the Flow execution to the list of steps in the
+// Shoot manifest's `status.lastOperation` section. The list is persisted with the next progress report.
+func (o *Operation) ReportShootStep(name string, duration time.Duration, failed bool) {
+	state := gardenv1beta1.ShootLastOperationStateSucceeded
+	if failed {
+		state = gardenv1beta1.ShootLastOperationStateError
+	}
+
+	o.Shoot.Info.Status.LastOperation.Steps = append(o.Shoot.Info.Status.LastOperation.Steps, gardenv1beta1.LastOperationStep{
+		Name:     sanitizeFunctionNames(name),
+		Duration: metav1.Duration{Duration: duration.Round(time.Second)},
+		State:    state,
+	})
+}
+
+// ReportShootMachines will update the progress of the rollout of the machines in the Shoot manifest's
+// `status.machines` section.
+func (o *Operation) ReportShootMachines(status *gardenv1beta1.ShootMachinesStatus) {
+	o.Shoot.Info.Status.Machines = status
+
+	if newShoot, err := o.K8sGardenClient.GardenClientset().GardenV1beta1().Shoots(o.Shoot.Info.Namespace).UpdateStatus(o.Shoot.Info); err == nil {
+		o.Shoot.Info = newShoot
+	}
+}
+
+// ReportShootEtcdOperationLock will update the holder of the etcd operation lock in the Shoot manifest's
+// `status.etcdOperationLock` section.
+func (o *Operation) ReportShootEtcdOperationLock(lock *gardenv1beta1.ShootEtcdOperationLock) {
+	o.Shoot.Info.Status.EtcdOperationLock = lock
+
+	if newShoot, err := o.K8sGardenClient.GardenClientset().GardenV1beta1().Shoots(o.Shoot.Info.Namespace).UpdateStatus(o.Shoot.Info); err == nil {
+		o.Shoot.Info = newShoot
+	}
+}
+
 // ReportBackupInfrastructureProgress will update the phase and error in the BackupInfrastructure manifest `status` section
 // by the current progress of the Flow execution.
 func (o *Operation) ReportBackupInfrastructureProgress(progress int, currentFunctions string) {
@@ -204,6 +240,34 @@ func (o *Operation) InjectImages(values map[string]interface{}, version string,
 	return copy, nil
 }
 
+// ComputeImageArchitectures returns the CPU architectures on which all images with the given <imageNames> can run.
+// It is used to restrict the nodes of DaemonSets to the architectures supported by their images.
+func (o *Operation) ComputeImageArchitectures(version string, imageNames ...string) ([]string, error) {
+	var architectures []string
+
+	for _, imageName := range imageNames {
+		image, err := o.ImageVector.FindImage(imageName, version)
+		if err != nil {
+			return nil, err
+		}
+
+		if architectures == nil {
+			architectures = image.GetArchitectures()
+			continue
+		}
+
+		supported := []string{}
+		for _, architecture := range architectures {
+			if image.SupportsArchitecture(architecture) {
+				supported = append(supported, architecture)
+			}
+		}
+		architectures = supported
+	}
+
+	return architectures, nil
+}
+
 // ComputeDownloaderCloudConfig computes the downloader cloud config which is injected as user data while
 // creating machines/VMs. It needs the name of the worker group it is used for (<workerName>) and returns
 // the rendered chart.
@@ -214,6 +278,30 @@ func (o *Operation) ComputeDownloaderCloudConfig(workerName string) (*chartrende
 	})
 }
 
+// ComputeDownloaderUserData computes the user data for the machines of the worker group <workerName>, i.e. the
+// downloader cloud config. If the user data is larger than <maxSize> bytes (the limit of the cloud provider), the
+// files written by the cloud config are compressed. A <maxSize> of zero means that the user data is not limited.
+func (o *Operation) ComputeDownloaderUserData(workerName string, maxSize int) (string, error) {
+	cloudConfig, err := o.ComputeDownloaderCloudConfig(workerName)
+	if err != nil {
+		return "", err
+	}
+
+	userData := cloudConfig.FileContent("cloud-config.yaml")
+	if maxSize == 0 || len(userData) <= maxSize {
+		return userData, nil
+	}
+
+	compressed, err := common.CompressCloudConfigFiles(userData)
+	if err != nil {
+		return "", err
+	}
+	if len(compressed) > maxSize {
+		return "", fmt.Errorf("the user data of worker group %s has %d bytes even when compressed, but the cloud provider only allows %d bytes", workerName, len(compressed), maxSize)
+	}
+	return compressed, nil
+}
+
 // ComputeOriginalCloudConfig computes the original cloud config which is downloaded by the cloud config
 // downloader process running on machines/VMs. It will regularly check for new versions and restart all
 // units once it finds a newer state.
@@ -246,10 +334,11 @@ func NameContainedInMachineDeploymentList(name string, machineDeployments []Mach
 }
 
 // ClassContainedInMachineDeploymentList checks whether the <className> is part of the <machineDeployments>
-// list, i.e. whether there is an entry whose 'ClassName' attribute matches <name>. It returns true or false.
+// list, i.e. whether there is an entry whose 'ClassName' or 'FallbackClassName' attribute matches <name>. It returns
+// true or false.
 func ClassContainedInMachineDeploymentList(className string, machineDeployments []MachineDeployment) bool {
 	for _, deployment := range machineDeployments {
-		if className == deployment.ClassName {
+		if className == deployment.ClassName || (len(deployment.FallbackClassName) > 0 && className == deployment.FallbackClassName) {
 			return true
 		}
 	}
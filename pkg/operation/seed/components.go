@@ -0,0 +1,123 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// seedCoreComponent is the name of the seed component which consists of all templates of the seed-bootstrap chart
+// which do not belong to any other component, e.g. the priority classes and the cluster roles.
+const seedCoreComponent = "core"
+
+// seedComponent is a component which the Gardener deploys into every Seed cluster. It consists of the templates of the
+// seed-bootstrap chart whose paths (relative to its templates directory) start with one of the given prefixes.
+type seedComponent struct {
+	name     string
+	prefixes []string
+}
+
+// seedComponents are the components of the seed-bootstrap chart (besides the core component) in the order in which
+// they are deployed after the core component.
+var seedComponents = []seedComponent{
+	{name: "custom-resource-definitions", prefixes: []string{"crd-"}},
+	{name: "vpn", prefixes: []string{"vpn/"}},
+	{name: "ingress", prefixes: []string{"nginx-ingress/"}},
+	{name: "monitoring", prefixes: []string{"prometheus/", "clusterrole-prometheus.yaml", "clusterrole-kube-state-metrics.yaml"}},
+	{name: "machine-class-validator", prefixes: []string{"machine-class-validator/"}},
+}
+
+// seedComponentManifest is the rendered manifest of a seed component and its checksum.
+type seedComponentManifest struct {
+	name     string
+	manifest []byte
+	checksum string
+}
+
+// splitSeedComponents splits the rendered seed-bootstrap <release> into the manifests of the seed components in the
+// order in which they are deployed. The templates of a component are ordered by their paths, hence, the checksum of a
+// component only changes if its rendered templates change.
+func splitSeedComponents(release *chartrenderer.RenderedChart) []seedComponentManifest {
+	var (
+		templatesPrefix = release.ChartName + "/templates/"
+		files           = map[string][]string{}
+	)
+
+	for path := range release.Files {
+		name := seedCoreComponent
+		for _, component := range seedComponents {
+			for _, prefix := range component.prefixes {
+				if strings.HasPrefix(strings.TrimPrefix(path, templatesPrefix), prefix) {
+					name = component.name
+				}
+			}
+		}
+		files[name] = append(files[name], path)
+	}
+
+	names := []string{seedCoreComponent}
+	for _, component := range seedComponents {
+		names = append(names, component.name)
+	}
+
+	manifests := make([]seedComponentManifest, 0, len(names))
+	for _, name := range names {
+		var manifest bytes.Buffer
+		sort.Strings(files[name])
+		for _, path := range files[name] {
+			manifest.WriteString("\n---\n# Source: " + path + "\n")
+			manifest.WriteString(release.Files[path])
+		}
+		manifests = append(manifests, seedComponentManifest{
+			name:     name,
+			manifest: manifest.Bytes(),
+			checksum: utils.ComputeSHA256Hex(manifest.Bytes()),
+		})
+	}
+	return manifests
+}
+
+// seedComponentStatuses returns the statuses of the seed components with the given <manifests> after they have been
+// deployed by the Gardener of the given <version> at <now>. Components which have neither changed nor been deployed by
+// another version of the Gardener keep their <existing> statuses, hence, the last update time is the time of the last
+// installation or upgrade of a component.
+func seedComponentStatuses(existing []gardenv1beta1.SeedComponentStatus, manifests []seedComponentManifest, version string, now metav1.Time) []gardenv1beta1.SeedComponentStatus {
+	existingByName := make(map[string]gardenv1beta1.SeedComponentStatus, len(existing))
+	for _, status := range existing {
+		existingByName[status.Name] = status
+	}
+
+	statuses := make([]gardenv1beta1.SeedComponentStatus, 0, len(manifests))
+	for _, manifest := range manifests {
+		if status, ok := existingByName[manifest.name]; ok && status.Version == version && status.Checksum == manifest.checksum {
+			statuses = append(statuses, status)
+			continue
+		}
+		statuses = append(statuses, gardenv1beta1.SeedComponentStatus{
+			Name:           manifest.name,
+			Version:        version,
+			Checksum:       manifest.checksum,
+			LastUpdateTime: now,
+		})
+	}
+	return statuses
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	. "github.com/gardener/gardener/pkg/operation/seed"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("components", func() {
+	newRelease := func(prometheusConfig string) *chartrenderer.RenderedChart {
+		return &chartrenderer.RenderedChart{
+			ChartName: "seed-bootstrap",
+			Files: map[string]string{
+				"seed-bootstrap/templates/priorityclasses.yaml":                        "kind: PriorityClass",
+				"seed-bootstrap/templates/clusterrole-machine-controller-manager.yaml": "kind: ClusterRole",
+				"seed-bootstrap/templates/crd-machines.yaml":                           "kind: CustomResourceDefinition",
+				"seed-bootstrap/templates/vpn/podsecuritypolicy.yaml":                  "kind: PodSecurityPolicy",
+				"seed-bootstrap/templates/nginx-ingress/controller-deployment.yaml":    "kind: Deployment",
+				"seed-bootstrap/templates/clusterrole-prometheus.yaml":                 "kind: ClusterRole",
+				"seed-bootstrap/templates/prometheus/config.yaml":                      prometheusConfig,
+				"seed-bootstrap/templates/machine-class-validator/deployment.yaml":     "kind: Deployment",
+			},
+		}
+	}
+
+	Describe("#splitSeedComponents", func() {
+		It("should split the rendered chart into the seed components in their deployment order", func() {
+			names, manifests := ExportSeedComponentManifests(newRelease("kind: ConfigMap"))
+
+			Expect(names).To(Equal([]string{"core", "custom-resource-definitions", "vpn", "ingress", "monitoring", "machine-class-validator"}))
+			Expect(manifests["core"]).To(Equal("\n---\n# Source: seed-bootstrap/templates/clusterrole-machine-controller-manager.yaml\nkind: ClusterRole" +
+				"\n---\n# Source: seed-bootstrap/templates/priorityclasses.yaml\nkind: PriorityClass"))
+			Expect(manifests["custom-resource-definitions"]).To(Equal("\n---\n# Source: seed-bootstrap/templates/crd-machines.yaml\nkind: CustomResourceDefinition"))
+			Expect(manifests["vpn"]).To(Equal("\n---\n# Source: seed-bootstrap/templates/vpn/podsecuritypolicy.yaml\nkind: PodSecurityPolicy"))
+			Expect(manifests["ingress"]).To(Equal("\n---\n# Source: seed-bootstrap/templates/nginx-ingress/controller-deployment.yaml\nkind: Deployment"))
+			Expect(manifests["monitoring"]).To(Equal("\n---\n# Source: seed-bootstrap/templates/clusterrole-prometheus.yaml\nkind: ClusterRole" +
+				"\n---\n# Source: seed-bootstrap/templates/prometheus/config.yaml\nkind: ConfigMap"))
+			Expect(manifests["machine-class-validator"]).To(Equal("\n---\n# Source: seed-bootstrap/templates/machine-class-validator/deployment.yaml\nkind: Deployment"))
+		})
+	})
+
+	Describe("#seedComponentStatuses", func() {
+		var (
+			then = metav1.NewTime(time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC))
+			now  = metav1.NewTime(then.Add(time.Hour))
+		)
+
+		It("should report all seed components as updated on the first deployment", func() {
+			statuses := ExportSeedComponentStatuses(nil, newRelease("kind: ConfigMap"), "0.10.0", now)
+
+			Expect(statuses).To(HaveLen(6))
+			for _, status := range statuses {
+				Expect(status.Version).To(Equal("0.10.0"))
+				Expect(status.Checksum).NotTo(BeEmpty())
+				Expect(status.LastUpdateTime).To(Equal(now))
+			}
+		})
+
+		It("should only update the statuses of changed seed components", func() {
+			existing := ExportSeedComponentStatuses(nil, newRelease("kind: ConfigMap"), "0.10.0", then)
+
+			statuses := ExportSeedComponentStatuses(existing, newRelease("kind: ConfigMap\ndata: {}"), "0.10.0", now)
+
+			Expect(statuses).To(HaveLen(6))
+			for i, status := range statuses {
+				if status.Name == "monitoring" {
+					Expect(status.Checksum).NotTo(Equal(existing[i].Checksum))
+					Expect(status.LastUpdateTime).To(Equal(now))
+					continue
+				}
+				Expect(status).To(Equal(existing[i]))
+			}
+		})
+
+		It("should update the statuses of all seed components if the Gardener version changed", func() {
+			existing := ExportSeedComponentStatuses(nil, newRelease("kind: ConfigMap"), "0.10.0", then)
+
+			statuses := ExportSeedComponentStatuses(existing, newRelease("kind: ConfigMap"), "0.11.0", now)
+
+			Expect(statuses).To(HaveLen(6))
+			for i, status := range statuses {
+				Expect(status).To(Equal(gardenv1beta1.SeedComponentStatus{
+					Name:           existing[i].Name,
+					Version:        "0.11.0",
+					Checksum:       existing[i].Checksum,
+					LastUpdateTime: now,
+				}))
+			}
+		})
+	})
+})
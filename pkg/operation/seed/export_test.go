@@ -0,0 +1,43 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExportValidatePermissions exports validatePermissions for testing.
+var ExportValidatePermissions = validatePermissions
+
+// ExportSeedComponentManifests returns the manifests of the seed components of the given release by their names for
+// testing.
+func ExportSeedComponentManifests(release *chartrenderer.RenderedChart) ([]string, map[string]string) {
+	var (
+		names     []string
+		manifests = map[string]string{}
+	)
+	for _, manifest := range splitSeedComponents(release) {
+		names = append(names, manifest.name)
+		manifests[manifest.name] = string(manifest.manifest)
+	}
+	return names, manifests
+}
+
+// ExportSeedComponentStatuses exports seedComponentStatuses for the seed components of the given release for testing.
+func ExportSeedComponentStatuses(existing []gardenv1beta1.SeedComponentStatus, release *chartrenderer.RenderedChart, version string, now metav1.Time) []gardenv1beta1.SeedComponentStatus {
+	return seedComponentStatuses(existing, splitSeedComponents(release), version, now)
+}
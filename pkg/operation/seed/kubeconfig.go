@@ -0,0 +1,60 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	kubernetesclientset "k8s.io/client-go/kubernetes"
+)
+
+// ValidateKubeconfig checks whether the kubeconfig stored in the given <secret> grants access to the Seed cluster,
+// i.e. whether its API server is reachable with it and whether it is allowed to manage all resources.
+func ValidateKubeconfig(secret *corev1.Secret) error {
+	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(secret)
+	if err != nil {
+		return fmt.Errorf("could not connect to the Seed cluster: %v", err)
+	}
+	return validatePermissions(k8sSeedClient.Clientset())
+}
+
+// validatePermissions checks whether the user of the given <clientset> is allowed to manage all resources. The
+// Gardener deploys the control planes of the Shoots with many different resources, hence, it requires admin rights.
+func validatePermissions(clientset kubernetesclientset.Interface) error {
+	review, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(&authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Verb:     "*",
+				Group:    "*",
+				Resource: "*",
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("could not check the permissions in the Seed cluster: %v", err)
+	}
+	if !review.Status.Allowed {
+		message := "the credentials are not allowed to manage all resources of the Seed cluster"
+		if len(review.Status.Reason) > 0 {
+			message += ": " + review.Status.Reason
+		}
+		return errors.New(message)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/gardener/gardener/pkg/operation/seed"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+var _ = Describe("kubeconfig", func() {
+	Describe("#validatePermissions", func() {
+		var server *httptest.Server
+
+		newClientset := func(allowed bool, reason string) kubernetes.Interface {
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+
+				var review authorizationv1.SelfSubjectAccessReview
+				Expect(r.URL.Path).To(Equal("/apis/authorization.k8s.io/v1/selfsubjectaccessreviews"))
+				Expect(json.NewDecoder(r.Body).Decode(&review)).To(Succeed())
+				Expect(review.Spec.ResourceAttributes.Verb).To(Equal("*"))
+				Expect(review.Spec.ResourceAttributes.Resource).To(Equal("*"))
+
+				review.Status = authorizationv1.SubjectAccessReviewStatus{Allowed: allowed, Reason: reason}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				json.NewEncoder(w).Encode(review)
+			}))
+			return kubernetes.NewForConfigOrDie(&rest.Config{Host: server.URL})
+		}
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should succeed if the credentials may manage all resources", func() {
+			Expect(ExportValidatePermissions(newClientset(true, ""))).To(Succeed())
+		})
+
+		It("should fail if the credentials may not manage all resources", func() {
+			err := ExportValidatePermissions(newClientset(false, "no RBAC policy matched"))
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no RBAC policy matched"))
+		})
+	})
+})
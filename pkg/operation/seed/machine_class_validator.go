@@ -0,0 +1,158 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/version"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const machineClassValidatorName = "gardener-machine-class-validator"
+
+// machineClassResources maps the cloud providers to the resource names of their machine classes.
+var machineClassResources = map[gardenv1beta1.CloudProvider]string{
+	gardenv1beta1.CloudProviderAWS:       "awsmachineclasses",
+	gardenv1beta1.CloudProviderAzure:     "azuremachineclasses",
+	gardenv1beta1.CloudProviderGCP:       "gcpmachineclasses",
+	gardenv1beta1.CloudProviderOpenStack: "openstackmachineclasses",
+}
+
+// MachineClassValidationEnabled returns true if the machine class validating webhook shall be deployed into
+// the Seed cluster, i.e. if the Seed is annotated accordingly and its cloud provider uses machine classes.
+func (s *Seed) MachineClassValidationEnabled() bool {
+	if _, ok := machineClassResources[s.CloudProvider]; !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(s.Info.Annotations[common.SeedMachineClassValidation])
+	return err == nil && enabled
+}
+
+// computeMachineClassValidatorValues computes the chart values for the machine class validating webhook. If the
+// validation is enabled, the TLS secret of the webhook server is created in the Seed cluster unless it already
+// exists. Otherwise, the webhook configuration and the webhook server are removed from the Seed cluster.
+func computeMachineClassValidatorValues(seed *Seed, k8sSeedClient kubernetes.Client, imageVector imagevector.ImageVector, k8sVersion string) (map[string]interface{}, error) {
+	if !seed.MachineClassValidationEnabled() {
+		return map[string]interface{}{"enabled": false}, deleteMachineClassValidator(k8sSeedClient)
+	}
+
+	image, err := imageVector.FindImage("machine-class-validator", k8sVersion)
+	if err != nil {
+		return nil, err
+	}
+	imageName := image.String()
+	if len(image.Tag) == 0 {
+		imageName = fmt.Sprintf("%s:%s", image.Repository, version.Version)
+	}
+
+	secret, err := k8sSeedClient.GetSecret(common.GardenNamespace, machineClassValidatorName)
+	if apierrors.IsNotFound(err) {
+		data, err := generateMachineClassValidatorCertificates()
+		if err != nil {
+			return nil, err
+		}
+		secret, err = k8sSeedClient.CreateSecret(common.GardenNamespace, machineClassValidatorName, corev1.SecretTypeTLS, data, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"enabled":  true,
+		"image":    imageName,
+		"resource": machineClassResources[seed.CloudProvider],
+		"caBundle": utils.EncodeBase64(secret.Data["ca.crt"]),
+		"checksum": utils.ComputeSHA256Hex(secret.Data[corev1.TLSCertKey]),
+	}, nil
+}
+
+// deleteMachineClassValidator deletes the webhook configuration and the webhook server of the machine class
+// validation from the Seed cluster. The webhook configuration is deleted first so that no further requests
+// are sent to the webhook server.
+func deleteMachineClassValidator(k8sSeedClient kubernetes.Client) error {
+	err := k8sSeedClient.Clientset().AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Delete(machineClassValidatorName, &metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if err := k8sSeedClient.DeleteDeployment(common.GardenNamespace, machineClassValidatorName); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// generateMachineClassValidatorCertificates generates a CA and a server certificate signed by this CA for the
+// service of the machine class validating webhook. It returns the data of the TLS secret containing the
+// PEM-encoded certificates and the private key of the server. The certificates are valid for 10 years.
+func generateMachineClassValidatorCertificates() (map[string][]byte, error) {
+	caPrivateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	caTemplate := machineClassValidatorCertificateTemplate(fmt.Sprintf("%s-ca", machineClassValidatorName), nil)
+	caTemplate.IsCA = true
+	caTemplate.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	caCertificate, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caPrivateKey.PublicKey, caPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	serviceName := fmt.Sprintf("%s.%s.svc", machineClassValidatorName, common.GardenNamespace)
+	template := machineClassValidatorCertificateTemplate(serviceName, []string{machineClassValidatorName, serviceName})
+	template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	certificate, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &privateKey.PublicKey, caPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		"ca.crt":                utils.EncodeCertificate(caCertificate),
+		corev1.TLSCertKey:       utils.EncodeCertificate(certificate),
+		corev1.TLSPrivateKeyKey: utils.EncodePrivateKey(privateKey),
+	}, nil
+}
+
+func machineClassValidatorCertificateTemplate(commonName string, dnsNames []string) *x509.Certificate {
+	serialNumber, _ := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	return &x509.Certificate{
+		BasicConstraintsValid: true,
+		SerialNumber:          serialNumber,
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		Subject: pkix.Name{
+			CommonName: commonName,
+		},
+		DNSNames: dnsNames,
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/version"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -89,13 +90,15 @@ func List(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.
 	return seedList, nil
 }
 
-// BootstrapCluster bootstraps a Seed cluster and deploys various required manifests.
-func BootstrapCluster(seed *Seed, k8sGardenClient kubernetes.Client, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector) error {
+// BootstrapCluster bootstraps a Seed cluster and deploys the seed components one after another. The ingress controller
+// is only deployed if <deployIngressController> is true. It returns the statuses of the deployed components which must
+// be stored in the status of the Seed.
+func BootstrapCluster(seed *Seed, k8sGardenClient kubernetes.Client, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, deployIngressController bool) ([]gardenv1beta1.SeedComponentStatus, error) {
 	const chartName = "seed-bootstrap"
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(seed.Secret)
+	k8sSeedClient, err := kubernetes.NewSeedClientFromSecretObject(seed.Info.Name, seed.Secret)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	gardenNamespace := &corev1.Namespace{
@@ -104,25 +107,55 @@ func BootstrapCluster(seed *Seed, k8sGardenClient kubernetes.Client, secrets map
 		},
 	}
 	if _, err := k8sSeedClient.CreateNamespace(gardenNamespace, false); err != nil && !apierrors.IsAlreadyExists(err) {
-		return err
+		return nil, err
 	}
 
 	prometheusVersion, err := imageVector.FindImage("prometheus", k8sGardenClient.Version())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	configMapReloader, err := imageVector.FindImage("configmap-reloader", k8sGardenClient.Version())
 	if err != nil {
-		return err
+		return nil, err
+	}
+	machineClassValidator, err := computeMachineClassValidatorValues(seed, k8sSeedClient, imageVector, k8sSeedClient.Version())
+	if err != nil {
+		return nil, err
+	}
+	nginxIngressController, err := imageVector.FindImage("nginx-ingress-controller", k8sSeedClient.Version())
+	if err != nil {
+		return nil, err
+	}
+	ingressDefaultBackend, err := imageVector.FindImage("ingress-default-backend", k8sSeedClient.Version())
+	if err != nil {
+		return nil, err
 	}
 
-	return common.ApplyChart(k8sSeedClient, chartrenderer.New(k8sSeedClient), filepath.Join("charts", chartName), chartName, common.GardenNamespace, nil, map[string]interface{}{
+	release, err := chartrenderer.New(k8sSeedClient).Render(filepath.Join("charts", chartName), chartName, common.GardenNamespace, map[string]interface{}{
 		"cloudProvider": seed.CloudProvider,
 		"images": map[string]interface{}{
-			"prometheus":         prometheusVersion.String(),
-			"configmap-reloader": configMapReloader.String(),
+			"prometheus":               prometheusVersion.String(),
+			"configmap-reloader":       configMapReloader.String(),
+			"nginx-ingress-controller": nginxIngressController.String(),
+			"ingress-default-backend":  ingressDefaultBackend.String(),
+		},
+		"machineClassValidator": machineClassValidator,
+		"ingress": map[string]interface{}{
+			"enabled": deployIngressController,
 		},
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := splitSeedComponents(release)
+	for _, manifest := range manifests {
+		if err := k8sSeedClient.Apply(manifest.manifest); err != nil {
+			return nil, fmt.Errorf("failed to deploy seed component %q: %v", manifest.name, err)
+		}
+	}
+
+	return seedComponentStatuses(seed.Info.Status.Components, manifests, version.Version, metav1.Now()), nil
 }
 
 // GetIngressFQDN returns the fully qualified domain name of ingress sub-resource for the Seed cluster. The
@@ -141,7 +174,7 @@ func (s *Seed) CheckMinimumK8SVersion() error {
 		minSeedVersion = "1.8" // CRD garbage collection
 	}
 
-	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(s.Secret)
+	k8sSeedClient, err := kubernetes.NewSeedClientFromSecretObject(s.Info.Name, s.Secret)
 	if err != nil {
 		return err
 	}
@@ -23,6 +23,7 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/operation/common"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
 	"github.com/gardener/gardener/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -79,12 +80,15 @@ func New(k8sGardenClient kubernetes.Client, k8sGardenInformers gardeninformers.I
 	}
 	shootObj.KubernetesMajorMinorVersion = fmt.Sprintf("%d.%d", v.Major(), v.Minor())
 
-	// Check whether the Shoot should be hibernated
-	workers := shootObj.GetWorkers()
-	for _, worker := range workers {
-		if worker.AutoScalerMax != 0 {
-			shootObj.Hibernated = false
-			break
+	// Check whether the Shoot should be hibernated. This is the case if it is explicitly requested in its specification
+	// or if all its worker groups have a maximum size of zero.
+	if hibernation := shoot.Spec.Hibernation; hibernation == nil || !hibernation.Enabled {
+		workers := shootObj.GetWorkers()
+		for _, worker := range workers {
+			if worker.AutoScalerMax != 0 {
+				shootObj.Hibernated = false
+				break
+			}
 		}
 	}
 
@@ -118,7 +122,17 @@ func (s *Shoot) GetWorkers() []gardenv1beta1.Worker {
 		for _, worker := range s.Info.Spec.Cloud.OpenStack.Workers {
 			workers = append(workers, worker.Worker)
 		}
+	case gardenv1beta1.CloudProviderPacket:
+		for _, worker := range s.Info.Spec.Cloud.Packet.Workers {
+			workers = append(workers, worker.Worker)
+		}
 	case gardenv1beta1.CloudProviderLocal:
+		if s.SimulatesMachines() {
+			for _, worker := range s.Info.Spec.Cloud.Local.Workers {
+				workers = append(workers, worker.Worker)
+			}
+			break
+		}
 		workers = append(workers, gardenv1beta1.Worker{
 			Name:          "local",
 			AutoScalerMax: 1,
@@ -129,6 +143,12 @@ func (s *Shoot) GetWorkers() []gardenv1beta1.Worker {
 	return workers
 }
 
+// SimulatesMachines returns true if the Shoot uses the local provider with worker groups whose machines are simulated
+// by a fake machine-controller-manager instead of being created by the local service.
+func (s *Shoot) SimulatesMachines() bool {
+	return s.Info.Spec.Cloud.Local != nil && len(s.Info.Spec.Cloud.Local.Workers) > 0
+}
+
 // GetWorkerNames returns a list of names of the worker groups in the Shoot manifest.
 func (s *Shoot) GetWorkerNames() []string {
 	var (
@@ -143,6 +163,67 @@ func (s *Shoot) GetWorkerNames() []string {
 	return workerNames
 }
 
+// GetExternalWorkers returns the worker groups of the Shoot whose machines are not managed by the Gardener as workers
+// without a machine type, so that they can be handled like the other worker groups where it applies.
+func (s *Shoot) GetExternalWorkers() []gardenv1beta1.Worker {
+	workers := []gardenv1beta1.Worker{}
+	for _, worker := range s.Info.Spec.Cloud.ExternalWorkers {
+		workers = append(workers, gardenv1beta1.Worker{
+			Name:              worker.Name,
+			NodeLabels:        worker.NodeLabels,
+			NodeTaints:        worker.NodeTaints,
+			Architecture:      worker.Architecture,
+			KubernetesVersion: worker.KubernetesVersion,
+			Kubelet:           worker.Kubelet,
+		})
+	}
+	return workers
+}
+
+// GetExternalWorkerNames returns the names of the worker groups of the Shoot whose machines are not managed by the
+// Gardener.
+func (s *Shoot) GetExternalWorkerNames() []string {
+	names := []string{}
+	for _, worker := range s.Info.Spec.Cloud.ExternalWorkers {
+		names = append(names, worker.Name)
+	}
+	return names
+}
+
+// GetPreemptibleWorkerNames returns the names of all worker groups of the Shoot whose machines can be reclaimed by the
+// cloud provider at any time, i.e. the spot worker groups and, on GCP, the worker groups using preemptible VMs. Only
+// AWS and GCP support such worker groups.
+func (s *Shoot) GetPreemptibleWorkerNames() []string {
+	names := []string{}
+	switch s.CloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		for _, worker := range s.Info.Spec.Cloud.AWS.Workers {
+			if worker.Spot != nil {
+				names = append(names, worker.Name)
+			}
+		}
+	case gardenv1beta1.CloudProviderGCP:
+		for _, worker := range s.Info.Spec.Cloud.GCP.Workers {
+			if worker.Spot != nil || (worker.Preemptible != nil && *worker.Preemptible) {
+				names = append(names, worker.Name)
+			}
+		}
+	}
+	return names
+}
+
+// GetOSUpdatesWorkerNames returns the names of all worker groups of the Shoot whose machines are rebooted into the
+// installed updates of their operating system.
+func (s *Shoot) GetOSUpdatesWorkerNames() []string {
+	names := []string{}
+	for _, worker := range s.GetWorkers() {
+		if worker.OSUpdates != nil && worker.OSUpdates.Enabled {
+			names = append(names, worker.Name)
+		}
+	}
+	return names
+}
+
 // GetNodeCount returns the sum of all 'autoScalerMax' fields of all worker groups of the Shoot.
 func (s *Shoot) GetNodeCount() int {
 	nodeCount := 0
@@ -164,8 +245,18 @@ func (s *Shoot) GetNodeCount() int {
 		for _, worker := range s.Info.Spec.Cloud.OpenStack.Workers {
 			nodeCount += worker.AutoScalerMax
 		}
+	case gardenv1beta1.CloudProviderPacket:
+		for _, worker := range s.Info.Spec.Cloud.Packet.Workers {
+			nodeCount += worker.AutoScalerMax
+		}
 	case gardenv1beta1.CloudProviderLocal:
 		nodeCount = 1
+		if s.SimulatesMachines() {
+			nodeCount = 0
+			for _, worker := range s.Info.Spec.Cloud.Local.Workers {
+				nodeCount += worker.AutoScalerMax
+			}
+		}
 	}
 
 	return nodeCount
@@ -182,6 +273,8 @@ func (s *Shoot) GetK8SNetworks() *gardenv1beta1.K8SNetworks {
 		return &s.Info.Spec.Cloud.GCP.Networks.K8SNetworks
 	case gardenv1beta1.CloudProviderOpenStack:
 		return &s.Info.Spec.Cloud.OpenStack.Networks.K8SNetworks
+	case gardenv1beta1.CloudProviderPacket:
+		return &s.Info.Spec.Cloud.Packet.Networks.K8SNetworks
 	case gardenv1beta1.CloudProviderLocal:
 		return &s.Info.Spec.Cloud.Local.Networks.K8SNetworks
 	}
@@ -223,10 +316,208 @@ func (s *Shoot) GetMachineImageName() gardenv1beta1.MachineImageName {
 		return s.Info.Spec.Cloud.GCP.MachineImage.Name
 	case gardenv1beta1.CloudProviderOpenStack:
 		return s.Info.Spec.Cloud.OpenStack.MachineImage.Name
+	case gardenv1beta1.CloudProviderPacket:
+		return s.Info.Spec.Cloud.Packet.MachineImage.Name
 	}
 	return ""
 }
 
+// GetWorkerMachineImageName returns the name of the machine image for the machines of the given <worker>, i.e. the
+// machine image which it overrides or the machine image of the Shoot.
+func (s *Shoot) GetWorkerMachineImageName(worker gardenv1beta1.Worker) gardenv1beta1.MachineImageName {
+	if worker.MachineImage != nil {
+		return *worker.MachineImage
+	}
+	return s.GetMachineImageName()
+}
+
+// GetWorkerMachineImage returns the cloud-specific machine image for the machines of the given <worker>. Workers of
+// the default architecture which do not override the machine image use the machine image of the Shoot, all others the
+// machine image with their name which the CloudProfile offers for their architecture. Workers with enabled warm-up use
+// the pre-warmed variant of it.
+func (s *Shoot) GetWorkerMachineImage(worker gardenv1beta1.Worker) (interface{}, error) {
+	architecture := helper.GetMachineArchitecture(worker.Architecture)
+	if worker.WarmUp != nil && *worker.WarmUp {
+		name := s.GetWorkerMachineImageName(worker)
+		found, machineImage, err := helper.DetermineWarmMachineImage(*s.CloudProfile, name, s.Info.Spec.Cloud.Region, architecture)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, operationerrors.Errorf(operationerrors.ClassConfiguration, "no pre-warmed variant of machine image %s for architecture %s of worker %s is offered in region %s", name, architecture, worker.Name, s.Info.Spec.Cloud.Region)
+		}
+		return machineImage, nil
+	}
+
+	if architecture == gardenv1beta1.MachineArchitectureAMD64 && worker.MachineImage == nil {
+		switch s.CloudProvider {
+		case gardenv1beta1.CloudProviderAWS:
+			return s.Info.Spec.Cloud.AWS.MachineImage, nil
+		case gardenv1beta1.CloudProviderAzure:
+			return s.Info.Spec.Cloud.Azure.MachineImage, nil
+		case gardenv1beta1.CloudProviderGCP:
+			return s.Info.Spec.Cloud.GCP.MachineImage, nil
+		case gardenv1beta1.CloudProviderOpenStack:
+			return s.Info.Spec.Cloud.OpenStack.MachineImage, nil
+		case gardenv1beta1.CloudProviderPacket:
+			return s.Info.Spec.Cloud.Packet.MachineImage, nil
+		}
+	}
+
+	name := s.GetWorkerMachineImageName(worker)
+	found, machineImage, err := helper.DetermineMachineImageForArchitecture(*s.CloudProfile, name, s.Info.Spec.Cloud.Region, architecture)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, operationerrors.Errorf(operationerrors.ClassConfiguration, "machine image %s for architecture %s of worker %s is not offered in region %s", name, architecture, worker.Name, s.Info.Spec.Cloud.Region)
+	}
+	return machineImage, nil
+}
+
+// GetWorkerGPUs returns the number of GPUs of the machine type of the given <worker> as offered by the CloudProfile.
+// The GPUs are made schedulable by the NVIDIA device plugin, hence, they are only supported for amd64 machines with
+// kubelets of Kubernetes >= 1.10. Machine types which are not offered by the CloudProfile do not have any GPUs.
+func (s *Shoot) GetWorkerGPUs(worker gardenv1beta1.Worker) (int, error) {
+	found, machineType, err := helper.DetermineMachineType(*s.CloudProfile, worker.MachineType)
+	if err != nil || !found || machineType.GPU.Sign() <= 0 {
+		return 0, err
+	}
+
+	if architecture := helper.GetMachineArchitecture(worker.Architecture); architecture != gardenv1beta1.MachineArchitectureAMD64 {
+		return 0, operationerrors.Errorf(operationerrors.ClassConfiguration, "GPU machine type %s of worker %s is not supported for architecture %s", worker.MachineType, worker.Name, architecture)
+	}
+	version := s.GetWorkerKubernetesVersion(worker.Name)
+	supported, err := utils.CompareVersions(version, ">=", "1.10")
+	if err != nil {
+		return 0, err
+	}
+	if !supported {
+		return 0, operationerrors.Errorf(operationerrors.ClassConfiguration, "GPU machine type %s of worker %s requires Kubernetes >= 1.10, but the worker uses %s", worker.MachineType, worker.Name, version)
+	}
+
+	return int(machineType.GPU.Value()), nil
+}
+
+// GetGPUWorkerNames returns the names of all worker groups of the Shoot whose machine types have GPUs.
+func (s *Shoot) GetGPUWorkerNames() ([]string, error) {
+	names := []string{}
+	for _, worker := range s.GetWorkers() {
+		gpus, err := s.GetWorkerGPUs(worker)
+		if err != nil {
+			return nil, err
+		}
+		if gpus > 0 {
+			names = append(names, worker.Name)
+		}
+	}
+	return names, nil
+}
+
+// ComputeMachineDeploymentAnnotations returns the annotations of the MachineDeployments of the given <worker>, i.e.
+// its own annotations and the capacity and the metadata of its machine type as offered by the CloudProfile. The
+// capacity annotations allow the cluster-autoscaler to scale up MachineDeployments which do not have any machines.
+func (s *Shoot) ComputeMachineDeploymentAnnotations(worker gardenv1beta1.Worker) (map[string]string, error) {
+	found, machineType, err := helper.DetermineMachineType(*s.CloudProfile, worker.MachineType)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := map[string]string{}
+	for key, value := range worker.Annotations {
+		annotations[key] = value
+	}
+	// Machine types which have been removed from the CloudProfile keep the capacity annotations of the live
+	// MachineDeployments, because existing annotations are preserved when they are updated.
+	if !found {
+		return annotations, nil
+	}
+	annotations[common.MachineDeploymentCapacityCPU] = machineType.CPU.String()
+	annotations[common.MachineDeploymentCapacityMemory] = machineType.Memory.String()
+	annotations[common.MachineDeploymentCapacityGPU] = machineType.GPU.String()
+	annotations[common.MachineDeploymentMachineType] = machineType.Name
+	annotations[common.MachineDeploymentArchitecture] = string(helper.GetMachineArchitecture(worker.Architecture))
+	return annotations, nil
+}
+
+// ReplaceMachinesOnCredentialsChange returns true if the machines of the Shoot should be replaced by a rolling update
+// when the cloud provider credentials change. Credentials which are issued by an external secret backend change
+// regularly, hence, they never replace the machines.
+func (s *Shoot) ReplaceMachinesOnCredentialsChange() bool {
+	maintenance := s.Info.Spec.Maintenance
+	return s.MachineCredentials == nil && maintenance != nil && maintenance.ReplaceMachinesOnCredentialsChange != nil && *maintenance.ReplaceMachinesOnCredentialsChange
+}
+
+// ReplaceMachinesOnCloudConfigChange returns true if the machines of a worker group of the Shoot should be replaced by
+// a rolling update when its cloud config changes.
+func (s *Shoot) ReplaceMachinesOnCloudConfigChange() bool {
+	maintenance := s.Info.Spec.Maintenance
+	return maintenance != nil && maintenance.ReplaceMachinesOnCloudConfigChange != nil && *maintenance.ReplaceMachinesOnCloudConfigChange
+}
+
+// ReplaceMachinesInTimeWindow returns true if changes which replace the machines of the Shoot should be deferred until
+// its maintenance time window.
+func (s *Shoot) ReplaceMachinesInTimeWindow() bool {
+	maintenance := s.Info.Spec.Maintenance
+	return maintenance != nil && maintenance.TimeWindow != nil && maintenance.ReplaceMachinesInTimeWindow != nil && *maintenance.ReplaceMachinesInTimeWindow
+}
+
+// GetMachineCredentials returns the cloud provider credentials for the machine class secrets, i.e. the data of the
+// Shoot secret overwritten by the credentials which have been issued by an external secret backend (if any).
+func (s *Shoot) GetMachineCredentials() map[string][]byte {
+	if s.MachineCredentials == nil {
+		return s.Secret.Data
+	}
+	credentials := make(map[string][]byte, len(s.Secret.Data)+len(s.MachineCredentials))
+	for key, value := range s.Secret.Data {
+		credentials[key] = value
+	}
+	for key, value := range s.MachineCredentials {
+		credentials[key] = value
+	}
+	return credentials
+}
+
+// ComputeMachineClassHash computes the hash which is used as suffix of the name of the machine class with the given
+// <machineClassSpec> for the worker group with the given <workerName>. The <secretData> of the machine class secret is
+// only considered if the machines should be replaced when the cloud provider credentials change, and the checksum of
+// the cloud config of the worker group only if the machines should be replaced when it changes. The time of the last
+// requested restart of the worker group (if any) is considered as well.
+func (s *Shoot) ComputeMachineClassHash(workerName string, machineClassSpec map[string]interface{}, secretData map[string][]byte) string {
+	kubernetesMajorMinorVersion := s.GetWorkerKubernetesMajorMinorVersion(workerName)
+	if checksum, ok := s.CloudConfigChecksums[workerName]; ok && s.ReplaceMachinesOnCloudConfigChange() {
+		machineClassSpec = map[string]interface{}{"spec": machineClassSpec, "cloudConfigChecksum": checksum}
+	}
+	if restartedAt, ok := s.MachineRestarts[workerName]; ok {
+		machineClassSpec = map[string]interface{}{"spec": machineClassSpec, "restartedAt": restartedAt}
+	}
+	if s.ReplaceMachinesOnCredentialsChange() {
+		return common.MachineClassCredentialsHash(machineClassSpec, secretData, kubernetesMajorMinorVersion)
+	}
+	return common.MachineClassHash(machineClassSpec, kubernetesMajorMinorVersion)
+}
+
+// GetWorkerKubernetesVersion returns the Kubernetes version of the kubelets of the worker group with the given
+// <workerName>, i.e. the version which it overrides or the Kubernetes version of the Shoot.
+func (s *Shoot) GetWorkerKubernetesVersion(workerName string) string {
+	for _, worker := range append(s.GetWorkers(), s.GetExternalWorkers()...) {
+		if worker.Name == workerName && worker.KubernetesVersion != nil {
+			return *worker.KubernetesVersion
+		}
+	}
+	return s.Info.Spec.Kubernetes.Version
+}
+
+// GetWorkerKubernetesMajorMinorVersion returns the Kubernetes version of the kubelets of the worker group with the
+// given <workerName> in the format <major>.<minor>.
+func (s *Shoot) GetWorkerKubernetesMajorMinorVersion(workerName string) string {
+	v, err := semver.NewVersion(s.GetWorkerKubernetesVersion(workerName))
+	if err != nil {
+		return s.KubernetesMajorMinorVersion
+	}
+	return fmt.Sprintf("%d.%d", v.Major(), v.Minor())
+}
+
 // ClusterAutoscalerEnabled returns true if the cluster-autoscaler addon is enabled in the Shoot manifest.
 func (s *Shoot) ClusterAutoscalerEnabled() bool {
 	return s.Info.Spec.Addons != nil && s.Info.Spec.Addons.ClusterAutoscaler != nil && s.Info.Spec.Addons.ClusterAutoscaler.Enabled
@@ -262,11 +553,39 @@ func (s *Shoot) MonocularEnabled() bool {
 	return s.Info.Spec.Addons != nil && s.Info.Spec.Addons.Monocular != nil && s.Info.Spec.Addons.Monocular.Enabled
 }
 
+// NetworkPoliciesEnabled returns true if the network-policies addon is enabled in the Shoot manifest.
+func (s *Shoot) NetworkPoliciesEnabled() bool {
+	return s.Info.Spec.Addons != nil && s.Info.Spec.Addons.NetworkPolicies != nil && s.Info.Spec.Addons.NetworkPolicies.Enabled
+}
+
+// GetPurpose returns the purpose of the Shoot (e.g. 'evaluation' or 'production') from its annotations. It returns an
+// empty string if no purpose has been specified.
+func (s *Shoot) GetPurpose() string {
+	return s.Info.Annotations[common.GardenPurpose]
+}
+
+// PassiveReplicaSeedName returns the name of the Seed cluster which hosts the passive replica of the Shoot's control plane.
+// It returns an empty string if no passive replica has been requested.
+func (s *Shoot) PassiveReplicaSeedName() string {
+	return s.Info.Annotations[common.ShootPassiveReplicaSeed]
+}
+
+// CloneSourceName returns the name of the Shoot whose etcd backups are restored into this Shoot (clone). It returns an
+// empty string if the Shoot is not a clone or if its creation has already been completed.
+func (s *Shoot) CloneSourceName() string {
+	if lastOperation := s.Info.Status.LastOperation; lastOperation != nil {
+		if lastOperation.Type != gardenv1beta1.ShootLastOperationTypeCreate || lastOperation.State == gardenv1beta1.ShootLastOperationStateSucceeded {
+			return ""
+		}
+	}
+	return s.Info.Annotations[common.ShootCloneOf]
+}
+
 // ComputeCloudConfigSecretName computes the name for a secret which contains the original cloud config for
 // the worker group with the given <workerName>. It is build by the cloud config secret prefix, the worker
-// name itself and a hash of the minor Kubernetes version of the Shoot cluster.
+// name itself and a hash of the minor Kubernetes version of the kubelets of the worker group.
 func (s *Shoot) ComputeCloudConfigSecretName(workerName string) string {
-	return fmt.Sprintf("%s-%s-%s", common.CloudConfigPrefix, workerName, utils.ComputeSHA256Hex([]byte(s.KubernetesMajorMinorVersion))[:5])
+	return fmt.Sprintf("%s-%s-%s", common.CloudConfigPrefix, workerName, utils.ComputeSHA256Hex([]byte(s.GetWorkerKubernetesMajorMinorVersion(workerName)))[:5])
 }
 
 // ComputeTechnicalID determines the technical id of that Shoot which is later used for the name of the
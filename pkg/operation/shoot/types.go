@@ -30,4 +30,7 @@ type Shoot struct {
 	ExternalClusterDomain       *string
 	KubernetesMajorMinorVersion string
 	Hibernated                  bool
+	MachineCredentials          map[string][]byte
+	CloudConfigChecksums        map[string]string
+	MachineRestarts             map[string]string
 }
@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -36,6 +37,14 @@ func (t *Terraformer) SetImage(image string) *Terraformer {
 	return t
 }
 
+// SetGuard sets the <guard> which rate limits and circuit breaks the Terraform runs against the cloud API. The
+// runs of all Terraformers sharing the same credentials should share the same Guard (see cloudapi.GuardFor with
+// IsAPIFailure).
+func (t *Terraformer) SetGuard(guard *cloudapi.Guard) *Terraformer {
+	t.guard = guard
+	return t
+}
+
 // DefineConfig creates a ConfigMap for the tf state (if it does not exist, otherwise it won't update it),
 // as well as a ConfigMap for the tf configuration (if it does not exist, otherwise it will update it).
 // The tfvars are stored in a Secret as the contain confidential information like credentials.
@@ -22,8 +22,27 @@ import (
 	"strings"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
 )
 
+// logIssuesHeading introduces the errors found in the logs of a failed Terraform job in the returned error.
+const logIssuesHeading = "The following issues have been found in the logs"
+
+// apiFailureRegexp matches the errors of the AWS, Azure, GCP and OpenStack APIs which indicate that the API is
+// throttling the requests or is unavailable.
+var apiFailureRegexp = regexp.MustCompile(`(?i)(Throttl|TooManyRequests|Too Many Requests|RequestLimitExceeded|rateLimitExceeded|ServiceUnavailable|Service Unavailable|InternalServerError|Internal Server Error|backendError|StatusCode=(?:429|5\d\d)|Error (?:429|5\d\d)|got (?:429|5\d\d)|i/o timeout|Client\.Timeout exceeded)`)
+
+// IsAPIFailure returns true if the given error of a Terraform run reports that the cloud API is throttling the
+// requests or is unavailable, and false if it has been caused by the configuration or the resources themselves, or
+// if the Terraform job could not be run at all.
+func IsAPIFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	message := err.Error()
+	return strings.Contains(message, logIssuesHeading) && apiFailureRegexp.MatchString(message)
+}
+
 // retrieveTerraformErrors gets a map <logList> whose keys are pod names and whose values are the corresponding logs,
 // and it parses the logs for Terraform errors. If none are found, it will return nil, and otherwhise the list of
 // found errors as string slice.
@@ -52,11 +71,13 @@ func retrieveTerraformErrors(logList map[string]string) []string {
 	return errorList
 }
 
-// determineErrorCode determines the Garden error code for the given error message.
+// determineErrorCode determines the Garden error code for the given error message and classifies the returned error
+// accordingly.
 func determineErrorCode(message string) error {
 	var (
 		code                         gardenv1beta1.ErrorCode
-		unauthorizedRegexp           = regexp.MustCompile(`(?i)(Unauthorized|InvalidClientTokenId|SignatureDoesNotMatch|Authentication failed)`)
+		class                        = operationerrors.ClassUnknown
+		unauthorizedRegexp           = regexp.MustCompile(`(?i)(Unauthorized|InvalidClientTokenId|SignatureDoesNotMatch|Authentication failed)`)
 		quotaExceededRegexp          = regexp.MustCompile(`(?i)(LimitExceeded|Quota)`)
 		insufficientPrivilegesRegexp = regexp.MustCompile(`(?i)(AccessDenied|Forbidden)`)
 		dependenciesRegexp           = regexp.MustCompile(`(?i)(DependencyViolation)`)
@@ -64,20 +85,20 @@ func determineErrorCode(message string) error {
 
 	switch {
 	case unauthorizedRegexp.MatchString(message):
-		code = gardenv1beta1.ErrorInfraUnauthorized
+		code, class = gardenv1beta1.ErrorInfraUnauthorized, operationerrors.ClassUnauthorized
 	case quotaExceededRegexp.MatchString(message):
-		code = gardenv1beta1.ErrorInfraQuotaExceeded
+		code, class = gardenv1beta1.ErrorInfraQuotaExceeded, operationerrors.ClassQuotaExceeded
 	case insufficientPrivilegesRegexp.MatchString(message):
-		code = gardenv1beta1.ErrorInfraInsufficientPrivileges
+		code, class = gardenv1beta1.ErrorInfraInsufficientPrivileges, operationerrors.ClassUnauthorized
 	case dependenciesRegexp.MatchString(message):
-		code = gardenv1beta1.ErrorInfraDependencies
+		code, class = gardenv1beta1.ErrorInfraDependencies, operationerrors.ClassTransient
 	}
 
 	if len(code) != 0 {
 		message = fmt.Sprintf("CODE:%s %s", code, message)
 	}
 
-	return errors.New(message)
+	return operationerrors.Wrap(class, errors.New(message))
 }
 
 // findTerraformErrors gets the <output> of a Terraform run and parses it to find the occurred
@@ -127,3 +148,35 @@ func findTerraformErrors(output string) string {
 	}
 	return ""
 }
+
+// FindBlockingResources parses the message of the given <err> of a failed Terraform destroy run with the given
+// provider-specific <patterns>. Each pattern must contain one capturing group which matches the identifier of a
+// cloud provider resource that is involved in a dependency conflict, i.e. a resource which blocks the deletion or a
+// resource which cannot be deleted because others depend on it. It returns the sorted list of distinct identifiers.
+func FindBlockingResources(err error, patterns ...*regexp.Regexp) []string {
+	found := map[string]bool{}
+	for _, pattern := range patterns {
+		for _, match := range pattern.FindAllStringSubmatch(err.Error(), -1) {
+			if len(match) > 1 && len(match[1]) > 0 {
+				found[match[1]] = true
+			}
+		}
+	}
+
+	blockers := make([]string, 0, len(found))
+	for blocker := range found {
+		blockers = append(blockers, blocker)
+	}
+	sort.Strings(blockers)
+	return blockers
+}
+
+// DependenciesError returns an error with the ERR_INFRA_DEPENDENCIES code which lists the given <blockers> that are
+// involved in the dependency conflicts preventing the infrastructure from being destroyed, followed by the description of the original <err>. If no blockers
+// are known, <err> is returned unchanged.
+func DependenciesError(err error, blockers []string) error {
+	if len(blockers) == 0 {
+		return err
+	}
+	return operationerrors.Errorf(operationerrors.ClassTransient, "CODE:%s infrastructure cannot be destroyed because of dependency conflicts involving: %s (%s)", gardenv1beta1.ErrorInfraDependencies, strings.Join(blockers, ", "), operationerrors.New(err).Description)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraformer_test
+
+import (
+	"errors"
+	"regexp"
+
+	. "github.com/gardener/gardener/pkg/operation/terraformer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("errors", func() {
+	Describe("#FindBlockingResources", func() {
+		It("should return the distinct and sorted identifiers of the blocking resources", func() {
+			var (
+				err = errors.New(`* aws_subnet.nodes_z1: DependencyViolation: The subnet 'subnet-2' has dependencies and cannot be deleted.
+* aws_subnet.nodes_z0: DependencyViolation: The subnet 'subnet-1' has dependencies and cannot be deleted.
+* aws_security_group.nodes: DependencyViolation: resource sg-1 has a dependent object
+* aws_security_group.bastions: DependencyViolation: resource sg-1 has a dependent object`)
+				pattern = regexp.MustCompile(`(?:resource|The [a-z]+) '?([a-z]+-[0-9a-f]+)'? has (?:a dependent object|dependencies)`)
+			)
+
+			Expect(FindBlockingResources(err, pattern)).To(Equal([]string{"sg-1", "subnet-1", "subnet-2"}))
+		})
+
+		It("should return an empty list if no pattern matches", func() {
+			Expect(FindBlockingResources(errors.New("timeout"), regexp.MustCompile(`used by (\S+)`))).To(BeEmpty())
+		})
+	})
+
+	Describe("#IsAPIFailure", func() {
+		const prefix = "Terraform execution job 'shoot.infra.tf-job' could not be completed. The following issues have been found in the logs:\n\n-> Pod 'shoot.infra.tf-job-abcde' reported:\n"
+
+		It("should detect throttling and unavailable cloud APIs", func() {
+			for _, message := range []string{
+				"* azurerm_subnet.workers: network.SubnetsClient#Get: Failure sending request: StatusCode=429 -- Original Error: autorest/azure: Service returned an error. Code=\"TooManyRequests\"",
+				"* google_compute_network.network: googleapi: Error 503: Backend Error, backendError",
+				"* google_compute_firewall.rule: googleapi: Error 403: Rate Limit Exceeded, rateLimitExceeded",
+				"* openstack_networking_router_v2.router: Expected HTTP response code [200] when accessing [GET https://network.example.com/v2.0/routers], but got 503 instead",
+				"* aws_vpc.vpc: RequestLimitExceeded: Request limit exceeded.",
+			} {
+				Expect(IsAPIFailure(errors.New(prefix+message))).To(BeTrue(), message)
+			}
+		})
+
+		It("should not count errors caused by the configuration or the resources", func() {
+			for _, message := range []string{
+				"* azurerm_resource_group.rg: resources.GroupsClient#CreateOrUpdate: Failure responding to request: StatusCode=403 -- Code=\"AuthorizationFailed\"",
+				"* google_compute_subnetwork.subnet: googleapi: Error 400: Invalid value for field 'resource.ipCidrRange', invalid",
+				"* openstack_networking_network_v2.network: Resource not found",
+			} {
+				Expect(IsAPIFailure(errors.New(prefix+message))).To(BeFalse(), message)
+			}
+		})
+
+		It("should not count errors which did not occur in a Terraform run", func() {
+			Expect(IsAPIFailure(errors.New("Failed to deploy the Terraformer: Service Unavailable"))).To(BeFalse())
+			Expect(IsAPIFailure(nil)).To(BeFalse())
+		})
+	})
+
+	Describe("#DependenciesError", func() {
+		It("should list the blockers in front of the description of the original error", func() {
+			err := DependenciesError(errors.New("CODE:ERR_INFRA_DEPENDENCIES terraform failed"), []string{"eni-1", "sg-1"})
+
+			Expect(err.Error()).To(Equal("CODE:ERR_INFRA_DEPENDENCIES infrastructure cannot be destroyed because of dependency conflicts involving: eni-1, sg-1 (terraform failed)"))
+		})
+
+		It("should return the original error if there are no blockers", func() {
+			original := errors.New("terraform failed")
+
+			Expect(DependenciesError(original, nil)).To(Equal(original))
+		})
+	})
+})
@@ -76,17 +76,27 @@ func (t *Terraformer) Apply() error {
 	if !t.configurationDefined {
 		return errors.New("Terraformer configuration has not been defined, cannot execute the Terraform scripts")
 	}
-	return t.execute("apply")
+	return t.run("apply")
 }
 
 // Destroy executes the Terraform Job by running the 'terraform destroy' command.
 func (t *Terraformer) Destroy() error {
-	if err := t.execute("destroy"); err != nil {
+	if err := t.run("destroy"); err != nil {
 		return err
 	}
 	return t.cleanupConfiguration()
 }
 
+// run executes the given <scriptName> through the Guard of the cloud API if one has been set.
+func (t *Terraformer) run(scriptName string) error {
+	if t.guard == nil {
+		return t.execute(scriptName)
+	}
+	return t.guard.Do(func() error {
+		return t.execute(scriptName)
+	})
+}
+
 // execute creates a Terraform Job which runs the provided scriptName (apply or destroy), waits for the Job to be completed
 // (either successful or not), prints its logs, deletes it and returns whether it was successful or not.
 func (t *Terraformer) execute(scriptName string) error {
@@ -215,7 +225,7 @@ func (t *Terraformer) execute(scriptName string) error {
 	if !succeeded {
 		errorMessage := fmt.Sprintf("Terraform execution job '%s' could not be completed.", t.jobName)
 		if terraformErrors := retrieveTerraformErrors(logList); terraformErrors != nil {
-			errorMessage += fmt.Sprintf(" The following issues have been found in the logs:\n\n%s", strings.Join(terraformErrors, "\n\n"))
+			errorMessage += fmt.Sprintf(" %s:\n\n%s", logIssuesHeading, strings.Join(terraformErrors, "\n\n"))
 		}
 		return determineErrorCode(errorMessage)
 	}
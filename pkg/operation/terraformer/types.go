@@ -18,6 +18,7 @@ import (
 	"path/filepath"
 
 	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/cloudapi"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/sirupsen/logrus"
@@ -37,6 +38,7 @@ import (
 //   with TF_VAR_).
 // * configurationDefined indicates whether the required configuration ConfigMaps/Secrets have been
 //   successfully defined.
+// * guard rate limits and circuit breaks the Terraform runs against the cloud API (optional).
 type Terraformer struct {
 	logger        *logrus.Entry
 	k8sClient     kubernetes.Client
@@ -53,6 +55,7 @@ type Terraformer struct {
 	jobName              string
 	variablesEnvironment []map[string]interface{}
 	configurationDefined bool
+	guard                *cloudapi.Guard
 }
 
 var chartPath = filepath.Join(common.ChartPath, "seed-terraformer", "charts")
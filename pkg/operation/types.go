@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	"github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
@@ -50,10 +51,24 @@ type Operation struct {
 	BackupInfrastructure *gardenv1beta1.BackupInfrastructure
 }
 
-// MachineDeployment holds insformation about the name, class, replicas of a MachineDeployment
-// managed by the machine-controller-manager.
+// MachineDeployment holds insformation about the name, worker group, class, size bounds, additional labels and
+// annotations, and the rolling update settings of a MachineDeployment managed by the machine-controller-manager. Unset
+// rolling update settings are defaulted when the machine deployment is deployed. MachineDeployments are deployed with
+// <Maximum> replicas unless their size is managed by the cluster-autoscaler. Spot worker groups which fall back to
+// on-demand machines have a <FallbackClassName> which replaces <ClassName> while the cloud provider has no spare
+// capacity. <GPUs> is the number of GPUs of the machine type, which the cloud botanists have checked to be supported.
 type MachineDeployment struct {
-	Name      string
-	ClassName string
-	Replicas  int
+	Name              string
+	WorkerName        string
+	ClassName         string
+	Minimum           int
+	Maximum           int
+	Labels            map[string]string
+	Annotations       map[string]string
+	Cordoned          bool
+	MaxSurge          *intstr.IntOrString
+	MaxUnavailable    *intstr.IntOrString
+	MinReadySeconds   *int32
+	FallbackClassName string
+	GPUs              int
 }
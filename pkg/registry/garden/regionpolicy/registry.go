@@ -0,0 +1,90 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regionpolicy
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// Registry is an interface for things that know how to store RegionPolicies.
+type Registry interface {
+	ListRegionPolicies(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (*garden.RegionPolicyList, error)
+	WatchRegionPolicies(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (watch.Interface, error)
+	GetRegionPolicy(ctx genericapirequest.Context, name string, options *metav1.GetOptions) (*garden.RegionPolicy, error)
+	CreateRegionPolicy(ctx genericapirequest.Context, regionPolicy *garden.RegionPolicy, createValidation rest.ValidateObjectFunc) (*garden.RegionPolicy, error)
+	UpdateRegionPolicy(ctx genericapirequest.Context, regionPolicy *garden.RegionPolicy, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (*garden.RegionPolicy, error)
+	DeleteRegionPolicy(ctx genericapirequest.Context, name string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListRegionPolicies(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (*garden.RegionPolicyList, error) {
+	obj, err := s.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*garden.RegionPolicyList), err
+}
+
+func (s *storage) WatchRegionPolicies(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	return s.Watch(ctx, options)
+}
+
+func (s *storage) GetRegionPolicy(ctx genericapirequest.Context, name string, options *metav1.GetOptions) (*garden.RegionPolicy, error) {
+	obj, err := s.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*garden.RegionPolicy), nil
+}
+
+func (s *storage) CreateRegionPolicy(ctx genericapirequest.Context, regionPolicy *garden.RegionPolicy, createValidation rest.ValidateObjectFunc) (*garden.RegionPolicy, error) {
+	obj, err := s.Create(ctx, regionPolicy, createValidation, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*garden.RegionPolicy), nil
+}
+
+func (s *storage) UpdateRegionPolicy(ctx genericapirequest.Context, regionPolicy *garden.RegionPolicy, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (*garden.RegionPolicy, error) {
+	obj, _, err := s.Update(ctx, regionPolicy.Name, rest.DefaultUpdatedObjectInfo(regionPolicy), createValidation, updateValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.(*garden.RegionPolicy), nil
+}
+
+func (s *storage) DeleteRegionPolicy(ctx genericapirequest.Context, name string) error {
+	_, _, err := s.Delete(ctx, name, nil)
+	return err
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regionpolicy
+
+import (
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+type regionPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for RegionPolicies.
+var Strategy = regionPolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (regionPolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (regionPolicyStrategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
+}
+
+func (regionPolicyStrategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
+	regionPolicy := obj.(*garden.RegionPolicy)
+	return validation.ValidateRegionPolicy(regionPolicy)
+}
+
+func (regionPolicyStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (regionPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (regionPolicyStrategy) PrepareForUpdate(ctx genericapirequest.Context, newObj, oldObj runtime.Object) {
+}
+
+func (regionPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return true
+}
+
+func (regionPolicyStrategy) ValidateUpdate(ctx genericapirequest.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldRegionPolicy, newRegionPolicy := oldObj.(*garden.RegionPolicy), newObj.(*garden.RegionPolicy)
+	return validation.ValidateRegionPolicyUpdate(newRegionPolicy, oldRegionPolicy)
+}
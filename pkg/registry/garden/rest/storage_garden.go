@@ -21,9 +21,12 @@ import (
 	backupinfrastructurestore "github.com/gardener/gardener/pkg/registry/garden/backupinfrastructure/storage"
 	cloudprofilestore "github.com/gardener/gardener/pkg/registry/garden/cloudprofile/storage"
 	quotastore "github.com/gardener/gardener/pkg/registry/garden/quota/storage"
+	regionpolicystore "github.com/gardener/gardener/pkg/registry/garden/regionpolicy/storage"
 	secretbinding "github.com/gardener/gardener/pkg/registry/garden/secretbinding/storage"
 	seedstore "github.com/gardener/gardener/pkg/registry/garden/seed/storage"
+	seedaccessrequeststore "github.com/gardener/gardener/pkg/registry/garden/seedaccessrequest/storage"
 	shootstore "github.com/gardener/gardener/pkg/registry/garden/shoot/storage"
+	shootoperationstore "github.com/gardener/gardener/pkg/registry/garden/shootoperation/storage"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
@@ -71,5 +74,16 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["backupinfrastructures"] = backupInfrastructureStorage.BackupInfrastructure
 	storage["backupinfrastructures/status"] = backupInfrastructureStorage.Status
 
+	seedAccessRequestStorage := seedaccessrequeststore.NewStorage(restOptionsGetter)
+	storage["seedaccessrequests"] = seedAccessRequestStorage.SeedAccessRequest
+	storage["seedaccessrequests/status"] = seedAccessRequestStorage.Status
+
+	shootOperationStorage := shootoperationstore.NewStorage(restOptionsGetter)
+	storage["shootoperations"] = shootOperationStorage.ShootOperation
+	storage["shootoperations/status"] = shootOperationStorage.Status
+
+	regionPolicyStorage := regionpolicystore.NewStorage(restOptionsGetter)
+	storage["regionpolicies"] = regionPolicyStorage.RegionPolicy
+
 	return storage
 }
@@ -0,0 +1,105 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/registry/garden/seedaccessrequest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// REST implements a RESTStorage for seedAccessRequests against etcd
+type REST struct {
+	*genericregistry.Store
+}
+
+// SeedAccessRequestStorage implements the storage for SeedAccessRequests and their status subresource.
+type SeedAccessRequestStorage struct {
+	SeedAccessRequest *REST
+	Status            *StatusREST
+}
+
+// NewStorage creates a new SeedAccessRequestStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) SeedAccessRequestStorage {
+	seedAccessRequestRest, seedAccessRequestStatusRest := NewREST(optsGetter)
+
+	return SeedAccessRequestStorage{
+		SeedAccessRequest: seedAccessRequestRest,
+		Status:            seedAccessRequestStatusRest,
+	}
+}
+
+// NewREST returns a RESTStorage object that will work against seedAccessRequests.
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
+	store := &genericregistry.Store{
+		NewFunc:                  func() runtime.Object { return &garden.SeedAccessRequest{} },
+		NewListFunc:              func() runtime.Object { return &garden.SeedAccessRequestList{} },
+		DefaultQualifiedResource: garden.Resource("seedaccessrequests"),
+		EnableGarbageCollection:  true,
+
+		CreateStrategy: seedaccessrequest.Strategy,
+		UpdateStrategy: seedaccessrequest.Strategy,
+		DeleteStrategy: seedaccessrequest.Strategy,
+	}
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = seedaccessrequest.StatusStrategy
+	return &REST{store}, &StatusREST{store: &statusStore}
+}
+
+// Implement CategoriesProvider
+var _ rest.CategoriesProvider = &REST{}
+
+// Categories implements the CategoriesProvider interface. Returns a list of categories a resource is part of.
+func (r *REST) Categories() []string {
+	return []string{"all"}
+}
+
+// StatusREST implements the REST endpoint for changing the status of a SeedAccessRequest.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+// New creates a new (empty) internal SeedAccessRequest object.
+func (r *StatusREST) New() runtime.Object {
+	return &garden.SeedAccessRequest{}
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx genericapirequest.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx genericapirequest.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation)
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"seedaccess"}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedaccessrequest
+
+import (
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+type seedAccessRequestStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for SeedAccessRequests.
+var Strategy = seedAccessRequestStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (seedAccessRequestStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (seedAccessRequestStrategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
+	seedAccessRequest := obj.(*garden.SeedAccessRequest)
+
+	// The requester is taken from the authenticated user of the request, hence, it cannot be forged and can be used
+	// for auditing purposes.
+	seedAccessRequest.Status = garden.SeedAccessRequestStatus{
+		Phase: garden.SeedAccessRequestPhasePending,
+	}
+	if user, ok := genericapirequest.UserFrom(ctx); ok {
+		seedAccessRequest.Status.Requester = user.GetName()
+	}
+
+	finalizers := sets.NewString(seedAccessRequest.Finalizers...)
+	if !finalizers.Has(gardenv1beta1.GardenerName) {
+		finalizers.Insert(gardenv1beta1.GardenerName)
+	}
+	seedAccessRequest.Finalizers = finalizers.UnsortedList()
+}
+
+func (seedAccessRequestStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newSeedAccessRequest := obj.(*garden.SeedAccessRequest)
+	oldSeedAccessRequest := old.(*garden.SeedAccessRequest)
+	newSeedAccessRequest.Status = oldSeedAccessRequest.Status
+}
+
+func (seedAccessRequestStrategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
+	seedAccessRequest := obj.(*garden.SeedAccessRequest)
+	return validation.ValidateSeedAccessRequest(seedAccessRequest)
+}
+
+func (seedAccessRequestStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (seedAccessRequestStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (seedAccessRequestStrategy) ValidateUpdate(ctx genericapirequest.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldSeedAccessRequest, newSeedAccessRequest := oldObj.(*garden.SeedAccessRequest), newObj.(*garden.SeedAccessRequest)
+	return validation.ValidateSeedAccessRequestUpdate(newSeedAccessRequest, oldSeedAccessRequest)
+}
+
+func (seedAccessRequestStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+type seedAccessRequestStatusStrategy struct {
+	seedAccessRequestStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of SeedAccessRequests.
+var StatusStrategy = seedAccessRequestStatusStrategy{Strategy}
+
+func (seedAccessRequestStatusStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newSeedAccessRequest := obj.(*garden.SeedAccessRequest)
+	oldSeedAccessRequest := old.(*garden.SeedAccessRequest)
+	newSeedAccessRequest.Spec = oldSeedAccessRequest.Spec
+}
+
+func (seedAccessRequestStatusStrategy) ValidateUpdate(ctx genericapirequest.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateSeedAccessRequestStatusUpdate(obj.(*garden.SeedAccessRequest), old.(*garden.SeedAccessRequest))
+}
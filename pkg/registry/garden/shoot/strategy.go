@@ -15,7 +15,10 @@
 package shoot
 
 import (
+	"fmt"
+
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -23,6 +26,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/validation"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -63,6 +67,10 @@ func (shootStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old ru
 		newShoot.Generation = oldShoot.Generation + 1
 	}
 
+	if newShoot.Spec.Kubernetes.Version != oldShoot.Spec.Kubernetes.Version {
+		recordKubernetesUpgrade(newShoot, oldShoot)
+	}
+
 	if newShoot.Annotations != nil {
 		delete(newShoot.Annotations, common.ShootOperation)
 	}
@@ -79,10 +87,25 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 		return true
 	}
 
+	// A reconciliation was explicitly requested by the reconcile annotation.
+	if val, ok := newShoot.Annotations[common.ShootOperation]; ok && val == common.ShootOperationReconcile {
+		return true
+	}
+
+	// A restart of the machines of worker groups was requested.
+	if val, ok := newShoot.Annotations[common.ShootRestartWorkers]; ok && val != oldShoot.Annotations[common.ShootRestartWorkers] {
+		return true
+	}
+
+	// A rename of worker groups was requested.
+	if val, ok := newShoot.Annotations[common.ShootRenameWorkers]; ok && val != oldShoot.Annotations[common.ShootRenameWorkers] {
+		return true
+	}
+
 	// The shoot state was failed but the retry annotation was set.
 	lastOperation := newShoot.Status.LastOperation
 	if lastOperation != nil && lastOperation.State == garden.ShootLastOperationStateFailed {
-		if val, ok := newShoot.Annotations[common.ShootOperation]; ok && val == "retry" {
+		if val, ok := newShoot.Annotations[common.ShootOperation]; ok && val == common.ShootOperationRetry {
 			return true
 		}
 	}
@@ -90,6 +113,28 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 	return false
 }
 
+// recordKubernetesUpgrade records the change of the Kubernetes version of the <oldShoot> to the version of the
+// <newShoot> in the status. If the change resets a progressing upgrade to its previous version, the upgrade is marked
+// as rolled back.
+func recordKubernetesUpgrade(newShoot, oldShoot *garden.Shoot) {
+	if helper.IsKubernetesUpgradeRollback(newShoot, oldShoot) {
+		upgrade := oldShoot.Status.KubernetesUpgrade.DeepCopy()
+		upgrade.Phase = garden.KubernetesUpgradePhaseRolledBack
+		if len(upgrade.Message) == 0 {
+			upgrade.Message = fmt.Sprintf("Kubernetes version has been rolled back to %s.", upgrade.PreviousVersion)
+		}
+		newShoot.Status.KubernetesUpgrade = upgrade
+		return
+	}
+
+	newShoot.Status.KubernetesUpgrade = &garden.ShootKubernetesUpgrade{
+		PreviousVersion: oldShoot.Spec.Kubernetes.Version,
+		Version:         newShoot.Spec.Kubernetes.Version,
+		Phase:           garden.KubernetesUpgradePhaseProgressing,
+		StartTime:       metav1.Now(),
+	}
+}
+
 func (shootStrategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
 	shoot := obj.(*garden.Shoot)
 	return validation.ValidateShoot(shoot)
@@ -0,0 +1,92 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// Registry is an interface for things that know how to store ShootOperations.
+type Registry interface {
+	ListShootOperations(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (*garden.ShootOperationList, error)
+	WatchShootOperations(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (watch.Interface, error)
+	GetShootOperation(ctx genericapirequest.Context, shootOperationID string, options *metav1.GetOptions) (*garden.ShootOperation, error)
+	CreateShootOperation(ctx genericapirequest.Context, shootOperation *garden.ShootOperation, createValidation rest.ValidateObjectFunc) (*garden.ShootOperation, error)
+	UpdateShootOperation(ctx genericapirequest.Context, shootOperation *garden.ShootOperation, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (*garden.ShootOperation, error)
+	DeleteShootOperation(ctx genericapirequest.Context, shootOperationID string) error
+}
+
+// storage puts strong typing around storage calls
+type storage struct {
+	rest.StandardStorage
+}
+
+// NewRegistry returns a new Registry interface for the given Storage. Any mismatched
+// types will panic.
+func NewRegistry(s rest.StandardStorage) Registry {
+	return &storage{s}
+}
+
+func (s *storage) ListShootOperations(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (*garden.ShootOperationList, error) {
+	if options != nil && options.FieldSelector != nil && !options.FieldSelector.Empty() {
+		return nil, fmt.Errorf("field selector not supported yet")
+	}
+	obj, err := s.List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*garden.ShootOperationList), err
+}
+
+func (s *storage) WatchShootOperations(ctx genericapirequest.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	return s.Watch(ctx, options)
+}
+
+func (s *storage) GetShootOperation(ctx genericapirequest.Context, shootOperationID string, options *metav1.GetOptions) (*garden.ShootOperation, error) {
+	obj, err := s.Get(ctx, shootOperationID, options)
+	if err != nil {
+		return nil, errors.NewNotFound(garden.Resource("shootoperations"), shootOperationID)
+	}
+	return obj.(*garden.ShootOperation), nil
+}
+
+func (s *storage) CreateShootOperation(ctx genericapirequest.Context, shootOperation *garden.ShootOperation, createValidation rest.ValidateObjectFunc) (*garden.ShootOperation, error) {
+	obj, err := s.Create(ctx, shootOperation, rest.ValidateAllObjectFunc, false)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*garden.ShootOperation), nil
+}
+
+func (s *storage) UpdateShootOperation(ctx genericapirequest.Context, shootOperation *garden.ShootOperation, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc) (*garden.ShootOperation, error) {
+	obj, _, err := s.Update(ctx, shootOperation.Name, rest.DefaultUpdatedObjectInfo(shootOperation), createValidation, updateValidation)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*garden.ShootOperation), nil
+}
+
+func (s *storage) DeleteShootOperation(ctx genericapirequest.Context, shootOperationID string) error {
+	_, _, err := s.Delete(ctx, shootOperationID, nil)
+	return err
+}
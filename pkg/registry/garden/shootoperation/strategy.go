@@ -0,0 +1,89 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootoperation
+
+import (
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+type shootOperationStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ShootOperations.
+var Strategy = shootOperationStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootOperationStrategy) NamespaceScoped() bool {
+	return true
+}
+
+func (shootOperationStrategy) PrepareForCreate(ctx genericapirequest.Context, obj runtime.Object) {
+	shootOperation := obj.(*garden.ShootOperation)
+
+	shootOperation.Status = garden.ShootOperationStatus{
+		Phase: garden.ShootOperationPhasePending,
+	}
+}
+
+func (shootOperationStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newShootOperation := obj.(*garden.ShootOperation)
+	oldShootOperation := old.(*garden.ShootOperation)
+	newShootOperation.Status = oldShootOperation.Status
+}
+
+func (shootOperationStrategy) Validate(ctx genericapirequest.Context, obj runtime.Object) field.ErrorList {
+	shootOperation := obj.(*garden.ShootOperation)
+	return validation.ValidateShootOperation(shootOperation)
+}
+
+func (shootOperationStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (shootOperationStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootOperationStrategy) ValidateUpdate(ctx genericapirequest.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldShootOperation, newShootOperation := oldObj.(*garden.ShootOperation), newObj.(*garden.ShootOperation)
+	return validation.ValidateShootOperationUpdate(newShootOperation, oldShootOperation)
+}
+
+func (shootOperationStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+type shootOperationStatusStrategy struct {
+	shootOperationStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ShootOperations.
+var StatusStrategy = shootOperationStatusStrategy{Strategy}
+
+func (shootOperationStatusStrategy) PrepareForUpdate(ctx genericapirequest.Context, obj, old runtime.Object) {
+	newShootOperation := obj.(*garden.ShootOperation)
+	oldShootOperation := old.(*garden.ShootOperation)
+	newShootOperation.Spec = oldShootOperation.Spec
+}
+
+func (shootOperationStatusStrategy) ValidateUpdate(ctx genericapirequest.Context, obj, old runtime.Object) field.ErrorList {
+	return validation.ValidateShootOperationStatusUpdate(obj.(*garden.ShootOperation), old.(*garden.ShootOperation))
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/logger"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	authenticationv1client "k8s.io/client-go/kubernetes/typed/authentication/v1"
+	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+)
+
+// ShootSubresource returns a <http.Handler> which only passes requests for the Shoot identified by the 'namespace'
+// and 'name' query parameters to the given <handler> if they are authenticated and authorized by the Garden cluster.
+// The bearer token of a request is verified with a TokenReview, and its user must be allowed to 'get' the given
+// <subresource> of the Shoot (e.g., 'shoots/reconcile-plan'), which is checked with a SubjectAccessReview.
+func ShootSubresource(tokenReviews authenticationv1client.TokenReviewInterface, subjectAccessReviews authorizationv1client.SubjectAccessReviewInterface, subresource string, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := bearerToken(r)
+		if len(token) == 0 {
+			http.Error(w, "a bearer token is required", http.StatusUnauthorized)
+			return
+		}
+
+		var (
+			namespace = r.URL.Query().Get("namespace")
+			name      = r.URL.Query().Get("name")
+		)
+		if len(namespace) == 0 || len(name) == 0 {
+			http.Error(w, "query parameters 'namespace' and 'name' are required", http.StatusBadRequest)
+			return
+		}
+
+		tokenReview, err := tokenReviews.Create(&authenticationv1.TokenReview{
+			Spec: authenticationv1.TokenReviewSpec{Token: token},
+		})
+		if err != nil {
+			logger.Logger.Errorf("Could not review the token of a request for %s: %s", r.URL.Path, err.Error())
+			http.Error(w, "could not authenticate the request", http.StatusInternalServerError)
+			return
+		}
+		if !tokenReview.Status.Authenticated {
+			http.Error(w, "the bearer token is invalid", http.StatusUnauthorized)
+			return
+		}
+
+		user := tokenReview.Status.User
+		subjectAccessReview, err := subjectAccessReviews.Create(&authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				User:   user.Username,
+				Groups: user.Groups,
+				UID:    user.UID,
+				Extra:  extra(user.Extra),
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace:   namespace,
+					Name:        name,
+					Verb:        "get",
+					Group:       gardenv1beta1.GroupName,
+					Resource:    "shoots",
+					Subresource: subresource,
+				},
+			},
+		})
+		if err != nil {
+			logger.Logger.Errorf("Could not review the access of %s to %s: %s", user.Username, r.URL.Path, err.Error())
+			http.Error(w, "could not authorize the request", http.StatusInternalServerError)
+			return
+		}
+		if !subjectAccessReview.Status.Allowed {
+			http.Error(w, fmt.Sprintf("user %q cannot get shoots/%s of Shoot %s/%s", user.Username, subresource, namespace, name), http.StatusForbidden)
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// bearerToken returns the bearer token of the Authorization header of the request <r>, or an empty string.
+func bearerToken(r *http.Request) string {
+	parts := strings.SplitN(r.Header.Get("Authorization"), " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(parts[1])
+}
+
+// extra converts the extra information of an authenticated user to the format of a SubjectAccessReview.
+func extra(in map[string]authenticationv1.ExtraValue) map[string]authorizationv1.ExtraValue {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]authorizationv1.ExtraValue, len(in))
+	for k, v := range in {
+		out[k] = authorizationv1.ExtraValue(v)
+	}
+	return out
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/gardener/gardener/pkg/server/handlers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+)
+
+type fakeTokenReviews struct {
+	users map[string]authenticationv1.UserInfo
+	err   error
+}
+
+func (f *fakeTokenReviews) Create(tokenReview *authenticationv1.TokenReview) (*authenticationv1.TokenReview, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	user, ok := f.users[tokenReview.Spec.Token]
+	tokenReview.Status = authenticationv1.TokenReviewStatus{Authenticated: ok, User: user}
+	return tokenReview, nil
+}
+
+type fakeSubjectAccessReviews struct {
+	allowed  bool
+	err      error
+	reviewed *authorizationv1.SubjectAccessReview
+}
+
+func (f *fakeSubjectAccessReviews) Create(sar *authorizationv1.SubjectAccessReview) (*authorizationv1.SubjectAccessReview, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.reviewed = sar
+	sar.Status = authorizationv1.SubjectAccessReviewStatus{Allowed: f.allowed}
+	return sar, nil
+}
+
+var _ = Describe("authentication", func() {
+	Describe("#ShootSubresource", func() {
+		var (
+			tokenReviews         *fakeTokenReviews
+			subjectAccessReviews *fakeSubjectAccessReviews
+			handler              http.Handler
+
+			serve = func(url, authorization string) *httptest.ResponseRecorder {
+				r := httptest.NewRequest(http.MethodGet, url, nil)
+				if len(authorization) > 0 {
+					r.Header.Set("Authorization", authorization)
+				}
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, r)
+				return w
+			}
+		)
+
+		BeforeEach(func() {
+			logger.NewLogger("error")
+
+			tokenReviews = &fakeTokenReviews{
+				users: map[string]authenticationv1.UserInfo{
+					"valid": {
+						Username: "jane",
+						UID:      "1234",
+						Groups:   []string{"operators"},
+						Extra:    map[string]authenticationv1.ExtraValue{"scopes": {"plan"}},
+					},
+				},
+			}
+			subjectAccessReviews = &fakeSubjectAccessReviews{allowed: true}
+			handler = ShootSubresource(tokenReviews, subjectAccessReviews, "reconcile-plan", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("plan"))
+			}))
+		})
+
+		It("should reject requests without a bearer token", func() {
+			Expect(serve("/shoots/reconcile-plan?namespace=garden-dev&name=foo", "").Code).To(Equal(http.StatusUnauthorized))
+			Expect(serve("/shoots/reconcile-plan?namespace=garden-dev&name=foo", "Basic Zm9vOmJhcg==").Code).To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should reject requests without the namespace or name of the Shoot", func() {
+			Expect(serve("/shoots/reconcile-plan?namespace=garden-dev", "Bearer valid").Code).To(Equal(http.StatusBadRequest))
+			Expect(serve("/shoots/reconcile-plan?name=foo", "Bearer valid").Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should reject requests with an invalid bearer token", func() {
+			Expect(serve("/shoots/reconcile-plan?namespace=garden-dev&name=foo", "Bearer invalid").Code).To(Equal(http.StatusUnauthorized))
+			Expect(subjectAccessReviews.reviewed).To(BeNil())
+		})
+
+		It("should fail if the token cannot be reviewed", func() {
+			tokenReviews.err = errors.New("fake")
+
+			Expect(serve("/shoots/reconcile-plan?namespace=garden-dev&name=foo", "Bearer valid").Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should reject requests of users who may not get the subresource", func() {
+			subjectAccessReviews.allowed = false
+
+			Expect(serve("/shoots/reconcile-plan?namespace=garden-dev&name=foo", "Bearer valid").Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("should fail if the access cannot be reviewed", func() {
+			subjectAccessReviews.err = errors.New("fake")
+
+			Expect(serve("/shoots/reconcile-plan?namespace=garden-dev&name=foo", "Bearer valid").Code).To(Equal(http.StatusInternalServerError))
+		})
+
+		It("should pass authorized requests to the handler", func() {
+			w := serve("/shoots/reconcile-plan?namespace=garden-dev&name=foo", "Bearer valid")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal("plan"))
+			Expect(subjectAccessReviews.reviewed.Spec).To(Equal(authorizationv1.SubjectAccessReviewSpec{
+				User:   "jane",
+				UID:    "1234",
+				Groups: []string{"operators"},
+				Extra:  map[string]authorizationv1.ExtraValue{"scopes": {"plan"}},
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Namespace:   "garden-dev",
+					Name:        "foo",
+					Verb:        "get",
+					Group:       "garden.sapcloud.io",
+					Resource:    "shoots",
+					Subresource: "reconcile-plan",
+				},
+			}))
+		})
+	})
+})
@@ -164,6 +164,10 @@ func (m metrics) initShootMetrics() {
 				for _, worker := range shoot.Spec.Cloud.OpenStack.Workers {
 					nodeCount += worker.AutoScalerMax
 				}
+			case gardenv1beta1.CloudProviderPacket:
+				for _, worker := range shoot.Spec.Cloud.Packet.Workers {
+					nodeCount += worker.AutoScalerMax
+				}
 			case gardenv1beta1.CloudProviderLocal:
 				nodeCount = 1
 			}
@@ -0,0 +1,204 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Status is the aggregated status of the Gardener controller manager and of the Shoots it manages.
+type Status struct {
+	// Healthy indicates whether the Gardener controller manager is healthy.
+	Healthy bool `json:"healthy"`
+	// CollectionTime is the time at which the Shoot status has been collected.
+	CollectionTime metav1.Time `json:"collectionTime"`
+	// ShootsTotal is the number of Shoots in the Garden cluster.
+	ShootsTotal int `json:"shootsTotal"`
+	// ShootsHealthy is the number of healthy Shoots in the Garden cluster.
+	ShootsHealthy int `json:"shootsHealthy"`
+	// ShootsHealthyPercentage is the percentage (0-100) of healthy Shoots in the Garden cluster.
+	ShootsHealthyPercentage float64 `json:"shootsHealthyPercentage"`
+	// OldestPendingOperation is the pending operation which has been waiting the longest, if any.
+	OldestPendingOperation *PendingOperation `json:"oldestPendingOperation,omitempty"`
+	// Shoots contains the outcome of the last operation of every Shoot.
+	Shoots []ShootStatus `json:"shoots"`
+}
+
+// PendingOperation describes an operation of a Shoot which has not completed yet.
+type PendingOperation struct {
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Type is the type of the operation.
+	Type gardenv1beta1.ShootLastOperationType `json:"type,omitempty"`
+	// Age is the time since the operation has last changed its state.
+	Age metav1.Duration `json:"age"`
+}
+
+// ShootStatus is the outcome of the last operation of a Shoot.
+type ShootStatus struct {
+	// Name is the name of the Shoot.
+	Name string `json:"name"`
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace"`
+	// Healthy indicates whether the last operation of the Shoot has succeeded and all its conditions are true.
+	Healthy bool `json:"healthy"`
+	// LastOperationType is the type of the last operation of the Shoot.
+	LastOperationType gardenv1beta1.ShootLastOperationType `json:"lastOperationType,omitempty"`
+	// LastOperationState is the state of the last operation of the Shoot.
+	LastOperationState gardenv1beta1.ShootLastOperationState `json:"lastOperationState,omitempty"`
+	// LastUpdateTime is the last time the state of the last operation of the Shoot has changed.
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+type status struct {
+	k8sGardenClient kubernetes.Client
+	interval        time.Duration
+
+	mutex          sync.Mutex
+	shoots         []gardenv1beta1.Shoot
+	collectionTime time.Time
+}
+
+// InitStatus takes a Kubernetes <client> for a Garden cluster and initiates the periodic collection of the
+// Shoots. It returns a <http.Handler> to register on a webserver, which responds with the aggregated Status
+// as JSON. The status code is 200 OK if the Gardener controller manager is healthy, and 503 Service Unavailable
+// otherwise, so that the endpoint can also be used by load balancers and external monitoring.
+func InitStatus(client kubernetes.Client, interval time.Duration) http.Handler {
+	s := &status{
+		k8sGardenClient: client,
+		interval:        interval,
+	}
+	go func() {
+		for {
+			s.collect()
+			time.Sleep(s.interval)
+		}
+	}()
+	return s
+}
+
+func (s *status) collect() {
+	shoots, err := s.k8sGardenClient.GardenClientset().GardenV1beta1().Shoots(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		logger.Logger.Infof("Unable to fetch shoots. skip status collection: %s", err.Error())
+		return
+	}
+
+	s.mutex.Lock()
+	s.shoots = shoots.Items
+	s.collectionTime = time.Now()
+	s.mutex.Unlock()
+}
+
+func (s *status) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mutex.Lock()
+	isHealthy := healthy
+	mutex.Unlock()
+
+	s.mutex.Lock()
+	result := computeStatus(isHealthy, s.shoots, s.collectionTime)
+	s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if isHealthy {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(result)
+}
+
+// computeStatus aggregates the given <shoots> which have been collected at <now> into a Status.
+func computeStatus(isHealthy bool, shoots []gardenv1beta1.Shoot, now time.Time) Status {
+	result := Status{
+		Healthy:        isHealthy,
+		CollectionTime: metav1.NewTime(now),
+		ShootsTotal:    len(shoots),
+		Shoots:         []ShootStatus{},
+	}
+
+	for _, shoot := range shoots {
+		shootStatus := ShootStatus{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+			Healthy:   shootHealthy(shoot),
+		}
+
+		pendingSince := shoot.CreationTimestamp
+		if lastOperation := shoot.Status.LastOperation; lastOperation != nil {
+			shootStatus.LastOperationType = lastOperation.Type
+			shootStatus.LastOperationState = lastOperation.State
+			shootStatus.LastUpdateTime = &lastOperation.LastUpdateTime
+			pendingSince = lastOperation.LastUpdateTime
+		}
+
+		if shootStatus.Healthy {
+			result.ShootsHealthy++
+		}
+		if operationPending(shoot) && (result.OldestPendingOperation == nil || now.Sub(pendingSince.Time) > result.OldestPendingOperation.Age.Duration) {
+			result.OldestPendingOperation = &PendingOperation{
+				Name:      shoot.Name,
+				Namespace: shoot.Namespace,
+				Type:      shootStatus.LastOperationType,
+				Age:       metav1.Duration{Duration: now.Sub(pendingSince.Time)},
+			}
+		}
+
+		result.Shoots = append(result.Shoots, shootStatus)
+	}
+
+	if result.ShootsTotal > 0 {
+		result.ShootsHealthyPercentage = float64(result.ShootsHealthy) * 100 / float64(result.ShootsTotal)
+	}
+	return result
+}
+
+// shootHealthy checks whether the last operation of the given <shoot> has succeeded and whether all of its
+// conditions are true.
+func shootHealthy(shoot gardenv1beta1.Shoot) bool {
+	if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardenv1beta1.ShootLastOperationStateSucceeded {
+		return false
+	}
+	for _, condition := range shoot.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
+// operationPending checks whether the given <shoot> has an operation which has not been completed yet, i.e.
+// which has not been started yet or which is still processing or will be retried.
+func operationPending(shoot gardenv1beta1.Shoot) bool {
+	if shoot.Status.LastOperation == nil {
+		return true
+	}
+	switch shoot.Status.LastOperation.State {
+	case gardenv1beta1.ShootLastOperationStateProcessing, gardenv1beta1.ShootLastOperationStateError, gardenv1beta1.ShootLastOperationStatePending:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handlers_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/server/handlers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("status", func() {
+	Describe("#computeStatus", func() {
+		var (
+			now = time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+
+			shoot = func(name string, state gardenv1beta1.ShootLastOperationState, age time.Duration, conditionStatus corev1.ConditionStatus) gardenv1beta1.Shoot {
+				return gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-dev"},
+					Status: gardenv1beta1.ShootStatus{
+						Conditions: []gardenv1beta1.Condition{{Type: gardenv1beta1.ShootControlPlaneHealthy, Status: conditionStatus}},
+						LastOperation: &gardenv1beta1.LastOperation{
+							Type:           gardenv1beta1.ShootLastOperationTypeReconcile,
+							State:          state,
+							LastUpdateTime: metav1.NewTime(now.Add(-age)),
+						},
+					},
+				}
+			}
+		)
+
+		It("should return an empty status if there are no Shoots", func() {
+			status := ExportComputeStatus(true, nil, now)
+
+			Expect(status.Healthy).To(BeTrue())
+			Expect(status.ShootsTotal).To(Equal(0))
+			Expect(status.ShootsHealthyPercentage).To(Equal(float64(0)))
+			Expect(status.OldestPendingOperation).To(BeNil())
+			Expect(status.Shoots).To(BeEmpty())
+		})
+
+		It("should aggregate the health of the Shoots", func() {
+			status := ExportComputeStatus(false, []gardenv1beta1.Shoot{
+				shoot("healthy", gardenv1beta1.ShootLastOperationStateSucceeded, time.Hour, corev1.ConditionTrue),
+				shoot("unhealthy-condition", gardenv1beta1.ShootLastOperationStateSucceeded, time.Hour, corev1.ConditionFalse),
+				shoot("failed", gardenv1beta1.ShootLastOperationStateFailed, time.Hour, corev1.ConditionTrue),
+				shoot("processing", gardenv1beta1.ShootLastOperationStateProcessing, time.Minute, corev1.ConditionTrue),
+			}, now)
+
+			Expect(status.Healthy).To(BeFalse())
+			Expect(status.ShootsTotal).To(Equal(4))
+			Expect(status.ShootsHealthy).To(Equal(1))
+			Expect(status.ShootsHealthyPercentage).To(Equal(float64(25)))
+			Expect(status.Shoots).To(HaveLen(4))
+			Expect(status.Shoots[0].Healthy).To(BeTrue())
+			Expect(status.Shoots[2].LastOperationState).To(Equal(gardenv1beta1.ShootLastOperationStateFailed))
+		})
+
+		It("should determine the oldest pending operation", func() {
+			status := ExportComputeStatus(true, []gardenv1beta1.Shoot{
+				shoot("succeeded", gardenv1beta1.ShootLastOperationStateSucceeded, 3*time.Hour, corev1.ConditionTrue),
+				shoot("processing", gardenv1beta1.ShootLastOperationStateProcessing, time.Minute, corev1.ConditionTrue),
+				shoot("error", gardenv1beta1.ShootLastOperationStateError, time.Hour, corev1.ConditionTrue),
+			}, now)
+
+			Expect(status.OldestPendingOperation).To(Equal(&PendingOperation{
+				Name:      "error",
+				Namespace: "garden-dev",
+				Type:      gardenv1beta1.ShootLastOperationTypeReconcile,
+				Age:       metav1.Duration{Duration: time.Hour},
+			}))
+		})
+
+		It("should consider Shoots without an operation as pending since their creation", func() {
+			status := ExportComputeStatus(true, []gardenv1beta1.Shoot{
+				{ObjectMeta: metav1.ObjectMeta{Name: "new", Namespace: "garden-dev", CreationTimestamp: metav1.NewTime(now.Add(-5 * time.Minute))}},
+			}, now)
+
+			Expect(status.ShootsHealthy).To(Equal(0))
+			Expect(status.OldestPendingOperation).NotTo(BeNil())
+			Expect(status.OldestPendingOperation.Name).To(Equal("new"))
+			Expect(status.OldestPendingOperation.Age.Duration).To(Equal(5 * time.Minute))
+		})
+	})
+})
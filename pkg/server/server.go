@@ -28,6 +28,7 @@ import (
 func Serve(k8sGardenClient kubernetes.Client, bindAddress string, port int, metricsInterval time.Duration) {
 	http.HandleFunc("/healthz", handlers.Healthz)
 	http.Handle("/metrics", handlers.InitMetrics(k8sGardenClient, metricsInterval))
+	http.Handle("/status", handlers.InitStatus(k8sGardenClient, metricsInterval))
 
 	listenAddress := fmt.Sprintf("%s:%d", bindAddress, port)
 	go http.ListenAndServe(listenAddress, nil)
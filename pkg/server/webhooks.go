@@ -0,0 +1,54 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/webhook/deletionprotection"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ServeWebhooks starts a HTTPS server for the validating webhooks of the Garden cluster. It waits until the caches of
+// the Shoots and SecretBindings have been synced before it serves any request. The server also serves the given
+// <shootHandlers> below '/shoots/', which must authenticate and authorize the requests themselves.
+func ServeWebhooks(k8sGardenClient kubernetes.Client, bindAddress string, port int, certFile, keyFile string, shootHandlers http.Handler, stopCh <-chan struct{}) {
+	var (
+		gardenInformerFactory = gardeninformers.NewSharedInformerFactory(k8sGardenClient.GardenClientset(), 0)
+		shootInformer         = gardenInformerFactory.Garden().V1beta1().Shoots()
+		secretBindingInformer = gardenInformerFactory.Garden().V1beta1().SecretBindings()
+	)
+	shootLister, secretBindingLister := shootInformer.Lister(), secretBindingInformer.Lister()
+
+	gardenInformerFactory.Start(stopCh)
+	if !cache.WaitForCacheSync(stopCh, shootInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced) {
+		logger.Logger.Error("Timed out waiting for the caches of the webhook server to sync")
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/webhooks/deletion-protection", deletionprotection.NewHandler(logger.Logger, shootLister, secretBindingLister))
+	mux.Handle("/shoots/", shootHandlers)
+
+	listenAddress := fmt.Sprintf("%s:%d", bindAddress, port)
+	logger.Logger.Infof("Gardener controller manager webhook server started (serving on %s)", listenAddress)
+	if err := http.ListenAndServeTLS(listenAddress, certFile, keyFile, mux); err != nil {
+		logger.Logger.Errorf("Gardener controller manager webhook server failed: %v", err)
+	}
+}
@@ -15,6 +15,7 @@
 package flow
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -64,6 +65,23 @@ func (f *Flow) AddTaskConditional(function func() error, retryDuration time.Dura
 	return task
 }
 
+// AddContextTask takes a <function> which accepts a context and a <retryDuration> and returns a pointer to a Task
+// object. The function is called with the context of the Flow, and it is expected to return once the context has been
+// canceled.
+func (f *Flow) AddContextTask(function func(context.Context) error, retryDuration time.Duration, dependsOn ...*Task) *Task {
+	task := f.AddTask(nil, retryDuration, dependsOn...)
+	task.ContextFunction = function
+	return task
+}
+
+// AddContextTaskConditional takes a <function> which accepts a context and a <retryDuration> and returns a pointer to
+// a Task object. In case the <condition> is false, the task will be marked as "skipped".
+func (f *Flow) AddContextTaskConditional(function func(context.Context) error, retryDuration time.Duration, condition bool, dependsOn ...*Task) *Task {
+	task := f.AddTaskConditional(nil, retryDuration, condition, dependsOn...)
+	task.ContextFunction = function
+	return task
+}
+
 // AddSyncPoint takes a list of tasks and returns a dummy task which can be used by others
 // as dependency. With that, a long list of dependencies must only defined once.
 func (f *Flow) AddSyncPoint(dependsOn ...*Task) *Task {
@@ -78,6 +96,22 @@ func (f *Flow) SetProgressReporter(reporter func(int, string)) *Flow {
 	return f
 }
 
+// SetStepReporter will take a function <reporter> and store it on the Flow object. The function will be called
+// whenever a (not skipped) task has been completed. It will receive the name of the task's function, the time it took
+// to execute the task (including all retries), and whether the task has failed as arguments.
+func (f *Flow) SetStepReporter(reporter func(string, time.Duration, bool)) *Flow {
+	f.StepReporterFunc = reporter
+	return f
+}
+
+// SetContext will take a context <ctx> and store it on the Flow object. Once the context has been canceled, no further
+// tasks are started, the tasks are not retried anymore, and the functions of tasks added with AddContextTask are
+// expected to return.
+func (f *Flow) SetContext(ctx context.Context) *Flow {
+	f.Context = ctx
+	return f
+}
+
 // SetLogger will take a <logger> and store it on the Flow object. The logger will be used at the begin of each
 // function invocation, and in case of errors.
 func (f *Flow) SetLogger(logger *logrus.Entry) *Flow {
@@ -102,6 +136,45 @@ func (f *Flow) Execute() *gardenv1beta1.LastError {
 	return nil
 }
 
+// Plan computes the list of tasks of the flow in the order in which they would be started by Execute, without
+// executing any of them. Tasks which can run in parallel are ordered as they have been added to the flow.
+func (f *Flow) Plan() []PlannedTask {
+	var (
+		plan                = []PlannedTask{}
+		dependencies        = map[*Task][]string{}
+		pendingDependencies = map[*Task]int{}
+		queue               = append(TaskList{}, f.RootTasks...)
+	)
+
+	for _, t := range queue {
+		pendingDependencies[t] = t.NumberOfPendingDependencies
+	}
+
+	for len(queue) > 0 {
+		t := queue[0]
+		queue = queue[1:]
+
+		plan = append(plan, PlannedTask{
+			Name:      t.String(),
+			Skipped:   t.Skip,
+			DependsOn: dependencies[t],
+		})
+
+		for _, triggered := range t.TriggerTasks {
+			if _, ok := pendingDependencies[triggered]; !ok {
+				pendingDependencies[triggered] = triggered.NumberOfPendingDependencies
+			}
+			dependencies[triggered] = append(dependencies[triggered], t.String())
+			pendingDependencies[triggered]--
+			if pendingDependencies[triggered] == 0 {
+				queue = append(queue, triggered)
+			}
+		}
+	}
+
+	return plan
+}
+
 func (f *Flow) handleFlow() {
 	for len(f.ActiveTasks) > 0 {
 		t := <-f.DoneCh
@@ -115,6 +188,9 @@ func (f *Flow) handleFlow() {
 		} else {
 			f.triggerDependencies(t)
 		}
+		if f.StepReporterFunc != nil && !t.Skip {
+			f.StepReporterFunc(t.String(), t.Duration, t.Error != nil)
+		}
 		if f.ProgressReporterFunc != nil {
 			f.ProgressReporterFunc(100*f.NumberOfCompletedTasks/(f.NumberOfExecutableTasks), f.ActiveTasks.String())
 		}
@@ -127,7 +203,9 @@ func (f *Flow) startTask(task *Task) {
 	go func() {
 		if !task.Skip {
 			f.infof("Executing %s", task)
-			err := utils.Retry(f.Logger, task.RetryDuration, utils.RetryFunc(f.Logger, task.Function))
+			start := time.Now()
+			err := utils.Retry(f.Logger, task.RetryDuration, f.retryFunc(task))
+			task.Duration = time.Since(start)
 			if err != nil {
 				task.Error = utilerrors.New(err)
 			}
@@ -138,6 +216,42 @@ func (f *Flow) startTask(task *Task) {
 	}()
 }
 
+// retryFunc returns the condition function for utils.Retry which executes the function of the given <task>. Once the
+// context of the Flow has been canceled, the function is not executed anymore, and the returned error is of the class
+// ClassCanceled so that it is not retried.
+func (f *Flow) retryFunc(task *Task) func() (bool, error) {
+	ctx := f.context()
+
+	return func() (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, utilerrors.Canceled(err)
+		}
+
+		var err error
+		if task.ContextFunction != nil {
+			err = task.ContextFunction(ctx)
+		} else {
+			err = task.Function()
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return false, utilerrors.Canceled(err)
+			}
+			f.infof("Execution of %s did not succeed... (%s)", task, err.Error())
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+// context returns the context of the Flow, or the background context if none has been set.
+func (f *Flow) context() context.Context {
+	if f.Context == nil {
+		return context.Background()
+	}
+	return f.Context
+}
+
 func (f *Flow) triggerDependencies(task *Task) {
 	for _, t := range task.TriggerTasks {
 		t.NumberOfPendingDependencies--
@@ -156,6 +270,38 @@ func (f *Flow) removeFromActiveTasks(task *Task) {
 	}
 }
 
+// ErrorClass returns the class of the errors of the tasks which failed during the execution of the flow, see
+// utilerrors.AggregateClass. It decides how fast the flow is executed again.
+func (f *Flow) ErrorClass() utilerrors.Class {
+	classes := make([]utilerrors.Class, 0, len(f.ErrornousTasks))
+	for _, t := range f.ErrornousTasks {
+		classes = append(classes, t.Error.Class)
+	}
+	return utilerrors.AggregateClass(classes...)
+}
+
+// LastErrors returns one error per task which failed during the execution of the flow, in the order in which the tasks
+// failed. The task ID of an error is the name of the task's function, so that independent failures (e.g. of the DNS
+// records and of the machines) are reported separately.
+func (f *Flow) LastErrors() []gardenv1beta1.LastError {
+	if len(f.ErrornousTasks) == 0 {
+		return nil
+	}
+
+	lastErrors := make([]gardenv1beta1.LastError, 0, len(f.ErrornousTasks))
+	for _, t := range f.ErrornousTasks {
+		lastError := gardenv1beta1.LastError{
+			Description: t.Error.Description,
+			TaskID:      t.String(),
+		}
+		if t.Error.Code != nil {
+			lastError.Codes = []gardenv1beta1.ErrorCode{*t.Error.Code}
+		}
+		lastErrors = append(lastErrors, lastError)
+	}
+	return lastErrors
+}
+
 func (f *Flow) aggregateErrors() *gardenv1beta1.LastError {
 	if len(f.ErrornousTasks) == 0 {
 		return nil
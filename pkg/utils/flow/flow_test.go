@@ -0,0 +1,96 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"context"
+
+	. "github.com/gardener/gardener/pkg/utils/flow"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type steps struct {
+	executed []string
+}
+
+func (s *steps) DeployA() error                    { return s.execute("DeployA") }
+func (s *steps) DeployB() error                    { return s.execute("DeployB") }
+func (s *steps) DeployC(ctx context.Context) error { return s.execute("DeployC") }
+func (s *steps) WaitUntilD() error                 { return s.execute("WaitUntilD") }
+
+func (s *steps) execute(name string) error {
+	s.executed = append(s.executed, name)
+	return nil
+}
+
+var _ = Describe("flow", func() {
+	Describe("#Plan", func() {
+		var s *steps
+
+		BeforeEach(func() {
+			s = &steps{}
+		})
+
+		It("should return an empty plan for an empty flow", func() {
+			Expect(New("empty").Plan()).To(BeEmpty())
+		})
+
+		It("should order the tasks as they would be started and record their dependencies", func() {
+			var (
+				f = New("test")
+				a = f.AddTask(s.DeployA, 0)
+				b = f.AddTask(s.DeployB, 0)
+				c = f.AddContextTask(s.DeployC, 0, a)
+				_ = f.AddTask(s.WaitUntilD, 0, c, b)
+			)
+
+			Expect(f.Plan()).To(Equal([]PlannedTask{
+				{Name: "(*steps).DeployA"},
+				{Name: "(*steps).DeployB"},
+				{Name: "(*steps).DeployC", DependsOn: []string{"(*steps).DeployA"}},
+				{Name: "(*steps).WaitUntilD", DependsOn: []string{"(*steps).DeployB", "(*steps).DeployC"}},
+			}))
+		})
+
+		It("should mark the tasks which would be skipped", func() {
+			var (
+				f = New("test")
+				a = f.AddTaskConditional(s.DeployA, 0, false)
+				_ = f.AddContextTaskConditional(s.DeployC, 0, true, a)
+			)
+
+			Expect(f.Plan()).To(Equal([]PlannedTask{
+				{Name: "(*steps).DeployA", Skipped: true},
+				{Name: "(*steps).DeployC", DependsOn: []string{"(*steps).DeployA"}},
+			}))
+		})
+
+		It("should not execute any task and keep the flow executable", func() {
+			var (
+				f = New("test")
+				a = f.AddTask(s.DeployA, 0)
+				_ = f.AddTask(s.DeployB, 0, a)
+			)
+
+			Expect(f.Plan()).To(HaveLen(2))
+			Expect(s.executed).To(BeEmpty())
+
+			Expect(f.Execute()).To(BeNil())
+			Expect(s.executed).To(Equal([]string{"DeployA", "DeployB"}))
+		})
+	})
+})
@@ -18,5 +18,8 @@ import "github.com/gardener/gardener/pkg/utils"
 
 // String will returns the string representation of the task.
 func (t *Task) String() string {
+	if t.ContextFunction != nil {
+		return utils.FuncName(t.ContextFunction)
+	}
 	return utils.FuncName(t.Function)
 }
@@ -15,6 +15,7 @@
 package flow
 
 import (
+	"context"
 	"time"
 
 	utilerrors "github.com/gardener/gardener/pkg/operation/errors"
@@ -27,8 +28,10 @@ type TaskList []*Task
 // Flow is the definition of a flow.
 type Flow struct {
 	Name                    string
+	Context                 context.Context
 	Logger                  *logrus.Entry
 	ProgressReporterFunc    func(int, string)
+	StepReporterFunc        func(string, time.Duration, bool)
 	DoneCh                  chan *Task
 	RootTasks               TaskList
 	ActiveTasks             TaskList
@@ -40,9 +43,21 @@ type Flow struct {
 // Task is the definition of a task in the flow.
 type Task struct {
 	Function                    func() error
+	ContextFunction             func(context.Context) error
 	RetryDuration               time.Duration
 	Error                       *utilerrors.Error
 	Skip                        bool
+	Duration                    time.Duration
 	TriggerTasks                TaskList
 	NumberOfPendingDependencies int
 }
+
+// PlannedTask describes a task of a flow as it would be executed, without actually executing it.
+type PlannedTask struct {
+	// Name is the name of the task's function.
+	Name string `json:"name"`
+	// Skipped indicates whether the task would be skipped.
+	Skipped bool `json:"skipped"`
+	// DependsOn is the list of names of the tasks which must be completed before this task is started.
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
@@ -47,21 +47,32 @@ func ReadImageVector() (ImageVector, error) {
 	return vector.Images, nil
 }
 
-// FindImage returns the image with the given <name> in the image vector. If multiple entries were
-// found, the provided <k8sVersion> is compared with the constraints stated in the image definition.
-// In case multiple images match the search, the first which was found is returned.
-// In case no image was found, an error is returned.
+// DefaultArchitecture is the CPU architecture of images which do not state their architectures.
+const DefaultArchitecture = "amd64"
+
+// FindImage returns the image with the given <name> in the image vector which can run on the default
+// architecture. If multiple entries were found, the provided <k8sVersion> is compared with the constraints
+// stated in the image definition. In case multiple images match the search, the first which was found
+// is returned. In case no image was found, an error is returned.
 func (v ImageVector) FindImage(name, k8sVersion string) (*Image, error) {
+	return v.FindImageForArchitecture(name, k8sVersion, DefaultArchitecture)
+}
+
+// FindImageForArchitecture returns the image with the given <name> in the image vector which can run on
+// the given CPU <architecture>. If multiple entries were found, the provided <k8sVersion> is compared with
+// the constraints stated in the image definition. In case multiple images match the search, the first
+// which was found is returned. In case no image was found, an error is returned.
+func (v ImageVector) FindImageForArchitecture(name, k8sVersion, architecture string) (*Image, error) {
 	foundImages := []*Image{}
 
 	for _, image := range v {
-		if image.Name == name {
+		if image.Name == name && image.SupportsArchitecture(architecture) {
 			foundImages = append(foundImages, image)
 		}
 	}
 
 	if len(foundImages) == 0 {
-		return nil, fmt.Errorf("could not find image '%s' in the image vector", name)
+		return nil, fmt.Errorf("could not find image '%s' for architecture '%s' in the image vector", name, architecture)
 	}
 
 	if len(foundImages) == 1 {
@@ -85,6 +96,24 @@ func (v ImageVector) FindImage(name, k8sVersion string) (*Image, error) {
 	return nil, fmt.Errorf("could not find image '%s' matching the version constraint", name)
 }
 
+// SupportsArchitecture returns true if the image can run on the given CPU <architecture>.
+func (i *Image) SupportsArchitecture(architecture string) bool {
+	return utils.ValueExists(architecture, i.GetArchitectures())
+}
+
+// GetArchitectures returns the CPU architectures the image can run on.
+func (i *Image) GetArchitectures() []string {
+	if len(i.Architectures) == 0 {
+		return []string{DefaultArchitecture}
+	}
+	return i.Architectures
+}
+
+// ServesAPIVersion returns true if the image serves the given API group version <apiVersion>.
+func (i *Image) ServesAPIVersion(apiVersion string) bool {
+	return utils.ValueExists(apiVersion, i.APIVersions)
+}
+
 // String will returns the string representation of the image.
 func (i *Image) String() string {
 	if len(i.Tag) == 0 {
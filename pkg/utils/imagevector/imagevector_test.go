@@ -132,6 +132,64 @@ var _ = Describe("imagevector", func() {
 				}))
 			})
 		})
+
+		Describe("#FindImageForArchitecture", func() {
+			var (
+				image1 = &Image{
+					Name:       "image1",
+					Repository: "repo1",
+				}
+				image2 = &Image{
+					Name:          "image1",
+					Repository:    "repo1-arm64",
+					Architectures: []string{"arm64"},
+				}
+				image3 = &Image{
+					Name:          "image3",
+					Repository:    "repo3",
+					Architectures: []string{"amd64", "arm64"},
+				}
+			)
+
+			It("should return the image for the requested architecture", func() {
+				vector = ImageVector{image1, image2}
+
+				image, err := vector.FindImageForArchitecture(image1.Name, "1.10.4", "arm64")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(image.Repository).To(Equal(image2.Repository))
+			})
+
+			It("should consider images without architectures as amd64 images", func() {
+				vector = ImageVector{image2, image1}
+
+				image, err := vector.FindImage(image1.Name, "1.10.4")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(image.Repository).To(Equal(image1.Repository))
+			})
+
+			It("should return a multi-architecture image for all of its architectures", func() {
+				vector = ImageVector{image3}
+
+				amd64Image, err := vector.FindImageForArchitecture(image3.Name, "1.10.4", "amd64")
+				Expect(err).NotTo(HaveOccurred())
+				arm64Image, err := vector.FindImageForArchitecture(image3.Name, "1.10.4", "arm64")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(amd64Image).To(Equal(image3))
+				Expect(arm64Image).To(Equal(image3))
+			})
+
+			It("should return an error because no image was found for the architecture", func() {
+				vector = ImageVector{image1}
+
+				image, err := vector.FindImageForArchitecture(image1.Name, "1.10.4", "arm64")
+
+				Expect(err).To(HaveOccurred())
+				Expect(image).To(BeNil())
+			})
+		})
 	})
 
 	Describe("> Image", func() {
@@ -168,5 +226,31 @@ var _ = Describe("imagevector", func() {
 				Expect(image.String()).To(Equal(repo))
 			})
 		})
+
+		Describe("#ServesAPIVersion", func() {
+			It("should return true for a listed API version", func() {
+				image = Image{
+					Name:        "my-image",
+					APIVersions: []string{"machine.sapcloud.io/v1alpha1"},
+				}
+
+				Expect(image.ServesAPIVersion("machine.sapcloud.io/v1alpha1")).To(BeTrue())
+			})
+
+			It("should return false for an API version which is not listed", func() {
+				image = Image{
+					Name:        "my-image",
+					APIVersions: []string{"machine.sapcloud.io/v1alpha1"},
+				}
+
+				Expect(image.ServesAPIVersion("machine.sapcloud.io/v1beta1")).To(BeFalse())
+			})
+
+			It("should return false if the image does not list any API versions", func() {
+				image = Image{Name: "my-image"}
+
+				Expect(image.ServesAPIVersion("machine.sapcloud.io/v1alpha1")).To(BeFalse())
+			})
+		})
 	})
 })
@@ -16,12 +16,17 @@ package imagevector
 
 // Image contains the repository and the tag of a Docker container image. If the respective
 // image is only valid for a specific Kubernetes version, then it must also contain the 'versions'
-// field describing for which versions it can be used.
+// field describing for which versions it can be used. The 'architectures' field lists the CPU
+// architectures the image can run on (e.g. because it is a multi-architecture manifest list); an
+// image without this field can only run on amd64. The 'apiVersions' field lists the API group versions
+// of the resources the image serves or reconciles (e.g. machine.sapcloud.io/v1alpha1).
 type Image struct {
-	Name       string `json:"name" yaml:"name"`
-	Repository string `json:"repository" yaml:"repository"`
-	Tag        string `json:"tag" yaml:"tag"`
-	Versions   string `json:"versions" yaml:"versions"`
+	Name          string   `json:"name" yaml:"name"`
+	Repository    string   `json:"repository" yaml:"repository"`
+	Tag           string   `json:"tag" yaml:"tag"`
+	Versions      string   `json:"versions" yaml:"versions"`
+	Architectures []string `json:"architectures,omitempty" yaml:"architectures,omitempty"`
+	APIVersions   []string `json:"apiVersions,omitempty" yaml:"apiVersions,omitempty"`
 }
 
 // ImageVector is a list of Docker container images.
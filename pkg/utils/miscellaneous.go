@@ -15,17 +15,24 @@
 package utils
 
 import (
+	"fmt"
 	"net"
 	"reflect"
 	"regexp"
 	"runtime"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
-const maintenanceTimeLayout = "150405-0700"
+const (
+	maintenanceTimeLayout = "150405-0700"
+
+	// truncatedNameHashLength is the length of the hash which is appended to truncated names.
+	truncatedNameHashLength = 5
+)
 
 // FuncName takes a function <f> as input and returns its name as a string. If the function is a method
 // of a struct, the struct will be also prefixed, e.g. 'Botanist.CreateNamespace'.
@@ -109,3 +116,47 @@ func FormatMaintenanceTime(t time.Time) string {
 func ParseMaintenanceTime(value string) (time.Time, error) {
 	return time.Parse(maintenanceTimeLayout, value)
 }
+
+// NowWithinTimeWindow returns true in case the current time is within <begin> and <end>.
+// <begin> and <end> must be in the following format: HHMMSS+ZZZZ. In case one of the
+// times can not be parsed, an error is returned.
+func NowWithinTimeWindow(begin, end string, nowTime time.Time) (bool, error) {
+	maintenanceWindowBegin, err := ParseMaintenanceTime(begin)
+	if err != nil {
+		return false, fmt.Errorf("Could not parse the maintenance time window begin value: %s", err.Error())
+	}
+	maintenanceWindowEnd, err := ParseMaintenanceTime(end)
+	if err != nil {
+		return false, fmt.Errorf("Could not parse the maintenance time window end value: %s", err.Error())
+	}
+	now, err := ParseMaintenanceTime(FormatMaintenanceTime(nowTime))
+	if err != nil {
+		return false, fmt.Errorf("Could not parse the current time into the maintenance format: %s", err.Error())
+	}
+
+	// Handle time windows whose end is on a different day than the beginning.
+	if maintenanceWindowEnd.Sub(maintenanceWindowBegin) < 0 {
+		maintenanceWindowEnd = maintenanceWindowEnd.Add(24 * time.Hour)
+
+		if now.Sub(maintenanceWindowEnd) < 0 {
+			now = now.Add(24 * time.Hour)
+		}
+	}
+
+	return now.After(maintenanceWindowBegin) && now.Before(maintenanceWindowEnd), nil
+}
+
+// TruncateName returns <name> if it does not exceed <maxLength> characters. Otherwise, it cuts <name> and appends
+// a short hash of the complete name, so that the result is deterministic and different long names stay distinguishable.
+func TruncateName(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+
+	hash := ComputeSHA256Hex([]byte(name))[:truncatedNameHashLength]
+	if maxLength <= truncatedNameHashLength+1 {
+		return hash[:maxLength]
+	}
+
+	return strings.TrimRight(name[:maxLength-truncatedNameHashLength-1], "-") + "-" + hash
+}
@@ -48,4 +48,84 @@ var _ = Describe("utils", func() {
 			Expect(val).To(Equal("140000+0100"))
 		})
 	})
+
+	Describe("#NowWithinTimeWindow", func() {
+		var now = time.Date(0, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+		It("should return an error due to invalid formats", func() {
+			invalidFormat := "98123723921023"
+
+			_, err := NowWithinTimeWindow(invalidFormat, invalidFormat, now)
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("begin and end on the same day", func() {
+			const (
+				begin = "160000+0000"
+				end   = "190000+0000"
+			)
+
+			It("should return false", func() {
+				now := time.Date(0, time.January, 1, 15, 0, 0, 0, time.UTC)
+
+				res, err := NowWithinTimeWindow(begin, end, now)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeFalse())
+			})
+
+			It("should return true", func() {
+				now := time.Date(0, time.January, 1, 17, 0, 0, 0, time.UTC)
+
+				res, err := NowWithinTimeWindow(begin, end, now)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeTrue())
+			})
+		})
+
+		Context("begin and end on different days", func() {
+			const (
+				begin = "230000+0000"
+				end   = "010000+0000"
+			)
+
+			It("should return false", func() {
+				now := time.Date(0, time.January, 1, 22, 0, 0, 0, time.UTC)
+
+				res, err := NowWithinTimeWindow(begin, end, now)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeFalse())
+			})
+
+			It("should return true", func() {
+				now := time.Date(0, time.January, 1, 0, 59, 0, 0, time.UTC)
+
+				res, err := NowWithinTimeWindow(begin, end, now)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(res).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("#TruncateName", func() {
+		It("should return the name if it does not exceed the maximum length", func() {
+			Expect(TruncateName("shoot--foo--bar", 15)).To(Equal("shoot--foo--bar"))
+		})
+
+		It("should truncate the name deterministically and append a hash", func() {
+			var (
+				name = "shoot--project--very-long-shoot-name-role"
+				val  = TruncateName(name, 30)
+			)
+
+			Expect(val).To(HaveLen(30))
+			Expect(val).To(HavePrefix("shoot--project--very-lon"))
+			Expect(val).To(Equal(TruncateName(name, 30)))
+			Expect(val).NotTo(Equal(TruncateName(name+"2", 30)))
+		})
+	})
 })
@@ -23,7 +23,9 @@ import (
 
 // Retry tries a condition function <f> until it returns true or the timeout <maxWaitTime> is reached.
 // Retry always waits the 5 seconds before retrying <f> the next time.
-// It ensures that the function <f> is always executed at least once.
+// It ensures that the function <f> is always executed at least once. It stops retrying early if <f> returns an
+// error which declares that it cannot be resolved by retrying (see the Retriable method of the errors of the
+// pkg/operation/errors package).
 func Retry(logger *logrus.Entry, maxWaitTime time.Duration, f func() (bool, error)) error {
 	var startTime = time.Now().UTC()
 
@@ -33,6 +35,11 @@ func Retry(logger *logrus.Entry, maxWaitTime time.Duration, f func() (bool, erro
 			return nil
 		}
 
+		if err != nil && !retriable(err) {
+			logger.Errorf("Not retrying as the error cannot be resolved by retrying: %s", err.Error())
+			return err
+		}
+
 		if time.Since(startTime) >= maxWaitTime {
 			if err != nil {
 				logger.Errorf("Maximum waiting time exceeded after %s waiting time, returning error", maxWaitTime)
@@ -61,3 +68,11 @@ func RetryFunc(logger *logrus.Entry, f func() error) func() (bool, error) {
 		return true, nil
 	}
 }
+
+// retriable returns false if the given <err> declares that the failed operation cannot succeed when it is retried.
+func retriable(err error) bool {
+	r, ok := err.(interface {
+		Retriable() bool
+	})
+	return !ok || r.Retriable()
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"errors"
+	"time"
+
+	operationerrors "github.com/gardener/gardener/pkg/operation/errors"
+	. "github.com/gardener/gardener/pkg/utils"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("retry", func() {
+	Describe("#Retry", func() {
+		var logger = logrus.NewEntry(logrus.New())
+
+		It("should stop retrying if the error cannot be resolved by retrying", func() {
+			var (
+				attempts = 0
+				cause    = operationerrors.Configuration(errors.New("invalid configuration"))
+			)
+
+			err := Retry(logger, time.Minute, func() (bool, error) {
+				attempts++
+				return false, cause
+			})
+
+			Expect(err).To(Equal(cause))
+			Expect(attempts).To(Equal(1))
+		})
+
+		It("should return nil once the condition is met", func() {
+			Expect(Retry(logger, time.Minute, func() (bool, error) {
+				return true, nil
+			})).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,136 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"github.com/sirupsen/logrus"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Handler is a http.Handler which serves admission reviews for the deletion of namespaces and secrets sent by the
+// kube-apiserver of the Garden cluster. It rejects the deletion of project namespaces and cloud provider secrets as
+// long as Shoots still use them.
+type Handler struct {
+	logger              logrus.FieldLogger
+	shootLister         gardenlisters.ShootLister
+	secretBindingLister gardenlisters.SecretBindingLister
+}
+
+// NewHandler creates a new Handler which logs with the given <logger> and determines the Shoots using a namespace or
+// a secret with the given <shootLister> and <secretBindingLister>.
+func NewHandler(logger logrus.FieldLogger, shootLister gardenlisters.ShootLister, secretBindingLister gardenlisters.SecretBindingLister) *Handler {
+	return &Handler{logger, shootLister, secretBindingLister}
+}
+
+// ServeHTTP decodes the admission review contained in the request body, checks whether the object may be deleted and
+// responds with the admission review containing the result.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+		http.Error(w, fmt.Sprintf("content type %q is not supported", contentType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not read request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	review := &admissionv1beta1.AdmissionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(w, "could not decode admission review", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.admit(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+
+	data, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not encode admission review: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func (h *Handler) admit(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	if request.Operation != admissionv1beta1.Delete {
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	var (
+		shoots []string
+		err    error
+	)
+	switch request.Kind.Kind {
+	case "Namespace":
+		shoots, err = NamespaceShoots(request.Name, h.shootLister)
+	case "Secret":
+		shoots, err = SecretShoots(request.Namespace, request.Name, h.secretBindingLister, h.shootLister)
+	default:
+		return &admissionv1beta1.AdmissionResponse{Allowed: true}
+	}
+
+	if err != nil {
+		h.logger.Errorf("Could not determine the Shoots using %s %s: %v", request.Kind.Kind, objectName(request), err)
+		return &admissionv1beta1.AdmissionResponse{
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusInternalServerError,
+				Reason:  metav1.StatusReasonInternalError,
+				Message: err.Error(),
+			},
+		}
+	}
+
+	if len(shoots) > 0 {
+		message := fmt.Sprintf("%s %s cannot be deleted because it is still used by the Shoots %s, delete them first", request.Kind.Kind, objectName(request), strings.Join(shoots, ", "))
+		h.logger.Infof("Rejected the deletion: %s", message)
+		return &admissionv1beta1.AdmissionResponse{
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusForbidden,
+				Reason:  metav1.StatusReasonForbidden,
+				Message: message,
+			},
+		}
+	}
+
+	return &admissionv1beta1.AdmissionResponse{Allowed: true}
+}
+
+// objectName returns the name of the object of the given admission <request>, qualified with its namespace if it is
+// namespaced.
+func objectName(request *admissionv1beta1.AdmissionRequest) string {
+	if len(request.Namespace) == 0 {
+		return request.Name
+	}
+	return fmt.Sprintf("%s/%s", request.Namespace, request.Name)
+}
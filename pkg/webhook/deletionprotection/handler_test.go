@@ -0,0 +1,141 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions"
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/gardener/gardener/pkg/webhook/deletionprotection"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("handler", func() {
+	var (
+		handler *Handler
+
+		shoot = func(namespace, name, bindingName string) *gardenv1beta1.Shoot {
+			s := &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+			s.Spec.Cloud.SecretBindingRef.Name = bindingName
+			return s
+		}
+		binding = func(namespace, name, secretNamespace, secretName string) *gardenv1beta1.SecretBinding {
+			return &gardenv1beta1.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+				SecretRef:  corev1.SecretReference{Namespace: secretNamespace, Name: secretName},
+			}
+		}
+
+		serve = func(operation admissionv1beta1.Operation, kind, namespace, name string) *admissionv1beta1.AdmissionResponse {
+			review := &admissionv1beta1.AdmissionReview{
+				Request: &admissionv1beta1.AdmissionRequest{
+					UID:       "1234",
+					Kind:      metav1.GroupVersionKind{Version: "v1", Kind: kind},
+					Namespace: namespace,
+					Name:      name,
+					Operation: operation,
+				},
+			}
+			body, err := json.Marshal(review)
+			Expect(err).NotTo(HaveOccurred())
+
+			request := httptest.NewRequest(http.MethodPost, "/webhooks/deletion-protection", bytes.NewReader(body))
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			result := &admissionv1beta1.AdmissionReview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+			Expect(result.Response).NotTo(BeNil())
+			Expect(result.Response.UID).To(BeEquivalentTo("1234"))
+			return result.Response
+		}
+	)
+
+	BeforeEach(func() {
+		gardenInformerFactory := gardeninformers.NewSharedInformerFactory(nil, 0)
+		shootInformer := gardenInformerFactory.Garden().V1beta1().Shoots()
+		secretBindingInformer := gardenInformerFactory.Garden().V1beta1().SecretBindings()
+
+		for _, s := range []*gardenv1beta1.Shoot{
+			shoot("garden-dev", "foo", "aws-credentials"),
+			shoot("garden-dev", "bar", "shared-credentials"),
+			shoot("garden-qa", "baz", "shared-credentials"),
+		} {
+			Expect(shootInformer.Informer().GetStore().Add(s)).To(Succeed())
+		}
+		for _, b := range []*gardenv1beta1.SecretBinding{
+			binding("garden-dev", "aws-credentials", "garden-dev", "aws-credentials"),
+			binding("garden-dev", "shared-credentials", "garden-shared", "credentials"),
+			binding("garden-qa", "shared-credentials", "garden-shared", "credentials"),
+			binding("garden-qa", "unused", "garden-qa", "unused"),
+		} {
+			Expect(secretBindingInformer.Informer().GetStore().Add(b)).To(Succeed())
+		}
+
+		handler = NewHandler(logger.NewLogger("info"), shootInformer.Lister(), secretBindingInformer.Lister())
+	})
+
+	It("should reject the deletion of a namespace with Shoots", func() {
+		response := serve(admissionv1beta1.Delete, "Namespace", "", "garden-dev")
+
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Reason).To(Equal(metav1.StatusReasonForbidden))
+		Expect(response.Result.Message).To(ContainSubstring("garden-dev/bar, garden-dev/foo"))
+	})
+
+	It("should admit the deletion of a namespace without Shoots", func() {
+		response := serve(admissionv1beta1.Delete, "Namespace", "", "garden-empty")
+
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should reject the deletion of a secret used by Shoots of several projects", func() {
+		response := serve(admissionv1beta1.Delete, "Secret", "garden-shared", "credentials")
+
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Reason).To(Equal(metav1.StatusReasonForbidden))
+		Expect(response.Result.Message).To(ContainSubstring("garden-dev/bar, garden-qa/baz"))
+	})
+
+	It("should admit the deletion of a secret whose SecretBinding is not used by any Shoot", func() {
+		response := serve(admissionv1beta1.Delete, "Secret", "garden-qa", "unused")
+
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should admit other operations", func() {
+		response := serve(admissionv1beta1.Update, "Namespace", "", "garden-dev")
+
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should refuse requests which are not sent via POST", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/webhooks/deletion-protection", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
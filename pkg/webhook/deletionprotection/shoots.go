@@ -0,0 +1,66 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection
+
+import (
+	"fmt"
+	"sort"
+
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// NamespaceShoots returns the names of the Shoots (in the format <namespace>/<name>) in the given <namespace>.
+func NamespaceShoots(namespace string, shootLister gardenlisters.ShootLister) ([]string, error) {
+	shoots, err := shootLister.Shoots(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, shoot := range shoots {
+		names = append(names, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// SecretShoots returns the names of the Shoots (in the format <namespace>/<name>) which use the secret with the given
+// <namespace> and <name> through one of their SecretBindings.
+func SecretShoots(namespace, name string, secretBindingLister gardenlisters.SecretBindingLister, shootLister gardenlisters.ShootLister) ([]string, error) {
+	bindings, err := secretBindingLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, binding := range bindings {
+		if binding.SecretRef.Name != name || binding.SecretRef.Namespace != namespace {
+			continue
+		}
+
+		shoots, err := shootLister.Shoots(binding.Namespace).List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, shoot := range shoots {
+			if shoot.Spec.Cloud.SecretBindingRef.Name == binding.Name {
+				names = append(names, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+			}
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
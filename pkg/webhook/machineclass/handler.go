@@ -0,0 +1,139 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineclass
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/sirupsen/logrus"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Handler is a http.Handler which serves admission reviews for machine class objects sent by the
+// kube-apiserver of the Seed cluster.
+type Handler struct {
+	logger logrus.FieldLogger
+}
+
+// NewHandler creates a new Handler which logs with the given <logger>.
+func NewHandler(logger logrus.FieldLogger) *Handler {
+	return &Handler{logger}
+}
+
+// ServeHTTP decodes the admission review contained in the request body, validates the machine class
+// object and responds with the admission review containing the result.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+	if contentType := r.Header.Get("Content-Type"); contentType != "application/json" {
+		http.Error(w, fmt.Sprintf("content type %q is not supported", contentType), http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not read request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	review := &admissionv1beta1.AdmissionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(w, "could not decode admission review", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = h.admit(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+
+	data, err := json.Marshal(review)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not encode admission review: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(data)
+}
+
+func (h *Handler) admit(request *admissionv1beta1.AdmissionRequest) *admissionv1beta1.AdmissionResponse {
+	allErrs, err := Validate(request.Kind.Kind, request.Object.Raw)
+	if err != nil {
+		h.logger.Errorf("Could not validate %s %s/%s: %v", request.Kind.Kind, request.Namespace, request.Name, err)
+		return &admissionv1beta1.AdmissionResponse{
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusBadRequest,
+				Reason:  metav1.StatusReasonBadRequest,
+				Message: err.Error(),
+			},
+		}
+	}
+
+	if len(allErrs) > 0 {
+		h.logger.Infof("Rejected %s %s/%s: %s", request.Kind.Kind, request.Namespace, request.Name, allErrs.ToAggregate().Error())
+		return &admissionv1beta1.AdmissionResponse{
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusUnprocessableEntity,
+				Reason:  metav1.StatusReasonInvalid,
+				Message: allErrs.ToAggregate().Error(),
+			},
+		}
+	}
+
+	return &admissionv1beta1.AdmissionResponse{Allowed: true}
+}
+
+// Validate decodes the given raw machine class object of the given <kind> and validates it. An error
+// is returned if the kind is unknown or if the object cannot be decoded.
+func Validate(kind string, raw []byte) (field.ErrorList, error) {
+	switch kind {
+	case "AWSMachineClass":
+		machineClass := &machinev1alpha1.AWSMachineClass{}
+		if err := json.Unmarshal(raw, machineClass); err != nil {
+			return nil, err
+		}
+		return ValidateAWSMachineClass(machineClass), nil
+	case "AzureMachineClass":
+		machineClass := &machinev1alpha1.AzureMachineClass{}
+		if err := json.Unmarshal(raw, machineClass); err != nil {
+			return nil, err
+		}
+		return ValidateAzureMachineClass(machineClass), nil
+	case "GCPMachineClass":
+		machineClass := &machinev1alpha1.GCPMachineClass{}
+		if err := json.Unmarshal(raw, machineClass); err != nil {
+			return nil, err
+		}
+		return ValidateGCPMachineClass(machineClass), nil
+	case "OpenStackMachineClass":
+		machineClass := &machinev1alpha1.OpenStackMachineClass{}
+		if err := json.Unmarshal(raw, machineClass); err != nil {
+			return nil, err
+		}
+		return ValidateOpenStackMachineClass(machineClass), nil
+	}
+
+	return nil, fmt.Errorf("kind %q is not a supported machine class", kind)
+}
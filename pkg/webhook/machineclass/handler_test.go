@@ -0,0 +1,88 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineclass_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/gardener/gardener/pkg/logger"
+	. "github.com/gardener/gardener/pkg/webhook/machineclass"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ = Describe("handler", func() {
+	var (
+		handler = NewHandler(logger.NewLogger("info"))
+
+		serve = func(kind, object string) *admissionv1beta1.AdmissionResponse {
+			review := &admissionv1beta1.AdmissionReview{
+				Request: &admissionv1beta1.AdmissionRequest{
+					UID:    "1234",
+					Kind:   metav1.GroupVersionKind{Group: "machine.sapcloud.io", Version: "v1alpha1", Kind: kind},
+					Object: runtime.RawExtension{Raw: []byte(object)},
+				},
+			}
+			body, err := json.Marshal(review)
+			Expect(err).NotTo(HaveOccurred())
+
+			request := httptest.NewRequest(http.MethodPost, "/validate", bytes.NewReader(body))
+			request.Header.Set("Content-Type", "application/json")
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, request)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			result := &admissionv1beta1.AdmissionReview{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+			Expect(result.Response).NotTo(BeNil())
+			Expect(result.Response.UID).To(BeEquivalentTo("1234"))
+			return result.Response
+		}
+	)
+
+	It("should admit a valid machine class", func() {
+		response := serve("OpenStackMachineClass", `{"spec":{"region":"europe-1","availabilityZone":"europe-1a","flavorName":"medium_2_4","imageName":"coreos","keyName":"key","networkID":"network","securityGroups":["nodes"],"secretRef":{"name":"foo","namespace":"bar"}}}`)
+
+		Expect(response.Allowed).To(BeTrue())
+	})
+
+	It("should reject an invalid machine class", func() {
+		response := serve("OpenStackMachineClass", `{"spec":{"region":"europe-1"}}`)
+
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Reason).To(Equal(metav1.StatusReasonInvalid))
+		Expect(response.Result.Message).To(ContainSubstring("spec.flavorName"))
+	})
+
+	It("should reject objects of unknown kinds", func() {
+		response := serve("Machine", `{}`)
+
+		Expect(response.Allowed).To(BeFalse())
+		Expect(response.Result.Reason).To(Equal(metav1.StatusReasonBadRequest))
+	})
+
+	It("should refuse requests which are not sent via POST", func() {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/validate", nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -0,0 +1,225 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineclass
+
+import (
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	awsVolumeTypes           = sets.NewString("gp2", "io1", "st1", "sc1", "standard")
+	gcpDiskTypes             = sets.NewString("pd-standard", "pd-ssd")
+	gcpOnHostMaintenanceKeys = sets.NewString("MIGRATE", "TERMINATE")
+)
+
+// ValidateAWSMachineClass validates an AWSMachineClass object.
+func ValidateAWSMachineClass(machineClass *machinev1alpha1.AWSMachineClass) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		spec    = machineClass.Spec
+		fldPath = field.NewPath("spec")
+	)
+
+	allErrs = append(allErrs, validateRequired(spec.AMI, fldPath.Child("ami"))...)
+	allErrs = append(allErrs, validateRequired(spec.Region, fldPath.Child("region"))...)
+	allErrs = append(allErrs, validateRequired(spec.MachineType, fldPath.Child("machineType"))...)
+	allErrs = append(allErrs, validateRequired(spec.KeyName, fldPath.Child("keyName"))...)
+	allErrs = append(allErrs, validateRequired(spec.IAM.Name, fldPath.Child("iam", "name"))...)
+	allErrs = append(allErrs, validateSecretRef(spec.SecretRef, fldPath.Child("secretRef"))...)
+
+	if len(spec.NetworkInterfaces) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkInterfaces"), "must specify at least one network interface"))
+	}
+	for i, networkInterface := range spec.NetworkInterfaces {
+		idxPath := fldPath.Child("networkInterfaces").Index(i)
+		allErrs = append(allErrs, validateRequired(networkInterface.SubnetID, idxPath.Child("subnetID"))...)
+		if len(networkInterface.SecurityGroupIDs) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("securityGroupIDs"), "must specify at least one security group"))
+		}
+	}
+
+	if len(spec.BlockDevices) != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("blockDevices"), len(spec.BlockDevices), "must specify exactly one block device"))
+	}
+	for i, blockDevice := range spec.BlockDevices {
+		ebsPath := fldPath.Child("blockDevices").Index(i).Child("ebs")
+		if blockDevice.Ebs.VolumeSize <= 0 {
+			allErrs = append(allErrs, field.Invalid(ebsPath.Child("volumeSize"), blockDevice.Ebs.VolumeSize, "must be greater than 0"))
+		}
+		if !awsVolumeTypes.Has(blockDevice.Ebs.VolumeType) {
+			allErrs = append(allErrs, field.NotSupported(ebsPath.Child("volumeType"), blockDevice.Ebs.VolumeType, awsVolumeTypes.List()))
+		}
+		if blockDevice.Ebs.VolumeType == "io1" && blockDevice.Ebs.Iops <= 0 {
+			allErrs = append(allErrs, field.Required(ebsPath.Child("iops"), "must be set for volumes of type io1"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateAzureMachineClass validates an AzureMachineClass object.
+func ValidateAzureMachineClass(machineClass *machinev1alpha1.AzureMachineClass) field.ErrorList {
+	var (
+		allErrs        = field.ErrorList{}
+		spec           = machineClass.Spec
+		fldPath        = field.NewPath("spec")
+		propertiesPath = fldPath.Child("properties")
+		storagePath    = propertiesPath.Child("storageProfile")
+		imagePath      = storagePath.Child("imageReference")
+		sshKeyPath     = propertiesPath.Child("osProfile", "linuxConfiguration", "ssh", "publicKeys")
+	)
+
+	allErrs = append(allErrs, validateRequired(spec.Location, fldPath.Child("location"))...)
+	allErrs = append(allErrs, validateRequired(spec.ResourceGroup, fldPath.Child("resourceGroup"))...)
+	allErrs = append(allErrs, validateRequired(spec.SubnetInfo.VnetName, fldPath.Child("subnetInfo", "vnetName"))...)
+	allErrs = append(allErrs, validateRequired(spec.SubnetInfo.SubnetName, fldPath.Child("subnetInfo", "subnetName"))...)
+	allErrs = append(allErrs, validateSecretRef(spec.SecretRef, fldPath.Child("secretRef"))...)
+
+	allErrs = append(allErrs, validateRequired(spec.Properties.HardwareProfile.VMSize, propertiesPath.Child("hardwareProfile", "vmSize"))...)
+	allErrs = append(allErrs, validateRequired(spec.Properties.AvailabilitySet.ID, propertiesPath.Child("availabilitySet", "id"))...)
+
+	if image := spec.Properties.StorageProfile.ImageReference; len(image.ID) == 0 {
+		allErrs = append(allErrs, validateRequired(image.Publisher, imagePath.Child("publisher"))...)
+		allErrs = append(allErrs, validateRequired(image.Offer, imagePath.Child("offer"))...)
+		allErrs = append(allErrs, validateRequired(image.Sku, imagePath.Child("sku"))...)
+		allErrs = append(allErrs, validateRequired(image.Version, imagePath.Child("version"))...)
+	}
+	if diskSize := spec.Properties.StorageProfile.OsDisk.DiskSizeGB; diskSize <= 0 {
+		allErrs = append(allErrs, field.Invalid(storagePath.Child("osDisk", "diskSizeGB"), diskSize, "must be greater than 0"))
+	}
+	allErrs = append(allErrs, validateRequired(spec.Properties.StorageProfile.OsDisk.CreateOption, storagePath.Child("osDisk", "createOption"))...)
+
+	allErrs = append(allErrs, validateRequired(spec.Properties.OsProfile.LinuxConfiguration.SSH.PublicKeys.Path, sshKeyPath.Child("path"))...)
+	allErrs = append(allErrs, validateRequired(spec.Properties.OsProfile.LinuxConfiguration.SSH.PublicKeys.KeyData, sshKeyPath.Child("keyData"))...)
+
+	return allErrs
+}
+
+// ValidateGCPMachineClass validates a GCPMachineClass object.
+func ValidateGCPMachineClass(machineClass *machinev1alpha1.GCPMachineClass) field.ErrorList {
+	var (
+		allErrs   = field.ErrorList{}
+		spec      = machineClass.Spec
+		fldPath   = field.NewPath("spec")
+		bootDisks = 0
+	)
+
+	allErrs = append(allErrs, validateRequired(spec.Region, fldPath.Child("region"))...)
+	allErrs = append(allErrs, validateRequired(spec.Zone, fldPath.Child("zone"))...)
+	allErrs = append(allErrs, validateRequired(spec.MachineType, fldPath.Child("machineType"))...)
+	allErrs = append(allErrs, validateSecretRef(spec.SecretRef, fldPath.Child("secretRef"))...)
+
+	if len(spec.Disks) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("disks"), "must specify at least one disk"))
+	}
+	for i, disk := range spec.Disks {
+		idxPath := fldPath.Child("disks").Index(i)
+		if disk == nil {
+			allErrs = append(allErrs, field.Required(idxPath, "must not be empty"))
+			continue
+		}
+		if disk.SizeGb < 10 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("sizeGb"), disk.SizeGb, "must be at least 10"))
+		}
+		if !gcpDiskTypes.Has(disk.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), disk.Type, gcpDiskTypes.List()))
+		}
+		if disk.Boot {
+			bootDisks++
+			allErrs = append(allErrs, validateRequired(disk.Image, idxPath.Child("image"))...)
+		}
+	}
+	if len(spec.Disks) > 0 && bootDisks != 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("disks"), bootDisks, "must specify exactly one boot disk"))
+	}
+
+	if len(spec.NetworkInterfaces) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("networkInterfaces"), "must specify at least one network interface"))
+	}
+	for i, networkInterface := range spec.NetworkInterfaces {
+		idxPath := fldPath.Child("networkInterfaces").Index(i)
+		if networkInterface == nil || (len(networkInterface.Network) == 0 && len(networkInterface.Subnetwork) == 0) {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify either a network or a subnetwork"))
+		}
+	}
+
+	if !gcpOnHostMaintenanceKeys.Has(spec.Scheduling.OnHostMaintenance) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scheduling", "onHostMaintenance"), spec.Scheduling.OnHostMaintenance, gcpOnHostMaintenanceKeys.List()))
+	}
+	if spec.Scheduling.Preemptible && spec.Scheduling.AutomaticRestart {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scheduling", "automaticRestart"), spec.Scheduling.AutomaticRestart, "must be false for preemptible machines"))
+	}
+
+	for i, serviceAccount := range spec.ServiceAccounts {
+		idxPath := fldPath.Child("serviceAccounts").Index(i)
+		allErrs = append(allErrs, validateRequired(serviceAccount.Email, idxPath.Child("email"))...)
+		if len(serviceAccount.Scopes) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("scopes"), "must specify at least one scope"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateOpenStackMachineClass validates an OpenStackMachineClass object.
+func ValidateOpenStackMachineClass(machineClass *machinev1alpha1.OpenStackMachineClass) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		spec    = machineClass.Spec
+		fldPath = field.NewPath("spec")
+	)
+
+	allErrs = append(allErrs, validateRequired(spec.Region, fldPath.Child("region"))...)
+	allErrs = append(allErrs, validateRequired(spec.AvailabilityZone, fldPath.Child("availabilityZone"))...)
+	allErrs = append(allErrs, validateRequired(spec.FlavorName, fldPath.Child("flavorName"))...)
+	allErrs = append(allErrs, validateRequired(spec.ImageName, fldPath.Child("imageName"))...)
+	allErrs = append(allErrs, validateRequired(spec.KeyName, fldPath.Child("keyName"))...)
+	allErrs = append(allErrs, validateRequired(spec.NetworkID, fldPath.Child("networkID"))...)
+	allErrs = append(allErrs, validateSecretRef(spec.SecretRef, fldPath.Child("secretRef"))...)
+
+	if len(spec.SecurityGroups) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("securityGroups"), "must specify at least one security group"))
+	}
+	for i, securityGroup := range spec.SecurityGroups {
+		allErrs = append(allErrs, validateRequired(securityGroup, fldPath.Child("securityGroups").Index(i))...)
+	}
+
+	return allErrs
+}
+
+func validateRequired(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(value) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must not be empty"))
+	}
+
+	return allErrs
+}
+
+func validateSecretRef(secretRef *corev1.SecretReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if secretRef == nil {
+		return append(allErrs, field.Required(fldPath, "must reference the secret containing the provider credentials"))
+	}
+	allErrs = append(allErrs, validateRequired(secretRef.Name, fldPath.Child("name"))...)
+	allErrs = append(allErrs, validateRequired(secretRef.Namespace, fldPath.Child("namespace"))...)
+
+	return allErrs
+}
@@ -0,0 +1,307 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package machineclass_test
+
+import (
+	. "github.com/gardener/gardener/pkg/webhook/machineclass"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("validation", func() {
+	secretRef := &corev1.SecretReference{
+		Name:      "machine-class",
+		Namespace: "shoot--foo--bar",
+	}
+
+	Describe("#ValidateAWSMachineClass", func() {
+		var machineClass *machinev1alpha1.AWSMachineClass
+
+		BeforeEach(func() {
+			machineClass = &machinev1alpha1.AWSMachineClass{
+				Spec: machinev1alpha1.AWSMachineClassSpec{
+					AMI:         "ami-12345678",
+					Region:      "eu-west-1",
+					MachineType: "m4.large",
+					KeyName:     "shoot--foo--bar-ssh-publickey",
+					IAM: machinev1alpha1.AWSIAMProfileSpec{
+						Name: "shoot--foo--bar-nodes",
+					},
+					NetworkInterfaces: []machinev1alpha1.AWSNetworkInterfaceSpec{
+						{
+							SubnetID:         "subnet-12345678",
+							SecurityGroupIDs: []string{"sg-12345678"},
+						},
+					},
+					BlockDevices: []machinev1alpha1.AWSBlockDeviceMappingSpec{
+						{
+							Ebs: machinev1alpha1.AWSEbsBlockDeviceSpec{
+								VolumeSize: 20,
+								VolumeType: "gp2",
+							},
+						},
+					},
+					SecretRef: secretRef,
+				},
+			}
+		})
+
+		It("should allow a valid machine class", func() {
+			Expect(ValidateAWSMachineClass(machineClass)).To(BeEmpty())
+		})
+
+		It("should forbid missing required fields", func() {
+			machineClass.Spec.AMI = ""
+			machineClass.Spec.NetworkInterfaces[0].SubnetID = ""
+			machineClass.Spec.SecretRef = nil
+
+			errorList := ValidateAWSMachineClass(machineClass)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.ami"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.networkInterfaces[0].subnetID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.secretRef"),
+				})),
+			))
+		})
+
+		It("should forbid invalid block devices", func() {
+			machineClass.Spec.BlockDevices[0].Ebs.VolumeSize = 0
+			machineClass.Spec.BlockDevices[0].Ebs.VolumeType = "io1"
+
+			errorList := ValidateAWSMachineClass(machineClass)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.blockDevices[0].ebs.volumeSize"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.blockDevices[0].ebs.iops"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateAzureMachineClass", func() {
+		var machineClass *machinev1alpha1.AzureMachineClass
+
+		BeforeEach(func() {
+			machineClass = &machinev1alpha1.AzureMachineClass{
+				Spec: machinev1alpha1.AzureMachineClassSpec{
+					Location:      "westeurope",
+					ResourceGroup: "shoot--foo--bar",
+					SubnetInfo: machinev1alpha1.AzureSubnetInfo{
+						VnetName:   "shoot--foo--bar",
+						SubnetName: "shoot--foo--bar-nodes",
+					},
+					Properties: machinev1alpha1.AzureVirtualMachineProperties{
+						HardwareProfile: machinev1alpha1.AzureHardwareProfile{
+							VMSize: "Standard_DS2_v2",
+						},
+						AvailabilitySet: machinev1alpha1.AzureSubResource{
+							ID: "availability-set-id",
+						},
+						StorageProfile: machinev1alpha1.AzureStorageProfile{
+							ImageReference: machinev1alpha1.AzureImageReference{
+								Publisher: "CoreOS",
+								Offer:     "CoreOS",
+								Sku:       "Stable",
+								Version:   "1576.5.0",
+							},
+							OsDisk: machinev1alpha1.AzureOSDisk{
+								DiskSizeGB:   35,
+								CreateOption: "FromImage",
+							},
+						},
+						OsProfile: machinev1alpha1.AzureOSProfile{
+							LinuxConfiguration: machinev1alpha1.AzureLinuxConfiguration{
+								SSH: machinev1alpha1.AzureSSHConfiguration{
+									PublicKeys: machinev1alpha1.AzureSSHPublicKey{
+										Path:    "/home/core/.ssh/authorized_keys",
+										KeyData: "ssh-rsa AAAA",
+									},
+								},
+							},
+						},
+					},
+					SecretRef: secretRef,
+				},
+			}
+		})
+
+		It("should allow a valid machine class", func() {
+			Expect(ValidateAzureMachineClass(machineClass)).To(BeEmpty())
+		})
+
+		It("should allow referencing the image by its id", func() {
+			machineClass.Spec.Properties.StorageProfile.ImageReference = machinev1alpha1.AzureImageReference{
+				ID: "image-id",
+			}
+
+			Expect(ValidateAzureMachineClass(machineClass)).To(BeEmpty())
+		})
+
+		It("should forbid an incomplete image reference and an invalid disk size", func() {
+			machineClass.Spec.Properties.StorageProfile.ImageReference.Sku = ""
+			machineClass.Spec.Properties.StorageProfile.OsDisk.DiskSizeGB = -1
+
+			errorList := ValidateAzureMachineClass(machineClass)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.properties.storageProfile.imageReference.sku"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.properties.storageProfile.osDisk.diskSizeGB"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateGCPMachineClass", func() {
+		var machineClass *machinev1alpha1.GCPMachineClass
+
+		BeforeEach(func() {
+			machineClass = &machinev1alpha1.GCPMachineClass{
+				Spec: machinev1alpha1.GCPMachineClassSpec{
+					Region:      "europe-west1",
+					Zone:        "europe-west1-b",
+					MachineType: "n1-standard-4",
+					Disks: []*machinev1alpha1.GCPDisk{
+						{
+							Boot:   true,
+							SizeGb: 50,
+							Type:   "pd-standard",
+							Image:  "coreos-stable",
+						},
+					},
+					NetworkInterfaces: []*machinev1alpha1.GCPNetworkInterface{
+						{
+							Subnetwork: "shoot--foo--bar-nodes",
+						},
+					},
+					Scheduling: machinev1alpha1.GCPScheduling{
+						AutomaticRestart:  true,
+						OnHostMaintenance: "MIGRATE",
+					},
+					ServiceAccounts: []machinev1alpha1.GCPServiceAccount{
+						{
+							Email:  "foo@bar.iam.gserviceaccount.com",
+							Scopes: []string{"https://www.googleapis.com/auth/compute"},
+						},
+					},
+					SecretRef: secretRef,
+				},
+			}
+		})
+
+		It("should allow a valid machine class", func() {
+			Expect(ValidateGCPMachineClass(machineClass)).To(BeEmpty())
+		})
+
+		It("should forbid invalid disks", func() {
+			machineClass.Spec.Disks[0].Boot = false
+			machineClass.Spec.Disks[0].SizeGb = 5
+			machineClass.Spec.Disks[0].Type = "foo"
+
+			errorList := ValidateGCPMachineClass(machineClass)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.disks[0].sizeGb"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.disks[0].type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.disks"),
+				})),
+			))
+		})
+
+		It("should forbid automatic restarts for preemptible machines", func() {
+			machineClass.Spec.Scheduling.Preemptible = true
+
+			errorList := ValidateGCPMachineClass(machineClass)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.scheduling.automaticRestart"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateOpenStackMachineClass", func() {
+		var machineClass *machinev1alpha1.OpenStackMachineClass
+
+		BeforeEach(func() {
+			machineClass = &machinev1alpha1.OpenStackMachineClass{
+				Spec: machinev1alpha1.OpenStackMachineClassSpec{
+					Region:           "europe-1",
+					AvailabilityZone: "europe-1a",
+					FlavorName:       "medium_2_4",
+					ImageName:        "coreos-1576.5.0",
+					KeyName:          "shoot--foo--bar-ssh-publickey",
+					NetworkID:        "network-id",
+					SecurityGroups:   []string{"shoot--foo--bar-nodes"},
+					SecretRef:        secretRef,
+				},
+			}
+		})
+
+		It("should allow a valid machine class", func() {
+			Expect(ValidateOpenStackMachineClass(machineClass)).To(BeEmpty())
+		})
+
+		It("should forbid missing security groups and an incomplete secret reference", func() {
+			machineClass.Spec.SecurityGroups = nil
+			machineClass.Spec.SecretRef = &corev1.SecretReference{Name: "machine-class"}
+
+			errorList := ValidateOpenStackMachineClass(machineClass)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.securityGroups"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.secretRef.namespace"),
+				})),
+			))
+		})
+	})
+})
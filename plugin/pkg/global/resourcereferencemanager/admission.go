@@ -18,6 +18,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -26,6 +28,7 @@ import (
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authorization/authorizer"
 	kubeinformers "k8s.io/client-go/informers"
@@ -53,6 +56,7 @@ type ReferenceManager struct {
 	seedLister          gardenlisters.SeedLister
 	secretBindingLister gardenlisters.SecretBindingLister
 	quotaLister         gardenlisters.QuotaLister
+	shootLister         gardenlisters.ShootLister
 }
 
 var _ = admissioninitializer.WantsInternalGardenInformerFactory(&ReferenceManager{})
@@ -62,7 +66,7 @@ var _ = admissioninitializer.WantsAuthorizer(&ReferenceManager{})
 // New creates a new ReferenceManager admission plugin.
 func New() (*ReferenceManager, error) {
 	return &ReferenceManager{
-		Handler: admission.NewHandler(admission.Create, admission.Update),
+		Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
 	}, nil
 }
 
@@ -77,6 +81,7 @@ func (r *ReferenceManager) SetInternalGardenInformerFactory(f gardeninformers.Sh
 	r.seedLister = f.Garden().InternalVersion().Seeds().Lister()
 	r.secretBindingLister = f.Garden().InternalVersion().SecretBindings().Lister()
 	r.quotaLister = f.Garden().InternalVersion().Quotas().Lister()
+	r.shootLister = f.Garden().InternalVersion().Shoots().Lister()
 }
 
 // SetKubeInformerFactory gets Lister from SharedInformerFactory.
@@ -104,6 +109,9 @@ func (r *ReferenceManager) ValidateInitialization() error {
 	if r.quotaLister == nil {
 		return errors.New("missing quota lister")
 	}
+	if r.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
 	return nil
 }
 
@@ -111,7 +119,8 @@ func skipVerification(operation admission.Operation, metadata metav1.ObjectMeta)
 	return operation == admission.Update && metadata.DeletionTimestamp != nil
 }
 
-// Admit ensures that referenced resources do actually exist.
+// Admit ensures that referenced resources do actually exist, and that SecretBindings are not deleted while Shoots
+// still reference them.
 func (r *ReferenceManager) Admit(a admission.Attributes) error {
 	// Wait until the caches have been synced
 	if !r.WaitForReady() {
@@ -123,6 +132,15 @@ func (r *ReferenceManager) Admit(a admission.Attributes) error {
 		operation = a.GetOperation()
 	)
 
+	if operation == admission.Delete {
+		if a.GetKind().GroupKind() == garden.Kind("SecretBinding") {
+			if err := r.ensureSecretBindingNotReferenced(a.GetNamespace(), a.GetName()); err != nil {
+				return admission.NewForbidden(a, err)
+			}
+		}
+		return nil
+	}
+
 	switch a.GetKind().GroupKind() {
 	case garden.Kind("SecretBinding"):
 		binding, ok := a.GetObject().(*garden.SecretBinding)
@@ -221,6 +239,25 @@ func (r *ReferenceManager) ensureSecretBindingReferences(attributes admission.At
 	return nil
 }
 
+func (r *ReferenceManager) ensureSecretBindingNotReferenced(namespace, name string) error {
+	shoots, err := r.shootLister.Shoots(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var referencingShoots []string
+	for _, shoot := range shoots {
+		if shoot.Spec.Cloud.SecretBindingRef.Name == name {
+			referencingShoots = append(referencingShoots, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+		}
+	}
+	if len(referencingShoots) > 0 {
+		sort.Strings(referencingShoots)
+		return fmt.Errorf("SecretBinding %s/%s cannot be deleted because it is still referenced by the Shoots %s, delete them first", namespace, name, strings.Join(referencingShoots, ", "))
+	}
+	return nil
+}
+
 func (r *ReferenceManager) ensureSeedReferences(seed *garden.Seed) error {
 	if _, err := r.cloudProfileLister.Get(seed.Spec.Cloud.Profile); err != nil {
 		return err
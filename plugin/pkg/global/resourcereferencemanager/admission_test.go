@@ -15,6 +15,8 @@
 package resourcereferencemanager_test
 
 import (
+	"fmt"
+
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	. "github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
@@ -267,6 +269,29 @@ var _ = Describe("resourcereferencemanager", func() {
 
 				Expect(err).To(HaveOccurred())
 			})
+
+			It("should reject the deletion because a Shoot still references the SecretBinding", func() {
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&shoot)
+
+				attrs := admission.NewAttributesRecord(nil, nil, garden.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, garden.Resource("secretbindings").WithVersion("version"), "", admission.Delete, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name)))
+			})
+
+			It("should accept the deletion because no Shoot references the SecretBinding", func() {
+				otherShoot := shoot
+				otherShoot.Spec.Cloud.SecretBindingRef.Name = "other-binding"
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&otherShoot)
+
+				attrs := admission.NewAttributesRecord(nil, nil, garden.Kind("SecretBinding").WithVersion("version"), secretBinding.Namespace, secretBinding.Name, garden.Resource("secretbindings").WithVersion("version"), "", admission.Delete, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
 		})
 
 		Context("tests for Seed objects", func() {
@@ -0,0 +1,155 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package naming
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootNaming"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		configuration, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+		return New(configuration)
+	})
+}
+
+// Naming contains the shoot lister and the admission handler.
+type Naming struct {
+	*admission.Handler
+	configuration *Configuration
+	shootLister   gardenlisters.ShootLister
+}
+
+var _ = admissioninitializer.WantsInternalGardenInformerFactory(&Naming{})
+
+// New creates a new Naming admission plugin. If no configuration is given, the default configuration is used.
+func New(configuration *Configuration) (*Naming, error) {
+	if configuration == nil {
+		c, err := LoadConfiguration(nil)
+		if err != nil {
+			return nil, err
+		}
+		configuration = c
+	}
+
+	return &Naming{
+		Handler:       admission.NewHandler(admission.Create, admission.Update),
+		configuration: configuration,
+	}, nil
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (h *Naming) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	h.shootLister = f.Garden().InternalVersion().Shoots().Lister()
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (h *Naming) ValidateInitialization() error {
+	if h.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	return nil
+}
+
+// Admit ensures that the names of new Shoots follow the configured naming conventions, that the domain of a Shoot is
+// not changed once it has been set, and that no two Shoots with managed DNS share the same domain.
+func (h *Naming) Admit(a admission.Attributes) error {
+	// Wait until the caches have been synced
+	if !h.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+	// Ignore updates to the status or other subresources
+	if len(a.GetSubresource()) > 0 {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	switch a.GetOperation() {
+	case admission.Create:
+		// The naming conventions are only checked for new Shoots, i.e. existing Shoots are not affected if they are
+		// tightened.
+		if err := h.configuration.validateName(shoot.Name); err != nil {
+			return admission.NewForbidden(a, err)
+		}
+		return h.verifyDomainUnique(a, shoot)
+
+	case admission.Update:
+		oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		if oldShoot.Spec.DNS.Domain != nil && (shoot.Spec.DNS.Domain == nil || *shoot.Spec.DNS.Domain != *oldShoot.Spec.DNS.Domain) {
+			return admission.NewForbidden(a, fmt.Errorf("the domain of a shoot must not be changed once it has been set (domain: %s)", *oldShoot.Spec.DNS.Domain))
+		}
+		if oldShoot.Spec.DNS.Domain == nil {
+			return h.verifyDomainUnique(a, shoot)
+		}
+	}
+
+	return nil
+}
+
+// verifyDomainUnique checks that the domain of the given <shoot> is not used by any other Shoot of the landscape. The
+// domains of Shoots with the 'unmanaged' DNS provider are not used for DNS records, hence, they do not have to be
+// unique.
+func (h *Naming) verifyDomainUnique(a admission.Attributes, shoot *garden.Shoot) error {
+	if shoot.Spec.DNS.Provider == garden.DNSUnmanaged || shoot.Spec.DNS.Domain == nil {
+		return nil
+	}
+
+	shoots, err := h.shootLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	for _, other := range shoots {
+		if other.Namespace == shoot.Namespace && other.Name == shoot.Name {
+			continue
+		}
+		if other.Spec.DNS.Provider == garden.DNSUnmanaged || other.Spec.DNS.Domain == nil {
+			continue
+		}
+		if *other.Spec.DNS.Domain == *shoot.Spec.DNS.Domain {
+			return admission.NewForbidden(a, fmt.Errorf("the domain %q is already used by shoot %s/%s", *shoot.Spec.DNS.Domain, other.Namespace, other.Name))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package naming_test
+
+import (
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/naming"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("naming", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler      *Naming
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			shoot                 garden.Shoot
+
+			domain      = "foo.example.com"
+			otherDomain = "bar.example.com"
+
+			shootBase = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "garden-dev",
+				},
+				Spec: garden.ShootSpec{
+					DNS: garden.DNS{
+						Provider: garden.DNSAWSRoute53,
+						Domain:   &domain,
+					},
+				},
+			}
+
+			newHandler = func(configuration string) {
+				config, err := LoadConfiguration(strings.NewReader(configuration))
+				Expect(err).NotTo(HaveOccurred())
+				admissionHandler, _ = New(config)
+				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			}
+			createAttributes = func(shoot *garden.Shoot) admission.Attributes {
+				return admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+			}
+			updateAttributes = func(shoot, oldShoot *garden.Shoot) admission.Attributes {
+				return admission.NewAttributesRecord(shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+			}
+		)
+
+		BeforeEach(func() {
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			newHandler("")
+			shoot = *shootBase.DeepCopy()
+		})
+
+		Context("naming conventions", func() {
+			It("should accept any name by default", func() {
+				shoot.Name = "a-very-long-shoot-name"
+
+				Expect(admissionHandler.Admit(createAttributes(&shoot))).To(Succeed())
+			})
+
+			It("should reject new Shoots whose names exceed the maximum length", func() {
+				newHandler("maxNameLength: 5")
+				shoot.Name = "foobar"
+
+				err := admissionHandler.Admit(createAttributes(&shoot))
+
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject new Shoots whose names do not match the pattern entirely", func() {
+				newHandler(`namePattern: "[a-z]+"`)
+				shoot.Name = "foo1"
+
+				err := admissionHandler.Admit(createAttributes(&shoot))
+
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should accept new Shoots whose names follow the conventions", func() {
+				newHandler(`namePattern: "[a-z]+"
+maxNameLength: 5`)
+
+				Expect(admissionHandler.Admit(createAttributes(&shoot))).To(Succeed())
+			})
+
+			It("should not check the names of existing Shoots", func() {
+				newHandler("maxNameLength: 2")
+
+				Expect(admissionHandler.Admit(updateAttributes(&shoot, shoot.DeepCopy()))).To(Succeed())
+			})
+		})
+
+		Context("domains", func() {
+			It("should reject new Shoots whose domain is already used by another Shoot", func() {
+				other := shootBase.DeepCopy()
+				other.Name, other.Namespace = "bar", "garden-prod"
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(other)
+
+				err := admissionHandler.Admit(createAttributes(&shoot))
+
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should accept new Shoots whose domain is not used yet", func() {
+				other := shootBase.DeepCopy()
+				other.Name, other.Spec.DNS.Domain = "bar", &otherDomain
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(other)
+
+				Expect(admissionHandler.Admit(createAttributes(&shoot))).To(Succeed())
+			})
+
+			It("should not require the domains of Shoots with unmanaged DNS to be unique", func() {
+				other := shootBase.DeepCopy()
+				other.Name = "bar"
+				other.Spec.DNS.Provider = garden.DNSUnmanaged
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(other)
+				shoot.Spec.DNS.Provider = garden.DNSUnmanaged
+
+				Expect(admissionHandler.Admit(createAttributes(&shoot))).To(Succeed())
+			})
+
+			It("should reject changes of the domain", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.DNS.Domain = &otherDomain
+
+				err := admissionHandler.Admit(updateAttributes(&shoot, oldShoot))
+
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should accept updates of Shoots which keep their domain", func() {
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(shoot.DeepCopy())
+
+				Expect(admissionHandler.Admit(updateAttributes(&shoot, shoot.DeepCopy()))).To(Succeed())
+			})
+		})
+	})
+
+	Describe("#LoadConfiguration", func() {
+		It("should return the default configuration if none is given", func() {
+			configuration, err := LoadConfiguration(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configuration.NamePattern).To(BeEmpty())
+			Expect(configuration.MaxNameLength).To(BeZero())
+		})
+
+		It("should fail for an invalid name pattern", func() {
+			_, err := LoadConfiguration(strings.NewReader(`namePattern: "[a-z"`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for a negative maximum name length", func() {
+			_, err := LoadConfiguration(strings.NewReader(`maxNameLength: -1`))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,90 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package naming
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"regexp"
+
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Configuration is the configuration of the ShootNaming admission plugin. It is read from the file referenced for this
+// plugin in the admission control configuration file of the Gardener API server.
+type Configuration struct {
+	// NamePattern is a regular expression which the names of new Shoots must match entirely. If it is empty, any name
+	// which is valid for Shoots is accepted.
+	NamePattern string `json:"namePattern,omitempty"`
+	// MaxNameLength is the maximum length of the names of new Shoots. The names of many resources and the DNS names of
+	// a Shoot are derived from its name, hence, it can be limited further than required by the Shoot validation. If it
+	// is zero, the length of the names is not limited by this plugin.
+	MaxNameLength int `json:"maxNameLength,omitempty"`
+
+	namePattern *regexp.Regexp
+}
+
+// LoadConfiguration reads the configuration of the ShootNaming admission plugin. If no configuration is given, the
+// default configuration is returned, which does not restrict the names of Shoots.
+func LoadConfiguration(config io.Reader) (*Configuration, error) {
+	configuration := &Configuration{}
+	if config == nil {
+		return configuration, nil
+	}
+
+	data, err := ioutil.ReadAll(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, configuration); err != nil {
+		return nil, fmt.Errorf("could not decode the configuration of the %s admission plugin: %v", PluginName, err)
+	}
+
+	if errs := validateConfiguration(configuration); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration of the %s admission plugin: %v", PluginName, errs.ToAggregate())
+	}
+	return configuration, nil
+}
+
+func validateConfiguration(configuration *Configuration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(configuration.NamePattern) > 0 {
+		// The pattern must match the whole name, not only a part of it.
+		namePattern, err := regexp.Compile("^(?:" + configuration.NamePattern + ")$")
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("namePattern"), configuration.NamePattern, err.Error()))
+		}
+		configuration.namePattern = namePattern
+	}
+	if configuration.MaxNameLength < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("maxNameLength"), configuration.MaxNameLength, "must not be negative"))
+	}
+
+	return allErrs
+}
+
+// validateName checks whether the given Shoot <name> matches the name pattern and does not exceed the maximum length.
+func (c *Configuration) validateName(name string) error {
+	if c.MaxNameLength > 0 && len(name) > c.MaxNameLength {
+		return fmt.Errorf("the length of the shoot name must not exceed %d characters (shoot: %s)", c.MaxNameLength, name)
+	}
+	if c.namePattern != nil && !c.namePattern.MatchString(name) {
+		return fmt.Errorf("the shoot name must match the pattern %q (shoot: %s)", c.NamePattern, name)
+	}
+	return nil
+}
@@ -359,6 +359,16 @@ func (h *RejectShootIfQuotaExceeded) getShootResources(shoot garden.Shoot) (v1.R
 			return nil, fmt.Errorf("MachineType %s not found in CloudProfile %s", worker.MachineType, cloudProfile.Name)
 		}
 
+		// For now we always use the max. amount of resources for quota calculation
+		resources[garden.QuotaMetricCPU] = multiplyQuantity(machineType.CPU, worker.AutoScalerMax)
+		resources[garden.QuotaMetricGPU] = multiplyQuantity(machineType.GPU, worker.AutoScalerMax)
+		resources[garden.QuotaMetricMemory] = multiplyQuantity(machineType.Memory, worker.AutoScalerMax)
+
+		// Workers without a volume (e.g., bare-metal servers with local disks) do not consume any storage.
+		if len(worker.VolumeType) == 0 {
+			continue
+		}
+
 		// Get the proper VolumeType
 		for _, element := range volumeTypes {
 			if element.Name == worker.VolumeType {
@@ -370,11 +380,6 @@ func (h *RejectShootIfQuotaExceeded) getShootResources(shoot garden.Shoot) (v1.R
 			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.MachineType, cloudProfile.Name)
 		}
 
-		// For now we always use the max. amount of resources for quota calculation
-		resources[garden.QuotaMetricCPU] = multiplyQuantity(machineType.CPU, worker.AutoScalerMax)
-		resources[garden.QuotaMetricGPU] = multiplyQuantity(machineType.GPU, worker.AutoScalerMax)
-		resources[garden.QuotaMetricMemory] = multiplyQuantity(machineType.Memory, worker.AutoScalerMax)
-
 		switch volumeType.Class {
 		case garden.VolumeClassStandard:
 			resources[garden.QuotaMetricStorageStandard] = multiplyQuantity(worker.VolumeSize, worker.AutoScalerMax)
@@ -433,6 +438,12 @@ func getShootWorkerResources(shoot garden.Shoot, cloudProvider garden.CloudProvi
 				}
 			}
 		}
+	case garden.CloudProviderPacket:
+		workers = make([]quotaWorker, len(shoot.Spec.Cloud.Packet.Workers))
+
+		for idx, packetWorker := range shoot.Spec.Cloud.Packet.Workers {
+			workers[idx].Worker = packetWorker.Worker
+		}
 	}
 	return workers
 }
@@ -451,6 +462,8 @@ func getMachineTypes(provider garden.CloudProvider, cloudProfile garden.CloudPro
 		for _, element := range cloudProfile.Spec.OpenStack.Constraints.MachineTypes {
 			machineTypes = append(machineTypes, element.MachineType)
 		}
+	case garden.CloudProviderPacket:
+		machineTypes = cloudProfile.Spec.Packet.Constraints.MachineTypes
 	}
 	return machineTypes
 }
@@ -579,6 +592,21 @@ func quotaVerificationNeeded(new, old garden.Shoot, provider garden.CloudProvide
 				return true
 			}
 		}
+	case garden.CloudProviderPacket:
+		for _, worker := range new.Spec.Cloud.Packet.Workers {
+			oldHasWorker := false
+			for _, oldWorker := range old.Spec.Cloud.Packet.Workers {
+				if worker.Name == oldWorker.Name {
+					oldHasWorker = true
+					if hasWorkerDiff(worker.Worker, oldWorker.Worker) {
+						return true
+					}
+				}
+			}
+			if !oldHasWorker {
+				return true
+			}
+		}
 	}
 
 	return false
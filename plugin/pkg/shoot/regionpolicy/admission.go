@@ -0,0 +1,166 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regionpolicy
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootRegionPolicy"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// RegionPolicyEnforcer contains listers and and admission handler.
+type RegionPolicyEnforcer struct {
+	*admission.Handler
+	regionPolicyLister listers.RegionPolicyLister
+	namespaceLister    kubecorev1listers.NamespaceLister
+}
+
+var _ = admissioninitializer.WantsInternalGardenInformerFactory(&RegionPolicyEnforcer{})
+var _ = admissioninitializer.WantsKubeInformerFactory(&RegionPolicyEnforcer{})
+
+// New creates a new RegionPolicyEnforcer admission plugin. Only creations are checked because the cloud profile and
+// the region of a Shoot are immutable.
+func New() (*RegionPolicyEnforcer, error) {
+	return &RegionPolicyEnforcer{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (r *RegionPolicyEnforcer) SetInternalGardenInformerFactory(f informers.SharedInformerFactory) {
+	r.regionPolicyLister = f.Garden().InternalVersion().RegionPolicies().Lister()
+}
+
+// SetKubeInformerFactory gets Lister from SharedInformerFactory.
+func (r *RegionPolicyEnforcer) SetKubeInformerFactory(f kubeinformers.SharedInformerFactory) {
+	r.namespaceLister = f.Core().V1().Namespaces().Lister()
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (r *RegionPolicyEnforcer) ValidateInitialization() error {
+	if r.regionPolicyLister == nil {
+		return errors.New("missing regionPolicy lister")
+	}
+	if r.namespaceLister == nil {
+		return errors.New("missing namespace lister")
+	}
+	return nil
+}
+
+// Admit ensures that the region of a new Shoot is allowed by the RegionPolicies which select its project.
+func (r *RegionPolicyEnforcer) Admit(a admission.Attributes) error {
+	// Wait until the caches have been synced
+	if !r.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	if a.GetKind().GroupKind() != garden.Kind("Shoot") {
+		return nil
+	}
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	namespace, err := r.namespaceLister.Get(shoot.Namespace)
+	if err != nil {
+		return apierrors.NewBadRequest("could not find referenced namespace")
+	}
+	regionPolicies, err := r.regionPolicyLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	policies, allowedRegions, err := matchingRegionPolicies(regionPolicies, shoot.Spec.Cloud.Profile, namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if len(policies) == 0 || allowedRegions.Has(shoot.Spec.Cloud.Region) {
+		return nil
+	}
+
+	project := namespace.Name
+	if name, ok := namespace.Labels[common.ProjectName]; ok {
+		project = name
+	}
+	return admission.NewForbidden(a, fmt.Errorf("region %q of cloud profile %q is not allowed for project %q by the region policies %s, allowed regions are %s",
+		shoot.Spec.Cloud.Region, shoot.Spec.Cloud.Profile, project, strings.Join(policies, ", "), strings.Join(allowedRegions.List(), ", ")))
+}
+
+// matchingRegionPolicies returns the sorted names of the given <regionPolicies> for the cloud profile <cloudProfileName>
+// which select the project <namespace>, and the union of the regions which they allow.
+func matchingRegionPolicies(regionPolicies []*garden.RegionPolicy, cloudProfileName string, namespace *corev1.Namespace) ([]string, sets.String, error) {
+	var (
+		names          []string
+		allowedRegions = sets.NewString()
+	)
+
+	for _, regionPolicy := range regionPolicies {
+		if regionPolicy.Spec.CloudProfileName != cloudProfileName {
+			continue
+		}
+
+		selector := labels.Everything()
+		if regionPolicy.Spec.ProjectSelector != nil {
+			var err error
+			selector, err = metav1.LabelSelectorAsSelector(regionPolicy.Spec.ProjectSelector)
+			if err != nil {
+				return nil, nil, fmt.Errorf("invalid project selector of region policy %s: %s", regionPolicy.Name, err.Error())
+			}
+		}
+		if !selector.Matches(labels.Set(namespace.Labels)) {
+			continue
+		}
+
+		names = append(names, regionPolicy.Name)
+		allowedRegions.Insert(regionPolicy.Spec.Regions...)
+	}
+
+	sort.Strings(names)
+	return names, allowedRegions, nil
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package regionpolicy_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/regionpolicy"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	kubeinformers "k8s.io/client-go/informers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("regionpolicy", func() {
+	Describe("#Admit", func() {
+		var (
+			admissionHandler      *RegionPolicyEnforcer
+			kubeInformerFactory   kubeinformers.SharedInformerFactory
+			gardenInformerFactory gardeninformers.SharedInformerFactory
+			namespace             corev1.Namespace
+			shoot                 garden.Shoot
+			euPolicy              garden.RegionPolicy
+
+			admit = func() error {
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+				return admissionHandler.Admit(attrs)
+			}
+		)
+
+		BeforeEach(func() {
+			namespace = corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "garden-dev",
+					Labels: map[string]string{
+						common.ProjectName: "dev",
+						"data-residency":   "eu",
+					},
+				},
+			}
+			shoot = garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-dev",
+				},
+				Spec: garden.ShootSpec{
+					Cloud: garden.Cloud{
+						Profile: "aws",
+						Region:  "us-east-1",
+					},
+				},
+			}
+			euPolicy = garden.RegionPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "aws-eu",
+				},
+				Spec: garden.RegionPolicySpec{
+					CloudProfileName: "aws",
+					ProjectSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"data-residency": "eu"},
+					},
+					Regions: []string{"eu-central-1", "eu-west-1"},
+				},
+			}
+
+			admissionHandler, _ = New()
+			kubeInformerFactory = kubeinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetKubeInformerFactory(kubeInformerFactory)
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+		})
+
+		It("should allow all regions if no region policy exists", func() {
+			Expect(admit()).To(Succeed())
+		})
+
+		It("should allow a region which is listed by a selecting region policy", func() {
+			shoot.Spec.Cloud.Region = "eu-west-1"
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&euPolicy)
+
+			Expect(admit()).To(Succeed())
+		})
+
+		It("should reject a region which is not listed by the selecting region policies", func() {
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&euPolicy)
+
+			err := admit()
+
+			Expect(err).To(HaveOccurred())
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`region "us-east-1" of cloud profile "aws" is not allowed for project "dev" by the region policies aws-eu, allowed regions are eu-central-1, eu-west-1`))
+		})
+
+		It("should allow the union of the regions of all selecting region policies", func() {
+			usPolicy := euPolicy
+			usPolicy.Name = "aws-us-dev"
+			usPolicy.Spec = garden.RegionPolicySpec{
+				CloudProfileName: "aws",
+				ProjectSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{common.ProjectName: "dev"},
+				},
+				Regions: []string{"us-east-1"},
+			}
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&euPolicy)
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&usPolicy)
+
+			Expect(admit()).To(Succeed())
+		})
+
+		It("should ignore region policies which do not select the project", func() {
+			delete(namespace.Labels, "data-residency")
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&euPolicy)
+
+			Expect(admit()).To(Succeed())
+		})
+
+		It("should ignore region policies of other cloud profiles", func() {
+			euPolicy.Spec.CloudProfileName = "aws-china"
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&euPolicy)
+
+			Expect(admit()).To(Succeed())
+		})
+
+		It("should apply region policies without a project selector to all projects", func() {
+			euPolicy.Spec.ProjectSelector = nil
+			delete(namespace.Labels, "data-residency")
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&euPolicy)
+
+			err := admit()
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should not check updates of existing Shoots", func() {
+			gardenInformerFactory.Garden().InternalVersion().RegionPolicies().Informer().GetStore().Add(&euPolicy)
+
+			Expect(admissionHandler.Handles(admission.Update)).To(BeFalse())
+		})
+	})
+})
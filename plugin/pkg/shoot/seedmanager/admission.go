@@ -16,6 +16,7 @@ package seedmanager
 
 import (
 	"errors"
+	"fmt"
 	"io"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
@@ -38,28 +39,44 @@ const (
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
-		return New()
+		configuration, err := LoadConfiguration(config)
+		if err != nil {
+			return nil, err
+		}
+		return New(configuration)
 	})
 }
 
 // SeedManager contains listers and and admission handler.
 type SeedManager struct {
 	*admission.Handler
-	seedLister gardenlisters.SeedLister
+	configuration      *Configuration
+	seedLister         gardenlisters.SeedLister
+	cloudProfileLister gardenlisters.CloudProfileLister
 }
 
 var _ = admissioninitializer.WantsInternalGardenInformerFactory(&SeedManager{})
 
-// New creates a new SeedManager admission plugin.
-func New() (*SeedManager, error) {
+// New creates a new SeedManager admission plugin. If no configuration is given, the default configuration is used.
+func New(configuration *Configuration) (*SeedManager, error) {
+	if configuration == nil {
+		c, err := LoadConfiguration(nil)
+		if err != nil {
+			return nil, err
+		}
+		configuration = c
+	}
+
 	return &SeedManager{
-		Handler: admission.NewHandler(admission.Create, admission.Update),
+		Handler:       admission.NewHandler(admission.Create, admission.Update),
+		configuration: configuration,
 	}, nil
 }
 
 // SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
 func (h *SeedManager) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
 	h.seedLister = f.Garden().InternalVersion().Seeds().Lister()
+	h.cloudProfileLister = f.Garden().InternalVersion().CloudProfiles().Lister()
 }
 
 // ValidateInitialization checks whether the plugin was correctly initialized.
@@ -67,12 +84,16 @@ func (h *SeedManager) ValidateInitialization() error {
 	if h.seedLister == nil {
 		return errors.New("missing seed lister")
 	}
+	if h.cloudProfileLister == nil {
+		return errors.New("missing cloudprofile lister")
+	}
 	return nil
 }
 
 // Admit tries to find an adequate Seed cluster for the given cloud provider profile and region,
 // and writes the name into the Shoot specification. It also ensures that protected Seeds are
-// only usable by Shoots in the garden namespace.
+// only usable by Shoots in the garden namespace, and that Seeds only host Shoots whose Kubernetes
+// version lies within the Seed's constraints.
 func (h *SeedManager) Admit(a admission.Attributes) error {
 	// Wait until the caches have been synced
 	if !h.WaitForReady() {
@@ -105,11 +126,27 @@ func (h *SeedManager) Admit(a admission.Attributes) error {
 			return admission.NewForbidden(a, errors.New("forbidden to use a seed marked to be deleted"))
 		}
 
+		// The Kubernetes version constraints of the Seed are only checked if the Shoot is created or its version is
+		// changed, i.e. Shoots which have been scheduled before the constraints have been tightened are not affected.
+		if a.GetOperation() == admission.Create || kubernetesVersionChanged(a) {
+			supported, err := helper.SeedSupportsShootKubernetesVersion(seed, shoot.Spec.Kubernetes.Version)
+			if err != nil {
+				return admission.NewForbidden(a, err)
+			}
+			if !supported {
+				return admission.NewForbidden(a, fmt.Errorf("seed %q does not support hosting shoots with Kubernetes version %q", seed.Name, shoot.Spec.Kubernetes.Version))
+			}
+		}
+
 		return nil
 	}
 
 	// If no Seed is referenced, we try to determine an adequate one.
-	seed, err := determineSeed(shoot, h.seedLister)
+	strategy, err := h.seedSelectionStrategy(shoot)
+	if err != nil {
+		return admission.NewForbidden(a, err)
+	}
+	seed, err := determineSeed(shoot, h.seedLister, strategy, h.configuration)
 	if err != nil {
 		return admission.NewForbidden(a, err)
 	}
@@ -118,24 +155,92 @@ func (h *SeedManager) Admit(a admission.Attributes) error {
 	return nil
 }
 
-// determineSeed returns an appropriate Seed cluster (or nil).
-func determineSeed(shoot *garden.Shoot, lister gardenlisters.SeedLister) (*garden.Seed, error) {
+// seedSelectionStrategy returns the strategy used to determine the Seed of the given Shoot. The strategy of the
+// Shoot's CloudProfile takes precedence over the globally configured one.
+func (h *SeedManager) seedSelectionStrategy(shoot *garden.Shoot) (garden.SeedSelectionStrategy, error) {
+	cloudProfile, err := h.cloudProfileLister.Get(shoot.Spec.Cloud.Profile)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", err
+	}
+	if cloudProfile != nil && cloudProfile.Spec.SeedSelectionStrategy != nil {
+		return *cloudProfile.Spec.SeedSelectionStrategy, nil
+	}
+	return h.configuration.Strategy, nil
+}
+
+// determineSeed returns an appropriate Seed cluster (or nil) according to the given strategy.
+func determineSeed(shoot *garden.Shoot, lister gardenlisters.SeedLister, strategy garden.SeedSelectionStrategy, configuration *Configuration) (*garden.Seed, error) {
+	if strategy == garden.SeedSelectionStrategyManual {
+		return nil, fmt.Errorf("the seed selection strategy of cloud profile %q is %s, please specify the seed cluster in the Shoot manifest", shoot.Spec.Cloud.Profile, strategy)
+	}
+
 	list, err := lister.List(labels.Everything())
 	if err != nil {
 		return nil, err
 	}
 
+	var (
+		candidate         *garden.Seed
+		candidateDistance int
+	)
 	for _, seed := range list {
-		// We return the first matching seed cluster.
-		if seed.DeletionTimestamp == nil && seed.Spec.Cloud.Profile == shoot.Spec.Cloud.Profile && seed.Spec.Cloud.Region == shoot.Spec.Cloud.Region && seed.Spec.Visible != nil && *seed.Spec.Visible && verifySeedAvailability(seed) {
-			return seed, nil
+		if seed.DeletionTimestamp != nil || seed.Spec.Cloud.Profile != shoot.Spec.Cloud.Profile || seed.Spec.Visible == nil || !*seed.Spec.Visible || !verifySeedAvailability(seed) || !verifySeedKubernetesVersion(seed, shoot.Spec.Kubernetes.Version) {
+			continue
+		}
+
+		var distance int
+		switch strategy {
+		case garden.SeedSelectionStrategyMinimalDistance:
+			d, known := configuration.regionDistance(seed.Spec.Cloud.Region, shoot.Spec.Cloud.Region)
+			if !known {
+				continue
+			}
+			distance = d
+		default:
+			if seed.Spec.Cloud.Region != shoot.Spec.Cloud.Region {
+				continue
+			}
+		}
+
+		// Prefer the closest seed cluster, and the one with the lexicographically smallest name among equally distant
+		// ones in order to get deterministic results.
+		if candidate == nil || distance < candidateDistance || (distance == candidateDistance && seed.Name < candidate.Name) {
+			candidate, candidateDistance = seed, distance
 		}
 	}
 
-	return nil, errors.New("failed to determine an adequate Seed cluster for this cloud profile and region")
+	if candidate == nil {
+		return nil, fmt.Errorf("failed to determine an adequate Seed cluster for this cloud profile, region and Kubernetes version (seed selection strategy: %s)", strategy)
+	}
+	return candidate, nil
+}
+
+func verifySeedKubernetesVersion(seed *garden.Seed, version string) bool {
+	supported, err := helper.SeedSupportsShootKubernetesVersion(seed, version)
+	return err == nil && supported
 }
 
+func kubernetesVersionChanged(a admission.Attributes) bool {
+	if a.GetOperation() != admission.Update {
+		return false
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return false
+	}
+	oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+	if !ok {
+		return false
+	}
+	return shoot.Spec.Kubernetes.Version != oldShoot.Spec.Kubernetes.Version
+}
+
+// verifySeedAvailability returns true if the given <seed> is available. Seeds whose kubeconfig has been found invalid
+// are considered unavailable, because no Shoots could be created on them.
 func verifySeedAvailability(seed *garden.Seed) bool {
+	if cond := helper.GetCondition(seed.Status.Conditions, garden.SeedKubeconfigValid); cond != nil && cond.Status == corev1.ConditionFalse {
+		return false
+	}
 	if cond := helper.GetCondition(seed.Status.Conditions, garden.SeedAvailable); cond != nil {
 		return cond.Status == corev1.ConditionTrue
 	}
@@ -15,6 +15,8 @@
 package seedmanager_test
 
 import (
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/garden"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/seedmanager"
@@ -73,12 +75,15 @@ var _ = Describe("seedmanager", func() {
 						Profile: cloudProfileName,
 						Region:  region,
 					},
+					Kubernetes: garden.Kubernetes{
+						Version: "1.10.1",
+					},
 				},
 			}
 		)
 
 		BeforeEach(func() {
-			admissionHandler, _ = New()
+			admissionHandler, _ = New(nil)
 			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
 
@@ -145,6 +150,67 @@ var _ = Describe("seedmanager", func() {
 			})
 		})
 
+		Context("Shoot references a Seed - Kubernetes version constraints", func() {
+			var (
+				minVersion = "1.9.0"
+				maxVersion = "1.10"
+			)
+
+			BeforeEach(func() {
+				shoot.Spec.Cloud.Seed = &seedName
+				seed.Spec.ShootKubernetesVersions = &garden.SeedShootKubernetesVersions{
+					Min: &minVersion,
+					Max: &maxVersion,
+				}
+			})
+
+			It("should pass because the Kubernetes version of the shoot is within the constraints of the seed", func() {
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should fail because the Kubernetes version of the shoot is lower than the minimum of the seed", func() {
+				shoot.Spec.Kubernetes.Version = "1.8.6"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should fail because the Kubernetes version of the shoot is updated beyond the maximum of the seed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Kubernetes.Version = "1.11.0"
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should pass because the Kubernetes version of an existing shoot is not changed", func() {
+				shoot.Spec.Kubernetes.Version = "1.8.6"
+				oldShoot := shoot.DeepCopy()
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
 		Context("Shoot does not reference a Seed - find an adequate one", func() {
 			BeforeEach(func() {
 				shoot.Spec.Cloud.Seed = nil
@@ -204,6 +270,22 @@ var _ = Describe("seedmanager", func() {
 				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
 			})
 
+			It("should fail because it cannot find a seed cluster due to an invalid kubeconfig", func() {
+				seed.Status.Conditions = append(seed.Status.Conditions, garden.Condition{
+					Type:   garden.SeedKubeconfigValid,
+					Status: corev1.ConditionFalse,
+				})
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
 			It("should fail because it cannot find a seed cluster due to invisibility", func() {
 				seed.Spec.Visible = &falseVar
 
@@ -216,6 +298,169 @@ var _ = Describe("seedmanager", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
 			})
+
+			It("should fail because it cannot find a seed cluster supporting the Kubernetes version", func() {
+				maxVersion := "1.9"
+				seed.Spec.ShootKubernetesVersions = &garden.SeedShootKubernetesVersions{
+					Max: &maxVersion,
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+		})
+
+		Context("Shoot does not reference a Seed - seed selection strategies", func() {
+			var (
+				cloudProfile garden.CloudProfile
+
+				newSeed = func(name, region string) *garden.Seed {
+					s := seedBase.DeepCopy()
+					s.Name = name
+					s.Spec.Cloud.Region = region
+					return s
+				}
+				newStrategy = func(strategy garden.SeedSelectionStrategy) *garden.SeedSelectionStrategy {
+					return &strategy
+				}
+			)
+
+			BeforeEach(func() {
+				shoot.Spec.Cloud.Seed = nil
+				cloudProfile = garden.CloudProfile{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: cloudProfileName,
+					},
+				}
+
+				admissionHandler, _ = New(&Configuration{
+					Strategy: garden.SeedSelectionStrategyMinimalDistance,
+					RegionDistances: []RegionDistance{
+						{Regions: []string{region, "asia"}, Distance: 100},
+						{Regions: []string{"america", region}, Distance: 50},
+					},
+				})
+				admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			})
+
+			It("should select the seed cluster with the minimal distance", func() {
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-asia", "asia"))
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-america", "america"))
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-africa", "africa"))
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal("seed-america"))
+			})
+
+			It("should prefer a seed cluster in the same region", func() {
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-america", "america"))
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-b", region))
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-a", region))
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal("seed-a"))
+			})
+
+			It("should fail because the distance to the regions of all seed clusters is unknown", func() {
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-africa", "africa"))
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
+			It("should use the strategy of the cloud profile instead of the global one", func() {
+				cloudProfile.Spec.SeedSelectionStrategy = newStrategy(garden.SeedSelectionStrategySameRegion)
+
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(newSeed("seed-america", "america"))
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
+			It("should fail because the strategy of the cloud profile requires the seed to be set manually", func() {
+				cloudProfile.Spec.SeedSelectionStrategy = newStrategy(garden.SeedSelectionStrategyManual)
+
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(shoot.Spec.Cloud.Seed).To(BeNil())
+			})
+
+			It("should pass with the manual strategy if the seed is set", func() {
+				cloudProfile.Spec.SeedSelectionStrategy = newStrategy(garden.SeedSelectionStrategyManual)
+				shoot.Spec.Cloud.Seed = &seedName
+
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*shoot.Spec.Cloud.Seed).To(Equal(seedName))
+			})
+		})
+	})
+
+	Describe("#LoadConfiguration", func() {
+		It("should return the default configuration if none is given", func() {
+			configuration, err := LoadConfiguration(nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configuration.Strategy).To(Equal(garden.SeedSelectionStrategySameRegion))
+		})
+
+		It("should read the configuration", func() {
+			configuration, err := LoadConfiguration(strings.NewReader(`strategy: MinimalDistance
+regionDistances:
+- regions: [eu-west-1, eu-central-1]
+  distance: 10
+`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(configuration.Strategy).To(Equal(garden.SeedSelectionStrategyMinimalDistance))
+			Expect(configuration.RegionDistances).To(ConsistOf(RegionDistance{Regions: []string{"eu-west-1", "eu-central-1"}, Distance: 10}))
+		})
+
+		It("should fail for an unknown strategy", func() {
+			_, err := LoadConfiguration(strings.NewReader(`strategy: Random`))
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail for a distance not referring to two regions", func() {
+			_, err := LoadConfiguration(strings.NewReader(`regionDistances:
+- regions: [eu-west-1]
+  distance: 10
+`))
+
+			Expect(err).To(HaveOccurred())
 		})
 	})
 })
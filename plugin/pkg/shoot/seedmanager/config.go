@@ -0,0 +1,106 @@
+// Copyright (c) 2018 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seedmanager
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/garden/validation"
+	"github.com/ghodss/yaml"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Configuration is the configuration of the ShootSeedManager admission plugin. It is read from the file referenced
+// for this plugin in the admission control configuration file of the Gardener API server.
+type Configuration struct {
+	// Strategy is the strategy used to determine the Seed cluster of Shoots which do not reference a Seed, unless
+	// their CloudProfile specifies a different one. Defaults to SameRegion.
+	Strategy garden.SeedSelectionStrategy `json:"strategy,omitempty"`
+	// RegionDistances is the table of distances between regions used by the MinimalDistance strategy.
+	RegionDistances []RegionDistance `json:"regionDistances,omitempty"`
+}
+
+// RegionDistance is the (geographic or latency) distance between two regions. Distances are symmetric, i.e. the
+// order of the regions does not matter.
+type RegionDistance struct {
+	// Regions are the names of the two regions.
+	Regions []string `json:"regions"`
+	// Distance is the distance between the two regions. Lower values are preferred.
+	Distance int `json:"distance"`
+}
+
+// LoadConfiguration reads the configuration of the ShootSeedManager admission plugin. If no configuration is given,
+// the default configuration is returned.
+func LoadConfiguration(config io.Reader) (*Configuration, error) {
+	configuration := &Configuration{
+		Strategy: garden.SeedSelectionStrategySameRegion,
+	}
+	if config == nil {
+		return configuration, nil
+	}
+
+	data, err := ioutil.ReadAll(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, configuration); err != nil {
+		return nil, fmt.Errorf("could not decode the configuration of the %s admission plugin: %v", PluginName, err)
+	}
+	if len(configuration.Strategy) == 0 {
+		configuration.Strategy = garden.SeedSelectionStrategySameRegion
+	}
+
+	if errs := validateConfiguration(configuration); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid configuration of the %s admission plugin: %v", PluginName, errs.ToAggregate())
+	}
+	return configuration, nil
+}
+
+func validateConfiguration(configuration *Configuration) field.ErrorList {
+	allErrs := validation.ValidateSeedSelectionStrategy(configuration.Strategy, field.NewPath("strategy"))
+
+	for i, regionDistance := range configuration.RegionDistances {
+		idxPath := field.NewPath("regionDistances").Index(i)
+
+		if len(regionDistance.Regions) != 2 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("regions"), regionDistance.Regions, "must contain exactly two regions"))
+		}
+		if regionDistance.Distance < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("distance"), regionDistance.Distance, "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+// regionDistance returns the distance between the two given regions and whether it is known at all. The distance
+// of a region to itself is always zero.
+func (c *Configuration) regionDistance(region1, region2 string) (int, bool) {
+	if region1 == region2 {
+		return 0, true
+	}
+	for _, regionDistance := range c.RegionDistances {
+		if len(regionDistance.Regions) != 2 {
+			continue
+		}
+		if (regionDistance.Regions[0] == region1 && regionDistance.Regions[1] == region2) || (regionDistance.Regions[0] == region2 && regionDistance.Regions[1] == region1) {
+			return regionDistance.Distance, true
+		}
+	}
+	return 0, false
+}
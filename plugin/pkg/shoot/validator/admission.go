@@ -19,20 +19,25 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"sort"
 	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/apis/garden/helper"
+	"github.com/gardener/gardener/pkg/apiserver/admission/cloudprovider"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	informers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	listers "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
 	"github.com/gardener/gardener/pkg/operation/common"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	kubeinformers "k8s.io/client-go/informers"
 	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
@@ -50,18 +55,22 @@ func Register(plugins *admission.Plugins) {
 // ValidateShoot contains listers and and admission handler.
 type ValidateShoot struct {
 	*admission.Handler
-	cloudProfileLister listers.CloudProfileLister
-	seedLister         listers.SeedLister
-	namespaceLister    kubecorev1listers.NamespaceLister
+	cloudProfileLister    listers.CloudProfileLister
+	seedLister            listers.SeedLister
+	shootLister           listers.ShootLister
+	namespaceLister       kubecorev1listers.NamespaceLister
+	cloudProviderRegistry *cloudprovider.Registry
 }
 
 var _ = admissioninitializer.WantsInternalGardenInformerFactory(&ValidateShoot{})
 var _ = admissioninitializer.WantsKubeInformerFactory(&ValidateShoot{})
+var _ = admissioninitializer.WantsCloudProviderRegistry(&ValidateShoot{})
 
 // New creates a new ValidateShoot admission plugin.
 func New() (*ValidateShoot, error) {
 	return &ValidateShoot{
-		Handler: admission.NewHandler(admission.Create, admission.Update),
+		Handler:               admission.NewHandler(admission.Create, admission.Update),
+		cloudProviderRegistry: cloudprovider.NewDefaultRegistry(),
 	}, nil
 }
 
@@ -69,6 +78,7 @@ func New() (*ValidateShoot, error) {
 func (h *ValidateShoot) SetInternalGardenInformerFactory(f informers.SharedInformerFactory) {
 	h.cloudProfileLister = f.Garden().InternalVersion().CloudProfiles().Lister()
 	h.seedLister = f.Garden().InternalVersion().Seeds().Lister()
+	h.shootLister = f.Garden().InternalVersion().Shoots().Lister()
 }
 
 // SetKubeInformerFactory gets Lister from SharedInformerFactory.
@@ -76,6 +86,11 @@ func (h *ValidateShoot) SetKubeInformerFactory(f kubeinformers.SharedInformerFac
 	h.namespaceLister = f.Core().V1().Namespaces().Lister()
 }
 
+// SetCloudProviderRegistry sets the registry of cloud provider specific Shoot hooks.
+func (h *ValidateShoot) SetCloudProviderRegistry(r *cloudprovider.Registry) {
+	h.cloudProviderRegistry = r
+}
+
 // ValidateInitialization checks whether the plugin was correctly initialized.
 func (h *ValidateShoot) ValidateInitialization() error {
 	if h.cloudProfileLister == nil {
@@ -84,9 +99,15 @@ func (h *ValidateShoot) ValidateInitialization() error {
 	if h.seedLister == nil {
 		return errors.New("missing seed lister")
 	}
+	if h.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
 	if h.namespaceLister == nil {
 		return errors.New("missing namespace lister")
 	}
+	if h.cloudProviderRegistry == nil {
+		return errors.New("missing cloud provider registry")
+	}
 	return nil
 }
 
@@ -126,14 +147,12 @@ func (h *ValidateShoot) Admit(a admission.Attributes) error {
 	// this limit. The project name is a label on the namespace. If it is not found, the namespace name itself is used as
 	// project name. These checks should only be performed for CREATE operations (we do not want to reject changes to existing
 	// Shoots in case the limits are changed in the future).
+	projectName := shoot.Namespace
+	if projectNameLabel, ok := namespace.Labels[common.ProjectName]; ok {
+		projectName = projectNameLabel
+	}
 	if a.GetOperation() == admission.Create {
-		var (
-			projectName = shoot.Namespace
-			lengthLimit = 21
-		)
-		if projectNameLabel, ok := namespace.Labels[common.ProjectName]; ok {
-			projectName = projectNameLabel
-		}
+		lengthLimit := 21
 		if len(projectName+shoot.Name) > lengthLimit {
 			return apierrors.NewBadRequest(fmt.Sprintf("the length of the shoot name and the project name must not exceed %d characters (project: %s; shoot: %s)", lengthLimit, projectName, shoot.Name))
 		}
@@ -175,6 +194,9 @@ func (h *ValidateShoot) Admit(a admission.Attributes) error {
 					OpenStack: &garden.OpenStackCloud{
 						MachineImage: &garden.OpenStackMachineImage{},
 					},
+					Packet: &garden.PacketCloud{
+						MachineImage: &garden.PacketMachineImage{},
+					},
 				},
 			},
 		}
@@ -186,12 +208,28 @@ func (h *ValidateShoot) Admit(a admission.Attributes) error {
 		oldShoot = old
 	}
 
+	// Execute the defaulting hooks which have been registered by the cloud providers.
+	shootValidators := h.cloudProviderRegistry.ShootValidators(cloudProviderInShoot)
+	for _, shootValidator := range shootValidators {
+		if err := shootValidator.Default(shoot, cloudProfile); err != nil {
+			return apierrors.NewBadRequest(err.Error())
+		}
+	}
+
+	// The technical id is the prefix of the names of many resources which are generated for the Shoot. If it is not yet
+	// stored in the Shoot status we use the pattern for new Shoots as it is at least as long as the one of old Shoots.
+	technicalID := shoot.Status.TechnicalID
+	if len(technicalID) == 0 {
+		technicalID = fmt.Sprintf("shoot--%s--%s", projectName, shoot.Name)
+	}
+
 	var (
 		validationContext = &validationContext{
 			cloudProfile: cloudProfile,
 			seed:         seed,
 			shoot:        shoot,
 			oldShoot:     oldShoot,
+			technicalID:  technicalID,
 		}
 		allErrs field.ErrorList
 	)
@@ -236,6 +274,32 @@ func (h *ValidateShoot) Admit(a admission.Attributes) error {
 			shoot.Spec.Cloud.OpenStack.MachineImage = image
 		}
 		allErrs = validateOpenStack(validationContext)
+
+	case garden.CloudProviderPacket:
+		if shoot.Spec.Cloud.Packet.MachineImage == nil {
+			image, err := getPacketMachineImage(shoot, cloudProfile)
+			if err != nil {
+				return apierrors.NewBadRequest(err.Error())
+			}
+			shoot.Spec.Cloud.Packet.MachineImage = image
+		}
+		allErrs = validatePacket(validationContext)
+	}
+
+	allErrs = append(allErrs, h.validatePassiveReplicaSeed(shoot, seed)...)
+	allErrs = append(allErrs, h.validateCloneSource(shoot, oldShoot, a.GetOperation())...)
+	allErrs = append(allErrs, h.validateDependencies(shoot, oldShoot)...)
+	allErrs = append(allErrs, validateWorkerRenames(shoot, oldShoot, a.GetOperation())...)
+	allErrs = append(allErrs, validateRecoveryDrills(shoot)...)
+
+	// Execute the validation hooks which have been registered by the cloud providers. They only receive the
+	// real old Shoot object (nil on CREATE operations).
+	var previousShoot *garden.Shoot
+	if a.GetOperation() == admission.Update {
+		previousShoot = oldShoot
+	}
+	for _, shootValidator := range shootValidators {
+		allErrs = append(allErrs, shootValidator.Validate(shoot, previousShoot, cloudProfile, seed)...)
 	}
 
 	if len(allErrs) > 0 {
@@ -255,6 +319,213 @@ func (h *ValidateShoot) Admit(a admission.Attributes) error {
 	return nil
 }
 
+// validatePassiveReplicaSeed validates the Seed referenced by the (experimental) passive replica annotation of the
+// <shoot>. The passive replica restores the backups of the active etcd, hence, it must run in a different Seed cluster
+// which uses the same cloud profile as the <seed> of the active control plane.
+func (h *ValidateShoot) validatePassiveReplicaSeed(shoot *garden.Shoot, seed *garden.Seed) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		path    = field.NewPath("metadata", "annotations").Key(common.ShootPassiveReplicaSeed)
+	)
+
+	passiveSeedName, ok := shoot.Annotations[common.ShootPassiveReplicaSeed]
+	if !ok {
+		return allErrs
+	}
+
+	if passiveSeedName == seed.Name {
+		allErrs = append(allErrs, field.Invalid(path, passiveSeedName, "passive replica seed must differ from the seed of the shoot"))
+		return allErrs
+	}
+	passiveSeed, err := h.seedLister.Get(passiveSeedName)
+	if err != nil {
+		allErrs = append(allErrs, field.NotFound(path, passiveSeedName))
+		return allErrs
+	}
+	if passiveSeed.Spec.Cloud.Profile != seed.Spec.Cloud.Profile {
+		allErrs = append(allErrs, field.Invalid(path, passiveSeedName, fmt.Sprintf("passive replica seed must use the same cloud profile as the seed of the shoot (%s)", seed.Spec.Cloud.Profile)))
+	}
+
+	return allErrs
+}
+
+// validateCloneSource validates the Shoot referenced by the clone annotation of the <shoot>. The etcd of the clone is
+// restored from the backups of the source Shoot which are only accessible from the Seed cluster of the source, hence,
+// both Shoots must use the same Seed. The annotation is only evaluated while the clone is created, thus, it cannot be
+// added or changed afterwards.
+func (h *ValidateShoot) validateCloneSource(shoot, oldShoot *garden.Shoot, operation admission.Operation) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		path    = field.NewPath("metadata", "annotations").Key(common.ShootCloneOf)
+	)
+
+	sourceName, ok := shoot.Annotations[common.ShootCloneOf]
+	if operation == admission.Update {
+		if oldShoot.Annotations[common.ShootCloneOf] != sourceName {
+			allErrs = append(allErrs, field.Forbidden(path, "clone source cannot be changed after the shoot has been created"))
+		}
+		return allErrs
+	}
+	if !ok {
+		return allErrs
+	}
+
+	if sourceName == shoot.Name {
+		allErrs = append(allErrs, field.Invalid(path, sourceName, "shoot cannot be a clone of itself"))
+		return allErrs
+	}
+	source, err := h.shootLister.Shoots(shoot.Namespace).Get(sourceName)
+	if err != nil {
+		allErrs = append(allErrs, field.NotFound(path, sourceName))
+		return allErrs
+	}
+	if source.DeletionTimestamp != nil {
+		allErrs = append(allErrs, field.Invalid(path, sourceName, "clone source is being deleted"))
+	}
+	if len(source.Status.UID) == 0 || len(source.Status.TechnicalID) == 0 {
+		allErrs = append(allErrs, field.Invalid(path, sourceName, "clone source has not been created yet"))
+	}
+	if source.Spec.Cloud.Seed == nil || shoot.Spec.Cloud.Seed == nil || *source.Spec.Cloud.Seed != *shoot.Spec.Cloud.Seed {
+		allErrs = append(allErrs, field.Invalid(path, sourceName, "clone must use the same seed as its source"))
+	}
+
+	return allErrs
+}
+
+// validateWorkerRenames validates the renames of worker groups requested with the rename annotation of the <shoot>.
+// The new names must be worker groups of the <shoot>, while the old names must not be worker groups of the <shoot>
+// anymore. Renames which are added with this update must rename worker groups of the <oldShoot>. Worker groups of new
+// Shoots cannot be renamed.
+func validateWorkerRenames(shoot, oldShoot *garden.Shoot, operation admission.Operation) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		path    = field.NewPath("metadata", "annotations").Key(common.ShootRenameWorkers)
+	)
+
+	value, ok := shoot.Annotations[common.ShootRenameWorkers]
+	if !ok {
+		return allErrs
+	}
+	if operation == admission.Create {
+		allErrs = append(allErrs, field.Forbidden(path, "worker groups can only be renamed for existing shoots"))
+		return allErrs
+	}
+
+	renames, err := common.ParseWorkerRenames(value)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(path, value, err.Error()))
+		return allErrs
+	}
+	// Renames which are already pending have been validated against the worker groups they rename before.
+	pendingRenames, _ := common.ParseWorkerRenames(oldShoot.Annotations[common.ShootRenameWorkers])
+
+	var (
+		workerNames    = sets.NewString(helper.GetWorkerNames(shoot.Spec.Cloud)...)
+		oldWorkerNames = sets.NewString(helper.GetWorkerNames(oldShoot.Spec.Cloud)...)
+		oldNames       = make([]string, 0, len(renames))
+	)
+	for oldName := range renames {
+		oldNames = append(oldNames, oldName)
+	}
+	sort.Strings(oldNames)
+
+	for _, oldName := range oldNames {
+		newName := renames[oldName]
+		if !workerNames.Has(newName) {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("worker group %q does not exist", newName)))
+		}
+		if workerNames.Has(oldName) {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("worker group %q must be removed when it is renamed", oldName)))
+		}
+		if pendingRenames[oldName] != newName && !oldWorkerNames.Has(oldName) {
+			allErrs = append(allErrs, field.Invalid(path, value, fmt.Sprintf("worker group %q does not exist and cannot be renamed", oldName)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateRecoveryDrills validates the recovery drills requested with the recovery drills annotation of the <shoot>.
+func validateRecoveryDrills(shoot *garden.Shoot) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	value, ok := shoot.Annotations[common.ShootRecoveryDrills]
+	if !ok {
+		return allErrs
+	}
+	if _, err := common.ParseRecoveryDrills(value); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "annotations").Key(common.ShootRecoveryDrills), value, err.Error()))
+	}
+
+	return allErrs
+}
+
+// validateDependencies checks that the Shoots the given <shoot> depends on exist and that they do not (transitively)
+// depend on the <shoot> itself. Dependencies which were already present in the <oldShoot> are not required to exist
+// anymore, so that Shoots whose dependencies have been deleted can still be updated.
+func (h *ValidateShoot) validateDependencies(shoot, oldShoot *garden.Shoot) field.ErrorList {
+	var (
+		allErrs              = field.ErrorList{}
+		path                 = field.NewPath("spec", "dependsOn")
+		shootKey             = common.ShootDependencyKey(shoot.Namespace, corev1.ObjectReference{Name: shoot.Name})
+		existingDependencies = sets.NewString()
+	)
+
+	for _, dependency := range oldShoot.Spec.DependsOn {
+		existingDependencies.Insert(common.ShootDependencyKey(oldShoot.Namespace, dependency))
+	}
+
+	for i, dependency := range shoot.Spec.DependsOn {
+		key := common.ShootDependencyKey(shoot.Namespace, dependency)
+		if key == shootKey {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), key, "shoot cannot depend on itself"))
+			continue
+		}
+		if !existingDependencies.Has(key) {
+			if _, err := h.getShootByKey(key); err != nil {
+				allErrs = append(allErrs, field.NotFound(path.Index(i), key))
+				continue
+			}
+		}
+		if cycle := h.findDependencyPath(key, shootKey, sets.NewString()); cycle != nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), key, fmt.Sprintf("dependency cycle detected: %s", strings.Join(append([]string{shootKey}, cycle...), " -> "))))
+		}
+	}
+
+	return allErrs
+}
+
+// findDependencyPath follows the dependencies of the Shoot with the given <key> and returns the chain of Shoot keys
+// which leads to the Shoot with the key <target>. It returns nil if <target> is not reachable.
+func (h *ValidateShoot) findDependencyPath(key, target string, visited sets.String) []string {
+	if key == target {
+		return []string{key}
+	}
+	if visited.Has(key) {
+		return nil
+	}
+	visited.Insert(key)
+
+	shoot, err := h.getShootByKey(key)
+	if err != nil {
+		return nil
+	}
+	for _, dependency := range shoot.Spec.DependsOn {
+		if path := h.findDependencyPath(common.ShootDependencyKey(shoot.Namespace, dependency), target, visited); path != nil {
+			return append([]string{key}, path...)
+		}
+	}
+	return nil
+}
+
+func (h *ValidateShoot) getShootByKey(key string) (*garden.Shoot, error) {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return h.shootLister.Shoots(namespace).Get(name)
+}
+
 // Cloud specific validation
 
 type validationContext struct {
@@ -262,6 +533,7 @@ type validationContext struct {
 	seed         *garden.Seed
 	shoot        *garden.Shoot
 	oldShoot     *garden.Shoot
+	technicalID  string
 }
 
 func validateAWS(c *validationContext) field.ErrorList {
@@ -292,9 +564,19 @@ func validateAWS(c *validationContext) field.ErrorList {
 		}
 
 		idxPath := path.Child("workers").Index(i)
+		if len(oldWorker.Name) == 0 {
+			allErrs = append(allErrs, validateMachineDeploymentName(common.ZonedMachineDeploymentName(c.technicalID, worker.Name, len(c.shoot.Spec.Cloud.AWS.Zones)-1), worker.Name, idxPath.Child("name"))...)
+		}
 		if ok, validMachineTypes := validateMachineTypes(c.cloudProfile.Spec.AWS.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
 		}
+		if ok, validArchitectures := validateWorkerArchitecture(c.cloudProfile.Spec.AWS.Constraints.MachineTypes, machineImageArchitectures(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.AWS.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region), worker.Worker, oldWorker.Worker); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("architecture"), helper.GetMachineArchitecture(worker.Architecture), validArchitectures))
+		}
+		if worker.WarmUp != nil && *worker.WarmUp && !warmMachineImageOffered(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.AWS.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region, helper.GetMachineArchitecture(worker.Architecture)) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("warmUp"), "the cloud profile does not offer a pre-warmed variant of the machine image for the region and architecture of this worker"))
+		}
+		allErrs = append(allErrs, validateWorkerOverrides(c, c.cloudProfile.Spec.AWS.Constraints.Kubernetes.Versions, worker.Worker, oldWorker.Worker, idxPath)...)
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.AWS.Constraints.VolumeTypes, worker.VolumeType, oldWorker.VolumeType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
@@ -342,9 +624,19 @@ func validateAzure(c *validationContext) field.ErrorList {
 		}
 
 		idxPath := path.Child("workers").Index(i)
+		if len(oldWorker.Name) == 0 {
+			allErrs = append(allErrs, validateMachineDeploymentName(common.MachineDeploymentName(c.technicalID, worker.Name), worker.Name, idxPath.Child("name"))...)
+		}
 		if ok, validMachineTypes := validateMachineTypes(c.cloudProfile.Spec.Azure.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
 		}
+		if ok, validArchitectures := validateWorkerArchitecture(c.cloudProfile.Spec.Azure.Constraints.MachineTypes, machineImageArchitectures(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.Azure.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region), worker.Worker, oldWorker.Worker); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("architecture"), helper.GetMachineArchitecture(worker.Architecture), validArchitectures))
+		}
+		if worker.WarmUp != nil && *worker.WarmUp && !warmMachineImageOffered(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.Azure.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region, helper.GetMachineArchitecture(worker.Architecture)) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("warmUp"), "the cloud profile does not offer a pre-warmed variant of the machine image for the region and architecture of this worker"))
+		}
+		allErrs = append(allErrs, validateWorkerOverrides(c, c.cloudProfile.Spec.Azure.Constraints.Kubernetes.Versions, worker.Worker, oldWorker.Worker, idxPath)...)
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.Azure.Constraints.VolumeTypes, worker.VolumeType, oldWorker.VolumeType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
@@ -388,9 +680,19 @@ func validateGCP(c *validationContext) field.ErrorList {
 		}
 
 		idxPath := path.Child("workers").Index(i)
+		if len(oldWorker.Name) == 0 {
+			allErrs = append(allErrs, validateMachineDeploymentName(common.ZonedMachineDeploymentName(c.technicalID, worker.Name, len(c.shoot.Spec.Cloud.GCP.Zones)-1), worker.Name, idxPath.Child("name"))...)
+		}
 		if ok, validMachineTypes := validateMachineTypes(c.cloudProfile.Spec.GCP.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
 		}
+		if ok, validArchitectures := validateWorkerArchitecture(c.cloudProfile.Spec.GCP.Constraints.MachineTypes, machineImageArchitectures(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.GCP.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region), worker.Worker, oldWorker.Worker); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("architecture"), helper.GetMachineArchitecture(worker.Architecture), validArchitectures))
+		}
+		if worker.WarmUp != nil && *worker.WarmUp && !warmMachineImageOffered(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.GCP.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region, helper.GetMachineArchitecture(worker.Architecture)) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("warmUp"), "the cloud profile does not offer a pre-warmed variant of the machine image for the region and architecture of this worker"))
+		}
+		allErrs = append(allErrs, validateWorkerOverrides(c, c.cloudProfile.Spec.GCP.Constraints.Kubernetes.Versions, worker.Worker, oldWorker.Worker, idxPath)...)
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.GCP.Constraints.VolumeTypes, worker.VolumeType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volumeType"), worker.VolumeType, validVolumeTypes))
 		}
@@ -444,9 +746,19 @@ func validateOpenStack(c *validationContext) field.ErrorList {
 		}
 
 		idxPath := path.Child("workers").Index(i)
+		if len(oldWorker.Name) == 0 {
+			allErrs = append(allErrs, validateMachineDeploymentName(common.ZonedMachineDeploymentName(c.technicalID, worker.Name, len(c.shoot.Spec.Cloud.OpenStack.Zones)-1), worker.Name, idxPath.Child("name"))...)
+		}
 		if ok, validMachineTypes := validateOpenStackMachineTypes(c.cloudProfile.Spec.OpenStack.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
 		}
+		if ok, validArchitectures := validateWorkerArchitecture(openStackMachineTypes(c.cloudProfile.Spec.OpenStack.Constraints.MachineTypes), machineImageArchitectures(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.OpenStack.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region), worker.Worker, oldWorker.Worker); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("architecture"), helper.GetMachineArchitecture(worker.Architecture), validArchitectures))
+		}
+		if worker.WarmUp != nil && *worker.WarmUp && !warmMachineImageOffered(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.OpenStack.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region, helper.GetMachineArchitecture(worker.Architecture)) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("warmUp"), "the cloud profile does not offer a pre-warmed variant of the machine image for the region and architecture of this worker"))
+		}
+		allErrs = append(allErrs, validateWorkerOverrides(c, c.cloudProfile.Spec.OpenStack.Constraints.Kubernetes.Versions, worker.Worker, oldWorker.Worker, idxPath)...)
 	}
 
 	for i, zone := range c.shoot.Spec.Cloud.OpenStack.Zones {
@@ -463,6 +775,63 @@ func validateOpenStack(c *validationContext) field.ErrorList {
 	return allErrs
 }
 
+func validatePacket(c *validationContext) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		path    = field.NewPath("spec", "cloud", "packet")
+	)
+
+	allErrs = append(allErrs, validateNetworkDisjointedness(c.seed.Spec.Networks, c.shoot.Spec.Cloud.Packet.Networks.K8SNetworks, path.Child("networks"))...)
+
+	if ok, validDNSProviders := validateDNSConstraints(c.cloudProfile.Spec.Packet.Constraints.DNSProviders, c.shoot.Spec.DNS.Provider, c.oldShoot.Spec.DNS.Provider); !ok {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "dns", "provider"), c.shoot.Spec.DNS.Provider, validDNSProviders))
+	}
+	if ok, validKubernetesVersions := validateKubernetesVersionConstraints(c.cloudProfile.Spec.Packet.Constraints.Kubernetes.Versions, c.shoot.Spec.Kubernetes.Version, c.oldShoot.Spec.Kubernetes.Version); !ok {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "kubernetes", "version"), c.shoot.Spec.Kubernetes.Version, validKubernetesVersions))
+	}
+	if ok, validMachineImages := validatePacketMachineImagesConstraints(c.cloudProfile.Spec.Packet.Constraints.MachineImages, c.shoot.Spec.Cloud.Packet.MachineImage, c.oldShoot.Spec.Cloud.Packet.MachineImage); !ok {
+		allErrs = append(allErrs, field.NotSupported(path.Child("machineImage"), *c.shoot.Spec.Cloud.Packet.MachineImage, validMachineImages))
+	}
+
+	for i, worker := range c.shoot.Spec.Cloud.Packet.Workers {
+		var oldWorker = garden.PacketWorker{}
+		for _, ow := range c.oldShoot.Spec.Cloud.Packet.Workers {
+			if ow.Name == worker.Name {
+				oldWorker = ow
+				break
+			}
+		}
+
+		idxPath := path.Child("workers").Index(i)
+		if len(oldWorker.Name) == 0 {
+			allErrs = append(allErrs, validateMachineDeploymentName(common.ZonedMachineDeploymentName(c.technicalID, worker.Name, len(c.shoot.Spec.Cloud.Packet.Zones)-1), worker.Name, idxPath.Child("name"))...)
+		}
+		if ok, validMachineTypes := validateMachineTypes(c.cloudProfile.Spec.Packet.Constraints.MachineTypes, worker.MachineType, oldWorker.MachineType); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineType"), worker.MachineType, validMachineTypes))
+		}
+		if ok, validArchitectures := validateWorkerArchitecture(c.cloudProfile.Spec.Packet.Constraints.MachineTypes, machineImageArchitectures(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.Packet.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region), worker.Worker, oldWorker.Worker); !ok {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("architecture"), helper.GetMachineArchitecture(worker.Architecture), validArchitectures))
+		}
+		if worker.WarmUp != nil && *worker.WarmUp && !warmMachineImageOffered(c.cloudProfile, workerMachineImageName(c.shoot.Spec.Cloud.Packet.MachineImage.Name, worker.Worker), c.shoot.Spec.Cloud.Region, helper.GetMachineArchitecture(worker.Architecture)) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("warmUp"), "the cloud profile does not offer a pre-warmed variant of the machine image for the region and architecture of this worker"))
+		}
+		allErrs = append(allErrs, validateWorkerOverrides(c, c.cloudProfile.Spec.Packet.Constraints.Kubernetes.Versions, worker.Worker, oldWorker.Worker, idxPath)...)
+	}
+
+	for i, zone := range c.shoot.Spec.Cloud.Packet.Zones {
+		idxPath := path.Child("zones").Index(i)
+		if ok, validZones := validateZones(c.cloudProfile.Spec.Packet.Constraints.Zones, c.shoot.Spec.Cloud.Region, zone); !ok {
+			if len(validZones) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath, c.shoot.Spec.Cloud.Region, "this region is not allowed"))
+			} else {
+				allErrs = append(allErrs, field.NotSupported(idxPath, zone, validZones))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // Helper functions
 
 func networksIntersect(cidr1, cidr2 garden.CIDR) bool {
@@ -471,6 +840,18 @@ func networksIntersect(cidr1, cidr2 garden.CIDR) bool {
 	return err1 != nil || err2 != nil || net2.Contains(net1.IP) || net1.Contains(net2.IP)
 }
 
+// validateMachineDeploymentName validates that the name of a MachineDeployment which is derived from the technical id
+// of the Shoot and the name of a worker group does not exceed the maximum length.
+func validateMachineDeploymentName(machineDeploymentName, workerName string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(machineDeploymentName) > common.MachineDeploymentNameMaxLength {
+		allErrs = append(allErrs, field.Invalid(fldPath, workerName, fmt.Sprintf("the derived machine deployment name %s must not exceed %d characters, choose a shorter worker name", machineDeploymentName, common.MachineDeploymentNameMaxLength)))
+	}
+
+	return allErrs
+}
+
 func validateDNSConstraints(constraints []garden.DNSProviderConstraint, provider, oldProvider garden.DNSProvider) (bool, []string) {
 	if provider == oldProvider {
 		return true, nil
@@ -523,12 +904,83 @@ func validateMachineTypes(constraints []garden.MachineType, machineType, oldMach
 }
 
 func validateOpenStackMachineTypes(constraints []garden.OpenStackMachineType, machineType, oldMachineType string) (bool, []string) {
+	return validateMachineTypes(openStackMachineTypes(constraints), machineType, oldMachineType)
+}
+
+func openStackMachineTypes(constraints []garden.OpenStackMachineType) []garden.MachineType {
 	machineTypes := []garden.MachineType{}
 	for _, t := range constraints {
 		machineTypes = append(machineTypes, t.MachineType)
 	}
+	return machineTypes
+}
+
+// validateWorkerArchitecture validates that the CPU architecture of the <worker> matches the architecture of its
+// machine type, and that the cloud profile offers the machine image of the Shoot for this architecture. Workers of the
+// default architecture use the machine image of the Shoot which is validated separately.
+func validateWorkerArchitecture(constraints []garden.MachineType, imageArchitectures []garden.MachineArchitecture, worker, oldWorker garden.Worker) (bool, []string) {
+	architecture := helper.GetMachineArchitecture(worker.Architecture)
+	if worker.MachineType == oldWorker.MachineType && architecture == helper.GetMachineArchitecture(oldWorker.Architecture) {
+		return true, nil
+	}
+
+	for _, t := range constraints {
+		if t.Name != worker.MachineType {
+			continue
+		}
+
+		machineTypeArchitecture := helper.GetMachineArchitecture(t.Architecture)
+		if machineTypeArchitecture != garden.MachineArchitectureAMD64 && !machineArchitecturesContain(imageArchitectures, machineTypeArchitecture) {
+			return false, []string{}
+		}
+		return architecture == machineTypeArchitecture, []string{string(machineTypeArchitecture)}
+	}
+
+	// Unknown machine types are reported by validateMachineTypes.
+	return true, nil
+}
+
+// workerMachineImageName returns the name of the machine image of the <worker>, i.e. the machine image it overrides or
+// the machine image of the Shoot with the given <name>.
+func workerMachineImageName(name garden.MachineImageName, worker garden.Worker) garden.MachineImageName {
+	if worker.MachineImage != nil {
+		return *worker.MachineImage
+	}
+	return name
+}
+
+// validateWorkerOverrides validates that the cloud profile offers the machine image and the Kubernetes version which
+// the <worker> overrides. The Kubernetes versions offered by the cloud profile are given by <kubernetesVersions>.
+// Overrides which have not been changed are not validated again.
+func validateWorkerOverrides(c *validationContext, kubernetesVersions []string, worker, oldWorker garden.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if worker.MachineImage != nil && (oldWorker.MachineImage == nil || *worker.MachineImage != *oldWorker.MachineImage || helper.GetMachineArchitecture(worker.Architecture) != helper.GetMachineArchitecture(oldWorker.Architecture)) {
+		if !machineArchitecturesContain(machineImageArchitectures(c.cloudProfile, *worker.MachineImage, c.shoot.Spec.Cloud.Region), helper.GetMachineArchitecture(worker.Architecture)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("machineImage"), *worker.MachineImage, "the cloud profile does not offer this machine image for the region and architecture of this worker"))
+		}
+	}
+
+	if worker.KubernetesVersion != nil {
+		var oldVersion string
+		if oldWorker.KubernetesVersion != nil {
+			oldVersion = *oldWorker.KubernetesVersion
+		}
+		if ok, validKubernetesVersions := validateKubernetesVersionConstraints(kubernetesVersions, *worker.KubernetesVersion, oldVersion); !ok {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("kubernetesVersion"), *worker.KubernetesVersion, validKubernetesVersions))
+		}
+	}
 
-	return validateMachineTypes(machineTypes, machineType, oldMachineType)
+	return allErrs
+}
+
+func machineArchitecturesContain(architectures []garden.MachineArchitecture, architecture garden.MachineArchitecture) bool {
+	for _, a := range architectures {
+		if a == architecture {
+			return true
+		}
+	}
+	return false
 }
 
 func validateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks garden.K8SNetworks, fldPath *field.Path) field.ErrorList {
@@ -626,8 +1078,75 @@ func validateLoadBalancerProviderConstraints(providers []garden.OpenStackLoadBal
 
 // Machine Image Helper functions
 
+// machineImageArchitectures returns the CPU architectures for which the <cloudProfile> offers the machine image with the
+// given <name> in the given <region>.
+func machineImageArchitectures(cloudProfile *garden.CloudProfile, name garden.MachineImageName, region string) []garden.MachineArchitecture {
+	architectures := []garden.MachineArchitecture{}
+
+	switch {
+	case cloudProfile.Spec.AWS != nil:
+		for _, image := range cloudProfile.Spec.AWS.Constraints.MachineImages {
+			if _, err := findAWSMachineImageForRegion(image, region); image.Name == name && err == nil {
+				architectures = append(architectures, helper.GetMachineArchitecture(image.Architecture))
+			}
+		}
+	case cloudProfile.Spec.Azure != nil:
+		for _, image := range cloudProfile.Spec.Azure.Constraints.MachineImages {
+			if image.Name == name {
+				architectures = append(architectures, helper.GetMachineArchitecture(image.Architecture))
+			}
+		}
+	case cloudProfile.Spec.GCP != nil:
+		for _, image := range cloudProfile.Spec.GCP.Constraints.MachineImages {
+			if image.Name == name {
+				architectures = append(architectures, helper.GetMachineArchitecture(image.Architecture))
+			}
+		}
+	case cloudProfile.Spec.OpenStack != nil:
+		for _, image := range cloudProfile.Spec.OpenStack.Constraints.MachineImages {
+			if image.Name == name {
+				architectures = append(architectures, helper.GetMachineArchitecture(image.Architecture))
+			}
+		}
+	case cloudProfile.Spec.Packet != nil:
+		for _, image := range cloudProfile.Spec.Packet.Constraints.MachineImages {
+			if image.Name == name {
+				architectures = append(architectures, helper.GetMachineArchitecture(image.Architecture))
+			}
+		}
+	}
+
+	return architectures
+}
+
+// warmMachineImageOffered returns true if the <cloudProfile> offers a pre-warmed variant of the machine image with the
+// given <name> for the given <region> and CPU <architecture>. Pre-warmed variants are only supported on AWS and GCP.
+func warmMachineImageOffered(cloudProfile *garden.CloudProfile, name garden.MachineImageName, region string, architecture garden.MachineArchitecture) bool {
+	switch {
+	case cloudProfile.Spec.AWS != nil:
+		for _, image := range cloudProfile.Spec.AWS.Constraints.MachineImages {
+			if image.Name != name || helper.GetMachineArchitecture(image.Architecture) != architecture {
+				continue
+			}
+			for _, regionalMachineImage := range image.Regions {
+				if regionalMachineImage.Name == region && regionalMachineImage.WarmAMI != nil {
+					return true
+				}
+			}
+		}
+	case cloudProfile.Spec.GCP != nil:
+		for _, image := range cloudProfile.Spec.GCP.Constraints.MachineImages {
+			if image.Name == name && helper.GetMachineArchitecture(image.Architecture) == architecture && image.WarmImage != nil {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func getAWSMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) (*garden.AWSMachineImage, error) {
-	machineImageMappings := cloudProfile.Spec.AWS.Constraints.MachineImages
+	machineImageMappings := defaultArchitectureAWSMachineImages(cloudProfile.Spec.AWS.Constraints.MachineImages)
 	if len(machineImageMappings) != 1 {
 		return nil, errors.New("must provide a value for .spec.cloud.aws.machineImage as the referenced cloud profile contains more than one")
 	}
@@ -647,6 +1166,18 @@ func findAWSMachineImageForRegion(machineImageMapping garden.AWSMachineImageMapp
 	return nil, fmt.Errorf("could not find an AMI for region %s and machine image %s", region, machineImageMapping.Name)
 }
 
+// defaultArchitectureAWSMachineImages returns the machine images of the given list which are built for the default
+// architecture. Only these can be used as the machine image of a Shoot.
+func defaultArchitectureAWSMachineImages(machineImages []garden.AWSMachineImageMapping) []garden.AWSMachineImageMapping {
+	images := []garden.AWSMachineImageMapping{}
+	for _, image := range machineImages {
+		if helper.GetMachineArchitecture(image.Architecture) == garden.MachineArchitectureAMD64 {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
 func validateAWSMachineImagesConstraints(constraints []garden.AWSMachineImageMapping, region string, image, oldImage *garden.AWSMachineImage) (bool, []string) {
 	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
@@ -654,7 +1185,7 @@ func validateAWSMachineImagesConstraints(constraints []garden.AWSMachineImageMap
 
 	validValues := []string{}
 
-	for _, v := range constraints {
+	for _, v := range defaultArchitectureAWSMachineImages(constraints) {
 		machineImage, err := findAWSMachineImageForRegion(v, region)
 		if err != nil {
 			return false, nil
@@ -671,13 +1202,25 @@ func validateAWSMachineImagesConstraints(constraints []garden.AWSMachineImageMap
 }
 
 func getAzureMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) (*garden.AzureMachineImage, error) {
-	machineImages := cloudProfile.Spec.Azure.Constraints.MachineImages
+	machineImages := defaultArchitectureAzureMachineImages(cloudProfile.Spec.Azure.Constraints.MachineImages)
 	if len(machineImages) != 1 {
 		return nil, errors.New("must provide a value for .spec.cloud.azure.machineImage as the referenced cloud profile contains more than one")
 	}
 	return &machineImages[0], nil
 }
 
+// defaultArchitectureAzureMachineImages returns the machine images of the given list which are built for the default
+// architecture. Only these can be used as the machine image of a Shoot.
+func defaultArchitectureAzureMachineImages(machineImages []garden.AzureMachineImage) []garden.AzureMachineImage {
+	images := []garden.AzureMachineImage{}
+	for _, image := range machineImages {
+		if helper.GetMachineArchitecture(image.Architecture) == garden.MachineArchitectureAMD64 {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
 func validateAzureMachineImagesConstraints(constraints []garden.AzureMachineImage, image, oldImage *garden.AzureMachineImage) (bool, []string) {
 	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
@@ -685,7 +1228,7 @@ func validateAzureMachineImagesConstraints(constraints []garden.AzureMachineImag
 
 	validValues := []string{}
 
-	for _, v := range constraints {
+	for _, v := range defaultArchitectureAzureMachineImages(constraints) {
 		validValues = append(validValues, fmt.Sprintf("%+v", v))
 		if apiequality.Semantic.DeepEqual(v, *image) {
 			return true, nil
@@ -696,13 +1239,25 @@ func validateAzureMachineImagesConstraints(constraints []garden.AzureMachineImag
 }
 
 func getGCPMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) (*garden.GCPMachineImage, error) {
-	machineImages := cloudProfile.Spec.GCP.Constraints.MachineImages
+	machineImages := defaultArchitectureGCPMachineImages(cloudProfile.Spec.GCP.Constraints.MachineImages)
 	if len(machineImages) != 1 {
 		return nil, errors.New("must provide a value for .spec.cloud.gcp.machineImage as the referenced cloud profile contains more than one")
 	}
 	return &machineImages[0], nil
 }
 
+// defaultArchitectureGCPMachineImages returns the machine images of the given list which are built for the default
+// architecture. Only these can be used as the machine image of a Shoot.
+func defaultArchitectureGCPMachineImages(machineImages []garden.GCPMachineImage) []garden.GCPMachineImage {
+	images := []garden.GCPMachineImage{}
+	for _, image := range machineImages {
+		if helper.GetMachineArchitecture(image.Architecture) == garden.MachineArchitectureAMD64 {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
 func validateGCPMachineImagesConstraints(constraints []garden.GCPMachineImage, image, oldImage *garden.GCPMachineImage) (bool, []string) {
 	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
@@ -710,7 +1265,7 @@ func validateGCPMachineImagesConstraints(constraints []garden.GCPMachineImage, i
 
 	validValues := []string{}
 
-	for _, v := range constraints {
+	for _, v := range defaultArchitectureGCPMachineImages(constraints) {
 		validValues = append(validValues, fmt.Sprintf("%+v", v))
 		if apiequality.Semantic.DeepEqual(v, *image) {
 			return true, nil
@@ -721,13 +1276,25 @@ func validateGCPMachineImagesConstraints(constraints []garden.GCPMachineImage, i
 }
 
 func getOpenStackMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) (*garden.OpenStackMachineImage, error) {
-	machineImages := cloudProfile.Spec.OpenStack.Constraints.MachineImages
+	machineImages := defaultArchitectureOpenStackMachineImages(cloudProfile.Spec.OpenStack.Constraints.MachineImages)
 	if len(machineImages) != 1 {
 		return nil, errors.New("must provide a value for .spec.cloud.openstack.machineImage as the referenced cloud profile contains more than one")
 	}
 	return &machineImages[0], nil
 }
 
+// defaultArchitectureOpenStackMachineImages returns the machine images of the given list which are built for the default
+// architecture. Only these can be used as the machine image of a Shoot.
+func defaultArchitectureOpenStackMachineImages(machineImages []garden.OpenStackMachineImage) []garden.OpenStackMachineImage {
+	images := []garden.OpenStackMachineImage{}
+	for _, image := range machineImages {
+		if helper.GetMachineArchitecture(image.Architecture) == garden.MachineArchitectureAMD64 {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
 func validateOpenStackMachineImagesConstraints(constraints []garden.OpenStackMachineImage, image, oldImage *garden.OpenStackMachineImage) (bool, []string) {
 	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
 		return true, nil
@@ -735,7 +1302,44 @@ func validateOpenStackMachineImagesConstraints(constraints []garden.OpenStackMac
 
 	validValues := []string{}
 
-	for _, v := range constraints {
+	for _, v := range defaultArchitectureOpenStackMachineImages(constraints) {
+		validValues = append(validValues, fmt.Sprintf("%+v", v))
+		if apiequality.Semantic.DeepEqual(v, *image) {
+			return true, nil
+		}
+	}
+
+	return false, validValues
+}
+
+func getPacketMachineImage(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) (*garden.PacketMachineImage, error) {
+	machineImages := defaultArchitecturePacketMachineImages(cloudProfile.Spec.Packet.Constraints.MachineImages)
+	if len(machineImages) != 1 {
+		return nil, errors.New("must provide a value for .spec.cloud.packet.machineImage as the referenced cloud profile contains more than one")
+	}
+	return &machineImages[0], nil
+}
+
+// defaultArchitecturePacketMachineImages returns the machine images of the given list which are built for the default
+// architecture. Only these can be used as the machine image of a Shoot.
+func defaultArchitecturePacketMachineImages(machineImages []garden.PacketMachineImage) []garden.PacketMachineImage {
+	images := []garden.PacketMachineImage{}
+	for _, image := range machineImages {
+		if helper.GetMachineArchitecture(image.Architecture) == garden.MachineArchitectureAMD64 {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+func validatePacketMachineImagesConstraints(constraints []garden.PacketMachineImage, image, oldImage *garden.PacketMachineImage) (bool, []string) {
+	if apiequality.Semantic.DeepEqual(*image, *oldImage) {
+		return true, nil
+	}
+
+	validValues := []string{}
+
+	for _, v := range defaultArchitecturePacketMachineImages(constraints) {
 		validValues = append(validValues, fmt.Sprintf("%+v", v))
 		if apiequality.Semantic.DeepEqual(v, *image) {
 			return true, nil
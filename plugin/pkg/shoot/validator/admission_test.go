@@ -16,6 +16,7 @@ package validator_test
 
 import (
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apiserver/admission/cloudprovider"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	"github.com/gardener/gardener/pkg/operation/common"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/validator"
@@ -23,6 +24,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 	"k8s.io/apiserver/pkg/authentication/user"
 	kubeinformers "k8s.io/client-go/informers"
@@ -362,7 +365,12 @@ var _ = Describe("validator", func() {
 			BeforeEach(func() {
 				cloudProfile = cloudProfileBase
 				shoot = shootBase
-				awsCloud.Networks = garden.AWSNetworks{K8SNetworks: k8sNetworks}
+				awsCloud.Networks = garden.AWSNetworks{
+					K8SNetworks: k8sNetworks,
+					Internal:    []garden.CIDR{"10.250.112.0/22"},
+					Public:      []garden.CIDR{"10.250.96.0/22"},
+					Workers:     []garden.CIDR{"10.250.0.0/19"},
+				}
 				awsCloud.Workers = workers
 				awsCloud.Zones = zones
 				cloudProfile.Spec.AWS = awsProfile
@@ -386,6 +394,273 @@ var _ = Describe("validator", func() {
 				Expect(shoot.Annotations).To(HaveKeyWithValue(common.GardenCreatedBy, userName))
 			})
 
+			It("should reject because the passive replica seed is the seed of the shoot", func() {
+				shoot.Annotations = map[string]string{common.ShootPassiveReplicaSeed: seedName}
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject because the passive replica seed uses a different cloud profile", func() {
+				passiveSeed := seedBase
+				passiveSeed.Name = "passive-seed"
+				passiveSeed.Spec.Cloud.Profile = "other-profile"
+				shoot.Annotations = map[string]string{common.ShootPassiveReplicaSeed: passiveSeed.Name}
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&passiveSeed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should accept a passive replica seed using the same cloud profile", func() {
+				passiveSeed := seedBase
+				passiveSeed.Name = "passive-seed"
+				shoot.Annotations = map[string]string{common.ShootPassiveReplicaSeed: passiveSeed.Name}
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&passiveSeed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, &user.DefaultInfo{Name: "test-user"})
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject a clone whose source uses a different seed", func() {
+				otherSeedName := "other-seed"
+				source := shootBase
+				source.Name = "source"
+				source.Spec.Cloud.Seed = &otherSeedName
+				source.Status = garden.ShootStatus{UID: "1234", TechnicalID: "shoot--my-ns--source"}
+				shoot.Annotations = map[string]string{common.ShootCloneOf: source.Name}
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&source)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should accept a clone whose source uses the same seed", func() {
+				source := shootBase
+				source.Name = "source"
+				source.Status = garden.ShootStatus{UID: "1234", TechnicalID: "shoot--my-ns--source"}
+				shoot.Annotations = map[string]string{common.ShootCloneOf: source.Name}
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&source)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, &user.DefaultInfo{Name: "test-user"})
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("shoot dependencies", func() {
+				var base garden.Shoot
+
+				BeforeEach(func() {
+					base = shootBase
+					base.Name = "base"
+					base.Namespace = "garden"
+					shoot.Spec.DependsOn = []corev1.ObjectReference{{Namespace: "garden", Name: "base"}}
+				})
+
+				admit := func() error {
+					kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, &user.DefaultInfo{Name: "test-user"})
+
+					return admissionHandler.Admit(attrs)
+				}
+
+				It("should accept a dependency on an existing shoot", func() {
+					gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&base)
+
+					err := admit()
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject a dependency on a non-existing shoot", func() {
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a dependency on itself", func() {
+					shoot.Spec.DependsOn = []corev1.ObjectReference{{Name: shoot.Name}}
+
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a dependency cycle", func() {
+					base.Spec.DependsOn = []corev1.ObjectReference{{Namespace: shoot.Namespace, Name: shoot.Name}}
+					gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(&base)
+
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("dependency cycle detected"))
+				})
+			})
+
+			Context("worker renames", func() {
+				var oldShoot *garden.Shoot
+
+				BeforeEach(func() {
+					oldShoot = shoot.DeepCopy()
+					renamedWorker := workers[0]
+					renamedWorker.Name = "worker-name-v2"
+					shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{renamedWorker}
+					shoot.Annotations = map[string]string{common.ShootRenameWorkers: "worker-name=worker-name-v2"}
+				})
+
+				admit := func(operation admission.Operation) error {
+					kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					var old runtime.Object
+					if operation == admission.Update {
+						old = oldShoot
+					}
+					attrs := admission.NewAttributesRecord(&shoot, old, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", operation, &user.DefaultInfo{Name: "test-user"})
+
+					return admissionHandler.Admit(attrs)
+				}
+
+				It("should accept the rename of an existing worker group", func() {
+					Expect(admit(admission.Update)).To(Succeed())
+				})
+
+				It("should accept a pending rename", func() {
+					oldShoot = shoot.DeepCopy()
+
+					Expect(admit(admission.Update)).To(Succeed())
+				})
+
+				It("should reject the rename of a worker group which does not exist", func() {
+					shoot.Annotations[common.ShootRenameWorkers] = "unknown-worker=worker-name-v2"
+
+					err := admit(admission.Update)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a rename whose new worker group does not exist", func() {
+					shoot.Annotations[common.ShootRenameWorkers] = "worker-name=worker-name-v3"
+
+					err := admit(admission.Update)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a rename which keeps the old worker group", func() {
+					shoot.Spec.Cloud.AWS.Workers = append(shoot.Spec.Cloud.AWS.Workers, workers...)
+
+					err := admit(admission.Update)
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring("must be removed when it is renamed"))
+				})
+
+				It("should reject renames on creation", func() {
+					err := admit(admission.Create)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+			})
+
+			Context("recovery drills", func() {
+				admit := func() error {
+					kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, &user.DefaultInfo{Name: "test-user"})
+
+					return admissionHandler.Admit(attrs)
+				}
+
+				It("should accept supported recovery drills", func() {
+					shoot.Annotations = map[string]string{common.ShootRecoveryDrills: "kube-apiserver,machine,etcd-restore"}
+
+					Expect(admit()).To(Succeed())
+				})
+
+				It("should reject unknown recovery drills", func() {
+					shoot.Annotations = map[string]string{common.ShootRecoveryDrills: "kube-apiserver,seed"}
+
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(`unknown recovery drill "seed"`))
+				})
+			})
+
+			It("should reject a new worker group whose derived machine deployment name is too long", func() {
+				shoot.Status.TechnicalID = "shoot-a-very-long-project-name-and-a-very-long-shoot-name"
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Cloud.AWS.Workers = append([]garden.AWSWorker{}, workers...)
+				newWorker := workers[0]
+				newWorker.Name = "new-worker"
+				shoot.Spec.Cloud.AWS.Workers = append(shoot.Spec.Cloud.AWS.Workers, newWorker)
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should not reject existing worker groups whose derived machine deployment name is too long", func() {
+				shoot.Status.TechnicalID = "shoot-a-very-long-project-name-and-a-very-long-shoot-name"
+				oldShoot := shoot.DeepCopy()
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
 			It("should reject because the shoot node and the seed node networks intersect", func() {
 				shoot.Spec.Cloud.AWS.Networks.Nodes = &seedNodesCIDR
 
@@ -493,6 +768,198 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			Context("worker architectures", func() {
+				var arm64 = garden.MachineArchitectureARM64
+
+				BeforeEach(func() {
+					profile := awsProfile.DeepCopy()
+					profile.Constraints.MachineTypes = append(profile.Constraints.MachineTypes, garden.MachineType{
+						Name:         "machine-type-arm64",
+						CPU:          resource.MustParse("2"),
+						GPU:          resource.MustParse("0"),
+						Memory:       resource.MustParse("100Gi"),
+						Architecture: &arm64,
+					})
+					cloudProfile.Spec.AWS = profile
+
+					shoot.Spec.Cloud.AWS.MachineImage = nil
+					shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+						{
+							Worker: garden.Worker{
+								Name:          "worker-arm64",
+								MachineType:   "machine-type-arm64",
+								AutoScalerMin: 1,
+								AutoScalerMax: 1,
+								Architecture:  &arm64,
+							},
+							VolumeSize: "10Gi",
+							VolumeType: "volume-type-1",
+						},
+					}
+				})
+
+				admit := func() error {
+					kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, &user.DefaultInfo{Name: "test-user"})
+
+					return admissionHandler.Admit(attrs)
+				}
+
+				It("should accept a worker whose machine type and machine image are offered for its architecture", func() {
+					cloudProfile.Spec.AWS.Constraints.MachineImages = append(cloudProfile.Spec.AWS.Constraints.MachineImages, garden.AWSMachineImageMapping{
+						Name:         garden.MachineImageCoreOS,
+						Architecture: &arm64,
+						Regions: []garden.AWSRegionalMachineImage{
+							{
+								Name: "europe",
+								AMI:  "ami-87654321",
+							},
+						},
+					})
+
+					err := admit()
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(shoot.Spec.Cloud.AWS.MachineImage.AMI).To(Equal("ami-12345678"))
+				})
+
+				It("should reject a worker whose machine image is not offered for its architecture", func() {
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a worker whose architecture does not match the one of its machine type", func() {
+					shoot.Spec.Cloud.AWS.Workers[0].Architecture = nil
+
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+			})
+
+			Context("worker warm-up", func() {
+				var warmUp = true
+
+				BeforeEach(func() {
+					cloudProfile.Spec.AWS = awsProfile.DeepCopy()
+
+					shoot.Spec.Cloud.AWS.MachineImage = nil
+					shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+						{
+							Worker: garden.Worker{
+								Name:          "worker-warm",
+								MachineType:   "machine-type-1",
+								AutoScalerMin: 1,
+								AutoScalerMax: 1,
+								WarmUp:        &warmUp,
+							},
+							VolumeSize: "10Gi",
+							VolumeType: "volume-type-1",
+						},
+					}
+				})
+
+				admit := func() error {
+					kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, &user.DefaultInfo{Name: "test-user"})
+
+					return admissionHandler.Admit(attrs)
+				}
+
+				It("should accept a worker with warm-up if a pre-warmed machine image is offered", func() {
+					warmAMI := "ami-87654321"
+					cloudProfile.Spec.AWS.Constraints.MachineImages[0].Regions[0].WarmAMI = &warmAMI
+
+					err := admit()
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject a worker with warm-up if no pre-warmed machine image is offered", func() {
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+			})
+
+			Context("worker overrides", func() {
+				var ubuntu = garden.MachineImageName("Ubuntu")
+
+				BeforeEach(func() {
+					cloudProfile.Spec.AWS = awsProfile.DeepCopy()
+					cloudProfile.Spec.AWS.Constraints.Kubernetes.Versions = []string{"1.6.4", "1.6.3"}
+
+					shoot.Spec.Cloud.AWS.MachineImage = nil
+					shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
+						{
+							Worker: garden.Worker{
+								Name:          "worker-canary",
+								MachineType:   "machine-type-1",
+								AutoScalerMin: 1,
+								AutoScalerMax: 1,
+								MachineImage:  &ubuntu,
+							},
+							VolumeSize: "10Gi",
+							VolumeType: "volume-type-1",
+						},
+					}
+				})
+
+				admit := func() error {
+					kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, &user.DefaultInfo{Name: "test-user"})
+
+					return admissionHandler.Admit(attrs)
+				}
+
+				It("should accept a worker whose machine image and Kubernetes version are offered", func() {
+					version := "1.6.3"
+					shoot.Spec.Cloud.AWS.MachineImage = &garden.AWSMachineImage{Name: garden.MachineImageCoreOS, AMI: "ami-12345678"}
+					shoot.Spec.Cloud.AWS.Workers[0].KubernetesVersion = &version
+					cloudProfile.Spec.AWS.Constraints.MachineImages = append(cloudProfile.Spec.AWS.Constraints.MachineImages, garden.AWSMachineImageMapping{
+						Name: ubuntu,
+						Regions: []garden.AWSRegionalMachineImage{
+							{
+								Name: "europe",
+								AMI:  "ami-87654321",
+							},
+						},
+					})
+
+					err := admit()
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should reject a worker whose machine image is not offered", func() {
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a worker whose Kubernetes version is not offered", func() {
+					version := "1.6.2"
+					shoot.Spec.Cloud.AWS.Workers[0].MachineImage = nil
+					shoot.Spec.Cloud.AWS.Workers[0].KubernetesVersion = &version
+
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+			})
+
 			It("should reject due to an invalid volume type", func() {
 				shoot.Spec.Cloud.AWS.Workers = []garden.AWSWorker{
 					{
@@ -542,6 +1009,57 @@ var _ = Describe("validator", func() {
 				Expect(err).To(HaveOccurred())
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
+
+			It("should reject due to a workers network which is not part of the vpc network", func() {
+				vpcCIDR := garden.CIDR("10.250.0.0/16")
+				shoot.Spec.Cloud.AWS.Networks.VPC = garden.AWSVPC{CIDR: &vpcCIDR}
+				shoot.Spec.Cloud.AWS.Networks.Workers = []garden.CIDR{"10.251.0.0/19"}
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.cloud.aws.networks.workers[0]"))
+			})
+
+			It("should reject because the number of workers networks does not match the number of zones", func() {
+				shoot.Spec.Cloud.AWS.Networks.Workers = []garden.CIDR{"10.250.0.0/19", "10.250.32.0/19"}
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("spec.cloud.aws.networks.workers"))
+				Expect(err.Error()).To(ContainSubstring("must specify as many workers networks as zones"))
+			})
+
+			It("should execute the hooks of the registered cloud provider validators", func() {
+				registry := cloudprovider.NewRegistry()
+				registry.Register(garden.CloudProviderAWS, &fakeShootValidator{})
+				admissionHandler.SetCloudProviderRegistry(registry)
+
+				kubeInformerFactory.Core().V1().Namespaces().Informer().GetStore().Add(&namespace)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, nil)
+
+				err := admissionHandler.Admit(attrs)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("rejected by fake validator"))
+				Expect(shoot.Annotations).To(HaveKeyWithValue("fake-validator", "defaulted"))
+			})
 		})
 
 		Context("tests for Azure cloud", func() {
@@ -1227,3 +1745,17 @@ var _ = Describe("validator", func() {
 		})
 	})
 })
+
+type fakeShootValidator struct{}
+
+func (v *fakeShootValidator) Default(shoot *garden.Shoot, cloudProfile *garden.CloudProfile) error {
+	if shoot.Annotations == nil {
+		shoot.Annotations = map[string]string{}
+	}
+	shoot.Annotations["fake-validator"] = "defaulted"
+	return nil
+}
+
+func (v *fakeShootValidator) Validate(shoot, oldShoot *garden.Shoot, cloudProfile *garden.CloudProfile, seed *garden.Seed) field.ErrorList {
+	return field.ErrorList{field.Forbidden(field.NewPath("spec"), "rejected by fake validator")}
+}